@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"time"
 
 	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/gin-contrib/cors"
@@ -38,26 +40,61 @@ func main() {
 	}
 	defer entClient.Close()
 
+	// Initialize media storage
+	blobStore, err := config.InitializeBlobStore()
+	if err != nil {
+		log.Fatal("Failed to initialize blob store:", err)
+	}
+	mediaURLSecret, err := config.MediaURLSecret()
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// Initialize repositories
 	collectionRepo := repository.NewCollectionRepository(entClient)
 	flashcardRepo := repository.NewFlashcardRepository(entClient)
 	flashcardReviewRepo := repository.NewFlashcardReviewRepository(entClient)
 	userRepo := repository.NewUserRepository()
+	mediaRepo := repository.NewMediaRepository(entClient)
+	tagRepo := repository.NewTagRepository(entClient)
+	flashcardRevisionRepo := repository.NewFlashcardRevisionRepository(entClient)
+	deletionJobRepo := repository.NewDeletionJobRepository(entClient)
+	annotationRepo := repository.NewFlashcardAnnotationRepository(entClient)
+	quizRepo := repository.NewQuizRepository(entClient)
+	quizAttemptRepo := repository.NewQuizAttemptRepository(entClient)
+	abilityRepo := repository.NewAbilityRepository(entClient)
+	quizReviewUpdateRepo := repository.NewQuizReviewUpdateRepository(entClient)
+	itemStatisticRepo := repository.NewItemStatisticRepository(entClient)
 
 	// Initialize services
-	collectionService := service.NewCollectionService(collectionRepo, userRepo)
-	flashcardService := service.NewFlashcardService(flashcardRepo, collectionService)
-	flashcardReviewService := service.NewFlashcardReviewService(flashcardReviewRepo, flashcardRepo, collectionService)
-	userService := service.NewUserService(userRepo)
+	collectionService := service.NewCollectionService(collectionRepo, flashcardRepo, userRepo)
+	mediaService := service.NewMediaService(mediaRepo, blobStore, collectionService, mediaURLSecret)
+	flashcardService := service.NewFlashcardService(flashcardRepo, tagRepo, flashcardRevisionRepo, annotationRepo, collectionService, mediaService)
+	flashcardReviewService := service.NewFlashcardReviewService(flashcardReviewRepo, quizReviewUpdateRepo, flashcardRepo, collectionService)
+	userService := service.NewUserService(userRepo, flashcardReviewRepo, annotationRepo, tagRepo)
+	trashService := service.NewTrashService(collectionRepo, flashcardRepo, deletionJobRepo, collectionService)
+	quizService := service.NewQuizService(quizRepo, quizAttemptRepo, itemStatisticRepo, abilityRepo, quizReviewUpdateRepo, flashcardRepo, flashcardReviewService, collectionService)
 
 	// Initialize controllers
 	collectionController := controller.NewCollectionController(collectionService)
 	flashcardController := controller.NewFlashcardController(flashcardService)
 	flashcardReviewController := controller.NewFlashcardReviewController(flashcardReviewService)
 	userController := controller.NewUserController(userService)
+	mediaController := controller.NewMediaController(mediaService)
+	trashController := controller.NewTrashController(trashService)
+	quizController := controller.NewQuizController(quizService)
 
 	// Initialize router
-	appRouter := internal.NewRouter(collectionController, flashcardController, flashcardReviewController, userController)
+	appRouter := internal.NewRouter(collectionController, flashcardController, flashcardReviewController, userController, mediaController, trashController, quizController)
+
+	// Start background jobs
+	backgroundCtx, stopBackgroundJobs := context.WithCancel(context.Background())
+	defer stopBackgroundJobs()
+	go mediaService.RunGarbageCollector(backgroundCtx, time.Hour)
+	go trashService.RunPurger(backgroundCtx, time.Hour)
+	go flashcardService.BackfillQuestionKeys(backgroundCtx)
+	go flashcardService.BackfillSearchText(backgroundCtx)
+	go quizService.RunDeadlineSweeper(backgroundCtx, time.Minute)
 
 	// Setup Gin router
 	router := gin.Default()
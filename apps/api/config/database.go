@@ -12,6 +12,7 @@ import (
 	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/migrate"
 )
 
 func InitializeDatabase() (*ent.Client, error) {
@@ -40,12 +41,44 @@ func InitializeDatabase() (*ent.Client, error) {
 	drv := entsql.OpenDB(dialect.Postgres, db)
 	client := ent.NewClient(ent.Driver(drv))
 
-	// Run auto migration
+	// Run auto migration, dropping indexes that were replaced in the schema
 	ctx := context.Background()
-	if err := client.Schema.Create(ctx); err != nil {
+
+	// pg_trgm provides the trigram operator class used by the duplicate detection index
+	if _, err := db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS pg_trgm"); err != nil {
+		return nil, err
+	}
+
+	if err := client.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
+		return nil, err
+	}
+
+	if _, err := db.ExecContext(ctx, flashcardSearchTriggerSQL); err != nil {
 		return nil, err
 	}
 
 	log.Println("Database connection established successfully")
 	return client, nil
 }
+
+// flashcardSearchTriggerSQL keeps flashcards.search_vector in sync with the plain
+// text of the question and answer, weighting question matches above answer
+// matches. The text is stored without HTML or markdown markup, so that markup is
+// not indexed; flashcards created before it was stored are backfilled by
+// BackfillSearchText. The "simple" configuration does not stem, so it works for
+// any language.
+const flashcardSearchTriggerSQL = `
+CREATE OR REPLACE FUNCTION flashcards_search_vector_update() RETURNS trigger AS $$
+BEGIN
+	NEW.search_vector :=
+		setweight(to_tsvector('simple', coalesce(NEW.question_text, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(NEW.answer_text, '')), 'B');
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS flashcards_search_vector_trigger ON flashcards;
+CREATE TRIGGER flashcards_search_vector_trigger
+	BEFORE INSERT OR UPDATE OF question_text, answer_text ON flashcards
+	FOR EACH ROW EXECUTE FUNCTION flashcards_search_vector_update();
+`
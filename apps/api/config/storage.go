@@ -0,0 +1,46 @@
+package config
+
+import (
+	"errors"
+	"log"
+	"os"
+
+	"github.com/quanphung1120/advanced-quiz-be/internal/storage"
+)
+
+// InitializeBlobStore creates the blob store for media attachments. BLOB_STORE selects
+// the backend: "local" (default) stores files under BLOB_LOCAL_DIR, "s3" talks to an
+// S3-compatible service such as MinIO.
+func InitializeBlobStore() (storage.BlobStore, error) {
+	switch os.Getenv("BLOB_STORE") {
+	case "", "local":
+		dir := os.Getenv("BLOB_LOCAL_DIR")
+		if dir == "" {
+			dir = "./data/blobs"
+		}
+		log.Println("Using local blob store at", dir)
+		return storage.NewLocalBlobStore(dir)
+
+	case "s3":
+		log.Println("Using S3 blob store at", os.Getenv("S3_ENDPOINT"))
+		return storage.NewS3BlobStore(storage.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+		})
+
+	default:
+		return nil, errors.New("BLOB_STORE must be local or s3")
+	}
+}
+
+// MediaURLSecret returns the key used to sign media download URLs
+func MediaURLSecret() ([]byte, error) {
+	secret := os.Getenv("MEDIA_URL_SECRET")
+	if secret == "" {
+		return nil, errors.New("MEDIA_URL_SECRET environment variable is required")
+	}
+	return []byte(secret), nil
+}
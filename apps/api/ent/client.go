@@ -18,8 +18,23 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,10 +46,36 @@ type Client struct {
 	Collection *CollectionClient
 	// CollectionCollaborator is the client for interacting with the CollectionCollaborator builders.
 	CollectionCollaborator *CollectionCollaboratorClient
+	// DeletionJob is the client for interacting with the DeletionJob builders.
+	DeletionJob *DeletionJobClient
 	// Flashcard is the client for interacting with the Flashcard builders.
 	Flashcard *FlashcardClient
+	// FlashcardAnnotation is the client for interacting with the FlashcardAnnotation builders.
+	FlashcardAnnotation *FlashcardAnnotationClient
+	// FlashcardRating is the client for interacting with the FlashcardRating builders.
+	FlashcardRating *FlashcardRatingClient
 	// FlashcardReview is the client for interacting with the FlashcardReview builders.
 	FlashcardReview *FlashcardReviewClient
+	// FlashcardRevision is the client for interacting with the FlashcardRevision builders.
+	FlashcardRevision *FlashcardRevisionClient
+	// FlashcardTag is the client for interacting with the FlashcardTag builders.
+	FlashcardTag *FlashcardTagClient
+	// ItemStatistic is the client for interacting with the ItemStatistic builders.
+	ItemStatistic *ItemStatisticClient
+	// LearnerAbility is the client for interacting with the LearnerAbility builders.
+	LearnerAbility *LearnerAbilityClient
+	// Media is the client for interacting with the Media builders.
+	Media *MediaClient
+	// MediaUpload is the client for interacting with the MediaUpload builders.
+	MediaUpload *MediaUploadClient
+	// Quiz is the client for interacting with the Quiz builders.
+	Quiz *QuizClient
+	// QuizAnswer is the client for interacting with the QuizAnswer builders.
+	QuizAnswer *QuizAnswerClient
+	// QuizAttempt is the client for interacting with the QuizAttempt builders.
+	QuizAttempt *QuizAttemptClient
+	// QuizReviewUpdate is the client for interacting with the QuizReviewUpdate builders.
+	QuizReviewUpdate *QuizReviewUpdateClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -48,8 +89,21 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Collection = NewCollectionClient(c.config)
 	c.CollectionCollaborator = NewCollectionCollaboratorClient(c.config)
+	c.DeletionJob = NewDeletionJobClient(c.config)
 	c.Flashcard = NewFlashcardClient(c.config)
+	c.FlashcardAnnotation = NewFlashcardAnnotationClient(c.config)
+	c.FlashcardRating = NewFlashcardRatingClient(c.config)
 	c.FlashcardReview = NewFlashcardReviewClient(c.config)
+	c.FlashcardRevision = NewFlashcardRevisionClient(c.config)
+	c.FlashcardTag = NewFlashcardTagClient(c.config)
+	c.ItemStatistic = NewItemStatisticClient(c.config)
+	c.LearnerAbility = NewLearnerAbilityClient(c.config)
+	c.Media = NewMediaClient(c.config)
+	c.MediaUpload = NewMediaUploadClient(c.config)
+	c.Quiz = NewQuizClient(c.config)
+	c.QuizAnswer = NewQuizAnswerClient(c.config)
+	c.QuizAttempt = NewQuizAttemptClient(c.config)
+	c.QuizReviewUpdate = NewQuizReviewUpdateClient(c.config)
 }
 
 type (
@@ -144,8 +198,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:                 cfg,
 		Collection:             NewCollectionClient(cfg),
 		CollectionCollaborator: NewCollectionCollaboratorClient(cfg),
+		DeletionJob:            NewDeletionJobClient(cfg),
 		Flashcard:              NewFlashcardClient(cfg),
+		FlashcardAnnotation:    NewFlashcardAnnotationClient(cfg),
+		FlashcardRating:        NewFlashcardRatingClient(cfg),
 		FlashcardReview:        NewFlashcardReviewClient(cfg),
+		FlashcardRevision:      NewFlashcardRevisionClient(cfg),
+		FlashcardTag:           NewFlashcardTagClient(cfg),
+		ItemStatistic:          NewItemStatisticClient(cfg),
+		LearnerAbility:         NewLearnerAbilityClient(cfg),
+		Media:                  NewMediaClient(cfg),
+		MediaUpload:            NewMediaUploadClient(cfg),
+		Quiz:                   NewQuizClient(cfg),
+		QuizAnswer:             NewQuizAnswerClient(cfg),
+		QuizAttempt:            NewQuizAttemptClient(cfg),
+		QuizReviewUpdate:       NewQuizReviewUpdateClient(cfg),
 	}, nil
 }
 
@@ -167,8 +234,21 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:                 cfg,
 		Collection:             NewCollectionClient(cfg),
 		CollectionCollaborator: NewCollectionCollaboratorClient(cfg),
+		DeletionJob:            NewDeletionJobClient(cfg),
 		Flashcard:              NewFlashcardClient(cfg),
+		FlashcardAnnotation:    NewFlashcardAnnotationClient(cfg),
+		FlashcardRating:        NewFlashcardRatingClient(cfg),
 		FlashcardReview:        NewFlashcardReviewClient(cfg),
+		FlashcardRevision:      NewFlashcardRevisionClient(cfg),
+		FlashcardTag:           NewFlashcardTagClient(cfg),
+		ItemStatistic:          NewItemStatisticClient(cfg),
+		LearnerAbility:         NewLearnerAbilityClient(cfg),
+		Media:                  NewMediaClient(cfg),
+		MediaUpload:            NewMediaUploadClient(cfg),
+		Quiz:                   NewQuizClient(cfg),
+		QuizAnswer:             NewQuizAnswerClient(cfg),
+		QuizAttempt:            NewQuizAttemptClient(cfg),
+		QuizReviewUpdate:       NewQuizReviewUpdateClient(cfg),
 	}, nil
 }
 
@@ -197,19 +277,29 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Collection.Use(hooks...)
-	c.CollectionCollaborator.Use(hooks...)
-	c.Flashcard.Use(hooks...)
-	c.FlashcardReview.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Collection, c.CollectionCollaborator, c.DeletionJob, c.Flashcard,
+		c.FlashcardAnnotation, c.FlashcardRating, c.FlashcardReview,
+		c.FlashcardRevision, c.FlashcardTag, c.ItemStatistic, c.LearnerAbility,
+		c.Media, c.MediaUpload, c.Quiz, c.QuizAnswer, c.QuizAttempt,
+		c.QuizReviewUpdate,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Collection.Intercept(interceptors...)
-	c.CollectionCollaborator.Intercept(interceptors...)
-	c.Flashcard.Intercept(interceptors...)
-	c.FlashcardReview.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Collection, c.CollectionCollaborator, c.DeletionJob, c.Flashcard,
+		c.FlashcardAnnotation, c.FlashcardRating, c.FlashcardReview,
+		c.FlashcardRevision, c.FlashcardTag, c.ItemStatistic, c.LearnerAbility,
+		c.Media, c.MediaUpload, c.Quiz, c.QuizAnswer, c.QuizAttempt,
+		c.QuizReviewUpdate,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -219,10 +309,36 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Collection.mutate(ctx, m)
 	case *CollectionCollaboratorMutation:
 		return c.CollectionCollaborator.mutate(ctx, m)
+	case *DeletionJobMutation:
+		return c.DeletionJob.mutate(ctx, m)
 	case *FlashcardMutation:
 		return c.Flashcard.mutate(ctx, m)
+	case *FlashcardAnnotationMutation:
+		return c.FlashcardAnnotation.mutate(ctx, m)
+	case *FlashcardRatingMutation:
+		return c.FlashcardRating.mutate(ctx, m)
 	case *FlashcardReviewMutation:
 		return c.FlashcardReview.mutate(ctx, m)
+	case *FlashcardRevisionMutation:
+		return c.FlashcardRevision.mutate(ctx, m)
+	case *FlashcardTagMutation:
+		return c.FlashcardTag.mutate(ctx, m)
+	case *ItemStatisticMutation:
+		return c.ItemStatistic.mutate(ctx, m)
+	case *LearnerAbilityMutation:
+		return c.LearnerAbility.mutate(ctx, m)
+	case *MediaMutation:
+		return c.Media.mutate(ctx, m)
+	case *MediaUploadMutation:
+		return c.MediaUpload.mutate(ctx, m)
+	case *QuizMutation:
+		return c.Quiz.mutate(ctx, m)
+	case *QuizAnswerMutation:
+		return c.QuizAnswer.mutate(ctx, m)
+	case *QuizAttemptMutation:
+		return c.QuizAttempt.mutate(ctx, m)
+	case *QuizReviewUpdateMutation:
+		return c.QuizReviewUpdate.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -368,6 +484,70 @@ func (c *CollectionClient) QueryFlashcards(_m *Collection) *FlashcardQuery {
 	return query
 }
 
+// QueryQuizzes queries the quizzes edge of a Collection.
+func (c *CollectionClient) QueryQuizzes(_m *Collection) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.QuizzesTable, collection.QuizzesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAbilities queries the abilities edge of a Collection.
+func (c *CollectionClient) QueryAbilities(_m *Collection) *LearnerAbilityQuery {
+	query := (&LearnerAbilityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(learnerability.Table, learnerability.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.AbilitiesTable, collection.AbilitiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Collection.
+func (c *CollectionClient) QueryParent(_m *Collection) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, collection.ParentTable, collection.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Collection.
+func (c *CollectionClient) QueryChildren(_m *Collection) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(collection.Table, collection.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, collection.ChildrenTable, collection.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *CollectionClient) Hooks() []Hook {
 	return c.hooks.Collection
@@ -542,6 +722,139 @@ func (c *CollectionCollaboratorClient) mutate(ctx context.Context, m *Collection
 	}
 }
 
+// DeletionJobClient is a client for the DeletionJob schema.
+type DeletionJobClient struct {
+	config
+}
+
+// NewDeletionJobClient returns a client for the DeletionJob from the given config.
+func NewDeletionJobClient(c config) *DeletionJobClient {
+	return &DeletionJobClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deletionjob.Hooks(f(g(h())))`.
+func (c *DeletionJobClient) Use(hooks ...Hook) {
+	c.hooks.DeletionJob = append(c.hooks.DeletionJob, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deletionjob.Intercept(f(g(h())))`.
+func (c *DeletionJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeletionJob = append(c.inters.DeletionJob, interceptors...)
+}
+
+// Create returns a builder for creating a DeletionJob entity.
+func (c *DeletionJobClient) Create() *DeletionJobCreate {
+	mutation := newDeletionJobMutation(c.config, OpCreate)
+	return &DeletionJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeletionJob entities.
+func (c *DeletionJobClient) CreateBulk(builders ...*DeletionJobCreate) *DeletionJobCreateBulk {
+	return &DeletionJobCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeletionJobClient) MapCreateBulk(slice any, setFunc func(*DeletionJobCreate, int)) *DeletionJobCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeletionJobCreateBulk{err: fmt.Errorf("calling to DeletionJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeletionJobCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeletionJobCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeletionJob.
+func (c *DeletionJobClient) Update() *DeletionJobUpdate {
+	mutation := newDeletionJobMutation(c.config, OpUpdate)
+	return &DeletionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeletionJobClient) UpdateOne(_m *DeletionJob) *DeletionJobUpdateOne {
+	mutation := newDeletionJobMutation(c.config, OpUpdateOne, withDeletionJob(_m))
+	return &DeletionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeletionJobClient) UpdateOneID(id uuid.UUID) *DeletionJobUpdateOne {
+	mutation := newDeletionJobMutation(c.config, OpUpdateOne, withDeletionJobID(id))
+	return &DeletionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeletionJob.
+func (c *DeletionJobClient) Delete() *DeletionJobDelete {
+	mutation := newDeletionJobMutation(c.config, OpDelete)
+	return &DeletionJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeletionJobClient) DeleteOne(_m *DeletionJob) *DeletionJobDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeletionJobClient) DeleteOneID(id uuid.UUID) *DeletionJobDeleteOne {
+	builder := c.Delete().Where(deletionjob.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeletionJobDeleteOne{builder}
+}
+
+// Query returns a query builder for DeletionJob.
+func (c *DeletionJobClient) Query() *DeletionJobQuery {
+	return &DeletionJobQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeletionJob},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeletionJob entity by its id.
+func (c *DeletionJobClient) Get(ctx context.Context, id uuid.UUID) (*DeletionJob, error) {
+	return c.Query().Where(deletionjob.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeletionJobClient) GetX(ctx context.Context, id uuid.UUID) *DeletionJob {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeletionJobClient) Hooks() []Hook {
+	return c.hooks.DeletionJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeletionJobClient) Interceptors() []Interceptor {
+	return c.inters.DeletionJob
+}
+
+func (c *DeletionJobClient) mutate(ctx context.Context, m *DeletionJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeletionJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeletionJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeletionJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeletionJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeletionJob mutation op: %q", m.Op())
+	}
+}
+
 // FlashcardClient is a client for the Flashcard schema.
 type FlashcardClient struct {
 	config
@@ -682,6 +995,118 @@ func (c *FlashcardClient) QueryReviews(_m *Flashcard) *FlashcardReviewQuery {
 	return query
 }
 
+// QueryMedia queries the media edge of a Flashcard.
+func (c *FlashcardClient) QueryMedia(_m *Flashcard) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, flashcard.MediaTable, flashcard.MediaPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Flashcard.
+func (c *FlashcardClient) QueryTags(_m *Flashcard) *FlashcardTagQuery {
+	query := (&FlashcardTagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(flashcardtag.Table, flashcardtag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.TagsTable, flashcard.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRevisions queries the revisions edge of a Flashcard.
+func (c *FlashcardClient) QueryRevisions(_m *Flashcard) *FlashcardRevisionQuery {
+	query := (&FlashcardRevisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(flashcardrevision.Table, flashcardrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.RevisionsTable, flashcard.RevisionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnnotations queries the annotations edge of a Flashcard.
+func (c *FlashcardClient) QueryAnnotations(_m *Flashcard) *FlashcardAnnotationQuery {
+	query := (&FlashcardAnnotationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(flashcardannotation.Table, flashcardannotation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.AnnotationsTable, flashcard.AnnotationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuizAnswers queries the quiz_answers edge of a Flashcard.
+func (c *FlashcardClient) QueryQuizAnswers(_m *Flashcard) *QuizAnswerQuery {
+	query := (&QuizAnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(quizanswer.Table, quizanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.QuizAnswersTable, flashcard.QuizAnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemStatistics queries the item_statistics edge of a Flashcard.
+func (c *FlashcardClient) QueryItemStatistics(_m *Flashcard) *ItemStatisticQuery {
+	query := (&ItemStatisticClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(itemstatistic.Table, itemstatistic.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.ItemStatisticsTable, flashcard.ItemStatisticsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRating queries the rating edge of a Flashcard.
+func (c *FlashcardClient) QueryRating(_m *Flashcard) *FlashcardRatingQuery {
+	query := (&FlashcardRatingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, id),
+			sqlgraph.To(flashcardrating.Table, flashcardrating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, flashcard.RatingTable, flashcard.RatingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FlashcardClient) Hooks() []Hook {
 	return c.hooks.Flashcard
@@ -707,107 +1132,107 @@ func (c *FlashcardClient) mutate(ctx context.Context, m *FlashcardMutation) (Val
 	}
 }
 
-// FlashcardReviewClient is a client for the FlashcardReview schema.
-type FlashcardReviewClient struct {
+// FlashcardAnnotationClient is a client for the FlashcardAnnotation schema.
+type FlashcardAnnotationClient struct {
 	config
 }
 
-// NewFlashcardReviewClient returns a client for the FlashcardReview from the given config.
-func NewFlashcardReviewClient(c config) *FlashcardReviewClient {
-	return &FlashcardReviewClient{config: c}
+// NewFlashcardAnnotationClient returns a client for the FlashcardAnnotation from the given config.
+func NewFlashcardAnnotationClient(c config) *FlashcardAnnotationClient {
+	return &FlashcardAnnotationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `flashcardreview.Hooks(f(g(h())))`.
-func (c *FlashcardReviewClient) Use(hooks ...Hook) {
-	c.hooks.FlashcardReview = append(c.hooks.FlashcardReview, hooks...)
+// A call to `Use(f, g, h)` equals to `flashcardannotation.Hooks(f(g(h())))`.
+func (c *FlashcardAnnotationClient) Use(hooks ...Hook) {
+	c.hooks.FlashcardAnnotation = append(c.hooks.FlashcardAnnotation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `flashcardreview.Intercept(f(g(h())))`.
-func (c *FlashcardReviewClient) Intercept(interceptors ...Interceptor) {
-	c.inters.FlashcardReview = append(c.inters.FlashcardReview, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `flashcardannotation.Intercept(f(g(h())))`.
+func (c *FlashcardAnnotationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FlashcardAnnotation = append(c.inters.FlashcardAnnotation, interceptors...)
 }
 
-// Create returns a builder for creating a FlashcardReview entity.
-func (c *FlashcardReviewClient) Create() *FlashcardReviewCreate {
-	mutation := newFlashcardReviewMutation(c.config, OpCreate)
-	return &FlashcardReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FlashcardAnnotation entity.
+func (c *FlashcardAnnotationClient) Create() *FlashcardAnnotationCreate {
+	mutation := newFlashcardAnnotationMutation(c.config, OpCreate)
+	return &FlashcardAnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of FlashcardReview entities.
-func (c *FlashcardReviewClient) CreateBulk(builders ...*FlashcardReviewCreate) *FlashcardReviewCreateBulk {
-	return &FlashcardReviewCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FlashcardAnnotation entities.
+func (c *FlashcardAnnotationClient) CreateBulk(builders ...*FlashcardAnnotationCreate) *FlashcardAnnotationCreateBulk {
+	return &FlashcardAnnotationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *FlashcardReviewClient) MapCreateBulk(slice any, setFunc func(*FlashcardReviewCreate, int)) *FlashcardReviewCreateBulk {
+func (c *FlashcardAnnotationClient) MapCreateBulk(slice any, setFunc func(*FlashcardAnnotationCreate, int)) *FlashcardAnnotationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &FlashcardReviewCreateBulk{err: fmt.Errorf("calling to FlashcardReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FlashcardAnnotationCreateBulk{err: fmt.Errorf("calling to FlashcardAnnotationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*FlashcardReviewCreate, rv.Len())
+	builders := make([]*FlashcardAnnotationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &FlashcardReviewCreateBulk{config: c.config, builders: builders}
+	return &FlashcardAnnotationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for FlashcardReview.
-func (c *FlashcardReviewClient) Update() *FlashcardReviewUpdate {
-	mutation := newFlashcardReviewMutation(c.config, OpUpdate)
-	return &FlashcardReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FlashcardAnnotation.
+func (c *FlashcardAnnotationClient) Update() *FlashcardAnnotationUpdate {
+	mutation := newFlashcardAnnotationMutation(c.config, OpUpdate)
+	return &FlashcardAnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FlashcardReviewClient) UpdateOne(_m *FlashcardReview) *FlashcardReviewUpdateOne {
-	mutation := newFlashcardReviewMutation(c.config, OpUpdateOne, withFlashcardReview(_m))
-	return &FlashcardReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FlashcardAnnotationClient) UpdateOne(_m *FlashcardAnnotation) *FlashcardAnnotationUpdateOne {
+	mutation := newFlashcardAnnotationMutation(c.config, OpUpdateOne, withFlashcardAnnotation(_m))
+	return &FlashcardAnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FlashcardReviewClient) UpdateOneID(id uuid.UUID) *FlashcardReviewUpdateOne {
-	mutation := newFlashcardReviewMutation(c.config, OpUpdateOne, withFlashcardReviewID(id))
-	return &FlashcardReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FlashcardAnnotationClient) UpdateOneID(id uuid.UUID) *FlashcardAnnotationUpdateOne {
+	mutation := newFlashcardAnnotationMutation(c.config, OpUpdateOne, withFlashcardAnnotationID(id))
+	return &FlashcardAnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for FlashcardReview.
-func (c *FlashcardReviewClient) Delete() *FlashcardReviewDelete {
-	mutation := newFlashcardReviewMutation(c.config, OpDelete)
-	return &FlashcardReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FlashcardAnnotation.
+func (c *FlashcardAnnotationClient) Delete() *FlashcardAnnotationDelete {
+	mutation := newFlashcardAnnotationMutation(c.config, OpDelete)
+	return &FlashcardAnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *FlashcardReviewClient) DeleteOne(_m *FlashcardReview) *FlashcardReviewDeleteOne {
+func (c *FlashcardAnnotationClient) DeleteOne(_m *FlashcardAnnotation) *FlashcardAnnotationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *FlashcardReviewClient) DeleteOneID(id uuid.UUID) *FlashcardReviewDeleteOne {
-	builder := c.Delete().Where(flashcardreview.ID(id))
+func (c *FlashcardAnnotationClient) DeleteOneID(id uuid.UUID) *FlashcardAnnotationDeleteOne {
+	builder := c.Delete().Where(flashcardannotation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FlashcardReviewDeleteOne{builder}
+	return &FlashcardAnnotationDeleteOne{builder}
 }
 
-// Query returns a query builder for FlashcardReview.
-func (c *FlashcardReviewClient) Query() *FlashcardReviewQuery {
-	return &FlashcardReviewQuery{
+// Query returns a query builder for FlashcardAnnotation.
+func (c *FlashcardAnnotationClient) Query() *FlashcardAnnotationQuery {
+	return &FlashcardAnnotationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeFlashcardReview},
+		ctx:    &QueryContext{Type: TypeFlashcardAnnotation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a FlashcardReview entity by its id.
-func (c *FlashcardReviewClient) Get(ctx context.Context, id uuid.UUID) (*FlashcardReview, error) {
-	return c.Query().Where(flashcardreview.ID(id)).Only(ctx)
+// Get returns a FlashcardAnnotation entity by its id.
+func (c *FlashcardAnnotationClient) Get(ctx context.Context, id uuid.UUID) (*FlashcardAnnotation, error) {
+	return c.Query().Where(flashcardannotation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FlashcardReviewClient) GetX(ctx context.Context, id uuid.UUID) *FlashcardReview {
+func (c *FlashcardAnnotationClient) GetX(ctx context.Context, id uuid.UUID) *FlashcardAnnotation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -815,15 +1240,15 @@ func (c *FlashcardReviewClient) GetX(ctx context.Context, id uuid.UUID) *Flashca
 	return obj
 }
 
-// QueryFlashcard queries the flashcard edge of a FlashcardReview.
-func (c *FlashcardReviewClient) QueryFlashcard(_m *FlashcardReview) *FlashcardQuery {
+// QueryFlashcard queries the flashcard edge of a FlashcardAnnotation.
+func (c *FlashcardAnnotationClient) QueryFlashcard(_m *FlashcardAnnotation) *FlashcardQuery {
 	query := (&FlashcardClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(flashcardreview.Table, flashcardreview.FieldID, id),
+			sqlgraph.From(flashcardannotation.Table, flashcardannotation.FieldID, id),
 			sqlgraph.To(flashcard.Table, flashcard.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, flashcardreview.FlashcardTable, flashcardreview.FlashcardColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, flashcardannotation.FlashcardTable, flashcardannotation.FlashcardColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -832,8 +1257,322 @@ func (c *FlashcardReviewClient) QueryFlashcard(_m *FlashcardReview) *FlashcardQu
 }
 
 // Hooks returns the client hooks.
-func (c *FlashcardReviewClient) Hooks() []Hook {
-	return c.hooks.FlashcardReview
+func (c *FlashcardAnnotationClient) Hooks() []Hook {
+	return c.hooks.FlashcardAnnotation
+}
+
+// Interceptors returns the client interceptors.
+func (c *FlashcardAnnotationClient) Interceptors() []Interceptor {
+	return c.inters.FlashcardAnnotation
+}
+
+func (c *FlashcardAnnotationClient) mutate(ctx context.Context, m *FlashcardAnnotationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FlashcardAnnotationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FlashcardAnnotationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FlashcardAnnotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FlashcardAnnotationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FlashcardAnnotation mutation op: %q", m.Op())
+	}
+}
+
+// FlashcardRatingClient is a client for the FlashcardRating schema.
+type FlashcardRatingClient struct {
+	config
+}
+
+// NewFlashcardRatingClient returns a client for the FlashcardRating from the given config.
+func NewFlashcardRatingClient(c config) *FlashcardRatingClient {
+	return &FlashcardRatingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `flashcardrating.Hooks(f(g(h())))`.
+func (c *FlashcardRatingClient) Use(hooks ...Hook) {
+	c.hooks.FlashcardRating = append(c.hooks.FlashcardRating, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `flashcardrating.Intercept(f(g(h())))`.
+func (c *FlashcardRatingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FlashcardRating = append(c.inters.FlashcardRating, interceptors...)
+}
+
+// Create returns a builder for creating a FlashcardRating entity.
+func (c *FlashcardRatingClient) Create() *FlashcardRatingCreate {
+	mutation := newFlashcardRatingMutation(c.config, OpCreate)
+	return &FlashcardRatingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FlashcardRating entities.
+func (c *FlashcardRatingClient) CreateBulk(builders ...*FlashcardRatingCreate) *FlashcardRatingCreateBulk {
+	return &FlashcardRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FlashcardRatingClient) MapCreateBulk(slice any, setFunc func(*FlashcardRatingCreate, int)) *FlashcardRatingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FlashcardRatingCreateBulk{err: fmt.Errorf("calling to FlashcardRatingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FlashcardRatingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FlashcardRatingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FlashcardRating.
+func (c *FlashcardRatingClient) Update() *FlashcardRatingUpdate {
+	mutation := newFlashcardRatingMutation(c.config, OpUpdate)
+	return &FlashcardRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FlashcardRatingClient) UpdateOne(_m *FlashcardRating) *FlashcardRatingUpdateOne {
+	mutation := newFlashcardRatingMutation(c.config, OpUpdateOne, withFlashcardRating(_m))
+	return &FlashcardRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FlashcardRatingClient) UpdateOneID(id uuid.UUID) *FlashcardRatingUpdateOne {
+	mutation := newFlashcardRatingMutation(c.config, OpUpdateOne, withFlashcardRatingID(id))
+	return &FlashcardRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FlashcardRating.
+func (c *FlashcardRatingClient) Delete() *FlashcardRatingDelete {
+	mutation := newFlashcardRatingMutation(c.config, OpDelete)
+	return &FlashcardRatingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FlashcardRatingClient) DeleteOne(_m *FlashcardRating) *FlashcardRatingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FlashcardRatingClient) DeleteOneID(id uuid.UUID) *FlashcardRatingDeleteOne {
+	builder := c.Delete().Where(flashcardrating.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FlashcardRatingDeleteOne{builder}
+}
+
+// Query returns a query builder for FlashcardRating.
+func (c *FlashcardRatingClient) Query() *FlashcardRatingQuery {
+	return &FlashcardRatingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFlashcardRating},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FlashcardRating entity by its id.
+func (c *FlashcardRatingClient) Get(ctx context.Context, id uuid.UUID) (*FlashcardRating, error) {
+	return c.Query().Where(flashcardrating.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FlashcardRatingClient) GetX(ctx context.Context, id uuid.UUID) *FlashcardRating {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlashcard queries the flashcard edge of a FlashcardRating.
+func (c *FlashcardRatingClient) QueryFlashcard(_m *FlashcardRating) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcardrating.Table, flashcardrating.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, flashcardrating.FlashcardTable, flashcardrating.FlashcardColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FlashcardRatingClient) Hooks() []Hook {
+	return c.hooks.FlashcardRating
+}
+
+// Interceptors returns the client interceptors.
+func (c *FlashcardRatingClient) Interceptors() []Interceptor {
+	return c.inters.FlashcardRating
+}
+
+func (c *FlashcardRatingClient) mutate(ctx context.Context, m *FlashcardRatingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FlashcardRatingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FlashcardRatingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FlashcardRatingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FlashcardRatingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FlashcardRating mutation op: %q", m.Op())
+	}
+}
+
+// FlashcardReviewClient is a client for the FlashcardReview schema.
+type FlashcardReviewClient struct {
+	config
+}
+
+// NewFlashcardReviewClient returns a client for the FlashcardReview from the given config.
+func NewFlashcardReviewClient(c config) *FlashcardReviewClient {
+	return &FlashcardReviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `flashcardreview.Hooks(f(g(h())))`.
+func (c *FlashcardReviewClient) Use(hooks ...Hook) {
+	c.hooks.FlashcardReview = append(c.hooks.FlashcardReview, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `flashcardreview.Intercept(f(g(h())))`.
+func (c *FlashcardReviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FlashcardReview = append(c.inters.FlashcardReview, interceptors...)
+}
+
+// Create returns a builder for creating a FlashcardReview entity.
+func (c *FlashcardReviewClient) Create() *FlashcardReviewCreate {
+	mutation := newFlashcardReviewMutation(c.config, OpCreate)
+	return &FlashcardReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FlashcardReview entities.
+func (c *FlashcardReviewClient) CreateBulk(builders ...*FlashcardReviewCreate) *FlashcardReviewCreateBulk {
+	return &FlashcardReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FlashcardReviewClient) MapCreateBulk(slice any, setFunc func(*FlashcardReviewCreate, int)) *FlashcardReviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FlashcardReviewCreateBulk{err: fmt.Errorf("calling to FlashcardReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FlashcardReviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FlashcardReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FlashcardReview.
+func (c *FlashcardReviewClient) Update() *FlashcardReviewUpdate {
+	mutation := newFlashcardReviewMutation(c.config, OpUpdate)
+	return &FlashcardReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FlashcardReviewClient) UpdateOne(_m *FlashcardReview) *FlashcardReviewUpdateOne {
+	mutation := newFlashcardReviewMutation(c.config, OpUpdateOne, withFlashcardReview(_m))
+	return &FlashcardReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FlashcardReviewClient) UpdateOneID(id uuid.UUID) *FlashcardReviewUpdateOne {
+	mutation := newFlashcardReviewMutation(c.config, OpUpdateOne, withFlashcardReviewID(id))
+	return &FlashcardReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FlashcardReview.
+func (c *FlashcardReviewClient) Delete() *FlashcardReviewDelete {
+	mutation := newFlashcardReviewMutation(c.config, OpDelete)
+	return &FlashcardReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FlashcardReviewClient) DeleteOne(_m *FlashcardReview) *FlashcardReviewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FlashcardReviewClient) DeleteOneID(id uuid.UUID) *FlashcardReviewDeleteOne {
+	builder := c.Delete().Where(flashcardreview.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FlashcardReviewDeleteOne{builder}
+}
+
+// Query returns a query builder for FlashcardReview.
+func (c *FlashcardReviewClient) Query() *FlashcardReviewQuery {
+	return &FlashcardReviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFlashcardReview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FlashcardReview entity by its id.
+func (c *FlashcardReviewClient) Get(ctx context.Context, id uuid.UUID) (*FlashcardReview, error) {
+	return c.Query().Where(flashcardreview.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FlashcardReviewClient) GetX(ctx context.Context, id uuid.UUID) *FlashcardReview {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlashcard queries the flashcard edge of a FlashcardReview.
+func (c *FlashcardReviewClient) QueryFlashcard(_m *FlashcardReview) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcardreview.Table, flashcardreview.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, flashcardreview.FlashcardTable, flashcardreview.FlashcardColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuizUpdates queries the quiz_updates edge of a FlashcardReview.
+func (c *FlashcardReviewClient) QueryQuizUpdates(_m *FlashcardReview) *QuizReviewUpdateQuery {
+	query := (&QuizReviewUpdateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcardreview.Table, flashcardreview.FieldID, id),
+			sqlgraph.To(quizreviewupdate.Table, quizreviewupdate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcardreview.QuizUpdatesTable, flashcardreview.QuizUpdatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FlashcardReviewClient) Hooks() []Hook {
+	return c.hooks.FlashcardReview
 }
 
 // Interceptors returns the client interceptors.
@@ -856,12 +1595,1660 @@ func (c *FlashcardReviewClient) mutate(ctx context.Context, m *FlashcardReviewMu
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		Collection, CollectionCollaborator, Flashcard, FlashcardReview []ent.Hook
-	}
-	inters struct {
-		Collection, CollectionCollaborator, Flashcard, FlashcardReview []ent.Interceptor
-	}
-)
+// FlashcardRevisionClient is a client for the FlashcardRevision schema.
+type FlashcardRevisionClient struct {
+	config
+}
+
+// NewFlashcardRevisionClient returns a client for the FlashcardRevision from the given config.
+func NewFlashcardRevisionClient(c config) *FlashcardRevisionClient {
+	return &FlashcardRevisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `flashcardrevision.Hooks(f(g(h())))`.
+func (c *FlashcardRevisionClient) Use(hooks ...Hook) {
+	c.hooks.FlashcardRevision = append(c.hooks.FlashcardRevision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `flashcardrevision.Intercept(f(g(h())))`.
+func (c *FlashcardRevisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FlashcardRevision = append(c.inters.FlashcardRevision, interceptors...)
+}
+
+// Create returns a builder for creating a FlashcardRevision entity.
+func (c *FlashcardRevisionClient) Create() *FlashcardRevisionCreate {
+	mutation := newFlashcardRevisionMutation(c.config, OpCreate)
+	return &FlashcardRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FlashcardRevision entities.
+func (c *FlashcardRevisionClient) CreateBulk(builders ...*FlashcardRevisionCreate) *FlashcardRevisionCreateBulk {
+	return &FlashcardRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FlashcardRevisionClient) MapCreateBulk(slice any, setFunc func(*FlashcardRevisionCreate, int)) *FlashcardRevisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FlashcardRevisionCreateBulk{err: fmt.Errorf("calling to FlashcardRevisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FlashcardRevisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FlashcardRevisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FlashcardRevision.
+func (c *FlashcardRevisionClient) Update() *FlashcardRevisionUpdate {
+	mutation := newFlashcardRevisionMutation(c.config, OpUpdate)
+	return &FlashcardRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FlashcardRevisionClient) UpdateOne(_m *FlashcardRevision) *FlashcardRevisionUpdateOne {
+	mutation := newFlashcardRevisionMutation(c.config, OpUpdateOne, withFlashcardRevision(_m))
+	return &FlashcardRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FlashcardRevisionClient) UpdateOneID(id uuid.UUID) *FlashcardRevisionUpdateOne {
+	mutation := newFlashcardRevisionMutation(c.config, OpUpdateOne, withFlashcardRevisionID(id))
+	return &FlashcardRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FlashcardRevision.
+func (c *FlashcardRevisionClient) Delete() *FlashcardRevisionDelete {
+	mutation := newFlashcardRevisionMutation(c.config, OpDelete)
+	return &FlashcardRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FlashcardRevisionClient) DeleteOne(_m *FlashcardRevision) *FlashcardRevisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FlashcardRevisionClient) DeleteOneID(id uuid.UUID) *FlashcardRevisionDeleteOne {
+	builder := c.Delete().Where(flashcardrevision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FlashcardRevisionDeleteOne{builder}
+}
+
+// Query returns a query builder for FlashcardRevision.
+func (c *FlashcardRevisionClient) Query() *FlashcardRevisionQuery {
+	return &FlashcardRevisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFlashcardRevision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FlashcardRevision entity by its id.
+func (c *FlashcardRevisionClient) Get(ctx context.Context, id uuid.UUID) (*FlashcardRevision, error) {
+	return c.Query().Where(flashcardrevision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FlashcardRevisionClient) GetX(ctx context.Context, id uuid.UUID) *FlashcardRevision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlashcard queries the flashcard edge of a FlashcardRevision.
+func (c *FlashcardRevisionClient) QueryFlashcard(_m *FlashcardRevision) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcardrevision.Table, flashcardrevision.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, flashcardrevision.FlashcardTable, flashcardrevision.FlashcardColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FlashcardRevisionClient) Hooks() []Hook {
+	return c.hooks.FlashcardRevision
+}
+
+// Interceptors returns the client interceptors.
+func (c *FlashcardRevisionClient) Interceptors() []Interceptor {
+	return c.inters.FlashcardRevision
+}
+
+func (c *FlashcardRevisionClient) mutate(ctx context.Context, m *FlashcardRevisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FlashcardRevisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FlashcardRevisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FlashcardRevisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FlashcardRevisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FlashcardRevision mutation op: %q", m.Op())
+	}
+}
+
+// FlashcardTagClient is a client for the FlashcardTag schema.
+type FlashcardTagClient struct {
+	config
+}
+
+// NewFlashcardTagClient returns a client for the FlashcardTag from the given config.
+func NewFlashcardTagClient(c config) *FlashcardTagClient {
+	return &FlashcardTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `flashcardtag.Hooks(f(g(h())))`.
+func (c *FlashcardTagClient) Use(hooks ...Hook) {
+	c.hooks.FlashcardTag = append(c.hooks.FlashcardTag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `flashcardtag.Intercept(f(g(h())))`.
+func (c *FlashcardTagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FlashcardTag = append(c.inters.FlashcardTag, interceptors...)
+}
+
+// Create returns a builder for creating a FlashcardTag entity.
+func (c *FlashcardTagClient) Create() *FlashcardTagCreate {
+	mutation := newFlashcardTagMutation(c.config, OpCreate)
+	return &FlashcardTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FlashcardTag entities.
+func (c *FlashcardTagClient) CreateBulk(builders ...*FlashcardTagCreate) *FlashcardTagCreateBulk {
+	return &FlashcardTagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FlashcardTagClient) MapCreateBulk(slice any, setFunc func(*FlashcardTagCreate, int)) *FlashcardTagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FlashcardTagCreateBulk{err: fmt.Errorf("calling to FlashcardTagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FlashcardTagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FlashcardTagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FlashcardTag.
+func (c *FlashcardTagClient) Update() *FlashcardTagUpdate {
+	mutation := newFlashcardTagMutation(c.config, OpUpdate)
+	return &FlashcardTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FlashcardTagClient) UpdateOne(_m *FlashcardTag) *FlashcardTagUpdateOne {
+	mutation := newFlashcardTagMutation(c.config, OpUpdateOne, withFlashcardTag(_m))
+	return &FlashcardTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FlashcardTagClient) UpdateOneID(id uuid.UUID) *FlashcardTagUpdateOne {
+	mutation := newFlashcardTagMutation(c.config, OpUpdateOne, withFlashcardTagID(id))
+	return &FlashcardTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FlashcardTag.
+func (c *FlashcardTagClient) Delete() *FlashcardTagDelete {
+	mutation := newFlashcardTagMutation(c.config, OpDelete)
+	return &FlashcardTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FlashcardTagClient) DeleteOne(_m *FlashcardTag) *FlashcardTagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FlashcardTagClient) DeleteOneID(id uuid.UUID) *FlashcardTagDeleteOne {
+	builder := c.Delete().Where(flashcardtag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FlashcardTagDeleteOne{builder}
+}
+
+// Query returns a query builder for FlashcardTag.
+func (c *FlashcardTagClient) Query() *FlashcardTagQuery {
+	return &FlashcardTagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFlashcardTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FlashcardTag entity by its id.
+func (c *FlashcardTagClient) Get(ctx context.Context, id uuid.UUID) (*FlashcardTag, error) {
+	return c.Query().Where(flashcardtag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FlashcardTagClient) GetX(ctx context.Context, id uuid.UUID) *FlashcardTag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlashcard queries the flashcard edge of a FlashcardTag.
+func (c *FlashcardTagClient) QueryFlashcard(_m *FlashcardTag) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcardtag.Table, flashcardtag.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, flashcardtag.FlashcardTable, flashcardtag.FlashcardColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FlashcardTagClient) Hooks() []Hook {
+	return c.hooks.FlashcardTag
+}
+
+// Interceptors returns the client interceptors.
+func (c *FlashcardTagClient) Interceptors() []Interceptor {
+	return c.inters.FlashcardTag
+}
+
+func (c *FlashcardTagClient) mutate(ctx context.Context, m *FlashcardTagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FlashcardTagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FlashcardTagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FlashcardTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FlashcardTagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FlashcardTag mutation op: %q", m.Op())
+	}
+}
+
+// ItemStatisticClient is a client for the ItemStatistic schema.
+type ItemStatisticClient struct {
+	config
+}
+
+// NewItemStatisticClient returns a client for the ItemStatistic from the given config.
+func NewItemStatisticClient(c config) *ItemStatisticClient {
+	return &ItemStatisticClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `itemstatistic.Hooks(f(g(h())))`.
+func (c *ItemStatisticClient) Use(hooks ...Hook) {
+	c.hooks.ItemStatistic = append(c.hooks.ItemStatistic, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `itemstatistic.Intercept(f(g(h())))`.
+func (c *ItemStatisticClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ItemStatistic = append(c.inters.ItemStatistic, interceptors...)
+}
+
+// Create returns a builder for creating a ItemStatistic entity.
+func (c *ItemStatisticClient) Create() *ItemStatisticCreate {
+	mutation := newItemStatisticMutation(c.config, OpCreate)
+	return &ItemStatisticCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ItemStatistic entities.
+func (c *ItemStatisticClient) CreateBulk(builders ...*ItemStatisticCreate) *ItemStatisticCreateBulk {
+	return &ItemStatisticCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemStatisticClient) MapCreateBulk(slice any, setFunc func(*ItemStatisticCreate, int)) *ItemStatisticCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemStatisticCreateBulk{err: fmt.Errorf("calling to ItemStatisticClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemStatisticCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemStatisticCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ItemStatistic.
+func (c *ItemStatisticClient) Update() *ItemStatisticUpdate {
+	mutation := newItemStatisticMutation(c.config, OpUpdate)
+	return &ItemStatisticUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemStatisticClient) UpdateOne(_m *ItemStatistic) *ItemStatisticUpdateOne {
+	mutation := newItemStatisticMutation(c.config, OpUpdateOne, withItemStatistic(_m))
+	return &ItemStatisticUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemStatisticClient) UpdateOneID(id uuid.UUID) *ItemStatisticUpdateOne {
+	mutation := newItemStatisticMutation(c.config, OpUpdateOne, withItemStatisticID(id))
+	return &ItemStatisticUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ItemStatistic.
+func (c *ItemStatisticClient) Delete() *ItemStatisticDelete {
+	mutation := newItemStatisticMutation(c.config, OpDelete)
+	return &ItemStatisticDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemStatisticClient) DeleteOne(_m *ItemStatistic) *ItemStatisticDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemStatisticClient) DeleteOneID(id uuid.UUID) *ItemStatisticDeleteOne {
+	builder := c.Delete().Where(itemstatistic.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemStatisticDeleteOne{builder}
+}
+
+// Query returns a query builder for ItemStatistic.
+func (c *ItemStatisticClient) Query() *ItemStatisticQuery {
+	return &ItemStatisticQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItemStatistic},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ItemStatistic entity by its id.
+func (c *ItemStatisticClient) Get(ctx context.Context, id uuid.UUID) (*ItemStatistic, error) {
+	return c.Query().Where(itemstatistic.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemStatisticClient) GetX(ctx context.Context, id uuid.UUID) *ItemStatistic {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlashcard queries the flashcard edge of a ItemStatistic.
+func (c *ItemStatisticClient) QueryFlashcard(_m *ItemStatistic) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemstatistic.Table, itemstatistic.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemstatistic.FlashcardTable, itemstatistic.FlashcardColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuiz queries the quiz edge of a ItemStatistic.
+func (c *ItemStatisticClient) QueryQuiz(_m *ItemStatistic) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(itemstatistic.Table, itemstatistic.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, itemstatistic.QuizTable, itemstatistic.QuizColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemStatisticClient) Hooks() []Hook {
+	return c.hooks.ItemStatistic
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemStatisticClient) Interceptors() []Interceptor {
+	return c.inters.ItemStatistic
+}
+
+func (c *ItemStatisticClient) mutate(ctx context.Context, m *ItemStatisticMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemStatisticCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemStatisticUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemStatisticUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemStatisticDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ItemStatistic mutation op: %q", m.Op())
+	}
+}
+
+// LearnerAbilityClient is a client for the LearnerAbility schema.
+type LearnerAbilityClient struct {
+	config
+}
+
+// NewLearnerAbilityClient returns a client for the LearnerAbility from the given config.
+func NewLearnerAbilityClient(c config) *LearnerAbilityClient {
+	return &LearnerAbilityClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `learnerability.Hooks(f(g(h())))`.
+func (c *LearnerAbilityClient) Use(hooks ...Hook) {
+	c.hooks.LearnerAbility = append(c.hooks.LearnerAbility, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `learnerability.Intercept(f(g(h())))`.
+func (c *LearnerAbilityClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LearnerAbility = append(c.inters.LearnerAbility, interceptors...)
+}
+
+// Create returns a builder for creating a LearnerAbility entity.
+func (c *LearnerAbilityClient) Create() *LearnerAbilityCreate {
+	mutation := newLearnerAbilityMutation(c.config, OpCreate)
+	return &LearnerAbilityCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LearnerAbility entities.
+func (c *LearnerAbilityClient) CreateBulk(builders ...*LearnerAbilityCreate) *LearnerAbilityCreateBulk {
+	return &LearnerAbilityCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LearnerAbilityClient) MapCreateBulk(slice any, setFunc func(*LearnerAbilityCreate, int)) *LearnerAbilityCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LearnerAbilityCreateBulk{err: fmt.Errorf("calling to LearnerAbilityClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LearnerAbilityCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LearnerAbilityCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LearnerAbility.
+func (c *LearnerAbilityClient) Update() *LearnerAbilityUpdate {
+	mutation := newLearnerAbilityMutation(c.config, OpUpdate)
+	return &LearnerAbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LearnerAbilityClient) UpdateOne(_m *LearnerAbility) *LearnerAbilityUpdateOne {
+	mutation := newLearnerAbilityMutation(c.config, OpUpdateOne, withLearnerAbility(_m))
+	return &LearnerAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LearnerAbilityClient) UpdateOneID(id uuid.UUID) *LearnerAbilityUpdateOne {
+	mutation := newLearnerAbilityMutation(c.config, OpUpdateOne, withLearnerAbilityID(id))
+	return &LearnerAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LearnerAbility.
+func (c *LearnerAbilityClient) Delete() *LearnerAbilityDelete {
+	mutation := newLearnerAbilityMutation(c.config, OpDelete)
+	return &LearnerAbilityDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LearnerAbilityClient) DeleteOne(_m *LearnerAbility) *LearnerAbilityDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LearnerAbilityClient) DeleteOneID(id uuid.UUID) *LearnerAbilityDeleteOne {
+	builder := c.Delete().Where(learnerability.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LearnerAbilityDeleteOne{builder}
+}
+
+// Query returns a query builder for LearnerAbility.
+func (c *LearnerAbilityClient) Query() *LearnerAbilityQuery {
+	return &LearnerAbilityQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLearnerAbility},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LearnerAbility entity by its id.
+func (c *LearnerAbilityClient) Get(ctx context.Context, id uuid.UUID) (*LearnerAbility, error) {
+	return c.Query().Where(learnerability.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LearnerAbilityClient) GetX(ctx context.Context, id uuid.UUID) *LearnerAbility {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCollection queries the collection edge of a LearnerAbility.
+func (c *LearnerAbilityClient) QueryCollection(_m *LearnerAbility) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(learnerability.Table, learnerability.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, learnerability.CollectionTable, learnerability.CollectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LearnerAbilityClient) Hooks() []Hook {
+	return c.hooks.LearnerAbility
+}
+
+// Interceptors returns the client interceptors.
+func (c *LearnerAbilityClient) Interceptors() []Interceptor {
+	return c.inters.LearnerAbility
+}
+
+func (c *LearnerAbilityClient) mutate(ctx context.Context, m *LearnerAbilityMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LearnerAbilityCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LearnerAbilityUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LearnerAbilityUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LearnerAbilityDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LearnerAbility mutation op: %q", m.Op())
+	}
+}
+
+// MediaClient is a client for the Media schema.
+type MediaClient struct {
+	config
+}
+
+// NewMediaClient returns a client for the Media from the given config.
+func NewMediaClient(c config) *MediaClient {
+	return &MediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `media.Hooks(f(g(h())))`.
+func (c *MediaClient) Use(hooks ...Hook) {
+	c.hooks.Media = append(c.hooks.Media, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `media.Intercept(f(g(h())))`.
+func (c *MediaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Media = append(c.inters.Media, interceptors...)
+}
+
+// Create returns a builder for creating a Media entity.
+func (c *MediaClient) Create() *MediaCreate {
+	mutation := newMediaMutation(c.config, OpCreate)
+	return &MediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Media entities.
+func (c *MediaClient) CreateBulk(builders ...*MediaCreate) *MediaCreateBulk {
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaClient) MapCreateBulk(slice any, setFunc func(*MediaCreate, int)) *MediaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaCreateBulk{err: fmt.Errorf("calling to MediaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Media.
+func (c *MediaClient) Update() *MediaUpdate {
+	mutation := newMediaMutation(c.config, OpUpdate)
+	return &MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaClient) UpdateOne(_m *Media) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMedia(_m))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaClient) UpdateOneID(id uuid.UUID) *MediaUpdateOne {
+	mutation := newMediaMutation(c.config, OpUpdateOne, withMediaID(id))
+	return &MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Media.
+func (c *MediaClient) Delete() *MediaDelete {
+	mutation := newMediaMutation(c.config, OpDelete)
+	return &MediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaClient) DeleteOne(_m *Media) *MediaDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaClient) DeleteOneID(id uuid.UUID) *MediaDeleteOne {
+	builder := c.Delete().Where(media.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaDeleteOne{builder}
+}
+
+// Query returns a query builder for Media.
+func (c *MediaClient) Query() *MediaQuery {
+	return &MediaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMedia},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Media entity by its id.
+func (c *MediaClient) Get(ctx context.Context, id uuid.UUID) (*Media, error) {
+	return c.Query().Where(media.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaClient) GetX(ctx context.Context, id uuid.UUID) *Media {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlashcards queries the flashcards edge of a Media.
+func (c *MediaClient) QueryFlashcards(_m *Media) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, media.FlashcardsTable, media.FlashcardsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUploads queries the uploads edge of a Media.
+func (c *MediaClient) QueryUploads(_m *Media) *MediaUploadQuery {
+	query := (&MediaUploadClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(media.Table, media.FieldID, id),
+			sqlgraph.To(mediaupload.Table, mediaupload.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, media.UploadsTable, media.UploadsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaClient) Hooks() []Hook {
+	return c.hooks.Media
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaClient) Interceptors() []Interceptor {
+	return c.inters.Media
+}
+
+func (c *MediaClient) mutate(ctx context.Context, m *MediaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Media mutation op: %q", m.Op())
+	}
+}
+
+// MediaUploadClient is a client for the MediaUpload schema.
+type MediaUploadClient struct {
+	config
+}
+
+// NewMediaUploadClient returns a client for the MediaUpload from the given config.
+func NewMediaUploadClient(c config) *MediaUploadClient {
+	return &MediaUploadClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `mediaupload.Hooks(f(g(h())))`.
+func (c *MediaUploadClient) Use(hooks ...Hook) {
+	c.hooks.MediaUpload = append(c.hooks.MediaUpload, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `mediaupload.Intercept(f(g(h())))`.
+func (c *MediaUploadClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MediaUpload = append(c.inters.MediaUpload, interceptors...)
+}
+
+// Create returns a builder for creating a MediaUpload entity.
+func (c *MediaUploadClient) Create() *MediaUploadCreate {
+	mutation := newMediaUploadMutation(c.config, OpCreate)
+	return &MediaUploadCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MediaUpload entities.
+func (c *MediaUploadClient) CreateBulk(builders ...*MediaUploadCreate) *MediaUploadCreateBulk {
+	return &MediaUploadCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MediaUploadClient) MapCreateBulk(slice any, setFunc func(*MediaUploadCreate, int)) *MediaUploadCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MediaUploadCreateBulk{err: fmt.Errorf("calling to MediaUploadClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MediaUploadCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MediaUploadCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MediaUpload.
+func (c *MediaUploadClient) Update() *MediaUploadUpdate {
+	mutation := newMediaUploadMutation(c.config, OpUpdate)
+	return &MediaUploadUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MediaUploadClient) UpdateOne(_m *MediaUpload) *MediaUploadUpdateOne {
+	mutation := newMediaUploadMutation(c.config, OpUpdateOne, withMediaUpload(_m))
+	return &MediaUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MediaUploadClient) UpdateOneID(id uuid.UUID) *MediaUploadUpdateOne {
+	mutation := newMediaUploadMutation(c.config, OpUpdateOne, withMediaUploadID(id))
+	return &MediaUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MediaUpload.
+func (c *MediaUploadClient) Delete() *MediaUploadDelete {
+	mutation := newMediaUploadMutation(c.config, OpDelete)
+	return &MediaUploadDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MediaUploadClient) DeleteOne(_m *MediaUpload) *MediaUploadDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MediaUploadClient) DeleteOneID(id uuid.UUID) *MediaUploadDeleteOne {
+	builder := c.Delete().Where(mediaupload.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MediaUploadDeleteOne{builder}
+}
+
+// Query returns a query builder for MediaUpload.
+func (c *MediaUploadClient) Query() *MediaUploadQuery {
+	return &MediaUploadQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMediaUpload},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MediaUpload entity by its id.
+func (c *MediaUploadClient) Get(ctx context.Context, id uuid.UUID) (*MediaUpload, error) {
+	return c.Query().Where(mediaupload.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MediaUploadClient) GetX(ctx context.Context, id uuid.UUID) *MediaUpload {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMedia queries the media edge of a MediaUpload.
+func (c *MediaUploadClient) QueryMedia(_m *MediaUpload) *MediaQuery {
+	query := (&MediaClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(mediaupload.Table, mediaupload.FieldID, id),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, mediaupload.MediaTable, mediaupload.MediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MediaUploadClient) Hooks() []Hook {
+	return c.hooks.MediaUpload
+}
+
+// Interceptors returns the client interceptors.
+func (c *MediaUploadClient) Interceptors() []Interceptor {
+	return c.inters.MediaUpload
+}
+
+func (c *MediaUploadClient) mutate(ctx context.Context, m *MediaUploadMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MediaUploadCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MediaUploadUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MediaUploadUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MediaUploadDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MediaUpload mutation op: %q", m.Op())
+	}
+}
+
+// QuizClient is a client for the Quiz schema.
+type QuizClient struct {
+	config
+}
+
+// NewQuizClient returns a client for the Quiz from the given config.
+func NewQuizClient(c config) *QuizClient {
+	return &QuizClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quiz.Hooks(f(g(h())))`.
+func (c *QuizClient) Use(hooks ...Hook) {
+	c.hooks.Quiz = append(c.hooks.Quiz, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quiz.Intercept(f(g(h())))`.
+func (c *QuizClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Quiz = append(c.inters.Quiz, interceptors...)
+}
+
+// Create returns a builder for creating a Quiz entity.
+func (c *QuizClient) Create() *QuizCreate {
+	mutation := newQuizMutation(c.config, OpCreate)
+	return &QuizCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Quiz entities.
+func (c *QuizClient) CreateBulk(builders ...*QuizCreate) *QuizCreateBulk {
+	return &QuizCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizClient) MapCreateBulk(slice any, setFunc func(*QuizCreate, int)) *QuizCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizCreateBulk{err: fmt.Errorf("calling to QuizClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Quiz.
+func (c *QuizClient) Update() *QuizUpdate {
+	mutation := newQuizMutation(c.config, OpUpdate)
+	return &QuizUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizClient) UpdateOne(_m *Quiz) *QuizUpdateOne {
+	mutation := newQuizMutation(c.config, OpUpdateOne, withQuiz(_m))
+	return &QuizUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizClient) UpdateOneID(id uuid.UUID) *QuizUpdateOne {
+	mutation := newQuizMutation(c.config, OpUpdateOne, withQuizID(id))
+	return &QuizUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Quiz.
+func (c *QuizClient) Delete() *QuizDelete {
+	mutation := newQuizMutation(c.config, OpDelete)
+	return &QuizDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizClient) DeleteOne(_m *Quiz) *QuizDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizClient) DeleteOneID(id uuid.UUID) *QuizDeleteOne {
+	builder := c.Delete().Where(quiz.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizDeleteOne{builder}
+}
+
+// Query returns a query builder for Quiz.
+func (c *QuizClient) Query() *QuizQuery {
+	return &QuizQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuiz},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Quiz entity by its id.
+func (c *QuizClient) Get(ctx context.Context, id uuid.UUID) (*Quiz, error) {
+	return c.Query().Where(quiz.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizClient) GetX(ctx context.Context, id uuid.UUID) *Quiz {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryCollection queries the collection edge of a Quiz.
+func (c *QuizClient) QueryCollection(_m *Quiz) *CollectionQuery {
+	query := (&CollectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(collection.Table, collection.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quiz.CollectionTable, quiz.CollectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAttempts queries the attempts edge of a Quiz.
+func (c *QuizClient) QueryAttempts(_m *Quiz) *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quiz.AttemptsTable, quiz.AttemptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItemStatistics queries the item_statistics edge of a Quiz.
+func (c *QuizClient) QueryItemStatistics(_m *Quiz) *ItemStatisticQuery {
+	query := (&ItemStatisticClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(itemstatistic.Table, itemstatistic.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quiz.ItemStatisticsTable, quiz.ItemStatisticsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuizClient) Hooks() []Hook {
+	return c.hooks.Quiz
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizClient) Interceptors() []Interceptor {
+	return c.inters.Quiz
+}
+
+func (c *QuizClient) mutate(ctx context.Context, m *QuizMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Quiz mutation op: %q", m.Op())
+	}
+}
+
+// QuizAnswerClient is a client for the QuizAnswer schema.
+type QuizAnswerClient struct {
+	config
+}
+
+// NewQuizAnswerClient returns a client for the QuizAnswer from the given config.
+func NewQuizAnswerClient(c config) *QuizAnswerClient {
+	return &QuizAnswerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quizanswer.Hooks(f(g(h())))`.
+func (c *QuizAnswerClient) Use(hooks ...Hook) {
+	c.hooks.QuizAnswer = append(c.hooks.QuizAnswer, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quizanswer.Intercept(f(g(h())))`.
+func (c *QuizAnswerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuizAnswer = append(c.inters.QuizAnswer, interceptors...)
+}
+
+// Create returns a builder for creating a QuizAnswer entity.
+func (c *QuizAnswerClient) Create() *QuizAnswerCreate {
+	mutation := newQuizAnswerMutation(c.config, OpCreate)
+	return &QuizAnswerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuizAnswer entities.
+func (c *QuizAnswerClient) CreateBulk(builders ...*QuizAnswerCreate) *QuizAnswerCreateBulk {
+	return &QuizAnswerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizAnswerClient) MapCreateBulk(slice any, setFunc func(*QuizAnswerCreate, int)) *QuizAnswerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizAnswerCreateBulk{err: fmt.Errorf("calling to QuizAnswerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizAnswerCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizAnswerCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuizAnswer.
+func (c *QuizAnswerClient) Update() *QuizAnswerUpdate {
+	mutation := newQuizAnswerMutation(c.config, OpUpdate)
+	return &QuizAnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizAnswerClient) UpdateOne(_m *QuizAnswer) *QuizAnswerUpdateOne {
+	mutation := newQuizAnswerMutation(c.config, OpUpdateOne, withQuizAnswer(_m))
+	return &QuizAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizAnswerClient) UpdateOneID(id uuid.UUID) *QuizAnswerUpdateOne {
+	mutation := newQuizAnswerMutation(c.config, OpUpdateOne, withQuizAnswerID(id))
+	return &QuizAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuizAnswer.
+func (c *QuizAnswerClient) Delete() *QuizAnswerDelete {
+	mutation := newQuizAnswerMutation(c.config, OpDelete)
+	return &QuizAnswerDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizAnswerClient) DeleteOne(_m *QuizAnswer) *QuizAnswerDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizAnswerClient) DeleteOneID(id uuid.UUID) *QuizAnswerDeleteOne {
+	builder := c.Delete().Where(quizanswer.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizAnswerDeleteOne{builder}
+}
+
+// Query returns a query builder for QuizAnswer.
+func (c *QuizAnswerClient) Query() *QuizAnswerQuery {
+	return &QuizAnswerQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuizAnswer},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuizAnswer entity by its id.
+func (c *QuizAnswerClient) Get(ctx context.Context, id uuid.UUID) (*QuizAnswer, error) {
+	return c.Query().Where(quizanswer.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizAnswerClient) GetX(ctx context.Context, id uuid.UUID) *QuizAnswer {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttempt queries the attempt edge of a QuizAnswer.
+func (c *QuizAnswerClient) QueryAttempt(_m *QuizAnswer) *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizanswer.Table, quizanswer.FieldID, id),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizanswer.AttemptTable, quizanswer.AttemptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFlashcard queries the flashcard edge of a QuizAnswer.
+func (c *QuizAnswerClient) QueryFlashcard(_m *QuizAnswer) *FlashcardQuery {
+	query := (&FlashcardClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizanswer.Table, quizanswer.FieldID, id),
+			sqlgraph.To(flashcard.Table, flashcard.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizanswer.FlashcardTable, quizanswer.FlashcardColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuizAnswerClient) Hooks() []Hook {
+	return c.hooks.QuizAnswer
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizAnswerClient) Interceptors() []Interceptor {
+	return c.inters.QuizAnswer
+}
+
+func (c *QuizAnswerClient) mutate(ctx context.Context, m *QuizAnswerMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizAnswerCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizAnswerUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizAnswerUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizAnswerDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuizAnswer mutation op: %q", m.Op())
+	}
+}
+
+// QuizAttemptClient is a client for the QuizAttempt schema.
+type QuizAttemptClient struct {
+	config
+}
+
+// NewQuizAttemptClient returns a client for the QuizAttempt from the given config.
+func NewQuizAttemptClient(c config) *QuizAttemptClient {
+	return &QuizAttemptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quizattempt.Hooks(f(g(h())))`.
+func (c *QuizAttemptClient) Use(hooks ...Hook) {
+	c.hooks.QuizAttempt = append(c.hooks.QuizAttempt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quizattempt.Intercept(f(g(h())))`.
+func (c *QuizAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuizAttempt = append(c.inters.QuizAttempt, interceptors...)
+}
+
+// Create returns a builder for creating a QuizAttempt entity.
+func (c *QuizAttemptClient) Create() *QuizAttemptCreate {
+	mutation := newQuizAttemptMutation(c.config, OpCreate)
+	return &QuizAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuizAttempt entities.
+func (c *QuizAttemptClient) CreateBulk(builders ...*QuizAttemptCreate) *QuizAttemptCreateBulk {
+	return &QuizAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizAttemptClient) MapCreateBulk(slice any, setFunc func(*QuizAttemptCreate, int)) *QuizAttemptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizAttemptCreateBulk{err: fmt.Errorf("calling to QuizAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizAttemptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizAttemptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuizAttempt.
+func (c *QuizAttemptClient) Update() *QuizAttemptUpdate {
+	mutation := newQuizAttemptMutation(c.config, OpUpdate)
+	return &QuizAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizAttemptClient) UpdateOne(_m *QuizAttempt) *QuizAttemptUpdateOne {
+	mutation := newQuizAttemptMutation(c.config, OpUpdateOne, withQuizAttempt(_m))
+	return &QuizAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizAttemptClient) UpdateOneID(id uuid.UUID) *QuizAttemptUpdateOne {
+	mutation := newQuizAttemptMutation(c.config, OpUpdateOne, withQuizAttemptID(id))
+	return &QuizAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuizAttempt.
+func (c *QuizAttemptClient) Delete() *QuizAttemptDelete {
+	mutation := newQuizAttemptMutation(c.config, OpDelete)
+	return &QuizAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizAttemptClient) DeleteOne(_m *QuizAttempt) *QuizAttemptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizAttemptClient) DeleteOneID(id uuid.UUID) *QuizAttemptDeleteOne {
+	builder := c.Delete().Where(quizattempt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizAttemptDeleteOne{builder}
+}
+
+// Query returns a query builder for QuizAttempt.
+func (c *QuizAttemptClient) Query() *QuizAttemptQuery {
+	return &QuizAttemptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuizAttempt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuizAttempt entity by its id.
+func (c *QuizAttemptClient) Get(ctx context.Context, id uuid.UUID) (*QuizAttempt, error) {
+	return c.Query().Where(quizattempt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizAttemptClient) GetX(ctx context.Context, id uuid.UUID) *QuizAttempt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryQuiz queries the quiz edge of a QuizAttempt.
+func (c *QuizAttemptClient) QueryQuiz(_m *QuizAttempt) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizattempt.Table, quizattempt.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizattempt.QuizTable, quizattempt.QuizColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAnswers queries the answers edge of a QuizAttempt.
+func (c *QuizAttemptClient) QueryAnswers(_m *QuizAttempt) *QuizAnswerQuery {
+	query := (&QuizAnswerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizattempt.Table, quizattempt.FieldID, id),
+			sqlgraph.To(quizanswer.Table, quizanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quizattempt.AnswersTable, quizattempt.AnswersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReviewUpdates queries the review_updates edge of a QuizAttempt.
+func (c *QuizAttemptClient) QueryReviewUpdates(_m *QuizAttempt) *QuizReviewUpdateQuery {
+	query := (&QuizReviewUpdateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizattempt.Table, quizattempt.FieldID, id),
+			sqlgraph.To(quizreviewupdate.Table, quizreviewupdate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, quizattempt.ReviewUpdatesTable, quizattempt.ReviewUpdatesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuizAttemptClient) Hooks() []Hook {
+	return c.hooks.QuizAttempt
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizAttemptClient) Interceptors() []Interceptor {
+	return c.inters.QuizAttempt
+}
+
+func (c *QuizAttemptClient) mutate(ctx context.Context, m *QuizAttemptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuizAttempt mutation op: %q", m.Op())
+	}
+}
+
+// QuizReviewUpdateClient is a client for the QuizReviewUpdate schema.
+type QuizReviewUpdateClient struct {
+	config
+}
+
+// NewQuizReviewUpdateClient returns a client for the QuizReviewUpdate from the given config.
+func NewQuizReviewUpdateClient(c config) *QuizReviewUpdateClient {
+	return &QuizReviewUpdateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `quizreviewupdate.Hooks(f(g(h())))`.
+func (c *QuizReviewUpdateClient) Use(hooks ...Hook) {
+	c.hooks.QuizReviewUpdate = append(c.hooks.QuizReviewUpdate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `quizreviewupdate.Intercept(f(g(h())))`.
+func (c *QuizReviewUpdateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.QuizReviewUpdate = append(c.inters.QuizReviewUpdate, interceptors...)
+}
+
+// Create returns a builder for creating a QuizReviewUpdate entity.
+func (c *QuizReviewUpdateClient) Create() *QuizReviewUpdateCreate {
+	mutation := newQuizReviewUpdateMutation(c.config, OpCreate)
+	return &QuizReviewUpdateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of QuizReviewUpdate entities.
+func (c *QuizReviewUpdateClient) CreateBulk(builders ...*QuizReviewUpdateCreate) *QuizReviewUpdateCreateBulk {
+	return &QuizReviewUpdateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *QuizReviewUpdateClient) MapCreateBulk(slice any, setFunc func(*QuizReviewUpdateCreate, int)) *QuizReviewUpdateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &QuizReviewUpdateCreateBulk{err: fmt.Errorf("calling to QuizReviewUpdateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*QuizReviewUpdateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &QuizReviewUpdateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for QuizReviewUpdate.
+func (c *QuizReviewUpdateClient) Update() *QuizReviewUpdateUpdate {
+	mutation := newQuizReviewUpdateMutation(c.config, OpUpdate)
+	return &QuizReviewUpdateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *QuizReviewUpdateClient) UpdateOne(_m *QuizReviewUpdate) *QuizReviewUpdateUpdateOne {
+	mutation := newQuizReviewUpdateMutation(c.config, OpUpdateOne, withQuizReviewUpdate(_m))
+	return &QuizReviewUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *QuizReviewUpdateClient) UpdateOneID(id uuid.UUID) *QuizReviewUpdateUpdateOne {
+	mutation := newQuizReviewUpdateMutation(c.config, OpUpdateOne, withQuizReviewUpdateID(id))
+	return &QuizReviewUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for QuizReviewUpdate.
+func (c *QuizReviewUpdateClient) Delete() *QuizReviewUpdateDelete {
+	mutation := newQuizReviewUpdateMutation(c.config, OpDelete)
+	return &QuizReviewUpdateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *QuizReviewUpdateClient) DeleteOne(_m *QuizReviewUpdate) *QuizReviewUpdateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *QuizReviewUpdateClient) DeleteOneID(id uuid.UUID) *QuizReviewUpdateDeleteOne {
+	builder := c.Delete().Where(quizreviewupdate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &QuizReviewUpdateDeleteOne{builder}
+}
+
+// Query returns a query builder for QuizReviewUpdate.
+func (c *QuizReviewUpdateClient) Query() *QuizReviewUpdateQuery {
+	return &QuizReviewUpdateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeQuizReviewUpdate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a QuizReviewUpdate entity by its id.
+func (c *QuizReviewUpdateClient) Get(ctx context.Context, id uuid.UUID) (*QuizReviewUpdate, error) {
+	return c.Query().Where(quizreviewupdate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *QuizReviewUpdateClient) GetX(ctx context.Context, id uuid.UUID) *QuizReviewUpdate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAttempt queries the attempt edge of a QuizReviewUpdate.
+func (c *QuizReviewUpdateClient) QueryAttempt(_m *QuizReviewUpdate) *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizreviewupdate.Table, quizreviewupdate.FieldID, id),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizreviewupdate.AttemptTable, quizreviewupdate.AttemptColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReview queries the review edge of a QuizReviewUpdate.
+func (c *QuizReviewUpdateClient) QueryReview(_m *QuizReviewUpdate) *FlashcardReviewQuery {
+	query := (&FlashcardReviewClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizreviewupdate.Table, quizreviewupdate.FieldID, id),
+			sqlgraph.To(flashcardreview.Table, flashcardreview.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizreviewupdate.ReviewTable, quizreviewupdate.ReviewColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *QuizReviewUpdateClient) Hooks() []Hook {
+	return c.hooks.QuizReviewUpdate
+}
+
+// Interceptors returns the client interceptors.
+func (c *QuizReviewUpdateClient) Interceptors() []Interceptor {
+	return c.inters.QuizReviewUpdate
+}
+
+func (c *QuizReviewUpdateClient) mutate(ctx context.Context, m *QuizReviewUpdateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&QuizReviewUpdateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&QuizReviewUpdateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&QuizReviewUpdateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&QuizReviewUpdateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown QuizReviewUpdate mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		Collection, CollectionCollaborator, DeletionJob, Flashcard, FlashcardAnnotation,
+		FlashcardRating, FlashcardReview, FlashcardRevision, FlashcardTag,
+		ItemStatistic, LearnerAbility, Media, MediaUpload, Quiz, QuizAnswer,
+		QuizAttempt, QuizReviewUpdate []ent.Hook
+	}
+	inters struct {
+		Collection, CollectionCollaborator, DeletionJob, Flashcard, FlashcardAnnotation,
+		FlashcardRating, FlashcardReview, FlashcardRevision, FlashcardTag,
+		ItemStatistic, LearnerAbility, Media, MediaUpload, Quiz, QuizAnswer,
+		QuizAttempt, QuizReviewUpdate []ent.Interceptor
+	}
+)
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
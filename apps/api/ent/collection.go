@@ -26,10 +26,16 @@ type Collection struct {
 	OwnerID string `json:"owner_id,omitempty"`
 	// IsPublic holds the value of the "is_public" field.
 	IsPublic bool `json:"is_public,omitempty"`
+	// Parent collection; nil for top-level collections
+	ParentID *uuid.UUID `json:"parent_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set while the collection is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CollectionQuery when eager-loading is set.
 	Edges        CollectionEdges `json:"edges"`
@@ -42,9 +48,17 @@ type CollectionEdges struct {
 	Collaborators []*CollectionCollaborator `json:"collaborators,omitempty"`
 	// Flashcards holds the value of the flashcards edge.
 	Flashcards []*Flashcard `json:"flashcards,omitempty"`
+	// Quizzes holds the value of the quizzes edge.
+	Quizzes []*Quiz `json:"quizzes,omitempty"`
+	// Abilities holds the value of the abilities edge.
+	Abilities []*LearnerAbility `json:"abilities,omitempty"`
+	// Sub-collections, which inherit the permissions of their parent
+	Parent *Collection `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Collection `json:"children,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [6]bool
 }
 
 // CollaboratorsOrErr returns the Collaborators value or an error if the edge
@@ -65,16 +79,56 @@ func (e CollectionEdges) FlashcardsOrErr() ([]*Flashcard, error) {
 	return nil, &NotLoadedError{edge: "flashcards"}
 }
 
+// QuizzesOrErr returns the Quizzes value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) QuizzesOrErr() ([]*Quiz, error) {
+	if e.loadedTypes[2] {
+		return e.Quizzes, nil
+	}
+	return nil, &NotLoadedError{edge: "quizzes"}
+}
+
+// AbilitiesOrErr returns the Abilities value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) AbilitiesOrErr() ([]*LearnerAbility, error) {
+	if e.loadedTypes[3] {
+		return e.Abilities, nil
+	}
+	return nil, &NotLoadedError{edge: "abilities"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CollectionEdges) ParentOrErr() (*Collection, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: collection.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e CollectionEdges) ChildrenOrErr() ([]*Collection, error) {
+	if e.loadedTypes[5] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Collection) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case collection.FieldParentID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case collection.FieldIsPublic:
 			values[i] = new(sql.NullBool)
-		case collection.FieldName, collection.FieldDescription, collection.FieldOwnerID:
+		case collection.FieldName, collection.FieldDescription, collection.FieldOwnerID, collection.FieldDeletedBy:
 			values[i] = new(sql.NullString)
-		case collection.FieldCreatedAt, collection.FieldUpdatedAt:
+		case collection.FieldCreatedAt, collection.FieldUpdatedAt, collection.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case collection.FieldID:
 			values[i] = new(uuid.UUID)
@@ -123,6 +177,13 @@ func (_m *Collection) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case collection.FieldParentID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(uuid.UUID)
+				*_m.ParentID = *value.S.(*uuid.UUID)
+			}
 		case collection.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -135,6 +196,19 @@ func (_m *Collection) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case collection.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case collection.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +232,26 @@ func (_m *Collection) QueryFlashcards() *FlashcardQuery {
 	return NewCollectionClient(_m.config).QueryFlashcards(_m)
 }
 
+// QueryQuizzes queries the "quizzes" edge of the Collection entity.
+func (_m *Collection) QueryQuizzes() *QuizQuery {
+	return NewCollectionClient(_m.config).QueryQuizzes(_m)
+}
+
+// QueryAbilities queries the "abilities" edge of the Collection entity.
+func (_m *Collection) QueryAbilities() *LearnerAbilityQuery {
+	return NewCollectionClient(_m.config).QueryAbilities(_m)
+}
+
+// QueryParent queries the "parent" edge of the Collection entity.
+func (_m *Collection) QueryParent() *CollectionQuery {
+	return NewCollectionClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Collection entity.
+func (_m *Collection) QueryChildren() *CollectionQuery {
+	return NewCollectionClient(_m.config).QueryChildren(_m)
+}
+
 // Update returns a builder for updating this Collection.
 // Note that you need to call Collection.Unwrap() before calling this method if this Collection
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -193,11 +287,24 @@ func (_m *Collection) String() string {
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -23,14 +23,28 @@ const (
 	FieldOwnerID = "owner_id"
 	// FieldIsPublic holds the string denoting the is_public field in the database.
 	FieldIsPublic = "is_public"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
 	// EdgeCollaborators holds the string denoting the collaborators edge name in mutations.
 	EdgeCollaborators = "collaborators"
 	// EdgeFlashcards holds the string denoting the flashcards edge name in mutations.
 	EdgeFlashcards = "flashcards"
+	// EdgeQuizzes holds the string denoting the quizzes edge name in mutations.
+	EdgeQuizzes = "quizzes"
+	// EdgeAbilities holds the string denoting the abilities edge name in mutations.
+	EdgeAbilities = "abilities"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
 	// Table holds the table name of the collection in the database.
 	Table = "collections"
 	// CollaboratorsTable is the table that holds the collaborators relation/edge.
@@ -47,6 +61,28 @@ const (
 	FlashcardsInverseTable = "flashcards"
 	// FlashcardsColumn is the table column denoting the flashcards relation/edge.
 	FlashcardsColumn = "collection_id"
+	// QuizzesTable is the table that holds the quizzes relation/edge.
+	QuizzesTable = "quizs"
+	// QuizzesInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	QuizzesInverseTable = "quizs"
+	// QuizzesColumn is the table column denoting the quizzes relation/edge.
+	QuizzesColumn = "collection_id"
+	// AbilitiesTable is the table that holds the abilities relation/edge.
+	AbilitiesTable = "learner_abilities"
+	// AbilitiesInverseTable is the table name for the LearnerAbility entity.
+	// It exists in this package in order to avoid circular dependency with the "learnerability" package.
+	AbilitiesInverseTable = "learner_abilities"
+	// AbilitiesColumn is the table column denoting the abilities relation/edge.
+	AbilitiesColumn = "collection_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "collections"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "collections"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
 )
 
 // Columns holds all SQL columns for collection fields.
@@ -56,8 +92,11 @@ var Columns = []string{
 	FieldDescription,
 	FieldOwnerID,
 	FieldIsPublic,
+	FieldParentID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldDeletedBy,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -85,6 +124,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DeletedByValidator is a validator for the "deleted_by" field. It is called by the builders before save.
+	DeletedByValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -117,6 +158,11 @@ func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
 }
 
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -127,6 +173,16 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
 // ByCollaboratorsCount orders the results by collaborators count.
 func ByCollaboratorsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -154,6 +210,55 @@ func ByFlashcards(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFlashcardsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByQuizzesCount orders the results by quizzes count.
+func ByQuizzesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuizzesStep(), opts...)
+	}
+}
+
+// ByQuizzes orders the results by quizzes terms.
+func ByQuizzes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizzesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAbilitiesCount orders the results by abilities count.
+func ByAbilitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAbilitiesStep(), opts...)
+	}
+}
+
+// ByAbilities orders the results by abilities terms.
+func ByAbilities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAbilitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newCollaboratorsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -168,3 +273,31 @@ func newFlashcardsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FlashcardsTable, FlashcardsColumn),
 	)
 }
+func newQuizzesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizzesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuizzesTable, QuizzesColumn),
+	)
+}
+func newAbilitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AbilitiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AbilitiesTable, AbilitiesColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
 )
 
 // CollectionCreate is the builder for creating a Collection entity.
@@ -63,6 +65,20 @@ func (_c *CollectionCreate) SetNillableIsPublic(v *bool) *CollectionCreate {
 	return _c
 }
 
+// SetParentID sets the "parent_id" field.
+func (_c *CollectionCreate) SetParentID(v uuid.UUID) *CollectionCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *CollectionCreate) SetNillableParentID(v *uuid.UUID) *CollectionCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *CollectionCreate) SetCreatedAt(v time.Time) *CollectionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -91,6 +107,34 @@ func (_c *CollectionCreate) SetNillableUpdatedAt(v *time.Time) *CollectionCreate
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *CollectionCreate) SetDeletedAt(v time.Time) *CollectionCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *CollectionCreate) SetNillableDeletedAt(v *time.Time) *CollectionCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *CollectionCreate) SetDeletedBy(v string) *CollectionCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *CollectionCreate) SetNillableDeletedBy(v *string) *CollectionCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CollectionCreate) SetID(v uuid.UUID) *CollectionCreate {
 	_c.mutation.SetID(v)
@@ -135,6 +179,56 @@ func (_c *CollectionCreate) AddFlashcards(v ...*Flashcard) *CollectionCreate {
 	return _c.AddFlashcardIDs(ids...)
 }
 
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (_c *CollectionCreate) AddQuizIDs(ids ...uuid.UUID) *CollectionCreate {
+	_c.mutation.AddQuizIDs(ids...)
+	return _c
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (_c *CollectionCreate) AddQuizzes(v ...*Quiz) *CollectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuizIDs(ids...)
+}
+
+// AddAbilityIDs adds the "abilities" edge to the LearnerAbility entity by IDs.
+func (_c *CollectionCreate) AddAbilityIDs(ids ...uuid.UUID) *CollectionCreate {
+	_c.mutation.AddAbilityIDs(ids...)
+	return _c
+}
+
+// AddAbilities adds the "abilities" edges to the LearnerAbility entity.
+func (_c *CollectionCreate) AddAbilities(v ...*LearnerAbility) *CollectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAbilityIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Collection entity.
+func (_c *CollectionCreate) SetParent(v *Collection) *CollectionCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Collection entity by IDs.
+func (_c *CollectionCreate) AddChildIDs(ids ...uuid.UUID) *CollectionCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Collection entity.
+func (_c *CollectionCreate) AddChildren(v ...*Collection) *CollectionCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (_c *CollectionCreate) Mutation() *CollectionMutation {
 	return _c.mutation
@@ -219,6 +313,11 @@ func (_c *CollectionCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Collection.updated_at"`)}
 	}
+	if v, ok := _c.mutation.DeletedBy(); ok {
+		if err := collection.DeletedByValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`ent: validator failed for field "Collection.deleted_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -278,6 +377,14 @@ func (_c *CollectionCreate) createSpec() (*Collection, *sqlgraph.CreateSpec) {
 		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(collection.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(collection.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if nodes := _c.mutation.CollaboratorsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -310,6 +417,71 @@ func (_c *CollectionCreate) createSpec() (*Collection, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AbilitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collection.ParentTable,
+			Columns: []string{collection.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
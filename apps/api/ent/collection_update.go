@@ -15,14 +15,17 @@ import (
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
 	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
 )
 
 // CollectionUpdate is the builder for updating Collection entities.
 type CollectionUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CollectionMutation
+	hooks     []Hook
+	mutation  *CollectionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CollectionUpdate builder.
@@ -93,12 +96,72 @@ func (_u *CollectionUpdate) SetNillableIsPublic(v *bool) *CollectionUpdate {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *CollectionUpdate) SetParentID(v uuid.UUID) *CollectionUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CollectionUpdate) SetNillableParentID(v *uuid.UUID) *CollectionUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *CollectionUpdate) ClearParentID() *CollectionUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *CollectionUpdate) SetUpdatedAt(v time.Time) *CollectionUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CollectionUpdate) SetDeletedAt(v time.Time) *CollectionUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CollectionUpdate) SetNillableDeletedAt(v *time.Time) *CollectionUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CollectionUpdate) ClearDeletedAt() *CollectionUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *CollectionUpdate) SetDeletedBy(v string) *CollectionUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *CollectionUpdate) SetNillableDeletedBy(v *string) *CollectionUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *CollectionUpdate) ClearDeletedBy() *CollectionUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // AddCollaboratorIDs adds the "collaborators" edge to the CollectionCollaborator entity by IDs.
 func (_u *CollectionUpdate) AddCollaboratorIDs(ids ...uuid.UUID) *CollectionUpdate {
 	_u.mutation.AddCollaboratorIDs(ids...)
@@ -129,6 +192,56 @@ func (_u *CollectionUpdate) AddFlashcards(v ...*Flashcard) *CollectionUpdate {
 	return _u.AddFlashcardIDs(ids...)
 }
 
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (_u *CollectionUpdate) AddQuizIDs(ids ...uuid.UUID) *CollectionUpdate {
+	_u.mutation.AddQuizIDs(ids...)
+	return _u
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (_u *CollectionUpdate) AddQuizzes(v ...*Quiz) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuizIDs(ids...)
+}
+
+// AddAbilityIDs adds the "abilities" edge to the LearnerAbility entity by IDs.
+func (_u *CollectionUpdate) AddAbilityIDs(ids ...uuid.UUID) *CollectionUpdate {
+	_u.mutation.AddAbilityIDs(ids...)
+	return _u
+}
+
+// AddAbilities adds the "abilities" edges to the LearnerAbility entity.
+func (_u *CollectionUpdate) AddAbilities(v ...*LearnerAbility) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAbilityIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Collection entity.
+func (_u *CollectionUpdate) SetParent(v *Collection) *CollectionUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Collection entity by IDs.
+func (_u *CollectionUpdate) AddChildIDs(ids ...uuid.UUID) *CollectionUpdate {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Collection entity.
+func (_u *CollectionUpdate) AddChildren(v ...*Collection) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (_u *CollectionUpdate) Mutation() *CollectionMutation {
 	return _u.mutation
@@ -176,6 +289,75 @@ func (_u *CollectionUpdate) RemoveFlashcards(v ...*Flashcard) *CollectionUpdate
 	return _u.RemoveFlashcardIDs(ids...)
 }
 
+// ClearQuizzes clears all "quizzes" edges to the Quiz entity.
+func (_u *CollectionUpdate) ClearQuizzes() *CollectionUpdate {
+	_u.mutation.ClearQuizzes()
+	return _u
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to Quiz entities by IDs.
+func (_u *CollectionUpdate) RemoveQuizIDs(ids ...uuid.UUID) *CollectionUpdate {
+	_u.mutation.RemoveQuizIDs(ids...)
+	return _u
+}
+
+// RemoveQuizzes removes "quizzes" edges to Quiz entities.
+func (_u *CollectionUpdate) RemoveQuizzes(v ...*Quiz) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuizIDs(ids...)
+}
+
+// ClearAbilities clears all "abilities" edges to the LearnerAbility entity.
+func (_u *CollectionUpdate) ClearAbilities() *CollectionUpdate {
+	_u.mutation.ClearAbilities()
+	return _u
+}
+
+// RemoveAbilityIDs removes the "abilities" edge to LearnerAbility entities by IDs.
+func (_u *CollectionUpdate) RemoveAbilityIDs(ids ...uuid.UUID) *CollectionUpdate {
+	_u.mutation.RemoveAbilityIDs(ids...)
+	return _u
+}
+
+// RemoveAbilities removes "abilities" edges to LearnerAbility entities.
+func (_u *CollectionUpdate) RemoveAbilities(v ...*LearnerAbility) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAbilityIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Collection entity.
+func (_u *CollectionUpdate) ClearParent() *CollectionUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Collection entity.
+func (_u *CollectionUpdate) ClearChildren() *CollectionUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Collection entities by IDs.
+func (_u *CollectionUpdate) RemoveChildIDs(ids ...uuid.UUID) *CollectionUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Collection entities.
+func (_u *CollectionUpdate) RemoveChildren(v ...*Collection) *CollectionUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CollectionUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -224,9 +406,20 @@ func (_u *CollectionUpdate) check() error {
 			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "Collection.owner_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeletedBy(); ok {
+		if err := collection.DeletedByValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`ent: validator failed for field "Collection.deleted_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CollectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -257,6 +450,18 @@ func (_u *CollectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(collection.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(collection.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(collection.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(collection.FieldDeletedBy, field.TypeString)
+	}
 	if _u.mutation.CollaboratorsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -347,6 +552,171 @@ func (_u *CollectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuizzesIDs(); len(nodes) > 0 && !_u.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAbilitiesIDs(); len(nodes) > 0 && !_u.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AbilitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collection.ParentTable,
+			Columns: []string{collection.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collection.ParentTable,
+			Columns: []string{collection.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{collection.Label}
@@ -362,9 +732,10 @@ func (_u *CollectionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 // CollectionUpdateOne is the builder for updating a single Collection entity.
 type CollectionUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CollectionMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CollectionMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetName sets the "name" field.
@@ -429,12 +800,72 @@ func (_u *CollectionUpdateOne) SetNillableIsPublic(v *bool) *CollectionUpdateOne
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *CollectionUpdateOne) SetParentID(v uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *CollectionUpdateOne) SetNillableParentID(v *uuid.UUID) *CollectionUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *CollectionUpdateOne) ClearParentID() *CollectionUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *CollectionUpdateOne) SetUpdatedAt(v time.Time) *CollectionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *CollectionUpdateOne) SetDeletedAt(v time.Time) *CollectionUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *CollectionUpdateOne) SetNillableDeletedAt(v *time.Time) *CollectionUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *CollectionUpdateOne) ClearDeletedAt() *CollectionUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *CollectionUpdateOne) SetDeletedBy(v string) *CollectionUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *CollectionUpdateOne) SetNillableDeletedBy(v *string) *CollectionUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *CollectionUpdateOne) ClearDeletedBy() *CollectionUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // AddCollaboratorIDs adds the "collaborators" edge to the CollectionCollaborator entity by IDs.
 func (_u *CollectionUpdateOne) AddCollaboratorIDs(ids ...uuid.UUID) *CollectionUpdateOne {
 	_u.mutation.AddCollaboratorIDs(ids...)
@@ -465,6 +896,56 @@ func (_u *CollectionUpdateOne) AddFlashcards(v ...*Flashcard) *CollectionUpdateO
 	return _u.AddFlashcardIDs(ids...)
 }
 
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (_u *CollectionUpdateOne) AddQuizIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.AddQuizIDs(ids...)
+	return _u
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (_u *CollectionUpdateOne) AddQuizzes(v ...*Quiz) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuizIDs(ids...)
+}
+
+// AddAbilityIDs adds the "abilities" edge to the LearnerAbility entity by IDs.
+func (_u *CollectionUpdateOne) AddAbilityIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.AddAbilityIDs(ids...)
+	return _u
+}
+
+// AddAbilities adds the "abilities" edges to the LearnerAbility entity.
+func (_u *CollectionUpdateOne) AddAbilities(v ...*LearnerAbility) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAbilityIDs(ids...)
+}
+
+// SetParent sets the "parent" edge to the Collection entity.
+func (_u *CollectionUpdateOne) SetParent(v *Collection) *CollectionUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Collection entity by IDs.
+func (_u *CollectionUpdateOne) AddChildIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
+	return _u
+}
+
+// AddChildren adds the "children" edges to the Collection entity.
+func (_u *CollectionUpdateOne) AddChildren(v ...*Collection) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChildIDs(ids...)
+}
+
 // Mutation returns the CollectionMutation object of the builder.
 func (_u *CollectionUpdateOne) Mutation() *CollectionMutation {
 	return _u.mutation
@@ -512,6 +993,75 @@ func (_u *CollectionUpdateOne) RemoveFlashcards(v ...*Flashcard) *CollectionUpda
 	return _u.RemoveFlashcardIDs(ids...)
 }
 
+// ClearQuizzes clears all "quizzes" edges to the Quiz entity.
+func (_u *CollectionUpdateOne) ClearQuizzes() *CollectionUpdateOne {
+	_u.mutation.ClearQuizzes()
+	return _u
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to Quiz entities by IDs.
+func (_u *CollectionUpdateOne) RemoveQuizIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.RemoveQuizIDs(ids...)
+	return _u
+}
+
+// RemoveQuizzes removes "quizzes" edges to Quiz entities.
+func (_u *CollectionUpdateOne) RemoveQuizzes(v ...*Quiz) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuizIDs(ids...)
+}
+
+// ClearAbilities clears all "abilities" edges to the LearnerAbility entity.
+func (_u *CollectionUpdateOne) ClearAbilities() *CollectionUpdateOne {
+	_u.mutation.ClearAbilities()
+	return _u
+}
+
+// RemoveAbilityIDs removes the "abilities" edge to LearnerAbility entities by IDs.
+func (_u *CollectionUpdateOne) RemoveAbilityIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.RemoveAbilityIDs(ids...)
+	return _u
+}
+
+// RemoveAbilities removes "abilities" edges to LearnerAbility entities.
+func (_u *CollectionUpdateOne) RemoveAbilities(v ...*LearnerAbility) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAbilityIDs(ids...)
+}
+
+// ClearParent clears the "parent" edge to the Collection entity.
+func (_u *CollectionUpdateOne) ClearParent() *CollectionUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Collection entity.
+func (_u *CollectionUpdateOne) ClearChildren() *CollectionUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Collection entities by IDs.
+func (_u *CollectionUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *CollectionUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Collection entities.
+func (_u *CollectionUpdateOne) RemoveChildren(v ...*Collection) *CollectionUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
 // Where appends a list predicates to the CollectionUpdate builder.
 func (_u *CollectionUpdateOne) Where(ps ...predicate.Collection) *CollectionUpdateOne {
 	_u.mutation.Where(ps...)
@@ -573,9 +1123,20 @@ func (_u *CollectionUpdateOne) check() error {
 			return &ValidationError{Name: "owner_id", err: fmt.Errorf(`ent: validator failed for field "Collection.owner_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeletedBy(); ok {
+		if err := collection.DeletedByValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`ent: validator failed for field "Collection.deleted_by": %w`, err)}
+		}
+	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -623,6 +1184,18 @@ func (_u *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection,
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(collection.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(collection.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(collection.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(collection.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(collection.FieldDeletedBy, field.TypeString)
+	}
 	if _u.mutation.CollaboratorsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -713,6 +1286,171 @@ func (_u *CollectionUpdateOne) sqlSave(ctx context.Context) (_node *Collection,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuizzesIDs(); len(nodes) > 0 && !_u.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.QuizzesTable,
+			Columns: []string{collection.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAbilitiesIDs(); len(nodes) > 0 && !_u.mutation.AbilitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AbilitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.AbilitiesTable,
+			Columns: []string{collection.AbilitiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collection.ParentTable,
+			Columns: []string{collection.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   collection.ParentTable,
+			Columns: []string{collection.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   collection.ChildrenTable,
+			Columns: []string{collection.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Collection{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
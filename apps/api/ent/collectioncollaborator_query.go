@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type CollectionCollaboratorQuery struct {
 	inters         []Interceptor
 	predicates     []predicate.CollectionCollaborator
 	withCollection *CollectionQuery
+	modifiers      []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -277,8 +279,9 @@ func (_q *CollectionCollaboratorQuery) Clone() *CollectionCollaboratorQuery {
 		predicates:     append([]predicate.CollectionCollaborator{}, _q.predicates...),
 		withCollection: _q.withCollection.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -384,6 +387,9 @@ func (_q *CollectionCollaboratorQuery) sqlAll(ctx context.Context, hooks ...quer
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -434,6 +440,9 @@ func (_q *CollectionCollaboratorQuery) loadCollection(ctx context.Context, query
 
 func (_q *CollectionCollaboratorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -499,6 +508,9 @@ func (_q *CollectionCollaboratorQuery) sqlQuery(ctx context.Context) *sql.Select
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -516,6 +528,38 @@ func (_q *CollectionCollaboratorQuery) sqlQuery(ctx context.Context) *sql.Select
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *CollectionCollaboratorQuery) ForUpdate(opts ...sql.LockOption) *CollectionCollaboratorQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *CollectionCollaboratorQuery) ForShare(opts ...sql.LockOption) *CollectionCollaboratorQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *CollectionCollaboratorQuery) Modify(modifiers ...func(s *sql.Selector)) *CollectionCollaboratorSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // CollectionCollaboratorGroupBy is the group-by builder for CollectionCollaborator entities.
 type CollectionCollaboratorGroupBy struct {
 	selector
@@ -605,3 +649,9 @@ func (_s *CollectionCollaboratorSelect) sqlScan(ctx context.Context, root *Colle
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *CollectionCollaboratorSelect) Modify(modifiers ...func(s *sql.Selector)) *CollectionCollaboratorSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
@@ -19,8 +19,9 @@ import (
 // CollectionCollaboratorUpdate is the builder for updating CollectionCollaborator entities.
 type CollectionCollaboratorUpdate struct {
 	config
-	hooks    []Hook
-	mutation *CollectionCollaboratorMutation
+	hooks     []Hook
+	mutation  *CollectionCollaboratorMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the CollectionCollaboratorUpdate builder.
@@ -132,6 +133,12 @@ func (_u *CollectionCollaboratorUpdate) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionCollaboratorUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionCollaboratorUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CollectionCollaboratorUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -179,6 +186,7 @@ func (_u *CollectionCollaboratorUpdate) sqlSave(ctx context.Context) (_node int,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{collectioncollaborator.Label}
@@ -194,9 +202,10 @@ func (_u *CollectionCollaboratorUpdate) sqlSave(ctx context.Context) (_node int,
 // CollectionCollaboratorUpdateOne is the builder for updating a single CollectionCollaborator entity.
 type CollectionCollaboratorUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *CollectionCollaboratorMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *CollectionCollaboratorMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetCollectionID sets the "collection_id" field.
@@ -315,6 +324,12 @@ func (_u *CollectionCollaboratorUpdateOne) check() error {
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *CollectionCollaboratorUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *CollectionCollaboratorUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *CollectionCollaboratorUpdateOne) sqlSave(ctx context.Context) (_node *CollectionCollaborator, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -379,6 +394,7 @@ func (_u *CollectionCollaboratorUpdateOne) sqlSave(ctx context.Context) (_node *
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &CollectionCollaborator{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
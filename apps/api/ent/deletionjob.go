@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
+)
+
+// DeletionJob is the model entity for the DeletionJob schema.
+type DeletionJob struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Collection being deleted; not an edge since the collection is removed
+	CollectionID uuid.UUID `json:"collection_id,omitempty"`
+	// RequestedBy holds the value of the "requested_by" field.
+	RequestedBy string `json:"requested_by,omitempty"`
+	// Status holds the value of the "status" field.
+	Status deletionjob.Status `json:"status,omitempty"`
+	// TotalFlashcards holds the value of the "total_flashcards" field.
+	TotalFlashcards int `json:"total_flashcards,omitempty"`
+	// DeletedFlashcards holds the value of the "deleted_flashcards" field.
+	DeletedFlashcards int `json:"deleted_flashcards,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeletionJob) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deletionjob.FieldTotalFlashcards, deletionjob.FieldDeletedFlashcards:
+			values[i] = new(sql.NullInt64)
+		case deletionjob.FieldRequestedBy, deletionjob.FieldStatus, deletionjob.FieldError:
+			values[i] = new(sql.NullString)
+		case deletionjob.FieldCreatedAt, deletionjob.FieldUpdatedAt, deletionjob.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		case deletionjob.FieldID, deletionjob.FieldCollectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeletionJob fields.
+func (_m *DeletionJob) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deletionjob.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case deletionjob.FieldCollectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
+			} else if value != nil {
+				_m.CollectionID = *value
+			}
+		case deletionjob.FieldRequestedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value.Valid {
+				_m.RequestedBy = value.String
+			}
+		case deletionjob.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = deletionjob.Status(value.String)
+			}
+		case deletionjob.FieldTotalFlashcards:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_flashcards", values[i])
+			} else if value.Valid {
+				_m.TotalFlashcards = int(value.Int64)
+			}
+		case deletionjob.FieldDeletedFlashcards:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_flashcards", values[i])
+			} else if value.Valid {
+				_m.DeletedFlashcards = int(value.Int64)
+			}
+		case deletionjob.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				_m.Error = value.String
+			}
+		case deletionjob.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case deletionjob.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case deletionjob.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeletionJob.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeletionJob) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeletionJob.
+// Note that you need to call DeletionJob.Unwrap() before calling this method if this DeletionJob
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeletionJob) Update() *DeletionJobUpdateOne {
+	return NewDeletionJobClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeletionJob entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeletionJob) Unwrap() *DeletionJob {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeletionJob is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeletionJob) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeletionJob(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(_m.RequestedBy)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("total_flashcards=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalFlashcards))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_flashcards=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DeletedFlashcards))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(_m.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeletionJobs is a parsable slice of DeletionJob.
+type DeletionJobs []*DeletionJob
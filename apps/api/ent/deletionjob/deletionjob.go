@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deletionjob
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the deletionjob type in the database.
+	Label = "deletion_job"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCollectionID holds the string denoting the collection_id field in the database.
+	FieldCollectionID = "collection_id"
+	// FieldRequestedBy holds the string denoting the requested_by field in the database.
+	FieldRequestedBy = "requested_by"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldTotalFlashcards holds the string denoting the total_flashcards field in the database.
+	FieldTotalFlashcards = "total_flashcards"
+	// FieldDeletedFlashcards holds the string denoting the deleted_flashcards field in the database.
+	FieldDeletedFlashcards = "deleted_flashcards"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// Table holds the table name of the deletionjob in the database.
+	Table = "deletion_jobs"
+)
+
+// Columns holds all SQL columns for deletionjob fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectionID,
+	FieldRequestedBy,
+	FieldStatus,
+	FieldTotalFlashcards,
+	FieldDeletedFlashcards,
+	FieldError,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldCompletedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	RequestedByValidator func(string) error
+	// DefaultTotalFlashcards holds the default value on creation for the "total_flashcards" field.
+	DefaultTotalFlashcards int
+	// TotalFlashcardsValidator is a validator for the "total_flashcards" field. It is called by the builders before save.
+	TotalFlashcardsValidator func(int) error
+	// DefaultDeletedFlashcards holds the default value on creation for the "deleted_flashcards" field.
+	DefaultDeletedFlashcards int
+	// DeletedFlashcardsValidator is a validator for the "deleted_flashcards" field. It is called by the builders before save.
+	DeletedFlashcardsValidator func(int) error
+	// DefaultError holds the default value on creation for the "error" field.
+	DefaultError string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("deletionjob: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the DeletionJob queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectionID orders the results by the collection_id field.
+func ByCollectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectionID, opts...).ToFunc()
+}
+
+// ByRequestedBy orders the results by the requested_by field.
+func ByRequestedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestedBy, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByTotalFlashcards orders the results by the total_flashcards field.
+func ByTotalFlashcards(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalFlashcards, opts...).ToFunc()
+}
+
+// ByDeletedFlashcards orders the results by the deleted_flashcards field.
+func ByDeletedFlashcards(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedFlashcards, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
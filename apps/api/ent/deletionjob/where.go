@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deletionjob
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldID, id))
+}
+
+// CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
+func CollectionID(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// RequestedBy applies equality check predicate on the "requested_by" field. It's identical to RequestedByEQ.
+func RequestedBy(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// TotalFlashcards applies equality check predicate on the "total_flashcards" field. It's identical to TotalFlashcardsEQ.
+func TotalFlashcards(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldTotalFlashcards, v))
+}
+
+// DeletedFlashcards applies equality check predicate on the "deleted_flashcards" field. It's identical to DeletedFlashcardsEQ.
+func DeletedFlashcards(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldDeletedFlashcards, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDGT applies the GT predicate on the "collection_id" field.
+func CollectionIDGT(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldCollectionID, v))
+}
+
+// CollectionIDGTE applies the GTE predicate on the "collection_id" field.
+func CollectionIDGTE(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldCollectionID, v))
+}
+
+// CollectionIDLT applies the LT predicate on the "collection_id" field.
+func CollectionIDLT(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldCollectionID, v))
+}
+
+// CollectionIDLTE applies the LTE predicate on the "collection_id" field.
+func CollectionIDLTE(v uuid.UUID) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldCollectionID, v))
+}
+
+// RequestedByEQ applies the EQ predicate on the "requested_by" field.
+func RequestedByEQ(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldRequestedBy, v))
+}
+
+// RequestedByNEQ applies the NEQ predicate on the "requested_by" field.
+func RequestedByNEQ(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldRequestedBy, v))
+}
+
+// RequestedByIn applies the In predicate on the "requested_by" field.
+func RequestedByIn(vs ...string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByNotIn applies the NotIn predicate on the "requested_by" field.
+func RequestedByNotIn(vs ...string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldRequestedBy, vs...))
+}
+
+// RequestedByGT applies the GT predicate on the "requested_by" field.
+func RequestedByGT(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldRequestedBy, v))
+}
+
+// RequestedByGTE applies the GTE predicate on the "requested_by" field.
+func RequestedByGTE(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldRequestedBy, v))
+}
+
+// RequestedByLT applies the LT predicate on the "requested_by" field.
+func RequestedByLT(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldRequestedBy, v))
+}
+
+// RequestedByLTE applies the LTE predicate on the "requested_by" field.
+func RequestedByLTE(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldRequestedBy, v))
+}
+
+// RequestedByContains applies the Contains predicate on the "requested_by" field.
+func RequestedByContains(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldContains(FieldRequestedBy, v))
+}
+
+// RequestedByHasPrefix applies the HasPrefix predicate on the "requested_by" field.
+func RequestedByHasPrefix(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldHasPrefix(FieldRequestedBy, v))
+}
+
+// RequestedByHasSuffix applies the HasSuffix predicate on the "requested_by" field.
+func RequestedByHasSuffix(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldHasSuffix(FieldRequestedBy, v))
+}
+
+// RequestedByEqualFold applies the EqualFold predicate on the "requested_by" field.
+func RequestedByEqualFold(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEqualFold(FieldRequestedBy, v))
+}
+
+// RequestedByContainsFold applies the ContainsFold predicate on the "requested_by" field.
+func RequestedByContainsFold(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldContainsFold(FieldRequestedBy, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TotalFlashcardsEQ applies the EQ predicate on the "total_flashcards" field.
+func TotalFlashcardsEQ(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldTotalFlashcards, v))
+}
+
+// TotalFlashcardsNEQ applies the NEQ predicate on the "total_flashcards" field.
+func TotalFlashcardsNEQ(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldTotalFlashcards, v))
+}
+
+// TotalFlashcardsIn applies the In predicate on the "total_flashcards" field.
+func TotalFlashcardsIn(vs ...int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldTotalFlashcards, vs...))
+}
+
+// TotalFlashcardsNotIn applies the NotIn predicate on the "total_flashcards" field.
+func TotalFlashcardsNotIn(vs ...int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldTotalFlashcards, vs...))
+}
+
+// TotalFlashcardsGT applies the GT predicate on the "total_flashcards" field.
+func TotalFlashcardsGT(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldTotalFlashcards, v))
+}
+
+// TotalFlashcardsGTE applies the GTE predicate on the "total_flashcards" field.
+func TotalFlashcardsGTE(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldTotalFlashcards, v))
+}
+
+// TotalFlashcardsLT applies the LT predicate on the "total_flashcards" field.
+func TotalFlashcardsLT(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldTotalFlashcards, v))
+}
+
+// TotalFlashcardsLTE applies the LTE predicate on the "total_flashcards" field.
+func TotalFlashcardsLTE(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldTotalFlashcards, v))
+}
+
+// DeletedFlashcardsEQ applies the EQ predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsEQ(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldDeletedFlashcards, v))
+}
+
+// DeletedFlashcardsNEQ applies the NEQ predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsNEQ(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldDeletedFlashcards, v))
+}
+
+// DeletedFlashcardsIn applies the In predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsIn(vs ...int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldDeletedFlashcards, vs...))
+}
+
+// DeletedFlashcardsNotIn applies the NotIn predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsNotIn(vs ...int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldDeletedFlashcards, vs...))
+}
+
+// DeletedFlashcardsGT applies the GT predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsGT(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldDeletedFlashcards, v))
+}
+
+// DeletedFlashcardsGTE applies the GTE predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsGTE(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldDeletedFlashcards, v))
+}
+
+// DeletedFlashcardsLT applies the LT predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsLT(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldDeletedFlashcards, v))
+}
+
+// DeletedFlashcardsLTE applies the LTE predicate on the "deleted_flashcards" field.
+func DeletedFlashcardsLTE(v int) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldDeletedFlashcards, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.DeletionJob {
+	return predicate.DeletionJob(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeletionJob) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeletionJob) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeletionJob) predicate.DeletionJob {
+	return predicate.DeletionJob(sql.NotPredicates(p))
+}
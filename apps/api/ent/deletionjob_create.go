@@ -0,0 +1,412 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
+)
+
+// DeletionJobCreate is the builder for creating a DeletionJob entity.
+type DeletionJobCreate struct {
+	config
+	mutation *DeletionJobMutation
+	hooks    []Hook
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_c *DeletionJobCreate) SetCollectionID(v uuid.UUID) *DeletionJobCreate {
+	_c.mutation.SetCollectionID(v)
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *DeletionJobCreate) SetRequestedBy(v string) *DeletionJobCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *DeletionJobCreate) SetStatus(v deletionjob.Status) *DeletionJobCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableStatus(v *deletionjob.Status) *DeletionJobCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTotalFlashcards sets the "total_flashcards" field.
+func (_c *DeletionJobCreate) SetTotalFlashcards(v int) *DeletionJobCreate {
+	_c.mutation.SetTotalFlashcards(v)
+	return _c
+}
+
+// SetNillableTotalFlashcards sets the "total_flashcards" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableTotalFlashcards(v *int) *DeletionJobCreate {
+	if v != nil {
+		_c.SetTotalFlashcards(*v)
+	}
+	return _c
+}
+
+// SetDeletedFlashcards sets the "deleted_flashcards" field.
+func (_c *DeletionJobCreate) SetDeletedFlashcards(v int) *DeletionJobCreate {
+	_c.mutation.SetDeletedFlashcards(v)
+	return _c
+}
+
+// SetNillableDeletedFlashcards sets the "deleted_flashcards" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableDeletedFlashcards(v *int) *DeletionJobCreate {
+	if v != nil {
+		_c.SetDeletedFlashcards(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *DeletionJobCreate) SetError(v string) *DeletionJobCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableError(v *string) *DeletionJobCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DeletionJobCreate) SetCreatedAt(v time.Time) *DeletionJobCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableCreatedAt(v *time.Time) *DeletionJobCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *DeletionJobCreate) SetUpdatedAt(v time.Time) *DeletionJobCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableUpdatedAt(v *time.Time) *DeletionJobCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *DeletionJobCreate) SetCompletedAt(v time.Time) *DeletionJobCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableCompletedAt(v *time.Time) *DeletionJobCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeletionJobCreate) SetID(v uuid.UUID) *DeletionJobCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeletionJobCreate) SetNillableID(v *uuid.UUID) *DeletionJobCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeletionJobMutation object of the builder.
+func (_c *DeletionJobCreate) Mutation() *DeletionJobMutation {
+	return _c.mutation
+}
+
+// Save creates the DeletionJob in the database.
+func (_c *DeletionJobCreate) Save(ctx context.Context) (*DeletionJob, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeletionJobCreate) SaveX(ctx context.Context) *DeletionJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeletionJobCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeletionJobCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeletionJobCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := deletionjob.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.TotalFlashcards(); !ok {
+		v := deletionjob.DefaultTotalFlashcards
+		_c.mutation.SetTotalFlashcards(v)
+	}
+	if _, ok := _c.mutation.DeletedFlashcards(); !ok {
+		v := deletionjob.DefaultDeletedFlashcards
+		_c.mutation.SetDeletedFlashcards(v)
+	}
+	if _, ok := _c.mutation.Error(); !ok {
+		v := deletionjob.DefaultError
+		_c.mutation.SetError(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := deletionjob.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := deletionjob.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := deletionjob.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeletionJobCreate) check() error {
+	if _, ok := _c.mutation.CollectionID(); !ok {
+		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "DeletionJob.collection_id"`)}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required field "DeletionJob.requested_by"`)}
+	}
+	if v, ok := _c.mutation.RequestedBy(); ok {
+		if err := deletionjob.RequestedByValidator(v); err != nil {
+			return &ValidationError{Name: "requested_by", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.requested_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DeletionJob.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := deletionjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalFlashcards(); !ok {
+		return &ValidationError{Name: "total_flashcards", err: errors.New(`ent: missing required field "DeletionJob.total_flashcards"`)}
+	}
+	if v, ok := _c.mutation.TotalFlashcards(); ok {
+		if err := deletionjob.TotalFlashcardsValidator(v); err != nil {
+			return &ValidationError{Name: "total_flashcards", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.total_flashcards": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeletedFlashcards(); !ok {
+		return &ValidationError{Name: "deleted_flashcards", err: errors.New(`ent: missing required field "DeletionJob.deleted_flashcards"`)}
+	}
+	if v, ok := _c.mutation.DeletedFlashcards(); ok {
+		if err := deletionjob.DeletedFlashcardsValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_flashcards", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.deleted_flashcards": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeletionJob.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DeletionJob.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *DeletionJobCreate) sqlSave(ctx context.Context) (*DeletionJob, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeletionJobCreate) createSpec() (*DeletionJob, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeletionJob{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deletionjob.Table, sqlgraph.NewFieldSpec(deletionjob.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CollectionID(); ok {
+		_spec.SetField(deletionjob.FieldCollectionID, field.TypeUUID, value)
+		_node.CollectionID = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(deletionjob.FieldRequestedBy, field.TypeString, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(deletionjob.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.TotalFlashcards(); ok {
+		_spec.SetField(deletionjob.FieldTotalFlashcards, field.TypeInt, value)
+		_node.TotalFlashcards = value
+	}
+	if value, ok := _c.mutation.DeletedFlashcards(); ok {
+		_spec.SetField(deletionjob.FieldDeletedFlashcards, field.TypeInt, value)
+		_node.DeletedFlashcards = value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(deletionjob.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(deletionjob.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(deletionjob.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(deletionjob.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	return _node, _spec
+}
+
+// DeletionJobCreateBulk is the builder for creating many DeletionJob entities in bulk.
+type DeletionJobCreateBulk struct {
+	config
+	err      error
+	builders []*DeletionJobCreate
+}
+
+// Save creates the DeletionJob entities in the database.
+func (_c *DeletionJobCreateBulk) Save(ctx context.Context) ([]*DeletionJob, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeletionJob, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeletionJobMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeletionJobCreateBulk) SaveX(ctx context.Context) []*DeletionJob {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeletionJobCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeletionJobCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
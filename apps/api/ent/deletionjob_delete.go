@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// DeletionJobDelete is the builder for deleting a DeletionJob entity.
+type DeletionJobDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeletionJobMutation
+}
+
+// Where appends a list predicates to the DeletionJobDelete builder.
+func (_d *DeletionJobDelete) Where(ps ...predicate.DeletionJob) *DeletionJobDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DeletionJobDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeletionJobDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DeletionJobDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deletionjob.Table, sqlgraph.NewFieldSpec(deletionjob.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DeletionJobDeleteOne is the builder for deleting a single DeletionJob entity.
+type DeletionJobDeleteOne struct {
+	_d *DeletionJobDelete
+}
+
+// Where appends a list predicates to the DeletionJobDelete builder.
+func (_d *DeletionJobDeleteOne) Where(ps ...predicate.DeletionJob) *DeletionJobDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DeletionJobDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deletionjob.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeletionJobDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
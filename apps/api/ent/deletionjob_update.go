@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// DeletionJobUpdate is the builder for updating DeletionJob entities.
+type DeletionJobUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeletionJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeletionJobUpdate builder.
+func (_u *DeletionJobUpdate) Where(ps ...predicate.DeletionJob) *DeletionJobUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *DeletionJobUpdate) SetStatus(v deletionjob.Status) *DeletionJobUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DeletionJobUpdate) SetNillableStatus(v *deletionjob.Status) *DeletionJobUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalFlashcards sets the "total_flashcards" field.
+func (_u *DeletionJobUpdate) SetTotalFlashcards(v int) *DeletionJobUpdate {
+	_u.mutation.ResetTotalFlashcards()
+	_u.mutation.SetTotalFlashcards(v)
+	return _u
+}
+
+// SetNillableTotalFlashcards sets the "total_flashcards" field if the given value is not nil.
+func (_u *DeletionJobUpdate) SetNillableTotalFlashcards(v *int) *DeletionJobUpdate {
+	if v != nil {
+		_u.SetTotalFlashcards(*v)
+	}
+	return _u
+}
+
+// AddTotalFlashcards adds value to the "total_flashcards" field.
+func (_u *DeletionJobUpdate) AddTotalFlashcards(v int) *DeletionJobUpdate {
+	_u.mutation.AddTotalFlashcards(v)
+	return _u
+}
+
+// SetDeletedFlashcards sets the "deleted_flashcards" field.
+func (_u *DeletionJobUpdate) SetDeletedFlashcards(v int) *DeletionJobUpdate {
+	_u.mutation.ResetDeletedFlashcards()
+	_u.mutation.SetDeletedFlashcards(v)
+	return _u
+}
+
+// SetNillableDeletedFlashcards sets the "deleted_flashcards" field if the given value is not nil.
+func (_u *DeletionJobUpdate) SetNillableDeletedFlashcards(v *int) *DeletionJobUpdate {
+	if v != nil {
+		_u.SetDeletedFlashcards(*v)
+	}
+	return _u
+}
+
+// AddDeletedFlashcards adds value to the "deleted_flashcards" field.
+func (_u *DeletionJobUpdate) AddDeletedFlashcards(v int) *DeletionJobUpdate {
+	_u.mutation.AddDeletedFlashcards(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DeletionJobUpdate) SetError(v string) *DeletionJobUpdate {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DeletionJobUpdate) SetNillableError(v *string) *DeletionJobUpdate {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *DeletionJobUpdate) ClearError() *DeletionJobUpdate {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeletionJobUpdate) SetUpdatedAt(v time.Time) *DeletionJobUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DeletionJobUpdate) SetCompletedAt(v time.Time) *DeletionJobUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DeletionJobUpdate) SetNillableCompletedAt(v *time.Time) *DeletionJobUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DeletionJobUpdate) ClearCompletedAt() *DeletionJobUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the DeletionJobMutation object of the builder.
+func (_u *DeletionJobUpdate) Mutation() *DeletionJobMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeletionJobUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeletionJobUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeletionJobUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeletionJobUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeletionJobUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deletionjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeletionJobUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := deletionjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalFlashcards(); ok {
+		if err := deletionjob.TotalFlashcardsValidator(v); err != nil {
+			return &ValidationError{Name: "total_flashcards", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.total_flashcards": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedFlashcards(); ok {
+		if err := deletionjob.DeletedFlashcardsValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_flashcards", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.deleted_flashcards": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DeletionJobUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeletionJobUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DeletionJobUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deletionjob.Table, deletionjob.Columns, sqlgraph.NewFieldSpec(deletionjob.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(deletionjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TotalFlashcards(); ok {
+		_spec.SetField(deletionjob.FieldTotalFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFlashcards(); ok {
+		_spec.AddField(deletionjob.FieldTotalFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeletedFlashcards(); ok {
+		_spec.SetField(deletionjob.FieldDeletedFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedFlashcards(); ok {
+		_spec.AddField(deletionjob.FieldDeletedFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(deletionjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(deletionjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deletionjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(deletionjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(deletionjob.FieldCompletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deletionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeletionJobUpdateOne is the builder for updating a single DeletionJob entity.
+type DeletionJobUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeletionJobMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetStatus sets the "status" field.
+func (_u *DeletionJobUpdateOne) SetStatus(v deletionjob.Status) *DeletionJobUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *DeletionJobUpdateOne) SetNillableStatus(v *deletionjob.Status) *DeletionJobUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetTotalFlashcards sets the "total_flashcards" field.
+func (_u *DeletionJobUpdateOne) SetTotalFlashcards(v int) *DeletionJobUpdateOne {
+	_u.mutation.ResetTotalFlashcards()
+	_u.mutation.SetTotalFlashcards(v)
+	return _u
+}
+
+// SetNillableTotalFlashcards sets the "total_flashcards" field if the given value is not nil.
+func (_u *DeletionJobUpdateOne) SetNillableTotalFlashcards(v *int) *DeletionJobUpdateOne {
+	if v != nil {
+		_u.SetTotalFlashcards(*v)
+	}
+	return _u
+}
+
+// AddTotalFlashcards adds value to the "total_flashcards" field.
+func (_u *DeletionJobUpdateOne) AddTotalFlashcards(v int) *DeletionJobUpdateOne {
+	_u.mutation.AddTotalFlashcards(v)
+	return _u
+}
+
+// SetDeletedFlashcards sets the "deleted_flashcards" field.
+func (_u *DeletionJobUpdateOne) SetDeletedFlashcards(v int) *DeletionJobUpdateOne {
+	_u.mutation.ResetDeletedFlashcards()
+	_u.mutation.SetDeletedFlashcards(v)
+	return _u
+}
+
+// SetNillableDeletedFlashcards sets the "deleted_flashcards" field if the given value is not nil.
+func (_u *DeletionJobUpdateOne) SetNillableDeletedFlashcards(v *int) *DeletionJobUpdateOne {
+	if v != nil {
+		_u.SetDeletedFlashcards(*v)
+	}
+	return _u
+}
+
+// AddDeletedFlashcards adds value to the "deleted_flashcards" field.
+func (_u *DeletionJobUpdateOne) AddDeletedFlashcards(v int) *DeletionJobUpdateOne {
+	_u.mutation.AddDeletedFlashcards(v)
+	return _u
+}
+
+// SetError sets the "error" field.
+func (_u *DeletionJobUpdateOne) SetError(v string) *DeletionJobUpdateOne {
+	_u.mutation.SetError(v)
+	return _u
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_u *DeletionJobUpdateOne) SetNillableError(v *string) *DeletionJobUpdateOne {
+	if v != nil {
+		_u.SetError(*v)
+	}
+	return _u
+}
+
+// ClearError clears the value of the "error" field.
+func (_u *DeletionJobUpdateOne) ClearError() *DeletionJobUpdateOne {
+	_u.mutation.ClearError()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *DeletionJobUpdateOne) SetUpdatedAt(v time.Time) *DeletionJobUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *DeletionJobUpdateOne) SetCompletedAt(v time.Time) *DeletionJobUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *DeletionJobUpdateOne) SetNillableCompletedAt(v *time.Time) *DeletionJobUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *DeletionJobUpdateOne) ClearCompletedAt() *DeletionJobUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// Mutation returns the DeletionJobMutation object of the builder.
+func (_u *DeletionJobUpdateOne) Mutation() *DeletionJobMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeletionJobUpdate builder.
+func (_u *DeletionJobUpdateOne) Where(ps ...predicate.DeletionJob) *DeletionJobUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeletionJobUpdateOne) Select(field string, fields ...string) *DeletionJobUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeletionJob entity.
+func (_u *DeletionJobUpdateOne) Save(ctx context.Context) (*DeletionJob, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeletionJobUpdateOne) SaveX(ctx context.Context) *DeletionJob {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeletionJobUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeletionJobUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DeletionJobUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := deletionjob.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DeletionJobUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := deletionjob.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalFlashcards(); ok {
+		if err := deletionjob.TotalFlashcardsValidator(v); err != nil {
+			return &ValidationError{Name: "total_flashcards", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.total_flashcards": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeletedFlashcards(); ok {
+		if err := deletionjob.DeletedFlashcardsValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_flashcards", err: fmt.Errorf(`ent: validator failed for field "DeletionJob.deleted_flashcards": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *DeletionJobUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeletionJobUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *DeletionJobUpdateOne) sqlSave(ctx context.Context) (_node *DeletionJob, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deletionjob.Table, deletionjob.Columns, sqlgraph.NewFieldSpec(deletionjob.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeletionJob.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deletionjob.FieldID)
+		for _, f := range fields {
+			if !deletionjob.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deletionjob.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(deletionjob.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TotalFlashcards(); ok {
+		_spec.SetField(deletionjob.FieldTotalFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalFlashcards(); ok {
+		_spec.AddField(deletionjob.FieldTotalFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DeletedFlashcards(); ok {
+		_spec.SetField(deletionjob.FieldDeletedFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedFlashcards(); ok {
+		_spec.AddField(deletionjob.FieldDeletedFlashcards, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Error(); ok {
+		_spec.SetField(deletionjob.FieldError, field.TypeString, value)
+	}
+	if _u.mutation.ErrorCleared() {
+		_spec.ClearField(deletionjob.FieldError, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(deletionjob.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(deletionjob.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(deletionjob.FieldCompletedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &DeletionJob{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deletionjob.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,8 +14,21 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,8 +91,21 @@ func checkColumn(t, c string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			collection.Table:             collection.ValidColumn,
 			collectioncollaborator.Table: collectioncollaborator.ValidColumn,
+			deletionjob.Table:            deletionjob.ValidColumn,
 			flashcard.Table:              flashcard.ValidColumn,
+			flashcardannotation.Table:    flashcardannotation.ValidColumn,
+			flashcardrating.Table:        flashcardrating.ValidColumn,
 			flashcardreview.Table:        flashcardreview.ValidColumn,
+			flashcardrevision.Table:      flashcardrevision.ValidColumn,
+			flashcardtag.Table:           flashcardtag.ValidColumn,
+			itemstatistic.Table:          itemstatistic.ValidColumn,
+			learnerability.Table:         learnerability.ValidColumn,
+			media.Table:                  media.ValidColumn,
+			mediaupload.Table:            mediaupload.ValidColumn,
+			quiz.Table:                   quiz.ValidColumn,
+			quizanswer.Table:             quizanswer.ValidColumn,
+			quizattempt.Table:            quizattempt.ValidColumn,
+			quizreviewupdate.Table:       quizreviewupdate.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
 // Flashcard is the model entity for the Flashcard schema.
@@ -25,14 +28,52 @@ type Flashcard struct {
 	Answer string `json:"answer,omitempty"`
 	// Type holds the value of the "type" field.
 	Type string `json:"type,omitempty"`
+	// Choices for multiple_choice cards, items in correct order for ordering cards
+	Options []string `json:"options,omitempty"`
+	// Left/right pairs in correct correspondence for matching cards
+	Pairs []schema.MatchPair `json:"pairs,omitempty"`
+	// Image and masks for image_occlusion cards, one review item per mask
+	Occlusion *schema.ImageOcclusion `json:"occlusion,omitempty"`
+	// Format of question and answer: plain, markdown or html
+	ContentFormat string `json:"content_format,omitempty"`
+	// Sanitized HTML rendering of the question
+	QuestionHTML string `json:"question_html,omitempty"`
+	// Sanitized HTML rendering of the answer
+	AnswerHTML string `json:"answer_html,omitempty"`
+	// Optional hint the learner can reveal before answering
+	Hint string `json:"hint,omitempty"`
+	// Sanitized HTML rendering of the hint
+	HintHTML string `json:"hint_html,omitempty"`
+	// Longer explanation shown after answering
+	Explanation string `json:"explanation,omitempty"`
+	// Sanitized HTML rendering of the explanation
+	ExplanationHTML string `json:"explanation_html,omitempty"`
+	// Citations of the material the flashcard is based on
+	Sources []schema.SourceReference `json:"sources,omitempty"`
+	// Normalized plain text of the question for duplicate detection
+	QuestionKey string `json:"question_key,omitempty"`
+	// Plain text of the question for full-text search, nil until computed
+	QuestionText *string `json:"-"`
+	// Plain text of the answer for full-text search, nil until computed
+	AnswerText *string `json:"-"`
+	// Full-text search document of the plain text, maintained by a database trigger
+	SearchVector string `json:"-"`
 	// CollectionID holds the value of the "collection_id" field.
 	CollectionID uuid.UUID `json:"collection_id,omitempty"`
+	// Drafts are only visible to owners and editors and are never reviewed
+	Status flashcard.Status `json:"status,omitempty"`
+	// Sort key within the collection; cards are ordered by position, then ID
+	Position float64 `json:"position,omitempty"`
 	// CreatedBy holds the value of the "created_by" field.
 	CreatedBy string `json:"created_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Set while the flashcard is in the trash
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// DeletedBy holds the value of the "deleted_by" field.
+	DeletedBy string `json:"deleted_by,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FlashcardQuery when eager-loading is set.
 	Edges        FlashcardEdges `json:"edges"`
@@ -45,9 +86,23 @@ type FlashcardEdges struct {
 	Collection *Collection `json:"collection,omitempty"`
 	// Reviews for this flashcard across different users
 	Reviews []*FlashcardReview `json:"reviews,omitempty"`
+	// Image and audio attachments
+	Media []*Media `json:"media,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*FlashcardTag `json:"tags,omitempty"`
+	// Edit history
+	Revisions []*FlashcardRevision `json:"revisions,omitempty"`
+	// Private notes, flags and bookmarks of users
+	Annotations []*FlashcardAnnotation `json:"annotations,omitempty"`
+	// QuizAnswers holds the value of the quiz_answers edge.
+	QuizAnswers []*QuizAnswer `json:"quiz_answers,omitempty"`
+	// ItemStatistics holds the value of the item_statistics edge.
+	ItemStatistics []*ItemStatistic `json:"item_statistics,omitempty"`
+	// Rating holds the value of the rating edge.
+	Rating *FlashcardRating `json:"rating,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // CollectionOrErr returns the Collection value or an error if the edge
@@ -70,14 +125,83 @@ func (e FlashcardEdges) ReviewsOrErr() ([]*FlashcardReview, error) {
 	return nil, &NotLoadedError{edge: "reviews"}
 }
 
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardEdges) MediaOrErr() ([]*Media, error) {
+	if e.loadedTypes[2] {
+		return e.Media, nil
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardEdges) TagsOrErr() ([]*FlashcardTag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardEdges) RevisionsOrErr() ([]*FlashcardRevision, error) {
+	if e.loadedTypes[4] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// AnnotationsOrErr returns the Annotations value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardEdges) AnnotationsOrErr() ([]*FlashcardAnnotation, error) {
+	if e.loadedTypes[5] {
+		return e.Annotations, nil
+	}
+	return nil, &NotLoadedError{edge: "annotations"}
+}
+
+// QuizAnswersOrErr returns the QuizAnswers value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardEdges) QuizAnswersOrErr() ([]*QuizAnswer, error) {
+	if e.loadedTypes[6] {
+		return e.QuizAnswers, nil
+	}
+	return nil, &NotLoadedError{edge: "quiz_answers"}
+}
+
+// ItemStatisticsOrErr returns the ItemStatistics value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardEdges) ItemStatisticsOrErr() ([]*ItemStatistic, error) {
+	if e.loadedTypes[7] {
+		return e.ItemStatistics, nil
+	}
+	return nil, &NotLoadedError{edge: "item_statistics"}
+}
+
+// RatingOrErr returns the Rating value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FlashcardEdges) RatingOrErr() (*FlashcardRating, error) {
+	if e.Rating != nil {
+		return e.Rating, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: flashcardrating.Label}
+	}
+	return nil, &NotLoadedError{edge: "rating"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Flashcard) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case flashcard.FieldQuestion, flashcard.FieldAnswer, flashcard.FieldType, flashcard.FieldCreatedBy:
+		case flashcard.FieldOptions, flashcard.FieldPairs, flashcard.FieldOcclusion, flashcard.FieldSources:
+			values[i] = new([]byte)
+		case flashcard.FieldPosition:
+			values[i] = new(sql.NullFloat64)
+		case flashcard.FieldQuestion, flashcard.FieldAnswer, flashcard.FieldType, flashcard.FieldContentFormat, flashcard.FieldQuestionHTML, flashcard.FieldAnswerHTML, flashcard.FieldHint, flashcard.FieldHintHTML, flashcard.FieldExplanation, flashcard.FieldExplanationHTML, flashcard.FieldQuestionKey, flashcard.FieldQuestionText, flashcard.FieldAnswerText, flashcard.FieldSearchVector, flashcard.FieldStatus, flashcard.FieldCreatedBy, flashcard.FieldDeletedBy:
 			values[i] = new(sql.NullString)
-		case flashcard.FieldCreatedAt, flashcard.FieldUpdatedAt:
+		case flashcard.FieldCreatedAt, flashcard.FieldUpdatedAt, flashcard.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case flashcard.FieldID, flashcard.FieldCollectionID:
 			values[i] = new(uuid.UUID)
@@ -120,12 +244,124 @@ func (_m *Flashcard) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Type = value.String
 			}
+		case flashcard.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case flashcard.FieldPairs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pairs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Pairs); err != nil {
+					return fmt.Errorf("unmarshal field pairs: %w", err)
+				}
+			}
+		case flashcard.FieldOcclusion:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field occlusion", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Occlusion); err != nil {
+					return fmt.Errorf("unmarshal field occlusion: %w", err)
+				}
+			}
+		case flashcard.FieldContentFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_format", values[i])
+			} else if value.Valid {
+				_m.ContentFormat = value.String
+			}
+		case flashcard.FieldQuestionHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_html", values[i])
+			} else if value.Valid {
+				_m.QuestionHTML = value.String
+			}
+		case flashcard.FieldAnswerHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_html", values[i])
+			} else if value.Valid {
+				_m.AnswerHTML = value.String
+			}
+		case flashcard.FieldHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hint", values[i])
+			} else if value.Valid {
+				_m.Hint = value.String
+			}
+		case flashcard.FieldHintHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hint_html", values[i])
+			} else if value.Valid {
+				_m.HintHTML = value.String
+			}
+		case flashcard.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				_m.Explanation = value.String
+			}
+		case flashcard.FieldExplanationHTML:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation_html", values[i])
+			} else if value.Valid {
+				_m.ExplanationHTML = value.String
+			}
+		case flashcard.FieldSources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Sources); err != nil {
+					return fmt.Errorf("unmarshal field sources: %w", err)
+				}
+			}
+		case flashcard.FieldQuestionKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_key", values[i])
+			} else if value.Valid {
+				_m.QuestionKey = value.String
+			}
+		case flashcard.FieldQuestionText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_text", values[i])
+			} else if value.Valid {
+				_m.QuestionText = new(string)
+				*_m.QuestionText = value.String
+			}
+		case flashcard.FieldAnswerText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_text", values[i])
+			} else if value.Valid {
+				_m.AnswerText = new(string)
+				*_m.AnswerText = value.String
+			}
+		case flashcard.FieldSearchVector:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field search_vector", values[i])
+			} else if value.Valid {
+				_m.SearchVector = value.String
+			}
 		case flashcard.FieldCollectionID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
 			} else if value != nil {
 				_m.CollectionID = *value
 			}
+		case flashcard.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = flashcard.Status(value.String)
+			}
+		case flashcard.FieldPosition:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = value.Float64
+			}
 		case flashcard.FieldCreatedBy:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -144,6 +380,19 @@ func (_m *Flashcard) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case flashcard.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case flashcard.FieldDeletedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_by", values[i])
+			} else if value.Valid {
+				_m.DeletedBy = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -167,6 +416,41 @@ func (_m *Flashcard) QueryReviews() *FlashcardReviewQuery {
 	return NewFlashcardClient(_m.config).QueryReviews(_m)
 }
 
+// QueryMedia queries the "media" edge of the Flashcard entity.
+func (_m *Flashcard) QueryMedia() *MediaQuery {
+	return NewFlashcardClient(_m.config).QueryMedia(_m)
+}
+
+// QueryTags queries the "tags" edge of the Flashcard entity.
+func (_m *Flashcard) QueryTags() *FlashcardTagQuery {
+	return NewFlashcardClient(_m.config).QueryTags(_m)
+}
+
+// QueryRevisions queries the "revisions" edge of the Flashcard entity.
+func (_m *Flashcard) QueryRevisions() *FlashcardRevisionQuery {
+	return NewFlashcardClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryAnnotations queries the "annotations" edge of the Flashcard entity.
+func (_m *Flashcard) QueryAnnotations() *FlashcardAnnotationQuery {
+	return NewFlashcardClient(_m.config).QueryAnnotations(_m)
+}
+
+// QueryQuizAnswers queries the "quiz_answers" edge of the Flashcard entity.
+func (_m *Flashcard) QueryQuizAnswers() *QuizAnswerQuery {
+	return NewFlashcardClient(_m.config).QueryQuizAnswers(_m)
+}
+
+// QueryItemStatistics queries the "item_statistics" edge of the Flashcard entity.
+func (_m *Flashcard) QueryItemStatistics() *ItemStatisticQuery {
+	return NewFlashcardClient(_m.config).QueryItemStatistics(_m)
+}
+
+// QueryRating queries the "rating" edge of the Flashcard entity.
+func (_m *Flashcard) QueryRating() *FlashcardRatingQuery {
+	return NewFlashcardClient(_m.config).QueryRating(_m)
+}
+
 // Update returns a builder for updating this Flashcard.
 // Note that you need to call Flashcard.Unwrap() before calling this method if this Flashcard
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -199,9 +483,64 @@ func (_m *Flashcard) String() string {
 	builder.WriteString("type=")
 	builder.WriteString(_m.Type)
 	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Options))
+	builder.WriteString(", ")
+	builder.WriteString("pairs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pairs))
+	builder.WriteString(", ")
+	builder.WriteString("occlusion=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Occlusion))
+	builder.WriteString(", ")
+	builder.WriteString("content_format=")
+	builder.WriteString(_m.ContentFormat)
+	builder.WriteString(", ")
+	builder.WriteString("question_html=")
+	builder.WriteString(_m.QuestionHTML)
+	builder.WriteString(", ")
+	builder.WriteString("answer_html=")
+	builder.WriteString(_m.AnswerHTML)
+	builder.WriteString(", ")
+	builder.WriteString("hint=")
+	builder.WriteString(_m.Hint)
+	builder.WriteString(", ")
+	builder.WriteString("hint_html=")
+	builder.WriteString(_m.HintHTML)
+	builder.WriteString(", ")
+	builder.WriteString("explanation=")
+	builder.WriteString(_m.Explanation)
+	builder.WriteString(", ")
+	builder.WriteString("explanation_html=")
+	builder.WriteString(_m.ExplanationHTML)
+	builder.WriteString(", ")
+	builder.WriteString("sources=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sources))
+	builder.WriteString(", ")
+	builder.WriteString("question_key=")
+	builder.WriteString(_m.QuestionKey)
+	builder.WriteString(", ")
+	if v := _m.QuestionText; v != nil {
+		builder.WriteString("question_text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AnswerText; v != nil {
+		builder.WriteString("answer_text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("search_vector=")
+	builder.WriteString(_m.SearchVector)
+	builder.WriteString(", ")
 	builder.WriteString("collection_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(_m.CreatedBy)
 	builder.WriteString(", ")
@@ -210,6 +549,14 @@ func (_m *Flashcard) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("deleted_by=")
+	builder.WriteString(_m.DeletedBy)
 	builder.WriteByte(')')
 	return builder.String()
 }
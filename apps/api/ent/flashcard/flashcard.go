@@ -3,6 +3,7 @@
 package flashcard
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -21,18 +22,70 @@ const (
 	FieldAnswer = "answer"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldPairs holds the string denoting the pairs field in the database.
+	FieldPairs = "pairs"
+	// FieldOcclusion holds the string denoting the occlusion field in the database.
+	FieldOcclusion = "occlusion"
+	// FieldContentFormat holds the string denoting the content_format field in the database.
+	FieldContentFormat = "content_format"
+	// FieldQuestionHTML holds the string denoting the question_html field in the database.
+	FieldQuestionHTML = "question_html"
+	// FieldAnswerHTML holds the string denoting the answer_html field in the database.
+	FieldAnswerHTML = "answer_html"
+	// FieldHint holds the string denoting the hint field in the database.
+	FieldHint = "hint"
+	// FieldHintHTML holds the string denoting the hint_html field in the database.
+	FieldHintHTML = "hint_html"
+	// FieldExplanation holds the string denoting the explanation field in the database.
+	FieldExplanation = "explanation"
+	// FieldExplanationHTML holds the string denoting the explanation_html field in the database.
+	FieldExplanationHTML = "explanation_html"
+	// FieldSources holds the string denoting the sources field in the database.
+	FieldSources = "sources"
+	// FieldQuestionKey holds the string denoting the question_key field in the database.
+	FieldQuestionKey = "question_key"
+	// FieldQuestionText holds the string denoting the question_text field in the database.
+	FieldQuestionText = "question_text"
+	// FieldAnswerText holds the string denoting the answer_text field in the database.
+	FieldAnswerText = "answer_text"
+	// FieldSearchVector holds the string denoting the search_vector field in the database.
+	FieldSearchVector = "search_vector"
 	// FieldCollectionID holds the string denoting the collection_id field in the database.
 	FieldCollectionID = "collection_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
+	FieldDeletedBy = "deleted_by"
 	// EdgeCollection holds the string denoting the collection edge name in mutations.
 	EdgeCollection = "collection"
 	// EdgeReviews holds the string denoting the reviews edge name in mutations.
 	EdgeReviews = "reviews"
+	// EdgeMedia holds the string denoting the media edge name in mutations.
+	EdgeMedia = "media"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeAnnotations holds the string denoting the annotations edge name in mutations.
+	EdgeAnnotations = "annotations"
+	// EdgeQuizAnswers holds the string denoting the quiz_answers edge name in mutations.
+	EdgeQuizAnswers = "quiz_answers"
+	// EdgeItemStatistics holds the string denoting the item_statistics edge name in mutations.
+	EdgeItemStatistics = "item_statistics"
+	// EdgeRating holds the string denoting the rating edge name in mutations.
+	EdgeRating = "rating"
 	// Table holds the table name of the flashcard in the database.
 	Table = "flashcards"
 	// CollectionTable is the table that holds the collection relation/edge.
@@ -49,6 +102,53 @@ const (
 	ReviewsInverseTable = "flashcard_reviews"
 	// ReviewsColumn is the table column denoting the reviews relation/edge.
 	ReviewsColumn = "flashcard_id"
+	// MediaTable is the table that holds the media relation/edge. The primary key declared below.
+	MediaTable = "flashcard_media"
+	// MediaInverseTable is the table name for the Media entity.
+	// It exists in this package in order to avoid circular dependency with the "media" package.
+	MediaInverseTable = "media"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "flashcard_tags"
+	// TagsInverseTable is the table name for the FlashcardTag entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcardtag" package.
+	TagsInverseTable = "flashcard_tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "flashcard_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "flashcard_revisions"
+	// RevisionsInverseTable is the table name for the FlashcardRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcardrevision" package.
+	RevisionsInverseTable = "flashcard_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "flashcard_id"
+	// AnnotationsTable is the table that holds the annotations relation/edge.
+	AnnotationsTable = "flashcard_annotations"
+	// AnnotationsInverseTable is the table name for the FlashcardAnnotation entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcardannotation" package.
+	AnnotationsInverseTable = "flashcard_annotations"
+	// AnnotationsColumn is the table column denoting the annotations relation/edge.
+	AnnotationsColumn = "flashcard_id"
+	// QuizAnswersTable is the table that holds the quiz_answers relation/edge.
+	QuizAnswersTable = "quiz_answers"
+	// QuizAnswersInverseTable is the table name for the QuizAnswer entity.
+	// It exists in this package in order to avoid circular dependency with the "quizanswer" package.
+	QuizAnswersInverseTable = "quiz_answers"
+	// QuizAnswersColumn is the table column denoting the quiz_answers relation/edge.
+	QuizAnswersColumn = "flashcard_id"
+	// ItemStatisticsTable is the table that holds the item_statistics relation/edge.
+	ItemStatisticsTable = "item_statistics"
+	// ItemStatisticsInverseTable is the table name for the ItemStatistic entity.
+	// It exists in this package in order to avoid circular dependency with the "itemstatistic" package.
+	ItemStatisticsInverseTable = "item_statistics"
+	// ItemStatisticsColumn is the table column denoting the item_statistics relation/edge.
+	ItemStatisticsColumn = "flashcard_id"
+	// RatingTable is the table that holds the rating relation/edge.
+	RatingTable = "flashcard_ratings"
+	// RatingInverseTable is the table name for the FlashcardRating entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcardrating" package.
+	RatingInverseTable = "flashcard_ratings"
+	// RatingColumn is the table column denoting the rating relation/edge.
+	RatingColumn = "flashcard_id"
 )
 
 // Columns holds all SQL columns for flashcard fields.
@@ -57,12 +157,37 @@ var Columns = []string{
 	FieldQuestion,
 	FieldAnswer,
 	FieldType,
+	FieldOptions,
+	FieldPairs,
+	FieldOcclusion,
+	FieldContentFormat,
+	FieldQuestionHTML,
+	FieldAnswerHTML,
+	FieldHint,
+	FieldHintHTML,
+	FieldExplanation,
+	FieldExplanationHTML,
+	FieldSources,
+	FieldQuestionKey,
+	FieldQuestionText,
+	FieldAnswerText,
+	FieldSearchVector,
 	FieldCollectionID,
+	FieldStatus,
+	FieldPosition,
 	FieldCreatedBy,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldDeletedBy,
 }
 
+var (
+	// MediaPrimaryKey and MediaColumn2 are the table columns denoting the
+	// primary key for the media relation (M2M).
+	MediaPrimaryKey = []string{"flashcard_id", "media_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -82,6 +207,26 @@ var (
 	DefaultType string
 	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	TypeValidator func(string) error
+	// DefaultContentFormat holds the default value on creation for the "content_format" field.
+	DefaultContentFormat string
+	// ContentFormatValidator is a validator for the "content_format" field. It is called by the builders before save.
+	ContentFormatValidator func(string) error
+	// DefaultQuestionHTML holds the default value on creation for the "question_html" field.
+	DefaultQuestionHTML string
+	// DefaultAnswerHTML holds the default value on creation for the "answer_html" field.
+	DefaultAnswerHTML string
+	// DefaultHint holds the default value on creation for the "hint" field.
+	DefaultHint string
+	// DefaultHintHTML holds the default value on creation for the "hint_html" field.
+	DefaultHintHTML string
+	// DefaultExplanation holds the default value on creation for the "explanation" field.
+	DefaultExplanation string
+	// DefaultExplanationHTML holds the default value on creation for the "explanation_html" field.
+	DefaultExplanationHTML string
+	// DefaultQuestionKey holds the default value on creation for the "question_key" field.
+	DefaultQuestionKey string
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition float64
 	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	CreatedByValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -90,10 +235,38 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DeletedByValidator is a validator for the "deleted_by" field. It is called by the builders before save.
+	DeletedByValidator func(string) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
 
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPublished is the default value of the Status enum.
+const DefaultStatus = StatusPublished
+
+// Status values.
+const (
+	StatusDraft     Status = "draft"
+	StatusPublished Status = "published"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusDraft, StatusPublished:
+		return nil
+	default:
+		return fmt.Errorf("flashcard: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Flashcard queries.
 type OrderOption func(*sql.Selector)
 
@@ -117,11 +290,76 @@ func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
 
+// ByContentFormat orders the results by the content_format field.
+func ByContentFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentFormat, opts...).ToFunc()
+}
+
+// ByQuestionHTML orders the results by the question_html field.
+func ByQuestionHTML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionHTML, opts...).ToFunc()
+}
+
+// ByAnswerHTML orders the results by the answer_html field.
+func ByAnswerHTML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerHTML, opts...).ToFunc()
+}
+
+// ByHint orders the results by the hint field.
+func ByHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHint, opts...).ToFunc()
+}
+
+// ByHintHTML orders the results by the hint_html field.
+func ByHintHTML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHintHTML, opts...).ToFunc()
+}
+
+// ByExplanation orders the results by the explanation field.
+func ByExplanation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanation, opts...).ToFunc()
+}
+
+// ByExplanationHTML orders the results by the explanation_html field.
+func ByExplanationHTML(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanationHTML, opts...).ToFunc()
+}
+
+// ByQuestionKey orders the results by the question_key field.
+func ByQuestionKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionKey, opts...).ToFunc()
+}
+
+// ByQuestionText orders the results by the question_text field.
+func ByQuestionText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionText, opts...).ToFunc()
+}
+
+// ByAnswerText orders the results by the answer_text field.
+func ByAnswerText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerText, opts...).ToFunc()
+}
+
+// BySearchVector orders the results by the search_vector field.
+func BySearchVector(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSearchVector, opts...).ToFunc()
+}
+
 // ByCollectionID orders the results by the collection_id field.
 func ByCollectionID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCollectionID, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -137,6 +375,16 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByDeletedBy orders the results by the deleted_by field.
+func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
+}
+
 // ByCollectionField orders the results by collection field.
 func ByCollectionField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -157,6 +405,97 @@ func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMediaCount orders the results by media count.
+func ByMediaCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMediaStep(), opts...)
+	}
+}
+
+// ByMedia orders the results by media terms.
+func ByMedia(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMediaStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAnnotationsCount orders the results by annotations count.
+func ByAnnotationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnnotationsStep(), opts...)
+	}
+}
+
+// ByAnnotations orders the results by annotations terms.
+func ByAnnotations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnnotationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByQuizAnswersCount orders the results by quiz_answers count.
+func ByQuizAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuizAnswersStep(), opts...)
+	}
+}
+
+// ByQuizAnswers orders the results by quiz_answers terms.
+func ByQuizAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemStatisticsCount orders the results by item_statistics count.
+func ByItemStatisticsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemStatisticsStep(), opts...)
+	}
+}
+
+// ByItemStatistics orders the results by item_statistics terms.
+func ByItemStatistics(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStatisticsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRatingField orders the results by rating field.
+func ByRatingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRatingStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCollectionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -171,3 +510,52 @@ func newReviewsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
 	)
 }
+func newMediaStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MediaInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MediaTable, MediaPrimaryKey...),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newAnnotationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnnotationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnnotationsTable, AnnotationsColumn),
+	)
+}
+func newQuizAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizAnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuizAnswersTable, QuizAnswersColumn),
+	)
+}
+func newItemStatisticsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemStatisticsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemStatisticsTable, ItemStatisticsColumn),
+	)
+}
+func newRatingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RatingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RatingTable, RatingColumn),
+	)
+}
@@ -71,11 +71,71 @@ func Type(v string) predicate.Flashcard {
 	return predicate.Flashcard(sql.FieldEQ(FieldType, v))
 }
 
+// ContentFormat applies equality check predicate on the "content_format" field. It's identical to ContentFormatEQ.
+func ContentFormat(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldContentFormat, v))
+}
+
+// QuestionHTML applies equality check predicate on the "question_html" field. It's identical to QuestionHTMLEQ.
+func QuestionHTML(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldQuestionHTML, v))
+}
+
+// AnswerHTML applies equality check predicate on the "answer_html" field. It's identical to AnswerHTMLEQ.
+func AnswerHTML(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldAnswerHTML, v))
+}
+
+// Hint applies equality check predicate on the "hint" field. It's identical to HintEQ.
+func Hint(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldHint, v))
+}
+
+// HintHTML applies equality check predicate on the "hint_html" field. It's identical to HintHTMLEQ.
+func HintHTML(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldHintHTML, v))
+}
+
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationHTML applies equality check predicate on the "explanation_html" field. It's identical to ExplanationHTMLEQ.
+func ExplanationHTML(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldExplanationHTML, v))
+}
+
+// QuestionKey applies equality check predicate on the "question_key" field. It's identical to QuestionKeyEQ.
+func QuestionKey(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldQuestionKey, v))
+}
+
+// QuestionText applies equality check predicate on the "question_text" field. It's identical to QuestionTextEQ.
+func QuestionText(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldQuestionText, v))
+}
+
+// AnswerText applies equality check predicate on the "answer_text" field. It's identical to AnswerTextEQ.
+func AnswerText(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldAnswerText, v))
+}
+
+// SearchVector applies equality check predicate on the "search_vector" field. It's identical to SearchVectorEQ.
+func SearchVector(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldSearchVector, v))
+}
+
 // CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
 func CollectionID(v uuid.UUID) predicate.Flashcard {
 	return predicate.Flashcard(sql.FieldEQ(FieldCollectionID, v))
 }
 
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldPosition, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v string) predicate.Flashcard {
 	return predicate.Flashcard(sql.FieldEQ(FieldCreatedBy, v))
@@ -91,6 +151,16 @@ func UpdatedAt(v time.Time) predicate.Flashcard {
 	return predicate.Flashcard(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldDeletedBy, v))
+}
+
 // QuestionEQ applies the EQ predicate on the "question" field.
 func QuestionEQ(v string) predicate.Flashcard {
 	return predicate.Flashcard(sql.FieldEQ(FieldQuestion, v))
@@ -286,209 +356,1410 @@ func TypeContainsFold(v string) predicate.Flashcard {
 	return predicate.Flashcard(sql.FieldContainsFold(FieldType, v))
 }
 
-// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
-func CollectionIDEQ(v uuid.UUID) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldEQ(FieldCollectionID, v))
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldOptions))
 }
 
-// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
-func CollectionIDNEQ(v uuid.UUID) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNEQ(FieldCollectionID, v))
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldOptions))
 }
 
-// CollectionIDIn applies the In predicate on the "collection_id" field.
-func CollectionIDIn(vs ...uuid.UUID) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldIn(FieldCollectionID, vs...))
+// PairsIsNil applies the IsNil predicate on the "pairs" field.
+func PairsIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldPairs))
 }
 
-// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
-func CollectionIDNotIn(vs ...uuid.UUID) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNotIn(FieldCollectionID, vs...))
+// PairsNotNil applies the NotNil predicate on the "pairs" field.
+func PairsNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldPairs))
 }
 
-// CreatedByEQ applies the EQ predicate on the "created_by" field.
-func CreatedByEQ(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldEQ(FieldCreatedBy, v))
+// OcclusionIsNil applies the IsNil predicate on the "occlusion" field.
+func OcclusionIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldOcclusion))
 }
 
-// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
-func CreatedByNEQ(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNEQ(FieldCreatedBy, v))
+// OcclusionNotNil applies the NotNil predicate on the "occlusion" field.
+func OcclusionNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldOcclusion))
 }
 
-// CreatedByIn applies the In predicate on the "created_by" field.
-func CreatedByIn(vs ...string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldIn(FieldCreatedBy, vs...))
+// ContentFormatEQ applies the EQ predicate on the "content_format" field.
+func ContentFormatEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldContentFormat, v))
 }
 
-// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
-func CreatedByNotIn(vs ...string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNotIn(FieldCreatedBy, vs...))
+// ContentFormatNEQ applies the NEQ predicate on the "content_format" field.
+func ContentFormatNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldContentFormat, v))
 }
 
-// CreatedByGT applies the GT predicate on the "created_by" field.
-func CreatedByGT(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldGT(FieldCreatedBy, v))
+// ContentFormatIn applies the In predicate on the "content_format" field.
+func ContentFormatIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldContentFormat, vs...))
 }
 
-// CreatedByGTE applies the GTE predicate on the "created_by" field.
-func CreatedByGTE(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldGTE(FieldCreatedBy, v))
+// ContentFormatNotIn applies the NotIn predicate on the "content_format" field.
+func ContentFormatNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldContentFormat, vs...))
 }
 
-// CreatedByLT applies the LT predicate on the "created_by" field.
-func CreatedByLT(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldLT(FieldCreatedBy, v))
+// ContentFormatGT applies the GT predicate on the "content_format" field.
+func ContentFormatGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldContentFormat, v))
 }
 
-// CreatedByLTE applies the LTE predicate on the "created_by" field.
-func CreatedByLTE(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldLTE(FieldCreatedBy, v))
+// ContentFormatGTE applies the GTE predicate on the "content_format" field.
+func ContentFormatGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldContentFormat, v))
 }
 
-// CreatedByContains applies the Contains predicate on the "created_by" field.
-func CreatedByContains(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldContains(FieldCreatedBy, v))
+// ContentFormatLT applies the LT predicate on the "content_format" field.
+func ContentFormatLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldContentFormat, v))
 }
 
-// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
-func CreatedByHasPrefix(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldHasPrefix(FieldCreatedBy, v))
+// ContentFormatLTE applies the LTE predicate on the "content_format" field.
+func ContentFormatLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldContentFormat, v))
 }
 
-// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
-func CreatedByHasSuffix(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldHasSuffix(FieldCreatedBy, v))
+// ContentFormatContains applies the Contains predicate on the "content_format" field.
+func ContentFormatContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldContentFormat, v))
 }
 
-// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
-func CreatedByEqualFold(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldEqualFold(FieldCreatedBy, v))
+// ContentFormatHasPrefix applies the HasPrefix predicate on the "content_format" field.
+func ContentFormatHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldContentFormat, v))
 }
 
-// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
-func CreatedByContainsFold(v string) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldContainsFold(FieldCreatedBy, v))
+// ContentFormatHasSuffix applies the HasSuffix predicate on the "content_format" field.
+func ContentFormatHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldContentFormat, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldEQ(FieldCreatedAt, v))
+// ContentFormatEqualFold applies the EqualFold predicate on the "content_format" field.
+func ContentFormatEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldContentFormat, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNEQ(FieldCreatedAt, v))
+// ContentFormatContainsFold applies the ContainsFold predicate on the "content_format" field.
+func ContentFormatContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldContentFormat, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldIn(FieldCreatedAt, vs...))
+// QuestionHTMLEQ applies the EQ predicate on the "question_html" field.
+func QuestionHTMLEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldQuestionHTML, v))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNotIn(FieldCreatedAt, vs...))
+// QuestionHTMLNEQ applies the NEQ predicate on the "question_html" field.
+func QuestionHTMLNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldQuestionHTML, v))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldGT(FieldCreatedAt, v))
+// QuestionHTMLIn applies the In predicate on the "question_html" field.
+func QuestionHTMLIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldQuestionHTML, vs...))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldGTE(FieldCreatedAt, v))
+// QuestionHTMLNotIn applies the NotIn predicate on the "question_html" field.
+func QuestionHTMLNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldQuestionHTML, vs...))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldLT(FieldCreatedAt, v))
+// QuestionHTMLGT applies the GT predicate on the "question_html" field.
+func QuestionHTMLGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldQuestionHTML, v))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldLTE(FieldCreatedAt, v))
+// QuestionHTMLGTE applies the GTE predicate on the "question_html" field.
+func QuestionHTMLGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldQuestionHTML, v))
 }
 
-// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
-func UpdatedAtEQ(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldEQ(FieldUpdatedAt, v))
+// QuestionHTMLLT applies the LT predicate on the "question_html" field.
+func QuestionHTMLLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldQuestionHTML, v))
 }
 
-// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
-func UpdatedAtNEQ(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNEQ(FieldUpdatedAt, v))
+// QuestionHTMLLTE applies the LTE predicate on the "question_html" field.
+func QuestionHTMLLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldQuestionHTML, v))
 }
 
-// UpdatedAtIn applies the In predicate on the "updated_at" field.
-func UpdatedAtIn(vs ...time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldIn(FieldUpdatedAt, vs...))
+// QuestionHTMLContains applies the Contains predicate on the "question_html" field.
+func QuestionHTMLContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldQuestionHTML, v))
 }
 
-// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
-func UpdatedAtNotIn(vs ...time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldNotIn(FieldUpdatedAt, vs...))
+// QuestionHTMLHasPrefix applies the HasPrefix predicate on the "question_html" field.
+func QuestionHTMLHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldQuestionHTML, v))
 }
 
-// UpdatedAtGT applies the GT predicate on the "updated_at" field.
-func UpdatedAtGT(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldGT(FieldUpdatedAt, v))
+// QuestionHTMLHasSuffix applies the HasSuffix predicate on the "question_html" field.
+func QuestionHTMLHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldQuestionHTML, v))
 }
 
-// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
-func UpdatedAtGTE(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldGTE(FieldUpdatedAt, v))
+// QuestionHTMLIsNil applies the IsNil predicate on the "question_html" field.
+func QuestionHTMLIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldQuestionHTML))
 }
 
-// UpdatedAtLT applies the LT predicate on the "updated_at" field.
-func UpdatedAtLT(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldLT(FieldUpdatedAt, v))
+// QuestionHTMLNotNil applies the NotNil predicate on the "question_html" field.
+func QuestionHTMLNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldQuestionHTML))
 }
 
-// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
-func UpdatedAtLTE(v time.Time) predicate.Flashcard {
-	return predicate.Flashcard(sql.FieldLTE(FieldUpdatedAt, v))
+// QuestionHTMLEqualFold applies the EqualFold predicate on the "question_html" field.
+func QuestionHTMLEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldQuestionHTML, v))
 }
 
-// HasCollection applies the HasEdge predicate on the "collection" edge.
-func HasCollection() predicate.Flashcard {
-	return predicate.Flashcard(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, CollectionTable, CollectionColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// QuestionHTMLContainsFold applies the ContainsFold predicate on the "question_html" field.
+func QuestionHTMLContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldQuestionHTML, v))
 }
 
-// HasCollectionWith applies the HasEdge predicate on the "collection" edge with a given conditions (other predicates).
-func HasCollectionWith(preds ...predicate.Collection) predicate.Flashcard {
-	return predicate.Flashcard(func(s *sql.Selector) {
-		step := newCollectionStep()
-		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
-			for _, p := range preds {
-				p(s)
-			}
-		})
-	})
+// AnswerHTMLEQ applies the EQ predicate on the "answer_html" field.
+func AnswerHTMLEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldAnswerHTML, v))
 }
 
-// HasReviews applies the HasEdge predicate on the "reviews" edge.
-func HasReviews() predicate.Flashcard {
-	return predicate.Flashcard(func(s *sql.Selector) {
-		step := sqlgraph.NewStep(
-			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
-		)
-		sqlgraph.HasNeighbors(s, step)
-	})
+// AnswerHTMLNEQ applies the NEQ predicate on the "answer_html" field.
+func AnswerHTMLNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldAnswerHTML, v))
 }
 
-// HasReviewsWith applies the HasEdge predicate on the "reviews" edge with a given conditions (other predicates).
-func HasReviewsWith(preds ...predicate.FlashcardReview) predicate.Flashcard {
-	return predicate.Flashcard(func(s *sql.Selector) {
-		step := newReviewsStep()
+// AnswerHTMLIn applies the In predicate on the "answer_html" field.
+func AnswerHTMLIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldAnswerHTML, vs...))
+}
+
+// AnswerHTMLNotIn applies the NotIn predicate on the "answer_html" field.
+func AnswerHTMLNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldAnswerHTML, vs...))
+}
+
+// AnswerHTMLGT applies the GT predicate on the "answer_html" field.
+func AnswerHTMLGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLGTE applies the GTE predicate on the "answer_html" field.
+func AnswerHTMLGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLLT applies the LT predicate on the "answer_html" field.
+func AnswerHTMLLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLLTE applies the LTE predicate on the "answer_html" field.
+func AnswerHTMLLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLContains applies the Contains predicate on the "answer_html" field.
+func AnswerHTMLContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLHasPrefix applies the HasPrefix predicate on the "answer_html" field.
+func AnswerHTMLHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLHasSuffix applies the HasSuffix predicate on the "answer_html" field.
+func AnswerHTMLHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLIsNil applies the IsNil predicate on the "answer_html" field.
+func AnswerHTMLIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldAnswerHTML))
+}
+
+// AnswerHTMLNotNil applies the NotNil predicate on the "answer_html" field.
+func AnswerHTMLNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldAnswerHTML))
+}
+
+// AnswerHTMLEqualFold applies the EqualFold predicate on the "answer_html" field.
+func AnswerHTMLEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldAnswerHTML, v))
+}
+
+// AnswerHTMLContainsFold applies the ContainsFold predicate on the "answer_html" field.
+func AnswerHTMLContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldAnswerHTML, v))
+}
+
+// HintEQ applies the EQ predicate on the "hint" field.
+func HintEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldHint, v))
+}
+
+// HintNEQ applies the NEQ predicate on the "hint" field.
+func HintNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldHint, v))
+}
+
+// HintIn applies the In predicate on the "hint" field.
+func HintIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldHint, vs...))
+}
+
+// HintNotIn applies the NotIn predicate on the "hint" field.
+func HintNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldHint, vs...))
+}
+
+// HintGT applies the GT predicate on the "hint" field.
+func HintGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldHint, v))
+}
+
+// HintGTE applies the GTE predicate on the "hint" field.
+func HintGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldHint, v))
+}
+
+// HintLT applies the LT predicate on the "hint" field.
+func HintLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldHint, v))
+}
+
+// HintLTE applies the LTE predicate on the "hint" field.
+func HintLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldHint, v))
+}
+
+// HintContains applies the Contains predicate on the "hint" field.
+func HintContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldHint, v))
+}
+
+// HintHasPrefix applies the HasPrefix predicate on the "hint" field.
+func HintHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldHint, v))
+}
+
+// HintHasSuffix applies the HasSuffix predicate on the "hint" field.
+func HintHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldHint, v))
+}
+
+// HintIsNil applies the IsNil predicate on the "hint" field.
+func HintIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldHint))
+}
+
+// HintNotNil applies the NotNil predicate on the "hint" field.
+func HintNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldHint))
+}
+
+// HintEqualFold applies the EqualFold predicate on the "hint" field.
+func HintEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldHint, v))
+}
+
+// HintContainsFold applies the ContainsFold predicate on the "hint" field.
+func HintContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldHint, v))
+}
+
+// HintHTMLEQ applies the EQ predicate on the "hint_html" field.
+func HintHTMLEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldHintHTML, v))
+}
+
+// HintHTMLNEQ applies the NEQ predicate on the "hint_html" field.
+func HintHTMLNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldHintHTML, v))
+}
+
+// HintHTMLIn applies the In predicate on the "hint_html" field.
+func HintHTMLIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldHintHTML, vs...))
+}
+
+// HintHTMLNotIn applies the NotIn predicate on the "hint_html" field.
+func HintHTMLNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldHintHTML, vs...))
+}
+
+// HintHTMLGT applies the GT predicate on the "hint_html" field.
+func HintHTMLGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldHintHTML, v))
+}
+
+// HintHTMLGTE applies the GTE predicate on the "hint_html" field.
+func HintHTMLGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldHintHTML, v))
+}
+
+// HintHTMLLT applies the LT predicate on the "hint_html" field.
+func HintHTMLLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldHintHTML, v))
+}
+
+// HintHTMLLTE applies the LTE predicate on the "hint_html" field.
+func HintHTMLLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldHintHTML, v))
+}
+
+// HintHTMLContains applies the Contains predicate on the "hint_html" field.
+func HintHTMLContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldHintHTML, v))
+}
+
+// HintHTMLHasPrefix applies the HasPrefix predicate on the "hint_html" field.
+func HintHTMLHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldHintHTML, v))
+}
+
+// HintHTMLHasSuffix applies the HasSuffix predicate on the "hint_html" field.
+func HintHTMLHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldHintHTML, v))
+}
+
+// HintHTMLIsNil applies the IsNil predicate on the "hint_html" field.
+func HintHTMLIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldHintHTML))
+}
+
+// HintHTMLNotNil applies the NotNil predicate on the "hint_html" field.
+func HintHTMLNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldHintHTML))
+}
+
+// HintHTMLEqualFold applies the EqualFold predicate on the "hint_html" field.
+func HintHTMLEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldHintHTML, v))
+}
+
+// HintHTMLContainsFold applies the ContainsFold predicate on the "hint_html" field.
+func HintHTMLContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldHintHTML, v))
+}
+
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationIsNil applies the IsNil predicate on the "explanation" field.
+func ExplanationIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldExplanation))
+}
+
+// ExplanationNotNil applies the NotNil predicate on the "explanation" field.
+func ExplanationNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldExplanation))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldExplanation, v))
+}
+
+// ExplanationHTMLEQ applies the EQ predicate on the "explanation_html" field.
+func ExplanationHTMLEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLNEQ applies the NEQ predicate on the "explanation_html" field.
+func ExplanationHTMLNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLIn applies the In predicate on the "explanation_html" field.
+func ExplanationHTMLIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldExplanationHTML, vs...))
+}
+
+// ExplanationHTMLNotIn applies the NotIn predicate on the "explanation_html" field.
+func ExplanationHTMLNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldExplanationHTML, vs...))
+}
+
+// ExplanationHTMLGT applies the GT predicate on the "explanation_html" field.
+func ExplanationHTMLGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLGTE applies the GTE predicate on the "explanation_html" field.
+func ExplanationHTMLGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLLT applies the LT predicate on the "explanation_html" field.
+func ExplanationHTMLLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLLTE applies the LTE predicate on the "explanation_html" field.
+func ExplanationHTMLLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLContains applies the Contains predicate on the "explanation_html" field.
+func ExplanationHTMLContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLHasPrefix applies the HasPrefix predicate on the "explanation_html" field.
+func ExplanationHTMLHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLHasSuffix applies the HasSuffix predicate on the "explanation_html" field.
+func ExplanationHTMLHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLIsNil applies the IsNil predicate on the "explanation_html" field.
+func ExplanationHTMLIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldExplanationHTML))
+}
+
+// ExplanationHTMLNotNil applies the NotNil predicate on the "explanation_html" field.
+func ExplanationHTMLNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldExplanationHTML))
+}
+
+// ExplanationHTMLEqualFold applies the EqualFold predicate on the "explanation_html" field.
+func ExplanationHTMLEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldExplanationHTML, v))
+}
+
+// ExplanationHTMLContainsFold applies the ContainsFold predicate on the "explanation_html" field.
+func ExplanationHTMLContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldExplanationHTML, v))
+}
+
+// SourcesIsNil applies the IsNil predicate on the "sources" field.
+func SourcesIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldSources))
+}
+
+// SourcesNotNil applies the NotNil predicate on the "sources" field.
+func SourcesNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldSources))
+}
+
+// QuestionKeyEQ applies the EQ predicate on the "question_key" field.
+func QuestionKeyEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldQuestionKey, v))
+}
+
+// QuestionKeyNEQ applies the NEQ predicate on the "question_key" field.
+func QuestionKeyNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldQuestionKey, v))
+}
+
+// QuestionKeyIn applies the In predicate on the "question_key" field.
+func QuestionKeyIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldQuestionKey, vs...))
+}
+
+// QuestionKeyNotIn applies the NotIn predicate on the "question_key" field.
+func QuestionKeyNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldQuestionKey, vs...))
+}
+
+// QuestionKeyGT applies the GT predicate on the "question_key" field.
+func QuestionKeyGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldQuestionKey, v))
+}
+
+// QuestionKeyGTE applies the GTE predicate on the "question_key" field.
+func QuestionKeyGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldQuestionKey, v))
+}
+
+// QuestionKeyLT applies the LT predicate on the "question_key" field.
+func QuestionKeyLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldQuestionKey, v))
+}
+
+// QuestionKeyLTE applies the LTE predicate on the "question_key" field.
+func QuestionKeyLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldQuestionKey, v))
+}
+
+// QuestionKeyContains applies the Contains predicate on the "question_key" field.
+func QuestionKeyContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldQuestionKey, v))
+}
+
+// QuestionKeyHasPrefix applies the HasPrefix predicate on the "question_key" field.
+func QuestionKeyHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldQuestionKey, v))
+}
+
+// QuestionKeyHasSuffix applies the HasSuffix predicate on the "question_key" field.
+func QuestionKeyHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldQuestionKey, v))
+}
+
+// QuestionKeyIsNil applies the IsNil predicate on the "question_key" field.
+func QuestionKeyIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldQuestionKey))
+}
+
+// QuestionKeyNotNil applies the NotNil predicate on the "question_key" field.
+func QuestionKeyNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldQuestionKey))
+}
+
+// QuestionKeyEqualFold applies the EqualFold predicate on the "question_key" field.
+func QuestionKeyEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldQuestionKey, v))
+}
+
+// QuestionKeyContainsFold applies the ContainsFold predicate on the "question_key" field.
+func QuestionKeyContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldQuestionKey, v))
+}
+
+// QuestionTextEQ applies the EQ predicate on the "question_text" field.
+func QuestionTextEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldQuestionText, v))
+}
+
+// QuestionTextNEQ applies the NEQ predicate on the "question_text" field.
+func QuestionTextNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldQuestionText, v))
+}
+
+// QuestionTextIn applies the In predicate on the "question_text" field.
+func QuestionTextIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldQuestionText, vs...))
+}
+
+// QuestionTextNotIn applies the NotIn predicate on the "question_text" field.
+func QuestionTextNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldQuestionText, vs...))
+}
+
+// QuestionTextGT applies the GT predicate on the "question_text" field.
+func QuestionTextGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldQuestionText, v))
+}
+
+// QuestionTextGTE applies the GTE predicate on the "question_text" field.
+func QuestionTextGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldQuestionText, v))
+}
+
+// QuestionTextLT applies the LT predicate on the "question_text" field.
+func QuestionTextLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldQuestionText, v))
+}
+
+// QuestionTextLTE applies the LTE predicate on the "question_text" field.
+func QuestionTextLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldQuestionText, v))
+}
+
+// QuestionTextContains applies the Contains predicate on the "question_text" field.
+func QuestionTextContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldQuestionText, v))
+}
+
+// QuestionTextHasPrefix applies the HasPrefix predicate on the "question_text" field.
+func QuestionTextHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldQuestionText, v))
+}
+
+// QuestionTextHasSuffix applies the HasSuffix predicate on the "question_text" field.
+func QuestionTextHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldQuestionText, v))
+}
+
+// QuestionTextIsNil applies the IsNil predicate on the "question_text" field.
+func QuestionTextIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldQuestionText))
+}
+
+// QuestionTextNotNil applies the NotNil predicate on the "question_text" field.
+func QuestionTextNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldQuestionText))
+}
+
+// QuestionTextEqualFold applies the EqualFold predicate on the "question_text" field.
+func QuestionTextEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldQuestionText, v))
+}
+
+// QuestionTextContainsFold applies the ContainsFold predicate on the "question_text" field.
+func QuestionTextContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldQuestionText, v))
+}
+
+// AnswerTextEQ applies the EQ predicate on the "answer_text" field.
+func AnswerTextEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldAnswerText, v))
+}
+
+// AnswerTextNEQ applies the NEQ predicate on the "answer_text" field.
+func AnswerTextNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldAnswerText, v))
+}
+
+// AnswerTextIn applies the In predicate on the "answer_text" field.
+func AnswerTextIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldAnswerText, vs...))
+}
+
+// AnswerTextNotIn applies the NotIn predicate on the "answer_text" field.
+func AnswerTextNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldAnswerText, vs...))
+}
+
+// AnswerTextGT applies the GT predicate on the "answer_text" field.
+func AnswerTextGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldAnswerText, v))
+}
+
+// AnswerTextGTE applies the GTE predicate on the "answer_text" field.
+func AnswerTextGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldAnswerText, v))
+}
+
+// AnswerTextLT applies the LT predicate on the "answer_text" field.
+func AnswerTextLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldAnswerText, v))
+}
+
+// AnswerTextLTE applies the LTE predicate on the "answer_text" field.
+func AnswerTextLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldAnswerText, v))
+}
+
+// AnswerTextContains applies the Contains predicate on the "answer_text" field.
+func AnswerTextContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldAnswerText, v))
+}
+
+// AnswerTextHasPrefix applies the HasPrefix predicate on the "answer_text" field.
+func AnswerTextHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldAnswerText, v))
+}
+
+// AnswerTextHasSuffix applies the HasSuffix predicate on the "answer_text" field.
+func AnswerTextHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldAnswerText, v))
+}
+
+// AnswerTextIsNil applies the IsNil predicate on the "answer_text" field.
+func AnswerTextIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldAnswerText))
+}
+
+// AnswerTextNotNil applies the NotNil predicate on the "answer_text" field.
+func AnswerTextNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldAnswerText))
+}
+
+// AnswerTextEqualFold applies the EqualFold predicate on the "answer_text" field.
+func AnswerTextEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldAnswerText, v))
+}
+
+// AnswerTextContainsFold applies the ContainsFold predicate on the "answer_text" field.
+func AnswerTextContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldAnswerText, v))
+}
+
+// SearchVectorEQ applies the EQ predicate on the "search_vector" field.
+func SearchVectorEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldSearchVector, v))
+}
+
+// SearchVectorNEQ applies the NEQ predicate on the "search_vector" field.
+func SearchVectorNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldSearchVector, v))
+}
+
+// SearchVectorIn applies the In predicate on the "search_vector" field.
+func SearchVectorIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorNotIn applies the NotIn predicate on the "search_vector" field.
+func SearchVectorNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldSearchVector, vs...))
+}
+
+// SearchVectorGT applies the GT predicate on the "search_vector" field.
+func SearchVectorGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldSearchVector, v))
+}
+
+// SearchVectorGTE applies the GTE predicate on the "search_vector" field.
+func SearchVectorGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldSearchVector, v))
+}
+
+// SearchVectorLT applies the LT predicate on the "search_vector" field.
+func SearchVectorLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldSearchVector, v))
+}
+
+// SearchVectorLTE applies the LTE predicate on the "search_vector" field.
+func SearchVectorLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldSearchVector, v))
+}
+
+// SearchVectorContains applies the Contains predicate on the "search_vector" field.
+func SearchVectorContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldSearchVector, v))
+}
+
+// SearchVectorHasPrefix applies the HasPrefix predicate on the "search_vector" field.
+func SearchVectorHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldSearchVector, v))
+}
+
+// SearchVectorHasSuffix applies the HasSuffix predicate on the "search_vector" field.
+func SearchVectorHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldSearchVector, v))
+}
+
+// SearchVectorIsNil applies the IsNil predicate on the "search_vector" field.
+func SearchVectorIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldSearchVector))
+}
+
+// SearchVectorNotNil applies the NotNil predicate on the "search_vector" field.
+func SearchVectorNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldSearchVector))
+}
+
+// SearchVectorEqualFold applies the EqualFold predicate on the "search_vector" field.
+func SearchVectorEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldSearchVector, v))
+}
+
+// SearchVectorContainsFold applies the ContainsFold predicate on the "search_vector" field.
+func SearchVectorContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldSearchVector, v))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v uuid.UUID) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v uuid.UUID) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...uuid.UUID) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...uuid.UUID) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v float64) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldPosition, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByContains applies the Contains predicate on the "deleted_by" field.
+func DeletedByContains(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContains(FieldDeletedBy, v))
+}
+
+// DeletedByHasPrefix applies the HasPrefix predicate on the "deleted_by" field.
+func DeletedByHasPrefix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasPrefix(FieldDeletedBy, v))
+}
+
+// DeletedByHasSuffix applies the HasSuffix predicate on the "deleted_by" field.
+func DeletedByHasSuffix(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldHasSuffix(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// DeletedByEqualFold applies the EqualFold predicate on the "deleted_by" field.
+func DeletedByEqualFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldEqualFold(FieldDeletedBy, v))
+}
+
+// DeletedByContainsFold applies the ContainsFold predicate on the "deleted_by" field.
+func DeletedByContainsFold(v string) predicate.Flashcard {
+	return predicate.Flashcard(sql.FieldContainsFold(FieldDeletedBy, v))
+}
+
+// HasCollection applies the HasEdge predicate on the "collection" edge.
+func HasCollection() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CollectionTable, CollectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionWith applies the HasEdge predicate on the "collection" edge with a given conditions (other predicates).
+func HasCollectionWith(preds ...predicate.Collection) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newCollectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviews applies the HasEdge predicate on the "reviews" edge.
+func HasReviews() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewsWith applies the HasEdge predicate on the "reviews" edge with a given conditions (other predicates).
+func HasReviewsWith(preds ...predicate.FlashcardReview) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newReviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMedia applies the HasEdge predicate on the "media" edge.
+func HasMedia() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, MediaTable, MediaPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMediaWith applies the HasEdge predicate on the "media" edge with a given conditions (other predicates).
+func HasMediaWith(preds ...predicate.Media) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.FlashcardTag) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.FlashcardRevision) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnnotations applies the HasEdge predicate on the "annotations" edge.
+func HasAnnotations() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnnotationsTable, AnnotationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnnotationsWith applies the HasEdge predicate on the "annotations" edge with a given conditions (other predicates).
+func HasAnnotationsWith(preds ...predicate.FlashcardAnnotation) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newAnnotationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuizAnswers applies the HasEdge predicate on the "quiz_answers" edge.
+func HasQuizAnswers() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuizAnswersTable, QuizAnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizAnswersWith applies the HasEdge predicate on the "quiz_answers" edge with a given conditions (other predicates).
+func HasQuizAnswersWith(preds ...predicate.QuizAnswer) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newQuizAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemStatistics applies the HasEdge predicate on the "item_statistics" edge.
+func HasItemStatistics() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemStatisticsTable, ItemStatisticsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemStatisticsWith applies the HasEdge predicate on the "item_statistics" edge with a given conditions (other predicates).
+func HasItemStatisticsWith(preds ...predicate.ItemStatistic) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newItemStatisticsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRating applies the HasEdge predicate on the "rating" edge.
+func HasRating() predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, RatingTable, RatingColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRatingWith applies the HasEdge predicate on the "rating" edge with a given conditions (other predicates).
+func HasRatingWith(preds ...predicate.FlashcardRating) predicate.Flashcard {
+	return predicate.Flashcard(func(s *sql.Selector) {
+		step := newRatingStep()
 		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
 			for _, p := range preds {
 				p(s)
@@ -13,7 +13,15 @@ import (
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
 // FlashcardCreate is the builder for creating a Flashcard entity.
@@ -49,12 +57,218 @@ func (_c *FlashcardCreate) SetNillableType(v *string) *FlashcardCreate {
 	return _c
 }
 
+// SetOptions sets the "options" field.
+func (_c *FlashcardCreate) SetOptions(v []string) *FlashcardCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetPairs sets the "pairs" field.
+func (_c *FlashcardCreate) SetPairs(v []schema.MatchPair) *FlashcardCreate {
+	_c.mutation.SetPairs(v)
+	return _c
+}
+
+// SetOcclusion sets the "occlusion" field.
+func (_c *FlashcardCreate) SetOcclusion(v *schema.ImageOcclusion) *FlashcardCreate {
+	_c.mutation.SetOcclusion(v)
+	return _c
+}
+
+// SetContentFormat sets the "content_format" field.
+func (_c *FlashcardCreate) SetContentFormat(v string) *FlashcardCreate {
+	_c.mutation.SetContentFormat(v)
+	return _c
+}
+
+// SetNillableContentFormat sets the "content_format" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableContentFormat(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetContentFormat(*v)
+	}
+	return _c
+}
+
+// SetQuestionHTML sets the "question_html" field.
+func (_c *FlashcardCreate) SetQuestionHTML(v string) *FlashcardCreate {
+	_c.mutation.SetQuestionHTML(v)
+	return _c
+}
+
+// SetNillableQuestionHTML sets the "question_html" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableQuestionHTML(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetQuestionHTML(*v)
+	}
+	return _c
+}
+
+// SetAnswerHTML sets the "answer_html" field.
+func (_c *FlashcardCreate) SetAnswerHTML(v string) *FlashcardCreate {
+	_c.mutation.SetAnswerHTML(v)
+	return _c
+}
+
+// SetNillableAnswerHTML sets the "answer_html" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableAnswerHTML(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetAnswerHTML(*v)
+	}
+	return _c
+}
+
+// SetHint sets the "hint" field.
+func (_c *FlashcardCreate) SetHint(v string) *FlashcardCreate {
+	_c.mutation.SetHint(v)
+	return _c
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableHint(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetHint(*v)
+	}
+	return _c
+}
+
+// SetHintHTML sets the "hint_html" field.
+func (_c *FlashcardCreate) SetHintHTML(v string) *FlashcardCreate {
+	_c.mutation.SetHintHTML(v)
+	return _c
+}
+
+// SetNillableHintHTML sets the "hint_html" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableHintHTML(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetHintHTML(*v)
+	}
+	return _c
+}
+
+// SetExplanation sets the "explanation" field.
+func (_c *FlashcardCreate) SetExplanation(v string) *FlashcardCreate {
+	_c.mutation.SetExplanation(v)
+	return _c
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableExplanation(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetExplanation(*v)
+	}
+	return _c
+}
+
+// SetExplanationHTML sets the "explanation_html" field.
+func (_c *FlashcardCreate) SetExplanationHTML(v string) *FlashcardCreate {
+	_c.mutation.SetExplanationHTML(v)
+	return _c
+}
+
+// SetNillableExplanationHTML sets the "explanation_html" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableExplanationHTML(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetExplanationHTML(*v)
+	}
+	return _c
+}
+
+// SetSources sets the "sources" field.
+func (_c *FlashcardCreate) SetSources(v []schema.SourceReference) *FlashcardCreate {
+	_c.mutation.SetSources(v)
+	return _c
+}
+
+// SetQuestionKey sets the "question_key" field.
+func (_c *FlashcardCreate) SetQuestionKey(v string) *FlashcardCreate {
+	_c.mutation.SetQuestionKey(v)
+	return _c
+}
+
+// SetNillableQuestionKey sets the "question_key" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableQuestionKey(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetQuestionKey(*v)
+	}
+	return _c
+}
+
+// SetQuestionText sets the "question_text" field.
+func (_c *FlashcardCreate) SetQuestionText(v string) *FlashcardCreate {
+	_c.mutation.SetQuestionText(v)
+	return _c
+}
+
+// SetNillableQuestionText sets the "question_text" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableQuestionText(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetQuestionText(*v)
+	}
+	return _c
+}
+
+// SetAnswerText sets the "answer_text" field.
+func (_c *FlashcardCreate) SetAnswerText(v string) *FlashcardCreate {
+	_c.mutation.SetAnswerText(v)
+	return _c
+}
+
+// SetNillableAnswerText sets the "answer_text" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableAnswerText(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetAnswerText(*v)
+	}
+	return _c
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (_c *FlashcardCreate) SetSearchVector(v string) *FlashcardCreate {
+	_c.mutation.SetSearchVector(v)
+	return _c
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableSearchVector(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetSearchVector(*v)
+	}
+	return _c
+}
+
 // SetCollectionID sets the "collection_id" field.
 func (_c *FlashcardCreate) SetCollectionID(v uuid.UUID) *FlashcardCreate {
 	_c.mutation.SetCollectionID(v)
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *FlashcardCreate) SetStatus(v flashcard.Status) *FlashcardCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableStatus(v *flashcard.Status) *FlashcardCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *FlashcardCreate) SetPosition(v float64) *FlashcardCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillablePosition(v *float64) *FlashcardCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_c *FlashcardCreate) SetCreatedBy(v string) *FlashcardCreate {
 	_c.mutation.SetCreatedBy(v)
@@ -89,6 +303,34 @@ func (_c *FlashcardCreate) SetNillableUpdatedAt(v *time.Time) *FlashcardCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FlashcardCreate) SetDeletedAt(v time.Time) *FlashcardCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableDeletedAt(v *time.Time) *FlashcardCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *FlashcardCreate) SetDeletedBy(v string) *FlashcardCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableDeletedBy(v *string) *FlashcardCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FlashcardCreate) SetID(v uuid.UUID) *FlashcardCreate {
 	_c.mutation.SetID(v)
@@ -123,6 +365,115 @@ func (_c *FlashcardCreate) AddReviews(v ...*FlashcardReview) *FlashcardCreate {
 	return _c.AddReviewIDs(ids...)
 }
 
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (_c *FlashcardCreate) AddMediumIDs(ids ...uuid.UUID) *FlashcardCreate {
+	_c.mutation.AddMediumIDs(ids...)
+	return _c
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (_c *FlashcardCreate) AddMedia(v ...*Media) *FlashcardCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMediumIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the FlashcardTag entity by IDs.
+func (_c *FlashcardCreate) AddTagIDs(ids ...uuid.UUID) *FlashcardCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the FlashcardTag entity.
+func (_c *FlashcardCreate) AddTags(v ...*FlashcardTag) *FlashcardCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the FlashcardRevision entity by IDs.
+func (_c *FlashcardCreate) AddRevisionIDs(ids ...uuid.UUID) *FlashcardCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the FlashcardRevision entity.
+func (_c *FlashcardCreate) AddRevisions(v ...*FlashcardRevision) *FlashcardCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the FlashcardAnnotation entity by IDs.
+func (_c *FlashcardCreate) AddAnnotationIDs(ids ...uuid.UUID) *FlashcardCreate {
+	_c.mutation.AddAnnotationIDs(ids...)
+	return _c
+}
+
+// AddAnnotations adds the "annotations" edges to the FlashcardAnnotation entity.
+func (_c *FlashcardCreate) AddAnnotations(v ...*FlashcardAnnotation) *FlashcardCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnnotationIDs(ids...)
+}
+
+// AddQuizAnswerIDs adds the "quiz_answers" edge to the QuizAnswer entity by IDs.
+func (_c *FlashcardCreate) AddQuizAnswerIDs(ids ...uuid.UUID) *FlashcardCreate {
+	_c.mutation.AddQuizAnswerIDs(ids...)
+	return _c
+}
+
+// AddQuizAnswers adds the "quiz_answers" edges to the QuizAnswer entity.
+func (_c *FlashcardCreate) AddQuizAnswers(v ...*QuizAnswer) *FlashcardCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuizAnswerIDs(ids...)
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (_c *FlashcardCreate) AddItemStatisticIDs(ids ...uuid.UUID) *FlashcardCreate {
+	_c.mutation.AddItemStatisticIDs(ids...)
+	return _c
+}
+
+// AddItemStatistics adds the "item_statistics" edges to the ItemStatistic entity.
+func (_c *FlashcardCreate) AddItemStatistics(v ...*ItemStatistic) *FlashcardCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemStatisticIDs(ids...)
+}
+
+// SetRatingID sets the "rating" edge to the FlashcardRating entity by ID.
+func (_c *FlashcardCreate) SetRatingID(id uuid.UUID) *FlashcardCreate {
+	_c.mutation.SetRatingID(id)
+	return _c
+}
+
+// SetNillableRatingID sets the "rating" edge to the FlashcardRating entity by ID if the given value is not nil.
+func (_c *FlashcardCreate) SetNillableRatingID(id *uuid.UUID) *FlashcardCreate {
+	if id != nil {
+		_c = _c.SetRatingID(*id)
+	}
+	return _c
+}
+
+// SetRating sets the "rating" edge to the FlashcardRating entity.
+func (_c *FlashcardCreate) SetRating(v *FlashcardRating) *FlashcardCreate {
+	return _c.SetRatingID(v.ID)
+}
+
 // Mutation returns the FlashcardMutation object of the builder.
 func (_c *FlashcardCreate) Mutation() *FlashcardMutation {
 	return _c.mutation
@@ -162,6 +513,46 @@ func (_c *FlashcardCreate) defaults() {
 		v := flashcard.DefaultType
 		_c.mutation.SetType(v)
 	}
+	if _, ok := _c.mutation.ContentFormat(); !ok {
+		v := flashcard.DefaultContentFormat
+		_c.mutation.SetContentFormat(v)
+	}
+	if _, ok := _c.mutation.QuestionHTML(); !ok {
+		v := flashcard.DefaultQuestionHTML
+		_c.mutation.SetQuestionHTML(v)
+	}
+	if _, ok := _c.mutation.AnswerHTML(); !ok {
+		v := flashcard.DefaultAnswerHTML
+		_c.mutation.SetAnswerHTML(v)
+	}
+	if _, ok := _c.mutation.Hint(); !ok {
+		v := flashcard.DefaultHint
+		_c.mutation.SetHint(v)
+	}
+	if _, ok := _c.mutation.HintHTML(); !ok {
+		v := flashcard.DefaultHintHTML
+		_c.mutation.SetHintHTML(v)
+	}
+	if _, ok := _c.mutation.Explanation(); !ok {
+		v := flashcard.DefaultExplanation
+		_c.mutation.SetExplanation(v)
+	}
+	if _, ok := _c.mutation.ExplanationHTML(); !ok {
+		v := flashcard.DefaultExplanationHTML
+		_c.mutation.SetExplanationHTML(v)
+	}
+	if _, ok := _c.mutation.QuestionKey(); !ok {
+		v := flashcard.DefaultQuestionKey
+		_c.mutation.SetQuestionKey(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := flashcard.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := flashcard.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := flashcard.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -202,9 +593,28 @@ func (_c *FlashcardCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Flashcard.type": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ContentFormat(); !ok {
+		return &ValidationError{Name: "content_format", err: errors.New(`ent: missing required field "Flashcard.content_format"`)}
+	}
+	if v, ok := _c.mutation.ContentFormat(); ok {
+		if err := flashcard.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "Flashcard.content_format": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CollectionID(); !ok {
 		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "Flashcard.collection_id"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Flashcard.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := flashcard.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Flashcard.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Flashcard.position"`)}
+	}
 	if _, ok := _c.mutation.CreatedBy(); !ok {
 		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Flashcard.created_by"`)}
 	}
@@ -219,6 +629,11 @@ func (_c *FlashcardCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Flashcard.updated_at"`)}
 	}
+	if v, ok := _c.mutation.DeletedBy(); ok {
+		if err := flashcard.DeletedByValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`ent: validator failed for field "Flashcard.deleted_by": %w`, err)}
+		}
+	}
 	if len(_c.mutation.CollectionIDs()) == 0 {
 		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required edge "Flashcard.collection"`)}
 	}
@@ -269,6 +684,74 @@ func (_c *FlashcardCreate) createSpec() (*Flashcard, *sqlgraph.CreateSpec) {
 		_spec.SetField(flashcard.FieldType, field.TypeString, value)
 		_node.Type = value
 	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(flashcard.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.Pairs(); ok {
+		_spec.SetField(flashcard.FieldPairs, field.TypeJSON, value)
+		_node.Pairs = value
+	}
+	if value, ok := _c.mutation.Occlusion(); ok {
+		_spec.SetField(flashcard.FieldOcclusion, field.TypeJSON, value)
+		_node.Occlusion = value
+	}
+	if value, ok := _c.mutation.ContentFormat(); ok {
+		_spec.SetField(flashcard.FieldContentFormat, field.TypeString, value)
+		_node.ContentFormat = value
+	}
+	if value, ok := _c.mutation.QuestionHTML(); ok {
+		_spec.SetField(flashcard.FieldQuestionHTML, field.TypeString, value)
+		_node.QuestionHTML = value
+	}
+	if value, ok := _c.mutation.AnswerHTML(); ok {
+		_spec.SetField(flashcard.FieldAnswerHTML, field.TypeString, value)
+		_node.AnswerHTML = value
+	}
+	if value, ok := _c.mutation.Hint(); ok {
+		_spec.SetField(flashcard.FieldHint, field.TypeString, value)
+		_node.Hint = value
+	}
+	if value, ok := _c.mutation.HintHTML(); ok {
+		_spec.SetField(flashcard.FieldHintHTML, field.TypeString, value)
+		_node.HintHTML = value
+	}
+	if value, ok := _c.mutation.Explanation(); ok {
+		_spec.SetField(flashcard.FieldExplanation, field.TypeString, value)
+		_node.Explanation = value
+	}
+	if value, ok := _c.mutation.ExplanationHTML(); ok {
+		_spec.SetField(flashcard.FieldExplanationHTML, field.TypeString, value)
+		_node.ExplanationHTML = value
+	}
+	if value, ok := _c.mutation.Sources(); ok {
+		_spec.SetField(flashcard.FieldSources, field.TypeJSON, value)
+		_node.Sources = value
+	}
+	if value, ok := _c.mutation.QuestionKey(); ok {
+		_spec.SetField(flashcard.FieldQuestionKey, field.TypeString, value)
+		_node.QuestionKey = value
+	}
+	if value, ok := _c.mutation.QuestionText(); ok {
+		_spec.SetField(flashcard.FieldQuestionText, field.TypeString, value)
+		_node.QuestionText = &value
+	}
+	if value, ok := _c.mutation.AnswerText(); ok {
+		_spec.SetField(flashcard.FieldAnswerText, field.TypeString, value)
+		_node.AnswerText = &value
+	}
+	if value, ok := _c.mutation.SearchVector(); ok {
+		_spec.SetField(flashcard.FieldSearchVector, field.TypeString, value)
+		_node.SearchVector = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(flashcard.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(flashcard.FieldPosition, field.TypeFloat64, value)
+		_node.Position = value
+	}
 	if value, ok := _c.mutation.CreatedBy(); ok {
 		_spec.SetField(flashcard.FieldCreatedBy, field.TypeString, value)
 		_node.CreatedBy = value
@@ -281,6 +764,14 @@ func (_c *FlashcardCreate) createSpec() (*Flashcard, *sqlgraph.CreateSpec) {
 		_spec.SetField(flashcard.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(flashcard.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(flashcard.FieldDeletedBy, field.TypeString, value)
+		_node.DeletedBy = value
+	}
 	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -314,6 +805,118 @@ func (_c *FlashcardCreate) createSpec() (*Flashcard, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AnnotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuizAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemStatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RatingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   flashcard.RatingTable,
+			Columns: []string{flashcard.RatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,25 +9,41 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
 	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
 )
 
 // FlashcardQuery is the builder for querying Flashcard entities.
 type FlashcardQuery struct {
 	config
-	ctx            *QueryContext
-	order          []flashcard.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Flashcard
-	withCollection *CollectionQuery
-	withReviews    *FlashcardReviewQuery
+	ctx                *QueryContext
+	order              []flashcard.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.Flashcard
+	withCollection     *CollectionQuery
+	withReviews        *FlashcardReviewQuery
+	withMedia          *MediaQuery
+	withTags           *FlashcardTagQuery
+	withRevisions      *FlashcardRevisionQuery
+	withAnnotations    *FlashcardAnnotationQuery
+	withQuizAnswers    *QuizAnswerQuery
+	withItemStatistics *ItemStatisticQuery
+	withRating         *FlashcardRatingQuery
+	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +124,160 @@ func (_q *FlashcardQuery) QueryReviews() *FlashcardReviewQuery {
 	return query
 }
 
+// QueryMedia chains the current query on the "media" edge.
+func (_q *FlashcardQuery) QueryMedia() *MediaQuery {
+	query := (&MediaClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, flashcard.MediaTable, flashcard.MediaPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *FlashcardQuery) QueryTags() *FlashcardTagQuery {
+	query := (&FlashcardTagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(flashcardtag.Table, flashcardtag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.TagsTable, flashcard.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *FlashcardQuery) QueryRevisions() *FlashcardRevisionQuery {
+	query := (&FlashcardRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(flashcardrevision.Table, flashcardrevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.RevisionsTable, flashcard.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAnnotations chains the current query on the "annotations" edge.
+func (_q *FlashcardQuery) QueryAnnotations() *FlashcardAnnotationQuery {
+	query := (&FlashcardAnnotationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(flashcardannotation.Table, flashcardannotation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.AnnotationsTable, flashcard.AnnotationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryQuizAnswers chains the current query on the "quiz_answers" edge.
+func (_q *FlashcardQuery) QueryQuizAnswers() *QuizAnswerQuery {
+	query := (&QuizAnswerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(quizanswer.Table, quizanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.QuizAnswersTable, flashcard.QuizAnswersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItemStatistics chains the current query on the "item_statistics" edge.
+func (_q *FlashcardQuery) QueryItemStatistics() *ItemStatisticQuery {
+	query := (&ItemStatisticClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(itemstatistic.Table, itemstatistic.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcard.ItemStatisticsTable, flashcard.ItemStatisticsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRating chains the current query on the "rating" edge.
+func (_q *FlashcardQuery) QueryRating() *FlashcardRatingQuery {
+	query := (&FlashcardRatingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcard.Table, flashcard.FieldID, selector),
+			sqlgraph.To(flashcardrating.Table, flashcardrating.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, flashcard.RatingTable, flashcard.RatingColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Flashcard entity from the query.
 // Returns a *NotFoundError when no Flashcard was found.
 func (_q *FlashcardQuery) First(ctx context.Context) (*Flashcard, error) {
@@ -295,16 +465,24 @@ func (_q *FlashcardQuery) Clone() *FlashcardQuery {
 		return nil
 	}
 	return &FlashcardQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]flashcard.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Flashcard{}, _q.predicates...),
-		withCollection: _q.withCollection.Clone(),
-		withReviews:    _q.withReviews.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]flashcard.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.Flashcard{}, _q.predicates...),
+		withCollection:     _q.withCollection.Clone(),
+		withReviews:        _q.withReviews.Clone(),
+		withMedia:          _q.withMedia.Clone(),
+		withTags:           _q.withTags.Clone(),
+		withRevisions:      _q.withRevisions.Clone(),
+		withAnnotations:    _q.withAnnotations.Clone(),
+		withQuizAnswers:    _q.withQuizAnswers.Clone(),
+		withItemStatistics: _q.withItemStatistics.Clone(),
+		withRating:         _q.withRating.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -330,6 +508,83 @@ func (_q *FlashcardQuery) WithReviews(opts ...func(*FlashcardReviewQuery)) *Flas
 	return _q
 }
 
+// WithMedia tells the query-builder to eager-load the nodes that are connected to
+// the "media" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithMedia(opts ...func(*MediaQuery)) *FlashcardQuery {
+	query := (&MediaClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMedia = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithTags(opts ...func(*FlashcardTagQuery)) *FlashcardQuery {
+	query := (&FlashcardTagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithRevisions(opts ...func(*FlashcardRevisionQuery)) *FlashcardQuery {
+	query := (&FlashcardRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithAnnotations tells the query-builder to eager-load the nodes that are connected to
+// the "annotations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithAnnotations(opts ...func(*FlashcardAnnotationQuery)) *FlashcardQuery {
+	query := (&FlashcardAnnotationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAnnotations = query
+	return _q
+}
+
+// WithQuizAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "quiz_answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithQuizAnswers(opts ...func(*QuizAnswerQuery)) *FlashcardQuery {
+	query := (&QuizAnswerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuizAnswers = query
+	return _q
+}
+
+// WithItemStatistics tells the query-builder to eager-load the nodes that are connected to
+// the "item_statistics" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithItemStatistics(opts ...func(*ItemStatisticQuery)) *FlashcardQuery {
+	query := (&ItemStatisticClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItemStatistics = query
+	return _q
+}
+
+// WithRating tells the query-builder to eager-load the nodes that are connected to
+// the "rating" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardQuery) WithRating(opts ...func(*FlashcardRatingQuery)) *FlashcardQuery {
+	query := (&FlashcardRatingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRating = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -408,9 +663,16 @@ func (_q *FlashcardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fl
 	var (
 		nodes       = []*Flashcard{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [9]bool{
 			_q.withCollection != nil,
 			_q.withReviews != nil,
+			_q.withMedia != nil,
+			_q.withTags != nil,
+			_q.withRevisions != nil,
+			_q.withAnnotations != nil,
+			_q.withQuizAnswers != nil,
+			_q.withItemStatistics != nil,
+			_q.withRating != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -422,6 +684,9 @@ func (_q *FlashcardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fl
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -444,6 +709,54 @@ func (_q *FlashcardQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Fl
 			return nil, err
 		}
 	}
+	if query := _q.withMedia; query != nil {
+		if err := _q.loadMedia(ctx, query, nodes,
+			func(n *Flashcard) { n.Edges.Media = []*Media{} },
+			func(n *Flashcard, e *Media) { n.Edges.Media = append(n.Edges.Media, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Flashcard) { n.Edges.Tags = []*FlashcardTag{} },
+			func(n *Flashcard, e *FlashcardTag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Flashcard) { n.Edges.Revisions = []*FlashcardRevision{} },
+			func(n *Flashcard, e *FlashcardRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAnnotations; query != nil {
+		if err := _q.loadAnnotations(ctx, query, nodes,
+			func(n *Flashcard) { n.Edges.Annotations = []*FlashcardAnnotation{} },
+			func(n *Flashcard, e *FlashcardAnnotation) { n.Edges.Annotations = append(n.Edges.Annotations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withQuizAnswers; query != nil {
+		if err := _q.loadQuizAnswers(ctx, query, nodes,
+			func(n *Flashcard) { n.Edges.QuizAnswers = []*QuizAnswer{} },
+			func(n *Flashcard, e *QuizAnswer) { n.Edges.QuizAnswers = append(n.Edges.QuizAnswers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withItemStatistics; query != nil {
+		if err := _q.loadItemStatistics(ctx, query, nodes,
+			func(n *Flashcard) { n.Edges.ItemStatistics = []*ItemStatistic{} },
+			func(n *Flashcard, e *ItemStatistic) { n.Edges.ItemStatistics = append(n.Edges.ItemStatistics, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRating; query != nil {
+		if err := _q.loadRating(ctx, query, nodes, nil,
+			func(n *Flashcard, e *FlashcardRating) { n.Edges.Rating = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -506,9 +819,250 @@ func (_q *FlashcardQuery) loadReviews(ctx context.Context, query *FlashcardRevie
 	}
 	return nil
 }
+func (_q *FlashcardQuery) loadMedia(ctx context.Context, query *MediaQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *Media)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Flashcard)
+	nids := make(map[uuid.UUID]map[*Flashcard]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(flashcard.MediaTable)
+		s.Join(joinT).On(s.C(media.FieldID), joinT.C(flashcard.MediaPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(flashcard.MediaPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(flashcard.MediaPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Flashcard]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Media](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "media" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *FlashcardQuery) loadTags(ctx context.Context, query *FlashcardTagQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *FlashcardTag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Flashcard)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(flashcardtag.FieldFlashcardID)
+	}
+	query.Where(predicate.FlashcardTag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcard.TagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlashcardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flashcard_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlashcardQuery) loadRevisions(ctx context.Context, query *FlashcardRevisionQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *FlashcardRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Flashcard)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(flashcardrevision.FieldFlashcardID)
+	}
+	query.Where(predicate.FlashcardRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcard.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlashcardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flashcard_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlashcardQuery) loadAnnotations(ctx context.Context, query *FlashcardAnnotationQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *FlashcardAnnotation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Flashcard)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(flashcardannotation.FieldFlashcardID)
+	}
+	query.Where(predicate.FlashcardAnnotation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcard.AnnotationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlashcardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flashcard_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlashcardQuery) loadQuizAnswers(ctx context.Context, query *QuizAnswerQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *QuizAnswer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Flashcard)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(quizanswer.FieldFlashcardID)
+	}
+	query.Where(predicate.QuizAnswer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcard.QuizAnswersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlashcardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flashcard_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlashcardQuery) loadItemStatistics(ctx context.Context, query *ItemStatisticQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *ItemStatistic)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Flashcard)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(itemstatistic.FieldFlashcardID)
+	}
+	query.Where(predicate.ItemStatistic(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcard.ItemStatisticsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlashcardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flashcard_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlashcardQuery) loadRating(ctx context.Context, query *FlashcardRatingQuery, nodes []*Flashcard, init func(*Flashcard), assign func(*Flashcard, *FlashcardRating)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Flashcard)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(flashcardrating.FieldFlashcardID)
+	}
+	query.Where(predicate.FlashcardRating(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcard.RatingColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlashcardID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flashcard_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *FlashcardQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -574,6 +1128,9 @@ func (_q *FlashcardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -591,6 +1148,38 @@ func (_q *FlashcardQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *FlashcardQuery) ForUpdate(opts ...sql.LockOption) *FlashcardQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *FlashcardQuery) ForShare(opts ...sql.LockOption) *FlashcardQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *FlashcardQuery) Modify(modifiers ...func(s *sql.Selector)) *FlashcardSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // FlashcardGroupBy is the group-by builder for Flashcard entities.
 type FlashcardGroupBy struct {
 	selector
@@ -680,3 +1269,9 @@ func (_s *FlashcardSelect) sqlScan(ctx context.Context, root *FlashcardQuery, v
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *FlashcardSelect) Modify(modifiers ...func(s *sql.Selector)) *FlashcardSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
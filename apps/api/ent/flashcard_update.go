@@ -10,19 +10,29 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
 	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
 // FlashcardUpdate is the builder for updating Flashcard entities.
 type FlashcardUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FlashcardMutation
+	hooks     []Hook
+	mutation  *FlashcardMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FlashcardUpdate builder.
@@ -73,6 +83,286 @@ func (_u *FlashcardUpdate) SetNillableType(v *string) *FlashcardUpdate {
 	return _u
 }
 
+// SetOptions sets the "options" field.
+func (_u *FlashcardUpdate) SetOptions(v []string) *FlashcardUpdate {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *FlashcardUpdate) AppendOptions(v []string) *FlashcardUpdate {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *FlashcardUpdate) ClearOptions() *FlashcardUpdate {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetPairs sets the "pairs" field.
+func (_u *FlashcardUpdate) SetPairs(v []schema.MatchPair) *FlashcardUpdate {
+	_u.mutation.SetPairs(v)
+	return _u
+}
+
+// AppendPairs appends value to the "pairs" field.
+func (_u *FlashcardUpdate) AppendPairs(v []schema.MatchPair) *FlashcardUpdate {
+	_u.mutation.AppendPairs(v)
+	return _u
+}
+
+// ClearPairs clears the value of the "pairs" field.
+func (_u *FlashcardUpdate) ClearPairs() *FlashcardUpdate {
+	_u.mutation.ClearPairs()
+	return _u
+}
+
+// SetOcclusion sets the "occlusion" field.
+func (_u *FlashcardUpdate) SetOcclusion(v *schema.ImageOcclusion) *FlashcardUpdate {
+	_u.mutation.SetOcclusion(v)
+	return _u
+}
+
+// ClearOcclusion clears the value of the "occlusion" field.
+func (_u *FlashcardUpdate) ClearOcclusion() *FlashcardUpdate {
+	_u.mutation.ClearOcclusion()
+	return _u
+}
+
+// SetContentFormat sets the "content_format" field.
+func (_u *FlashcardUpdate) SetContentFormat(v string) *FlashcardUpdate {
+	_u.mutation.SetContentFormat(v)
+	return _u
+}
+
+// SetNillableContentFormat sets the "content_format" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableContentFormat(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetContentFormat(*v)
+	}
+	return _u
+}
+
+// SetQuestionHTML sets the "question_html" field.
+func (_u *FlashcardUpdate) SetQuestionHTML(v string) *FlashcardUpdate {
+	_u.mutation.SetQuestionHTML(v)
+	return _u
+}
+
+// SetNillableQuestionHTML sets the "question_html" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableQuestionHTML(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetQuestionHTML(*v)
+	}
+	return _u
+}
+
+// ClearQuestionHTML clears the value of the "question_html" field.
+func (_u *FlashcardUpdate) ClearQuestionHTML() *FlashcardUpdate {
+	_u.mutation.ClearQuestionHTML()
+	return _u
+}
+
+// SetAnswerHTML sets the "answer_html" field.
+func (_u *FlashcardUpdate) SetAnswerHTML(v string) *FlashcardUpdate {
+	_u.mutation.SetAnswerHTML(v)
+	return _u
+}
+
+// SetNillableAnswerHTML sets the "answer_html" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableAnswerHTML(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetAnswerHTML(*v)
+	}
+	return _u
+}
+
+// ClearAnswerHTML clears the value of the "answer_html" field.
+func (_u *FlashcardUpdate) ClearAnswerHTML() *FlashcardUpdate {
+	_u.mutation.ClearAnswerHTML()
+	return _u
+}
+
+// SetHint sets the "hint" field.
+func (_u *FlashcardUpdate) SetHint(v string) *FlashcardUpdate {
+	_u.mutation.SetHint(v)
+	return _u
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableHint(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetHint(*v)
+	}
+	return _u
+}
+
+// ClearHint clears the value of the "hint" field.
+func (_u *FlashcardUpdate) ClearHint() *FlashcardUpdate {
+	_u.mutation.ClearHint()
+	return _u
+}
+
+// SetHintHTML sets the "hint_html" field.
+func (_u *FlashcardUpdate) SetHintHTML(v string) *FlashcardUpdate {
+	_u.mutation.SetHintHTML(v)
+	return _u
+}
+
+// SetNillableHintHTML sets the "hint_html" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableHintHTML(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetHintHTML(*v)
+	}
+	return _u
+}
+
+// ClearHintHTML clears the value of the "hint_html" field.
+func (_u *FlashcardUpdate) ClearHintHTML() *FlashcardUpdate {
+	_u.mutation.ClearHintHTML()
+	return _u
+}
+
+// SetExplanation sets the "explanation" field.
+func (_u *FlashcardUpdate) SetExplanation(v string) *FlashcardUpdate {
+	_u.mutation.SetExplanation(v)
+	return _u
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableExplanation(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetExplanation(*v)
+	}
+	return _u
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (_u *FlashcardUpdate) ClearExplanation() *FlashcardUpdate {
+	_u.mutation.ClearExplanation()
+	return _u
+}
+
+// SetExplanationHTML sets the "explanation_html" field.
+func (_u *FlashcardUpdate) SetExplanationHTML(v string) *FlashcardUpdate {
+	_u.mutation.SetExplanationHTML(v)
+	return _u
+}
+
+// SetNillableExplanationHTML sets the "explanation_html" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableExplanationHTML(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetExplanationHTML(*v)
+	}
+	return _u
+}
+
+// ClearExplanationHTML clears the value of the "explanation_html" field.
+func (_u *FlashcardUpdate) ClearExplanationHTML() *FlashcardUpdate {
+	_u.mutation.ClearExplanationHTML()
+	return _u
+}
+
+// SetSources sets the "sources" field.
+func (_u *FlashcardUpdate) SetSources(v []schema.SourceReference) *FlashcardUpdate {
+	_u.mutation.SetSources(v)
+	return _u
+}
+
+// AppendSources appends value to the "sources" field.
+func (_u *FlashcardUpdate) AppendSources(v []schema.SourceReference) *FlashcardUpdate {
+	_u.mutation.AppendSources(v)
+	return _u
+}
+
+// ClearSources clears the value of the "sources" field.
+func (_u *FlashcardUpdate) ClearSources() *FlashcardUpdate {
+	_u.mutation.ClearSources()
+	return _u
+}
+
+// SetQuestionKey sets the "question_key" field.
+func (_u *FlashcardUpdate) SetQuestionKey(v string) *FlashcardUpdate {
+	_u.mutation.SetQuestionKey(v)
+	return _u
+}
+
+// SetNillableQuestionKey sets the "question_key" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableQuestionKey(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetQuestionKey(*v)
+	}
+	return _u
+}
+
+// ClearQuestionKey clears the value of the "question_key" field.
+func (_u *FlashcardUpdate) ClearQuestionKey() *FlashcardUpdate {
+	_u.mutation.ClearQuestionKey()
+	return _u
+}
+
+// SetQuestionText sets the "question_text" field.
+func (_u *FlashcardUpdate) SetQuestionText(v string) *FlashcardUpdate {
+	_u.mutation.SetQuestionText(v)
+	return _u
+}
+
+// SetNillableQuestionText sets the "question_text" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableQuestionText(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetQuestionText(*v)
+	}
+	return _u
+}
+
+// ClearQuestionText clears the value of the "question_text" field.
+func (_u *FlashcardUpdate) ClearQuestionText() *FlashcardUpdate {
+	_u.mutation.ClearQuestionText()
+	return _u
+}
+
+// SetAnswerText sets the "answer_text" field.
+func (_u *FlashcardUpdate) SetAnswerText(v string) *FlashcardUpdate {
+	_u.mutation.SetAnswerText(v)
+	return _u
+}
+
+// SetNillableAnswerText sets the "answer_text" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableAnswerText(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetAnswerText(*v)
+	}
+	return _u
+}
+
+// ClearAnswerText clears the value of the "answer_text" field.
+func (_u *FlashcardUpdate) ClearAnswerText() *FlashcardUpdate {
+	_u.mutation.ClearAnswerText()
+	return _u
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (_u *FlashcardUpdate) SetSearchVector(v string) *FlashcardUpdate {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableSearchVector(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetSearchVector(*v)
+	}
+	return _u
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *FlashcardUpdate) ClearSearchVector() *FlashcardUpdate {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
 // SetCollectionID sets the "collection_id" field.
 func (_u *FlashcardUpdate) SetCollectionID(v uuid.UUID) *FlashcardUpdate {
 	_u.mutation.SetCollectionID(v)
@@ -87,6 +377,41 @@ func (_u *FlashcardUpdate) SetNillableCollectionID(v *uuid.UUID) *FlashcardUpdat
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *FlashcardUpdate) SetStatus(v flashcard.Status) *FlashcardUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableStatus(v *flashcard.Status) *FlashcardUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *FlashcardUpdate) SetPosition(v float64) *FlashcardUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillablePosition(v *float64) *FlashcardUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *FlashcardUpdate) AddPosition(v float64) *FlashcardUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *FlashcardUpdate) SetCreatedBy(v string) *FlashcardUpdate {
 	_u.mutation.SetCreatedBy(v)
@@ -107,6 +432,46 @@ func (_u *FlashcardUpdate) SetUpdatedAt(v time.Time) *FlashcardUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FlashcardUpdate) SetDeletedAt(v time.Time) *FlashcardUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableDeletedAt(v *time.Time) *FlashcardUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FlashcardUpdate) ClearDeletedAt() *FlashcardUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *FlashcardUpdate) SetDeletedBy(v string) *FlashcardUpdate {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableDeletedBy(v *string) *FlashcardUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *FlashcardUpdate) ClearDeletedBy() *FlashcardUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_u *FlashcardUpdate) SetCollection(v *Collection) *FlashcardUpdate {
 	return _u.SetCollectionID(v.ID)
@@ -127,6 +492,115 @@ func (_u *FlashcardUpdate) AddReviews(v ...*FlashcardReview) *FlashcardUpdate {
 	return _u.AddReviewIDs(ids...)
 }
 
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (_u *FlashcardUpdate) AddMediumIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.AddMediumIDs(ids...)
+	return _u
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (_u *FlashcardUpdate) AddMedia(v ...*Media) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMediumIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the FlashcardTag entity by IDs.
+func (_u *FlashcardUpdate) AddTagIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the FlashcardTag entity.
+func (_u *FlashcardUpdate) AddTags(v ...*FlashcardTag) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the FlashcardRevision entity by IDs.
+func (_u *FlashcardUpdate) AddRevisionIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the FlashcardRevision entity.
+func (_u *FlashcardUpdate) AddRevisions(v ...*FlashcardRevision) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the FlashcardAnnotation entity by IDs.
+func (_u *FlashcardUpdate) AddAnnotationIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.AddAnnotationIDs(ids...)
+	return _u
+}
+
+// AddAnnotations adds the "annotations" edges to the FlashcardAnnotation entity.
+func (_u *FlashcardUpdate) AddAnnotations(v ...*FlashcardAnnotation) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnnotationIDs(ids...)
+}
+
+// AddQuizAnswerIDs adds the "quiz_answers" edge to the QuizAnswer entity by IDs.
+func (_u *FlashcardUpdate) AddQuizAnswerIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.AddQuizAnswerIDs(ids...)
+	return _u
+}
+
+// AddQuizAnswers adds the "quiz_answers" edges to the QuizAnswer entity.
+func (_u *FlashcardUpdate) AddQuizAnswers(v ...*QuizAnswer) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuizAnswerIDs(ids...)
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (_u *FlashcardUpdate) AddItemStatisticIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.AddItemStatisticIDs(ids...)
+	return _u
+}
+
+// AddItemStatistics adds the "item_statistics" edges to the ItemStatistic entity.
+func (_u *FlashcardUpdate) AddItemStatistics(v ...*ItemStatistic) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemStatisticIDs(ids...)
+}
+
+// SetRatingID sets the "rating" edge to the FlashcardRating entity by ID.
+func (_u *FlashcardUpdate) SetRatingID(id uuid.UUID) *FlashcardUpdate {
+	_u.mutation.SetRatingID(id)
+	return _u
+}
+
+// SetNillableRatingID sets the "rating" edge to the FlashcardRating entity by ID if the given value is not nil.
+func (_u *FlashcardUpdate) SetNillableRatingID(id *uuid.UUID) *FlashcardUpdate {
+	if id != nil {
+		_u = _u.SetRatingID(*id)
+	}
+	return _u
+}
+
+// SetRating sets the "rating" edge to the FlashcardRating entity.
+func (_u *FlashcardUpdate) SetRating(v *FlashcardRating) *FlashcardUpdate {
+	return _u.SetRatingID(v.ID)
+}
+
 // Mutation returns the FlashcardMutation object of the builder.
 func (_u *FlashcardUpdate) Mutation() *FlashcardMutation {
 	return _u.mutation
@@ -159,7 +633,139 @@ func (_u *FlashcardUpdate) RemoveReviews(v ...*FlashcardReview) *FlashcardUpdate
 	return _u.RemoveReviewIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
+// ClearMedia clears all "media" edges to the Media entity.
+func (_u *FlashcardUpdate) ClearMedia() *FlashcardUpdate {
+	_u.mutation.ClearMedia()
+	return _u
+}
+
+// RemoveMediumIDs removes the "media" edge to Media entities by IDs.
+func (_u *FlashcardUpdate) RemoveMediumIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.RemoveMediumIDs(ids...)
+	return _u
+}
+
+// RemoveMedia removes "media" edges to Media entities.
+func (_u *FlashcardUpdate) RemoveMedia(v ...*Media) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMediumIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the FlashcardTag entity.
+func (_u *FlashcardUpdate) ClearTags() *FlashcardUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to FlashcardTag entities by IDs.
+func (_u *FlashcardUpdate) RemoveTagIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to FlashcardTag entities.
+func (_u *FlashcardUpdate) RemoveTags(v ...*FlashcardTag) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the FlashcardRevision entity.
+func (_u *FlashcardUpdate) ClearRevisions() *FlashcardUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to FlashcardRevision entities by IDs.
+func (_u *FlashcardUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to FlashcardRevision entities.
+func (_u *FlashcardUpdate) RemoveRevisions(v ...*FlashcardRevision) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearAnnotations clears all "annotations" edges to the FlashcardAnnotation entity.
+func (_u *FlashcardUpdate) ClearAnnotations() *FlashcardUpdate {
+	_u.mutation.ClearAnnotations()
+	return _u
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to FlashcardAnnotation entities by IDs.
+func (_u *FlashcardUpdate) RemoveAnnotationIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.RemoveAnnotationIDs(ids...)
+	return _u
+}
+
+// RemoveAnnotations removes "annotations" edges to FlashcardAnnotation entities.
+func (_u *FlashcardUpdate) RemoveAnnotations(v ...*FlashcardAnnotation) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnnotationIDs(ids...)
+}
+
+// ClearQuizAnswers clears all "quiz_answers" edges to the QuizAnswer entity.
+func (_u *FlashcardUpdate) ClearQuizAnswers() *FlashcardUpdate {
+	_u.mutation.ClearQuizAnswers()
+	return _u
+}
+
+// RemoveQuizAnswerIDs removes the "quiz_answers" edge to QuizAnswer entities by IDs.
+func (_u *FlashcardUpdate) RemoveQuizAnswerIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.RemoveQuizAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveQuizAnswers removes "quiz_answers" edges to QuizAnswer entities.
+func (_u *FlashcardUpdate) RemoveQuizAnswers(v ...*QuizAnswer) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuizAnswerIDs(ids...)
+}
+
+// ClearItemStatistics clears all "item_statistics" edges to the ItemStatistic entity.
+func (_u *FlashcardUpdate) ClearItemStatistics() *FlashcardUpdate {
+	_u.mutation.ClearItemStatistics()
+	return _u
+}
+
+// RemoveItemStatisticIDs removes the "item_statistics" edge to ItemStatistic entities by IDs.
+func (_u *FlashcardUpdate) RemoveItemStatisticIDs(ids ...uuid.UUID) *FlashcardUpdate {
+	_u.mutation.RemoveItemStatisticIDs(ids...)
+	return _u
+}
+
+// RemoveItemStatistics removes "item_statistics" edges to ItemStatistic entities.
+func (_u *FlashcardUpdate) RemoveItemStatistics(v ...*ItemStatistic) *FlashcardUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemStatisticIDs(ids...)
+}
+
+// ClearRating clears the "rating" edge to the FlashcardRating entity.
+func (_u *FlashcardUpdate) ClearRating() *FlashcardUpdate {
+	_u.mutation.ClearRating()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FlashcardUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -212,17 +818,38 @@ func (_u *FlashcardUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Flashcard.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ContentFormat(); ok {
+		if err := flashcard.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "Flashcard.content_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := flashcard.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Flashcard.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.CreatedBy(); ok {
 		if err := flashcard.CreatedByValidator(v); err != nil {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Flashcard.created_by": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeletedBy(); ok {
+		if err := flashcard.DeletedByValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`ent: validator failed for field "Flashcard.deleted_by": %w`, err)}
+		}
+	}
 	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Flashcard.collection"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FlashcardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -244,12 +871,135 @@ func (_u *FlashcardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(flashcard.FieldType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(flashcard.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flashcard.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(flashcard.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Pairs(); ok {
+		_spec.SetField(flashcard.FieldPairs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPairs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flashcard.FieldPairs, value)
+		})
+	}
+	if _u.mutation.PairsCleared() {
+		_spec.ClearField(flashcard.FieldPairs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Occlusion(); ok {
+		_spec.SetField(flashcard.FieldOcclusion, field.TypeJSON, value)
+	}
+	if _u.mutation.OcclusionCleared() {
+		_spec.ClearField(flashcard.FieldOcclusion, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ContentFormat(); ok {
+		_spec.SetField(flashcard.FieldContentFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuestionHTML(); ok {
+		_spec.SetField(flashcard.FieldQuestionHTML, field.TypeString, value)
+	}
+	if _u.mutation.QuestionHTMLCleared() {
+		_spec.ClearField(flashcard.FieldQuestionHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnswerHTML(); ok {
+		_spec.SetField(flashcard.FieldAnswerHTML, field.TypeString, value)
+	}
+	if _u.mutation.AnswerHTMLCleared() {
+		_spec.ClearField(flashcard.FieldAnswerHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hint(); ok {
+		_spec.SetField(flashcard.FieldHint, field.TypeString, value)
+	}
+	if _u.mutation.HintCleared() {
+		_spec.ClearField(flashcard.FieldHint, field.TypeString)
+	}
+	if value, ok := _u.mutation.HintHTML(); ok {
+		_spec.SetField(flashcard.FieldHintHTML, field.TypeString, value)
+	}
+	if _u.mutation.HintHTMLCleared() {
+		_spec.ClearField(flashcard.FieldHintHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.Explanation(); ok {
+		_spec.SetField(flashcard.FieldExplanation, field.TypeString, value)
+	}
+	if _u.mutation.ExplanationCleared() {
+		_spec.ClearField(flashcard.FieldExplanation, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExplanationHTML(); ok {
+		_spec.SetField(flashcard.FieldExplanationHTML, field.TypeString, value)
+	}
+	if _u.mutation.ExplanationHTMLCleared() {
+		_spec.ClearField(flashcard.FieldExplanationHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sources(); ok {
+		_spec.SetField(flashcard.FieldSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flashcard.FieldSources, value)
+		})
+	}
+	if _u.mutation.SourcesCleared() {
+		_spec.ClearField(flashcard.FieldSources, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QuestionKey(); ok {
+		_spec.SetField(flashcard.FieldQuestionKey, field.TypeString, value)
+	}
+	if _u.mutation.QuestionKeyCleared() {
+		_spec.ClearField(flashcard.FieldQuestionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuestionText(); ok {
+		_spec.SetField(flashcard.FieldQuestionText, field.TypeString, value)
+	}
+	if _u.mutation.QuestionTextCleared() {
+		_spec.ClearField(flashcard.FieldQuestionText, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnswerText(); ok {
+		_spec.SetField(flashcard.FieldAnswerText, field.TypeString, value)
+	}
+	if _u.mutation.AnswerTextCleared() {
+		_spec.ClearField(flashcard.FieldAnswerText, field.TypeString)
+	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(flashcard.FieldSearchVector, field.TypeString, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(flashcard.FieldSearchVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(flashcard.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(flashcard.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(flashcard.FieldPosition, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(flashcard.FieldCreatedBy, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(flashcard.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(flashcard.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(flashcard.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(flashcard.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(flashcard.FieldDeletedBy, field.TypeString)
+	}
 	if _u.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -324,68 +1074,649 @@ func (_u *FlashcardUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{flashcard.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	if _u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMediaIDs(); len(nodes) > 0 && !_u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnnotationsIDs(); len(nodes) > 0 && !_u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnnotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuizAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuizAnswersIDs(); len(nodes) > 0 && !_u.mutation.QuizAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemStatisticsIDs(); len(nodes) > 0 && !_u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemStatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RatingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   flashcard.RatingTable,
+			Columns: []string{flashcard.RatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RatingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   flashcard.RatingTable,
+			Columns: []string{flashcard.RatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcard.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FlashcardUpdateOne is the builder for updating a single Flashcard entity.
+type FlashcardUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FlashcardMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuestion sets the "question" field.
+func (_u *FlashcardUpdateOne) SetQuestion(v string) *FlashcardUpdateOne {
+	_u.mutation.SetQuestion(v)
+	return _u
+}
+
+// SetNillableQuestion sets the "question" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableQuestion(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetQuestion(*v)
+	}
+	return _u
+}
+
+// SetAnswer sets the "answer" field.
+func (_u *FlashcardUpdateOne) SetAnswer(v string) *FlashcardUpdateOne {
+	_u.mutation.SetAnswer(v)
+	return _u
+}
+
+// SetNillableAnswer sets the "answer" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableAnswer(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetAnswer(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *FlashcardUpdateOne) SetType(v string) *FlashcardUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableType(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *FlashcardUpdateOne) SetOptions(v []string) *FlashcardUpdateOne {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *FlashcardUpdateOne) AppendOptions(v []string) *FlashcardUpdateOne {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *FlashcardUpdateOne) ClearOptions() *FlashcardUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetPairs sets the "pairs" field.
+func (_u *FlashcardUpdateOne) SetPairs(v []schema.MatchPair) *FlashcardUpdateOne {
+	_u.mutation.SetPairs(v)
+	return _u
+}
+
+// AppendPairs appends value to the "pairs" field.
+func (_u *FlashcardUpdateOne) AppendPairs(v []schema.MatchPair) *FlashcardUpdateOne {
+	_u.mutation.AppendPairs(v)
+	return _u
+}
+
+// ClearPairs clears the value of the "pairs" field.
+func (_u *FlashcardUpdateOne) ClearPairs() *FlashcardUpdateOne {
+	_u.mutation.ClearPairs()
+	return _u
+}
+
+// SetOcclusion sets the "occlusion" field.
+func (_u *FlashcardUpdateOne) SetOcclusion(v *schema.ImageOcclusion) *FlashcardUpdateOne {
+	_u.mutation.SetOcclusion(v)
+	return _u
+}
+
+// ClearOcclusion clears the value of the "occlusion" field.
+func (_u *FlashcardUpdateOne) ClearOcclusion() *FlashcardUpdateOne {
+	_u.mutation.ClearOcclusion()
+	return _u
+}
+
+// SetContentFormat sets the "content_format" field.
+func (_u *FlashcardUpdateOne) SetContentFormat(v string) *FlashcardUpdateOne {
+	_u.mutation.SetContentFormat(v)
+	return _u
+}
+
+// SetNillableContentFormat sets the "content_format" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableContentFormat(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetContentFormat(*v)
+	}
+	return _u
+}
+
+// SetQuestionHTML sets the "question_html" field.
+func (_u *FlashcardUpdateOne) SetQuestionHTML(v string) *FlashcardUpdateOne {
+	_u.mutation.SetQuestionHTML(v)
+	return _u
+}
+
+// SetNillableQuestionHTML sets the "question_html" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableQuestionHTML(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetQuestionHTML(*v)
+	}
+	return _u
+}
+
+// ClearQuestionHTML clears the value of the "question_html" field.
+func (_u *FlashcardUpdateOne) ClearQuestionHTML() *FlashcardUpdateOne {
+	_u.mutation.ClearQuestionHTML()
+	return _u
+}
+
+// SetAnswerHTML sets the "answer_html" field.
+func (_u *FlashcardUpdateOne) SetAnswerHTML(v string) *FlashcardUpdateOne {
+	_u.mutation.SetAnswerHTML(v)
+	return _u
+}
+
+// SetNillableAnswerHTML sets the "answer_html" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableAnswerHTML(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetAnswerHTML(*v)
+	}
+	return _u
+}
+
+// ClearAnswerHTML clears the value of the "answer_html" field.
+func (_u *FlashcardUpdateOne) ClearAnswerHTML() *FlashcardUpdateOne {
+	_u.mutation.ClearAnswerHTML()
+	return _u
+}
+
+// SetHint sets the "hint" field.
+func (_u *FlashcardUpdateOne) SetHint(v string) *FlashcardUpdateOne {
+	_u.mutation.SetHint(v)
+	return _u
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableHint(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetHint(*v)
+	}
+	return _u
+}
+
+// ClearHint clears the value of the "hint" field.
+func (_u *FlashcardUpdateOne) ClearHint() *FlashcardUpdateOne {
+	_u.mutation.ClearHint()
+	return _u
+}
+
+// SetHintHTML sets the "hint_html" field.
+func (_u *FlashcardUpdateOne) SetHintHTML(v string) *FlashcardUpdateOne {
+	_u.mutation.SetHintHTML(v)
+	return _u
+}
+
+// SetNillableHintHTML sets the "hint_html" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableHintHTML(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetHintHTML(*v)
+	}
+	return _u
+}
+
+// ClearHintHTML clears the value of the "hint_html" field.
+func (_u *FlashcardUpdateOne) ClearHintHTML() *FlashcardUpdateOne {
+	_u.mutation.ClearHintHTML()
+	return _u
+}
+
+// SetExplanation sets the "explanation" field.
+func (_u *FlashcardUpdateOne) SetExplanation(v string) *FlashcardUpdateOne {
+	_u.mutation.SetExplanation(v)
+	return _u
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableExplanation(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetExplanation(*v)
+	}
+	return _u
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (_u *FlashcardUpdateOne) ClearExplanation() *FlashcardUpdateOne {
+	_u.mutation.ClearExplanation()
+	return _u
+}
+
+// SetExplanationHTML sets the "explanation_html" field.
+func (_u *FlashcardUpdateOne) SetExplanationHTML(v string) *FlashcardUpdateOne {
+	_u.mutation.SetExplanationHTML(v)
+	return _u
+}
+
+// SetNillableExplanationHTML sets the "explanation_html" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableExplanationHTML(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetExplanationHTML(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// FlashcardUpdateOne is the builder for updating a single Flashcard entity.
-type FlashcardUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *FlashcardMutation
+// ClearExplanationHTML clears the value of the "explanation_html" field.
+func (_u *FlashcardUpdateOne) ClearExplanationHTML() *FlashcardUpdateOne {
+	_u.mutation.ClearExplanationHTML()
+	return _u
 }
 
-// SetQuestion sets the "question" field.
-func (_u *FlashcardUpdateOne) SetQuestion(v string) *FlashcardUpdateOne {
-	_u.mutation.SetQuestion(v)
+// SetSources sets the "sources" field.
+func (_u *FlashcardUpdateOne) SetSources(v []schema.SourceReference) *FlashcardUpdateOne {
+	_u.mutation.SetSources(v)
 	return _u
 }
 
-// SetNillableQuestion sets the "question" field if the given value is not nil.
-func (_u *FlashcardUpdateOne) SetNillableQuestion(v *string) *FlashcardUpdateOne {
+// AppendSources appends value to the "sources" field.
+func (_u *FlashcardUpdateOne) AppendSources(v []schema.SourceReference) *FlashcardUpdateOne {
+	_u.mutation.AppendSources(v)
+	return _u
+}
+
+// ClearSources clears the value of the "sources" field.
+func (_u *FlashcardUpdateOne) ClearSources() *FlashcardUpdateOne {
+	_u.mutation.ClearSources()
+	return _u
+}
+
+// SetQuestionKey sets the "question_key" field.
+func (_u *FlashcardUpdateOne) SetQuestionKey(v string) *FlashcardUpdateOne {
+	_u.mutation.SetQuestionKey(v)
+	return _u
+}
+
+// SetNillableQuestionKey sets the "question_key" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableQuestionKey(v *string) *FlashcardUpdateOne {
 	if v != nil {
-		_u.SetQuestion(*v)
+		_u.SetQuestionKey(*v)
 	}
 	return _u
 }
 
-// SetAnswer sets the "answer" field.
-func (_u *FlashcardUpdateOne) SetAnswer(v string) *FlashcardUpdateOne {
-	_u.mutation.SetAnswer(v)
+// ClearQuestionKey clears the value of the "question_key" field.
+func (_u *FlashcardUpdateOne) ClearQuestionKey() *FlashcardUpdateOne {
+	_u.mutation.ClearQuestionKey()
 	return _u
 }
 
-// SetNillableAnswer sets the "answer" field if the given value is not nil.
-func (_u *FlashcardUpdateOne) SetNillableAnswer(v *string) *FlashcardUpdateOne {
+// SetQuestionText sets the "question_text" field.
+func (_u *FlashcardUpdateOne) SetQuestionText(v string) *FlashcardUpdateOne {
+	_u.mutation.SetQuestionText(v)
+	return _u
+}
+
+// SetNillableQuestionText sets the "question_text" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableQuestionText(v *string) *FlashcardUpdateOne {
 	if v != nil {
-		_u.SetAnswer(*v)
+		_u.SetQuestionText(*v)
 	}
 	return _u
 }
 
-// SetType sets the "type" field.
-func (_u *FlashcardUpdateOne) SetType(v string) *FlashcardUpdateOne {
-	_u.mutation.SetType(v)
+// ClearQuestionText clears the value of the "question_text" field.
+func (_u *FlashcardUpdateOne) ClearQuestionText() *FlashcardUpdateOne {
+	_u.mutation.ClearQuestionText()
 	return _u
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (_u *FlashcardUpdateOne) SetNillableType(v *string) *FlashcardUpdateOne {
+// SetAnswerText sets the "answer_text" field.
+func (_u *FlashcardUpdateOne) SetAnswerText(v string) *FlashcardUpdateOne {
+	_u.mutation.SetAnswerText(v)
+	return _u
+}
+
+// SetNillableAnswerText sets the "answer_text" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableAnswerText(v *string) *FlashcardUpdateOne {
 	if v != nil {
-		_u.SetType(*v)
+		_u.SetAnswerText(*v)
+	}
+	return _u
+}
+
+// ClearAnswerText clears the value of the "answer_text" field.
+func (_u *FlashcardUpdateOne) ClearAnswerText() *FlashcardUpdateOne {
+	_u.mutation.ClearAnswerText()
+	return _u
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (_u *FlashcardUpdateOne) SetSearchVector(v string) *FlashcardUpdateOne {
+	_u.mutation.SetSearchVector(v)
+	return _u
+}
+
+// SetNillableSearchVector sets the "search_vector" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableSearchVector(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetSearchVector(*v)
 	}
 	return _u
 }
 
+// ClearSearchVector clears the value of the "search_vector" field.
+func (_u *FlashcardUpdateOne) ClearSearchVector() *FlashcardUpdateOne {
+	_u.mutation.ClearSearchVector()
+	return _u
+}
+
 // SetCollectionID sets the "collection_id" field.
 func (_u *FlashcardUpdateOne) SetCollectionID(v uuid.UUID) *FlashcardUpdateOne {
 	_u.mutation.SetCollectionID(v)
@@ -400,6 +1731,41 @@ func (_u *FlashcardUpdateOne) SetNillableCollectionID(v *uuid.UUID) *FlashcardUp
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *FlashcardUpdateOne) SetStatus(v flashcard.Status) *FlashcardUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableStatus(v *flashcard.Status) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *FlashcardUpdateOne) SetPosition(v float64) *FlashcardUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillablePosition(v *float64) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *FlashcardUpdateOne) AddPosition(v float64) *FlashcardUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *FlashcardUpdateOne) SetCreatedBy(v string) *FlashcardUpdateOne {
 	_u.mutation.SetCreatedBy(v)
@@ -420,6 +1786,46 @@ func (_u *FlashcardUpdateOne) SetUpdatedAt(v time.Time) *FlashcardUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FlashcardUpdateOne) SetDeletedAt(v time.Time) *FlashcardUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableDeletedAt(v *time.Time) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FlashcardUpdateOne) ClearDeletedAt() *FlashcardUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *FlashcardUpdateOne) SetDeletedBy(v string) *FlashcardUpdateOne {
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableDeletedBy(v *string) *FlashcardUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *FlashcardUpdateOne) ClearDeletedBy() *FlashcardUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
 // SetCollection sets the "collection" edge to the Collection entity.
 func (_u *FlashcardUpdateOne) SetCollection(v *Collection) *FlashcardUpdateOne {
 	return _u.SetCollectionID(v.ID)
@@ -440,6 +1846,115 @@ func (_u *FlashcardUpdateOne) AddReviews(v ...*FlashcardReview) *FlashcardUpdate
 	return _u.AddReviewIDs(ids...)
 }
 
+// AddMediumIDs adds the "media" edge to the Media entity by IDs.
+func (_u *FlashcardUpdateOne) AddMediumIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.AddMediumIDs(ids...)
+	return _u
+}
+
+// AddMedia adds the "media" edges to the Media entity.
+func (_u *FlashcardUpdateOne) AddMedia(v ...*Media) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMediumIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the FlashcardTag entity by IDs.
+func (_u *FlashcardUpdateOne) AddTagIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the FlashcardTag entity.
+func (_u *FlashcardUpdateOne) AddTags(v ...*FlashcardTag) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the FlashcardRevision entity by IDs.
+func (_u *FlashcardUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the FlashcardRevision entity.
+func (_u *FlashcardUpdateOne) AddRevisions(v ...*FlashcardRevision) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the FlashcardAnnotation entity by IDs.
+func (_u *FlashcardUpdateOne) AddAnnotationIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.AddAnnotationIDs(ids...)
+	return _u
+}
+
+// AddAnnotations adds the "annotations" edges to the FlashcardAnnotation entity.
+func (_u *FlashcardUpdateOne) AddAnnotations(v ...*FlashcardAnnotation) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnnotationIDs(ids...)
+}
+
+// AddQuizAnswerIDs adds the "quiz_answers" edge to the QuizAnswer entity by IDs.
+func (_u *FlashcardUpdateOne) AddQuizAnswerIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.AddQuizAnswerIDs(ids...)
+	return _u
+}
+
+// AddQuizAnswers adds the "quiz_answers" edges to the QuizAnswer entity.
+func (_u *FlashcardUpdateOne) AddQuizAnswers(v ...*QuizAnswer) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuizAnswerIDs(ids...)
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (_u *FlashcardUpdateOne) AddItemStatisticIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.AddItemStatisticIDs(ids...)
+	return _u
+}
+
+// AddItemStatistics adds the "item_statistics" edges to the ItemStatistic entity.
+func (_u *FlashcardUpdateOne) AddItemStatistics(v ...*ItemStatistic) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemStatisticIDs(ids...)
+}
+
+// SetRatingID sets the "rating" edge to the FlashcardRating entity by ID.
+func (_u *FlashcardUpdateOne) SetRatingID(id uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.SetRatingID(id)
+	return _u
+}
+
+// SetNillableRatingID sets the "rating" edge to the FlashcardRating entity by ID if the given value is not nil.
+func (_u *FlashcardUpdateOne) SetNillableRatingID(id *uuid.UUID) *FlashcardUpdateOne {
+	if id != nil {
+		_u = _u.SetRatingID(*id)
+	}
+	return _u
+}
+
+// SetRating sets the "rating" edge to the FlashcardRating entity.
+func (_u *FlashcardUpdateOne) SetRating(v *FlashcardRating) *FlashcardUpdateOne {
+	return _u.SetRatingID(v.ID)
+}
+
 // Mutation returns the FlashcardMutation object of the builder.
 func (_u *FlashcardUpdateOne) Mutation() *FlashcardMutation {
 	return _u.mutation
@@ -472,6 +1987,138 @@ func (_u *FlashcardUpdateOne) RemoveReviews(v ...*FlashcardReview) *FlashcardUpd
 	return _u.RemoveReviewIDs(ids...)
 }
 
+// ClearMedia clears all "media" edges to the Media entity.
+func (_u *FlashcardUpdateOne) ClearMedia() *FlashcardUpdateOne {
+	_u.mutation.ClearMedia()
+	return _u
+}
+
+// RemoveMediumIDs removes the "media" edge to Media entities by IDs.
+func (_u *FlashcardUpdateOne) RemoveMediumIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.RemoveMediumIDs(ids...)
+	return _u
+}
+
+// RemoveMedia removes "media" edges to Media entities.
+func (_u *FlashcardUpdateOne) RemoveMedia(v ...*Media) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMediumIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the FlashcardTag entity.
+func (_u *FlashcardUpdateOne) ClearTags() *FlashcardUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to FlashcardTag entities by IDs.
+func (_u *FlashcardUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to FlashcardTag entities.
+func (_u *FlashcardUpdateOne) RemoveTags(v ...*FlashcardTag) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the FlashcardRevision entity.
+func (_u *FlashcardUpdateOne) ClearRevisions() *FlashcardUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to FlashcardRevision entities by IDs.
+func (_u *FlashcardUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to FlashcardRevision entities.
+func (_u *FlashcardUpdateOne) RemoveRevisions(v ...*FlashcardRevision) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearAnnotations clears all "annotations" edges to the FlashcardAnnotation entity.
+func (_u *FlashcardUpdateOne) ClearAnnotations() *FlashcardUpdateOne {
+	_u.mutation.ClearAnnotations()
+	return _u
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to FlashcardAnnotation entities by IDs.
+func (_u *FlashcardUpdateOne) RemoveAnnotationIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.RemoveAnnotationIDs(ids...)
+	return _u
+}
+
+// RemoveAnnotations removes "annotations" edges to FlashcardAnnotation entities.
+func (_u *FlashcardUpdateOne) RemoveAnnotations(v ...*FlashcardAnnotation) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnnotationIDs(ids...)
+}
+
+// ClearQuizAnswers clears all "quiz_answers" edges to the QuizAnswer entity.
+func (_u *FlashcardUpdateOne) ClearQuizAnswers() *FlashcardUpdateOne {
+	_u.mutation.ClearQuizAnswers()
+	return _u
+}
+
+// RemoveQuizAnswerIDs removes the "quiz_answers" edge to QuizAnswer entities by IDs.
+func (_u *FlashcardUpdateOne) RemoveQuizAnswerIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.RemoveQuizAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveQuizAnswers removes "quiz_answers" edges to QuizAnswer entities.
+func (_u *FlashcardUpdateOne) RemoveQuizAnswers(v ...*QuizAnswer) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuizAnswerIDs(ids...)
+}
+
+// ClearItemStatistics clears all "item_statistics" edges to the ItemStatistic entity.
+func (_u *FlashcardUpdateOne) ClearItemStatistics() *FlashcardUpdateOne {
+	_u.mutation.ClearItemStatistics()
+	return _u
+}
+
+// RemoveItemStatisticIDs removes the "item_statistics" edge to ItemStatistic entities by IDs.
+func (_u *FlashcardUpdateOne) RemoveItemStatisticIDs(ids ...uuid.UUID) *FlashcardUpdateOne {
+	_u.mutation.RemoveItemStatisticIDs(ids...)
+	return _u
+}
+
+// RemoveItemStatistics removes "item_statistics" edges to ItemStatistic entities.
+func (_u *FlashcardUpdateOne) RemoveItemStatistics(v ...*ItemStatistic) *FlashcardUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemStatisticIDs(ids...)
+}
+
+// ClearRating clears the "rating" edge to the FlashcardRating entity.
+func (_u *FlashcardUpdateOne) ClearRating() *FlashcardUpdateOne {
+	_u.mutation.ClearRating()
+	return _u
+}
+
 // Where appends a list predicates to the FlashcardUpdate builder.
 func (_u *FlashcardUpdateOne) Where(ps ...predicate.Flashcard) *FlashcardUpdateOne {
 	_u.mutation.Where(ps...)
@@ -538,17 +2185,38 @@ func (_u *FlashcardUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Flashcard.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ContentFormat(); ok {
+		if err := flashcard.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "Flashcard.content_format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := flashcard.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Flashcard.status": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.CreatedBy(); ok {
 		if err := flashcard.CreatedByValidator(v); err != nil {
 			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Flashcard.created_by": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.DeletedBy(); ok {
+		if err := flashcard.DeletedByValidator(v); err != nil {
+			return &ValidationError{Name: "deleted_by", err: fmt.Errorf(`ent: validator failed for field "Flashcard.deleted_by": %w`, err)}
+		}
+	}
 	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Flashcard.collection"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FlashcardUpdateOne) sqlSave(ctx context.Context) (_node *Flashcard, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -587,12 +2255,135 @@ func (_u *FlashcardUpdateOne) sqlSave(ctx context.Context) (_node *Flashcard, er
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(flashcard.FieldType, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(flashcard.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flashcard.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(flashcard.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Pairs(); ok {
+		_spec.SetField(flashcard.FieldPairs, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPairs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flashcard.FieldPairs, value)
+		})
+	}
+	if _u.mutation.PairsCleared() {
+		_spec.ClearField(flashcard.FieldPairs, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Occlusion(); ok {
+		_spec.SetField(flashcard.FieldOcclusion, field.TypeJSON, value)
+	}
+	if _u.mutation.OcclusionCleared() {
+		_spec.ClearField(flashcard.FieldOcclusion, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ContentFormat(); ok {
+		_spec.SetField(flashcard.FieldContentFormat, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.QuestionHTML(); ok {
+		_spec.SetField(flashcard.FieldQuestionHTML, field.TypeString, value)
+	}
+	if _u.mutation.QuestionHTMLCleared() {
+		_spec.ClearField(flashcard.FieldQuestionHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnswerHTML(); ok {
+		_spec.SetField(flashcard.FieldAnswerHTML, field.TypeString, value)
+	}
+	if _u.mutation.AnswerHTMLCleared() {
+		_spec.ClearField(flashcard.FieldAnswerHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.Hint(); ok {
+		_spec.SetField(flashcard.FieldHint, field.TypeString, value)
+	}
+	if _u.mutation.HintCleared() {
+		_spec.ClearField(flashcard.FieldHint, field.TypeString)
+	}
+	if value, ok := _u.mutation.HintHTML(); ok {
+		_spec.SetField(flashcard.FieldHintHTML, field.TypeString, value)
+	}
+	if _u.mutation.HintHTMLCleared() {
+		_spec.ClearField(flashcard.FieldHintHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.Explanation(); ok {
+		_spec.SetField(flashcard.FieldExplanation, field.TypeString, value)
+	}
+	if _u.mutation.ExplanationCleared() {
+		_spec.ClearField(flashcard.FieldExplanation, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExplanationHTML(); ok {
+		_spec.SetField(flashcard.FieldExplanationHTML, field.TypeString, value)
+	}
+	if _u.mutation.ExplanationHTMLCleared() {
+		_spec.ClearField(flashcard.FieldExplanationHTML, field.TypeString)
+	}
+	if value, ok := _u.mutation.Sources(); ok {
+		_spec.SetField(flashcard.FieldSources, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSources(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flashcard.FieldSources, value)
+		})
+	}
+	if _u.mutation.SourcesCleared() {
+		_spec.ClearField(flashcard.FieldSources, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QuestionKey(); ok {
+		_spec.SetField(flashcard.FieldQuestionKey, field.TypeString, value)
+	}
+	if _u.mutation.QuestionKeyCleared() {
+		_spec.ClearField(flashcard.FieldQuestionKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.QuestionText(); ok {
+		_spec.SetField(flashcard.FieldQuestionText, field.TypeString, value)
+	}
+	if _u.mutation.QuestionTextCleared() {
+		_spec.ClearField(flashcard.FieldQuestionText, field.TypeString)
+	}
+	if value, ok := _u.mutation.AnswerText(); ok {
+		_spec.SetField(flashcard.FieldAnswerText, field.TypeString, value)
+	}
+	if _u.mutation.AnswerTextCleared() {
+		_spec.ClearField(flashcard.FieldAnswerText, field.TypeString)
+	}
+	if value, ok := _u.mutation.SearchVector(); ok {
+		_spec.SetField(flashcard.FieldSearchVector, field.TypeString, value)
+	}
+	if _u.mutation.SearchVectorCleared() {
+		_spec.ClearField(flashcard.FieldSearchVector, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(flashcard.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(flashcard.FieldPosition, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(flashcard.FieldPosition, field.TypeFloat64, value)
+	}
 	if value, ok := _u.mutation.CreatedBy(); ok {
 		_spec.SetField(flashcard.FieldCreatedBy, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(flashcard.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(flashcard.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(flashcard.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(flashcard.FieldDeletedBy, field.TypeString, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(flashcard.FieldDeletedBy, field.TypeString)
+	}
 	if _u.mutation.CollectionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -667,6 +2458,306 @@ func (_u *FlashcardUpdateOne) sqlSave(ctx context.Context) (_node *Flashcard, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMediaIDs(); len(nodes) > 0 && !_u.mutation.MediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flashcard.MediaTable,
+			Columns: flashcard.MediaPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.TagsTable,
+			Columns: []string{flashcard.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.RevisionsTable,
+			Columns: []string{flashcard.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnnotationsIDs(); len(nodes) > 0 && !_u.mutation.AnnotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnnotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.AnnotationsTable,
+			Columns: []string{flashcard.AnnotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.QuizAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuizAnswersIDs(); len(nodes) > 0 && !_u.mutation.QuizAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.QuizAnswersTable,
+			Columns: []string{flashcard.QuizAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemStatisticsIDs(); len(nodes) > 0 && !_u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemStatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcard.ItemStatisticsTable,
+			Columns: []string{flashcard.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RatingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   flashcard.RatingTable,
+			Columns: []string{flashcard.RatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RatingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   flashcard.RatingTable,
+			Columns: []string{flashcard.RatingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &Flashcard{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+)
+
+// FlashcardAnnotation is the model entity for the FlashcardAnnotation schema.
+type FlashcardAnnotation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Clerk user ID
+	UserID string `json:"user_id,omitempty"`
+	// FlashcardID holds the value of the "flashcard_id" field.
+	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Private note of the user
+	Note string `json:"note,omitempty"`
+	// Colored flag, like Anki's card flags
+	Flag *flashcardannotation.Flag `json:"flag,omitempty"`
+	// Bookmarked holds the value of the "bookmarked" field.
+	Bookmarked bool `json:"bookmarked,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FlashcardAnnotationQuery when eager-loading is set.
+	Edges        FlashcardAnnotationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FlashcardAnnotationEdges holds the relations/edges for other nodes in the graph.
+type FlashcardAnnotationEdges struct {
+	// Flashcard holds the value of the flashcard edge.
+	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FlashcardOrErr returns the Flashcard value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FlashcardAnnotationEdges) FlashcardOrErr() (*Flashcard, error) {
+	if e.Flashcard != nil {
+		return e.Flashcard, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flashcard.Label}
+	}
+	return nil, &NotLoadedError{edge: "flashcard"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FlashcardAnnotation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case flashcardannotation.FieldBookmarked:
+			values[i] = new(sql.NullBool)
+		case flashcardannotation.FieldUserID, flashcardannotation.FieldNote, flashcardannotation.FieldFlag:
+			values[i] = new(sql.NullString)
+		case flashcardannotation.FieldCreatedAt, flashcardannotation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case flashcardannotation.FieldID, flashcardannotation.FieldFlashcardID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FlashcardAnnotation fields.
+func (_m *FlashcardAnnotation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case flashcardannotation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case flashcardannotation.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case flashcardannotation.FieldFlashcardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_id", values[i])
+			} else if value != nil {
+				_m.FlashcardID = *value
+			}
+		case flashcardannotation.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case flashcardannotation.FieldFlag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field flag", values[i])
+			} else if value.Valid {
+				_m.Flag = new(flashcardannotation.Flag)
+				*_m.Flag = flashcardannotation.Flag(value.String)
+			}
+		case flashcardannotation.FieldBookmarked:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field bookmarked", values[i])
+			} else if value.Valid {
+				_m.Bookmarked = value.Bool
+			}
+		case flashcardannotation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case flashcardannotation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FlashcardAnnotation.
+// This includes values selected through modifiers, order, etc.
+func (_m *FlashcardAnnotation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlashcard queries the "flashcard" edge of the FlashcardAnnotation entity.
+func (_m *FlashcardAnnotation) QueryFlashcard() *FlashcardQuery {
+	return NewFlashcardAnnotationClient(_m.config).QueryFlashcard(_m)
+}
+
+// Update returns a builder for updating this FlashcardAnnotation.
+// Note that you need to call FlashcardAnnotation.Unwrap() before calling this method if this FlashcardAnnotation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FlashcardAnnotation) Update() *FlashcardAnnotationUpdateOne {
+	return NewFlashcardAnnotationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FlashcardAnnotation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FlashcardAnnotation) Unwrap() *FlashcardAnnotation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FlashcardAnnotation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FlashcardAnnotation) String() string {
+	var builder strings.Builder
+	builder.WriteString("FlashcardAnnotation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("flashcard_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.Flag; v != nil {
+		builder.WriteString("flag=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("bookmarked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Bookmarked))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FlashcardAnnotations is a parsable slice of FlashcardAnnotation.
+type FlashcardAnnotations []*FlashcardAnnotation
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardannotation
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the flashcardannotation type in the database.
+	Label = "flashcard_annotation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
+	FieldFlashcardID = "flashcard_id"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldFlag holds the string denoting the flag field in the database.
+	FieldFlag = "flag"
+	// FieldBookmarked holds the string denoting the bookmarked field in the database.
+	FieldBookmarked = "bookmarked"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
+	EdgeFlashcard = "flashcard"
+	// Table holds the table name of the flashcardannotation in the database.
+	Table = "flashcard_annotations"
+	// FlashcardTable is the table that holds the flashcard relation/edge.
+	FlashcardTable = "flashcard_annotations"
+	// FlashcardInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardInverseTable = "flashcards"
+	// FlashcardColumn is the table column denoting the flashcard relation/edge.
+	FlashcardColumn = "flashcard_id"
+)
+
+// Columns holds all SQL columns for flashcardannotation fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldFlashcardID,
+	FieldNote,
+	FieldFlag,
+	FieldBookmarked,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	NoteValidator func(string) error
+	// DefaultBookmarked holds the default value on creation for the "bookmarked" field.
+	DefaultBookmarked bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Flag defines the type for the "flag" enum field.
+type Flag string
+
+// Flag values.
+const (
+	FlagRed       Flag = "red"
+	FlagOrange    Flag = "orange"
+	FlagGreen     Flag = "green"
+	FlagBlue      Flag = "blue"
+	FlagPink      Flag = "pink"
+	FlagTurquoise Flag = "turquoise"
+	FlagPurple    Flag = "purple"
+)
+
+func (f Flag) String() string {
+	return string(f)
+}
+
+// FlagValidator is a validator for the "flag" field enum values. It is called by the builders before save.
+func FlagValidator(f Flag) error {
+	switch f {
+	case FlagRed, FlagOrange, FlagGreen, FlagBlue, FlagPink, FlagTurquoise, FlagPurple:
+		return nil
+	default:
+		return fmt.Errorf("flashcardannotation: invalid enum value for flag field: %q", f)
+	}
+}
+
+// OrderOption defines the ordering options for the FlashcardAnnotation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByFlashcardID orders the results by the flashcard_id field.
+func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByFlag orders the results by the flag field.
+func ByFlag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlag, opts...).ToFunc()
+}
+
+// ByBookmarked orders the results by the bookmarked field.
+func ByBookmarked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBookmarked, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFlashcardField orders the results by flashcard field.
+func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlashcardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+	)
+}
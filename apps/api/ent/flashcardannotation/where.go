@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardannotation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldUserID, v))
+}
+
+// FlashcardID applies equality check predicate on the "flashcard_id" field. It's identical to FlashcardIDEQ.
+func FlashcardID(v uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldNote, v))
+}
+
+// Bookmarked applies equality check predicate on the "bookmarked" field. It's identical to BookmarkedEQ.
+func Bookmarked(v bool) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldBookmarked, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// FlashcardIDEQ applies the EQ predicate on the "flashcard_id" field.
+func FlashcardIDEQ(v uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDNEQ applies the NEQ predicate on the "flashcard_id" field.
+func FlashcardIDNEQ(v uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDIn applies the In predicate on the "flashcard_id" field.
+func FlashcardIDIn(vs ...uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldFlashcardID, vs...))
+}
+
+// FlashcardIDNotIn applies the NotIn predicate on the "flashcard_id" field.
+func FlashcardIDNotIn(vs ...uuid.UUID) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldFlashcardID, vs...))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldContainsFold(FieldNote, v))
+}
+
+// FlagEQ applies the EQ predicate on the "flag" field.
+func FlagEQ(v Flag) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldFlag, v))
+}
+
+// FlagNEQ applies the NEQ predicate on the "flag" field.
+func FlagNEQ(v Flag) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldFlag, v))
+}
+
+// FlagIn applies the In predicate on the "flag" field.
+func FlagIn(vs ...Flag) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldFlag, vs...))
+}
+
+// FlagNotIn applies the NotIn predicate on the "flag" field.
+func FlagNotIn(vs ...Flag) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldFlag, vs...))
+}
+
+// FlagIsNil applies the IsNil predicate on the "flag" field.
+func FlagIsNil() predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIsNull(FieldFlag))
+}
+
+// FlagNotNil applies the NotNil predicate on the "flag" field.
+func FlagNotNil() predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotNull(FieldFlag))
+}
+
+// BookmarkedEQ applies the EQ predicate on the "bookmarked" field.
+func BookmarkedEQ(v bool) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldBookmarked, v))
+}
+
+// BookmarkedNEQ applies the NEQ predicate on the "bookmarked" field.
+func BookmarkedNEQ(v bool) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldBookmarked, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasFlashcard applies the HasEdge predicate on the "flashcard" edge.
+func HasFlashcard() predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlashcardWith applies the HasEdge predicate on the "flashcard" edge with a given conditions (other predicates).
+func HasFlashcardWith(preds ...predicate.Flashcard) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(func(s *sql.Selector) {
+		step := newFlashcardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FlashcardAnnotation) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FlashcardAnnotation) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FlashcardAnnotation) predicate.FlashcardAnnotation {
+	return predicate.FlashcardAnnotation(sql.NotPredicates(p))
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+)
+
+// FlashcardAnnotationCreate is the builder for creating a FlashcardAnnotation entity.
+type FlashcardAnnotationCreate struct {
+	config
+	mutation *FlashcardAnnotationMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *FlashcardAnnotationCreate) SetUserID(v string) *FlashcardAnnotationCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_c *FlashcardAnnotationCreate) SetFlashcardID(v uuid.UUID) *FlashcardAnnotationCreate {
+	_c.mutation.SetFlashcardID(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *FlashcardAnnotationCreate) SetNote(v string) *FlashcardAnnotationCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *FlashcardAnnotationCreate) SetNillableNote(v *string) *FlashcardAnnotationCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetFlag sets the "flag" field.
+func (_c *FlashcardAnnotationCreate) SetFlag(v flashcardannotation.Flag) *FlashcardAnnotationCreate {
+	_c.mutation.SetFlag(v)
+	return _c
+}
+
+// SetNillableFlag sets the "flag" field if the given value is not nil.
+func (_c *FlashcardAnnotationCreate) SetNillableFlag(v *flashcardannotation.Flag) *FlashcardAnnotationCreate {
+	if v != nil {
+		_c.SetFlag(*v)
+	}
+	return _c
+}
+
+// SetBookmarked sets the "bookmarked" field.
+func (_c *FlashcardAnnotationCreate) SetBookmarked(v bool) *FlashcardAnnotationCreate {
+	_c.mutation.SetBookmarked(v)
+	return _c
+}
+
+// SetNillableBookmarked sets the "bookmarked" field if the given value is not nil.
+func (_c *FlashcardAnnotationCreate) SetNillableBookmarked(v *bool) *FlashcardAnnotationCreate {
+	if v != nil {
+		_c.SetBookmarked(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FlashcardAnnotationCreate) SetCreatedAt(v time.Time) *FlashcardAnnotationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FlashcardAnnotationCreate) SetNillableCreatedAt(v *time.Time) *FlashcardAnnotationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FlashcardAnnotationCreate) SetUpdatedAt(v time.Time) *FlashcardAnnotationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FlashcardAnnotationCreate) SetNillableUpdatedAt(v *time.Time) *FlashcardAnnotationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FlashcardAnnotationCreate) SetID(v uuid.UUID) *FlashcardAnnotationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FlashcardAnnotationCreate) SetNillableID(v *uuid.UUID) *FlashcardAnnotationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_c *FlashcardAnnotationCreate) SetFlashcard(v *Flashcard) *FlashcardAnnotationCreate {
+	return _c.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardAnnotationMutation object of the builder.
+func (_c *FlashcardAnnotationCreate) Mutation() *FlashcardAnnotationMutation {
+	return _c.mutation
+}
+
+// Save creates the FlashcardAnnotation in the database.
+func (_c *FlashcardAnnotationCreate) Save(ctx context.Context) (*FlashcardAnnotation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FlashcardAnnotationCreate) SaveX(ctx context.Context) *FlashcardAnnotation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardAnnotationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardAnnotationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FlashcardAnnotationCreate) defaults() {
+	if _, ok := _c.mutation.Note(); !ok {
+		v := flashcardannotation.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.Bookmarked(); !ok {
+		v := flashcardannotation.DefaultBookmarked
+		_c.mutation.SetBookmarked(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := flashcardannotation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := flashcardannotation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := flashcardannotation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FlashcardAnnotationCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "FlashcardAnnotation.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := flashcardannotation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FlashcardID(); !ok {
+		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "FlashcardAnnotation.flashcard_id"`)}
+	}
+	if v, ok := _c.mutation.Note(); ok {
+		if err := flashcardannotation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.note": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Flag(); ok {
+		if err := flashcardannotation.FlagValidator(v); err != nil {
+			return &ValidationError{Name: "flag", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.flag": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Bookmarked(); !ok {
+		return &ValidationError{Name: "bookmarked", err: errors.New(`ent: missing required field "FlashcardAnnotation.bookmarked"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FlashcardAnnotation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FlashcardAnnotation.updated_at"`)}
+	}
+	if len(_c.mutation.FlashcardIDs()) == 0 {
+		return &ValidationError{Name: "flashcard", err: errors.New(`ent: missing required edge "FlashcardAnnotation.flashcard"`)}
+	}
+	return nil
+}
+
+func (_c *FlashcardAnnotationCreate) sqlSave(ctx context.Context) (*FlashcardAnnotation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FlashcardAnnotationCreate) createSpec() (*FlashcardAnnotation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FlashcardAnnotation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(flashcardannotation.Table, sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(flashcardannotation.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(flashcardannotation.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.Flag(); ok {
+		_spec.SetField(flashcardannotation.FieldFlag, field.TypeEnum, value)
+		_node.Flag = &value
+	}
+	if value, ok := _c.mutation.Bookmarked(); ok {
+		_spec.SetField(flashcardannotation.FieldBookmarked, field.TypeBool, value)
+		_node.Bookmarked = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(flashcardannotation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(flashcardannotation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardannotation.FlashcardTable,
+			Columns: []string{flashcardannotation.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlashcardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FlashcardAnnotationCreateBulk is the builder for creating many FlashcardAnnotation entities in bulk.
+type FlashcardAnnotationCreateBulk struct {
+	config
+	err      error
+	builders []*FlashcardAnnotationCreate
+}
+
+// Save creates the FlashcardAnnotation entities in the database.
+func (_c *FlashcardAnnotationCreateBulk) Save(ctx context.Context) ([]*FlashcardAnnotation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FlashcardAnnotation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FlashcardAnnotationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FlashcardAnnotationCreateBulk) SaveX(ctx context.Context) []*FlashcardAnnotation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardAnnotationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardAnnotationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
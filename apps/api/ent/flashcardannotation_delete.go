@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardAnnotationDelete is the builder for deleting a FlashcardAnnotation entity.
+type FlashcardAnnotationDelete struct {
+	config
+	hooks    []Hook
+	mutation *FlashcardAnnotationMutation
+}
+
+// Where appends a list predicates to the FlashcardAnnotationDelete builder.
+func (_d *FlashcardAnnotationDelete) Where(ps ...predicate.FlashcardAnnotation) *FlashcardAnnotationDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FlashcardAnnotationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FlashcardAnnotationDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FlashcardAnnotationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(flashcardannotation.Table, sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FlashcardAnnotationDeleteOne is the builder for deleting a single FlashcardAnnotation entity.
+type FlashcardAnnotationDeleteOne struct {
+	_d *FlashcardAnnotationDelete
+}
+
+// Where appends a list predicates to the FlashcardAnnotationDelete builder.
+func (_d *FlashcardAnnotationDeleteOne) Where(ps ...predicate.FlashcardAnnotation) *FlashcardAnnotationDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FlashcardAnnotationDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{flashcardannotation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FlashcardAnnotationDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,562 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardAnnotationUpdate is the builder for updating FlashcardAnnotation entities.
+type FlashcardAnnotationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FlashcardAnnotationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FlashcardAnnotationUpdate builder.
+func (_u *FlashcardAnnotationUpdate) Where(ps ...predicate.FlashcardAnnotation) *FlashcardAnnotationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *FlashcardAnnotationUpdate) SetUserID(v string) *FlashcardAnnotationUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdate) SetNillableUserID(v *string) *FlashcardAnnotationUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_u *FlashcardAnnotationUpdate) SetFlashcardID(v uuid.UUID) *FlashcardAnnotationUpdate {
+	_u.mutation.SetFlashcardID(v)
+	return _u
+}
+
+// SetNillableFlashcardID sets the "flashcard_id" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdate) SetNillableFlashcardID(v *uuid.UUID) *FlashcardAnnotationUpdate {
+	if v != nil {
+		_u.SetFlashcardID(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *FlashcardAnnotationUpdate) SetNote(v string) *FlashcardAnnotationUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdate) SetNillableNote(v *string) *FlashcardAnnotationUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *FlashcardAnnotationUpdate) ClearNote() *FlashcardAnnotationUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetFlag sets the "flag" field.
+func (_u *FlashcardAnnotationUpdate) SetFlag(v flashcardannotation.Flag) *FlashcardAnnotationUpdate {
+	_u.mutation.SetFlag(v)
+	return _u
+}
+
+// SetNillableFlag sets the "flag" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdate) SetNillableFlag(v *flashcardannotation.Flag) *FlashcardAnnotationUpdate {
+	if v != nil {
+		_u.SetFlag(*v)
+	}
+	return _u
+}
+
+// ClearFlag clears the value of the "flag" field.
+func (_u *FlashcardAnnotationUpdate) ClearFlag() *FlashcardAnnotationUpdate {
+	_u.mutation.ClearFlag()
+	return _u
+}
+
+// SetBookmarked sets the "bookmarked" field.
+func (_u *FlashcardAnnotationUpdate) SetBookmarked(v bool) *FlashcardAnnotationUpdate {
+	_u.mutation.SetBookmarked(v)
+	return _u
+}
+
+// SetNillableBookmarked sets the "bookmarked" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdate) SetNillableBookmarked(v *bool) *FlashcardAnnotationUpdate {
+	if v != nil {
+		_u.SetBookmarked(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FlashcardAnnotationUpdate) SetUpdatedAt(v time.Time) *FlashcardAnnotationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardAnnotationUpdate) SetFlashcard(v *Flashcard) *FlashcardAnnotationUpdate {
+	return _u.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardAnnotationMutation object of the builder.
+func (_u *FlashcardAnnotationUpdate) Mutation() *FlashcardAnnotationMutation {
+	return _u.mutation
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardAnnotationUpdate) ClearFlashcard() *FlashcardAnnotationUpdate {
+	_u.mutation.ClearFlashcard()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FlashcardAnnotationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardAnnotationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FlashcardAnnotationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardAnnotationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FlashcardAnnotationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := flashcardannotation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardAnnotationUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := flashcardannotation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := flashcardannotation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Flag(); ok {
+		if err := flashcardannotation.FlagValidator(v); err != nil {
+			return &ValidationError{Name: "flag", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.flag": %w`, err)}
+		}
+	}
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardAnnotation.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardAnnotationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardAnnotationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardAnnotationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardannotation.Table, flashcardannotation.Columns, sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(flashcardannotation.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(flashcardannotation.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(flashcardannotation.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Flag(); ok {
+		_spec.SetField(flashcardannotation.FieldFlag, field.TypeEnum, value)
+	}
+	if _u.mutation.FlagCleared() {
+		_spec.ClearField(flashcardannotation.FieldFlag, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Bookmarked(); ok {
+		_spec.SetField(flashcardannotation.FieldBookmarked, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(flashcardannotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FlashcardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardannotation.FlashcardTable,
+			Columns: []string{flashcardannotation.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardannotation.FlashcardTable,
+			Columns: []string{flashcardannotation.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardannotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FlashcardAnnotationUpdateOne is the builder for updating a single FlashcardAnnotation entity.
+type FlashcardAnnotationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FlashcardAnnotationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *FlashcardAnnotationUpdateOne) SetUserID(v string) *FlashcardAnnotationUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdateOne) SetNillableUserID(v *string) *FlashcardAnnotationUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_u *FlashcardAnnotationUpdateOne) SetFlashcardID(v uuid.UUID) *FlashcardAnnotationUpdateOne {
+	_u.mutation.SetFlashcardID(v)
+	return _u
+}
+
+// SetNillableFlashcardID sets the "flashcard_id" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdateOne) SetNillableFlashcardID(v *uuid.UUID) *FlashcardAnnotationUpdateOne {
+	if v != nil {
+		_u.SetFlashcardID(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *FlashcardAnnotationUpdateOne) SetNote(v string) *FlashcardAnnotationUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdateOne) SetNillableNote(v *string) *FlashcardAnnotationUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *FlashcardAnnotationUpdateOne) ClearNote() *FlashcardAnnotationUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetFlag sets the "flag" field.
+func (_u *FlashcardAnnotationUpdateOne) SetFlag(v flashcardannotation.Flag) *FlashcardAnnotationUpdateOne {
+	_u.mutation.SetFlag(v)
+	return _u
+}
+
+// SetNillableFlag sets the "flag" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdateOne) SetNillableFlag(v *flashcardannotation.Flag) *FlashcardAnnotationUpdateOne {
+	if v != nil {
+		_u.SetFlag(*v)
+	}
+	return _u
+}
+
+// ClearFlag clears the value of the "flag" field.
+func (_u *FlashcardAnnotationUpdateOne) ClearFlag() *FlashcardAnnotationUpdateOne {
+	_u.mutation.ClearFlag()
+	return _u
+}
+
+// SetBookmarked sets the "bookmarked" field.
+func (_u *FlashcardAnnotationUpdateOne) SetBookmarked(v bool) *FlashcardAnnotationUpdateOne {
+	_u.mutation.SetBookmarked(v)
+	return _u
+}
+
+// SetNillableBookmarked sets the "bookmarked" field if the given value is not nil.
+func (_u *FlashcardAnnotationUpdateOne) SetNillableBookmarked(v *bool) *FlashcardAnnotationUpdateOne {
+	if v != nil {
+		_u.SetBookmarked(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FlashcardAnnotationUpdateOne) SetUpdatedAt(v time.Time) *FlashcardAnnotationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardAnnotationUpdateOne) SetFlashcard(v *Flashcard) *FlashcardAnnotationUpdateOne {
+	return _u.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardAnnotationMutation object of the builder.
+func (_u *FlashcardAnnotationUpdateOne) Mutation() *FlashcardAnnotationMutation {
+	return _u.mutation
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardAnnotationUpdateOne) ClearFlashcard() *FlashcardAnnotationUpdateOne {
+	_u.mutation.ClearFlashcard()
+	return _u
+}
+
+// Where appends a list predicates to the FlashcardAnnotationUpdate builder.
+func (_u *FlashcardAnnotationUpdateOne) Where(ps ...predicate.FlashcardAnnotation) *FlashcardAnnotationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FlashcardAnnotationUpdateOne) Select(field string, fields ...string) *FlashcardAnnotationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FlashcardAnnotation entity.
+func (_u *FlashcardAnnotationUpdateOne) Save(ctx context.Context) (*FlashcardAnnotation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardAnnotationUpdateOne) SaveX(ctx context.Context) *FlashcardAnnotation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FlashcardAnnotationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardAnnotationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FlashcardAnnotationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := flashcardannotation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardAnnotationUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := flashcardannotation.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Note(); ok {
+		if err := flashcardannotation.NoteValidator(v); err != nil {
+			return &ValidationError{Name: "note", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.note": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Flag(); ok {
+		if err := flashcardannotation.FlagValidator(v); err != nil {
+			return &ValidationError{Name: "flag", err: fmt.Errorf(`ent: validator failed for field "FlashcardAnnotation.flag": %w`, err)}
+		}
+	}
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardAnnotation.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardAnnotationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardAnnotationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardAnnotationUpdateOne) sqlSave(ctx context.Context) (_node *FlashcardAnnotation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardannotation.Table, flashcardannotation.Columns, sqlgraph.NewFieldSpec(flashcardannotation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FlashcardAnnotation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, flashcardannotation.FieldID)
+		for _, f := range fields {
+			if !flashcardannotation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != flashcardannotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(flashcardannotation.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(flashcardannotation.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(flashcardannotation.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Flag(); ok {
+		_spec.SetField(flashcardannotation.FieldFlag, field.TypeEnum, value)
+	}
+	if _u.mutation.FlagCleared() {
+		_spec.ClearField(flashcardannotation.FieldFlag, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Bookmarked(); ok {
+		_spec.SetField(flashcardannotation.FieldBookmarked, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(flashcardannotation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FlashcardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardannotation.FlashcardTable,
+			Columns: []string{flashcardannotation.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardannotation.FlashcardTable,
+			Columns: []string{flashcardannotation.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FlashcardAnnotation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardannotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
+)
+
+// FlashcardRating is the model entity for the FlashcardRating schema.
+type FlashcardRating struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// FlashcardID holds the value of the "flashcard_id" field.
+	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Difficulty holds the value of the "difficulty" field.
+	Difficulty float64 `json:"difficulty,omitempty"`
+	// Answers the estimate is based on; later answers move it less
+	AnswerCount int `json:"answer_count,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FlashcardRatingQuery when eager-loading is set.
+	Edges        FlashcardRatingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FlashcardRatingEdges holds the relations/edges for other nodes in the graph.
+type FlashcardRatingEdges struct {
+	// Flashcard holds the value of the flashcard edge.
+	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FlashcardOrErr returns the Flashcard value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FlashcardRatingEdges) FlashcardOrErr() (*Flashcard, error) {
+	if e.Flashcard != nil {
+		return e.Flashcard, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flashcard.Label}
+	}
+	return nil, &NotLoadedError{edge: "flashcard"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FlashcardRating) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case flashcardrating.FieldDifficulty:
+			values[i] = new(sql.NullFloat64)
+		case flashcardrating.FieldAnswerCount:
+			values[i] = new(sql.NullInt64)
+		case flashcardrating.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case flashcardrating.FieldID, flashcardrating.FieldFlashcardID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FlashcardRating fields.
+func (_m *FlashcardRating) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case flashcardrating.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case flashcardrating.FieldFlashcardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_id", values[i])
+			} else if value != nil {
+				_m.FlashcardID = *value
+			}
+		case flashcardrating.FieldDifficulty:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field difficulty", values[i])
+			} else if value.Valid {
+				_m.Difficulty = value.Float64
+			}
+		case flashcardrating.FieldAnswerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_count", values[i])
+			} else if value.Valid {
+				_m.AnswerCount = int(value.Int64)
+			}
+		case flashcardrating.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FlashcardRating.
+// This includes values selected through modifiers, order, etc.
+func (_m *FlashcardRating) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlashcard queries the "flashcard" edge of the FlashcardRating entity.
+func (_m *FlashcardRating) QueryFlashcard() *FlashcardQuery {
+	return NewFlashcardRatingClient(_m.config).QueryFlashcard(_m)
+}
+
+// Update returns a builder for updating this FlashcardRating.
+// Note that you need to call FlashcardRating.Unwrap() before calling this method if this FlashcardRating
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FlashcardRating) Update() *FlashcardRatingUpdateOne {
+	return NewFlashcardRatingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FlashcardRating entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FlashcardRating) Unwrap() *FlashcardRating {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FlashcardRating is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FlashcardRating) String() string {
+	var builder strings.Builder
+	builder.WriteString("FlashcardRating(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flashcard_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
+	builder.WriteString(", ")
+	builder.WriteString("difficulty=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Difficulty))
+	builder.WriteString(", ")
+	builder.WriteString("answer_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnswerCount))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FlashcardRatings is a parsable slice of FlashcardRating.
+type FlashcardRatings []*FlashcardRating
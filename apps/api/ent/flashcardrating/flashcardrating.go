@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardrating
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the flashcardrating type in the database.
+	Label = "flashcard_rating"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
+	FieldFlashcardID = "flashcard_id"
+	// FieldDifficulty holds the string denoting the difficulty field in the database.
+	FieldDifficulty = "difficulty"
+	// FieldAnswerCount holds the string denoting the answer_count field in the database.
+	FieldAnswerCount = "answer_count"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
+	EdgeFlashcard = "flashcard"
+	// Table holds the table name of the flashcardrating in the database.
+	Table = "flashcard_ratings"
+	// FlashcardTable is the table that holds the flashcard relation/edge.
+	FlashcardTable = "flashcard_ratings"
+	// FlashcardInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardInverseTable = "flashcards"
+	// FlashcardColumn is the table column denoting the flashcard relation/edge.
+	FlashcardColumn = "flashcard_id"
+)
+
+// Columns holds all SQL columns for flashcardrating fields.
+var Columns = []string{
+	FieldID,
+	FieldFlashcardID,
+	FieldDifficulty,
+	FieldAnswerCount,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultDifficulty holds the default value on creation for the "difficulty" field.
+	DefaultDifficulty float64
+	// DefaultAnswerCount holds the default value on creation for the "answer_count" field.
+	DefaultAnswerCount int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the FlashcardRating queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlashcardID orders the results by the flashcard_id field.
+func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
+}
+
+// ByDifficulty orders the results by the difficulty field.
+func ByDifficulty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifficulty, opts...).ToFunc()
+}
+
+// ByAnswerCount orders the results by the answer_count field.
+func ByAnswerCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerCount, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFlashcardField orders the results by flashcard field.
+func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlashcardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, FlashcardTable, FlashcardColumn),
+	)
+}
@@ -0,0 +1,255 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardrating
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLTE(FieldID, id))
+}
+
+// FlashcardID applies equality check predicate on the "flashcard_id" field. It's identical to FlashcardIDEQ.
+func FlashcardID(v uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// Difficulty applies equality check predicate on the "difficulty" field. It's identical to DifficultyEQ.
+func Difficulty(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// AnswerCount applies equality check predicate on the "answer_count" field. It's identical to AnswerCountEQ.
+func AnswerCount(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldAnswerCount, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FlashcardIDEQ applies the EQ predicate on the "flashcard_id" field.
+func FlashcardIDEQ(v uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDNEQ applies the NEQ predicate on the "flashcard_id" field.
+func FlashcardIDNEQ(v uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDIn applies the In predicate on the "flashcard_id" field.
+func FlashcardIDIn(vs ...uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldIn(FieldFlashcardID, vs...))
+}
+
+// FlashcardIDNotIn applies the NotIn predicate on the "flashcard_id" field.
+func FlashcardIDNotIn(vs ...uuid.UUID) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNotIn(FieldFlashcardID, vs...))
+}
+
+// DifficultyEQ applies the EQ predicate on the "difficulty" field.
+func DifficultyEQ(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// DifficultyNEQ applies the NEQ predicate on the "difficulty" field.
+func DifficultyNEQ(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNEQ(FieldDifficulty, v))
+}
+
+// DifficultyIn applies the In predicate on the "difficulty" field.
+func DifficultyIn(vs ...float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldIn(FieldDifficulty, vs...))
+}
+
+// DifficultyNotIn applies the NotIn predicate on the "difficulty" field.
+func DifficultyNotIn(vs ...float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNotIn(FieldDifficulty, vs...))
+}
+
+// DifficultyGT applies the GT predicate on the "difficulty" field.
+func DifficultyGT(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGT(FieldDifficulty, v))
+}
+
+// DifficultyGTE applies the GTE predicate on the "difficulty" field.
+func DifficultyGTE(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGTE(FieldDifficulty, v))
+}
+
+// DifficultyLT applies the LT predicate on the "difficulty" field.
+func DifficultyLT(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLT(FieldDifficulty, v))
+}
+
+// DifficultyLTE applies the LTE predicate on the "difficulty" field.
+func DifficultyLTE(v float64) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLTE(FieldDifficulty, v))
+}
+
+// AnswerCountEQ applies the EQ predicate on the "answer_count" field.
+func AnswerCountEQ(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldAnswerCount, v))
+}
+
+// AnswerCountNEQ applies the NEQ predicate on the "answer_count" field.
+func AnswerCountNEQ(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNEQ(FieldAnswerCount, v))
+}
+
+// AnswerCountIn applies the In predicate on the "answer_count" field.
+func AnswerCountIn(vs ...int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldIn(FieldAnswerCount, vs...))
+}
+
+// AnswerCountNotIn applies the NotIn predicate on the "answer_count" field.
+func AnswerCountNotIn(vs ...int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNotIn(FieldAnswerCount, vs...))
+}
+
+// AnswerCountGT applies the GT predicate on the "answer_count" field.
+func AnswerCountGT(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGT(FieldAnswerCount, v))
+}
+
+// AnswerCountGTE applies the GTE predicate on the "answer_count" field.
+func AnswerCountGTE(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGTE(FieldAnswerCount, v))
+}
+
+// AnswerCountLT applies the LT predicate on the "answer_count" field.
+func AnswerCountLT(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLT(FieldAnswerCount, v))
+}
+
+// AnswerCountLTE applies the LTE predicate on the "answer_count" field.
+func AnswerCountLTE(v int) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLTE(FieldAnswerCount, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasFlashcard applies the HasEdge predicate on the "flashcard" edge.
+func HasFlashcard() predicate.FlashcardRating {
+	return predicate.FlashcardRating(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, FlashcardTable, FlashcardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlashcardWith applies the HasEdge predicate on the "flashcard" edge with a given conditions (other predicates).
+func HasFlashcardWith(preds ...predicate.Flashcard) predicate.FlashcardRating {
+	return predicate.FlashcardRating(func(s *sql.Selector) {
+		step := newFlashcardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FlashcardRating) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FlashcardRating) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FlashcardRating) predicate.FlashcardRating {
+	return predicate.FlashcardRating(sql.NotPredicates(p))
+}
@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
+)
+
+// FlashcardRatingCreate is the builder for creating a FlashcardRating entity.
+type FlashcardRatingCreate struct {
+	config
+	mutation *FlashcardRatingMutation
+	hooks    []Hook
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_c *FlashcardRatingCreate) SetFlashcardID(v uuid.UUID) *FlashcardRatingCreate {
+	_c.mutation.SetFlashcardID(v)
+	return _c
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (_c *FlashcardRatingCreate) SetDifficulty(v float64) *FlashcardRatingCreate {
+	_c.mutation.SetDifficulty(v)
+	return _c
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (_c *FlashcardRatingCreate) SetNillableDifficulty(v *float64) *FlashcardRatingCreate {
+	if v != nil {
+		_c.SetDifficulty(*v)
+	}
+	return _c
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (_c *FlashcardRatingCreate) SetAnswerCount(v int) *FlashcardRatingCreate {
+	_c.mutation.SetAnswerCount(v)
+	return _c
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (_c *FlashcardRatingCreate) SetNillableAnswerCount(v *int) *FlashcardRatingCreate {
+	if v != nil {
+		_c.SetAnswerCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *FlashcardRatingCreate) SetUpdatedAt(v time.Time) *FlashcardRatingCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *FlashcardRatingCreate) SetNillableUpdatedAt(v *time.Time) *FlashcardRatingCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FlashcardRatingCreate) SetID(v uuid.UUID) *FlashcardRatingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FlashcardRatingCreate) SetNillableID(v *uuid.UUID) *FlashcardRatingCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_c *FlashcardRatingCreate) SetFlashcard(v *Flashcard) *FlashcardRatingCreate {
+	return _c.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardRatingMutation object of the builder.
+func (_c *FlashcardRatingCreate) Mutation() *FlashcardRatingMutation {
+	return _c.mutation
+}
+
+// Save creates the FlashcardRating in the database.
+func (_c *FlashcardRatingCreate) Save(ctx context.Context) (*FlashcardRating, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FlashcardRatingCreate) SaveX(ctx context.Context) *FlashcardRating {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardRatingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardRatingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FlashcardRatingCreate) defaults() {
+	if _, ok := _c.mutation.Difficulty(); !ok {
+		v := flashcardrating.DefaultDifficulty
+		_c.mutation.SetDifficulty(v)
+	}
+	if _, ok := _c.mutation.AnswerCount(); !ok {
+		v := flashcardrating.DefaultAnswerCount
+		_c.mutation.SetAnswerCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := flashcardrating.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := flashcardrating.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FlashcardRatingCreate) check() error {
+	if _, ok := _c.mutation.FlashcardID(); !ok {
+		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "FlashcardRating.flashcard_id"`)}
+	}
+	if _, ok := _c.mutation.Difficulty(); !ok {
+		return &ValidationError{Name: "difficulty", err: errors.New(`ent: missing required field "FlashcardRating.difficulty"`)}
+	}
+	if _, ok := _c.mutation.AnswerCount(); !ok {
+		return &ValidationError{Name: "answer_count", err: errors.New(`ent: missing required field "FlashcardRating.answer_count"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FlashcardRating.updated_at"`)}
+	}
+	if len(_c.mutation.FlashcardIDs()) == 0 {
+		return &ValidationError{Name: "flashcard", err: errors.New(`ent: missing required edge "FlashcardRating.flashcard"`)}
+	}
+	return nil
+}
+
+func (_c *FlashcardRatingCreate) sqlSave(ctx context.Context) (*FlashcardRating, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FlashcardRatingCreate) createSpec() (*FlashcardRating, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FlashcardRating{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(flashcardrating.Table, sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Difficulty(); ok {
+		_spec.SetField(flashcardrating.FieldDifficulty, field.TypeFloat64, value)
+		_node.Difficulty = value
+	}
+	if value, ok := _c.mutation.AnswerCount(); ok {
+		_spec.SetField(flashcardrating.FieldAnswerCount, field.TypeInt, value)
+		_node.AnswerCount = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(flashcardrating.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   flashcardrating.FlashcardTable,
+			Columns: []string{flashcardrating.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlashcardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FlashcardRatingCreateBulk is the builder for creating many FlashcardRating entities in bulk.
+type FlashcardRatingCreateBulk struct {
+	config
+	err      error
+	builders []*FlashcardRatingCreate
+}
+
+// Save creates the FlashcardRating entities in the database.
+func (_c *FlashcardRatingCreateBulk) Save(ctx context.Context) ([]*FlashcardRating, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FlashcardRating, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FlashcardRatingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FlashcardRatingCreateBulk) SaveX(ctx context.Context) []*FlashcardRating {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardRatingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardRatingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
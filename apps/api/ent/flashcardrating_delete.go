@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardRatingDelete is the builder for deleting a FlashcardRating entity.
+type FlashcardRatingDelete struct {
+	config
+	hooks    []Hook
+	mutation *FlashcardRatingMutation
+}
+
+// Where appends a list predicates to the FlashcardRatingDelete builder.
+func (_d *FlashcardRatingDelete) Where(ps ...predicate.FlashcardRating) *FlashcardRatingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FlashcardRatingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FlashcardRatingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FlashcardRatingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(flashcardrating.Table, sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FlashcardRatingDeleteOne is the builder for deleting a single FlashcardRating entity.
+type FlashcardRatingDeleteOne struct {
+	_d *FlashcardRatingDelete
+}
+
+// Where appends a list predicates to the FlashcardRatingDelete builder.
+func (_d *FlashcardRatingDeleteOne) Where(ps ...predicate.FlashcardRating) *FlashcardRatingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FlashcardRatingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{flashcardrating.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FlashcardRatingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
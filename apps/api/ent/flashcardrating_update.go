@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardRatingUpdate is the builder for updating FlashcardRating entities.
+type FlashcardRatingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FlashcardRatingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FlashcardRatingUpdate builder.
+func (_u *FlashcardRatingUpdate) Where(ps ...predicate.FlashcardRating) *FlashcardRatingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (_u *FlashcardRatingUpdate) SetDifficulty(v float64) *FlashcardRatingUpdate {
+	_u.mutation.ResetDifficulty()
+	_u.mutation.SetDifficulty(v)
+	return _u
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (_u *FlashcardRatingUpdate) SetNillableDifficulty(v *float64) *FlashcardRatingUpdate {
+	if v != nil {
+		_u.SetDifficulty(*v)
+	}
+	return _u
+}
+
+// AddDifficulty adds value to the "difficulty" field.
+func (_u *FlashcardRatingUpdate) AddDifficulty(v float64) *FlashcardRatingUpdate {
+	_u.mutation.AddDifficulty(v)
+	return _u
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (_u *FlashcardRatingUpdate) SetAnswerCount(v int) *FlashcardRatingUpdate {
+	_u.mutation.ResetAnswerCount()
+	_u.mutation.SetAnswerCount(v)
+	return _u
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (_u *FlashcardRatingUpdate) SetNillableAnswerCount(v *int) *FlashcardRatingUpdate {
+	if v != nil {
+		_u.SetAnswerCount(*v)
+	}
+	return _u
+}
+
+// AddAnswerCount adds value to the "answer_count" field.
+func (_u *FlashcardRatingUpdate) AddAnswerCount(v int) *FlashcardRatingUpdate {
+	_u.mutation.AddAnswerCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FlashcardRatingUpdate) SetUpdatedAt(v time.Time) *FlashcardRatingUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the FlashcardRatingMutation object of the builder.
+func (_u *FlashcardRatingUpdate) Mutation() *FlashcardRatingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FlashcardRatingUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardRatingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FlashcardRatingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardRatingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FlashcardRatingUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := flashcardrating.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardRatingUpdate) check() error {
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardRating.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardRatingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardRatingUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardRatingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardrating.Table, flashcardrating.Columns, sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Difficulty(); ok {
+		_spec.SetField(flashcardrating.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDifficulty(); ok {
+		_spec.AddField(flashcardrating.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AnswerCount(); ok {
+		_spec.SetField(flashcardrating.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnswerCount(); ok {
+		_spec.AddField(flashcardrating.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(flashcardrating.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardrating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FlashcardRatingUpdateOne is the builder for updating a single FlashcardRating entity.
+type FlashcardRatingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FlashcardRatingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (_u *FlashcardRatingUpdateOne) SetDifficulty(v float64) *FlashcardRatingUpdateOne {
+	_u.mutation.ResetDifficulty()
+	_u.mutation.SetDifficulty(v)
+	return _u
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (_u *FlashcardRatingUpdateOne) SetNillableDifficulty(v *float64) *FlashcardRatingUpdateOne {
+	if v != nil {
+		_u.SetDifficulty(*v)
+	}
+	return _u
+}
+
+// AddDifficulty adds value to the "difficulty" field.
+func (_u *FlashcardRatingUpdateOne) AddDifficulty(v float64) *FlashcardRatingUpdateOne {
+	_u.mutation.AddDifficulty(v)
+	return _u
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (_u *FlashcardRatingUpdateOne) SetAnswerCount(v int) *FlashcardRatingUpdateOne {
+	_u.mutation.ResetAnswerCount()
+	_u.mutation.SetAnswerCount(v)
+	return _u
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (_u *FlashcardRatingUpdateOne) SetNillableAnswerCount(v *int) *FlashcardRatingUpdateOne {
+	if v != nil {
+		_u.SetAnswerCount(*v)
+	}
+	return _u
+}
+
+// AddAnswerCount adds value to the "answer_count" field.
+func (_u *FlashcardRatingUpdateOne) AddAnswerCount(v int) *FlashcardRatingUpdateOne {
+	_u.mutation.AddAnswerCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *FlashcardRatingUpdateOne) SetUpdatedAt(v time.Time) *FlashcardRatingUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the FlashcardRatingMutation object of the builder.
+func (_u *FlashcardRatingUpdateOne) Mutation() *FlashcardRatingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FlashcardRatingUpdate builder.
+func (_u *FlashcardRatingUpdateOne) Where(ps ...predicate.FlashcardRating) *FlashcardRatingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FlashcardRatingUpdateOne) Select(field string, fields ...string) *FlashcardRatingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FlashcardRating entity.
+func (_u *FlashcardRatingUpdateOne) Save(ctx context.Context) (*FlashcardRating, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardRatingUpdateOne) SaveX(ctx context.Context) *FlashcardRating {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FlashcardRatingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardRatingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *FlashcardRatingUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := flashcardrating.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardRatingUpdateOne) check() error {
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardRating.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardRatingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardRatingUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardRatingUpdateOne) sqlSave(ctx context.Context) (_node *FlashcardRating, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardrating.Table, flashcardrating.Columns, sqlgraph.NewFieldSpec(flashcardrating.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FlashcardRating.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, flashcardrating.FieldID)
+		for _, f := range fields {
+			if !flashcardrating.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != flashcardrating.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Difficulty(); ok {
+		_spec.SetField(flashcardrating.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedDifficulty(); ok {
+		_spec.AddField(flashcardrating.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AnswerCount(); ok {
+		_spec.SetField(flashcardrating.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnswerCount(); ok {
+		_spec.AddField(flashcardrating.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(flashcardrating.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FlashcardRating{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardrating.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
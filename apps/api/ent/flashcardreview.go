@@ -23,6 +23,8 @@ type FlashcardReview struct {
 	UserID string `json:"user_id,omitempty"`
 	// Foreign key to the flashcard being reviewed
 	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Review item within the flashcard (the mask index for image occlusion cards)
+	Item int `json:"item,omitempty"`
 	// Ease factor (difficulty multiplier), minimum 1.3 like Anki
 	EaseFactor float64 `json:"ease_factor,omitempty"`
 	// Current interval in minutes (0 means new/learning)
@@ -37,6 +39,8 @@ type FlashcardReview struct {
 	ReviewCount int `json:"review_count,omitempty"`
 	// Number of times the card was forgotten (rated 'Again')
 	LapseCount int `json:"lapse_count,omitempty"`
+	// Number of reviews answered after revealing the hint
+	HintCount int `json:"hint_count,omitempty"`
 	// When the card was last reviewed
 	LastReviewedAt *time.Time `json:"last_reviewed_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -53,9 +57,11 @@ type FlashcardReview struct {
 type FlashcardReviewEdges struct {
 	// Flashcard holds the value of the flashcard edge.
 	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// QuizUpdates holds the value of the quiz_updates edge.
+	QuizUpdates []*QuizReviewUpdate `json:"quiz_updates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // FlashcardOrErr returns the Flashcard value or an error if the edge
@@ -69,6 +75,15 @@ func (e FlashcardReviewEdges) FlashcardOrErr() (*Flashcard, error) {
 	return nil, &NotLoadedError{edge: "flashcard"}
 }
 
+// QuizUpdatesOrErr returns the QuizUpdates value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlashcardReviewEdges) QuizUpdatesOrErr() ([]*QuizReviewUpdate, error) {
+	if e.loadedTypes[1] {
+		return e.QuizUpdates, nil
+	}
+	return nil, &NotLoadedError{edge: "quiz_updates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*FlashcardReview) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -76,7 +91,7 @@ func (*FlashcardReview) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case flashcardreview.FieldEaseFactor:
 			values[i] = new(sql.NullFloat64)
-		case flashcardreview.FieldInterval, flashcardreview.FieldLearningStep, flashcardreview.FieldReviewCount, flashcardreview.FieldLapseCount:
+		case flashcardreview.FieldItem, flashcardreview.FieldInterval, flashcardreview.FieldLearningStep, flashcardreview.FieldReviewCount, flashcardreview.FieldLapseCount, flashcardreview.FieldHintCount:
 			values[i] = new(sql.NullInt64)
 		case flashcardreview.FieldUserID, flashcardreview.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -117,6 +132,12 @@ func (_m *FlashcardReview) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.FlashcardID = *value
 			}
+		case flashcardreview.FieldItem:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item", values[i])
+			} else if value.Valid {
+				_m.Item = int(value.Int64)
+			}
 		case flashcardreview.FieldEaseFactor:
 			if value, ok := values[i].(*sql.NullFloat64); !ok {
 				return fmt.Errorf("unexpected type %T for field ease_factor", values[i])
@@ -159,6 +180,12 @@ func (_m *FlashcardReview) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.LapseCount = int(value.Int64)
 			}
+		case flashcardreview.FieldHintCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hint_count", values[i])
+			} else if value.Valid {
+				_m.HintCount = int(value.Int64)
+			}
 		case flashcardreview.FieldLastReviewedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_reviewed_at", values[i])
@@ -196,6 +223,11 @@ func (_m *FlashcardReview) QueryFlashcard() *FlashcardQuery {
 	return NewFlashcardReviewClient(_m.config).QueryFlashcard(_m)
 }
 
+// QueryQuizUpdates queries the "quiz_updates" edge of the FlashcardReview entity.
+func (_m *FlashcardReview) QueryQuizUpdates() *QuizReviewUpdateQuery {
+	return NewFlashcardReviewClient(_m.config).QueryQuizUpdates(_m)
+}
+
 // Update returns a builder for updating this FlashcardReview.
 // Note that you need to call FlashcardReview.Unwrap() before calling this method if this FlashcardReview
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -225,6 +257,9 @@ func (_m *FlashcardReview) String() string {
 	builder.WriteString("flashcard_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
 	builder.WriteString(", ")
+	builder.WriteString("item=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Item))
+	builder.WriteString(", ")
 	builder.WriteString("ease_factor=")
 	builder.WriteString(fmt.Sprintf("%v", _m.EaseFactor))
 	builder.WriteString(", ")
@@ -246,6 +281,9 @@ func (_m *FlashcardReview) String() string {
 	builder.WriteString("lapse_count=")
 	builder.WriteString(fmt.Sprintf("%v", _m.LapseCount))
 	builder.WriteString(", ")
+	builder.WriteString("hint_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HintCount))
+	builder.WriteString(", ")
 	if v := _m.LastReviewedAt; v != nil {
 		builder.WriteString("last_reviewed_at=")
 		builder.WriteString(v.Format(time.ANSIC))
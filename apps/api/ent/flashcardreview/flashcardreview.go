@@ -20,6 +20,8 @@ const (
 	FieldUserID = "user_id"
 	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
 	FieldFlashcardID = "flashcard_id"
+	// FieldItem holds the string denoting the item field in the database.
+	FieldItem = "item"
 	// FieldEaseFactor holds the string denoting the ease_factor field in the database.
 	FieldEaseFactor = "ease_factor"
 	// FieldInterval holds the string denoting the interval field in the database.
@@ -34,6 +36,8 @@ const (
 	FieldReviewCount = "review_count"
 	// FieldLapseCount holds the string denoting the lapse_count field in the database.
 	FieldLapseCount = "lapse_count"
+	// FieldHintCount holds the string denoting the hint_count field in the database.
+	FieldHintCount = "hint_count"
 	// FieldLastReviewedAt holds the string denoting the last_reviewed_at field in the database.
 	FieldLastReviewedAt = "last_reviewed_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -42,6 +46,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
 	EdgeFlashcard = "flashcard"
+	// EdgeQuizUpdates holds the string denoting the quiz_updates edge name in mutations.
+	EdgeQuizUpdates = "quiz_updates"
 	// Table holds the table name of the flashcardreview in the database.
 	Table = "flashcard_reviews"
 	// FlashcardTable is the table that holds the flashcard relation/edge.
@@ -51,6 +57,13 @@ const (
 	FlashcardInverseTable = "flashcards"
 	// FlashcardColumn is the table column denoting the flashcard relation/edge.
 	FlashcardColumn = "flashcard_id"
+	// QuizUpdatesTable is the table that holds the quiz_updates relation/edge.
+	QuizUpdatesTable = "quiz_review_updates"
+	// QuizUpdatesInverseTable is the table name for the QuizReviewUpdate entity.
+	// It exists in this package in order to avoid circular dependency with the "quizreviewupdate" package.
+	QuizUpdatesInverseTable = "quiz_review_updates"
+	// QuizUpdatesColumn is the table column denoting the quiz_updates relation/edge.
+	QuizUpdatesColumn = "review_id"
 )
 
 // Columns holds all SQL columns for flashcardreview fields.
@@ -58,6 +71,7 @@ var Columns = []string{
 	FieldID,
 	FieldUserID,
 	FieldFlashcardID,
+	FieldItem,
 	FieldEaseFactor,
 	FieldInterval,
 	FieldDueAt,
@@ -65,6 +79,7 @@ var Columns = []string{
 	FieldLearningStep,
 	FieldReviewCount,
 	FieldLapseCount,
+	FieldHintCount,
 	FieldLastReviewedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -83,6 +98,10 @@ func ValidColumn(column string) bool {
 var (
 	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
 	UserIDValidator func(string) error
+	// DefaultItem holds the default value on creation for the "item" field.
+	DefaultItem int
+	// ItemValidator is a validator for the "item" field. It is called by the builders before save.
+	ItemValidator func(int) error
 	// DefaultEaseFactor holds the default value on creation for the "ease_factor" field.
 	DefaultEaseFactor float64
 	// EaseFactorValidator is a validator for the "ease_factor" field. It is called by the builders before save.
@@ -105,6 +124,10 @@ var (
 	DefaultLapseCount int
 	// LapseCountValidator is a validator for the "lapse_count" field. It is called by the builders before save.
 	LapseCountValidator func(int) error
+	// DefaultHintCount holds the default value on creation for the "hint_count" field.
+	DefaultHintCount int
+	// HintCountValidator is a validator for the "hint_count" field. It is called by the builders before save.
+	HintCountValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -161,6 +184,11 @@ func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
 }
 
+// ByItem orders the results by the item field.
+func ByItem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItem, opts...).ToFunc()
+}
+
 // ByEaseFactor orders the results by the ease_factor field.
 func ByEaseFactor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEaseFactor, opts...).ToFunc()
@@ -196,6 +224,11 @@ func ByLapseCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLapseCount, opts...).ToFunc()
 }
 
+// ByHintCount orders the results by the hint_count field.
+func ByHintCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHintCount, opts...).ToFunc()
+}
+
 // ByLastReviewedAt orders the results by the last_reviewed_at field.
 func ByLastReviewedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastReviewedAt, opts...).ToFunc()
@@ -217,6 +250,20 @@ func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByQuizUpdatesCount orders the results by quiz_updates count.
+func ByQuizUpdatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newQuizUpdatesStep(), opts...)
+	}
+}
+
+// ByQuizUpdates orders the results by quiz_updates terms.
+func ByQuizUpdates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizUpdatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFlashcardStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -224,3 +271,10 @@ func newFlashcardStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
 	)
 }
+func newQuizUpdatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizUpdatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, QuizUpdatesTable, QuizUpdatesColumn),
+	)
+}
@@ -66,6 +66,11 @@ func FlashcardID(v uuid.UUID) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldEQ(FieldFlashcardID, v))
 }
 
+// Item applies equality check predicate on the "item" field. It's identical to ItemEQ.
+func Item(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldEQ(FieldItem, v))
+}
+
 // EaseFactor applies equality check predicate on the "ease_factor" field. It's identical to EaseFactorEQ.
 func EaseFactor(v float64) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldEQ(FieldEaseFactor, v))
@@ -96,6 +101,11 @@ func LapseCount(v int) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldEQ(FieldLapseCount, v))
 }
 
+// HintCount applies equality check predicate on the "hint_count" field. It's identical to HintCountEQ.
+func HintCount(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldEQ(FieldHintCount, v))
+}
+
 // LastReviewedAt applies equality check predicate on the "last_reviewed_at" field. It's identical to LastReviewedAtEQ.
 func LastReviewedAt(v time.Time) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldEQ(FieldLastReviewedAt, v))
@@ -196,6 +206,46 @@ func FlashcardIDNotIn(vs ...uuid.UUID) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldNotIn(FieldFlashcardID, vs...))
 }
 
+// ItemEQ applies the EQ predicate on the "item" field.
+func ItemEQ(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldEQ(FieldItem, v))
+}
+
+// ItemNEQ applies the NEQ predicate on the "item" field.
+func ItemNEQ(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldNEQ(FieldItem, v))
+}
+
+// ItemIn applies the In predicate on the "item" field.
+func ItemIn(vs ...int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldIn(FieldItem, vs...))
+}
+
+// ItemNotIn applies the NotIn predicate on the "item" field.
+func ItemNotIn(vs ...int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldNotIn(FieldItem, vs...))
+}
+
+// ItemGT applies the GT predicate on the "item" field.
+func ItemGT(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldGT(FieldItem, v))
+}
+
+// ItemGTE applies the GTE predicate on the "item" field.
+func ItemGTE(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldGTE(FieldItem, v))
+}
+
+// ItemLT applies the LT predicate on the "item" field.
+func ItemLT(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldLT(FieldItem, v))
+}
+
+// ItemLTE applies the LTE predicate on the "item" field.
+func ItemLTE(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldLTE(FieldItem, v))
+}
+
 // EaseFactorEQ applies the EQ predicate on the "ease_factor" field.
 func EaseFactorEQ(v float64) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldEQ(FieldEaseFactor, v))
@@ -456,6 +506,46 @@ func LapseCountLTE(v int) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldLTE(FieldLapseCount, v))
 }
 
+// HintCountEQ applies the EQ predicate on the "hint_count" field.
+func HintCountEQ(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldEQ(FieldHintCount, v))
+}
+
+// HintCountNEQ applies the NEQ predicate on the "hint_count" field.
+func HintCountNEQ(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldNEQ(FieldHintCount, v))
+}
+
+// HintCountIn applies the In predicate on the "hint_count" field.
+func HintCountIn(vs ...int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldIn(FieldHintCount, vs...))
+}
+
+// HintCountNotIn applies the NotIn predicate on the "hint_count" field.
+func HintCountNotIn(vs ...int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldNotIn(FieldHintCount, vs...))
+}
+
+// HintCountGT applies the GT predicate on the "hint_count" field.
+func HintCountGT(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldGT(FieldHintCount, v))
+}
+
+// HintCountGTE applies the GTE predicate on the "hint_count" field.
+func HintCountGTE(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldGTE(FieldHintCount, v))
+}
+
+// HintCountLT applies the LT predicate on the "hint_count" field.
+func HintCountLT(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldLT(FieldHintCount, v))
+}
+
+// HintCountLTE applies the LTE predicate on the "hint_count" field.
+func HintCountLTE(v int) predicate.FlashcardReview {
+	return predicate.FlashcardReview(sql.FieldLTE(FieldHintCount, v))
+}
+
 // LastReviewedAtEQ applies the EQ predicate on the "last_reviewed_at" field.
 func LastReviewedAtEQ(v time.Time) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.FieldEQ(FieldLastReviewedAt, v))
@@ -609,6 +699,29 @@ func HasFlashcardWith(preds ...predicate.Flashcard) predicate.FlashcardReview {
 	})
 }
 
+// HasQuizUpdates applies the HasEdge predicate on the "quiz_updates" edge.
+func HasQuizUpdates() predicate.FlashcardReview {
+	return predicate.FlashcardReview(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuizUpdatesTable, QuizUpdatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizUpdatesWith applies the HasEdge predicate on the "quiz_updates" edge with a given conditions (other predicates).
+func HasQuizUpdatesWith(preds ...predicate.QuizReviewUpdate) predicate.FlashcardReview {
+	return predicate.FlashcardReview(func(s *sql.Selector) {
+		step := newQuizUpdatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FlashcardReview) predicate.FlashcardReview {
 	return predicate.FlashcardReview(sql.AndPredicates(predicates...))
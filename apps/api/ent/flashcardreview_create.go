@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
 )
 
 // FlashcardReviewCreate is the builder for creating a FlashcardReview entity.
@@ -34,6 +35,20 @@ func (_c *FlashcardReviewCreate) SetFlashcardID(v uuid.UUID) *FlashcardReviewCre
 	return _c
 }
 
+// SetItem sets the "item" field.
+func (_c *FlashcardReviewCreate) SetItem(v int) *FlashcardReviewCreate {
+	_c.mutation.SetItem(v)
+	return _c
+}
+
+// SetNillableItem sets the "item" field if the given value is not nil.
+func (_c *FlashcardReviewCreate) SetNillableItem(v *int) *FlashcardReviewCreate {
+	if v != nil {
+		_c.SetItem(*v)
+	}
+	return _c
+}
+
 // SetEaseFactor sets the "ease_factor" field.
 func (_c *FlashcardReviewCreate) SetEaseFactor(v float64) *FlashcardReviewCreate {
 	_c.mutation.SetEaseFactor(v)
@@ -132,6 +147,20 @@ func (_c *FlashcardReviewCreate) SetNillableLapseCount(v *int) *FlashcardReviewC
 	return _c
 }
 
+// SetHintCount sets the "hint_count" field.
+func (_c *FlashcardReviewCreate) SetHintCount(v int) *FlashcardReviewCreate {
+	_c.mutation.SetHintCount(v)
+	return _c
+}
+
+// SetNillableHintCount sets the "hint_count" field if the given value is not nil.
+func (_c *FlashcardReviewCreate) SetNillableHintCount(v *int) *FlashcardReviewCreate {
+	if v != nil {
+		_c.SetHintCount(*v)
+	}
+	return _c
+}
+
 // SetLastReviewedAt sets the "last_reviewed_at" field.
 func (_c *FlashcardReviewCreate) SetLastReviewedAt(v time.Time) *FlashcardReviewCreate {
 	_c.mutation.SetLastReviewedAt(v)
@@ -193,6 +222,21 @@ func (_c *FlashcardReviewCreate) SetFlashcard(v *Flashcard) *FlashcardReviewCrea
 	return _c.SetFlashcardID(v.ID)
 }
 
+// AddQuizUpdateIDs adds the "quiz_updates" edge to the QuizReviewUpdate entity by IDs.
+func (_c *FlashcardReviewCreate) AddQuizUpdateIDs(ids ...uuid.UUID) *FlashcardReviewCreate {
+	_c.mutation.AddQuizUpdateIDs(ids...)
+	return _c
+}
+
+// AddQuizUpdates adds the "quiz_updates" edges to the QuizReviewUpdate entity.
+func (_c *FlashcardReviewCreate) AddQuizUpdates(v ...*QuizReviewUpdate) *FlashcardReviewCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddQuizUpdateIDs(ids...)
+}
+
 // Mutation returns the FlashcardReviewMutation object of the builder.
 func (_c *FlashcardReviewCreate) Mutation() *FlashcardReviewMutation {
 	return _c.mutation
@@ -228,6 +272,10 @@ func (_c *FlashcardReviewCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *FlashcardReviewCreate) defaults() {
+	if _, ok := _c.mutation.Item(); !ok {
+		v := flashcardreview.DefaultItem
+		_c.mutation.SetItem(v)
+	}
 	if _, ok := _c.mutation.EaseFactor(); !ok {
 		v := flashcardreview.DefaultEaseFactor
 		_c.mutation.SetEaseFactor(v)
@@ -256,6 +304,10 @@ func (_c *FlashcardReviewCreate) defaults() {
 		v := flashcardreview.DefaultLapseCount
 		_c.mutation.SetLapseCount(v)
 	}
+	if _, ok := _c.mutation.HintCount(); !ok {
+		v := flashcardreview.DefaultHintCount
+		_c.mutation.SetHintCount(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := flashcardreview.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -283,6 +335,14 @@ func (_c *FlashcardReviewCreate) check() error {
 	if _, ok := _c.mutation.FlashcardID(); !ok {
 		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "FlashcardReview.flashcard_id"`)}
 	}
+	if _, ok := _c.mutation.Item(); !ok {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required field "FlashcardReview.item"`)}
+	}
+	if v, ok := _c.mutation.Item(); ok {
+		if err := flashcardreview.ItemValidator(v); err != nil {
+			return &ValidationError{Name: "item", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.item": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.EaseFactor(); !ok {
 		return &ValidationError{Name: "ease_factor", err: errors.New(`ent: missing required field "FlashcardReview.ease_factor"`)}
 	}
@@ -334,6 +394,14 @@ func (_c *FlashcardReviewCreate) check() error {
 			return &ValidationError{Name: "lapse_count", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.lapse_count": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.HintCount(); !ok {
+		return &ValidationError{Name: "hint_count", err: errors.New(`ent: missing required field "FlashcardReview.hint_count"`)}
+	}
+	if v, ok := _c.mutation.HintCount(); ok {
+		if err := flashcardreview.HintCountValidator(v); err != nil {
+			return &ValidationError{Name: "hint_count", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.hint_count": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FlashcardReview.created_at"`)}
 	}
@@ -382,6 +450,10 @@ func (_c *FlashcardReviewCreate) createSpec() (*FlashcardReview, *sqlgraph.Creat
 		_spec.SetField(flashcardreview.FieldUserID, field.TypeString, value)
 		_node.UserID = value
 	}
+	if value, ok := _c.mutation.Item(); ok {
+		_spec.SetField(flashcardreview.FieldItem, field.TypeInt, value)
+		_node.Item = value
+	}
 	if value, ok := _c.mutation.EaseFactor(); ok {
 		_spec.SetField(flashcardreview.FieldEaseFactor, field.TypeFloat64, value)
 		_node.EaseFactor = value
@@ -410,6 +482,10 @@ func (_c *FlashcardReviewCreate) createSpec() (*FlashcardReview, *sqlgraph.Creat
 		_spec.SetField(flashcardreview.FieldLapseCount, field.TypeInt, value)
 		_node.LapseCount = value
 	}
+	if value, ok := _c.mutation.HintCount(); ok {
+		_spec.SetField(flashcardreview.FieldHintCount, field.TypeInt, value)
+		_node.HintCount = value
+	}
 	if value, ok := _c.mutation.LastReviewedAt(); ok {
 		_spec.SetField(flashcardreview.FieldLastReviewedAt, field.TypeTime, value)
 		_node.LastReviewedAt = &value
@@ -439,6 +515,22 @@ func (_c *FlashcardReviewCreate) createSpec() (*FlashcardReview, *sqlgraph.Creat
 		_node.FlashcardID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.QuizUpdatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -4,10 +4,12 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -15,16 +17,19 @@ import (
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
 	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
 )
 
 // FlashcardReviewQuery is the builder for querying FlashcardReview entities.
 type FlashcardReviewQuery struct {
 	config
-	ctx           *QueryContext
-	order         []flashcardreview.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.FlashcardReview
-	withFlashcard *FlashcardQuery
+	ctx             *QueryContext
+	order           []flashcardreview.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.FlashcardReview
+	withFlashcard   *FlashcardQuery
+	withQuizUpdates *QuizReviewUpdateQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +88,28 @@ func (_q *FlashcardReviewQuery) QueryFlashcard() *FlashcardQuery {
 	return query
 }
 
+// QueryQuizUpdates chains the current query on the "quiz_updates" edge.
+func (_q *FlashcardReviewQuery) QueryQuizUpdates() *QuizReviewUpdateQuery {
+	query := (&QuizReviewUpdateClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flashcardreview.Table, flashcardreview.FieldID, selector),
+			sqlgraph.To(quizreviewupdate.Table, quizreviewupdate.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flashcardreview.QuizUpdatesTable, flashcardreview.QuizUpdatesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first FlashcardReview entity from the query.
 // Returns a *NotFoundError when no FlashcardReview was found.
 func (_q *FlashcardReviewQuery) First(ctx context.Context) (*FlashcardReview, error) {
@@ -270,15 +297,17 @@ func (_q *FlashcardReviewQuery) Clone() *FlashcardReviewQuery {
 		return nil
 	}
 	return &FlashcardReviewQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]flashcardreview.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.FlashcardReview{}, _q.predicates...),
-		withFlashcard: _q.withFlashcard.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]flashcardreview.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.FlashcardReview{}, _q.predicates...),
+		withFlashcard:   _q.withFlashcard.Clone(),
+		withQuizUpdates: _q.withQuizUpdates.Clone(),
 		// clone intermediate query.
-		sql:  _q.sql.Clone(),
-		path: _q.path,
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
 	}
 }
 
@@ -293,6 +322,17 @@ func (_q *FlashcardReviewQuery) WithFlashcard(opts ...func(*FlashcardQuery)) *Fl
 	return _q
 }
 
+// WithQuizUpdates tells the query-builder to eager-load the nodes that are connected to
+// the "quiz_updates" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlashcardReviewQuery) WithQuizUpdates(opts ...func(*QuizReviewUpdateQuery)) *FlashcardReviewQuery {
+	query := (&QuizReviewUpdateClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withQuizUpdates = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +411,9 @@ func (_q *FlashcardReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*FlashcardReview{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			_q.withFlashcard != nil,
+			_q.withQuizUpdates != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -384,6 +425,9 @@ func (_q *FlashcardReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -399,6 +443,13 @@ func (_q *FlashcardReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withQuizUpdates; query != nil {
+		if err := _q.loadQuizUpdates(ctx, query, nodes,
+			func(n *FlashcardReview) { n.Edges.QuizUpdates = []*QuizReviewUpdate{} },
+			func(n *FlashcardReview, e *QuizReviewUpdate) { n.Edges.QuizUpdates = append(n.Edges.QuizUpdates, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -431,9 +482,42 @@ func (_q *FlashcardReviewQuery) loadFlashcard(ctx context.Context, query *Flashc
 	}
 	return nil
 }
+func (_q *FlashcardReviewQuery) loadQuizUpdates(ctx context.Context, query *QuizReviewUpdateQuery, nodes []*FlashcardReview, init func(*FlashcardReview), assign func(*FlashcardReview, *QuizReviewUpdate)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*FlashcardReview)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(quizreviewupdate.FieldReviewID)
+	}
+	query.Where(predicate.QuizReviewUpdate(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flashcardreview.QuizUpdatesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReviewID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "review_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *FlashcardReviewQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -499,6 +583,9 @@ func (_q *FlashcardReviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -516,6 +603,38 @@ func (_q *FlashcardReviewQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *FlashcardReviewQuery) ForUpdate(opts ...sql.LockOption) *FlashcardReviewQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *FlashcardReviewQuery) ForShare(opts ...sql.LockOption) *FlashcardReviewQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *FlashcardReviewQuery) Modify(modifiers ...func(s *sql.Selector)) *FlashcardReviewSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
 // FlashcardReviewGroupBy is the group-by builder for FlashcardReview entities.
 type FlashcardReviewGroupBy struct {
 	selector
@@ -605,3 +724,9 @@ func (_s *FlashcardReviewSelect) sqlScan(ctx context.Context, root *FlashcardRev
 	defer rows.Close()
 	return sql.ScanSlice(rows, v)
 }
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *FlashcardReviewSelect) Modify(modifiers ...func(s *sql.Selector)) *FlashcardReviewSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
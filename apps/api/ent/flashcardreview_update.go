@@ -15,13 +15,15 @@ import (
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
 	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
 )
 
 // FlashcardReviewUpdate is the builder for updating FlashcardReview entities.
 type FlashcardReviewUpdate struct {
 	config
-	hooks    []Hook
-	mutation *FlashcardReviewMutation
+	hooks     []Hook
+	mutation  *FlashcardReviewMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // Where appends a list predicates to the FlashcardReviewUpdate builder.
@@ -58,6 +60,27 @@ func (_u *FlashcardReviewUpdate) SetNillableFlashcardID(v *uuid.UUID) *Flashcard
 	return _u
 }
 
+// SetItem sets the "item" field.
+func (_u *FlashcardReviewUpdate) SetItem(v int) *FlashcardReviewUpdate {
+	_u.mutation.ResetItem()
+	_u.mutation.SetItem(v)
+	return _u
+}
+
+// SetNillableItem sets the "item" field if the given value is not nil.
+func (_u *FlashcardReviewUpdate) SetNillableItem(v *int) *FlashcardReviewUpdate {
+	if v != nil {
+		_u.SetItem(*v)
+	}
+	return _u
+}
+
+// AddItem adds value to the "item" field.
+func (_u *FlashcardReviewUpdate) AddItem(v int) *FlashcardReviewUpdate {
+	_u.mutation.AddItem(v)
+	return _u
+}
+
 // SetEaseFactor sets the "ease_factor" field.
 func (_u *FlashcardReviewUpdate) SetEaseFactor(v float64) *FlashcardReviewUpdate {
 	_u.mutation.ResetEaseFactor()
@@ -191,6 +214,27 @@ func (_u *FlashcardReviewUpdate) AddLapseCount(v int) *FlashcardReviewUpdate {
 	return _u
 }
 
+// SetHintCount sets the "hint_count" field.
+func (_u *FlashcardReviewUpdate) SetHintCount(v int) *FlashcardReviewUpdate {
+	_u.mutation.ResetHintCount()
+	_u.mutation.SetHintCount(v)
+	return _u
+}
+
+// SetNillableHintCount sets the "hint_count" field if the given value is not nil.
+func (_u *FlashcardReviewUpdate) SetNillableHintCount(v *int) *FlashcardReviewUpdate {
+	if v != nil {
+		_u.SetHintCount(*v)
+	}
+	return _u
+}
+
+// AddHintCount adds value to the "hint_count" field.
+func (_u *FlashcardReviewUpdate) AddHintCount(v int) *FlashcardReviewUpdate {
+	_u.mutation.AddHintCount(v)
+	return _u
+}
+
 // SetLastReviewedAt sets the "last_reviewed_at" field.
 func (_u *FlashcardReviewUpdate) SetLastReviewedAt(v time.Time) *FlashcardReviewUpdate {
 	_u.mutation.SetLastReviewedAt(v)
@@ -222,6 +266,21 @@ func (_u *FlashcardReviewUpdate) SetFlashcard(v *Flashcard) *FlashcardReviewUpda
 	return _u.SetFlashcardID(v.ID)
 }
 
+// AddQuizUpdateIDs adds the "quiz_updates" edge to the QuizReviewUpdate entity by IDs.
+func (_u *FlashcardReviewUpdate) AddQuizUpdateIDs(ids ...uuid.UUID) *FlashcardReviewUpdate {
+	_u.mutation.AddQuizUpdateIDs(ids...)
+	return _u
+}
+
+// AddQuizUpdates adds the "quiz_updates" edges to the QuizReviewUpdate entity.
+func (_u *FlashcardReviewUpdate) AddQuizUpdates(v ...*QuizReviewUpdate) *FlashcardReviewUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuizUpdateIDs(ids...)
+}
+
 // Mutation returns the FlashcardReviewMutation object of the builder.
 func (_u *FlashcardReviewUpdate) Mutation() *FlashcardReviewMutation {
 	return _u.mutation
@@ -233,6 +292,27 @@ func (_u *FlashcardReviewUpdate) ClearFlashcard() *FlashcardReviewUpdate {
 	return _u
 }
 
+// ClearQuizUpdates clears all "quiz_updates" edges to the QuizReviewUpdate entity.
+func (_u *FlashcardReviewUpdate) ClearQuizUpdates() *FlashcardReviewUpdate {
+	_u.mutation.ClearQuizUpdates()
+	return _u
+}
+
+// RemoveQuizUpdateIDs removes the "quiz_updates" edge to QuizReviewUpdate entities by IDs.
+func (_u *FlashcardReviewUpdate) RemoveQuizUpdateIDs(ids ...uuid.UUID) *FlashcardReviewUpdate {
+	_u.mutation.RemoveQuizUpdateIDs(ids...)
+	return _u
+}
+
+// RemoveQuizUpdates removes "quiz_updates" edges to QuizReviewUpdate entities.
+func (_u *FlashcardReviewUpdate) RemoveQuizUpdates(v ...*QuizReviewUpdate) *FlashcardReviewUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuizUpdateIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FlashcardReviewUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -276,6 +356,11 @@ func (_u *FlashcardReviewUpdate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.user_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Item(); ok {
+		if err := flashcardreview.ItemValidator(v); err != nil {
+			return &ValidationError{Name: "item", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.item": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EaseFactor(); ok {
 		if err := flashcardreview.EaseFactorValidator(v); err != nil {
 			return &ValidationError{Name: "ease_factor", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.ease_factor": %w`, err)}
@@ -306,12 +391,23 @@ func (_u *FlashcardReviewUpdate) check() error {
 			return &ValidationError{Name: "lapse_count", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.lapse_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.HintCount(); ok {
+		if err := flashcardreview.HintCountValidator(v); err != nil {
+			return &ValidationError{Name: "hint_count", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.hint_count": %w`, err)}
+		}
+	}
 	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FlashcardReview.flashcard"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardReviewUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardReviewUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FlashcardReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -327,6 +423,12 @@ func (_u *FlashcardReviewUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(flashcardreview.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Item(); ok {
+		_spec.SetField(flashcardreview.FieldItem, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItem(); ok {
+		_spec.AddField(flashcardreview.FieldItem, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.EaseFactor(); ok {
 		_spec.SetField(flashcardreview.FieldEaseFactor, field.TypeFloat64, value)
 	}
@@ -363,6 +465,12 @@ func (_u *FlashcardReviewUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.AddedLapseCount(); ok {
 		_spec.AddField(flashcardreview.FieldLapseCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.HintCount(); ok {
+		_spec.SetField(flashcardreview.FieldHintCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHintCount(); ok {
+		_spec.AddField(flashcardreview.FieldHintCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.LastReviewedAt(); ok {
 		_spec.SetField(flashcardreview.FieldLastReviewedAt, field.TypeTime, value)
 	}
@@ -401,6 +509,52 @@ func (_u *FlashcardReviewUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.QuizUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuizUpdatesIDs(); len(nodes) > 0 && !_u.mutation.QuizUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizUpdatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{flashcardreview.Label}
@@ -416,9 +570,10 @@ func (_u *FlashcardReviewUpdate) sqlSave(ctx context.Context) (_node int, err er
 // FlashcardReviewUpdateOne is the builder for updating a single FlashcardReview entity.
 type FlashcardReviewUpdateOne struct {
 	config
-	fields   []string
-	hooks    []Hook
-	mutation *FlashcardReviewMutation
+	fields    []string
+	hooks     []Hook
+	mutation  *FlashcardReviewMutation
+	modifiers []func(*sql.UpdateBuilder)
 }
 
 // SetUserID sets the "user_id" field.
@@ -449,6 +604,27 @@ func (_u *FlashcardReviewUpdateOne) SetNillableFlashcardID(v *uuid.UUID) *Flashc
 	return _u
 }
 
+// SetItem sets the "item" field.
+func (_u *FlashcardReviewUpdateOne) SetItem(v int) *FlashcardReviewUpdateOne {
+	_u.mutation.ResetItem()
+	_u.mutation.SetItem(v)
+	return _u
+}
+
+// SetNillableItem sets the "item" field if the given value is not nil.
+func (_u *FlashcardReviewUpdateOne) SetNillableItem(v *int) *FlashcardReviewUpdateOne {
+	if v != nil {
+		_u.SetItem(*v)
+	}
+	return _u
+}
+
+// AddItem adds value to the "item" field.
+func (_u *FlashcardReviewUpdateOne) AddItem(v int) *FlashcardReviewUpdateOne {
+	_u.mutation.AddItem(v)
+	return _u
+}
+
 // SetEaseFactor sets the "ease_factor" field.
 func (_u *FlashcardReviewUpdateOne) SetEaseFactor(v float64) *FlashcardReviewUpdateOne {
 	_u.mutation.ResetEaseFactor()
@@ -582,6 +758,27 @@ func (_u *FlashcardReviewUpdateOne) AddLapseCount(v int) *FlashcardReviewUpdateO
 	return _u
 }
 
+// SetHintCount sets the "hint_count" field.
+func (_u *FlashcardReviewUpdateOne) SetHintCount(v int) *FlashcardReviewUpdateOne {
+	_u.mutation.ResetHintCount()
+	_u.mutation.SetHintCount(v)
+	return _u
+}
+
+// SetNillableHintCount sets the "hint_count" field if the given value is not nil.
+func (_u *FlashcardReviewUpdateOne) SetNillableHintCount(v *int) *FlashcardReviewUpdateOne {
+	if v != nil {
+		_u.SetHintCount(*v)
+	}
+	return _u
+}
+
+// AddHintCount adds value to the "hint_count" field.
+func (_u *FlashcardReviewUpdateOne) AddHintCount(v int) *FlashcardReviewUpdateOne {
+	_u.mutation.AddHintCount(v)
+	return _u
+}
+
 // SetLastReviewedAt sets the "last_reviewed_at" field.
 func (_u *FlashcardReviewUpdateOne) SetLastReviewedAt(v time.Time) *FlashcardReviewUpdateOne {
 	_u.mutation.SetLastReviewedAt(v)
@@ -613,6 +810,21 @@ func (_u *FlashcardReviewUpdateOne) SetFlashcard(v *Flashcard) *FlashcardReviewU
 	return _u.SetFlashcardID(v.ID)
 }
 
+// AddQuizUpdateIDs adds the "quiz_updates" edge to the QuizReviewUpdate entity by IDs.
+func (_u *FlashcardReviewUpdateOne) AddQuizUpdateIDs(ids ...uuid.UUID) *FlashcardReviewUpdateOne {
+	_u.mutation.AddQuizUpdateIDs(ids...)
+	return _u
+}
+
+// AddQuizUpdates adds the "quiz_updates" edges to the QuizReviewUpdate entity.
+func (_u *FlashcardReviewUpdateOne) AddQuizUpdates(v ...*QuizReviewUpdate) *FlashcardReviewUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddQuizUpdateIDs(ids...)
+}
+
 // Mutation returns the FlashcardReviewMutation object of the builder.
 func (_u *FlashcardReviewUpdateOne) Mutation() *FlashcardReviewMutation {
 	return _u.mutation
@@ -624,6 +836,27 @@ func (_u *FlashcardReviewUpdateOne) ClearFlashcard() *FlashcardReviewUpdateOne {
 	return _u
 }
 
+// ClearQuizUpdates clears all "quiz_updates" edges to the QuizReviewUpdate entity.
+func (_u *FlashcardReviewUpdateOne) ClearQuizUpdates() *FlashcardReviewUpdateOne {
+	_u.mutation.ClearQuizUpdates()
+	return _u
+}
+
+// RemoveQuizUpdateIDs removes the "quiz_updates" edge to QuizReviewUpdate entities by IDs.
+func (_u *FlashcardReviewUpdateOne) RemoveQuizUpdateIDs(ids ...uuid.UUID) *FlashcardReviewUpdateOne {
+	_u.mutation.RemoveQuizUpdateIDs(ids...)
+	return _u
+}
+
+// RemoveQuizUpdates removes "quiz_updates" edges to QuizReviewUpdate entities.
+func (_u *FlashcardReviewUpdateOne) RemoveQuizUpdates(v ...*QuizReviewUpdate) *FlashcardReviewUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveQuizUpdateIDs(ids...)
+}
+
 // Where appends a list predicates to the FlashcardReviewUpdate builder.
 func (_u *FlashcardReviewUpdateOne) Where(ps ...predicate.FlashcardReview) *FlashcardReviewUpdateOne {
 	_u.mutation.Where(ps...)
@@ -680,6 +913,11 @@ func (_u *FlashcardReviewUpdateOne) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.user_id": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Item(); ok {
+		if err := flashcardreview.ItemValidator(v); err != nil {
+			return &ValidationError{Name: "item", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.item": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.EaseFactor(); ok {
 		if err := flashcardreview.EaseFactorValidator(v); err != nil {
 			return &ValidationError{Name: "ease_factor", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.ease_factor": %w`, err)}
@@ -710,12 +948,23 @@ func (_u *FlashcardReviewUpdateOne) check() error {
 			return &ValidationError{Name: "lapse_count", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.lapse_count": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.HintCount(); ok {
+		if err := flashcardreview.HintCountValidator(v); err != nil {
+			return &ValidationError{Name: "hint_count", err: fmt.Errorf(`ent: validator failed for field "FlashcardReview.hint_count": %w`, err)}
+		}
+	}
 	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "FlashcardReview.flashcard"`)
 	}
 	return nil
 }
 
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardReviewUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardReviewUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
 func (_u *FlashcardReviewUpdateOne) sqlSave(ctx context.Context) (_node *FlashcardReview, err error) {
 	if err := _u.check(); err != nil {
 		return _node, err
@@ -748,6 +997,12 @@ func (_u *FlashcardReviewUpdateOne) sqlSave(ctx context.Context) (_node *Flashca
 	if value, ok := _u.mutation.UserID(); ok {
 		_spec.SetField(flashcardreview.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Item(); ok {
+		_spec.SetField(flashcardreview.FieldItem, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedItem(); ok {
+		_spec.AddField(flashcardreview.FieldItem, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.EaseFactor(); ok {
 		_spec.SetField(flashcardreview.FieldEaseFactor, field.TypeFloat64, value)
 	}
@@ -784,6 +1039,12 @@ func (_u *FlashcardReviewUpdateOne) sqlSave(ctx context.Context) (_node *Flashca
 	if value, ok := _u.mutation.AddedLapseCount(); ok {
 		_spec.AddField(flashcardreview.FieldLapseCount, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.HintCount(); ok {
+		_spec.SetField(flashcardreview.FieldHintCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHintCount(); ok {
+		_spec.AddField(flashcardreview.FieldHintCount, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.LastReviewedAt(); ok {
 		_spec.SetField(flashcardreview.FieldLastReviewedAt, field.TypeTime, value)
 	}
@@ -822,6 +1083,52 @@ func (_u *FlashcardReviewUpdateOne) sqlSave(ctx context.Context) (_node *Flashca
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.QuizUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedQuizUpdatesIDs(); len(nodes) > 0 && !_u.mutation.QuizUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizUpdatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flashcardreview.QuizUpdatesTable,
+			Columns: []string{flashcardreview.QuizUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
 	_node = &FlashcardReview{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// FlashcardRevision is the model entity for the FlashcardRevision schema.
+type FlashcardRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// FlashcardID holds the value of the "flashcard_id" field.
+	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Revision number, starting at 1 for each flashcard
+	Number int `json:"number,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID string `json:"author_id,omitempty"`
+	// Fields changed compared to the previous revision
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	// Question holds the value of the "question" field.
+	Question string `json:"question,omitempty"`
+	// Answer holds the value of the "answer" field.
+	Answer string `json:"answer,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// ContentFormat holds the value of the "content_format" field.
+	ContentFormat string `json:"content_format,omitempty"`
+	// Hint holds the value of the "hint" field.
+	Hint string `json:"hint,omitempty"`
+	// Explanation holds the value of the "explanation" field.
+	Explanation string `json:"explanation,omitempty"`
+	// Sources holds the value of the "sources" field.
+	Sources []schema.SourceReference `json:"sources,omitempty"`
+	// Options holds the value of the "options" field.
+	Options []string `json:"options,omitempty"`
+	// Pairs holds the value of the "pairs" field.
+	Pairs []schema.MatchPair `json:"pairs,omitempty"`
+	// Occlusion holds the value of the "occlusion" field.
+	Occlusion *schema.ImageOcclusion `json:"occlusion,omitempty"`
+	// MediaIds holds the value of the "media_ids" field.
+	MediaIds []uuid.UUID `json:"media_ids,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FlashcardRevisionQuery when eager-loading is set.
+	Edges        FlashcardRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FlashcardRevisionEdges holds the relations/edges for other nodes in the graph.
+type FlashcardRevisionEdges struct {
+	// Flashcard holds the value of the flashcard edge.
+	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FlashcardOrErr returns the Flashcard value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FlashcardRevisionEdges) FlashcardOrErr() (*Flashcard, error) {
+	if e.Flashcard != nil {
+		return e.Flashcard, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flashcard.Label}
+	}
+	return nil, &NotLoadedError{edge: "flashcard"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FlashcardRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case flashcardrevision.FieldChangedFields, flashcardrevision.FieldSources, flashcardrevision.FieldOptions, flashcardrevision.FieldPairs, flashcardrevision.FieldOcclusion, flashcardrevision.FieldMediaIds:
+			values[i] = new([]byte)
+		case flashcardrevision.FieldNumber:
+			values[i] = new(sql.NullInt64)
+		case flashcardrevision.FieldAuthorID, flashcardrevision.FieldQuestion, flashcardrevision.FieldAnswer, flashcardrevision.FieldType, flashcardrevision.FieldContentFormat, flashcardrevision.FieldHint, flashcardrevision.FieldExplanation:
+			values[i] = new(sql.NullString)
+		case flashcardrevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case flashcardrevision.FieldID, flashcardrevision.FieldFlashcardID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FlashcardRevision fields.
+func (_m *FlashcardRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case flashcardrevision.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case flashcardrevision.FieldFlashcardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_id", values[i])
+			} else if value != nil {
+				_m.FlashcardID = *value
+			}
+		case flashcardrevision.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = int(value.Int64)
+			}
+		case flashcardrevision.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				_m.AuthorID = value.String
+			}
+		case flashcardrevision.FieldChangedFields:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_fields", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ChangedFields); err != nil {
+					return fmt.Errorf("unmarshal field changed_fields: %w", err)
+				}
+			}
+		case flashcardrevision.FieldQuestion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question", values[i])
+			} else if value.Valid {
+				_m.Question = value.String
+			}
+		case flashcardrevision.FieldAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field answer", values[i])
+			} else if value.Valid {
+				_m.Answer = value.String
+			}
+		case flashcardrevision.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = value.String
+			}
+		case flashcardrevision.FieldContentFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_format", values[i])
+			} else if value.Valid {
+				_m.ContentFormat = value.String
+			}
+		case flashcardrevision.FieldHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hint", values[i])
+			} else if value.Valid {
+				_m.Hint = value.String
+			}
+		case flashcardrevision.FieldExplanation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field explanation", values[i])
+			} else if value.Valid {
+				_m.Explanation = value.String
+			}
+		case flashcardrevision.FieldSources:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sources", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Sources); err != nil {
+					return fmt.Errorf("unmarshal field sources: %w", err)
+				}
+			}
+		case flashcardrevision.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case flashcardrevision.FieldPairs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pairs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Pairs); err != nil {
+					return fmt.Errorf("unmarshal field pairs: %w", err)
+				}
+			}
+		case flashcardrevision.FieldOcclusion:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field occlusion", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Occlusion); err != nil {
+					return fmt.Errorf("unmarshal field occlusion: %w", err)
+				}
+			}
+		case flashcardrevision.FieldMediaIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field media_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MediaIds); err != nil {
+					return fmt.Errorf("unmarshal field media_ids: %w", err)
+				}
+			}
+		case flashcardrevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FlashcardRevision.
+// This includes values selected through modifiers, order, etc.
+func (_m *FlashcardRevision) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlashcard queries the "flashcard" edge of the FlashcardRevision entity.
+func (_m *FlashcardRevision) QueryFlashcard() *FlashcardQuery {
+	return NewFlashcardRevisionClient(_m.config).QueryFlashcard(_m)
+}
+
+// Update returns a builder for updating this FlashcardRevision.
+// Note that you need to call FlashcardRevision.Unwrap() before calling this method if this FlashcardRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FlashcardRevision) Update() *FlashcardRevisionUpdateOne {
+	return NewFlashcardRevisionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FlashcardRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FlashcardRevision) Unwrap() *FlashcardRevision {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FlashcardRevision is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FlashcardRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("FlashcardRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flashcard_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
+	builder.WriteString(", ")
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Number))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(_m.AuthorID)
+	builder.WriteString(", ")
+	builder.WriteString("changed_fields=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangedFields))
+	builder.WriteString(", ")
+	builder.WriteString("question=")
+	builder.WriteString(_m.Question)
+	builder.WriteString(", ")
+	builder.WriteString("answer=")
+	builder.WriteString(_m.Answer)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(_m.Type)
+	builder.WriteString(", ")
+	builder.WriteString("content_format=")
+	builder.WriteString(_m.ContentFormat)
+	builder.WriteString(", ")
+	builder.WriteString("hint=")
+	builder.WriteString(_m.Hint)
+	builder.WriteString(", ")
+	builder.WriteString("explanation=")
+	builder.WriteString(_m.Explanation)
+	builder.WriteString(", ")
+	builder.WriteString("sources=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sources))
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Options))
+	builder.WriteString(", ")
+	builder.WriteString("pairs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Pairs))
+	builder.WriteString(", ")
+	builder.WriteString("occlusion=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Occlusion))
+	builder.WriteString(", ")
+	builder.WriteString("media_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MediaIds))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FlashcardRevisions is a parsable slice of FlashcardRevision.
+type FlashcardRevisions []*FlashcardRevision
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the flashcardrevision type in the database.
+	Label = "flashcard_revision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
+	FieldFlashcardID = "flashcard_id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldAuthorID holds the string denoting the author_id field in the database.
+	FieldAuthorID = "author_id"
+	// FieldChangedFields holds the string denoting the changed_fields field in the database.
+	FieldChangedFields = "changed_fields"
+	// FieldQuestion holds the string denoting the question field in the database.
+	FieldQuestion = "question"
+	// FieldAnswer holds the string denoting the answer field in the database.
+	FieldAnswer = "answer"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldContentFormat holds the string denoting the content_format field in the database.
+	FieldContentFormat = "content_format"
+	// FieldHint holds the string denoting the hint field in the database.
+	FieldHint = "hint"
+	// FieldExplanation holds the string denoting the explanation field in the database.
+	FieldExplanation = "explanation"
+	// FieldSources holds the string denoting the sources field in the database.
+	FieldSources = "sources"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldPairs holds the string denoting the pairs field in the database.
+	FieldPairs = "pairs"
+	// FieldOcclusion holds the string denoting the occlusion field in the database.
+	FieldOcclusion = "occlusion"
+	// FieldMediaIds holds the string denoting the media_ids field in the database.
+	FieldMediaIds = "media_ids"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
+	EdgeFlashcard = "flashcard"
+	// Table holds the table name of the flashcardrevision in the database.
+	Table = "flashcard_revisions"
+	// FlashcardTable is the table that holds the flashcard relation/edge.
+	FlashcardTable = "flashcard_revisions"
+	// FlashcardInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardInverseTable = "flashcards"
+	// FlashcardColumn is the table column denoting the flashcard relation/edge.
+	FlashcardColumn = "flashcard_id"
+)
+
+// Columns holds all SQL columns for flashcardrevision fields.
+var Columns = []string{
+	FieldID,
+	FieldFlashcardID,
+	FieldNumber,
+	FieldAuthorID,
+	FieldChangedFields,
+	FieldQuestion,
+	FieldAnswer,
+	FieldType,
+	FieldContentFormat,
+	FieldHint,
+	FieldExplanation,
+	FieldSources,
+	FieldOptions,
+	FieldPairs,
+	FieldOcclusion,
+	FieldMediaIds,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(int) error
+	// AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
+	AuthorIDValidator func(string) error
+	// TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	TypeValidator func(string) error
+	// ContentFormatValidator is a validator for the "content_format" field. It is called by the builders before save.
+	ContentFormatValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the FlashcardRevision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlashcardID orders the results by the flashcard_id field.
+func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByAuthorID orders the results by the author_id field.
+func ByAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorID, opts...).ToFunc()
+}
+
+// ByQuestion orders the results by the question field.
+func ByQuestion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestion, opts...).ToFunc()
+}
+
+// ByAnswer orders the results by the answer field.
+func ByAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswer, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByContentFormat orders the results by the content_format field.
+func ByContentFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentFormat, opts...).ToFunc()
+}
+
+// ByHint orders the results by the hint field.
+func ByHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHint, opts...).ToFunc()
+}
+
+// ByExplanation orders the results by the explanation field.
+func ByExplanation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExplanation, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFlashcardField orders the results by flashcard field.
+func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlashcardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+	)
+}
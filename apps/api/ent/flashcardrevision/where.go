@@ -0,0 +1,780 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardrevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldID, id))
+}
+
+// FlashcardID applies equality check predicate on the "flashcard_id" field. It's identical to FlashcardIDEQ.
+func FlashcardID(v uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// Question applies equality check predicate on the "question" field. It's identical to QuestionEQ.
+func Question(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldQuestion, v))
+}
+
+// Answer applies equality check predicate on the "answer" field. It's identical to AnswerEQ.
+func Answer(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldAnswer, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldType, v))
+}
+
+// ContentFormat applies equality check predicate on the "content_format" field. It's identical to ContentFormatEQ.
+func ContentFormat(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldContentFormat, v))
+}
+
+// Hint applies equality check predicate on the "hint" field. It's identical to HintEQ.
+func Hint(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldHint, v))
+}
+
+// Explanation applies equality check predicate on the "explanation" field. It's identical to ExplanationEQ.
+func Explanation(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldExplanation, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FlashcardIDEQ applies the EQ predicate on the "flashcard_id" field.
+func FlashcardIDEQ(v uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDNEQ applies the NEQ predicate on the "flashcard_id" field.
+func FlashcardIDNEQ(v uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDIn applies the In predicate on the "flashcard_id" field.
+func FlashcardIDIn(vs ...uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldFlashcardID, vs...))
+}
+
+// FlashcardIDNotIn applies the NotIn predicate on the "flashcard_id" field.
+func FlashcardIDNotIn(vs ...uuid.UUID) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldFlashcardID, vs...))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldNumber, v))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// AuthorIDContains applies the Contains predicate on the "author_id" field.
+func AuthorIDContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldAuthorID, v))
+}
+
+// AuthorIDHasPrefix applies the HasPrefix predicate on the "author_id" field.
+func AuthorIDHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldAuthorID, v))
+}
+
+// AuthorIDHasSuffix applies the HasSuffix predicate on the "author_id" field.
+func AuthorIDHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldAuthorID, v))
+}
+
+// AuthorIDEqualFold applies the EqualFold predicate on the "author_id" field.
+func AuthorIDEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldAuthorID, v))
+}
+
+// AuthorIDContainsFold applies the ContainsFold predicate on the "author_id" field.
+func AuthorIDContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldAuthorID, v))
+}
+
+// ChangedFieldsIsNil applies the IsNil predicate on the "changed_fields" field.
+func ChangedFieldsIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldChangedFields))
+}
+
+// ChangedFieldsNotNil applies the NotNil predicate on the "changed_fields" field.
+func ChangedFieldsNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldChangedFields))
+}
+
+// QuestionEQ applies the EQ predicate on the "question" field.
+func QuestionEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldQuestion, v))
+}
+
+// QuestionNEQ applies the NEQ predicate on the "question" field.
+func QuestionNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldQuestion, v))
+}
+
+// QuestionIn applies the In predicate on the "question" field.
+func QuestionIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldQuestion, vs...))
+}
+
+// QuestionNotIn applies the NotIn predicate on the "question" field.
+func QuestionNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldQuestion, vs...))
+}
+
+// QuestionGT applies the GT predicate on the "question" field.
+func QuestionGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldQuestion, v))
+}
+
+// QuestionGTE applies the GTE predicate on the "question" field.
+func QuestionGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldQuestion, v))
+}
+
+// QuestionLT applies the LT predicate on the "question" field.
+func QuestionLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldQuestion, v))
+}
+
+// QuestionLTE applies the LTE predicate on the "question" field.
+func QuestionLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldQuestion, v))
+}
+
+// QuestionContains applies the Contains predicate on the "question" field.
+func QuestionContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldQuestion, v))
+}
+
+// QuestionHasPrefix applies the HasPrefix predicate on the "question" field.
+func QuestionHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldQuestion, v))
+}
+
+// QuestionHasSuffix applies the HasSuffix predicate on the "question" field.
+func QuestionHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldQuestion, v))
+}
+
+// QuestionEqualFold applies the EqualFold predicate on the "question" field.
+func QuestionEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldQuestion, v))
+}
+
+// QuestionContainsFold applies the ContainsFold predicate on the "question" field.
+func QuestionContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldQuestion, v))
+}
+
+// AnswerEQ applies the EQ predicate on the "answer" field.
+func AnswerEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldAnswer, v))
+}
+
+// AnswerNEQ applies the NEQ predicate on the "answer" field.
+func AnswerNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldAnswer, v))
+}
+
+// AnswerIn applies the In predicate on the "answer" field.
+func AnswerIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldAnswer, vs...))
+}
+
+// AnswerNotIn applies the NotIn predicate on the "answer" field.
+func AnswerNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldAnswer, vs...))
+}
+
+// AnswerGT applies the GT predicate on the "answer" field.
+func AnswerGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldAnswer, v))
+}
+
+// AnswerGTE applies the GTE predicate on the "answer" field.
+func AnswerGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldAnswer, v))
+}
+
+// AnswerLT applies the LT predicate on the "answer" field.
+func AnswerLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldAnswer, v))
+}
+
+// AnswerLTE applies the LTE predicate on the "answer" field.
+func AnswerLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldAnswer, v))
+}
+
+// AnswerContains applies the Contains predicate on the "answer" field.
+func AnswerContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldAnswer, v))
+}
+
+// AnswerHasPrefix applies the HasPrefix predicate on the "answer" field.
+func AnswerHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldAnswer, v))
+}
+
+// AnswerHasSuffix applies the HasSuffix predicate on the "answer" field.
+func AnswerHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldAnswer, v))
+}
+
+// AnswerEqualFold applies the EqualFold predicate on the "answer" field.
+func AnswerEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldAnswer, v))
+}
+
+// AnswerContainsFold applies the ContainsFold predicate on the "answer" field.
+func AnswerContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldAnswer, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldType, v))
+}
+
+// TypeContains applies the Contains predicate on the "type" field.
+func TypeContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldType, v))
+}
+
+// TypeHasPrefix applies the HasPrefix predicate on the "type" field.
+func TypeHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldType, v))
+}
+
+// TypeHasSuffix applies the HasSuffix predicate on the "type" field.
+func TypeHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldType, v))
+}
+
+// TypeEqualFold applies the EqualFold predicate on the "type" field.
+func TypeEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldType, v))
+}
+
+// TypeContainsFold applies the ContainsFold predicate on the "type" field.
+func TypeContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldType, v))
+}
+
+// ContentFormatEQ applies the EQ predicate on the "content_format" field.
+func ContentFormatEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldContentFormat, v))
+}
+
+// ContentFormatNEQ applies the NEQ predicate on the "content_format" field.
+func ContentFormatNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldContentFormat, v))
+}
+
+// ContentFormatIn applies the In predicate on the "content_format" field.
+func ContentFormatIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldContentFormat, vs...))
+}
+
+// ContentFormatNotIn applies the NotIn predicate on the "content_format" field.
+func ContentFormatNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldContentFormat, vs...))
+}
+
+// ContentFormatGT applies the GT predicate on the "content_format" field.
+func ContentFormatGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldContentFormat, v))
+}
+
+// ContentFormatGTE applies the GTE predicate on the "content_format" field.
+func ContentFormatGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldContentFormat, v))
+}
+
+// ContentFormatLT applies the LT predicate on the "content_format" field.
+func ContentFormatLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldContentFormat, v))
+}
+
+// ContentFormatLTE applies the LTE predicate on the "content_format" field.
+func ContentFormatLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldContentFormat, v))
+}
+
+// ContentFormatContains applies the Contains predicate on the "content_format" field.
+func ContentFormatContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldContentFormat, v))
+}
+
+// ContentFormatHasPrefix applies the HasPrefix predicate on the "content_format" field.
+func ContentFormatHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldContentFormat, v))
+}
+
+// ContentFormatHasSuffix applies the HasSuffix predicate on the "content_format" field.
+func ContentFormatHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldContentFormat, v))
+}
+
+// ContentFormatEqualFold applies the EqualFold predicate on the "content_format" field.
+func ContentFormatEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldContentFormat, v))
+}
+
+// ContentFormatContainsFold applies the ContainsFold predicate on the "content_format" field.
+func ContentFormatContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldContentFormat, v))
+}
+
+// HintEQ applies the EQ predicate on the "hint" field.
+func HintEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldHint, v))
+}
+
+// HintNEQ applies the NEQ predicate on the "hint" field.
+func HintNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldHint, v))
+}
+
+// HintIn applies the In predicate on the "hint" field.
+func HintIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldHint, vs...))
+}
+
+// HintNotIn applies the NotIn predicate on the "hint" field.
+func HintNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldHint, vs...))
+}
+
+// HintGT applies the GT predicate on the "hint" field.
+func HintGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldHint, v))
+}
+
+// HintGTE applies the GTE predicate on the "hint" field.
+func HintGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldHint, v))
+}
+
+// HintLT applies the LT predicate on the "hint" field.
+func HintLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldHint, v))
+}
+
+// HintLTE applies the LTE predicate on the "hint" field.
+func HintLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldHint, v))
+}
+
+// HintContains applies the Contains predicate on the "hint" field.
+func HintContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldHint, v))
+}
+
+// HintHasPrefix applies the HasPrefix predicate on the "hint" field.
+func HintHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldHint, v))
+}
+
+// HintHasSuffix applies the HasSuffix predicate on the "hint" field.
+func HintHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldHint, v))
+}
+
+// HintIsNil applies the IsNil predicate on the "hint" field.
+func HintIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldHint))
+}
+
+// HintNotNil applies the NotNil predicate on the "hint" field.
+func HintNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldHint))
+}
+
+// HintEqualFold applies the EqualFold predicate on the "hint" field.
+func HintEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldHint, v))
+}
+
+// HintContainsFold applies the ContainsFold predicate on the "hint" field.
+func HintContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldHint, v))
+}
+
+// ExplanationEQ applies the EQ predicate on the "explanation" field.
+func ExplanationEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldExplanation, v))
+}
+
+// ExplanationNEQ applies the NEQ predicate on the "explanation" field.
+func ExplanationNEQ(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldExplanation, v))
+}
+
+// ExplanationIn applies the In predicate on the "explanation" field.
+func ExplanationIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldExplanation, vs...))
+}
+
+// ExplanationNotIn applies the NotIn predicate on the "explanation" field.
+func ExplanationNotIn(vs ...string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldExplanation, vs...))
+}
+
+// ExplanationGT applies the GT predicate on the "explanation" field.
+func ExplanationGT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldExplanation, v))
+}
+
+// ExplanationGTE applies the GTE predicate on the "explanation" field.
+func ExplanationGTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldExplanation, v))
+}
+
+// ExplanationLT applies the LT predicate on the "explanation" field.
+func ExplanationLT(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldExplanation, v))
+}
+
+// ExplanationLTE applies the LTE predicate on the "explanation" field.
+func ExplanationLTE(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldExplanation, v))
+}
+
+// ExplanationContains applies the Contains predicate on the "explanation" field.
+func ExplanationContains(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContains(FieldExplanation, v))
+}
+
+// ExplanationHasPrefix applies the HasPrefix predicate on the "explanation" field.
+func ExplanationHasPrefix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasPrefix(FieldExplanation, v))
+}
+
+// ExplanationHasSuffix applies the HasSuffix predicate on the "explanation" field.
+func ExplanationHasSuffix(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldHasSuffix(FieldExplanation, v))
+}
+
+// ExplanationIsNil applies the IsNil predicate on the "explanation" field.
+func ExplanationIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldExplanation))
+}
+
+// ExplanationNotNil applies the NotNil predicate on the "explanation" field.
+func ExplanationNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldExplanation))
+}
+
+// ExplanationEqualFold applies the EqualFold predicate on the "explanation" field.
+func ExplanationEqualFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEqualFold(FieldExplanation, v))
+}
+
+// ExplanationContainsFold applies the ContainsFold predicate on the "explanation" field.
+func ExplanationContainsFold(v string) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldContainsFold(FieldExplanation, v))
+}
+
+// SourcesIsNil applies the IsNil predicate on the "sources" field.
+func SourcesIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldSources))
+}
+
+// SourcesNotNil applies the NotNil predicate on the "sources" field.
+func SourcesNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldSources))
+}
+
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldOptions))
+}
+
+// PairsIsNil applies the IsNil predicate on the "pairs" field.
+func PairsIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldPairs))
+}
+
+// PairsNotNil applies the NotNil predicate on the "pairs" field.
+func PairsNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldPairs))
+}
+
+// OcclusionIsNil applies the IsNil predicate on the "occlusion" field.
+func OcclusionIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldOcclusion))
+}
+
+// OcclusionNotNil applies the NotNil predicate on the "occlusion" field.
+func OcclusionNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldOcclusion))
+}
+
+// MediaIdsIsNil applies the IsNil predicate on the "media_ids" field.
+func MediaIdsIsNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIsNull(FieldMediaIds))
+}
+
+// MediaIdsNotNil applies the NotNil predicate on the "media_ids" field.
+func MediaIdsNotNil() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotNull(FieldMediaIds))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFlashcard applies the HasEdge predicate on the "flashcard" edge.
+func HasFlashcard() predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlashcardWith applies the HasEdge predicate on the "flashcard" edge with a given conditions (other predicates).
+func HasFlashcardWith(preds ...predicate.Flashcard) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(func(s *sql.Selector) {
+		step := newFlashcardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FlashcardRevision) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FlashcardRevision) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FlashcardRevision) predicate.FlashcardRevision {
+	return predicate.FlashcardRevision(sql.NotPredicates(p))
+}
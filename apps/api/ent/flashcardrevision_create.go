@@ -0,0 +1,456 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// FlashcardRevisionCreate is the builder for creating a FlashcardRevision entity.
+type FlashcardRevisionCreate struct {
+	config
+	mutation *FlashcardRevisionMutation
+	hooks    []Hook
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_c *FlashcardRevisionCreate) SetFlashcardID(v uuid.UUID) *FlashcardRevisionCreate {
+	_c.mutation.SetFlashcardID(v)
+	return _c
+}
+
+// SetNumber sets the "number" field.
+func (_c *FlashcardRevisionCreate) SetNumber(v int) *FlashcardRevisionCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *FlashcardRevisionCreate) SetAuthorID(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (_c *FlashcardRevisionCreate) SetChangedFields(v []string) *FlashcardRevisionCreate {
+	_c.mutation.SetChangedFields(v)
+	return _c
+}
+
+// SetQuestion sets the "question" field.
+func (_c *FlashcardRevisionCreate) SetQuestion(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetQuestion(v)
+	return _c
+}
+
+// SetAnswer sets the "answer" field.
+func (_c *FlashcardRevisionCreate) SetAnswer(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetAnswer(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *FlashcardRevisionCreate) SetType(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetContentFormat sets the "content_format" field.
+func (_c *FlashcardRevisionCreate) SetContentFormat(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetContentFormat(v)
+	return _c
+}
+
+// SetHint sets the "hint" field.
+func (_c *FlashcardRevisionCreate) SetHint(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetHint(v)
+	return _c
+}
+
+// SetNillableHint sets the "hint" field if the given value is not nil.
+func (_c *FlashcardRevisionCreate) SetNillableHint(v *string) *FlashcardRevisionCreate {
+	if v != nil {
+		_c.SetHint(*v)
+	}
+	return _c
+}
+
+// SetExplanation sets the "explanation" field.
+func (_c *FlashcardRevisionCreate) SetExplanation(v string) *FlashcardRevisionCreate {
+	_c.mutation.SetExplanation(v)
+	return _c
+}
+
+// SetNillableExplanation sets the "explanation" field if the given value is not nil.
+func (_c *FlashcardRevisionCreate) SetNillableExplanation(v *string) *FlashcardRevisionCreate {
+	if v != nil {
+		_c.SetExplanation(*v)
+	}
+	return _c
+}
+
+// SetSources sets the "sources" field.
+func (_c *FlashcardRevisionCreate) SetSources(v []schema.SourceReference) *FlashcardRevisionCreate {
+	_c.mutation.SetSources(v)
+	return _c
+}
+
+// SetOptions sets the "options" field.
+func (_c *FlashcardRevisionCreate) SetOptions(v []string) *FlashcardRevisionCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetPairs sets the "pairs" field.
+func (_c *FlashcardRevisionCreate) SetPairs(v []schema.MatchPair) *FlashcardRevisionCreate {
+	_c.mutation.SetPairs(v)
+	return _c
+}
+
+// SetOcclusion sets the "occlusion" field.
+func (_c *FlashcardRevisionCreate) SetOcclusion(v *schema.ImageOcclusion) *FlashcardRevisionCreate {
+	_c.mutation.SetOcclusion(v)
+	return _c
+}
+
+// SetMediaIds sets the "media_ids" field.
+func (_c *FlashcardRevisionCreate) SetMediaIds(v []uuid.UUID) *FlashcardRevisionCreate {
+	_c.mutation.SetMediaIds(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FlashcardRevisionCreate) SetCreatedAt(v time.Time) *FlashcardRevisionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FlashcardRevisionCreate) SetNillableCreatedAt(v *time.Time) *FlashcardRevisionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FlashcardRevisionCreate) SetID(v uuid.UUID) *FlashcardRevisionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FlashcardRevisionCreate) SetNillableID(v *uuid.UUID) *FlashcardRevisionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_c *FlashcardRevisionCreate) SetFlashcard(v *Flashcard) *FlashcardRevisionCreate {
+	return _c.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardRevisionMutation object of the builder.
+func (_c *FlashcardRevisionCreate) Mutation() *FlashcardRevisionMutation {
+	return _c.mutation
+}
+
+// Save creates the FlashcardRevision in the database.
+func (_c *FlashcardRevisionCreate) Save(ctx context.Context) (*FlashcardRevision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FlashcardRevisionCreate) SaveX(ctx context.Context) *FlashcardRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardRevisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardRevisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FlashcardRevisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := flashcardrevision.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := flashcardrevision.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FlashcardRevisionCreate) check() error {
+	if _, ok := _c.mutation.FlashcardID(); !ok {
+		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "FlashcardRevision.flashcard_id"`)}
+	}
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "FlashcardRevision.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := flashcardrevision.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "FlashcardRevision.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`ent: missing required field "FlashcardRevision.author_id"`)}
+	}
+	if v, ok := _c.mutation.AuthorID(); ok {
+		if err := flashcardrevision.AuthorIDValidator(v); err != nil {
+			return &ValidationError{Name: "author_id", err: fmt.Errorf(`ent: validator failed for field "FlashcardRevision.author_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Question(); !ok {
+		return &ValidationError{Name: "question", err: errors.New(`ent: missing required field "FlashcardRevision.question"`)}
+	}
+	if _, ok := _c.mutation.Answer(); !ok {
+		return &ValidationError{Name: "answer", err: errors.New(`ent: missing required field "FlashcardRevision.answer"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FlashcardRevision.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := flashcardrevision.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FlashcardRevision.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentFormat(); !ok {
+		return &ValidationError{Name: "content_format", err: errors.New(`ent: missing required field "FlashcardRevision.content_format"`)}
+	}
+	if v, ok := _c.mutation.ContentFormat(); ok {
+		if err := flashcardrevision.ContentFormatValidator(v); err != nil {
+			return &ValidationError{Name: "content_format", err: fmt.Errorf(`ent: validator failed for field "FlashcardRevision.content_format": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FlashcardRevision.created_at"`)}
+	}
+	if len(_c.mutation.FlashcardIDs()) == 0 {
+		return &ValidationError{Name: "flashcard", err: errors.New(`ent: missing required edge "FlashcardRevision.flashcard"`)}
+	}
+	return nil
+}
+
+func (_c *FlashcardRevisionCreate) sqlSave(ctx context.Context) (*FlashcardRevision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FlashcardRevisionCreate) createSpec() (*FlashcardRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FlashcardRevision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(flashcardrevision.Table, sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(flashcardrevision.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.AuthorID(); ok {
+		_spec.SetField(flashcardrevision.FieldAuthorID, field.TypeString, value)
+		_node.AuthorID = value
+	}
+	if value, ok := _c.mutation.ChangedFields(); ok {
+		_spec.SetField(flashcardrevision.FieldChangedFields, field.TypeJSON, value)
+		_node.ChangedFields = value
+	}
+	if value, ok := _c.mutation.Question(); ok {
+		_spec.SetField(flashcardrevision.FieldQuestion, field.TypeString, value)
+		_node.Question = value
+	}
+	if value, ok := _c.mutation.Answer(); ok {
+		_spec.SetField(flashcardrevision.FieldAnswer, field.TypeString, value)
+		_node.Answer = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(flashcardrevision.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.ContentFormat(); ok {
+		_spec.SetField(flashcardrevision.FieldContentFormat, field.TypeString, value)
+		_node.ContentFormat = value
+	}
+	if value, ok := _c.mutation.Hint(); ok {
+		_spec.SetField(flashcardrevision.FieldHint, field.TypeString, value)
+		_node.Hint = value
+	}
+	if value, ok := _c.mutation.Explanation(); ok {
+		_spec.SetField(flashcardrevision.FieldExplanation, field.TypeString, value)
+		_node.Explanation = value
+	}
+	if value, ok := _c.mutation.Sources(); ok {
+		_spec.SetField(flashcardrevision.FieldSources, field.TypeJSON, value)
+		_node.Sources = value
+	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(flashcardrevision.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.Pairs(); ok {
+		_spec.SetField(flashcardrevision.FieldPairs, field.TypeJSON, value)
+		_node.Pairs = value
+	}
+	if value, ok := _c.mutation.Occlusion(); ok {
+		_spec.SetField(flashcardrevision.FieldOcclusion, field.TypeJSON, value)
+		_node.Occlusion = value
+	}
+	if value, ok := _c.mutation.MediaIds(); ok {
+		_spec.SetField(flashcardrevision.FieldMediaIds, field.TypeJSON, value)
+		_node.MediaIds = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(flashcardrevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardrevision.FlashcardTable,
+			Columns: []string{flashcardrevision.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlashcardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FlashcardRevisionCreateBulk is the builder for creating many FlashcardRevision entities in bulk.
+type FlashcardRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*FlashcardRevisionCreate
+}
+
+// Save creates the FlashcardRevision entities in the database.
+func (_c *FlashcardRevisionCreateBulk) Save(ctx context.Context) ([]*FlashcardRevision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FlashcardRevision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FlashcardRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FlashcardRevisionCreateBulk) SaveX(ctx context.Context) []*FlashcardRevision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,261 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardRevisionUpdate is the builder for updating FlashcardRevision entities.
+type FlashcardRevisionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FlashcardRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FlashcardRevisionUpdate builder.
+func (_u *FlashcardRevisionUpdate) Where(ps ...predicate.FlashcardRevision) *FlashcardRevisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the FlashcardRevisionMutation object of the builder.
+func (_u *FlashcardRevisionUpdate) Mutation() *FlashcardRevisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FlashcardRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FlashcardRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardRevisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardRevisionUpdate) check() error {
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardRevision.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardRevisionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardRevisionUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardRevisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardrevision.Table, flashcardrevision.Columns, sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ChangedFieldsCleared() {
+		_spec.ClearField(flashcardrevision.FieldChangedFields, field.TypeJSON)
+	}
+	if _u.mutation.HintCleared() {
+		_spec.ClearField(flashcardrevision.FieldHint, field.TypeString)
+	}
+	if _u.mutation.ExplanationCleared() {
+		_spec.ClearField(flashcardrevision.FieldExplanation, field.TypeString)
+	}
+	if _u.mutation.SourcesCleared() {
+		_spec.ClearField(flashcardrevision.FieldSources, field.TypeJSON)
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(flashcardrevision.FieldOptions, field.TypeJSON)
+	}
+	if _u.mutation.PairsCleared() {
+		_spec.ClearField(flashcardrevision.FieldPairs, field.TypeJSON)
+	}
+	if _u.mutation.OcclusionCleared() {
+		_spec.ClearField(flashcardrevision.FieldOcclusion, field.TypeJSON)
+	}
+	if _u.mutation.MediaIdsCleared() {
+		_spec.ClearField(flashcardrevision.FieldMediaIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FlashcardRevisionUpdateOne is the builder for updating a single FlashcardRevision entity.
+type FlashcardRevisionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FlashcardRevisionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the FlashcardRevisionMutation object of the builder.
+func (_u *FlashcardRevisionUpdateOne) Mutation() *FlashcardRevisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the FlashcardRevisionUpdate builder.
+func (_u *FlashcardRevisionUpdateOne) Where(ps ...predicate.FlashcardRevision) *FlashcardRevisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FlashcardRevisionUpdateOne) Select(field string, fields ...string) *FlashcardRevisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FlashcardRevision entity.
+func (_u *FlashcardRevisionUpdateOne) Save(ctx context.Context) (*FlashcardRevision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardRevisionUpdateOne) SaveX(ctx context.Context) *FlashcardRevision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FlashcardRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardRevisionUpdateOne) check() error {
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardRevision.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardRevisionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardRevisionUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardRevisionUpdateOne) sqlSave(ctx context.Context) (_node *FlashcardRevision, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardrevision.Table, flashcardrevision.Columns, sqlgraph.NewFieldSpec(flashcardrevision.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FlashcardRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, flashcardrevision.FieldID)
+		for _, f := range fields {
+			if !flashcardrevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != flashcardrevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ChangedFieldsCleared() {
+		_spec.ClearField(flashcardrevision.FieldChangedFields, field.TypeJSON)
+	}
+	if _u.mutation.HintCleared() {
+		_spec.ClearField(flashcardrevision.FieldHint, field.TypeString)
+	}
+	if _u.mutation.ExplanationCleared() {
+		_spec.ClearField(flashcardrevision.FieldExplanation, field.TypeString)
+	}
+	if _u.mutation.SourcesCleared() {
+		_spec.ClearField(flashcardrevision.FieldSources, field.TypeJSON)
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(flashcardrevision.FieldOptions, field.TypeJSON)
+	}
+	if _u.mutation.PairsCleared() {
+		_spec.ClearField(flashcardrevision.FieldPairs, field.TypeJSON)
+	}
+	if _u.mutation.OcclusionCleared() {
+		_spec.ClearField(flashcardrevision.FieldOcclusion, field.TypeJSON)
+	}
+	if _u.mutation.MediaIdsCleared() {
+		_spec.ClearField(flashcardrevision.FieldMediaIds, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FlashcardRevision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardrevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+)
+
+// FlashcardTag is the model entity for the FlashcardTag schema.
+type FlashcardTag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// FlashcardID holds the value of the "flashcard_id" field.
+	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Collection of the flashcard, denormalized for collection-wide tag queries
+	CollectionID uuid.UUID `json:"collection_id,omitempty"`
+	// Full tag path
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FlashcardTagQuery when eager-loading is set.
+	Edges        FlashcardTagEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FlashcardTagEdges holds the relations/edges for other nodes in the graph.
+type FlashcardTagEdges struct {
+	// Flashcard holds the value of the flashcard edge.
+	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FlashcardOrErr returns the Flashcard value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FlashcardTagEdges) FlashcardOrErr() (*Flashcard, error) {
+	if e.Flashcard != nil {
+		return e.Flashcard, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flashcard.Label}
+	}
+	return nil, &NotLoadedError{edge: "flashcard"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FlashcardTag) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case flashcardtag.FieldName:
+			values[i] = new(sql.NullString)
+		case flashcardtag.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case flashcardtag.FieldID, flashcardtag.FieldFlashcardID, flashcardtag.FieldCollectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FlashcardTag fields.
+func (_m *FlashcardTag) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case flashcardtag.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case flashcardtag.FieldFlashcardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_id", values[i])
+			} else if value != nil {
+				_m.FlashcardID = *value
+			}
+		case flashcardtag.FieldCollectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
+			} else if value != nil {
+				_m.CollectionID = *value
+			}
+		case flashcardtag.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case flashcardtag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FlashcardTag.
+// This includes values selected through modifiers, order, etc.
+func (_m *FlashcardTag) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlashcard queries the "flashcard" edge of the FlashcardTag entity.
+func (_m *FlashcardTag) QueryFlashcard() *FlashcardQuery {
+	return NewFlashcardTagClient(_m.config).QueryFlashcard(_m)
+}
+
+// Update returns a builder for updating this FlashcardTag.
+// Note that you need to call FlashcardTag.Unwrap() before calling this method if this FlashcardTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FlashcardTag) Update() *FlashcardTagUpdateOne {
+	return NewFlashcardTagClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FlashcardTag entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FlashcardTag) Unwrap() *FlashcardTag {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FlashcardTag is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FlashcardTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("FlashcardTag(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flashcard_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
+	builder.WriteString(", ")
+	builder.WriteString("collection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FlashcardTags is a parsable slice of FlashcardTag.
+type FlashcardTags []*FlashcardTag
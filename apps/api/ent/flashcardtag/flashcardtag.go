@@ -0,0 +1,107 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardtag
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the flashcardtag type in the database.
+	Label = "flashcard_tag"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
+	FieldFlashcardID = "flashcard_id"
+	// FieldCollectionID holds the string denoting the collection_id field in the database.
+	FieldCollectionID = "collection_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
+	EdgeFlashcard = "flashcard"
+	// Table holds the table name of the flashcardtag in the database.
+	Table = "flashcard_tags"
+	// FlashcardTable is the table that holds the flashcard relation/edge.
+	FlashcardTable = "flashcard_tags"
+	// FlashcardInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardInverseTable = "flashcards"
+	// FlashcardColumn is the table column denoting the flashcard relation/edge.
+	FlashcardColumn = "flashcard_id"
+)
+
+// Columns holds all SQL columns for flashcardtag fields.
+var Columns = []string{
+	FieldID,
+	FieldFlashcardID,
+	FieldCollectionID,
+	FieldName,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the FlashcardTag queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlashcardID orders the results by the flashcard_id field.
+func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
+}
+
+// ByCollectionID orders the results by the collection_id field.
+func ByCollectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectionID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFlashcardField orders the results by flashcard field.
+func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlashcardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+	)
+}
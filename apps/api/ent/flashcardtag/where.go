@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flashcardtag
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLTE(FieldID, id))
+}
+
+// FlashcardID applies equality check predicate on the "flashcard_id" field. It's identical to FlashcardIDEQ.
+func FlashcardID(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
+func CollectionID(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FlashcardIDEQ applies the EQ predicate on the "flashcard_id" field.
+func FlashcardIDEQ(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDNEQ applies the NEQ predicate on the "flashcard_id" field.
+func FlashcardIDNEQ(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDIn applies the In predicate on the "flashcard_id" field.
+func FlashcardIDIn(vs ...uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldIn(FieldFlashcardID, vs...))
+}
+
+// FlashcardIDNotIn applies the NotIn predicate on the "flashcard_id" field.
+func FlashcardIDNotIn(vs ...uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNotIn(FieldFlashcardID, vs...))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDGT applies the GT predicate on the "collection_id" field.
+func CollectionIDGT(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGT(FieldCollectionID, v))
+}
+
+// CollectionIDGTE applies the GTE predicate on the "collection_id" field.
+func CollectionIDGTE(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGTE(FieldCollectionID, v))
+}
+
+// CollectionIDLT applies the LT predicate on the "collection_id" field.
+func CollectionIDLT(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLT(FieldCollectionID, v))
+}
+
+// CollectionIDLTE applies the LTE predicate on the "collection_id" field.
+func CollectionIDLTE(v uuid.UUID) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLTE(FieldCollectionID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldContainsFold(FieldName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFlashcard applies the HasEdge predicate on the "flashcard" edge.
+func HasFlashcard() predicate.FlashcardTag {
+	return predicate.FlashcardTag(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlashcardWith applies the HasEdge predicate on the "flashcard" edge with a given conditions (other predicates).
+func HasFlashcardWith(preds ...predicate.Flashcard) predicate.FlashcardTag {
+	return predicate.FlashcardTag(func(s *sql.Selector) {
+		step := newFlashcardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FlashcardTag) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FlashcardTag) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FlashcardTag) predicate.FlashcardTag {
+	return predicate.FlashcardTag(sql.NotPredicates(p))
+}
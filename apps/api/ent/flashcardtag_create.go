@@ -0,0 +1,292 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+)
+
+// FlashcardTagCreate is the builder for creating a FlashcardTag entity.
+type FlashcardTagCreate struct {
+	config
+	mutation *FlashcardTagMutation
+	hooks    []Hook
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_c *FlashcardTagCreate) SetFlashcardID(v uuid.UUID) *FlashcardTagCreate {
+	_c.mutation.SetFlashcardID(v)
+	return _c
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_c *FlashcardTagCreate) SetCollectionID(v uuid.UUID) *FlashcardTagCreate {
+	_c.mutation.SetCollectionID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *FlashcardTagCreate) SetName(v string) *FlashcardTagCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FlashcardTagCreate) SetCreatedAt(v time.Time) *FlashcardTagCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FlashcardTagCreate) SetNillableCreatedAt(v *time.Time) *FlashcardTagCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *FlashcardTagCreate) SetID(v uuid.UUID) *FlashcardTagCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *FlashcardTagCreate) SetNillableID(v *uuid.UUID) *FlashcardTagCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_c *FlashcardTagCreate) SetFlashcard(v *Flashcard) *FlashcardTagCreate {
+	return _c.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardTagMutation object of the builder.
+func (_c *FlashcardTagCreate) Mutation() *FlashcardTagMutation {
+	return _c.mutation
+}
+
+// Save creates the FlashcardTag in the database.
+func (_c *FlashcardTagCreate) Save(ctx context.Context) (*FlashcardTag, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FlashcardTagCreate) SaveX(ctx context.Context) *FlashcardTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardTagCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardTagCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FlashcardTagCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := flashcardtag.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := flashcardtag.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FlashcardTagCreate) check() error {
+	if _, ok := _c.mutation.FlashcardID(); !ok {
+		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "FlashcardTag.flashcard_id"`)}
+	}
+	if _, ok := _c.mutation.CollectionID(); !ok {
+		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "FlashcardTag.collection_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FlashcardTag.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := flashcardtag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FlashcardTag.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FlashcardTag.created_at"`)}
+	}
+	if len(_c.mutation.FlashcardIDs()) == 0 {
+		return &ValidationError{Name: "flashcard", err: errors.New(`ent: missing required edge "FlashcardTag.flashcard"`)}
+	}
+	return nil
+}
+
+func (_c *FlashcardTagCreate) sqlSave(ctx context.Context) (*FlashcardTag, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FlashcardTagCreate) createSpec() (*FlashcardTag, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FlashcardTag{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(flashcardtag.Table, sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CollectionID(); ok {
+		_spec.SetField(flashcardtag.FieldCollectionID, field.TypeUUID, value)
+		_node.CollectionID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(flashcardtag.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(flashcardtag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardtag.FlashcardTable,
+			Columns: []string{flashcardtag.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlashcardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FlashcardTagCreateBulk is the builder for creating many FlashcardTag entities in bulk.
+type FlashcardTagCreateBulk struct {
+	config
+	err      error
+	builders []*FlashcardTagCreate
+}
+
+// Save creates the FlashcardTag entities in the database.
+func (_c *FlashcardTagCreateBulk) Save(ctx context.Context) ([]*FlashcardTag, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FlashcardTag, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FlashcardTagMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FlashcardTagCreateBulk) SaveX(ctx context.Context) []*FlashcardTag {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlashcardTagCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlashcardTagCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardTagUpdate is the builder for updating FlashcardTag entities.
+type FlashcardTagUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *FlashcardTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the FlashcardTagUpdate builder.
+func (_u *FlashcardTagUpdate) Where(ps ...predicate.FlashcardTag) *FlashcardTagUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_u *FlashcardTagUpdate) SetFlashcardID(v uuid.UUID) *FlashcardTagUpdate {
+	_u.mutation.SetFlashcardID(v)
+	return _u
+}
+
+// SetNillableFlashcardID sets the "flashcard_id" field if the given value is not nil.
+func (_u *FlashcardTagUpdate) SetNillableFlashcardID(v *uuid.UUID) *FlashcardTagUpdate {
+	if v != nil {
+		_u.SetFlashcardID(*v)
+	}
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *FlashcardTagUpdate) SetCollectionID(v uuid.UUID) *FlashcardTagUpdate {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *FlashcardTagUpdate) SetNillableCollectionID(v *uuid.UUID) *FlashcardTagUpdate {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FlashcardTagUpdate) SetName(v string) *FlashcardTagUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FlashcardTagUpdate) SetNillableName(v *string) *FlashcardTagUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardTagUpdate) SetFlashcard(v *Flashcard) *FlashcardTagUpdate {
+	return _u.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardTagMutation object of the builder.
+func (_u *FlashcardTagUpdate) Mutation() *FlashcardTagMutation {
+	return _u.mutation
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardTagUpdate) ClearFlashcard() *FlashcardTagUpdate {
+	_u.mutation.ClearFlashcard()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FlashcardTagUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FlashcardTagUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardTagUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardTagUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := flashcardtag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FlashcardTag.name": %w`, err)}
+		}
+	}
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardTag.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardTagUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardTagUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardTagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardtag.Table, flashcardtag.Columns, sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectionID(); ok {
+		_spec.SetField(flashcardtag.FieldCollectionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(flashcardtag.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.FlashcardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardtag.FlashcardTable,
+			Columns: []string{flashcardtag.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardtag.FlashcardTable,
+			Columns: []string{flashcardtag.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardtag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FlashcardTagUpdateOne is the builder for updating a single FlashcardTag entity.
+type FlashcardTagUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *FlashcardTagMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_u *FlashcardTagUpdateOne) SetFlashcardID(v uuid.UUID) *FlashcardTagUpdateOne {
+	_u.mutation.SetFlashcardID(v)
+	return _u
+}
+
+// SetNillableFlashcardID sets the "flashcard_id" field if the given value is not nil.
+func (_u *FlashcardTagUpdateOne) SetNillableFlashcardID(v *uuid.UUID) *FlashcardTagUpdateOne {
+	if v != nil {
+		_u.SetFlashcardID(*v)
+	}
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *FlashcardTagUpdateOne) SetCollectionID(v uuid.UUID) *FlashcardTagUpdateOne {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *FlashcardTagUpdateOne) SetNillableCollectionID(v *uuid.UUID) *FlashcardTagUpdateOne {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *FlashcardTagUpdateOne) SetName(v string) *FlashcardTagUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *FlashcardTagUpdateOne) SetNillableName(v *string) *FlashcardTagUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardTagUpdateOne) SetFlashcard(v *Flashcard) *FlashcardTagUpdateOne {
+	return _u.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the FlashcardTagMutation object of the builder.
+func (_u *FlashcardTagUpdateOne) Mutation() *FlashcardTagMutation {
+	return _u.mutation
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (_u *FlashcardTagUpdateOne) ClearFlashcard() *FlashcardTagUpdateOne {
+	_u.mutation.ClearFlashcard()
+	return _u
+}
+
+// Where appends a list predicates to the FlashcardTagUpdate builder.
+func (_u *FlashcardTagUpdateOne) Where(ps ...predicate.FlashcardTag) *FlashcardTagUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FlashcardTagUpdateOne) Select(field string, fields ...string) *FlashcardTagUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FlashcardTag entity.
+func (_u *FlashcardTagUpdateOne) Save(ctx context.Context) (*FlashcardTag, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlashcardTagUpdateOne) SaveX(ctx context.Context) *FlashcardTag {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FlashcardTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlashcardTagUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlashcardTagUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := flashcardtag.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "FlashcardTag.name": %w`, err)}
+		}
+	}
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlashcardTag.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *FlashcardTagUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *FlashcardTagUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *FlashcardTagUpdateOne) sqlSave(ctx context.Context) (_node *FlashcardTag, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flashcardtag.Table, flashcardtag.Columns, sqlgraph.NewFieldSpec(flashcardtag.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FlashcardTag.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, flashcardtag.FieldID)
+		for _, f := range fields {
+			if !flashcardtag.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != flashcardtag.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CollectionID(); ok {
+		_spec.SetField(flashcardtag.FieldCollectionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(flashcardtag.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.FlashcardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardtag.FlashcardTable,
+			Columns: []string{flashcardtag.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flashcardtag.FlashcardTable,
+			Columns: []string{flashcardtag.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &FlashcardTag{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flashcardtag.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -33,6 +33,18 @@ func (f CollectionCollaboratorFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CollectionCollaboratorMutation", m)
 }
 
+// The DeletionJobFunc type is an adapter to allow the use of ordinary
+// function as DeletionJob mutator.
+type DeletionJobFunc func(context.Context, *ent.DeletionJobMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeletionJobFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeletionJobMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeletionJobMutation", m)
+}
+
 // The FlashcardFunc type is an adapter to allow the use of ordinary
 // function as Flashcard mutator.
 type FlashcardFunc func(context.Context, *ent.FlashcardMutation) (ent.Value, error)
@@ -45,6 +57,30 @@ func (f FlashcardFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlashcardMutation", m)
 }
 
+// The FlashcardAnnotationFunc type is an adapter to allow the use of ordinary
+// function as FlashcardAnnotation mutator.
+type FlashcardAnnotationFunc func(context.Context, *ent.FlashcardAnnotationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FlashcardAnnotationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FlashcardAnnotationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlashcardAnnotationMutation", m)
+}
+
+// The FlashcardRatingFunc type is an adapter to allow the use of ordinary
+// function as FlashcardRating mutator.
+type FlashcardRatingFunc func(context.Context, *ent.FlashcardRatingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FlashcardRatingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FlashcardRatingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlashcardRatingMutation", m)
+}
+
 // The FlashcardReviewFunc type is an adapter to allow the use of ordinary
 // function as FlashcardReview mutator.
 type FlashcardReviewFunc func(context.Context, *ent.FlashcardReviewMutation) (ent.Value, error)
@@ -57,6 +93,126 @@ func (f FlashcardReviewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlashcardReviewMutation", m)
 }
 
+// The FlashcardRevisionFunc type is an adapter to allow the use of ordinary
+// function as FlashcardRevision mutator.
+type FlashcardRevisionFunc func(context.Context, *ent.FlashcardRevisionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FlashcardRevisionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FlashcardRevisionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlashcardRevisionMutation", m)
+}
+
+// The FlashcardTagFunc type is an adapter to allow the use of ordinary
+// function as FlashcardTag mutator.
+type FlashcardTagFunc func(context.Context, *ent.FlashcardTagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FlashcardTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FlashcardTagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlashcardTagMutation", m)
+}
+
+// The ItemStatisticFunc type is an adapter to allow the use of ordinary
+// function as ItemStatistic mutator.
+type ItemStatisticFunc func(context.Context, *ent.ItemStatisticMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ItemStatisticFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ItemStatisticMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ItemStatisticMutation", m)
+}
+
+// The LearnerAbilityFunc type is an adapter to allow the use of ordinary
+// function as LearnerAbility mutator.
+type LearnerAbilityFunc func(context.Context, *ent.LearnerAbilityMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LearnerAbilityFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LearnerAbilityMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LearnerAbilityMutation", m)
+}
+
+// The MediaFunc type is an adapter to allow the use of ordinary
+// function as Media mutator.
+type MediaFunc func(context.Context, *ent.MediaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MediaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MediaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaMutation", m)
+}
+
+// The MediaUploadFunc type is an adapter to allow the use of ordinary
+// function as MediaUpload mutator.
+type MediaUploadFunc func(context.Context, *ent.MediaUploadMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f MediaUploadFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.MediaUploadMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MediaUploadMutation", m)
+}
+
+// The QuizFunc type is an adapter to allow the use of ordinary
+// function as Quiz mutator.
+type QuizFunc func(context.Context, *ent.QuizMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuizMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizMutation", m)
+}
+
+// The QuizAnswerFunc type is an adapter to allow the use of ordinary
+// function as QuizAnswer mutator.
+type QuizAnswerFunc func(context.Context, *ent.QuizAnswerMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizAnswerFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuizAnswerMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizAnswerMutation", m)
+}
+
+// The QuizAttemptFunc type is an adapter to allow the use of ordinary
+// function as QuizAttempt mutator.
+type QuizAttemptFunc func(context.Context, *ent.QuizAttemptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizAttemptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuizAttemptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizAttemptMutation", m)
+}
+
+// The QuizReviewUpdateFunc type is an adapter to allow the use of ordinary
+// function as QuizReviewUpdate mutator.
+type QuizReviewUpdateFunc func(context.Context, *ent.QuizReviewUpdateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f QuizReviewUpdateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.QuizReviewUpdateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizReviewUpdateMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
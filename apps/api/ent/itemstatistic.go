@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+)
+
+// ItemStatistic is the model entity for the ItemStatistic schema.
+type ItemStatistic struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// FlashcardID holds the value of the "flashcard_id" field.
+	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Unset for the statistics of the flashcard across all quizzes
+	QuizID *uuid.UUID `json:"quiz_id,omitempty"`
+	// Submitted attempts that asked the flashcard
+	AttemptCount int `json:"attempt_count,omitempty"`
+	// AnsweredCount holds the value of the "answered_count" field.
+	AnsweredCount int `json:"answered_count,omitempty"`
+	// ScoreSum holds the value of the "score_sum" field.
+	ScoreSum float64 `json:"score_sum,omitempty"`
+	// ScoreSquares holds the value of the "score_squares" field.
+	ScoreSquares float64 `json:"score_squares,omitempty"`
+	// Sum of the attempt scores without this question, as a fraction of the maximum
+	RestSum float64 `json:"rest_sum,omitempty"`
+	// RestSquares holds the value of the "rest_squares" field.
+	RestSquares float64 `json:"rest_squares,omitempty"`
+	// ScoreRestProducts holds the value of the "score_rest_products" field.
+	ScoreRestProducts float64 `json:"score_rest_products,omitempty"`
+	// Total time spent on the answered questions
+	TimeSpentMs int64 `json:"time_spent_ms,omitempty"`
+	// Times each option was chosen, for questions asked with options
+	OptionCounts map[string]int `json:"option_counts,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ItemStatisticQuery when eager-loading is set.
+	Edges        ItemStatisticEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ItemStatisticEdges holds the relations/edges for other nodes in the graph.
+type ItemStatisticEdges struct {
+	// Flashcard holds the value of the flashcard edge.
+	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// Quiz holds the value of the quiz edge.
+	Quiz *Quiz `json:"quiz,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FlashcardOrErr returns the Flashcard value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemStatisticEdges) FlashcardOrErr() (*Flashcard, error) {
+	if e.Flashcard != nil {
+		return e.Flashcard, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flashcard.Label}
+	}
+	return nil, &NotLoadedError{edge: "flashcard"}
+}
+
+// QuizOrErr returns the Quiz value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemStatisticEdges) QuizOrErr() (*Quiz, error) {
+	if e.Quiz != nil {
+		return e.Quiz, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: quiz.Label}
+	}
+	return nil, &NotLoadedError{edge: "quiz"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ItemStatistic) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case itemstatistic.FieldQuizID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case itemstatistic.FieldOptionCounts:
+			values[i] = new([]byte)
+		case itemstatistic.FieldScoreSum, itemstatistic.FieldScoreSquares, itemstatistic.FieldRestSum, itemstatistic.FieldRestSquares, itemstatistic.FieldScoreRestProducts:
+			values[i] = new(sql.NullFloat64)
+		case itemstatistic.FieldAttemptCount, itemstatistic.FieldAnsweredCount, itemstatistic.FieldTimeSpentMs:
+			values[i] = new(sql.NullInt64)
+		case itemstatistic.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case itemstatistic.FieldID, itemstatistic.FieldFlashcardID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ItemStatistic fields.
+func (_m *ItemStatistic) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case itemstatistic.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case itemstatistic.FieldFlashcardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_id", values[i])
+			} else if value != nil {
+				_m.FlashcardID = *value
+			}
+		case itemstatistic.FieldQuizID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field quiz_id", values[i])
+			} else if value.Valid {
+				_m.QuizID = new(uuid.UUID)
+				*_m.QuizID = *value.S.(*uuid.UUID)
+			}
+		case itemstatistic.FieldAttemptCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_count", values[i])
+			} else if value.Valid {
+				_m.AttemptCount = int(value.Int64)
+			}
+		case itemstatistic.FieldAnsweredCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_count", values[i])
+			} else if value.Valid {
+				_m.AnsweredCount = int(value.Int64)
+			}
+		case itemstatistic.FieldScoreSum:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score_sum", values[i])
+			} else if value.Valid {
+				_m.ScoreSum = value.Float64
+			}
+		case itemstatistic.FieldScoreSquares:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score_squares", values[i])
+			} else if value.Valid {
+				_m.ScoreSquares = value.Float64
+			}
+		case itemstatistic.FieldRestSum:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rest_sum", values[i])
+			} else if value.Valid {
+				_m.RestSum = value.Float64
+			}
+		case itemstatistic.FieldRestSquares:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field rest_squares", values[i])
+			} else if value.Valid {
+				_m.RestSquares = value.Float64
+			}
+		case itemstatistic.FieldScoreRestProducts:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score_rest_products", values[i])
+			} else if value.Valid {
+				_m.ScoreRestProducts = value.Float64
+			}
+		case itemstatistic.FieldTimeSpentMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_spent_ms", values[i])
+			} else if value.Valid {
+				_m.TimeSpentMs = value.Int64
+			}
+		case itemstatistic.FieldOptionCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field option_counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OptionCounts); err != nil {
+					return fmt.Errorf("unmarshal field option_counts: %w", err)
+				}
+			}
+		case itemstatistic.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ItemStatistic.
+// This includes values selected through modifiers, order, etc.
+func (_m *ItemStatistic) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlashcard queries the "flashcard" edge of the ItemStatistic entity.
+func (_m *ItemStatistic) QueryFlashcard() *FlashcardQuery {
+	return NewItemStatisticClient(_m.config).QueryFlashcard(_m)
+}
+
+// QueryQuiz queries the "quiz" edge of the ItemStatistic entity.
+func (_m *ItemStatistic) QueryQuiz() *QuizQuery {
+	return NewItemStatisticClient(_m.config).QueryQuiz(_m)
+}
+
+// Update returns a builder for updating this ItemStatistic.
+// Note that you need to call ItemStatistic.Unwrap() before calling this method if this ItemStatistic
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ItemStatistic) Update() *ItemStatisticUpdateOne {
+	return NewItemStatisticClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ItemStatistic entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ItemStatistic) Unwrap() *ItemStatistic {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ItemStatistic is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ItemStatistic) String() string {
+	var builder strings.Builder
+	builder.WriteString("ItemStatistic(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flashcard_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
+	builder.WriteString(", ")
+	if v := _m.QuizID; v != nil {
+		builder.WriteString("quiz_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("attempt_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptCount))
+	builder.WriteString(", ")
+	builder.WriteString("answered_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnsweredCount))
+	builder.WriteString(", ")
+	builder.WriteString("score_sum=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScoreSum))
+	builder.WriteString(", ")
+	builder.WriteString("score_squares=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScoreSquares))
+	builder.WriteString(", ")
+	builder.WriteString("rest_sum=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestSum))
+	builder.WriteString(", ")
+	builder.WriteString("rest_squares=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestSquares))
+	builder.WriteString(", ")
+	builder.WriteString("score_rest_products=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ScoreRestProducts))
+	builder.WriteString(", ")
+	builder.WriteString("time_spent_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeSpentMs))
+	builder.WriteString(", ")
+	builder.WriteString("option_counts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OptionCounts))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ItemStatistics is a parsable slice of ItemStatistic.
+type ItemStatistics []*ItemStatistic
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemstatistic
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the itemstatistic type in the database.
+	Label = "item_statistic"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
+	FieldFlashcardID = "flashcard_id"
+	// FieldQuizID holds the string denoting the quiz_id field in the database.
+	FieldQuizID = "quiz_id"
+	// FieldAttemptCount holds the string denoting the attempt_count field in the database.
+	FieldAttemptCount = "attempt_count"
+	// FieldAnsweredCount holds the string denoting the answered_count field in the database.
+	FieldAnsweredCount = "answered_count"
+	// FieldScoreSum holds the string denoting the score_sum field in the database.
+	FieldScoreSum = "score_sum"
+	// FieldScoreSquares holds the string denoting the score_squares field in the database.
+	FieldScoreSquares = "score_squares"
+	// FieldRestSum holds the string denoting the rest_sum field in the database.
+	FieldRestSum = "rest_sum"
+	// FieldRestSquares holds the string denoting the rest_squares field in the database.
+	FieldRestSquares = "rest_squares"
+	// FieldScoreRestProducts holds the string denoting the score_rest_products field in the database.
+	FieldScoreRestProducts = "score_rest_products"
+	// FieldTimeSpentMs holds the string denoting the time_spent_ms field in the database.
+	FieldTimeSpentMs = "time_spent_ms"
+	// FieldOptionCounts holds the string denoting the option_counts field in the database.
+	FieldOptionCounts = "option_counts"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
+	EdgeFlashcard = "flashcard"
+	// EdgeQuiz holds the string denoting the quiz edge name in mutations.
+	EdgeQuiz = "quiz"
+	// Table holds the table name of the itemstatistic in the database.
+	Table = "item_statistics"
+	// FlashcardTable is the table that holds the flashcard relation/edge.
+	FlashcardTable = "item_statistics"
+	// FlashcardInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardInverseTable = "flashcards"
+	// FlashcardColumn is the table column denoting the flashcard relation/edge.
+	FlashcardColumn = "flashcard_id"
+	// QuizTable is the table that holds the quiz relation/edge.
+	QuizTable = "item_statistics"
+	// QuizInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	QuizInverseTable = "quizs"
+	// QuizColumn is the table column denoting the quiz relation/edge.
+	QuizColumn = "quiz_id"
+)
+
+// Columns holds all SQL columns for itemstatistic fields.
+var Columns = []string{
+	FieldID,
+	FieldFlashcardID,
+	FieldQuizID,
+	FieldAttemptCount,
+	FieldAnsweredCount,
+	FieldScoreSum,
+	FieldScoreSquares,
+	FieldRestSum,
+	FieldRestSquares,
+	FieldScoreRestProducts,
+	FieldTimeSpentMs,
+	FieldOptionCounts,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttemptCount holds the default value on creation for the "attempt_count" field.
+	DefaultAttemptCount int
+	// DefaultAnsweredCount holds the default value on creation for the "answered_count" field.
+	DefaultAnsweredCount int
+	// DefaultScoreSum holds the default value on creation for the "score_sum" field.
+	DefaultScoreSum float64
+	// DefaultScoreSquares holds the default value on creation for the "score_squares" field.
+	DefaultScoreSquares float64
+	// DefaultRestSum holds the default value on creation for the "rest_sum" field.
+	DefaultRestSum float64
+	// DefaultRestSquares holds the default value on creation for the "rest_squares" field.
+	DefaultRestSquares float64
+	// DefaultScoreRestProducts holds the default value on creation for the "score_rest_products" field.
+	DefaultScoreRestProducts float64
+	// DefaultTimeSpentMs holds the default value on creation for the "time_spent_ms" field.
+	DefaultTimeSpentMs int64
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ItemStatistic queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlashcardID orders the results by the flashcard_id field.
+func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
+}
+
+// ByQuizID orders the results by the quiz_id field.
+func ByQuizID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuizID, opts...).ToFunc()
+}
+
+// ByAttemptCount orders the results by the attempt_count field.
+func ByAttemptCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptCount, opts...).ToFunc()
+}
+
+// ByAnsweredCount orders the results by the answered_count field.
+func ByAnsweredCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnsweredCount, opts...).ToFunc()
+}
+
+// ByScoreSum orders the results by the score_sum field.
+func ByScoreSum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoreSum, opts...).ToFunc()
+}
+
+// ByScoreSquares orders the results by the score_squares field.
+func ByScoreSquares(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoreSquares, opts...).ToFunc()
+}
+
+// ByRestSum orders the results by the rest_sum field.
+func ByRestSum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestSum, opts...).ToFunc()
+}
+
+// ByRestSquares orders the results by the rest_squares field.
+func ByRestSquares(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRestSquares, opts...).ToFunc()
+}
+
+// ByScoreRestProducts orders the results by the score_rest_products field.
+func ByScoreRestProducts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScoreRestProducts, opts...).ToFunc()
+}
+
+// ByTimeSpentMs orders the results by the time_spent_ms field.
+func ByTimeSpentMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeSpentMs, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFlashcardField orders the results by flashcard field.
+func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByQuizField orders the results by quiz field.
+func ByQuizField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlashcardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+	)
+}
+func newQuizStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+	)
+}
@@ -0,0 +1,593 @@
+// Code generated by ent, DO NOT EDIT.
+
+package itemstatistic
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldID, id))
+}
+
+// FlashcardID applies equality check predicate on the "flashcard_id" field. It's identical to FlashcardIDEQ.
+func FlashcardID(v uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// QuizID applies equality check predicate on the "quiz_id" field. It's identical to QuizIDEQ.
+func QuizID(v uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldQuizID, v))
+}
+
+// AttemptCount applies equality check predicate on the "attempt_count" field. It's identical to AttemptCountEQ.
+func AttemptCount(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// AnsweredCount applies equality check predicate on the "answered_count" field. It's identical to AnsweredCountEQ.
+func AnsweredCount(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldAnsweredCount, v))
+}
+
+// ScoreSum applies equality check predicate on the "score_sum" field. It's identical to ScoreSumEQ.
+func ScoreSum(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldScoreSum, v))
+}
+
+// ScoreSquares applies equality check predicate on the "score_squares" field. It's identical to ScoreSquaresEQ.
+func ScoreSquares(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldScoreSquares, v))
+}
+
+// RestSum applies equality check predicate on the "rest_sum" field. It's identical to RestSumEQ.
+func RestSum(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldRestSum, v))
+}
+
+// RestSquares applies equality check predicate on the "rest_squares" field. It's identical to RestSquaresEQ.
+func RestSquares(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldRestSquares, v))
+}
+
+// ScoreRestProducts applies equality check predicate on the "score_rest_products" field. It's identical to ScoreRestProductsEQ.
+func ScoreRestProducts(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldScoreRestProducts, v))
+}
+
+// TimeSpentMs applies equality check predicate on the "time_spent_ms" field. It's identical to TimeSpentMsEQ.
+func TimeSpentMs(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldTimeSpentMs, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FlashcardIDEQ applies the EQ predicate on the "flashcard_id" field.
+func FlashcardIDEQ(v uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDNEQ applies the NEQ predicate on the "flashcard_id" field.
+func FlashcardIDNEQ(v uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDIn applies the In predicate on the "flashcard_id" field.
+func FlashcardIDIn(vs ...uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldFlashcardID, vs...))
+}
+
+// FlashcardIDNotIn applies the NotIn predicate on the "flashcard_id" field.
+func FlashcardIDNotIn(vs ...uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldFlashcardID, vs...))
+}
+
+// QuizIDEQ applies the EQ predicate on the "quiz_id" field.
+func QuizIDEQ(v uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldQuizID, v))
+}
+
+// QuizIDNEQ applies the NEQ predicate on the "quiz_id" field.
+func QuizIDNEQ(v uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldQuizID, v))
+}
+
+// QuizIDIn applies the In predicate on the "quiz_id" field.
+func QuizIDIn(vs ...uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldQuizID, vs...))
+}
+
+// QuizIDNotIn applies the NotIn predicate on the "quiz_id" field.
+func QuizIDNotIn(vs ...uuid.UUID) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldQuizID, vs...))
+}
+
+// QuizIDIsNil applies the IsNil predicate on the "quiz_id" field.
+func QuizIDIsNil() predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIsNull(FieldQuizID))
+}
+
+// QuizIDNotNil applies the NotNil predicate on the "quiz_id" field.
+func QuizIDNotNil() predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotNull(FieldQuizID))
+}
+
+// AttemptCountEQ applies the EQ predicate on the "attempt_count" field.
+func AttemptCountEQ(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountNEQ applies the NEQ predicate on the "attempt_count" field.
+func AttemptCountNEQ(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldAttemptCount, v))
+}
+
+// AttemptCountIn applies the In predicate on the "attempt_count" field.
+func AttemptCountIn(vs ...int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountNotIn applies the NotIn predicate on the "attempt_count" field.
+func AttemptCountNotIn(vs ...int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldAttemptCount, vs...))
+}
+
+// AttemptCountGT applies the GT predicate on the "attempt_count" field.
+func AttemptCountGT(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldAttemptCount, v))
+}
+
+// AttemptCountGTE applies the GTE predicate on the "attempt_count" field.
+func AttemptCountGTE(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldAttemptCount, v))
+}
+
+// AttemptCountLT applies the LT predicate on the "attempt_count" field.
+func AttemptCountLT(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldAttemptCount, v))
+}
+
+// AttemptCountLTE applies the LTE predicate on the "attempt_count" field.
+func AttemptCountLTE(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldAttemptCount, v))
+}
+
+// AnsweredCountEQ applies the EQ predicate on the "answered_count" field.
+func AnsweredCountEQ(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldAnsweredCount, v))
+}
+
+// AnsweredCountNEQ applies the NEQ predicate on the "answered_count" field.
+func AnsweredCountNEQ(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldAnsweredCount, v))
+}
+
+// AnsweredCountIn applies the In predicate on the "answered_count" field.
+func AnsweredCountIn(vs ...int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldAnsweredCount, vs...))
+}
+
+// AnsweredCountNotIn applies the NotIn predicate on the "answered_count" field.
+func AnsweredCountNotIn(vs ...int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldAnsweredCount, vs...))
+}
+
+// AnsweredCountGT applies the GT predicate on the "answered_count" field.
+func AnsweredCountGT(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldAnsweredCount, v))
+}
+
+// AnsweredCountGTE applies the GTE predicate on the "answered_count" field.
+func AnsweredCountGTE(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldAnsweredCount, v))
+}
+
+// AnsweredCountLT applies the LT predicate on the "answered_count" field.
+func AnsweredCountLT(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldAnsweredCount, v))
+}
+
+// AnsweredCountLTE applies the LTE predicate on the "answered_count" field.
+func AnsweredCountLTE(v int) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldAnsweredCount, v))
+}
+
+// ScoreSumEQ applies the EQ predicate on the "score_sum" field.
+func ScoreSumEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldScoreSum, v))
+}
+
+// ScoreSumNEQ applies the NEQ predicate on the "score_sum" field.
+func ScoreSumNEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldScoreSum, v))
+}
+
+// ScoreSumIn applies the In predicate on the "score_sum" field.
+func ScoreSumIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldScoreSum, vs...))
+}
+
+// ScoreSumNotIn applies the NotIn predicate on the "score_sum" field.
+func ScoreSumNotIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldScoreSum, vs...))
+}
+
+// ScoreSumGT applies the GT predicate on the "score_sum" field.
+func ScoreSumGT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldScoreSum, v))
+}
+
+// ScoreSumGTE applies the GTE predicate on the "score_sum" field.
+func ScoreSumGTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldScoreSum, v))
+}
+
+// ScoreSumLT applies the LT predicate on the "score_sum" field.
+func ScoreSumLT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldScoreSum, v))
+}
+
+// ScoreSumLTE applies the LTE predicate on the "score_sum" field.
+func ScoreSumLTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldScoreSum, v))
+}
+
+// ScoreSquaresEQ applies the EQ predicate on the "score_squares" field.
+func ScoreSquaresEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldScoreSquares, v))
+}
+
+// ScoreSquaresNEQ applies the NEQ predicate on the "score_squares" field.
+func ScoreSquaresNEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldScoreSquares, v))
+}
+
+// ScoreSquaresIn applies the In predicate on the "score_squares" field.
+func ScoreSquaresIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldScoreSquares, vs...))
+}
+
+// ScoreSquaresNotIn applies the NotIn predicate on the "score_squares" field.
+func ScoreSquaresNotIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldScoreSquares, vs...))
+}
+
+// ScoreSquaresGT applies the GT predicate on the "score_squares" field.
+func ScoreSquaresGT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldScoreSquares, v))
+}
+
+// ScoreSquaresGTE applies the GTE predicate on the "score_squares" field.
+func ScoreSquaresGTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldScoreSquares, v))
+}
+
+// ScoreSquaresLT applies the LT predicate on the "score_squares" field.
+func ScoreSquaresLT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldScoreSquares, v))
+}
+
+// ScoreSquaresLTE applies the LTE predicate on the "score_squares" field.
+func ScoreSquaresLTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldScoreSquares, v))
+}
+
+// RestSumEQ applies the EQ predicate on the "rest_sum" field.
+func RestSumEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldRestSum, v))
+}
+
+// RestSumNEQ applies the NEQ predicate on the "rest_sum" field.
+func RestSumNEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldRestSum, v))
+}
+
+// RestSumIn applies the In predicate on the "rest_sum" field.
+func RestSumIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldRestSum, vs...))
+}
+
+// RestSumNotIn applies the NotIn predicate on the "rest_sum" field.
+func RestSumNotIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldRestSum, vs...))
+}
+
+// RestSumGT applies the GT predicate on the "rest_sum" field.
+func RestSumGT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldRestSum, v))
+}
+
+// RestSumGTE applies the GTE predicate on the "rest_sum" field.
+func RestSumGTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldRestSum, v))
+}
+
+// RestSumLT applies the LT predicate on the "rest_sum" field.
+func RestSumLT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldRestSum, v))
+}
+
+// RestSumLTE applies the LTE predicate on the "rest_sum" field.
+func RestSumLTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldRestSum, v))
+}
+
+// RestSquaresEQ applies the EQ predicate on the "rest_squares" field.
+func RestSquaresEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldRestSquares, v))
+}
+
+// RestSquaresNEQ applies the NEQ predicate on the "rest_squares" field.
+func RestSquaresNEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldRestSquares, v))
+}
+
+// RestSquaresIn applies the In predicate on the "rest_squares" field.
+func RestSquaresIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldRestSquares, vs...))
+}
+
+// RestSquaresNotIn applies the NotIn predicate on the "rest_squares" field.
+func RestSquaresNotIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldRestSquares, vs...))
+}
+
+// RestSquaresGT applies the GT predicate on the "rest_squares" field.
+func RestSquaresGT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldRestSquares, v))
+}
+
+// RestSquaresGTE applies the GTE predicate on the "rest_squares" field.
+func RestSquaresGTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldRestSquares, v))
+}
+
+// RestSquaresLT applies the LT predicate on the "rest_squares" field.
+func RestSquaresLT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldRestSquares, v))
+}
+
+// RestSquaresLTE applies the LTE predicate on the "rest_squares" field.
+func RestSquaresLTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldRestSquares, v))
+}
+
+// ScoreRestProductsEQ applies the EQ predicate on the "score_rest_products" field.
+func ScoreRestProductsEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldScoreRestProducts, v))
+}
+
+// ScoreRestProductsNEQ applies the NEQ predicate on the "score_rest_products" field.
+func ScoreRestProductsNEQ(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldScoreRestProducts, v))
+}
+
+// ScoreRestProductsIn applies the In predicate on the "score_rest_products" field.
+func ScoreRestProductsIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldScoreRestProducts, vs...))
+}
+
+// ScoreRestProductsNotIn applies the NotIn predicate on the "score_rest_products" field.
+func ScoreRestProductsNotIn(vs ...float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldScoreRestProducts, vs...))
+}
+
+// ScoreRestProductsGT applies the GT predicate on the "score_rest_products" field.
+func ScoreRestProductsGT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldScoreRestProducts, v))
+}
+
+// ScoreRestProductsGTE applies the GTE predicate on the "score_rest_products" field.
+func ScoreRestProductsGTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldScoreRestProducts, v))
+}
+
+// ScoreRestProductsLT applies the LT predicate on the "score_rest_products" field.
+func ScoreRestProductsLT(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldScoreRestProducts, v))
+}
+
+// ScoreRestProductsLTE applies the LTE predicate on the "score_rest_products" field.
+func ScoreRestProductsLTE(v float64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldScoreRestProducts, v))
+}
+
+// TimeSpentMsEQ applies the EQ predicate on the "time_spent_ms" field.
+func TimeSpentMsEQ(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsNEQ applies the NEQ predicate on the "time_spent_ms" field.
+func TimeSpentMsNEQ(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsIn applies the In predicate on the "time_spent_ms" field.
+func TimeSpentMsIn(vs ...int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldTimeSpentMs, vs...))
+}
+
+// TimeSpentMsNotIn applies the NotIn predicate on the "time_spent_ms" field.
+func TimeSpentMsNotIn(vs ...int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldTimeSpentMs, vs...))
+}
+
+// TimeSpentMsGT applies the GT predicate on the "time_spent_ms" field.
+func TimeSpentMsGT(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsGTE applies the GTE predicate on the "time_spent_ms" field.
+func TimeSpentMsGTE(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsLT applies the LT predicate on the "time_spent_ms" field.
+func TimeSpentMsLT(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsLTE applies the LTE predicate on the "time_spent_ms" field.
+func TimeSpentMsLTE(v int64) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldTimeSpentMs, v))
+}
+
+// OptionCountsIsNil applies the IsNil predicate on the "option_counts" field.
+func OptionCountsIsNil() predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIsNull(FieldOptionCounts))
+}
+
+// OptionCountsNotNil applies the NotNil predicate on the "option_counts" field.
+func OptionCountsNotNil() predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotNull(FieldOptionCounts))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasFlashcard applies the HasEdge predicate on the "flashcard" edge.
+func HasFlashcard() predicate.ItemStatistic {
+	return predicate.ItemStatistic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlashcardWith applies the HasEdge predicate on the "flashcard" edge with a given conditions (other predicates).
+func HasFlashcardWith(preds ...predicate.Flashcard) predicate.ItemStatistic {
+	return predicate.ItemStatistic(func(s *sql.Selector) {
+		step := newFlashcardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuiz applies the HasEdge predicate on the "quiz" edge.
+func HasQuiz() predicate.ItemStatistic {
+	return predicate.ItemStatistic(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizWith applies the HasEdge predicate on the "quiz" edge with a given conditions (other predicates).
+func HasQuizWith(preds ...predicate.Quiz) predicate.ItemStatistic {
+	return predicate.ItemStatistic(func(s *sql.Selector) {
+		step := newQuizStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ItemStatistic) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ItemStatistic) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ItemStatistic) predicate.ItemStatistic {
+	return predicate.ItemStatistic(sql.NotPredicates(p))
+}
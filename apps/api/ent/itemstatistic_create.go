@@ -0,0 +1,508 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+)
+
+// ItemStatisticCreate is the builder for creating a ItemStatistic entity.
+type ItemStatisticCreate struct {
+	config
+	mutation *ItemStatisticMutation
+	hooks    []Hook
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_c *ItemStatisticCreate) SetFlashcardID(v uuid.UUID) *ItemStatisticCreate {
+	_c.mutation.SetFlashcardID(v)
+	return _c
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (_c *ItemStatisticCreate) SetQuizID(v uuid.UUID) *ItemStatisticCreate {
+	_c.mutation.SetQuizID(v)
+	return _c
+}
+
+// SetNillableQuizID sets the "quiz_id" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableQuizID(v *uuid.UUID) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetQuizID(*v)
+	}
+	return _c
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_c *ItemStatisticCreate) SetAttemptCount(v int) *ItemStatisticCreate {
+	_c.mutation.SetAttemptCount(v)
+	return _c
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableAttemptCount(v *int) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetAttemptCount(*v)
+	}
+	return _c
+}
+
+// SetAnsweredCount sets the "answered_count" field.
+func (_c *ItemStatisticCreate) SetAnsweredCount(v int) *ItemStatisticCreate {
+	_c.mutation.SetAnsweredCount(v)
+	return _c
+}
+
+// SetNillableAnsweredCount sets the "answered_count" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableAnsweredCount(v *int) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetAnsweredCount(*v)
+	}
+	return _c
+}
+
+// SetScoreSum sets the "score_sum" field.
+func (_c *ItemStatisticCreate) SetScoreSum(v float64) *ItemStatisticCreate {
+	_c.mutation.SetScoreSum(v)
+	return _c
+}
+
+// SetNillableScoreSum sets the "score_sum" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableScoreSum(v *float64) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetScoreSum(*v)
+	}
+	return _c
+}
+
+// SetScoreSquares sets the "score_squares" field.
+func (_c *ItemStatisticCreate) SetScoreSquares(v float64) *ItemStatisticCreate {
+	_c.mutation.SetScoreSquares(v)
+	return _c
+}
+
+// SetNillableScoreSquares sets the "score_squares" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableScoreSquares(v *float64) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetScoreSquares(*v)
+	}
+	return _c
+}
+
+// SetRestSum sets the "rest_sum" field.
+func (_c *ItemStatisticCreate) SetRestSum(v float64) *ItemStatisticCreate {
+	_c.mutation.SetRestSum(v)
+	return _c
+}
+
+// SetNillableRestSum sets the "rest_sum" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableRestSum(v *float64) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetRestSum(*v)
+	}
+	return _c
+}
+
+// SetRestSquares sets the "rest_squares" field.
+func (_c *ItemStatisticCreate) SetRestSquares(v float64) *ItemStatisticCreate {
+	_c.mutation.SetRestSquares(v)
+	return _c
+}
+
+// SetNillableRestSquares sets the "rest_squares" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableRestSquares(v *float64) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetRestSquares(*v)
+	}
+	return _c
+}
+
+// SetScoreRestProducts sets the "score_rest_products" field.
+func (_c *ItemStatisticCreate) SetScoreRestProducts(v float64) *ItemStatisticCreate {
+	_c.mutation.SetScoreRestProducts(v)
+	return _c
+}
+
+// SetNillableScoreRestProducts sets the "score_rest_products" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableScoreRestProducts(v *float64) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetScoreRestProducts(*v)
+	}
+	return _c
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (_c *ItemStatisticCreate) SetTimeSpentMs(v int64) *ItemStatisticCreate {
+	_c.mutation.SetTimeSpentMs(v)
+	return _c
+}
+
+// SetNillableTimeSpentMs sets the "time_spent_ms" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableTimeSpentMs(v *int64) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetTimeSpentMs(*v)
+	}
+	return _c
+}
+
+// SetOptionCounts sets the "option_counts" field.
+func (_c *ItemStatisticCreate) SetOptionCounts(v map[string]int) *ItemStatisticCreate {
+	_c.mutation.SetOptionCounts(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ItemStatisticCreate) SetUpdatedAt(v time.Time) *ItemStatisticCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableUpdatedAt(v *time.Time) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ItemStatisticCreate) SetID(v uuid.UUID) *ItemStatisticCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ItemStatisticCreate) SetNillableID(v *uuid.UUID) *ItemStatisticCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_c *ItemStatisticCreate) SetFlashcard(v *Flashcard) *ItemStatisticCreate {
+	return _c.SetFlashcardID(v.ID)
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (_c *ItemStatisticCreate) SetQuiz(v *Quiz) *ItemStatisticCreate {
+	return _c.SetQuizID(v.ID)
+}
+
+// Mutation returns the ItemStatisticMutation object of the builder.
+func (_c *ItemStatisticCreate) Mutation() *ItemStatisticMutation {
+	return _c.mutation
+}
+
+// Save creates the ItemStatistic in the database.
+func (_c *ItemStatisticCreate) Save(ctx context.Context) (*ItemStatistic, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ItemStatisticCreate) SaveX(ctx context.Context) *ItemStatistic {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemStatisticCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemStatisticCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ItemStatisticCreate) defaults() {
+	if _, ok := _c.mutation.AttemptCount(); !ok {
+		v := itemstatistic.DefaultAttemptCount
+		_c.mutation.SetAttemptCount(v)
+	}
+	if _, ok := _c.mutation.AnsweredCount(); !ok {
+		v := itemstatistic.DefaultAnsweredCount
+		_c.mutation.SetAnsweredCount(v)
+	}
+	if _, ok := _c.mutation.ScoreSum(); !ok {
+		v := itemstatistic.DefaultScoreSum
+		_c.mutation.SetScoreSum(v)
+	}
+	if _, ok := _c.mutation.ScoreSquares(); !ok {
+		v := itemstatistic.DefaultScoreSquares
+		_c.mutation.SetScoreSquares(v)
+	}
+	if _, ok := _c.mutation.RestSum(); !ok {
+		v := itemstatistic.DefaultRestSum
+		_c.mutation.SetRestSum(v)
+	}
+	if _, ok := _c.mutation.RestSquares(); !ok {
+		v := itemstatistic.DefaultRestSquares
+		_c.mutation.SetRestSquares(v)
+	}
+	if _, ok := _c.mutation.ScoreRestProducts(); !ok {
+		v := itemstatistic.DefaultScoreRestProducts
+		_c.mutation.SetScoreRestProducts(v)
+	}
+	if _, ok := _c.mutation.TimeSpentMs(); !ok {
+		v := itemstatistic.DefaultTimeSpentMs
+		_c.mutation.SetTimeSpentMs(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := itemstatistic.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := itemstatistic.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ItemStatisticCreate) check() error {
+	if _, ok := _c.mutation.FlashcardID(); !ok {
+		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "ItemStatistic.flashcard_id"`)}
+	}
+	if _, ok := _c.mutation.AttemptCount(); !ok {
+		return &ValidationError{Name: "attempt_count", err: errors.New(`ent: missing required field "ItemStatistic.attempt_count"`)}
+	}
+	if _, ok := _c.mutation.AnsweredCount(); !ok {
+		return &ValidationError{Name: "answered_count", err: errors.New(`ent: missing required field "ItemStatistic.answered_count"`)}
+	}
+	if _, ok := _c.mutation.ScoreSum(); !ok {
+		return &ValidationError{Name: "score_sum", err: errors.New(`ent: missing required field "ItemStatistic.score_sum"`)}
+	}
+	if _, ok := _c.mutation.ScoreSquares(); !ok {
+		return &ValidationError{Name: "score_squares", err: errors.New(`ent: missing required field "ItemStatistic.score_squares"`)}
+	}
+	if _, ok := _c.mutation.RestSum(); !ok {
+		return &ValidationError{Name: "rest_sum", err: errors.New(`ent: missing required field "ItemStatistic.rest_sum"`)}
+	}
+	if _, ok := _c.mutation.RestSquares(); !ok {
+		return &ValidationError{Name: "rest_squares", err: errors.New(`ent: missing required field "ItemStatistic.rest_squares"`)}
+	}
+	if _, ok := _c.mutation.ScoreRestProducts(); !ok {
+		return &ValidationError{Name: "score_rest_products", err: errors.New(`ent: missing required field "ItemStatistic.score_rest_products"`)}
+	}
+	if _, ok := _c.mutation.TimeSpentMs(); !ok {
+		return &ValidationError{Name: "time_spent_ms", err: errors.New(`ent: missing required field "ItemStatistic.time_spent_ms"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ItemStatistic.updated_at"`)}
+	}
+	if len(_c.mutation.FlashcardIDs()) == 0 {
+		return &ValidationError{Name: "flashcard", err: errors.New(`ent: missing required edge "ItemStatistic.flashcard"`)}
+	}
+	return nil
+}
+
+func (_c *ItemStatisticCreate) sqlSave(ctx context.Context) (*ItemStatistic, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ItemStatisticCreate) createSpec() (*ItemStatistic, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ItemStatistic{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(itemstatistic.Table, sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.AttemptCount(); ok {
+		_spec.SetField(itemstatistic.FieldAttemptCount, field.TypeInt, value)
+		_node.AttemptCount = value
+	}
+	if value, ok := _c.mutation.AnsweredCount(); ok {
+		_spec.SetField(itemstatistic.FieldAnsweredCount, field.TypeInt, value)
+		_node.AnsweredCount = value
+	}
+	if value, ok := _c.mutation.ScoreSum(); ok {
+		_spec.SetField(itemstatistic.FieldScoreSum, field.TypeFloat64, value)
+		_node.ScoreSum = value
+	}
+	if value, ok := _c.mutation.ScoreSquares(); ok {
+		_spec.SetField(itemstatistic.FieldScoreSquares, field.TypeFloat64, value)
+		_node.ScoreSquares = value
+	}
+	if value, ok := _c.mutation.RestSum(); ok {
+		_spec.SetField(itemstatistic.FieldRestSum, field.TypeFloat64, value)
+		_node.RestSum = value
+	}
+	if value, ok := _c.mutation.RestSquares(); ok {
+		_spec.SetField(itemstatistic.FieldRestSquares, field.TypeFloat64, value)
+		_node.RestSquares = value
+	}
+	if value, ok := _c.mutation.ScoreRestProducts(); ok {
+		_spec.SetField(itemstatistic.FieldScoreRestProducts, field.TypeFloat64, value)
+		_node.ScoreRestProducts = value
+	}
+	if value, ok := _c.mutation.TimeSpentMs(); ok {
+		_spec.SetField(itemstatistic.FieldTimeSpentMs, field.TypeInt64, value)
+		_node.TimeSpentMs = value
+	}
+	if value, ok := _c.mutation.OptionCounts(); ok {
+		_spec.SetField(itemstatistic.FieldOptionCounts, field.TypeJSON, value)
+		_node.OptionCounts = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemstatistic.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatistic.FlashcardTable,
+			Columns: []string{itemstatistic.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlashcardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   itemstatistic.QuizTable,
+			Columns: []string{itemstatistic.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuizID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ItemStatisticCreateBulk is the builder for creating many ItemStatistic entities in bulk.
+type ItemStatisticCreateBulk struct {
+	config
+	err      error
+	builders []*ItemStatisticCreate
+}
+
+// Save creates the ItemStatistic entities in the database.
+func (_c *ItemStatisticCreateBulk) Save(ctx context.Context) ([]*ItemStatistic, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ItemStatistic, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ItemStatisticMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ItemStatisticCreateBulk) SaveX(ctx context.Context) []*ItemStatistic {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ItemStatisticCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ItemStatisticCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,718 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ItemStatisticUpdate is the builder for updating ItemStatistic entities.
+type ItemStatisticUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ItemStatisticMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ItemStatisticUpdate builder.
+func (_u *ItemStatisticUpdate) Where(ps ...predicate.ItemStatistic) *ItemStatisticUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_u *ItemStatisticUpdate) SetAttemptCount(v int) *ItemStatisticUpdate {
+	_u.mutation.ResetAttemptCount()
+	_u.mutation.SetAttemptCount(v)
+	return _u
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableAttemptCount(v *int) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetAttemptCount(*v)
+	}
+	return _u
+}
+
+// AddAttemptCount adds value to the "attempt_count" field.
+func (_u *ItemStatisticUpdate) AddAttemptCount(v int) *ItemStatisticUpdate {
+	_u.mutation.AddAttemptCount(v)
+	return _u
+}
+
+// SetAnsweredCount sets the "answered_count" field.
+func (_u *ItemStatisticUpdate) SetAnsweredCount(v int) *ItemStatisticUpdate {
+	_u.mutation.ResetAnsweredCount()
+	_u.mutation.SetAnsweredCount(v)
+	return _u
+}
+
+// SetNillableAnsweredCount sets the "answered_count" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableAnsweredCount(v *int) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetAnsweredCount(*v)
+	}
+	return _u
+}
+
+// AddAnsweredCount adds value to the "answered_count" field.
+func (_u *ItemStatisticUpdate) AddAnsweredCount(v int) *ItemStatisticUpdate {
+	_u.mutation.AddAnsweredCount(v)
+	return _u
+}
+
+// SetScoreSum sets the "score_sum" field.
+func (_u *ItemStatisticUpdate) SetScoreSum(v float64) *ItemStatisticUpdate {
+	_u.mutation.ResetScoreSum()
+	_u.mutation.SetScoreSum(v)
+	return _u
+}
+
+// SetNillableScoreSum sets the "score_sum" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableScoreSum(v *float64) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetScoreSum(*v)
+	}
+	return _u
+}
+
+// AddScoreSum adds value to the "score_sum" field.
+func (_u *ItemStatisticUpdate) AddScoreSum(v float64) *ItemStatisticUpdate {
+	_u.mutation.AddScoreSum(v)
+	return _u
+}
+
+// SetScoreSquares sets the "score_squares" field.
+func (_u *ItemStatisticUpdate) SetScoreSquares(v float64) *ItemStatisticUpdate {
+	_u.mutation.ResetScoreSquares()
+	_u.mutation.SetScoreSquares(v)
+	return _u
+}
+
+// SetNillableScoreSquares sets the "score_squares" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableScoreSquares(v *float64) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetScoreSquares(*v)
+	}
+	return _u
+}
+
+// AddScoreSquares adds value to the "score_squares" field.
+func (_u *ItemStatisticUpdate) AddScoreSquares(v float64) *ItemStatisticUpdate {
+	_u.mutation.AddScoreSquares(v)
+	return _u
+}
+
+// SetRestSum sets the "rest_sum" field.
+func (_u *ItemStatisticUpdate) SetRestSum(v float64) *ItemStatisticUpdate {
+	_u.mutation.ResetRestSum()
+	_u.mutation.SetRestSum(v)
+	return _u
+}
+
+// SetNillableRestSum sets the "rest_sum" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableRestSum(v *float64) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetRestSum(*v)
+	}
+	return _u
+}
+
+// AddRestSum adds value to the "rest_sum" field.
+func (_u *ItemStatisticUpdate) AddRestSum(v float64) *ItemStatisticUpdate {
+	_u.mutation.AddRestSum(v)
+	return _u
+}
+
+// SetRestSquares sets the "rest_squares" field.
+func (_u *ItemStatisticUpdate) SetRestSquares(v float64) *ItemStatisticUpdate {
+	_u.mutation.ResetRestSquares()
+	_u.mutation.SetRestSquares(v)
+	return _u
+}
+
+// SetNillableRestSquares sets the "rest_squares" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableRestSquares(v *float64) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetRestSquares(*v)
+	}
+	return _u
+}
+
+// AddRestSquares adds value to the "rest_squares" field.
+func (_u *ItemStatisticUpdate) AddRestSquares(v float64) *ItemStatisticUpdate {
+	_u.mutation.AddRestSquares(v)
+	return _u
+}
+
+// SetScoreRestProducts sets the "score_rest_products" field.
+func (_u *ItemStatisticUpdate) SetScoreRestProducts(v float64) *ItemStatisticUpdate {
+	_u.mutation.ResetScoreRestProducts()
+	_u.mutation.SetScoreRestProducts(v)
+	return _u
+}
+
+// SetNillableScoreRestProducts sets the "score_rest_products" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableScoreRestProducts(v *float64) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetScoreRestProducts(*v)
+	}
+	return _u
+}
+
+// AddScoreRestProducts adds value to the "score_rest_products" field.
+func (_u *ItemStatisticUpdate) AddScoreRestProducts(v float64) *ItemStatisticUpdate {
+	_u.mutation.AddScoreRestProducts(v)
+	return _u
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (_u *ItemStatisticUpdate) SetTimeSpentMs(v int64) *ItemStatisticUpdate {
+	_u.mutation.ResetTimeSpentMs()
+	_u.mutation.SetTimeSpentMs(v)
+	return _u
+}
+
+// SetNillableTimeSpentMs sets the "time_spent_ms" field if the given value is not nil.
+func (_u *ItemStatisticUpdate) SetNillableTimeSpentMs(v *int64) *ItemStatisticUpdate {
+	if v != nil {
+		_u.SetTimeSpentMs(*v)
+	}
+	return _u
+}
+
+// AddTimeSpentMs adds value to the "time_spent_ms" field.
+func (_u *ItemStatisticUpdate) AddTimeSpentMs(v int64) *ItemStatisticUpdate {
+	_u.mutation.AddTimeSpentMs(v)
+	return _u
+}
+
+// SetOptionCounts sets the "option_counts" field.
+func (_u *ItemStatisticUpdate) SetOptionCounts(v map[string]int) *ItemStatisticUpdate {
+	_u.mutation.SetOptionCounts(v)
+	return _u
+}
+
+// ClearOptionCounts clears the value of the "option_counts" field.
+func (_u *ItemStatisticUpdate) ClearOptionCounts() *ItemStatisticUpdate {
+	_u.mutation.ClearOptionCounts()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemStatisticUpdate) SetUpdatedAt(v time.Time) *ItemStatisticUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ItemStatisticMutation object of the builder.
+func (_u *ItemStatisticUpdate) Mutation() *ItemStatisticMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ItemStatisticUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemStatisticUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ItemStatisticUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemStatisticUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ItemStatisticUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := itemstatistic.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemStatisticUpdate) check() error {
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemStatistic.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ItemStatisticUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemStatisticUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ItemStatisticUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemstatistic.Table, itemstatistic.Columns, sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AttemptCount(); ok {
+		_spec.SetField(itemstatistic.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(itemstatistic.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AnsweredCount(); ok {
+		_spec.SetField(itemstatistic.FieldAnsweredCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnsweredCount(); ok {
+		_spec.AddField(itemstatistic.FieldAnsweredCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScoreSum(); ok {
+		_spec.SetField(itemstatistic.FieldScoreSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScoreSum(); ok {
+		_spec.AddField(itemstatistic.FieldScoreSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ScoreSquares(); ok {
+		_spec.SetField(itemstatistic.FieldScoreSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScoreSquares(); ok {
+		_spec.AddField(itemstatistic.FieldScoreSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RestSum(); ok {
+		_spec.SetField(itemstatistic.FieldRestSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRestSum(); ok {
+		_spec.AddField(itemstatistic.FieldRestSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RestSquares(); ok {
+		_spec.SetField(itemstatistic.FieldRestSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRestSquares(); ok {
+		_spec.AddField(itemstatistic.FieldRestSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ScoreRestProducts(); ok {
+		_spec.SetField(itemstatistic.FieldScoreRestProducts, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScoreRestProducts(); ok {
+		_spec.AddField(itemstatistic.FieldScoreRestProducts, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TimeSpentMs(); ok {
+		_spec.SetField(itemstatistic.FieldTimeSpentMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimeSpentMs(); ok {
+		_spec.AddField(itemstatistic.FieldTimeSpentMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OptionCounts(); ok {
+		_spec.SetField(itemstatistic.FieldOptionCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.OptionCountsCleared() {
+		_spec.ClearField(itemstatistic.FieldOptionCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemstatistic.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemstatistic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ItemStatisticUpdateOne is the builder for updating a single ItemStatistic entity.
+type ItemStatisticUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ItemStatisticMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (_u *ItemStatisticUpdateOne) SetAttemptCount(v int) *ItemStatisticUpdateOne {
+	_u.mutation.ResetAttemptCount()
+	_u.mutation.SetAttemptCount(v)
+	return _u
+}
+
+// SetNillableAttemptCount sets the "attempt_count" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableAttemptCount(v *int) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetAttemptCount(*v)
+	}
+	return _u
+}
+
+// AddAttemptCount adds value to the "attempt_count" field.
+func (_u *ItemStatisticUpdateOne) AddAttemptCount(v int) *ItemStatisticUpdateOne {
+	_u.mutation.AddAttemptCount(v)
+	return _u
+}
+
+// SetAnsweredCount sets the "answered_count" field.
+func (_u *ItemStatisticUpdateOne) SetAnsweredCount(v int) *ItemStatisticUpdateOne {
+	_u.mutation.ResetAnsweredCount()
+	_u.mutation.SetAnsweredCount(v)
+	return _u
+}
+
+// SetNillableAnsweredCount sets the "answered_count" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableAnsweredCount(v *int) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetAnsweredCount(*v)
+	}
+	return _u
+}
+
+// AddAnsweredCount adds value to the "answered_count" field.
+func (_u *ItemStatisticUpdateOne) AddAnsweredCount(v int) *ItemStatisticUpdateOne {
+	_u.mutation.AddAnsweredCount(v)
+	return _u
+}
+
+// SetScoreSum sets the "score_sum" field.
+func (_u *ItemStatisticUpdateOne) SetScoreSum(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.ResetScoreSum()
+	_u.mutation.SetScoreSum(v)
+	return _u
+}
+
+// SetNillableScoreSum sets the "score_sum" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableScoreSum(v *float64) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetScoreSum(*v)
+	}
+	return _u
+}
+
+// AddScoreSum adds value to the "score_sum" field.
+func (_u *ItemStatisticUpdateOne) AddScoreSum(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.AddScoreSum(v)
+	return _u
+}
+
+// SetScoreSquares sets the "score_squares" field.
+func (_u *ItemStatisticUpdateOne) SetScoreSquares(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.ResetScoreSquares()
+	_u.mutation.SetScoreSquares(v)
+	return _u
+}
+
+// SetNillableScoreSquares sets the "score_squares" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableScoreSquares(v *float64) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetScoreSquares(*v)
+	}
+	return _u
+}
+
+// AddScoreSquares adds value to the "score_squares" field.
+func (_u *ItemStatisticUpdateOne) AddScoreSquares(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.AddScoreSquares(v)
+	return _u
+}
+
+// SetRestSum sets the "rest_sum" field.
+func (_u *ItemStatisticUpdateOne) SetRestSum(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.ResetRestSum()
+	_u.mutation.SetRestSum(v)
+	return _u
+}
+
+// SetNillableRestSum sets the "rest_sum" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableRestSum(v *float64) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetRestSum(*v)
+	}
+	return _u
+}
+
+// AddRestSum adds value to the "rest_sum" field.
+func (_u *ItemStatisticUpdateOne) AddRestSum(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.AddRestSum(v)
+	return _u
+}
+
+// SetRestSquares sets the "rest_squares" field.
+func (_u *ItemStatisticUpdateOne) SetRestSquares(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.ResetRestSquares()
+	_u.mutation.SetRestSquares(v)
+	return _u
+}
+
+// SetNillableRestSquares sets the "rest_squares" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableRestSquares(v *float64) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetRestSquares(*v)
+	}
+	return _u
+}
+
+// AddRestSquares adds value to the "rest_squares" field.
+func (_u *ItemStatisticUpdateOne) AddRestSquares(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.AddRestSquares(v)
+	return _u
+}
+
+// SetScoreRestProducts sets the "score_rest_products" field.
+func (_u *ItemStatisticUpdateOne) SetScoreRestProducts(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.ResetScoreRestProducts()
+	_u.mutation.SetScoreRestProducts(v)
+	return _u
+}
+
+// SetNillableScoreRestProducts sets the "score_rest_products" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableScoreRestProducts(v *float64) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetScoreRestProducts(*v)
+	}
+	return _u
+}
+
+// AddScoreRestProducts adds value to the "score_rest_products" field.
+func (_u *ItemStatisticUpdateOne) AddScoreRestProducts(v float64) *ItemStatisticUpdateOne {
+	_u.mutation.AddScoreRestProducts(v)
+	return _u
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (_u *ItemStatisticUpdateOne) SetTimeSpentMs(v int64) *ItemStatisticUpdateOne {
+	_u.mutation.ResetTimeSpentMs()
+	_u.mutation.SetTimeSpentMs(v)
+	return _u
+}
+
+// SetNillableTimeSpentMs sets the "time_spent_ms" field if the given value is not nil.
+func (_u *ItemStatisticUpdateOne) SetNillableTimeSpentMs(v *int64) *ItemStatisticUpdateOne {
+	if v != nil {
+		_u.SetTimeSpentMs(*v)
+	}
+	return _u
+}
+
+// AddTimeSpentMs adds value to the "time_spent_ms" field.
+func (_u *ItemStatisticUpdateOne) AddTimeSpentMs(v int64) *ItemStatisticUpdateOne {
+	_u.mutation.AddTimeSpentMs(v)
+	return _u
+}
+
+// SetOptionCounts sets the "option_counts" field.
+func (_u *ItemStatisticUpdateOne) SetOptionCounts(v map[string]int) *ItemStatisticUpdateOne {
+	_u.mutation.SetOptionCounts(v)
+	return _u
+}
+
+// ClearOptionCounts clears the value of the "option_counts" field.
+func (_u *ItemStatisticUpdateOne) ClearOptionCounts() *ItemStatisticUpdateOne {
+	_u.mutation.ClearOptionCounts()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ItemStatisticUpdateOne) SetUpdatedAt(v time.Time) *ItemStatisticUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ItemStatisticMutation object of the builder.
+func (_u *ItemStatisticUpdateOne) Mutation() *ItemStatisticMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ItemStatisticUpdate builder.
+func (_u *ItemStatisticUpdateOne) Where(ps ...predicate.ItemStatistic) *ItemStatisticUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ItemStatisticUpdateOne) Select(field string, fields ...string) *ItemStatisticUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ItemStatistic entity.
+func (_u *ItemStatisticUpdateOne) Save(ctx context.Context) (*ItemStatistic, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ItemStatisticUpdateOne) SaveX(ctx context.Context) *ItemStatistic {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ItemStatisticUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ItemStatisticUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ItemStatisticUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := itemstatistic.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ItemStatisticUpdateOne) check() error {
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ItemStatistic.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ItemStatisticUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ItemStatisticUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ItemStatisticUpdateOne) sqlSave(ctx context.Context) (_node *ItemStatistic, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(itemstatistic.Table, itemstatistic.Columns, sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ItemStatistic.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, itemstatistic.FieldID)
+		for _, f := range fields {
+			if !itemstatistic.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != itemstatistic.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.AttemptCount(); ok {
+		_spec.SetField(itemstatistic.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttemptCount(); ok {
+		_spec.AddField(itemstatistic.FieldAttemptCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AnsweredCount(); ok {
+		_spec.SetField(itemstatistic.FieldAnsweredCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnsweredCount(); ok {
+		_spec.AddField(itemstatistic.FieldAnsweredCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ScoreSum(); ok {
+		_spec.SetField(itemstatistic.FieldScoreSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScoreSum(); ok {
+		_spec.AddField(itemstatistic.FieldScoreSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ScoreSquares(); ok {
+		_spec.SetField(itemstatistic.FieldScoreSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScoreSquares(); ok {
+		_spec.AddField(itemstatistic.FieldScoreSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RestSum(); ok {
+		_spec.SetField(itemstatistic.FieldRestSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRestSum(); ok {
+		_spec.AddField(itemstatistic.FieldRestSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.RestSquares(); ok {
+		_spec.SetField(itemstatistic.FieldRestSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedRestSquares(); ok {
+		_spec.AddField(itemstatistic.FieldRestSquares, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ScoreRestProducts(); ok {
+		_spec.SetField(itemstatistic.FieldScoreRestProducts, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScoreRestProducts(); ok {
+		_spec.AddField(itemstatistic.FieldScoreRestProducts, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TimeSpentMs(); ok {
+		_spec.SetField(itemstatistic.FieldTimeSpentMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTimeSpentMs(); ok {
+		_spec.AddField(itemstatistic.FieldTimeSpentMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.OptionCounts(); ok {
+		_spec.SetField(itemstatistic.FieldOptionCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.OptionCountsCleared() {
+		_spec.ClearField(itemstatistic.FieldOptionCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(itemstatistic.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ItemStatistic{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{itemstatistic.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
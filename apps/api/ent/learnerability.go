@@ -0,0 +1,184 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+)
+
+// LearnerAbility is the model entity for the LearnerAbility schema.
+type LearnerAbility struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Clerk user ID
+	UserID string `json:"user_id,omitempty"`
+	// CollectionID holds the value of the "collection_id" field.
+	CollectionID uuid.UUID `json:"collection_id,omitempty"`
+	// Ability holds the value of the "ability" field.
+	Ability float64 `json:"ability,omitempty"`
+	// Answers the estimate is based on; later answers move it less
+	AnswerCount int `json:"answer_count,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LearnerAbilityQuery when eager-loading is set.
+	Edges        LearnerAbilityEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LearnerAbilityEdges holds the relations/edges for other nodes in the graph.
+type LearnerAbilityEdges struct {
+	// Collection holds the value of the collection edge.
+	Collection *Collection `json:"collection,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CollectionOrErr returns the Collection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LearnerAbilityEdges) CollectionOrErr() (*Collection, error) {
+	if e.Collection != nil {
+		return e.Collection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: collection.Label}
+	}
+	return nil, &NotLoadedError{edge: "collection"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LearnerAbility) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case learnerability.FieldAbility:
+			values[i] = new(sql.NullFloat64)
+		case learnerability.FieldAnswerCount:
+			values[i] = new(sql.NullInt64)
+		case learnerability.FieldUserID:
+			values[i] = new(sql.NullString)
+		case learnerability.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case learnerability.FieldID, learnerability.FieldCollectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LearnerAbility fields.
+func (_m *LearnerAbility) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case learnerability.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case learnerability.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case learnerability.FieldCollectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
+			} else if value != nil {
+				_m.CollectionID = *value
+			}
+		case learnerability.FieldAbility:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ability", values[i])
+			} else if value.Valid {
+				_m.Ability = value.Float64
+			}
+		case learnerability.FieldAnswerCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_count", values[i])
+			} else if value.Valid {
+				_m.AnswerCount = int(value.Int64)
+			}
+		case learnerability.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LearnerAbility.
+// This includes values selected through modifiers, order, etc.
+func (_m *LearnerAbility) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCollection queries the "collection" edge of the LearnerAbility entity.
+func (_m *LearnerAbility) QueryCollection() *CollectionQuery {
+	return NewLearnerAbilityClient(_m.config).QueryCollection(_m)
+}
+
+// Update returns a builder for updating this LearnerAbility.
+// Note that you need to call LearnerAbility.Unwrap() before calling this method if this LearnerAbility
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LearnerAbility) Update() *LearnerAbilityUpdateOne {
+	return NewLearnerAbilityClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LearnerAbility entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LearnerAbility) Unwrap() *LearnerAbility {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LearnerAbility is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LearnerAbility) String() string {
+	var builder strings.Builder
+	builder.WriteString("LearnerAbility(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("collection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
+	builder.WriteString(", ")
+	builder.WriteString("ability=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ability))
+	builder.WriteString(", ")
+	builder.WriteString("answer_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AnswerCount))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LearnerAbilities is a parsable slice of LearnerAbility.
+type LearnerAbilities []*LearnerAbility
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package learnerability
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldUserID, v))
+}
+
+// CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
+func CollectionID(v uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// Ability applies equality check predicate on the "ability" field. It's identical to AbilityEQ.
+func Ability(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldAbility, v))
+}
+
+// AnswerCount applies equality check predicate on the "answer_count" field. It's identical to AnswerCountEQ.
+func AnswerCount(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldAnswerCount, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...uuid.UUID) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// AbilityEQ applies the EQ predicate on the "ability" field.
+func AbilityEQ(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldAbility, v))
+}
+
+// AbilityNEQ applies the NEQ predicate on the "ability" field.
+func AbilityNEQ(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNEQ(FieldAbility, v))
+}
+
+// AbilityIn applies the In predicate on the "ability" field.
+func AbilityIn(vs ...float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldIn(FieldAbility, vs...))
+}
+
+// AbilityNotIn applies the NotIn predicate on the "ability" field.
+func AbilityNotIn(vs ...float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNotIn(FieldAbility, vs...))
+}
+
+// AbilityGT applies the GT predicate on the "ability" field.
+func AbilityGT(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGT(FieldAbility, v))
+}
+
+// AbilityGTE applies the GTE predicate on the "ability" field.
+func AbilityGTE(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGTE(FieldAbility, v))
+}
+
+// AbilityLT applies the LT predicate on the "ability" field.
+func AbilityLT(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLT(FieldAbility, v))
+}
+
+// AbilityLTE applies the LTE predicate on the "ability" field.
+func AbilityLTE(v float64) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLTE(FieldAbility, v))
+}
+
+// AnswerCountEQ applies the EQ predicate on the "answer_count" field.
+func AnswerCountEQ(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldAnswerCount, v))
+}
+
+// AnswerCountNEQ applies the NEQ predicate on the "answer_count" field.
+func AnswerCountNEQ(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNEQ(FieldAnswerCount, v))
+}
+
+// AnswerCountIn applies the In predicate on the "answer_count" field.
+func AnswerCountIn(vs ...int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldIn(FieldAnswerCount, vs...))
+}
+
+// AnswerCountNotIn applies the NotIn predicate on the "answer_count" field.
+func AnswerCountNotIn(vs ...int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNotIn(FieldAnswerCount, vs...))
+}
+
+// AnswerCountGT applies the GT predicate on the "answer_count" field.
+func AnswerCountGT(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGT(FieldAnswerCount, v))
+}
+
+// AnswerCountGTE applies the GTE predicate on the "answer_count" field.
+func AnswerCountGTE(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGTE(FieldAnswerCount, v))
+}
+
+// AnswerCountLT applies the LT predicate on the "answer_count" field.
+func AnswerCountLT(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLT(FieldAnswerCount, v))
+}
+
+// AnswerCountLTE applies the LTE predicate on the "answer_count" field.
+func AnswerCountLTE(v int) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLTE(FieldAnswerCount, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCollection applies the HasEdge predicate on the "collection" edge.
+func HasCollection() predicate.LearnerAbility {
+	return predicate.LearnerAbility(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CollectionTable, CollectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionWith applies the HasEdge predicate on the "collection" edge with a given conditions (other predicates).
+func HasCollectionWith(preds ...predicate.Collection) predicate.LearnerAbility {
+	return predicate.LearnerAbility(func(s *sql.Selector) {
+		step := newCollectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LearnerAbility) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LearnerAbility) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LearnerAbility) predicate.LearnerAbility {
+	return predicate.LearnerAbility(sql.NotPredicates(p))
+}
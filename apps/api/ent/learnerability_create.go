@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+)
+
+// LearnerAbilityCreate is the builder for creating a LearnerAbility entity.
+type LearnerAbilityCreate struct {
+	config
+	mutation *LearnerAbilityMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *LearnerAbilityCreate) SetUserID(v string) *LearnerAbilityCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_c *LearnerAbilityCreate) SetCollectionID(v uuid.UUID) *LearnerAbilityCreate {
+	_c.mutation.SetCollectionID(v)
+	return _c
+}
+
+// SetAbility sets the "ability" field.
+func (_c *LearnerAbilityCreate) SetAbility(v float64) *LearnerAbilityCreate {
+	_c.mutation.SetAbility(v)
+	return _c
+}
+
+// SetNillableAbility sets the "ability" field if the given value is not nil.
+func (_c *LearnerAbilityCreate) SetNillableAbility(v *float64) *LearnerAbilityCreate {
+	if v != nil {
+		_c.SetAbility(*v)
+	}
+	return _c
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (_c *LearnerAbilityCreate) SetAnswerCount(v int) *LearnerAbilityCreate {
+	_c.mutation.SetAnswerCount(v)
+	return _c
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (_c *LearnerAbilityCreate) SetNillableAnswerCount(v *int) *LearnerAbilityCreate {
+	if v != nil {
+		_c.SetAnswerCount(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LearnerAbilityCreate) SetUpdatedAt(v time.Time) *LearnerAbilityCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LearnerAbilityCreate) SetNillableUpdatedAt(v *time.Time) *LearnerAbilityCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LearnerAbilityCreate) SetID(v uuid.UUID) *LearnerAbilityCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LearnerAbilityCreate) SetNillableID(v *uuid.UUID) *LearnerAbilityCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_c *LearnerAbilityCreate) SetCollection(v *Collection) *LearnerAbilityCreate {
+	return _c.SetCollectionID(v.ID)
+}
+
+// Mutation returns the LearnerAbilityMutation object of the builder.
+func (_c *LearnerAbilityCreate) Mutation() *LearnerAbilityMutation {
+	return _c.mutation
+}
+
+// Save creates the LearnerAbility in the database.
+func (_c *LearnerAbilityCreate) Save(ctx context.Context) (*LearnerAbility, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LearnerAbilityCreate) SaveX(ctx context.Context) *LearnerAbility {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LearnerAbilityCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LearnerAbilityCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LearnerAbilityCreate) defaults() {
+	if _, ok := _c.mutation.Ability(); !ok {
+		v := learnerability.DefaultAbility
+		_c.mutation.SetAbility(v)
+	}
+	if _, ok := _c.mutation.AnswerCount(); !ok {
+		v := learnerability.DefaultAnswerCount
+		_c.mutation.SetAnswerCount(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := learnerability.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := learnerability.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LearnerAbilityCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "LearnerAbility.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := learnerability.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "LearnerAbility.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CollectionID(); !ok {
+		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "LearnerAbility.collection_id"`)}
+	}
+	if _, ok := _c.mutation.Ability(); !ok {
+		return &ValidationError{Name: "ability", err: errors.New(`ent: missing required field "LearnerAbility.ability"`)}
+	}
+	if _, ok := _c.mutation.AnswerCount(); !ok {
+		return &ValidationError{Name: "answer_count", err: errors.New(`ent: missing required field "LearnerAbility.answer_count"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LearnerAbility.updated_at"`)}
+	}
+	if len(_c.mutation.CollectionIDs()) == 0 {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required edge "LearnerAbility.collection"`)}
+	}
+	return nil
+}
+
+func (_c *LearnerAbilityCreate) sqlSave(ctx context.Context) (*LearnerAbility, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LearnerAbilityCreate) createSpec() (*LearnerAbility, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LearnerAbility{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(learnerability.Table, sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(learnerability.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Ability(); ok {
+		_spec.SetField(learnerability.FieldAbility, field.TypeFloat64, value)
+		_node.Ability = value
+	}
+	if value, ok := _c.mutation.AnswerCount(); ok {
+		_spec.SetField(learnerability.FieldAnswerCount, field.TypeInt, value)
+		_node.AnswerCount = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(learnerability.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   learnerability.CollectionTable,
+			Columns: []string{learnerability.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CollectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LearnerAbilityCreateBulk is the builder for creating many LearnerAbility entities in bulk.
+type LearnerAbilityCreateBulk struct {
+	config
+	err      error
+	builders []*LearnerAbilityCreate
+}
+
+// Save creates the LearnerAbility entities in the database.
+func (_c *LearnerAbilityCreateBulk) Save(ctx context.Context) ([]*LearnerAbility, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LearnerAbility, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LearnerAbilityMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LearnerAbilityCreateBulk) SaveX(ctx context.Context) []*LearnerAbility {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LearnerAbilityCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LearnerAbilityCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// LearnerAbilityDelete is the builder for deleting a LearnerAbility entity.
+type LearnerAbilityDelete struct {
+	config
+	hooks    []Hook
+	mutation *LearnerAbilityMutation
+}
+
+// Where appends a list predicates to the LearnerAbilityDelete builder.
+func (_d *LearnerAbilityDelete) Where(ps ...predicate.LearnerAbility) *LearnerAbilityDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *LearnerAbilityDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LearnerAbilityDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *LearnerAbilityDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(learnerability.Table, sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// LearnerAbilityDeleteOne is the builder for deleting a single LearnerAbility entity.
+type LearnerAbilityDeleteOne struct {
+	_d *LearnerAbilityDelete
+}
+
+// Where appends a list predicates to the LearnerAbilityDelete builder.
+func (_d *LearnerAbilityDeleteOne) Where(ps ...predicate.LearnerAbility) *LearnerAbilityDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *LearnerAbilityDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{learnerability.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *LearnerAbilityDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// LearnerAbilityUpdate is the builder for updating LearnerAbility entities.
+type LearnerAbilityUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *LearnerAbilityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the LearnerAbilityUpdate builder.
+func (_u *LearnerAbilityUpdate) Where(ps ...predicate.LearnerAbility) *LearnerAbilityUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAbility sets the "ability" field.
+func (_u *LearnerAbilityUpdate) SetAbility(v float64) *LearnerAbilityUpdate {
+	_u.mutation.ResetAbility()
+	_u.mutation.SetAbility(v)
+	return _u
+}
+
+// SetNillableAbility sets the "ability" field if the given value is not nil.
+func (_u *LearnerAbilityUpdate) SetNillableAbility(v *float64) *LearnerAbilityUpdate {
+	if v != nil {
+		_u.SetAbility(*v)
+	}
+	return _u
+}
+
+// AddAbility adds value to the "ability" field.
+func (_u *LearnerAbilityUpdate) AddAbility(v float64) *LearnerAbilityUpdate {
+	_u.mutation.AddAbility(v)
+	return _u
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (_u *LearnerAbilityUpdate) SetAnswerCount(v int) *LearnerAbilityUpdate {
+	_u.mutation.ResetAnswerCount()
+	_u.mutation.SetAnswerCount(v)
+	return _u
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (_u *LearnerAbilityUpdate) SetNillableAnswerCount(v *int) *LearnerAbilityUpdate {
+	if v != nil {
+		_u.SetAnswerCount(*v)
+	}
+	return _u
+}
+
+// AddAnswerCount adds value to the "answer_count" field.
+func (_u *LearnerAbilityUpdate) AddAnswerCount(v int) *LearnerAbilityUpdate {
+	_u.mutation.AddAnswerCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LearnerAbilityUpdate) SetUpdatedAt(v time.Time) *LearnerAbilityUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LearnerAbilityMutation object of the builder.
+func (_u *LearnerAbilityUpdate) Mutation() *LearnerAbilityMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LearnerAbilityUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LearnerAbilityUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LearnerAbilityUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LearnerAbilityUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LearnerAbilityUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := learnerability.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LearnerAbilityUpdate) check() error {
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LearnerAbility.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LearnerAbilityUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LearnerAbilityUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LearnerAbilityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(learnerability.Table, learnerability.Columns, sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Ability(); ok {
+		_spec.SetField(learnerability.FieldAbility, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAbility(); ok {
+		_spec.AddField(learnerability.FieldAbility, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AnswerCount(); ok {
+		_spec.SetField(learnerability.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnswerCount(); ok {
+		_spec.AddField(learnerability.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(learnerability.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{learnerability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LearnerAbilityUpdateOne is the builder for updating a single LearnerAbility entity.
+type LearnerAbilityUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *LearnerAbilityMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAbility sets the "ability" field.
+func (_u *LearnerAbilityUpdateOne) SetAbility(v float64) *LearnerAbilityUpdateOne {
+	_u.mutation.ResetAbility()
+	_u.mutation.SetAbility(v)
+	return _u
+}
+
+// SetNillableAbility sets the "ability" field if the given value is not nil.
+func (_u *LearnerAbilityUpdateOne) SetNillableAbility(v *float64) *LearnerAbilityUpdateOne {
+	if v != nil {
+		_u.SetAbility(*v)
+	}
+	return _u
+}
+
+// AddAbility adds value to the "ability" field.
+func (_u *LearnerAbilityUpdateOne) AddAbility(v float64) *LearnerAbilityUpdateOne {
+	_u.mutation.AddAbility(v)
+	return _u
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (_u *LearnerAbilityUpdateOne) SetAnswerCount(v int) *LearnerAbilityUpdateOne {
+	_u.mutation.ResetAnswerCount()
+	_u.mutation.SetAnswerCount(v)
+	return _u
+}
+
+// SetNillableAnswerCount sets the "answer_count" field if the given value is not nil.
+func (_u *LearnerAbilityUpdateOne) SetNillableAnswerCount(v *int) *LearnerAbilityUpdateOne {
+	if v != nil {
+		_u.SetAnswerCount(*v)
+	}
+	return _u
+}
+
+// AddAnswerCount adds value to the "answer_count" field.
+func (_u *LearnerAbilityUpdateOne) AddAnswerCount(v int) *LearnerAbilityUpdateOne {
+	_u.mutation.AddAnswerCount(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LearnerAbilityUpdateOne) SetUpdatedAt(v time.Time) *LearnerAbilityUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the LearnerAbilityMutation object of the builder.
+func (_u *LearnerAbilityUpdateOne) Mutation() *LearnerAbilityMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LearnerAbilityUpdate builder.
+func (_u *LearnerAbilityUpdateOne) Where(ps ...predicate.LearnerAbility) *LearnerAbilityUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LearnerAbilityUpdateOne) Select(field string, fields ...string) *LearnerAbilityUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LearnerAbility entity.
+func (_u *LearnerAbilityUpdateOne) Save(ctx context.Context) (*LearnerAbility, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LearnerAbilityUpdateOne) SaveX(ctx context.Context) *LearnerAbility {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LearnerAbilityUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LearnerAbilityUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LearnerAbilityUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := learnerability.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LearnerAbilityUpdateOne) check() error {
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LearnerAbility.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *LearnerAbilityUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *LearnerAbilityUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *LearnerAbilityUpdateOne) sqlSave(ctx context.Context) (_node *LearnerAbility, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(learnerability.Table, learnerability.Columns, sqlgraph.NewFieldSpec(learnerability.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LearnerAbility.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, learnerability.FieldID)
+		for _, f := range fields {
+			if !learnerability.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != learnerability.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Ability(); ok {
+		_spec.SetField(learnerability.FieldAbility, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAbility(); ok {
+		_spec.AddField(learnerability.FieldAbility, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AnswerCount(); ok {
+		_spec.SetField(learnerability.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAnswerCount(); ok {
+		_spec.AddField(learnerability.FieldAnswerCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(learnerability.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &LearnerAbility{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{learnerability.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+)
+
+// Media is the model entity for the Media schema.
+type Media struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Hex-encoded SHA-256 of the content
+	Hash string `json:"hash,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Key of the blob in the blob store
+	StorageKey string `json:"storage_key,omitempty"`
+	// First uploader; later uploaders of the same content get a MediaUpload
+	UploadedBy string `json:"uploaded_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MediaQuery when eager-loading is set.
+	Edges        MediaEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MediaEdges holds the relations/edges for other nodes in the graph.
+type MediaEdges struct {
+	// Flashcards holds the value of the flashcards edge.
+	Flashcards []*Flashcard `json:"flashcards,omitempty"`
+	// Uploads holds the value of the uploads edge.
+	Uploads []*MediaUpload `json:"uploads,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FlashcardsOrErr returns the Flashcards value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) FlashcardsOrErr() ([]*Flashcard, error) {
+	if e.loadedTypes[0] {
+		return e.Flashcards, nil
+	}
+	return nil, &NotLoadedError{edge: "flashcards"}
+}
+
+// UploadsOrErr returns the Uploads value or an error if the edge
+// was not loaded in eager-loading.
+func (e MediaEdges) UploadsOrErr() ([]*MediaUpload, error) {
+	if e.loadedTypes[1] {
+		return e.Uploads, nil
+	}
+	return nil, &NotLoadedError{edge: "uploads"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Media) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case media.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case media.FieldHash, media.FieldContentType, media.FieldStorageKey, media.FieldUploadedBy:
+			values[i] = new(sql.NullString)
+		case media.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case media.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Media fields.
+func (_m *Media) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case media.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case media.FieldHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[i])
+			} else if value.Valid {
+				_m.Hash = value.String
+			}
+		case media.FieldContentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[i])
+			} else if value.Valid {
+				_m.ContentType = value.String
+			}
+		case media.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case media.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				_m.StorageKey = value.String
+			}
+		case media.FieldUploadedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field uploaded_by", values[i])
+			} else if value.Valid {
+				_m.UploadedBy = value.String
+			}
+		case media.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Media.
+// This includes values selected through modifiers, order, etc.
+func (_m *Media) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlashcards queries the "flashcards" edge of the Media entity.
+func (_m *Media) QueryFlashcards() *FlashcardQuery {
+	return NewMediaClient(_m.config).QueryFlashcards(_m)
+}
+
+// QueryUploads queries the "uploads" edge of the Media entity.
+func (_m *Media) QueryUploads() *MediaUploadQuery {
+	return NewMediaClient(_m.config).QueryUploads(_m)
+}
+
+// Update returns a builder for updating this Media.
+// Note that you need to call Media.Unwrap() before calling this method if this Media
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Media) Update() *MediaUpdateOne {
+	return NewMediaClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Media entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Media) Unwrap() *Media {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Media is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Media) String() string {
+	var builder strings.Builder
+	builder.WriteString("Media(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("hash=")
+	builder.WriteString(_m.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(_m.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(_m.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("uploaded_by=")
+	builder.WriteString(_m.UploadedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MediaSlice is a parsable slice of Media.
+type MediaSlice []*Media
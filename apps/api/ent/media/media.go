@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package media
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the media type in the database.
+	Label = "media"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldHash holds the string denoting the hash field in the database.
+	FieldHash = "hash"
+	// FieldContentType holds the string denoting the content_type field in the database.
+	FieldContentType = "content_type"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldStorageKey holds the string denoting the storage_key field in the database.
+	FieldStorageKey = "storage_key"
+	// FieldUploadedBy holds the string denoting the uploaded_by field in the database.
+	FieldUploadedBy = "uploaded_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFlashcards holds the string denoting the flashcards edge name in mutations.
+	EdgeFlashcards = "flashcards"
+	// EdgeUploads holds the string denoting the uploads edge name in mutations.
+	EdgeUploads = "uploads"
+	// Table holds the table name of the media in the database.
+	Table = "media"
+	// FlashcardsTable is the table that holds the flashcards relation/edge. The primary key declared below.
+	FlashcardsTable = "flashcard_media"
+	// FlashcardsInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardsInverseTable = "flashcards"
+	// UploadsTable is the table that holds the uploads relation/edge.
+	UploadsTable = "media_uploads"
+	// UploadsInverseTable is the table name for the MediaUpload entity.
+	// It exists in this package in order to avoid circular dependency with the "mediaupload" package.
+	UploadsInverseTable = "media_uploads"
+	// UploadsColumn is the table column denoting the uploads relation/edge.
+	UploadsColumn = "media_id"
+)
+
+// Columns holds all SQL columns for media fields.
+var Columns = []string{
+	FieldID,
+	FieldHash,
+	FieldContentType,
+	FieldSize,
+	FieldStorageKey,
+	FieldUploadedBy,
+	FieldCreatedAt,
+}
+
+var (
+	// FlashcardsPrimaryKey and FlashcardsColumn2 are the table columns denoting the
+	// primary key for the flashcards relation (M2M).
+	FlashcardsPrimaryKey = []string{"flashcard_id", "media_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	HashValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(int64) error
+	// StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	StorageKeyValidator func(string) error
+	// UploadedByValidator is a validator for the "uploaded_by" field. It is called by the builders before save.
+	UploadedByValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Media queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByHash orders the results by the hash field.
+func ByHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHash, opts...).ToFunc()
+}
+
+// ByContentType orders the results by the content_type field.
+func ByContentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentType, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByStorageKey orders the results by the storage_key field.
+func ByStorageKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageKey, opts...).ToFunc()
+}
+
+// ByUploadedBy orders the results by the uploaded_by field.
+func ByUploadedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUploadedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFlashcardsCount orders the results by flashcards count.
+func ByFlashcardsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFlashcardsStep(), opts...)
+	}
+}
+
+// ByFlashcards orders the results by flashcards terms.
+func ByFlashcards(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByUploadsCount orders the results by uploads count.
+func ByUploadsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newUploadsStep(), opts...)
+	}
+}
+
+// ByUploads orders the results by uploads terms.
+func ByUploads(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUploadsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newFlashcardsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, FlashcardsTable, FlashcardsPrimaryKey...),
+	)
+}
+func newUploadsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UploadsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, UploadsTable, UploadsColumn),
+	)
+}
@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+)
+
+// MediaCreate is the builder for creating a Media entity.
+type MediaCreate struct {
+	config
+	mutation *MediaMutation
+	hooks    []Hook
+}
+
+// SetHash sets the "hash" field.
+func (_c *MediaCreate) SetHash(v string) *MediaCreate {
+	_c.mutation.SetHash(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *MediaCreate) SetContentType(v string) *MediaCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *MediaCreate) SetSize(v int64) *MediaCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (_c *MediaCreate) SetStorageKey(v string) *MediaCreate {
+	_c.mutation.SetStorageKey(v)
+	return _c
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (_c *MediaCreate) SetUploadedBy(v string) *MediaCreate {
+	_c.mutation.SetUploadedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MediaCreate) SetCreatedAt(v time.Time) *MediaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MediaCreate) SetNillableCreatedAt(v *time.Time) *MediaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MediaCreate) SetID(v uuid.UUID) *MediaCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MediaCreate) SetNillableID(v *uuid.UUID) *MediaCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddFlashcardIDs adds the "flashcards" edge to the Flashcard entity by IDs.
+func (_c *MediaCreate) AddFlashcardIDs(ids ...uuid.UUID) *MediaCreate {
+	_c.mutation.AddFlashcardIDs(ids...)
+	return _c
+}
+
+// AddFlashcards adds the "flashcards" edges to the Flashcard entity.
+func (_c *MediaCreate) AddFlashcards(v ...*Flashcard) *MediaCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFlashcardIDs(ids...)
+}
+
+// AddUploadIDs adds the "uploads" edge to the MediaUpload entity by IDs.
+func (_c *MediaCreate) AddUploadIDs(ids ...uuid.UUID) *MediaCreate {
+	_c.mutation.AddUploadIDs(ids...)
+	return _c
+}
+
+// AddUploads adds the "uploads" edges to the MediaUpload entity.
+func (_c *MediaCreate) AddUploads(v ...*MediaUpload) *MediaCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddUploadIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (_c *MediaCreate) Mutation() *MediaMutation {
+	return _c.mutation
+}
+
+// Save creates the Media in the database.
+func (_c *MediaCreate) Save(ctx context.Context) (*Media, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MediaCreate) SaveX(ctx context.Context) *Media {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MediaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MediaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MediaCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := media.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := media.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MediaCreate) check() error {
+	if _, ok := _c.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "Media.hash"`)}
+	}
+	if v, ok := _c.mutation.Hash(); ok {
+		if err := media.HashValidator(v); err != nil {
+			return &ValidationError{Name: "hash", err: fmt.Errorf(`ent: validator failed for field "Media.hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Media.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := media.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Media.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Media.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Media.storage_key"`)}
+	}
+	if v, ok := _c.mutation.StorageKey(); ok {
+		if err := media.StorageKeyValidator(v); err != nil {
+			return &ValidationError{Name: "storage_key", err: fmt.Errorf(`ent: validator failed for field "Media.storage_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UploadedBy(); !ok {
+		return &ValidationError{Name: "uploaded_by", err: errors.New(`ent: missing required field "Media.uploaded_by"`)}
+	}
+	if v, ok := _c.mutation.UploadedBy(); ok {
+		if err := media.UploadedByValidator(v); err != nil {
+			return &ValidationError{Name: "uploaded_by", err: fmt.Errorf(`ent: validator failed for field "Media.uploaded_by": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Media.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *MediaCreate) sqlSave(ctx context.Context) (*Media, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Media{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(media.Table, sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Hash(); ok {
+		_spec.SetField(media.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(media.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.StorageKey(); ok {
+		_spec.SetField(media.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := _c.mutation.UploadedBy(); ok {
+		_spec.SetField(media.FieldUploadedBy, field.TypeString, value)
+		_node.UploadedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(media.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FlashcardsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   media.FlashcardsTable,
+			Columns: media.FlashcardsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UploadsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.UploadsTable,
+			Columns: []string{media.UploadsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mediaupload.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaCreateBulk is the builder for creating many Media entities in bulk.
+type MediaCreateBulk struct {
+	config
+	err      error
+	builders []*MediaCreate
+}
+
+// Save creates the Media entities in the database.
+func (_c *MediaCreateBulk) Save(ctx context.Context) ([]*Media, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Media, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MediaCreateBulk) SaveX(ctx context.Context) []*Media {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MediaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+)
+
+// MediaUpload is the model entity for the MediaUpload schema.
+type MediaUpload struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// MediaID holds the value of the "media_id" field.
+	MediaID uuid.UUID `json:"media_id,omitempty"`
+	// Clerk user ID
+	UserID string `json:"user_id,omitempty"`
+	// Last upload of the content by the user; recent uploads are kept by the garbage collector
+	UploadedAt time.Time `json:"uploaded_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MediaUploadQuery when eager-loading is set.
+	Edges        MediaUploadEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MediaUploadEdges holds the relations/edges for other nodes in the graph.
+type MediaUploadEdges struct {
+	// Media holds the value of the media edge.
+	Media *Media `json:"media,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MediaOrErr returns the Media value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MediaUploadEdges) MediaOrErr() (*Media, error) {
+	if e.Media != nil {
+		return e.Media, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "media"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MediaUpload) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mediaupload.FieldUserID:
+			values[i] = new(sql.NullString)
+		case mediaupload.FieldUploadedAt:
+			values[i] = new(sql.NullTime)
+		case mediaupload.FieldID, mediaupload.FieldMediaID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MediaUpload fields.
+func (_m *MediaUpload) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mediaupload.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case mediaupload.FieldMediaID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field media_id", values[i])
+			} else if value != nil {
+				_m.MediaID = *value
+			}
+		case mediaupload.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case mediaupload.FieldUploadedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field uploaded_at", values[i])
+			} else if value.Valid {
+				_m.UploadedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MediaUpload.
+// This includes values selected through modifiers, order, etc.
+func (_m *MediaUpload) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMedia queries the "media" edge of the MediaUpload entity.
+func (_m *MediaUpload) QueryMedia() *MediaQuery {
+	return NewMediaUploadClient(_m.config).QueryMedia(_m)
+}
+
+// Update returns a builder for updating this MediaUpload.
+// Note that you need to call MediaUpload.Unwrap() before calling this method if this MediaUpload
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MediaUpload) Update() *MediaUploadUpdateOne {
+	return NewMediaUploadClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MediaUpload entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MediaUpload) Unwrap() *MediaUpload {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MediaUpload is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MediaUpload) String() string {
+	var builder strings.Builder
+	builder.WriteString("MediaUpload(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("media_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MediaID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("uploaded_at=")
+	builder.WriteString(_m.UploadedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MediaUploads is a parsable slice of MediaUpload.
+type MediaUploads []*MediaUpload
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package mediaupload
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldLTE(FieldID, id))
+}
+
+// MediaID applies equality check predicate on the "media_id" field. It's identical to MediaIDEQ.
+func MediaID(v uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldMediaID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldUserID, v))
+}
+
+// UploadedAt applies equality check predicate on the "uploaded_at" field. It's identical to UploadedAtEQ.
+func UploadedAt(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldUploadedAt, v))
+}
+
+// MediaIDEQ applies the EQ predicate on the "media_id" field.
+func MediaIDEQ(v uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldMediaID, v))
+}
+
+// MediaIDNEQ applies the NEQ predicate on the "media_id" field.
+func MediaIDNEQ(v uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNEQ(FieldMediaID, v))
+}
+
+// MediaIDIn applies the In predicate on the "media_id" field.
+func MediaIDIn(vs ...uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldIn(FieldMediaID, vs...))
+}
+
+// MediaIDNotIn applies the NotIn predicate on the "media_id" field.
+func MediaIDNotIn(vs ...uuid.UUID) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNotIn(FieldMediaID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// UploadedAtEQ applies the EQ predicate on the "uploaded_at" field.
+func UploadedAtEQ(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldEQ(FieldUploadedAt, v))
+}
+
+// UploadedAtNEQ applies the NEQ predicate on the "uploaded_at" field.
+func UploadedAtNEQ(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNEQ(FieldUploadedAt, v))
+}
+
+// UploadedAtIn applies the In predicate on the "uploaded_at" field.
+func UploadedAtIn(vs ...time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldIn(FieldUploadedAt, vs...))
+}
+
+// UploadedAtNotIn applies the NotIn predicate on the "uploaded_at" field.
+func UploadedAtNotIn(vs ...time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldNotIn(FieldUploadedAt, vs...))
+}
+
+// UploadedAtGT applies the GT predicate on the "uploaded_at" field.
+func UploadedAtGT(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldGT(FieldUploadedAt, v))
+}
+
+// UploadedAtGTE applies the GTE predicate on the "uploaded_at" field.
+func UploadedAtGTE(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldGTE(FieldUploadedAt, v))
+}
+
+// UploadedAtLT applies the LT predicate on the "uploaded_at" field.
+func UploadedAtLT(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldLT(FieldUploadedAt, v))
+}
+
+// UploadedAtLTE applies the LTE predicate on the "uploaded_at" field.
+func UploadedAtLTE(v time.Time) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.FieldLTE(FieldUploadedAt, v))
+}
+
+// HasMedia applies the HasEdge predicate on the "media" edge.
+func HasMedia() predicate.MediaUpload {
+	return predicate.MediaUpload(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, MediaTable, MediaColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMediaWith applies the HasEdge predicate on the "media" edge with a given conditions (other predicates).
+func HasMediaWith(preds ...predicate.Media) predicate.MediaUpload {
+	return predicate.MediaUpload(func(s *sql.Selector) {
+		step := newMediaStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MediaUpload) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MediaUpload) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MediaUpload) predicate.MediaUpload {
+	return predicate.MediaUpload(sql.NotPredicates(p))
+}
@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+)
+
+// MediaUploadCreate is the builder for creating a MediaUpload entity.
+type MediaUploadCreate struct {
+	config
+	mutation *MediaUploadMutation
+	hooks    []Hook
+}
+
+// SetMediaID sets the "media_id" field.
+func (_c *MediaUploadCreate) SetMediaID(v uuid.UUID) *MediaUploadCreate {
+	_c.mutation.SetMediaID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *MediaUploadCreate) SetUserID(v string) *MediaUploadCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (_c *MediaUploadCreate) SetUploadedAt(v time.Time) *MediaUploadCreate {
+	_c.mutation.SetUploadedAt(v)
+	return _c
+}
+
+// SetNillableUploadedAt sets the "uploaded_at" field if the given value is not nil.
+func (_c *MediaUploadCreate) SetNillableUploadedAt(v *time.Time) *MediaUploadCreate {
+	if v != nil {
+		_c.SetUploadedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MediaUploadCreate) SetID(v uuid.UUID) *MediaUploadCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MediaUploadCreate) SetNillableID(v *uuid.UUID) *MediaUploadCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetMedia sets the "media" edge to the Media entity.
+func (_c *MediaUploadCreate) SetMedia(v *Media) *MediaUploadCreate {
+	return _c.SetMediaID(v.ID)
+}
+
+// Mutation returns the MediaUploadMutation object of the builder.
+func (_c *MediaUploadCreate) Mutation() *MediaUploadMutation {
+	return _c.mutation
+}
+
+// Save creates the MediaUpload in the database.
+func (_c *MediaUploadCreate) Save(ctx context.Context) (*MediaUpload, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MediaUploadCreate) SaveX(ctx context.Context) *MediaUpload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MediaUploadCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MediaUploadCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MediaUploadCreate) defaults() {
+	if _, ok := _c.mutation.UploadedAt(); !ok {
+		v := mediaupload.DefaultUploadedAt()
+		_c.mutation.SetUploadedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := mediaupload.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MediaUploadCreate) check() error {
+	if _, ok := _c.mutation.MediaID(); !ok {
+		return &ValidationError{Name: "media_id", err: errors.New(`ent: missing required field "MediaUpload.media_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "MediaUpload.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := mediaupload.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "MediaUpload.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UploadedAt(); !ok {
+		return &ValidationError{Name: "uploaded_at", err: errors.New(`ent: missing required field "MediaUpload.uploaded_at"`)}
+	}
+	if len(_c.mutation.MediaIDs()) == 0 {
+		return &ValidationError{Name: "media", err: errors.New(`ent: missing required edge "MediaUpload.media"`)}
+	}
+	return nil
+}
+
+func (_c *MediaUploadCreate) sqlSave(ctx context.Context) (*MediaUpload, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MediaUploadCreate) createSpec() (*MediaUpload, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MediaUpload{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mediaupload.Table, sqlgraph.NewFieldSpec(mediaupload.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(mediaupload.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.UploadedAt(); ok {
+		_spec.SetField(mediaupload.FieldUploadedAt, field.TypeTime, value)
+		_node.UploadedAt = value
+	}
+	if nodes := _c.mutation.MediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mediaupload.MediaTable,
+			Columns: []string{mediaupload.MediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MediaID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaUploadCreateBulk is the builder for creating many MediaUpload entities in bulk.
+type MediaUploadCreateBulk struct {
+	config
+	err      error
+	builders []*MediaUploadCreate
+}
+
+// Save creates the MediaUpload entities in the database.
+func (_c *MediaUploadCreateBulk) Save(ctx context.Context) ([]*MediaUpload, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MediaUpload, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaUploadMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MediaUploadCreateBulk) SaveX(ctx context.Context) []*MediaUpload {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MediaUploadCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MediaUploadCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// MediaUploadUpdate is the builder for updating MediaUpload entities.
+type MediaUploadUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MediaUploadMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MediaUploadUpdate builder.
+func (_u *MediaUploadUpdate) Where(ps ...predicate.MediaUpload) *MediaUploadUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (_u *MediaUploadUpdate) SetUploadedAt(v time.Time) *MediaUploadUpdate {
+	_u.mutation.SetUploadedAt(v)
+	return _u
+}
+
+// SetNillableUploadedAt sets the "uploaded_at" field if the given value is not nil.
+func (_u *MediaUploadUpdate) SetNillableUploadedAt(v *time.Time) *MediaUploadUpdate {
+	if v != nil {
+		_u.SetUploadedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MediaUploadMutation object of the builder.
+func (_u *MediaUploadUpdate) Mutation() *MediaUploadMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MediaUploadUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MediaUploadUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MediaUploadUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MediaUploadUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MediaUploadUpdate) check() error {
+	if _u.mutation.MediaCleared() && len(_u.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaUpload.media"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MediaUploadUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MediaUploadUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MediaUploadUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediaupload.Table, mediaupload.Columns, sqlgraph.NewFieldSpec(mediaupload.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UploadedAt(); ok {
+		_spec.SetField(mediaupload.FieldUploadedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediaupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MediaUploadUpdateOne is the builder for updating a single MediaUpload entity.
+type MediaUploadUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MediaUploadMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (_u *MediaUploadUpdateOne) SetUploadedAt(v time.Time) *MediaUploadUpdateOne {
+	_u.mutation.SetUploadedAt(v)
+	return _u
+}
+
+// SetNillableUploadedAt sets the "uploaded_at" field if the given value is not nil.
+func (_u *MediaUploadUpdateOne) SetNillableUploadedAt(v *time.Time) *MediaUploadUpdateOne {
+	if v != nil {
+		_u.SetUploadedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the MediaUploadMutation object of the builder.
+func (_u *MediaUploadUpdateOne) Mutation() *MediaUploadMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MediaUploadUpdate builder.
+func (_u *MediaUploadUpdateOne) Where(ps ...predicate.MediaUpload) *MediaUploadUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MediaUploadUpdateOne) Select(field string, fields ...string) *MediaUploadUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MediaUpload entity.
+func (_u *MediaUploadUpdateOne) Save(ctx context.Context) (*MediaUpload, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MediaUploadUpdateOne) SaveX(ctx context.Context) *MediaUpload {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MediaUploadUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MediaUploadUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MediaUploadUpdateOne) check() error {
+	if _u.mutation.MediaCleared() && len(_u.mutation.MediaIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MediaUpload.media"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MediaUploadUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MediaUploadUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MediaUploadUpdateOne) sqlSave(ctx context.Context) (_node *MediaUpload, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mediaupload.Table, mediaupload.Columns, sqlgraph.NewFieldSpec(mediaupload.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MediaUpload.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mediaupload.FieldID)
+		for _, f := range fields {
+			if !mediaupload.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mediaupload.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UploadedAt(); ok {
+		_spec.SetField(mediaupload.FieldUploadedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MediaUpload{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mediaupload.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -17,18 +18,39 @@ var (
 		{Name: "is_public", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "parent_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// CollectionsTable holds the schema information for the "collections" table.
 	CollectionsTable = &schema.Table{
 		Name:       "collections",
 		Columns:    CollectionsColumns,
 		PrimaryKey: []*schema.Column{CollectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "collections_collections_children",
+				Columns:    []*schema.Column{CollectionsColumns[9]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "collection_owner_id",
 				Unique:  false,
 				Columns: []*schema.Column{CollectionsColumns[3]},
 			},
+			{
+				Name:    "collection_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionsColumns[9]},
+			},
+			{
+				Name:    "collection_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{CollectionsColumns[7]},
+			},
 		},
 	}
 	// CollectionCollaboratorsColumns holds the columns for the "collection_collaborators" table.
@@ -49,7 +71,33 @@ var (
 				Symbol:     "collection_collaborators_collections_collaborators",
 				Columns:    []*schema.Column{CollectionCollaboratorsColumns[4]},
 				RefColumns: []*schema.Column{CollectionsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DeletionJobsColumns holds the columns for the "deletion_jobs" table.
+	DeletionJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "collection_id", Type: field.TypeUUID},
+		{Name: "requested_by", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed"}, Default: "pending"},
+		{Name: "total_flashcards", Type: field.TypeInt, Default: 0},
+		{Name: "deleted_flashcards", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// DeletionJobsTable holds the schema information for the "deletion_jobs" table.
+	DeletionJobsTable = &schema.Table{
+		Name:       "deletion_jobs",
+		Columns:    DeletionJobsColumns,
+		PrimaryKey: []*schema.Column{DeletionJobsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deletionjob_status",
+				Unique:  false,
+				Columns: []*schema.Column{DeletionJobsColumns[3]},
 			},
 		},
 	}
@@ -59,9 +107,28 @@ var (
 		{Name: "question", Type: field.TypeString},
 		{Name: "answer", Type: field.TypeString},
 		{Name: "type", Type: field.TypeString, Size: 50, Default: "simple"},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "pairs", Type: field.TypeJSON, Nullable: true},
+		{Name: "occlusion", Type: field.TypeJSON, Nullable: true},
+		{Name: "content_format", Type: field.TypeString, Size: 20, Default: "plain"},
+		{Name: "question_html", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "answer_html", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "hint", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "hint_html", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "explanation", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "explanation_html", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "sources", Type: field.TypeJSON, Nullable: true},
+		{Name: "question_key", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "question_text", Type: field.TypeString, Nullable: true},
+		{Name: "answer_text", Type: field.TypeString, Nullable: true},
+		{Name: "search_vector", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"postgres": "tsvector"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published"}, Default: "published"},
+		{Name: "position", Type: field.TypeFloat64, Default: 0},
 		{Name: "created_by", Type: field.TypeString, Size: 255},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "collection_id", Type: field.TypeUUID},
 	}
 	// FlashcardsTable holds the schema information for the "flashcards" table.
@@ -72,9 +139,97 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "flashcards_collections_flashcards",
-				Columns:    []*schema.Column{FlashcardsColumns[7]},
+				Columns:    []*schema.Column{FlashcardsColumns[26]},
 				RefColumns: []*schema.Column{CollectionsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "flashcard_search_vector",
+				Unique:  false,
+				Columns: []*schema.Column{FlashcardsColumns[18]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "GIN",
+				},
+			},
+			{
+				Name:    "flashcard_question_key",
+				Unique:  false,
+				Columns: []*schema.Column{FlashcardsColumns[15]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "gin_trgm_ops",
+					Type:    "GIN",
+				},
+			},
+			{
+				Name:    "flashcard_collection_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{FlashcardsColumns[26], FlashcardsColumns[20]},
+			},
+			{
+				Name:    "flashcard_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{FlashcardsColumns[24]},
+			},
+		},
+	}
+	// FlashcardAnnotationsColumns holds the columns for the "flashcard_annotations" table.
+	FlashcardAnnotationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString, Size: 255},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 10000, Default: ""},
+		{Name: "flag", Type: field.TypeEnum, Nullable: true, Enums: []string{"red", "orange", "green", "blue", "pink", "turquoise", "purple"}},
+		{Name: "bookmarked", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "flashcard_id", Type: field.TypeUUID},
+	}
+	// FlashcardAnnotationsTable holds the schema information for the "flashcard_annotations" table.
+	FlashcardAnnotationsTable = &schema.Table{
+		Name:       "flashcard_annotations",
+		Columns:    FlashcardAnnotationsColumns,
+		PrimaryKey: []*schema.Column{FlashcardAnnotationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flashcard_annotations_flashcards_annotations",
+				Columns:    []*schema.Column{FlashcardAnnotationsColumns[7]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "flashcardannotation_user_id_flashcard_id",
+				Unique:  true,
+				Columns: []*schema.Column{FlashcardAnnotationsColumns[1], FlashcardAnnotationsColumns[7]},
+			},
+			{
+				Name:    "flashcardannotation_user_id_flag",
+				Unique:  false,
+				Columns: []*schema.Column{FlashcardAnnotationsColumns[1], FlashcardAnnotationsColumns[3]},
+			},
+		},
+	}
+	// FlashcardRatingsColumns holds the columns for the "flashcard_ratings" table.
+	FlashcardRatingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "difficulty", Type: field.TypeFloat64, Default: 0},
+		{Name: "answer_count", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "flashcard_id", Type: field.TypeUUID, Unique: true},
+	}
+	// FlashcardRatingsTable holds the schema information for the "flashcard_ratings" table.
+	FlashcardRatingsTable = &schema.Table{
+		Name:       "flashcard_ratings",
+		Columns:    FlashcardRatingsColumns,
+		PrimaryKey: []*schema.Column{FlashcardRatingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flashcard_ratings_flashcards_rating",
+				Columns:    []*schema.Column{FlashcardRatingsColumns[4]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
@@ -82,6 +237,7 @@ var (
 	FlashcardReviewsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeString, Size: 255},
+		{Name: "item", Type: field.TypeInt, Default: 0},
 		{Name: "ease_factor", Type: field.TypeFloat64, Default: 2.5},
 		{Name: "interval", Type: field.TypeInt, Default: 0},
 		{Name: "due_at", Type: field.TypeTime},
@@ -89,6 +245,7 @@ var (
 		{Name: "learning_step", Type: field.TypeInt, Default: 0},
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
 		{Name: "lapse_count", Type: field.TypeInt, Default: 0},
+		{Name: "hint_count", Type: field.TypeInt, Default: 0},
 		{Name: "last_reviewed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -102,26 +259,450 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "flashcard_reviews_flashcards_reviews",
-				Columns:    []*schema.Column{FlashcardReviewsColumns[12]},
+				Columns:    []*schema.Column{FlashcardReviewsColumns[14]},
 				RefColumns: []*schema.Column{FlashcardsColumns[0]},
-				OnDelete:   schema.NoAction,
+				OnDelete:   schema.Cascade,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "flashcardreview_user_id_flashcard_id",
+				Name:    "flashcardreview_user_id_flashcard_id_item",
 				Unique:  true,
-				Columns: []*schema.Column{FlashcardReviewsColumns[1], FlashcardReviewsColumns[12]},
+				Columns: []*schema.Column{FlashcardReviewsColumns[1], FlashcardReviewsColumns[14], FlashcardReviewsColumns[2]},
 			},
 			{
 				Name:    "flashcardreview_user_id_due_at",
 				Unique:  false,
-				Columns: []*schema.Column{FlashcardReviewsColumns[1], FlashcardReviewsColumns[4]},
+				Columns: []*schema.Column{FlashcardReviewsColumns[1], FlashcardReviewsColumns[5]},
 			},
 			{
 				Name:    "flashcardreview_user_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{FlashcardReviewsColumns[1], FlashcardReviewsColumns[5]},
+				Columns: []*schema.Column{FlashcardReviewsColumns[1], FlashcardReviewsColumns[6]},
+			},
+		},
+	}
+	// FlashcardRevisionsColumns holds the columns for the "flashcard_revisions" table.
+	FlashcardRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "author_id", Type: field.TypeString, Size: 255},
+		{Name: "changed_fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "question", Type: field.TypeString},
+		{Name: "answer", Type: field.TypeString},
+		{Name: "type", Type: field.TypeString, Size: 50},
+		{Name: "content_format", Type: field.TypeString, Size: 20},
+		{Name: "hint", Type: field.TypeString, Nullable: true},
+		{Name: "explanation", Type: field.TypeString, Nullable: true},
+		{Name: "sources", Type: field.TypeJSON, Nullable: true},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "pairs", Type: field.TypeJSON, Nullable: true},
+		{Name: "occlusion", Type: field.TypeJSON, Nullable: true},
+		{Name: "media_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "flashcard_id", Type: field.TypeUUID},
+	}
+	// FlashcardRevisionsTable holds the schema information for the "flashcard_revisions" table.
+	FlashcardRevisionsTable = &schema.Table{
+		Name:       "flashcard_revisions",
+		Columns:    FlashcardRevisionsColumns,
+		PrimaryKey: []*schema.Column{FlashcardRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flashcard_revisions_flashcards_revisions",
+				Columns:    []*schema.Column{FlashcardRevisionsColumns[16]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "flashcardrevision_flashcard_id_number",
+				Unique:  true,
+				Columns: []*schema.Column{FlashcardRevisionsColumns[16], FlashcardRevisionsColumns[1]},
+			},
+		},
+	}
+	// FlashcardTagsColumns holds the columns for the "flashcard_tags" table.
+	FlashcardTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "collection_id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "flashcard_id", Type: field.TypeUUID},
+	}
+	// FlashcardTagsTable holds the schema information for the "flashcard_tags" table.
+	FlashcardTagsTable = &schema.Table{
+		Name:       "flashcard_tags",
+		Columns:    FlashcardTagsColumns,
+		PrimaryKey: []*schema.Column{FlashcardTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flashcard_tags_flashcards_tags",
+				Columns:    []*schema.Column{FlashcardTagsColumns[4]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "flashcardtag_flashcard_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{FlashcardTagsColumns[4], FlashcardTagsColumns[2]},
+			},
+			{
+				Name:    "flashcardtag_collection_id_name",
+				Unique:  false,
+				Columns: []*schema.Column{FlashcardTagsColumns[1], FlashcardTagsColumns[2]},
+			},
+		},
+	}
+	// ItemStatisticsColumns holds the columns for the "item_statistics" table.
+	ItemStatisticsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "attempt_count", Type: field.TypeInt, Default: 0},
+		{Name: "answered_count", Type: field.TypeInt, Default: 0},
+		{Name: "score_sum", Type: field.TypeFloat64, Default: 0},
+		{Name: "score_squares", Type: field.TypeFloat64, Default: 0},
+		{Name: "rest_sum", Type: field.TypeFloat64, Default: 0},
+		{Name: "rest_squares", Type: field.TypeFloat64, Default: 0},
+		{Name: "score_rest_products", Type: field.TypeFloat64, Default: 0},
+		{Name: "time_spent_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "option_counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "flashcard_id", Type: field.TypeUUID},
+		{Name: "quiz_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// ItemStatisticsTable holds the schema information for the "item_statistics" table.
+	ItemStatisticsTable = &schema.Table{
+		Name:       "item_statistics",
+		Columns:    ItemStatisticsColumns,
+		PrimaryKey: []*schema.Column{ItemStatisticsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "item_statistics_flashcards_item_statistics",
+				Columns:    []*schema.Column{ItemStatisticsColumns[11]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "item_statistics_quizs_item_statistics",
+				Columns:    []*schema.Column{ItemStatisticsColumns[12]},
+				RefColumns: []*schema.Column{QuizsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "itemstatistic_quiz_id_flashcard_id",
+				Unique:  true,
+				Columns: []*schema.Column{ItemStatisticsColumns[12], ItemStatisticsColumns[11]},
+			},
+			{
+				Name:    "itemstatistic_flashcard_id",
+				Unique:  true,
+				Columns: []*schema.Column{ItemStatisticsColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "quiz_id IS NULL",
+				},
+			},
+		},
+	}
+	// LearnerAbilitiesColumns holds the columns for the "learner_abilities" table.
+	LearnerAbilitiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString, Size: 255},
+		{Name: "ability", Type: field.TypeFloat64, Default: 0},
+		{Name: "answer_count", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "collection_id", Type: field.TypeUUID},
+	}
+	// LearnerAbilitiesTable holds the schema information for the "learner_abilities" table.
+	LearnerAbilitiesTable = &schema.Table{
+		Name:       "learner_abilities",
+		Columns:    LearnerAbilitiesColumns,
+		PrimaryKey: []*schema.Column{LearnerAbilitiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "learner_abilities_collections_abilities",
+				Columns:    []*schema.Column{LearnerAbilitiesColumns[5]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "learnerability_user_id_collection_id",
+				Unique:  true,
+				Columns: []*schema.Column{LearnerAbilitiesColumns[1], LearnerAbilitiesColumns[5]},
+			},
+		},
+	}
+	// MediaColumns holds the columns for the "media" table.
+	MediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "hash", Type: field.TypeString, Size: 64},
+		{Name: "content_type", Type: field.TypeString, Size: 100},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "storage_key", Type: field.TypeString, Size: 255},
+		{Name: "uploaded_by", Type: field.TypeString, Size: 255},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MediaTable holds the schema information for the "media" table.
+	MediaTable = &schema.Table{
+		Name:       "media",
+		Columns:    MediaColumns,
+		PrimaryKey: []*schema.Column{MediaColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "media_hash",
+				Unique:  true,
+				Columns: []*schema.Column{MediaColumns[1]},
+			},
+		},
+	}
+	// MediaUploadsColumns holds the columns for the "media_uploads" table.
+	MediaUploadsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString, Size: 255},
+		{Name: "uploaded_at", Type: field.TypeTime},
+		{Name: "media_id", Type: field.TypeUUID},
+	}
+	// MediaUploadsTable holds the schema information for the "media_uploads" table.
+	MediaUploadsTable = &schema.Table{
+		Name:       "media_uploads",
+		Columns:    MediaUploadsColumns,
+		PrimaryKey: []*schema.Column{MediaUploadsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "media_uploads_media_uploads",
+				Columns:    []*schema.Column{MediaUploadsColumns[3]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "mediaupload_media_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{MediaUploadsColumns[3], MediaUploadsColumns[1]},
+			},
+			{
+				Name:    "mediaupload_media_id_uploaded_at",
+				Unique:  false,
+				Columns: []*schema.Column{MediaUploadsColumns[3], MediaUploadsColumns[2]},
+			},
+		},
+	}
+	// QuizsColumns holds the columns for the "quizs" table.
+	QuizsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_by", Type: field.TypeString, Size: 255},
+		{Name: "title", Type: field.TypeString, Nullable: true, Size: 255, Default: ""},
+		{Name: "selection", Type: field.TypeEnum, Enums: []string{"random", "weakest", "tagged"}, Default: "random"},
+		{Name: "question_count", Type: field.TypeInt},
+		{Name: "adaptive", Type: field.TypeBool, Default: false},
+		{Name: "type_counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "choice_count", Type: field.TypeInt, Default: 0},
+		{Name: "seed", Type: field.TypeInt64},
+		{Name: "flashcard_ids", Type: field.TypeJSON},
+		{Name: "time_limit_seconds", Type: field.TypeInt, Nullable: true},
+		{Name: "opens_at", Type: field.TypeTime, Nullable: true},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_attempts", Type: field.TypeInt, Nullable: true},
+		{Name: "review_feedback", Type: field.TypeBool, Default: false},
+		{Name: "slow_answer_seconds", Type: field.TypeInt, Default: 30},
+		{Name: "shared", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "collection_id", Type: field.TypeUUID},
+	}
+	// QuizsTable holds the schema information for the "quizs" table.
+	QuizsTable = &schema.Table{
+		Name:       "quizs",
+		Columns:    QuizsColumns,
+		PrimaryKey: []*schema.Column{QuizsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quizs_collections_quizzes",
+				Columns:    []*schema.Column{QuizsColumns[20]},
+				RefColumns: []*schema.Column{CollectionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quiz_collection_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuizsColumns[20], QuizsColumns[18]},
+			},
+		},
+	}
+	// QuizAnswersColumns holds the columns for the "quiz_answers" table.
+	QuizAnswersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "response", Type: field.TypeJSON, Nullable: true},
+		{Name: "answered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "correct", Type: field.TypeBool, Default: false},
+		{Name: "score", Type: field.TypeFloat64, Default: 0},
+		{Name: "time_spent_ms", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "flashcard_id", Type: field.TypeUUID},
+		{Name: "attempt_id", Type: field.TypeUUID},
+	}
+	// QuizAnswersTable holds the schema information for the "quiz_answers" table.
+	QuizAnswersTable = &schema.Table{
+		Name:       "quiz_answers",
+		Columns:    QuizAnswersColumns,
+		PrimaryKey: []*schema.Column{QuizAnswersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quiz_answers_flashcards_quiz_answers",
+				Columns:    []*schema.Column{QuizAnswersColumns[9]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "quiz_answers_quiz_attempts_answers",
+				Columns:    []*schema.Column{QuizAnswersColumns[10]},
+				RefColumns: []*schema.Column{QuizAttemptsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quizanswer_attempt_id_position",
+				Unique:  true,
+				Columns: []*schema.Column{QuizAnswersColumns[10], QuizAnswersColumns[1]},
+			},
+			{
+				Name:    "quizanswer_flashcard_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAnswersColumns[9]},
+			},
+		},
+	}
+	// QuizAttemptsColumns holds the columns for the "quiz_attempts" table.
+	QuizAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeString, Size: 255},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"in_progress", "submitted"}, Default: "in_progress"},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "deadline_at", Type: field.TypeTime, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "auto_submitted", Type: field.TypeBool, Default: false},
+		{Name: "score", Type: field.TypeFloat64, Default: 0},
+		{Name: "max_score", Type: field.TypeFloat64, Default: 0},
+		{Name: "ability", Type: field.TypeFloat64, Nullable: true},
+		{Name: "ability_error", Type: field.TypeFloat64, Nullable: true},
+		{Name: "time_spent_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "quiz_id", Type: field.TypeUUID},
+	}
+	// QuizAttemptsTable holds the schema information for the "quiz_attempts" table.
+	QuizAttemptsTable = &schema.Table{
+		Name:       "quiz_attempts",
+		Columns:    QuizAttemptsColumns,
+		PrimaryKey: []*schema.Column{QuizAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quiz_attempts_quizs_attempts",
+				Columns:    []*schema.Column{QuizAttemptsColumns[12]},
+				RefColumns: []*schema.Column{QuizsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quizattempt_quiz_id_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[12], QuizAttemptsColumns[1]},
+			},
+			{
+				Name:    "quizattempt_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[1], QuizAttemptsColumns[2]},
+			},
+			{
+				Name:    "quizattempt_status_deadline_at",
+				Unique:  false,
+				Columns: []*schema.Column{QuizAttemptsColumns[2], QuizAttemptsColumns[4]},
+			},
+		},
+	}
+	// QuizReviewUpdatesColumns holds the columns for the "quiz_review_updates" table.
+	QuizReviewUpdatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "rating", Type: field.TypeInt},
+		{Name: "previous_ease_factor", Type: field.TypeFloat64},
+		{Name: "previous_interval", Type: field.TypeInt},
+		{Name: "previous_due_at", Type: field.TypeTime},
+		{Name: "previous_status", Type: field.TypeEnum, Enums: []string{"new", "learning", "review", "relearning"}},
+		{Name: "previous_learning_step", Type: field.TypeInt},
+		{Name: "previous_review_count", Type: field.TypeInt},
+		{Name: "previous_lapse_count", Type: field.TypeInt},
+		{Name: "previous_last_reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reverted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "review_id", Type: field.TypeUUID},
+		{Name: "attempt_id", Type: field.TypeUUID},
+	}
+	// QuizReviewUpdatesTable holds the schema information for the "quiz_review_updates" table.
+	QuizReviewUpdatesTable = &schema.Table{
+		Name:       "quiz_review_updates",
+		Columns:    QuizReviewUpdatesColumns,
+		PrimaryKey: []*schema.Column{QuizReviewUpdatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quiz_review_updates_flashcard_reviews_quiz_updates",
+				Columns:    []*schema.Column{QuizReviewUpdatesColumns[12]},
+				RefColumns: []*schema.Column{FlashcardReviewsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "quiz_review_updates_quiz_attempts_review_updates",
+				Columns:    []*schema.Column{QuizReviewUpdatesColumns[13]},
+				RefColumns: []*schema.Column{QuizAttemptsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "quizreviewupdate_attempt_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuizReviewUpdatesColumns[13]},
+			},
+			{
+				Name:    "quizreviewupdate_review_id",
+				Unique:  false,
+				Columns: []*schema.Column{QuizReviewUpdatesColumns[12]},
+			},
+		},
+	}
+	// FlashcardMediaColumns holds the columns for the "flashcard_media" table.
+	FlashcardMediaColumns = []*schema.Column{
+		{Name: "flashcard_id", Type: field.TypeUUID},
+		{Name: "media_id", Type: field.TypeUUID},
+	}
+	// FlashcardMediaTable holds the schema information for the "flashcard_media" table.
+	FlashcardMediaTable = &schema.Table{
+		Name:       "flashcard_media",
+		Columns:    FlashcardMediaColumns,
+		PrimaryKey: []*schema.Column{FlashcardMediaColumns[0], FlashcardMediaColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flashcard_media_flashcard_id",
+				Columns:    []*schema.Column{FlashcardMediaColumns[0]},
+				RefColumns: []*schema.Column{FlashcardsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "flashcard_media_media_id",
+				Columns:    []*schema.Column{FlashcardMediaColumns[1]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
 		},
 	}
@@ -129,13 +710,44 @@ var (
 	Tables = []*schema.Table{
 		CollectionsTable,
 		CollectionCollaboratorsTable,
+		DeletionJobsTable,
 		FlashcardsTable,
+		FlashcardAnnotationsTable,
+		FlashcardRatingsTable,
 		FlashcardReviewsTable,
+		FlashcardRevisionsTable,
+		FlashcardTagsTable,
+		ItemStatisticsTable,
+		LearnerAbilitiesTable,
+		MediaTable,
+		MediaUploadsTable,
+		QuizsTable,
+		QuizAnswersTable,
+		QuizAttemptsTable,
+		QuizReviewUpdatesTable,
+		FlashcardMediaTable,
 	}
 )
 
 func init() {
+	CollectionsTable.ForeignKeys[0].RefTable = CollectionsTable
 	CollectionCollaboratorsTable.ForeignKeys[0].RefTable = CollectionsTable
 	FlashcardsTable.ForeignKeys[0].RefTable = CollectionsTable
+	FlashcardAnnotationsTable.ForeignKeys[0].RefTable = FlashcardsTable
+	FlashcardRatingsTable.ForeignKeys[0].RefTable = FlashcardsTable
 	FlashcardReviewsTable.ForeignKeys[0].RefTable = FlashcardsTable
+	FlashcardRevisionsTable.ForeignKeys[0].RefTable = FlashcardsTable
+	FlashcardTagsTable.ForeignKeys[0].RefTable = FlashcardsTable
+	ItemStatisticsTable.ForeignKeys[0].RefTable = FlashcardsTable
+	ItemStatisticsTable.ForeignKeys[1].RefTable = QuizsTable
+	LearnerAbilitiesTable.ForeignKeys[0].RefTable = CollectionsTable
+	MediaUploadsTable.ForeignKeys[0].RefTable = MediaTable
+	QuizsTable.ForeignKeys[0].RefTable = CollectionsTable
+	QuizAnswersTable.ForeignKeys[0].RefTable = FlashcardsTable
+	QuizAnswersTable.ForeignKeys[1].RefTable = QuizAttemptsTable
+	QuizAttemptsTable.ForeignKeys[0].RefTable = QuizsTable
+	QuizReviewUpdatesTable.ForeignKeys[0].RefTable = FlashcardReviewsTable
+	QuizReviewUpdatesTable.ForeignKeys[1].RefTable = QuizAttemptsTable
+	FlashcardMediaTable.ForeignKeys[0].RefTable = FlashcardsTable
+	FlashcardMediaTable.ForeignKeys[1].RefTable = MediaTable
 }
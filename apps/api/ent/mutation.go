@@ -14,9 +14,23 @@ import (
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
 	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
 const (
@@ -30,8 +44,21 @@ const (
 	// Node types.
 	TypeCollection             = "Collection"
 	TypeCollectionCollaborator = "CollectionCollaborator"
+	TypeDeletionJob            = "DeletionJob"
 	TypeFlashcard              = "Flashcard"
+	TypeFlashcardAnnotation    = "FlashcardAnnotation"
+	TypeFlashcardRating        = "FlashcardRating"
 	TypeFlashcardReview        = "FlashcardReview"
+	TypeFlashcardRevision      = "FlashcardRevision"
+	TypeFlashcardTag           = "FlashcardTag"
+	TypeItemStatistic          = "ItemStatistic"
+	TypeLearnerAbility         = "LearnerAbility"
+	TypeMedia                  = "Media"
+	TypeMediaUpload            = "MediaUpload"
+	TypeQuiz                   = "Quiz"
+	TypeQuizAnswer             = "QuizAnswer"
+	TypeQuizAttempt            = "QuizAttempt"
+	TypeQuizReviewUpdate       = "QuizReviewUpdate"
 )
 
 // CollectionMutation represents an operation that mutates the Collection nodes in the graph.
@@ -46,6 +73,8 @@ type CollectionMutation struct {
 	is_public            *bool
 	created_at           *time.Time
 	updated_at           *time.Time
+	deleted_at           *time.Time
+	deleted_by           *string
 	clearedFields        map[string]struct{}
 	collaborators        map[uuid.UUID]struct{}
 	removedcollaborators map[uuid.UUID]struct{}
@@ -53,6 +82,17 @@ type CollectionMutation struct {
 	flashcards           map[uuid.UUID]struct{}
 	removedflashcards    map[uuid.UUID]struct{}
 	clearedflashcards    bool
+	quizzes              map[uuid.UUID]struct{}
+	removedquizzes       map[uuid.UUID]struct{}
+	clearedquizzes       bool
+	abilities            map[uuid.UUID]struct{}
+	removedabilities     map[uuid.UUID]struct{}
+	clearedabilities     bool
+	parent               *uuid.UUID
+	clearedparent        bool
+	children             map[uuid.UUID]struct{}
+	removedchildren      map[uuid.UUID]struct{}
+	clearedchildren      bool
 	done                 bool
 	oldValue             func(context.Context) (*Collection, error)
 	predicates           []predicate.Collection
@@ -319,6 +359,55 @@ func (m *CollectionMutation) ResetIsPublic() {
 	m.is_public = nil
 }
 
+// SetParentID sets the "parent_id" field.
+func (m *CollectionMutation) SetParentID(u uuid.UUID) {
+	m.parent = &u
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CollectionMutation) ParentID() (r uuid.UUID, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldParentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CollectionMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[collection.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CollectionMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[collection.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CollectionMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, collection.FieldParentID)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *CollectionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -391,6 +480,104 @@ func (m *CollectionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (m *CollectionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *CollectionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *CollectionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[collection.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *CollectionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[collection.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *CollectionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, collection.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *CollectionMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *CollectionMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Collection entity.
+// If the Collection object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CollectionMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *CollectionMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[collection.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *CollectionMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[collection.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *CollectionMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, collection.FieldDeletedBy)
+}
+
 // AddCollaboratorIDs adds the "collaborators" edge to the CollectionCollaborator entity by ids.
 func (m *CollectionMutation) AddCollaboratorIDs(ids ...uuid.UUID) {
 	if m.collaborators == nil {
@@ -499,6 +686,195 @@ func (m *CollectionMutation) ResetFlashcards() {
 	m.removedflashcards = nil
 }
 
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by ids.
+func (m *CollectionMutation) AddQuizIDs(ids ...uuid.UUID) {
+	if m.quizzes == nil {
+		m.quizzes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.quizzes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuizzes clears the "quizzes" edge to the Quiz entity.
+func (m *CollectionMutation) ClearQuizzes() {
+	m.clearedquizzes = true
+}
+
+// QuizzesCleared reports if the "quizzes" edge to the Quiz entity was cleared.
+func (m *CollectionMutation) QuizzesCleared() bool {
+	return m.clearedquizzes
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to the Quiz entity by IDs.
+func (m *CollectionMutation) RemoveQuizIDs(ids ...uuid.UUID) {
+	if m.removedquizzes == nil {
+		m.removedquizzes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.quizzes, ids[i])
+		m.removedquizzes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizzes returns the removed IDs of the "quizzes" edge to the Quiz entity.
+func (m *CollectionMutation) RemovedQuizzesIDs() (ids []uuid.UUID) {
+	for id := range m.removedquizzes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizzesIDs returns the "quizzes" edge IDs in the mutation.
+func (m *CollectionMutation) QuizzesIDs() (ids []uuid.UUID) {
+	for id := range m.quizzes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizzes resets all changes to the "quizzes" edge.
+func (m *CollectionMutation) ResetQuizzes() {
+	m.quizzes = nil
+	m.clearedquizzes = false
+	m.removedquizzes = nil
+}
+
+// AddAbilityIDs adds the "abilities" edge to the LearnerAbility entity by ids.
+func (m *CollectionMutation) AddAbilityIDs(ids ...uuid.UUID) {
+	if m.abilities == nil {
+		m.abilities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.abilities[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAbilities clears the "abilities" edge to the LearnerAbility entity.
+func (m *CollectionMutation) ClearAbilities() {
+	m.clearedabilities = true
+}
+
+// AbilitiesCleared reports if the "abilities" edge to the LearnerAbility entity was cleared.
+func (m *CollectionMutation) AbilitiesCleared() bool {
+	return m.clearedabilities
+}
+
+// RemoveAbilityIDs removes the "abilities" edge to the LearnerAbility entity by IDs.
+func (m *CollectionMutation) RemoveAbilityIDs(ids ...uuid.UUID) {
+	if m.removedabilities == nil {
+		m.removedabilities = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.abilities, ids[i])
+		m.removedabilities[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAbilities returns the removed IDs of the "abilities" edge to the LearnerAbility entity.
+func (m *CollectionMutation) RemovedAbilitiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedabilities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AbilitiesIDs returns the "abilities" edge IDs in the mutation.
+func (m *CollectionMutation) AbilitiesIDs() (ids []uuid.UUID) {
+	for id := range m.abilities {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAbilities resets all changes to the "abilities" edge.
+func (m *CollectionMutation) ResetAbilities() {
+	m.abilities = nil
+	m.clearedabilities = false
+	m.removedabilities = nil
+}
+
+// ClearParent clears the "parent" edge to the Collection entity.
+func (m *CollectionMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[collection.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Collection entity was cleared.
+func (m *CollectionMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CollectionMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CollectionMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Collection entity by ids.
+func (m *CollectionMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Collection entity.
+func (m *CollectionMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Collection entity was cleared.
+func (m *CollectionMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Collection entity by IDs.
+func (m *CollectionMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Collection entity.
+func (m *CollectionMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *CollectionMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CollectionMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
 // Where appends a list predicates to the CollectionMutation builder.
 func (m *CollectionMutation) Where(ps ...predicate.Collection) {
 	m.predicates = append(m.predicates, ps...)
@@ -533,7 +909,7 @@ func (m *CollectionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CollectionMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 9)
 	if m.name != nil {
 		fields = append(fields, collection.FieldName)
 	}
@@ -546,12 +922,21 @@ func (m *CollectionMutation) Fields() []string {
 	if m.is_public != nil {
 		fields = append(fields, collection.FieldIsPublic)
 	}
+	if m.parent != nil {
+		fields = append(fields, collection.FieldParentID)
+	}
 	if m.created_at != nil {
 		fields = append(fields, collection.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
 		fields = append(fields, collection.FieldUpdatedAt)
 	}
+	if m.deleted_at != nil {
+		fields = append(fields, collection.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, collection.FieldDeletedBy)
+	}
 	return fields
 }
 
@@ -568,10 +953,16 @@ func (m *CollectionMutation) Field(name string) (ent.Value, bool) {
 		return m.OwnerID()
 	case collection.FieldIsPublic:
 		return m.IsPublic()
+	case collection.FieldParentID:
+		return m.ParentID()
 	case collection.FieldCreatedAt:
 		return m.CreatedAt()
 	case collection.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case collection.FieldDeletedAt:
+		return m.DeletedAt()
+	case collection.FieldDeletedBy:
+		return m.DeletedBy()
 	}
 	return nil, false
 }
@@ -589,10 +980,16 @@ func (m *CollectionMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldOwnerID(ctx)
 	case collection.FieldIsPublic:
 		return m.OldIsPublic(ctx)
+	case collection.FieldParentID:
+		return m.OldParentID(ctx)
 	case collection.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case collection.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case collection.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case collection.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
 	}
 	return nil, fmt.Errorf("unknown Collection field %s", name)
 }
@@ -630,6 +1027,13 @@ func (m *CollectionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsPublic(v)
 		return nil
+	case collection.FieldParentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
 	case collection.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -644,6 +1048,20 @@ func (m *CollectionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case collection.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case collection.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Collection field %s", name)
 }
@@ -677,6 +1095,15 @@ func (m *CollectionMutation) ClearedFields() []string {
 	if m.FieldCleared(collection.FieldDescription) {
 		fields = append(fields, collection.FieldDescription)
 	}
+	if m.FieldCleared(collection.FieldParentID) {
+		fields = append(fields, collection.FieldParentID)
+	}
+	if m.FieldCleared(collection.FieldDeletedAt) {
+		fields = append(fields, collection.FieldDeletedAt)
+	}
+	if m.FieldCleared(collection.FieldDeletedBy) {
+		fields = append(fields, collection.FieldDeletedBy)
+	}
 	return fields
 }
 
@@ -694,6 +1121,15 @@ func (m *CollectionMutation) ClearField(name string) error {
 	case collection.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case collection.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case collection.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case collection.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
 	}
 	return fmt.Errorf("unknown Collection nullable field %s", name)
 }
@@ -714,25 +1150,46 @@ func (m *CollectionMutation) ResetField(name string) error {
 	case collection.FieldIsPublic:
 		m.ResetIsPublic()
 		return nil
+	case collection.FieldParentID:
+		m.ResetParentID()
+		return nil
 	case collection.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	case collection.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case collection.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case collection.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
 	}
 	return fmt.Errorf("unknown Collection field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *CollectionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 6)
 	if m.collaborators != nil {
 		edges = append(edges, collection.EdgeCollaborators)
 	}
 	if m.flashcards != nil {
 		edges = append(edges, collection.EdgeFlashcards)
 	}
+	if m.quizzes != nil {
+		edges = append(edges, collection.EdgeQuizzes)
+	}
+	if m.abilities != nil {
+		edges = append(edges, collection.EdgeAbilities)
+	}
+	if m.parent != nil {
+		edges = append(edges, collection.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, collection.EdgeChildren)
+	}
 	return edges
 }
 
@@ -752,19 +1209,50 @@ func (m *CollectionMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case collection.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.quizzes))
+		for id := range m.quizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	case collection.EdgeAbilities:
+		ids := make([]ent.Value, 0, len(m.abilities))
+		for id := range m.abilities {
+			ids = append(ids, id)
+		}
+		return ids
+	case collection.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case collection.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *CollectionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 6)
 	if m.removedcollaborators != nil {
 		edges = append(edges, collection.EdgeCollaborators)
 	}
 	if m.removedflashcards != nil {
 		edges = append(edges, collection.EdgeFlashcards)
 	}
+	if m.removedquizzes != nil {
+		edges = append(edges, collection.EdgeQuizzes)
+	}
+	if m.removedabilities != nil {
+		edges = append(edges, collection.EdgeAbilities)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, collection.EdgeChildren)
+	}
 	return edges
 }
 
@@ -784,19 +1272,49 @@ func (m *CollectionMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case collection.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.removedquizzes))
+		for id := range m.removedquizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	case collection.EdgeAbilities:
+		ids := make([]ent.Value, 0, len(m.removedabilities))
+		for id := range m.removedabilities {
+			ids = append(ids, id)
+		}
+		return ids
+	case collection.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *CollectionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 6)
 	if m.clearedcollaborators {
 		edges = append(edges, collection.EdgeCollaborators)
 	}
 	if m.clearedflashcards {
 		edges = append(edges, collection.EdgeFlashcards)
 	}
+	if m.clearedquizzes {
+		edges = append(edges, collection.EdgeQuizzes)
+	}
+	if m.clearedabilities {
+		edges = append(edges, collection.EdgeAbilities)
+	}
+	if m.clearedparent {
+		edges = append(edges, collection.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, collection.EdgeChildren)
+	}
 	return edges
 }
 
@@ -808,6 +1326,14 @@ func (m *CollectionMutation) EdgeCleared(name string) bool {
 		return m.clearedcollaborators
 	case collection.EdgeFlashcards:
 		return m.clearedflashcards
+	case collection.EdgeQuizzes:
+		return m.clearedquizzes
+	case collection.EdgeAbilities:
+		return m.clearedabilities
+	case collection.EdgeParent:
+		return m.clearedparent
+	case collection.EdgeChildren:
+		return m.clearedchildren
 	}
 	return false
 }
@@ -816,6 +1342,9 @@ func (m *CollectionMutation) EdgeCleared(name string) bool {
 // if that edge is not defined in the schema.
 func (m *CollectionMutation) ClearEdge(name string) error {
 	switch name {
+	case collection.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
 	return fmt.Errorf("unknown Collection unique edge %s", name)
 }
@@ -830,6 +1359,18 @@ func (m *CollectionMutation) ResetEdge(name string) error {
 	case collection.EdgeFlashcards:
 		m.ResetFlashcards()
 		return nil
+	case collection.EdgeQuizzes:
+		m.ResetQuizzes()
+		return nil
+	case collection.EdgeAbilities:
+		m.ResetAbilities()
+		return nil
+	case collection.EdgeParent:
+		m.ResetParent()
+		return nil
+	case collection.EdgeChildren:
+		m.ResetChildren()
+		return nil
 	}
 	return fmt.Errorf("unknown Collection edge %s", name)
 }
@@ -1382,40 +1923,40 @@ func (m *CollectionCollaboratorMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown CollectionCollaborator edge %s", name)
 }
 
-// FlashcardMutation represents an operation that mutates the Flashcard nodes in the graph.
-type FlashcardMutation struct {
+// DeletionJobMutation represents an operation that mutates the DeletionJob nodes in the graph.
+type DeletionJobMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	question          *string
-	answer            *string
-	_type             *string
-	created_by        *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	collection        *uuid.UUID
-	clearedcollection bool
-	reviews           map[uuid.UUID]struct{}
-	removedreviews    map[uuid.UUID]struct{}
-	clearedreviews    bool
-	done              bool
-	oldValue          func(context.Context) (*Flashcard, error)
-	predicates        []predicate.Flashcard
-}
-
-var _ ent.Mutation = (*FlashcardMutation)(nil)
-
-// flashcardOption allows management of the mutation configuration using functional options.
-type flashcardOption func(*FlashcardMutation)
-
-// newFlashcardMutation creates new mutation for the Flashcard entity.
-func newFlashcardMutation(c config, op Op, opts ...flashcardOption) *FlashcardMutation {
-	m := &FlashcardMutation{
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	collection_id         *uuid.UUID
+	requested_by          *string
+	status                *deletionjob.Status
+	total_flashcards      *int
+	addtotal_flashcards   *int
+	deleted_flashcards    *int
+	adddeleted_flashcards *int
+	error                 *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	completed_at          *time.Time
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*DeletionJob, error)
+	predicates            []predicate.DeletionJob
+}
+
+var _ ent.Mutation = (*DeletionJobMutation)(nil)
+
+// deletionjobOption allows management of the mutation configuration using functional options.
+type deletionjobOption func(*DeletionJobMutation)
+
+// newDeletionJobMutation creates new mutation for the DeletionJob entity.
+func newDeletionJobMutation(c config, op Op, opts ...deletionjobOption) *DeletionJobMutation {
+	m := &DeletionJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFlashcard,
+		typ:           TypeDeletionJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1424,20 +1965,20 @@ func newFlashcardMutation(c config, op Op, opts ...flashcardOption) *FlashcardMu
 	return m
 }
 
-// withFlashcardID sets the ID field of the mutation.
-func withFlashcardID(id uuid.UUID) flashcardOption {
-	return func(m *FlashcardMutation) {
+// withDeletionJobID sets the ID field of the mutation.
+func withDeletionJobID(id uuid.UUID) deletionjobOption {
+	return func(m *DeletionJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Flashcard
+			value *DeletionJob
 		)
-		m.oldValue = func(ctx context.Context) (*Flashcard, error) {
+		m.oldValue = func(ctx context.Context) (*DeletionJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Flashcard.Get(ctx, id)
+					value, err = m.Client().DeletionJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1446,10 +1987,10 @@ func withFlashcardID(id uuid.UUID) flashcardOption {
 	}
 }
 
-// withFlashcard sets the old Flashcard of the mutation.
-func withFlashcard(node *Flashcard) flashcardOption {
-	return func(m *FlashcardMutation) {
-		m.oldValue = func(context.Context) (*Flashcard, error) {
+// withDeletionJob sets the old DeletionJob of the mutation.
+func withDeletionJob(node *DeletionJob) deletionjobOption {
+	return func(m *DeletionJobMutation) {
+		m.oldValue = func(context.Context) (*DeletionJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1458,7 +1999,7 @@ func withFlashcard(node *Flashcard) flashcardOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FlashcardMutation) Client() *Client {
+func (m DeletionJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1466,7 +2007,7 @@ func (m FlashcardMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FlashcardMutation) Tx() (*Tx, error) {
+func (m DeletionJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1476,14 +2017,14 @@ func (m FlashcardMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Flashcard entities.
-func (m *FlashcardMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of DeletionJob entities.
+func (m *DeletionJobMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FlashcardMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DeletionJobMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1494,7 +2035,7 @@ func (m *FlashcardMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FlashcardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DeletionJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1503,199 +2044,288 @@ func (m *FlashcardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Flashcard.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeletionJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetQuestion sets the "question" field.
-func (m *FlashcardMutation) SetQuestion(s string) {
-	m.question = &s
+// SetCollectionID sets the "collection_id" field.
+func (m *DeletionJobMutation) SetCollectionID(u uuid.UUID) {
+	m.collection_id = &u
 }
 
-// Question returns the value of the "question" field in the mutation.
-func (m *FlashcardMutation) Question() (r string, exists bool) {
-	v := m.question
+// CollectionID returns the value of the "collection_id" field in the mutation.
+func (m *DeletionJobMutation) CollectionID() (r uuid.UUID, exists bool) {
+	v := m.collection_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestion returns the old "question" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldCollectionID returns the old "collection_id" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldQuestion(ctx context.Context) (v string, err error) {
+func (m *DeletionJobMutation) OldCollectionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestion requires an ID field in the mutation")
+		return v, errors.New("OldCollectionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
 	}
-	return oldValue.Question, nil
+	return oldValue.CollectionID, nil
 }
 
-// ResetQuestion resets all changes to the "question" field.
-func (m *FlashcardMutation) ResetQuestion() {
-	m.question = nil
+// ResetCollectionID resets all changes to the "collection_id" field.
+func (m *DeletionJobMutation) ResetCollectionID() {
+	m.collection_id = nil
 }
 
-// SetAnswer sets the "answer" field.
-func (m *FlashcardMutation) SetAnswer(s string) {
-	m.answer = &s
+// SetRequestedBy sets the "requested_by" field.
+func (m *DeletionJobMutation) SetRequestedBy(s string) {
+	m.requested_by = &s
 }
 
-// Answer returns the value of the "answer" field in the mutation.
-func (m *FlashcardMutation) Answer() (r string, exists bool) {
-	v := m.answer
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *DeletionJobMutation) RequestedBy() (r string, exists bool) {
+	v := m.requested_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAnswer returns the old "answer" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldRequestedBy returns the old "requested_by" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldAnswer(ctx context.Context) (v string, err error) {
+func (m *DeletionJobMutation) OldRequestedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswer requires an ID field in the mutation")
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
 	}
-	return oldValue.Answer, nil
+	return oldValue.RequestedBy, nil
 }
 
-// ResetAnswer resets all changes to the "answer" field.
-func (m *FlashcardMutation) ResetAnswer() {
-	m.answer = nil
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *DeletionJobMutation) ResetRequestedBy() {
+	m.requested_by = nil
 }
 
-// SetType sets the "type" field.
-func (m *FlashcardMutation) SetType(s string) {
-	m._type = &s
+// SetStatus sets the "status" field.
+func (m *DeletionJobMutation) SetStatus(d deletionjob.Status) {
+	m.status = &d
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *FlashcardMutation) GetType() (r string, exists bool) {
-	v := m._type
+// Status returns the value of the "status" field in the mutation.
+func (m *DeletionJobMutation) Status() (r deletionjob.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldType(ctx context.Context) (v string, err error) {
+func (m *DeletionJobMutation) OldStatus(ctx context.Context) (v deletionjob.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.Status, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *FlashcardMutation) ResetType() {
-	m._type = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *DeletionJobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCollectionID sets the "collection_id" field.
-func (m *FlashcardMutation) SetCollectionID(u uuid.UUID) {
-	m.collection = &u
+// SetTotalFlashcards sets the "total_flashcards" field.
+func (m *DeletionJobMutation) SetTotalFlashcards(i int) {
+	m.total_flashcards = &i
+	m.addtotal_flashcards = nil
 }
 
-// CollectionID returns the value of the "collection_id" field in the mutation.
-func (m *FlashcardMutation) CollectionID() (r uuid.UUID, exists bool) {
-	v := m.collection
+// TotalFlashcards returns the value of the "total_flashcards" field in the mutation.
+func (m *DeletionJobMutation) TotalFlashcards() (r int, exists bool) {
+	v := m.total_flashcards
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCollectionID returns the old "collection_id" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalFlashcards returns the old "total_flashcards" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldCollectionID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *DeletionJobMutation) OldTotalFlashcards(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalFlashcards is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCollectionID requires an ID field in the mutation")
+		return v, errors.New("OldTotalFlashcards requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalFlashcards: %w", err)
 	}
-	return oldValue.CollectionID, nil
+	return oldValue.TotalFlashcards, nil
 }
 
-// ResetCollectionID resets all changes to the "collection_id" field.
-func (m *FlashcardMutation) ResetCollectionID() {
-	m.collection = nil
+// AddTotalFlashcards adds i to the "total_flashcards" field.
+func (m *DeletionJobMutation) AddTotalFlashcards(i int) {
+	if m.addtotal_flashcards != nil {
+		*m.addtotal_flashcards += i
+	} else {
+		m.addtotal_flashcards = &i
+	}
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (m *FlashcardMutation) SetCreatedBy(s string) {
-	m.created_by = &s
+// AddedTotalFlashcards returns the value that was added to the "total_flashcards" field in this mutation.
+func (m *DeletionJobMutation) AddedTotalFlashcards() (r int, exists bool) {
+	v := m.addtotal_flashcards
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *FlashcardMutation) CreatedBy() (r string, exists bool) {
-	v := m.created_by
+// ResetTotalFlashcards resets all changes to the "total_flashcards" field.
+func (m *DeletionJobMutation) ResetTotalFlashcards() {
+	m.total_flashcards = nil
+	m.addtotal_flashcards = nil
+}
+
+// SetDeletedFlashcards sets the "deleted_flashcards" field.
+func (m *DeletionJobMutation) SetDeletedFlashcards(i int) {
+	m.deleted_flashcards = &i
+	m.adddeleted_flashcards = nil
+}
+
+// DeletedFlashcards returns the value of the "deleted_flashcards" field in the mutation.
+func (m *DeletionJobMutation) DeletedFlashcards() (r int, exists bool) {
+	v := m.deleted_flashcards
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedFlashcards returns the old "deleted_flashcards" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+func (m *DeletionJobMutation) OldDeletedFlashcards(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedFlashcards is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+		return v, errors.New("OldDeletedFlashcards requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedFlashcards: %w", err)
 	}
-	return oldValue.CreatedBy, nil
+	return oldValue.DeletedFlashcards, nil
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *FlashcardMutation) ResetCreatedBy() {
-	m.created_by = nil
+// AddDeletedFlashcards adds i to the "deleted_flashcards" field.
+func (m *DeletionJobMutation) AddDeletedFlashcards(i int) {
+	if m.adddeleted_flashcards != nil {
+		*m.adddeleted_flashcards += i
+	} else {
+		m.adddeleted_flashcards = &i
+	}
+}
+
+// AddedDeletedFlashcards returns the value that was added to the "deleted_flashcards" field in this mutation.
+func (m *DeletionJobMutation) AddedDeletedFlashcards() (r int, exists bool) {
+	v := m.adddeleted_flashcards
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeletedFlashcards resets all changes to the "deleted_flashcards" field.
+func (m *DeletionJobMutation) ResetDeletedFlashcards() {
+	m.deleted_flashcards = nil
+	m.adddeleted_flashcards = nil
+}
+
+// SetError sets the "error" field.
+func (m *DeletionJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *DeletionJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionJobMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *DeletionJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[deletionjob.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *DeletionJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[deletionjob.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *DeletionJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, deletionjob.FieldError)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FlashcardMutation) SetCreatedAt(t time.Time) {
+func (m *DeletionJobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FlashcardMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DeletionJobMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1703,10 +2333,10 @@ func (m *FlashcardMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeletionJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1721,17 +2351,17 @@ func (m *FlashcardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FlashcardMutation) ResetCreatedAt() {
+func (m *DeletionJobMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FlashcardMutation) SetUpdatedAt(t time.Time) {
+func (m *DeletionJobMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FlashcardMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *DeletionJobMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1739,10 +2369,10 @@ func (m *FlashcardMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Flashcard entity.
-// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeletionJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1757,100 +2387,68 @@ func (m *FlashcardMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FlashcardMutation) ResetUpdatedAt() {
+func (m *DeletionJobMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// ClearCollection clears the "collection" edge to the Collection entity.
-func (m *FlashcardMutation) ClearCollection() {
-	m.clearedcollection = true
-	m.clearedFields[flashcard.FieldCollectionID] = struct{}{}
-}
-
-// CollectionCleared reports if the "collection" edge to the Collection entity was cleared.
-func (m *FlashcardMutation) CollectionCleared() bool {
-	return m.clearedcollection
+// SetCompletedAt sets the "completed_at" field.
+func (m *DeletionJobMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
 }
 
-// CollectionIDs returns the "collection" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CollectionID instead. It exists only for internal usage by the builders.
-func (m *FlashcardMutation) CollectionIDs() (ids []uuid.UUID) {
-	if id := m.collection; id != nil {
-		ids = append(ids, *id)
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *DeletionJobMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetCollection resets all changes to the "collection" edge.
-func (m *FlashcardMutation) ResetCollection() {
-	m.collection = nil
-	m.clearedcollection = false
+	return *v, true
 }
 
-// AddReviewIDs adds the "reviews" edge to the FlashcardReview entity by ids.
-func (m *FlashcardMutation) AddReviewIDs(ids ...uuid.UUID) {
-	if m.reviews == nil {
-		m.reviews = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reviews[ids[i]] = struct{}{}
+// OldCompletedAt returns the old "completed_at" field's value of the DeletionJob entity.
+// If the DeletionJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeletionJobMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearReviews clears the "reviews" edge to the FlashcardReview entity.
-func (m *FlashcardMutation) ClearReviews() {
-	m.clearedreviews = true
-}
-
-// ReviewsCleared reports if the "reviews" edge to the FlashcardReview entity was cleared.
-func (m *FlashcardMutation) ReviewsCleared() bool {
-	return m.clearedreviews
-}
-
-// RemoveReviewIDs removes the "reviews" edge to the FlashcardReview entity by IDs.
-func (m *FlashcardMutation) RemoveReviewIDs(ids ...uuid.UUID) {
-	if m.removedreviews == nil {
-		m.removedreviews = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.reviews, ids[i])
-		m.removedreviews[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
 	}
+	return oldValue.CompletedAt, nil
 }
 
-// RemovedReviews returns the removed IDs of the "reviews" edge to the FlashcardReview entity.
-func (m *FlashcardMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreviews {
-		ids = append(ids, id)
-	}
-	return
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *DeletionJobMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[deletionjob.FieldCompletedAt] = struct{}{}
 }
 
-// ReviewsIDs returns the "reviews" edge IDs in the mutation.
-func (m *FlashcardMutation) ReviewsIDs() (ids []uuid.UUID) {
-	for id := range m.reviews {
-		ids = append(ids, id)
-	}
-	return
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *DeletionJobMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[deletionjob.FieldCompletedAt]
+	return ok
 }
 
-// ResetReviews resets all changes to the "reviews" edge.
-func (m *FlashcardMutation) ResetReviews() {
-	m.reviews = nil
-	m.clearedreviews = false
-	m.removedreviews = nil
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *DeletionJobMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, deletionjob.FieldCompletedAt)
 }
 
-// Where appends a list predicates to the FlashcardMutation builder.
-func (m *FlashcardMutation) Where(ps ...predicate.Flashcard) {
+// Where appends a list predicates to the DeletionJobMutation builder.
+func (m *DeletionJobMutation) Where(ps ...predicate.DeletionJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FlashcardMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeletionJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FlashcardMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Flashcard, len(ps))
+func (m *DeletionJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeletionJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1858,45 +2456,51 @@ func (m *FlashcardMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FlashcardMutation) Op() Op {
+func (m *DeletionJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FlashcardMutation) SetOp(op Op) {
+func (m *DeletionJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Flashcard).
-func (m *FlashcardMutation) Type() string {
+// Type returns the node type of this mutation (DeletionJob).
+func (m *DeletionJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FlashcardMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.question != nil {
-		fields = append(fields, flashcard.FieldQuestion)
+func (m *DeletionJobMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.collection_id != nil {
+		fields = append(fields, deletionjob.FieldCollectionID)
 	}
-	if m.answer != nil {
-		fields = append(fields, flashcard.FieldAnswer)
+	if m.requested_by != nil {
+		fields = append(fields, deletionjob.FieldRequestedBy)
 	}
-	if m._type != nil {
-		fields = append(fields, flashcard.FieldType)
+	if m.status != nil {
+		fields = append(fields, deletionjob.FieldStatus)
 	}
-	if m.collection != nil {
-		fields = append(fields, flashcard.FieldCollectionID)
+	if m.total_flashcards != nil {
+		fields = append(fields, deletionjob.FieldTotalFlashcards)
 	}
-	if m.created_by != nil {
-		fields = append(fields, flashcard.FieldCreatedBy)
+	if m.deleted_flashcards != nil {
+		fields = append(fields, deletionjob.FieldDeletedFlashcards)
+	}
+	if m.error != nil {
+		fields = append(fields, deletionjob.FieldError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, flashcard.FieldCreatedAt)
+		fields = append(fields, deletionjob.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, flashcard.FieldUpdatedAt)
+		fields = append(fields, deletionjob.FieldUpdatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, deletionjob.FieldCompletedAt)
 	}
 	return fields
 }
@@ -1904,22 +2508,26 @@ func (m *FlashcardMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FlashcardMutation) Field(name string) (ent.Value, bool) {
+func (m *DeletionJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case flashcard.FieldQuestion:
-		return m.Question()
-	case flashcard.FieldAnswer:
-		return m.Answer()
-	case flashcard.FieldType:
-		return m.GetType()
-	case flashcard.FieldCollectionID:
+	case deletionjob.FieldCollectionID:
 		return m.CollectionID()
-	case flashcard.FieldCreatedBy:
-		return m.CreatedBy()
-	case flashcard.FieldCreatedAt:
+	case deletionjob.FieldRequestedBy:
+		return m.RequestedBy()
+	case deletionjob.FieldStatus:
+		return m.Status()
+	case deletionjob.FieldTotalFlashcards:
+		return m.TotalFlashcards()
+	case deletionjob.FieldDeletedFlashcards:
+		return m.DeletedFlashcards()
+	case deletionjob.FieldError:
+		return m.Error()
+	case deletionjob.FieldCreatedAt:
 		return m.CreatedAt()
-	case flashcard.FieldUpdatedAt:
+	case deletionjob.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case deletionjob.FieldCompletedAt:
+		return m.CompletedAt()
 	}
 	return nil, false
 }
@@ -1927,177 +2535,15520 @@ func (m *FlashcardMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FlashcardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeletionJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case flashcard.FieldQuestion:
-		return m.OldQuestion(ctx)
-	case flashcard.FieldAnswer:
-		return m.OldAnswer(ctx)
-	case flashcard.FieldType:
-		return m.OldType(ctx)
-	case flashcard.FieldCollectionID:
+	case deletionjob.FieldCollectionID:
 		return m.OldCollectionID(ctx)
-	case flashcard.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case flashcard.FieldCreatedAt:
+	case deletionjob.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case deletionjob.FieldStatus:
+		return m.OldStatus(ctx)
+	case deletionjob.FieldTotalFlashcards:
+		return m.OldTotalFlashcards(ctx)
+	case deletionjob.FieldDeletedFlashcards:
+		return m.OldDeletedFlashcards(ctx)
+	case deletionjob.FieldError:
+		return m.OldError(ctx)
+	case deletionjob.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case flashcard.FieldUpdatedAt:
+	case deletionjob.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case deletionjob.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Flashcard field %s", name)
+	return nil, fmt.Errorf("unknown DeletionJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlashcardMutation) SetField(name string, value ent.Value) error {
+func (m *DeletionJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case flashcard.FieldQuestion:
+	case deletionjob.FieldCollectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionID(v)
+		return nil
+	case deletionjob.FieldRequestedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case deletionjob.FieldStatus:
+		v, ok := value.(deletionjob.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case deletionjob.FieldTotalFlashcards:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalFlashcards(v)
+		return nil
+	case deletionjob.FieldDeletedFlashcards:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedFlashcards(v)
+		return nil
+	case deletionjob.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuestion(v)
+		m.SetError(v)
+		return nil
+	case deletionjob.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case deletionjob.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case deletionjob.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionJob field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeletionJobMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_flashcards != nil {
+		fields = append(fields, deletionjob.FieldTotalFlashcards)
+	}
+	if m.adddeleted_flashcards != nil {
+		fields = append(fields, deletionjob.FieldDeletedFlashcards)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeletionJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deletionjob.FieldTotalFlashcards:
+		return m.AddedTotalFlashcards()
+	case deletionjob.FieldDeletedFlashcards:
+		return m.AddedDeletedFlashcards()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeletionJobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deletionjob.FieldTotalFlashcards:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalFlashcards(v)
+		return nil
+	case deletionjob.FieldDeletedFlashcards:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeletedFlashcards(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionJob numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeletionJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deletionjob.FieldError) {
+		fields = append(fields, deletionjob.FieldError)
+	}
+	if m.FieldCleared(deletionjob.FieldCompletedAt) {
+		fields = append(fields, deletionjob.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeletionJobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeletionJobMutation) ClearField(name string) error {
+	switch name {
+	case deletionjob.FieldError:
+		m.ClearError()
+		return nil
+	case deletionjob.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionJob nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeletionJobMutation) ResetField(name string) error {
+	switch name {
+	case deletionjob.FieldCollectionID:
+		m.ResetCollectionID()
+		return nil
+	case deletionjob.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case deletionjob.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case deletionjob.FieldTotalFlashcards:
+		m.ResetTotalFlashcards()
+		return nil
+	case deletionjob.FieldDeletedFlashcards:
+		m.ResetDeletedFlashcards()
+		return nil
+	case deletionjob.FieldError:
+		m.ResetError()
+		return nil
+	case deletionjob.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deletionjob.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case deletionjob.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DeletionJob field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeletionJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeletionJobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeletionJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeletionJobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeletionJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeletionJobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeletionJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeletionJob unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeletionJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeletionJob edge %s", name)
+}
+
+// FlashcardMutation represents an operation that mutates the Flashcard nodes in the graph.
+type FlashcardMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	question               *string
+	answer                 *string
+	_type                  *string
+	options                *[]string
+	appendoptions          []string
+	pairs                  *[]schema.MatchPair
+	appendpairs            []schema.MatchPair
+	occlusion              **schema.ImageOcclusion
+	content_format         *string
+	question_html          *string
+	answer_html            *string
+	hint                   *string
+	hint_html              *string
+	explanation            *string
+	explanation_html       *string
+	sources                *[]schema.SourceReference
+	appendsources          []schema.SourceReference
+	question_key           *string
+	question_text          *string
+	answer_text            *string
+	search_vector          *string
+	status                 *flashcard.Status
+	position               *float64
+	addposition            *float64
+	created_by             *string
+	created_at             *time.Time
+	updated_at             *time.Time
+	deleted_at             *time.Time
+	deleted_by             *string
+	clearedFields          map[string]struct{}
+	collection             *uuid.UUID
+	clearedcollection      bool
+	reviews                map[uuid.UUID]struct{}
+	removedreviews         map[uuid.UUID]struct{}
+	clearedreviews         bool
+	media                  map[uuid.UUID]struct{}
+	removedmedia           map[uuid.UUID]struct{}
+	clearedmedia           bool
+	tags                   map[uuid.UUID]struct{}
+	removedtags            map[uuid.UUID]struct{}
+	clearedtags            bool
+	revisions              map[uuid.UUID]struct{}
+	removedrevisions       map[uuid.UUID]struct{}
+	clearedrevisions       bool
+	annotations            map[uuid.UUID]struct{}
+	removedannotations     map[uuid.UUID]struct{}
+	clearedannotations     bool
+	quiz_answers           map[uuid.UUID]struct{}
+	removedquiz_answers    map[uuid.UUID]struct{}
+	clearedquiz_answers    bool
+	item_statistics        map[uuid.UUID]struct{}
+	removeditem_statistics map[uuid.UUID]struct{}
+	cleareditem_statistics bool
+	rating                 *uuid.UUID
+	clearedrating          bool
+	done                   bool
+	oldValue               func(context.Context) (*Flashcard, error)
+	predicates             []predicate.Flashcard
+}
+
+var _ ent.Mutation = (*FlashcardMutation)(nil)
+
+// flashcardOption allows management of the mutation configuration using functional options.
+type flashcardOption func(*FlashcardMutation)
+
+// newFlashcardMutation creates new mutation for the Flashcard entity.
+func newFlashcardMutation(c config, op Op, opts ...flashcardOption) *FlashcardMutation {
+	m := &FlashcardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlashcard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlashcardID sets the ID field of the mutation.
+func withFlashcardID(id uuid.UUID) flashcardOption {
+	return func(m *FlashcardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Flashcard
+		)
+		m.oldValue = func(ctx context.Context) (*Flashcard, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Flashcard.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlashcard sets the old Flashcard of the mutation.
+func withFlashcard(node *Flashcard) flashcardOption {
+	return func(m *FlashcardMutation) {
+		m.oldValue = func(context.Context) (*Flashcard, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlashcardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlashcardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Flashcard entities.
+func (m *FlashcardMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlashcardMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlashcardMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Flashcard.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuestion sets the "question" field.
+func (m *FlashcardMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *FlashcardMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *FlashcardMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *FlashcardMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *FlashcardMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *FlashcardMutation) ResetAnswer() {
+	m.answer = nil
+}
+
+// SetType sets the "type" field.
+func (m *FlashcardMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *FlashcardMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *FlashcardMutation) ResetType() {
+	m._type = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *FlashcardMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *FlashcardMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *FlashcardMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *FlashcardMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *FlashcardMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[flashcard.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *FlashcardMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *FlashcardMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, flashcard.FieldOptions)
+}
+
+// SetPairs sets the "pairs" field.
+func (m *FlashcardMutation) SetPairs(sp []schema.MatchPair) {
+	m.pairs = &sp
+	m.appendpairs = nil
+}
+
+// Pairs returns the value of the "pairs" field in the mutation.
+func (m *FlashcardMutation) Pairs() (r []schema.MatchPair, exists bool) {
+	v := m.pairs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPairs returns the old "pairs" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldPairs(ctx context.Context) (v []schema.MatchPair, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPairs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPairs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPairs: %w", err)
+	}
+	return oldValue.Pairs, nil
+}
+
+// AppendPairs adds sp to the "pairs" field.
+func (m *FlashcardMutation) AppendPairs(sp []schema.MatchPair) {
+	m.appendpairs = append(m.appendpairs, sp...)
+}
+
+// AppendedPairs returns the list of values that were appended to the "pairs" field in this mutation.
+func (m *FlashcardMutation) AppendedPairs() ([]schema.MatchPair, bool) {
+	if len(m.appendpairs) == 0 {
+		return nil, false
+	}
+	return m.appendpairs, true
+}
+
+// ClearPairs clears the value of the "pairs" field.
+func (m *FlashcardMutation) ClearPairs() {
+	m.pairs = nil
+	m.appendpairs = nil
+	m.clearedFields[flashcard.FieldPairs] = struct{}{}
+}
+
+// PairsCleared returns if the "pairs" field was cleared in this mutation.
+func (m *FlashcardMutation) PairsCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldPairs]
+	return ok
+}
+
+// ResetPairs resets all changes to the "pairs" field.
+func (m *FlashcardMutation) ResetPairs() {
+	m.pairs = nil
+	m.appendpairs = nil
+	delete(m.clearedFields, flashcard.FieldPairs)
+}
+
+// SetOcclusion sets the "occlusion" field.
+func (m *FlashcardMutation) SetOcclusion(so *schema.ImageOcclusion) {
+	m.occlusion = &so
+}
+
+// Occlusion returns the value of the "occlusion" field in the mutation.
+func (m *FlashcardMutation) Occlusion() (r *schema.ImageOcclusion, exists bool) {
+	v := m.occlusion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOcclusion returns the old "occlusion" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldOcclusion(ctx context.Context) (v *schema.ImageOcclusion, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOcclusion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOcclusion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOcclusion: %w", err)
+	}
+	return oldValue.Occlusion, nil
+}
+
+// ClearOcclusion clears the value of the "occlusion" field.
+func (m *FlashcardMutation) ClearOcclusion() {
+	m.occlusion = nil
+	m.clearedFields[flashcard.FieldOcclusion] = struct{}{}
+}
+
+// OcclusionCleared returns if the "occlusion" field was cleared in this mutation.
+func (m *FlashcardMutation) OcclusionCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldOcclusion]
+	return ok
+}
+
+// ResetOcclusion resets all changes to the "occlusion" field.
+func (m *FlashcardMutation) ResetOcclusion() {
+	m.occlusion = nil
+	delete(m.clearedFields, flashcard.FieldOcclusion)
+}
+
+// SetContentFormat sets the "content_format" field.
+func (m *FlashcardMutation) SetContentFormat(s string) {
+	m.content_format = &s
+}
+
+// ContentFormat returns the value of the "content_format" field in the mutation.
+func (m *FlashcardMutation) ContentFormat() (r string, exists bool) {
+	v := m.content_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentFormat returns the old "content_format" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldContentFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentFormat: %w", err)
+	}
+	return oldValue.ContentFormat, nil
+}
+
+// ResetContentFormat resets all changes to the "content_format" field.
+func (m *FlashcardMutation) ResetContentFormat() {
+	m.content_format = nil
+}
+
+// SetQuestionHTML sets the "question_html" field.
+func (m *FlashcardMutation) SetQuestionHTML(s string) {
+	m.question_html = &s
+}
+
+// QuestionHTML returns the value of the "question_html" field in the mutation.
+func (m *FlashcardMutation) QuestionHTML() (r string, exists bool) {
+	v := m.question_html
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionHTML returns the old "question_html" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldQuestionHTML(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionHTML is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionHTML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionHTML: %w", err)
+	}
+	return oldValue.QuestionHTML, nil
+}
+
+// ClearQuestionHTML clears the value of the "question_html" field.
+func (m *FlashcardMutation) ClearQuestionHTML() {
+	m.question_html = nil
+	m.clearedFields[flashcard.FieldQuestionHTML] = struct{}{}
+}
+
+// QuestionHTMLCleared returns if the "question_html" field was cleared in this mutation.
+func (m *FlashcardMutation) QuestionHTMLCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldQuestionHTML]
+	return ok
+}
+
+// ResetQuestionHTML resets all changes to the "question_html" field.
+func (m *FlashcardMutation) ResetQuestionHTML() {
+	m.question_html = nil
+	delete(m.clearedFields, flashcard.FieldQuestionHTML)
+}
+
+// SetAnswerHTML sets the "answer_html" field.
+func (m *FlashcardMutation) SetAnswerHTML(s string) {
+	m.answer_html = &s
+}
+
+// AnswerHTML returns the value of the "answer_html" field in the mutation.
+func (m *FlashcardMutation) AnswerHTML() (r string, exists bool) {
+	v := m.answer_html
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerHTML returns the old "answer_html" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldAnswerHTML(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerHTML is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerHTML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerHTML: %w", err)
+	}
+	return oldValue.AnswerHTML, nil
+}
+
+// ClearAnswerHTML clears the value of the "answer_html" field.
+func (m *FlashcardMutation) ClearAnswerHTML() {
+	m.answer_html = nil
+	m.clearedFields[flashcard.FieldAnswerHTML] = struct{}{}
+}
+
+// AnswerHTMLCleared returns if the "answer_html" field was cleared in this mutation.
+func (m *FlashcardMutation) AnswerHTMLCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldAnswerHTML]
+	return ok
+}
+
+// ResetAnswerHTML resets all changes to the "answer_html" field.
+func (m *FlashcardMutation) ResetAnswerHTML() {
+	m.answer_html = nil
+	delete(m.clearedFields, flashcard.FieldAnswerHTML)
+}
+
+// SetHint sets the "hint" field.
+func (m *FlashcardMutation) SetHint(s string) {
+	m.hint = &s
+}
+
+// Hint returns the value of the "hint" field in the mutation.
+func (m *FlashcardMutation) Hint() (r string, exists bool) {
+	v := m.hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHint returns the old "hint" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+	}
+	return oldValue.Hint, nil
+}
+
+// ClearHint clears the value of the "hint" field.
+func (m *FlashcardMutation) ClearHint() {
+	m.hint = nil
+	m.clearedFields[flashcard.FieldHint] = struct{}{}
+}
+
+// HintCleared returns if the "hint" field was cleared in this mutation.
+func (m *FlashcardMutation) HintCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldHint]
+	return ok
+}
+
+// ResetHint resets all changes to the "hint" field.
+func (m *FlashcardMutation) ResetHint() {
+	m.hint = nil
+	delete(m.clearedFields, flashcard.FieldHint)
+}
+
+// SetHintHTML sets the "hint_html" field.
+func (m *FlashcardMutation) SetHintHTML(s string) {
+	m.hint_html = &s
+}
+
+// HintHTML returns the value of the "hint_html" field in the mutation.
+func (m *FlashcardMutation) HintHTML() (r string, exists bool) {
+	v := m.hint_html
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHintHTML returns the old "hint_html" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldHintHTML(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHintHTML is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHintHTML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHintHTML: %w", err)
+	}
+	return oldValue.HintHTML, nil
+}
+
+// ClearHintHTML clears the value of the "hint_html" field.
+func (m *FlashcardMutation) ClearHintHTML() {
+	m.hint_html = nil
+	m.clearedFields[flashcard.FieldHintHTML] = struct{}{}
+}
+
+// HintHTMLCleared returns if the "hint_html" field was cleared in this mutation.
+func (m *FlashcardMutation) HintHTMLCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldHintHTML]
+	return ok
+}
+
+// ResetHintHTML resets all changes to the "hint_html" field.
+func (m *FlashcardMutation) ResetHintHTML() {
+	m.hint_html = nil
+	delete(m.clearedFields, flashcard.FieldHintHTML)
+}
+
+// SetExplanation sets the "explanation" field.
+func (m *FlashcardMutation) SetExplanation(s string) {
+	m.explanation = &s
+}
+
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *FlashcardMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+	}
+	return oldValue.Explanation, nil
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (m *FlashcardMutation) ClearExplanation() {
+	m.explanation = nil
+	m.clearedFields[flashcard.FieldExplanation] = struct{}{}
+}
+
+// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
+func (m *FlashcardMutation) ExplanationCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldExplanation]
+	return ok
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *FlashcardMutation) ResetExplanation() {
+	m.explanation = nil
+	delete(m.clearedFields, flashcard.FieldExplanation)
+}
+
+// SetExplanationHTML sets the "explanation_html" field.
+func (m *FlashcardMutation) SetExplanationHTML(s string) {
+	m.explanation_html = &s
+}
+
+// ExplanationHTML returns the value of the "explanation_html" field in the mutation.
+func (m *FlashcardMutation) ExplanationHTML() (r string, exists bool) {
+	v := m.explanation_html
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanationHTML returns the old "explanation_html" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldExplanationHTML(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanationHTML is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanationHTML requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanationHTML: %w", err)
+	}
+	return oldValue.ExplanationHTML, nil
+}
+
+// ClearExplanationHTML clears the value of the "explanation_html" field.
+func (m *FlashcardMutation) ClearExplanationHTML() {
+	m.explanation_html = nil
+	m.clearedFields[flashcard.FieldExplanationHTML] = struct{}{}
+}
+
+// ExplanationHTMLCleared returns if the "explanation_html" field was cleared in this mutation.
+func (m *FlashcardMutation) ExplanationHTMLCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldExplanationHTML]
+	return ok
+}
+
+// ResetExplanationHTML resets all changes to the "explanation_html" field.
+func (m *FlashcardMutation) ResetExplanationHTML() {
+	m.explanation_html = nil
+	delete(m.clearedFields, flashcard.FieldExplanationHTML)
+}
+
+// SetSources sets the "sources" field.
+func (m *FlashcardMutation) SetSources(sr []schema.SourceReference) {
+	m.sources = &sr
+	m.appendsources = nil
+}
+
+// Sources returns the value of the "sources" field in the mutation.
+func (m *FlashcardMutation) Sources() (r []schema.SourceReference, exists bool) {
+	v := m.sources
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSources returns the old "sources" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldSources(ctx context.Context) (v []schema.SourceReference, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSources is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSources requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSources: %w", err)
+	}
+	return oldValue.Sources, nil
+}
+
+// AppendSources adds sr to the "sources" field.
+func (m *FlashcardMutation) AppendSources(sr []schema.SourceReference) {
+	m.appendsources = append(m.appendsources, sr...)
+}
+
+// AppendedSources returns the list of values that were appended to the "sources" field in this mutation.
+func (m *FlashcardMutation) AppendedSources() ([]schema.SourceReference, bool) {
+	if len(m.appendsources) == 0 {
+		return nil, false
+	}
+	return m.appendsources, true
+}
+
+// ClearSources clears the value of the "sources" field.
+func (m *FlashcardMutation) ClearSources() {
+	m.sources = nil
+	m.appendsources = nil
+	m.clearedFields[flashcard.FieldSources] = struct{}{}
+}
+
+// SourcesCleared returns if the "sources" field was cleared in this mutation.
+func (m *FlashcardMutation) SourcesCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldSources]
+	return ok
+}
+
+// ResetSources resets all changes to the "sources" field.
+func (m *FlashcardMutation) ResetSources() {
+	m.sources = nil
+	m.appendsources = nil
+	delete(m.clearedFields, flashcard.FieldSources)
+}
+
+// SetQuestionKey sets the "question_key" field.
+func (m *FlashcardMutation) SetQuestionKey(s string) {
+	m.question_key = &s
+}
+
+// QuestionKey returns the value of the "question_key" field in the mutation.
+func (m *FlashcardMutation) QuestionKey() (r string, exists bool) {
+	v := m.question_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionKey returns the old "question_key" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldQuestionKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionKey: %w", err)
+	}
+	return oldValue.QuestionKey, nil
+}
+
+// ClearQuestionKey clears the value of the "question_key" field.
+func (m *FlashcardMutation) ClearQuestionKey() {
+	m.question_key = nil
+	m.clearedFields[flashcard.FieldQuestionKey] = struct{}{}
+}
+
+// QuestionKeyCleared returns if the "question_key" field was cleared in this mutation.
+func (m *FlashcardMutation) QuestionKeyCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldQuestionKey]
+	return ok
+}
+
+// ResetQuestionKey resets all changes to the "question_key" field.
+func (m *FlashcardMutation) ResetQuestionKey() {
+	m.question_key = nil
+	delete(m.clearedFields, flashcard.FieldQuestionKey)
+}
+
+// SetQuestionText sets the "question_text" field.
+func (m *FlashcardMutation) SetQuestionText(s string) {
+	m.question_text = &s
+}
+
+// QuestionText returns the value of the "question_text" field in the mutation.
+func (m *FlashcardMutation) QuestionText() (r string, exists bool) {
+	v := m.question_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionText returns the old "question_text" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldQuestionText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionText: %w", err)
+	}
+	return oldValue.QuestionText, nil
+}
+
+// ClearQuestionText clears the value of the "question_text" field.
+func (m *FlashcardMutation) ClearQuestionText() {
+	m.question_text = nil
+	m.clearedFields[flashcard.FieldQuestionText] = struct{}{}
+}
+
+// QuestionTextCleared returns if the "question_text" field was cleared in this mutation.
+func (m *FlashcardMutation) QuestionTextCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldQuestionText]
+	return ok
+}
+
+// ResetQuestionText resets all changes to the "question_text" field.
+func (m *FlashcardMutation) ResetQuestionText() {
+	m.question_text = nil
+	delete(m.clearedFields, flashcard.FieldQuestionText)
+}
+
+// SetAnswerText sets the "answer_text" field.
+func (m *FlashcardMutation) SetAnswerText(s string) {
+	m.answer_text = &s
+}
+
+// AnswerText returns the value of the "answer_text" field in the mutation.
+func (m *FlashcardMutation) AnswerText() (r string, exists bool) {
+	v := m.answer_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerText returns the old "answer_text" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldAnswerText(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerText: %w", err)
+	}
+	return oldValue.AnswerText, nil
+}
+
+// ClearAnswerText clears the value of the "answer_text" field.
+func (m *FlashcardMutation) ClearAnswerText() {
+	m.answer_text = nil
+	m.clearedFields[flashcard.FieldAnswerText] = struct{}{}
+}
+
+// AnswerTextCleared returns if the "answer_text" field was cleared in this mutation.
+func (m *FlashcardMutation) AnswerTextCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldAnswerText]
+	return ok
+}
+
+// ResetAnswerText resets all changes to the "answer_text" field.
+func (m *FlashcardMutation) ResetAnswerText() {
+	m.answer_text = nil
+	delete(m.clearedFields, flashcard.FieldAnswerText)
+}
+
+// SetSearchVector sets the "search_vector" field.
+func (m *FlashcardMutation) SetSearchVector(s string) {
+	m.search_vector = &s
+}
+
+// SearchVector returns the value of the "search_vector" field in the mutation.
+func (m *FlashcardMutation) SearchVector() (r string, exists bool) {
+	v := m.search_vector
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchVector returns the old "search_vector" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldSearchVector(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchVector is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchVector requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchVector: %w", err)
+	}
+	return oldValue.SearchVector, nil
+}
+
+// ClearSearchVector clears the value of the "search_vector" field.
+func (m *FlashcardMutation) ClearSearchVector() {
+	m.search_vector = nil
+	m.clearedFields[flashcard.FieldSearchVector] = struct{}{}
+}
+
+// SearchVectorCleared returns if the "search_vector" field was cleared in this mutation.
+func (m *FlashcardMutation) SearchVectorCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldSearchVector]
+	return ok
+}
+
+// ResetSearchVector resets all changes to the "search_vector" field.
+func (m *FlashcardMutation) ResetSearchVector() {
+	m.search_vector = nil
+	delete(m.clearedFields, flashcard.FieldSearchVector)
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (m *FlashcardMutation) SetCollectionID(u uuid.UUID) {
+	m.collection = &u
+}
+
+// CollectionID returns the value of the "collection_id" field in the mutation.
+func (m *FlashcardMutation) CollectionID() (r uuid.UUID, exists bool) {
+	v := m.collection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectionID returns the old "collection_id" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldCollectionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
+	}
+	return oldValue.CollectionID, nil
+}
+
+// ResetCollectionID resets all changes to the "collection_id" field.
+func (m *FlashcardMutation) ResetCollectionID() {
+	m.collection = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *FlashcardMutation) SetStatus(f flashcard.Status) {
+	m.status = &f
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *FlashcardMutation) Status() (r flashcard.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldStatus(ctx context.Context) (v flashcard.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *FlashcardMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *FlashcardMutation) SetPosition(f float64) {
+	m.position = &f
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *FlashcardMutation) Position() (r float64, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldPosition(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds f to the "position" field.
+func (m *FlashcardMutation) AddPosition(f float64) {
+	if m.addposition != nil {
+		*m.addposition += f
+	} else {
+		m.addposition = &f
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *FlashcardMutation) AddedPosition() (r float64, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *FlashcardMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *FlashcardMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *FlashcardMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *FlashcardMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlashcardMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlashcardMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlashcardMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FlashcardMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FlashcardMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FlashcardMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FlashcardMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FlashcardMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FlashcardMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[flashcard.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FlashcardMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FlashcardMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, flashcard.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *FlashcardMutation) SetDeletedBy(s string) {
+	m.deleted_by = &s
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *FlashcardMutation) DeletedBy() (r string, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Flashcard entity.
+// If the Flashcard object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardMutation) OldDeletedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *FlashcardMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[flashcard.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *FlashcardMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[flashcard.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *FlashcardMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, flashcard.FieldDeletedBy)
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (m *FlashcardMutation) ClearCollection() {
+	m.clearedcollection = true
+	m.clearedFields[flashcard.FieldCollectionID] = struct{}{}
+}
+
+// CollectionCleared reports if the "collection" edge to the Collection entity was cleared.
+func (m *FlashcardMutation) CollectionCleared() bool {
+	return m.clearedcollection
+}
+
+// CollectionIDs returns the "collection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CollectionID instead. It exists only for internal usage by the builders.
+func (m *FlashcardMutation) CollectionIDs() (ids []uuid.UUID) {
+	if id := m.collection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCollection resets all changes to the "collection" edge.
+func (m *FlashcardMutation) ResetCollection() {
+	m.collection = nil
+	m.clearedcollection = false
+}
+
+// AddReviewIDs adds the "reviews" edge to the FlashcardReview entity by ids.
+func (m *FlashcardMutation) AddReviewIDs(ids ...uuid.UUID) {
+	if m.reviews == nil {
+		m.reviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviews clears the "reviews" edge to the FlashcardReview entity.
+func (m *FlashcardMutation) ClearReviews() {
+	m.clearedreviews = true
+}
+
+// ReviewsCleared reports if the "reviews" edge to the FlashcardReview entity was cleared.
+func (m *FlashcardMutation) ReviewsCleared() bool {
+	return m.clearedreviews
+}
+
+// RemoveReviewIDs removes the "reviews" edge to the FlashcardReview entity by IDs.
+func (m *FlashcardMutation) RemoveReviewIDs(ids ...uuid.UUID) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviews returns the removed IDs of the "reviews" edge to the FlashcardReview entity.
+func (m *FlashcardMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *FlashcardMutation) ReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *FlashcardMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
+}
+
+// AddMediumIDs adds the "media" edge to the Media entity by ids.
+func (m *FlashcardMutation) AddMediumIDs(ids ...uuid.UUID) {
+	if m.media == nil {
+		m.media = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.media[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *FlashcardMutation) ClearMedia() {
+	m.clearedmedia = true
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *FlashcardMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// RemoveMediumIDs removes the "media" edge to the Media entity by IDs.
+func (m *FlashcardMutation) RemoveMediumIDs(ids ...uuid.UUID) {
+	if m.removedmedia == nil {
+		m.removedmedia = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.media, ids[i])
+		m.removedmedia[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMedia returns the removed IDs of the "media" edge to the Media entity.
+func (m *FlashcardMutation) RemovedMediaIDs() (ids []uuid.UUID) {
+	for id := range m.removedmedia {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+func (m *FlashcardMutation) MediaIDs() (ids []uuid.UUID) {
+	for id := range m.media {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *FlashcardMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+	m.removedmedia = nil
+}
+
+// AddTagIDs adds the "tags" edge to the FlashcardTag entity by ids.
+func (m *FlashcardMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the FlashcardTag entity.
+func (m *FlashcardMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the FlashcardTag entity was cleared.
+func (m *FlashcardMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the FlashcardTag entity by IDs.
+func (m *FlashcardMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the FlashcardTag entity.
+func (m *FlashcardMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *FlashcardMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *FlashcardMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the FlashcardRevision entity by ids.
+func (m *FlashcardMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the FlashcardRevision entity.
+func (m *FlashcardMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the FlashcardRevision entity was cleared.
+func (m *FlashcardMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the FlashcardRevision entity by IDs.
+func (m *FlashcardMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the FlashcardRevision entity.
+func (m *FlashcardMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *FlashcardMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *FlashcardMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddAnnotationIDs adds the "annotations" edge to the FlashcardAnnotation entity by ids.
+func (m *FlashcardMutation) AddAnnotationIDs(ids ...uuid.UUID) {
+	if m.annotations == nil {
+		m.annotations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.annotations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnnotations clears the "annotations" edge to the FlashcardAnnotation entity.
+func (m *FlashcardMutation) ClearAnnotations() {
+	m.clearedannotations = true
+}
+
+// AnnotationsCleared reports if the "annotations" edge to the FlashcardAnnotation entity was cleared.
+func (m *FlashcardMutation) AnnotationsCleared() bool {
+	return m.clearedannotations
+}
+
+// RemoveAnnotationIDs removes the "annotations" edge to the FlashcardAnnotation entity by IDs.
+func (m *FlashcardMutation) RemoveAnnotationIDs(ids ...uuid.UUID) {
+	if m.removedannotations == nil {
+		m.removedannotations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.annotations, ids[i])
+		m.removedannotations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnnotations returns the removed IDs of the "annotations" edge to the FlashcardAnnotation entity.
+func (m *FlashcardMutation) RemovedAnnotationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedannotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnnotationsIDs returns the "annotations" edge IDs in the mutation.
+func (m *FlashcardMutation) AnnotationsIDs() (ids []uuid.UUID) {
+	for id := range m.annotations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnnotations resets all changes to the "annotations" edge.
+func (m *FlashcardMutation) ResetAnnotations() {
+	m.annotations = nil
+	m.clearedannotations = false
+	m.removedannotations = nil
+}
+
+// AddQuizAnswerIDs adds the "quiz_answers" edge to the QuizAnswer entity by ids.
+func (m *FlashcardMutation) AddQuizAnswerIDs(ids ...uuid.UUID) {
+	if m.quiz_answers == nil {
+		m.quiz_answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.quiz_answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuizAnswers clears the "quiz_answers" edge to the QuizAnswer entity.
+func (m *FlashcardMutation) ClearQuizAnswers() {
+	m.clearedquiz_answers = true
+}
+
+// QuizAnswersCleared reports if the "quiz_answers" edge to the QuizAnswer entity was cleared.
+func (m *FlashcardMutation) QuizAnswersCleared() bool {
+	return m.clearedquiz_answers
+}
+
+// RemoveQuizAnswerIDs removes the "quiz_answers" edge to the QuizAnswer entity by IDs.
+func (m *FlashcardMutation) RemoveQuizAnswerIDs(ids ...uuid.UUID) {
+	if m.removedquiz_answers == nil {
+		m.removedquiz_answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.quiz_answers, ids[i])
+		m.removedquiz_answers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizAnswers returns the removed IDs of the "quiz_answers" edge to the QuizAnswer entity.
+func (m *FlashcardMutation) RemovedQuizAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.removedquiz_answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizAnswersIDs returns the "quiz_answers" edge IDs in the mutation.
+func (m *FlashcardMutation) QuizAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.quiz_answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizAnswers resets all changes to the "quiz_answers" edge.
+func (m *FlashcardMutation) ResetQuizAnswers() {
+	m.quiz_answers = nil
+	m.clearedquiz_answers = false
+	m.removedquiz_answers = nil
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by ids.
+func (m *FlashcardMutation) AddItemStatisticIDs(ids ...uuid.UUID) {
+	if m.item_statistics == nil {
+		m.item_statistics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.item_statistics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItemStatistics clears the "item_statistics" edge to the ItemStatistic entity.
+func (m *FlashcardMutation) ClearItemStatistics() {
+	m.cleareditem_statistics = true
+}
+
+// ItemStatisticsCleared reports if the "item_statistics" edge to the ItemStatistic entity was cleared.
+func (m *FlashcardMutation) ItemStatisticsCleared() bool {
+	return m.cleareditem_statistics
+}
+
+// RemoveItemStatisticIDs removes the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (m *FlashcardMutation) RemoveItemStatisticIDs(ids ...uuid.UUID) {
+	if m.removeditem_statistics == nil {
+		m.removeditem_statistics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.item_statistics, ids[i])
+		m.removeditem_statistics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItemStatistics returns the removed IDs of the "item_statistics" edge to the ItemStatistic entity.
+func (m *FlashcardMutation) RemovedItemStatisticsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditem_statistics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemStatisticsIDs returns the "item_statistics" edge IDs in the mutation.
+func (m *FlashcardMutation) ItemStatisticsIDs() (ids []uuid.UUID) {
+	for id := range m.item_statistics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemStatistics resets all changes to the "item_statistics" edge.
+func (m *FlashcardMutation) ResetItemStatistics() {
+	m.item_statistics = nil
+	m.cleareditem_statistics = false
+	m.removeditem_statistics = nil
+}
+
+// SetRatingID sets the "rating" edge to the FlashcardRating entity by id.
+func (m *FlashcardMutation) SetRatingID(id uuid.UUID) {
+	m.rating = &id
+}
+
+// ClearRating clears the "rating" edge to the FlashcardRating entity.
+func (m *FlashcardMutation) ClearRating() {
+	m.clearedrating = true
+}
+
+// RatingCleared reports if the "rating" edge to the FlashcardRating entity was cleared.
+func (m *FlashcardMutation) RatingCleared() bool {
+	return m.clearedrating
+}
+
+// RatingID returns the "rating" edge ID in the mutation.
+func (m *FlashcardMutation) RatingID() (id uuid.UUID, exists bool) {
+	if m.rating != nil {
+		return *m.rating, true
+	}
+	return
+}
+
+// RatingIDs returns the "rating" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RatingID instead. It exists only for internal usage by the builders.
+func (m *FlashcardMutation) RatingIDs() (ids []uuid.UUID) {
+	if id := m.rating; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRating resets all changes to the "rating" edge.
+func (m *FlashcardMutation) ResetRating() {
+	m.rating = nil
+	m.clearedrating = false
+}
+
+// Where appends a list predicates to the FlashcardMutation builder.
+func (m *FlashcardMutation) Where(ps ...predicate.Flashcard) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlashcardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlashcardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Flashcard, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlashcardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlashcardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Flashcard).
+func (m *FlashcardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlashcardMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.question != nil {
+		fields = append(fields, flashcard.FieldQuestion)
+	}
+	if m.answer != nil {
+		fields = append(fields, flashcard.FieldAnswer)
+	}
+	if m._type != nil {
+		fields = append(fields, flashcard.FieldType)
+	}
+	if m.options != nil {
+		fields = append(fields, flashcard.FieldOptions)
+	}
+	if m.pairs != nil {
+		fields = append(fields, flashcard.FieldPairs)
+	}
+	if m.occlusion != nil {
+		fields = append(fields, flashcard.FieldOcclusion)
+	}
+	if m.content_format != nil {
+		fields = append(fields, flashcard.FieldContentFormat)
+	}
+	if m.question_html != nil {
+		fields = append(fields, flashcard.FieldQuestionHTML)
+	}
+	if m.answer_html != nil {
+		fields = append(fields, flashcard.FieldAnswerHTML)
+	}
+	if m.hint != nil {
+		fields = append(fields, flashcard.FieldHint)
+	}
+	if m.hint_html != nil {
+		fields = append(fields, flashcard.FieldHintHTML)
+	}
+	if m.explanation != nil {
+		fields = append(fields, flashcard.FieldExplanation)
+	}
+	if m.explanation_html != nil {
+		fields = append(fields, flashcard.FieldExplanationHTML)
+	}
+	if m.sources != nil {
+		fields = append(fields, flashcard.FieldSources)
+	}
+	if m.question_key != nil {
+		fields = append(fields, flashcard.FieldQuestionKey)
+	}
+	if m.question_text != nil {
+		fields = append(fields, flashcard.FieldQuestionText)
+	}
+	if m.answer_text != nil {
+		fields = append(fields, flashcard.FieldAnswerText)
+	}
+	if m.search_vector != nil {
+		fields = append(fields, flashcard.FieldSearchVector)
+	}
+	if m.collection != nil {
+		fields = append(fields, flashcard.FieldCollectionID)
+	}
+	if m.status != nil {
+		fields = append(fields, flashcard.FieldStatus)
+	}
+	if m.position != nil {
+		fields = append(fields, flashcard.FieldPosition)
+	}
+	if m.created_by != nil {
+		fields = append(fields, flashcard.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flashcard.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, flashcard.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, flashcard.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, flashcard.FieldDeletedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlashcardMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flashcard.FieldQuestion:
+		return m.Question()
+	case flashcard.FieldAnswer:
+		return m.Answer()
+	case flashcard.FieldType:
+		return m.GetType()
+	case flashcard.FieldOptions:
+		return m.Options()
+	case flashcard.FieldPairs:
+		return m.Pairs()
+	case flashcard.FieldOcclusion:
+		return m.Occlusion()
+	case flashcard.FieldContentFormat:
+		return m.ContentFormat()
+	case flashcard.FieldQuestionHTML:
+		return m.QuestionHTML()
+	case flashcard.FieldAnswerHTML:
+		return m.AnswerHTML()
+	case flashcard.FieldHint:
+		return m.Hint()
+	case flashcard.FieldHintHTML:
+		return m.HintHTML()
+	case flashcard.FieldExplanation:
+		return m.Explanation()
+	case flashcard.FieldExplanationHTML:
+		return m.ExplanationHTML()
+	case flashcard.FieldSources:
+		return m.Sources()
+	case flashcard.FieldQuestionKey:
+		return m.QuestionKey()
+	case flashcard.FieldQuestionText:
+		return m.QuestionText()
+	case flashcard.FieldAnswerText:
+		return m.AnswerText()
+	case flashcard.FieldSearchVector:
+		return m.SearchVector()
+	case flashcard.FieldCollectionID:
+		return m.CollectionID()
+	case flashcard.FieldStatus:
+		return m.Status()
+	case flashcard.FieldPosition:
+		return m.Position()
+	case flashcard.FieldCreatedBy:
+		return m.CreatedBy()
+	case flashcard.FieldCreatedAt:
+		return m.CreatedAt()
+	case flashcard.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case flashcard.FieldDeletedAt:
+		return m.DeletedAt()
+	case flashcard.FieldDeletedBy:
+		return m.DeletedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlashcardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flashcard.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case flashcard.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case flashcard.FieldType:
+		return m.OldType(ctx)
+	case flashcard.FieldOptions:
+		return m.OldOptions(ctx)
+	case flashcard.FieldPairs:
+		return m.OldPairs(ctx)
+	case flashcard.FieldOcclusion:
+		return m.OldOcclusion(ctx)
+	case flashcard.FieldContentFormat:
+		return m.OldContentFormat(ctx)
+	case flashcard.FieldQuestionHTML:
+		return m.OldQuestionHTML(ctx)
+	case flashcard.FieldAnswerHTML:
+		return m.OldAnswerHTML(ctx)
+	case flashcard.FieldHint:
+		return m.OldHint(ctx)
+	case flashcard.FieldHintHTML:
+		return m.OldHintHTML(ctx)
+	case flashcard.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case flashcard.FieldExplanationHTML:
+		return m.OldExplanationHTML(ctx)
+	case flashcard.FieldSources:
+		return m.OldSources(ctx)
+	case flashcard.FieldQuestionKey:
+		return m.OldQuestionKey(ctx)
+	case flashcard.FieldQuestionText:
+		return m.OldQuestionText(ctx)
+	case flashcard.FieldAnswerText:
+		return m.OldAnswerText(ctx)
+	case flashcard.FieldSearchVector:
+		return m.OldSearchVector(ctx)
+	case flashcard.FieldCollectionID:
+		return m.OldCollectionID(ctx)
+	case flashcard.FieldStatus:
+		return m.OldStatus(ctx)
+	case flashcard.FieldPosition:
+		return m.OldPosition(ctx)
+	case flashcard.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case flashcard.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case flashcard.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case flashcard.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case flashcard.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown Flashcard field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flashcard.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case flashcard.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case flashcard.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case flashcard.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case flashcard.FieldPairs:
+		v, ok := value.([]schema.MatchPair)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPairs(v)
+		return nil
+	case flashcard.FieldOcclusion:
+		v, ok := value.(*schema.ImageOcclusion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOcclusion(v)
+		return nil
+	case flashcard.FieldContentFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentFormat(v)
+		return nil
+	case flashcard.FieldQuestionHTML:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionHTML(v)
+		return nil
+	case flashcard.FieldAnswerHTML:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerHTML(v)
+		return nil
+	case flashcard.FieldHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHint(v)
+		return nil
+	case flashcard.FieldHintHTML:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHintHTML(v)
+		return nil
+	case flashcard.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case flashcard.FieldExplanationHTML:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanationHTML(v)
+		return nil
+	case flashcard.FieldSources:
+		v, ok := value.([]schema.SourceReference)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSources(v)
+		return nil
+	case flashcard.FieldQuestionKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionKey(v)
+		return nil
+	case flashcard.FieldQuestionText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionText(v)
+		return nil
+	case flashcard.FieldAnswerText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerText(v)
+		return nil
+	case flashcard.FieldSearchVector:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchVector(v)
+		return nil
+	case flashcard.FieldCollectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionID(v)
+		return nil
+	case flashcard.FieldStatus:
+		v, ok := value.(flashcard.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case flashcard.FieldPosition:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case flashcard.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case flashcard.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case flashcard.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case flashcard.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case flashcard.FieldDeletedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Flashcard field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlashcardMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, flashcard.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlashcardMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case flashcard.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case flashcard.FieldPosition:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Flashcard numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlashcardMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(flashcard.FieldOptions) {
+		fields = append(fields, flashcard.FieldOptions)
+	}
+	if m.FieldCleared(flashcard.FieldPairs) {
+		fields = append(fields, flashcard.FieldPairs)
+	}
+	if m.FieldCleared(flashcard.FieldOcclusion) {
+		fields = append(fields, flashcard.FieldOcclusion)
+	}
+	if m.FieldCleared(flashcard.FieldQuestionHTML) {
+		fields = append(fields, flashcard.FieldQuestionHTML)
+	}
+	if m.FieldCleared(flashcard.FieldAnswerHTML) {
+		fields = append(fields, flashcard.FieldAnswerHTML)
+	}
+	if m.FieldCleared(flashcard.FieldHint) {
+		fields = append(fields, flashcard.FieldHint)
+	}
+	if m.FieldCleared(flashcard.FieldHintHTML) {
+		fields = append(fields, flashcard.FieldHintHTML)
+	}
+	if m.FieldCleared(flashcard.FieldExplanation) {
+		fields = append(fields, flashcard.FieldExplanation)
+	}
+	if m.FieldCleared(flashcard.FieldExplanationHTML) {
+		fields = append(fields, flashcard.FieldExplanationHTML)
+	}
+	if m.FieldCleared(flashcard.FieldSources) {
+		fields = append(fields, flashcard.FieldSources)
+	}
+	if m.FieldCleared(flashcard.FieldQuestionKey) {
+		fields = append(fields, flashcard.FieldQuestionKey)
+	}
+	if m.FieldCleared(flashcard.FieldQuestionText) {
+		fields = append(fields, flashcard.FieldQuestionText)
+	}
+	if m.FieldCleared(flashcard.FieldAnswerText) {
+		fields = append(fields, flashcard.FieldAnswerText)
+	}
+	if m.FieldCleared(flashcard.FieldSearchVector) {
+		fields = append(fields, flashcard.FieldSearchVector)
+	}
+	if m.FieldCleared(flashcard.FieldDeletedAt) {
+		fields = append(fields, flashcard.FieldDeletedAt)
+	}
+	if m.FieldCleared(flashcard.FieldDeletedBy) {
+		fields = append(fields, flashcard.FieldDeletedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlashcardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlashcardMutation) ClearField(name string) error {
+	switch name {
+	case flashcard.FieldOptions:
+		m.ClearOptions()
+		return nil
+	case flashcard.FieldPairs:
+		m.ClearPairs()
+		return nil
+	case flashcard.FieldOcclusion:
+		m.ClearOcclusion()
+		return nil
+	case flashcard.FieldQuestionHTML:
+		m.ClearQuestionHTML()
+		return nil
+	case flashcard.FieldAnswerHTML:
+		m.ClearAnswerHTML()
+		return nil
+	case flashcard.FieldHint:
+		m.ClearHint()
+		return nil
+	case flashcard.FieldHintHTML:
+		m.ClearHintHTML()
+		return nil
+	case flashcard.FieldExplanation:
+		m.ClearExplanation()
+		return nil
+	case flashcard.FieldExplanationHTML:
+		m.ClearExplanationHTML()
+		return nil
+	case flashcard.FieldSources:
+		m.ClearSources()
+		return nil
+	case flashcard.FieldQuestionKey:
+		m.ClearQuestionKey()
+		return nil
+	case flashcard.FieldQuestionText:
+		m.ClearQuestionText()
+		return nil
+	case flashcard.FieldAnswerText:
+		m.ClearAnswerText()
+		return nil
+	case flashcard.FieldSearchVector:
+		m.ClearSearchVector()
+		return nil
+	case flashcard.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case flashcard.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Flashcard nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlashcardMutation) ResetField(name string) error {
+	switch name {
+	case flashcard.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case flashcard.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case flashcard.FieldType:
+		m.ResetType()
+		return nil
+	case flashcard.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case flashcard.FieldPairs:
+		m.ResetPairs()
+		return nil
+	case flashcard.FieldOcclusion:
+		m.ResetOcclusion()
+		return nil
+	case flashcard.FieldContentFormat:
+		m.ResetContentFormat()
+		return nil
+	case flashcard.FieldQuestionHTML:
+		m.ResetQuestionHTML()
+		return nil
+	case flashcard.FieldAnswerHTML:
+		m.ResetAnswerHTML()
+		return nil
+	case flashcard.FieldHint:
+		m.ResetHint()
+		return nil
+	case flashcard.FieldHintHTML:
+		m.ResetHintHTML()
+		return nil
+	case flashcard.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case flashcard.FieldExplanationHTML:
+		m.ResetExplanationHTML()
+		return nil
+	case flashcard.FieldSources:
+		m.ResetSources()
+		return nil
+	case flashcard.FieldQuestionKey:
+		m.ResetQuestionKey()
+		return nil
+	case flashcard.FieldQuestionText:
+		m.ResetQuestionText()
+		return nil
+	case flashcard.FieldAnswerText:
+		m.ResetAnswerText()
+		return nil
+	case flashcard.FieldSearchVector:
+		m.ResetSearchVector()
+		return nil
+	case flashcard.FieldCollectionID:
+		m.ResetCollectionID()
+		return nil
+	case flashcard.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case flashcard.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case flashcard.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case flashcard.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case flashcard.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case flashcard.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case flashcard.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown Flashcard field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlashcardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.collection != nil {
+		edges = append(edges, flashcard.EdgeCollection)
+	}
+	if m.reviews != nil {
+		edges = append(edges, flashcard.EdgeReviews)
+	}
+	if m.media != nil {
+		edges = append(edges, flashcard.EdgeMedia)
+	}
+	if m.tags != nil {
+		edges = append(edges, flashcard.EdgeTags)
+	}
+	if m.revisions != nil {
+		edges = append(edges, flashcard.EdgeRevisions)
+	}
+	if m.annotations != nil {
+		edges = append(edges, flashcard.EdgeAnnotations)
+	}
+	if m.quiz_answers != nil {
+		edges = append(edges, flashcard.EdgeQuizAnswers)
+	}
+	if m.item_statistics != nil {
+		edges = append(edges, flashcard.EdgeItemStatistics)
+	}
+	if m.rating != nil {
+		edges = append(edges, flashcard.EdgeRating)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlashcardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flashcard.EdgeCollection:
+		if id := m.collection; id != nil {
+			return []ent.Value{*id}
+		}
+	case flashcard.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.media))
+		for id := range m.media {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.annotations))
+		for id := range m.annotations {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeQuizAnswers:
+		ids := make([]ent.Value, 0, len(m.quiz_answers))
+		for id := range m.quiz_answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeItemStatistics:
+		ids := make([]ent.Value, 0, len(m.item_statistics))
+		for id := range m.item_statistics {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeRating:
+		if id := m.rating; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlashcardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedreviews != nil {
+		edges = append(edges, flashcard.EdgeReviews)
+	}
+	if m.removedmedia != nil {
+		edges = append(edges, flashcard.EdgeMedia)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, flashcard.EdgeTags)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, flashcard.EdgeRevisions)
+	}
+	if m.removedannotations != nil {
+		edges = append(edges, flashcard.EdgeAnnotations)
+	}
+	if m.removedquiz_answers != nil {
+		edges = append(edges, flashcard.EdgeQuizAnswers)
+	}
+	if m.removeditem_statistics != nil {
+		edges = append(edges, flashcard.EdgeItemStatistics)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlashcardMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case flashcard.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeMedia:
+		ids := make([]ent.Value, 0, len(m.removedmedia))
+		for id := range m.removedmedia {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeAnnotations:
+		ids := make([]ent.Value, 0, len(m.removedannotations))
+		for id := range m.removedannotations {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeQuizAnswers:
+		ids := make([]ent.Value, 0, len(m.removedquiz_answers))
+		for id := range m.removedquiz_answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case flashcard.EdgeItemStatistics:
+		ids := make([]ent.Value, 0, len(m.removeditem_statistics))
+		for id := range m.removeditem_statistics {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlashcardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedcollection {
+		edges = append(edges, flashcard.EdgeCollection)
+	}
+	if m.clearedreviews {
+		edges = append(edges, flashcard.EdgeReviews)
+	}
+	if m.clearedmedia {
+		edges = append(edges, flashcard.EdgeMedia)
+	}
+	if m.clearedtags {
+		edges = append(edges, flashcard.EdgeTags)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, flashcard.EdgeRevisions)
+	}
+	if m.clearedannotations {
+		edges = append(edges, flashcard.EdgeAnnotations)
+	}
+	if m.clearedquiz_answers {
+		edges = append(edges, flashcard.EdgeQuizAnswers)
+	}
+	if m.cleareditem_statistics {
+		edges = append(edges, flashcard.EdgeItemStatistics)
+	}
+	if m.clearedrating {
+		edges = append(edges, flashcard.EdgeRating)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlashcardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flashcard.EdgeCollection:
+		return m.clearedcollection
+	case flashcard.EdgeReviews:
+		return m.clearedreviews
+	case flashcard.EdgeMedia:
+		return m.clearedmedia
+	case flashcard.EdgeTags:
+		return m.clearedtags
+	case flashcard.EdgeRevisions:
+		return m.clearedrevisions
+	case flashcard.EdgeAnnotations:
+		return m.clearedannotations
+	case flashcard.EdgeQuizAnswers:
+		return m.clearedquiz_answers
+	case flashcard.EdgeItemStatistics:
+		return m.cleareditem_statistics
+	case flashcard.EdgeRating:
+		return m.clearedrating
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlashcardMutation) ClearEdge(name string) error {
+	switch name {
+	case flashcard.EdgeCollection:
+		m.ClearCollection()
+		return nil
+	case flashcard.EdgeRating:
+		m.ClearRating()
+		return nil
+	}
+	return fmt.Errorf("unknown Flashcard unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlashcardMutation) ResetEdge(name string) error {
+	switch name {
+	case flashcard.EdgeCollection:
+		m.ResetCollection()
+		return nil
+	case flashcard.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	case flashcard.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	case flashcard.EdgeTags:
+		m.ResetTags()
+		return nil
+	case flashcard.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case flashcard.EdgeAnnotations:
+		m.ResetAnnotations()
+		return nil
+	case flashcard.EdgeQuizAnswers:
+		m.ResetQuizAnswers()
+		return nil
+	case flashcard.EdgeItemStatistics:
+		m.ResetItemStatistics()
+		return nil
+	case flashcard.EdgeRating:
+		m.ResetRating()
+		return nil
+	}
+	return fmt.Errorf("unknown Flashcard edge %s", name)
+}
+
+// FlashcardAnnotationMutation represents an operation that mutates the FlashcardAnnotation nodes in the graph.
+type FlashcardAnnotationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	user_id          *string
+	note             *string
+	flag             *flashcardannotation.Flag
+	bookmarked       *bool
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	flashcard        *uuid.UUID
+	clearedflashcard bool
+	done             bool
+	oldValue         func(context.Context) (*FlashcardAnnotation, error)
+	predicates       []predicate.FlashcardAnnotation
+}
+
+var _ ent.Mutation = (*FlashcardAnnotationMutation)(nil)
+
+// flashcardannotationOption allows management of the mutation configuration using functional options.
+type flashcardannotationOption func(*FlashcardAnnotationMutation)
+
+// newFlashcardAnnotationMutation creates new mutation for the FlashcardAnnotation entity.
+func newFlashcardAnnotationMutation(c config, op Op, opts ...flashcardannotationOption) *FlashcardAnnotationMutation {
+	m := &FlashcardAnnotationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlashcardAnnotation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlashcardAnnotationID sets the ID field of the mutation.
+func withFlashcardAnnotationID(id uuid.UUID) flashcardannotationOption {
+	return func(m *FlashcardAnnotationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlashcardAnnotation
+		)
+		m.oldValue = func(ctx context.Context) (*FlashcardAnnotation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlashcardAnnotation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlashcardAnnotation sets the old FlashcardAnnotation of the mutation.
+func withFlashcardAnnotation(node *FlashcardAnnotation) flashcardannotationOption {
+	return func(m *FlashcardAnnotationMutation) {
+		m.oldValue = func(context.Context) (*FlashcardAnnotation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlashcardAnnotationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlashcardAnnotationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FlashcardAnnotation entities.
+func (m *FlashcardAnnotationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlashcardAnnotationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlashcardAnnotationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlashcardAnnotation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FlashcardAnnotationMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FlashcardAnnotationMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FlashcardAnnotationMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *FlashcardAnnotationMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *FlashcardAnnotationMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *FlashcardAnnotationMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetNote sets the "note" field.
+func (m *FlashcardAnnotationMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *FlashcardAnnotationMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *FlashcardAnnotationMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[flashcardannotation.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *FlashcardAnnotationMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[flashcardannotation.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *FlashcardAnnotationMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, flashcardannotation.FieldNote)
+}
+
+// SetFlag sets the "flag" field.
+func (m *FlashcardAnnotationMutation) SetFlag(f flashcardannotation.Flag) {
+	m.flag = &f
+}
+
+// Flag returns the value of the "flag" field in the mutation.
+func (m *FlashcardAnnotationMutation) Flag() (r flashcardannotation.Flag, exists bool) {
+	v := m.flag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlag returns the old "flag" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldFlag(ctx context.Context) (v *flashcardannotation.Flag, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlag: %w", err)
+	}
+	return oldValue.Flag, nil
+}
+
+// ClearFlag clears the value of the "flag" field.
+func (m *FlashcardAnnotationMutation) ClearFlag() {
+	m.flag = nil
+	m.clearedFields[flashcardannotation.FieldFlag] = struct{}{}
+}
+
+// FlagCleared returns if the "flag" field was cleared in this mutation.
+func (m *FlashcardAnnotationMutation) FlagCleared() bool {
+	_, ok := m.clearedFields[flashcardannotation.FieldFlag]
+	return ok
+}
+
+// ResetFlag resets all changes to the "flag" field.
+func (m *FlashcardAnnotationMutation) ResetFlag() {
+	m.flag = nil
+	delete(m.clearedFields, flashcardannotation.FieldFlag)
+}
+
+// SetBookmarked sets the "bookmarked" field.
+func (m *FlashcardAnnotationMutation) SetBookmarked(b bool) {
+	m.bookmarked = &b
+}
+
+// Bookmarked returns the value of the "bookmarked" field in the mutation.
+func (m *FlashcardAnnotationMutation) Bookmarked() (r bool, exists bool) {
+	v := m.bookmarked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookmarked returns the old "bookmarked" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldBookmarked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBookmarked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBookmarked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookmarked: %w", err)
+	}
+	return oldValue.Bookmarked, nil
+}
+
+// ResetBookmarked resets all changes to the "bookmarked" field.
+func (m *FlashcardAnnotationMutation) ResetBookmarked() {
+	m.bookmarked = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlashcardAnnotationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlashcardAnnotationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlashcardAnnotationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FlashcardAnnotationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FlashcardAnnotationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FlashcardAnnotation entity.
+// If the FlashcardAnnotation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardAnnotationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FlashcardAnnotationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *FlashcardAnnotationMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[flashcardannotation.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *FlashcardAnnotationMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *FlashcardAnnotationMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *FlashcardAnnotationMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// Where appends a list predicates to the FlashcardAnnotationMutation builder.
+func (m *FlashcardAnnotationMutation) Where(ps ...predicate.FlashcardAnnotation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlashcardAnnotationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlashcardAnnotationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlashcardAnnotation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlashcardAnnotationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlashcardAnnotationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlashcardAnnotation).
+func (m *FlashcardAnnotationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlashcardAnnotationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user_id != nil {
+		fields = append(fields, flashcardannotation.FieldUserID)
+	}
+	if m.flashcard != nil {
+		fields = append(fields, flashcardannotation.FieldFlashcardID)
+	}
+	if m.note != nil {
+		fields = append(fields, flashcardannotation.FieldNote)
+	}
+	if m.flag != nil {
+		fields = append(fields, flashcardannotation.FieldFlag)
+	}
+	if m.bookmarked != nil {
+		fields = append(fields, flashcardannotation.FieldBookmarked)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flashcardannotation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, flashcardannotation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlashcardAnnotationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardannotation.FieldUserID:
+		return m.UserID()
+	case flashcardannotation.FieldFlashcardID:
+		return m.FlashcardID()
+	case flashcardannotation.FieldNote:
+		return m.Note()
+	case flashcardannotation.FieldFlag:
+		return m.Flag()
+	case flashcardannotation.FieldBookmarked:
+		return m.Bookmarked()
+	case flashcardannotation.FieldCreatedAt:
+		return m.CreatedAt()
+	case flashcardannotation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlashcardAnnotationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flashcardannotation.FieldUserID:
+		return m.OldUserID(ctx)
+	case flashcardannotation.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case flashcardannotation.FieldNote:
+		return m.OldNote(ctx)
+	case flashcardannotation.FieldFlag:
+		return m.OldFlag(ctx)
+	case flashcardannotation.FieldBookmarked:
+		return m.OldBookmarked(ctx)
+	case flashcardannotation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case flashcardannotation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlashcardAnnotation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardAnnotationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flashcardannotation.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case flashcardannotation.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case flashcardannotation.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case flashcardannotation.FieldFlag:
+		v, ok := value.(flashcardannotation.Flag)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlag(v)
+		return nil
+	case flashcardannotation.FieldBookmarked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookmarked(v)
+		return nil
+	case flashcardannotation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case flashcardannotation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardAnnotation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlashcardAnnotationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlashcardAnnotationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardAnnotationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FlashcardAnnotation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlashcardAnnotationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(flashcardannotation.FieldNote) {
+		fields = append(fields, flashcardannotation.FieldNote)
+	}
+	if m.FieldCleared(flashcardannotation.FieldFlag) {
+		fields = append(fields, flashcardannotation.FieldFlag)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlashcardAnnotationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlashcardAnnotationMutation) ClearField(name string) error {
+	switch name {
+	case flashcardannotation.FieldNote:
+		m.ClearNote()
+		return nil
+	case flashcardannotation.FieldFlag:
+		m.ClearFlag()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardAnnotation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlashcardAnnotationMutation) ResetField(name string) error {
+	switch name {
+	case flashcardannotation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case flashcardannotation.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case flashcardannotation.FieldNote:
+		m.ResetNote()
+		return nil
+	case flashcardannotation.FieldFlag:
+		m.ResetFlag()
+		return nil
+	case flashcardannotation.FieldBookmarked:
+		m.ResetBookmarked()
+		return nil
+	case flashcardannotation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case flashcardannotation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardAnnotation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlashcardAnnotationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.flashcard != nil {
+		edges = append(edges, flashcardannotation.EdgeFlashcard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlashcardAnnotationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flashcardannotation.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlashcardAnnotationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlashcardAnnotationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlashcardAnnotationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedflashcard {
+		edges = append(edges, flashcardannotation.EdgeFlashcard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlashcardAnnotationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flashcardannotation.EdgeFlashcard:
+		return m.clearedflashcard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlashcardAnnotationMutation) ClearEdge(name string) error {
+	switch name {
+	case flashcardannotation.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardAnnotation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlashcardAnnotationMutation) ResetEdge(name string) error {
+	switch name {
+	case flashcardannotation.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardAnnotation edge %s", name)
+}
+
+// FlashcardRatingMutation represents an operation that mutates the FlashcardRating nodes in the graph.
+type FlashcardRatingMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	difficulty       *float64
+	adddifficulty    *float64
+	answer_count     *int
+	addanswer_count  *int
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	flashcard        *uuid.UUID
+	clearedflashcard bool
+	done             bool
+	oldValue         func(context.Context) (*FlashcardRating, error)
+	predicates       []predicate.FlashcardRating
+}
+
+var _ ent.Mutation = (*FlashcardRatingMutation)(nil)
+
+// flashcardratingOption allows management of the mutation configuration using functional options.
+type flashcardratingOption func(*FlashcardRatingMutation)
+
+// newFlashcardRatingMutation creates new mutation for the FlashcardRating entity.
+func newFlashcardRatingMutation(c config, op Op, opts ...flashcardratingOption) *FlashcardRatingMutation {
+	m := &FlashcardRatingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlashcardRating,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlashcardRatingID sets the ID field of the mutation.
+func withFlashcardRatingID(id uuid.UUID) flashcardratingOption {
+	return func(m *FlashcardRatingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlashcardRating
+		)
+		m.oldValue = func(ctx context.Context) (*FlashcardRating, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlashcardRating.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlashcardRating sets the old FlashcardRating of the mutation.
+func withFlashcardRating(node *FlashcardRating) flashcardratingOption {
+	return func(m *FlashcardRatingMutation) {
+		m.oldValue = func(context.Context) (*FlashcardRating, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlashcardRatingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlashcardRatingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FlashcardRating entities.
+func (m *FlashcardRatingMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlashcardRatingMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlashcardRatingMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlashcardRating.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *FlashcardRatingMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *FlashcardRatingMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the FlashcardRating entity.
+// If the FlashcardRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRatingMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *FlashcardRatingMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *FlashcardRatingMutation) SetDifficulty(f float64) {
+	m.difficulty = &f
+	m.adddifficulty = nil
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *FlashcardRatingMutation) Difficulty() (r float64, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the FlashcardRating entity.
+// If the FlashcardRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRatingMutation) OldDifficulty(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// AddDifficulty adds f to the "difficulty" field.
+func (m *FlashcardRatingMutation) AddDifficulty(f float64) {
+	if m.adddifficulty != nil {
+		*m.adddifficulty += f
+	} else {
+		m.adddifficulty = &f
+	}
+}
+
+// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
+func (m *FlashcardRatingMutation) AddedDifficulty() (r float64, exists bool) {
+	v := m.adddifficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *FlashcardRatingMutation) ResetDifficulty() {
+	m.difficulty = nil
+	m.adddifficulty = nil
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (m *FlashcardRatingMutation) SetAnswerCount(i int) {
+	m.answer_count = &i
+	m.addanswer_count = nil
+}
+
+// AnswerCount returns the value of the "answer_count" field in the mutation.
+func (m *FlashcardRatingMutation) AnswerCount() (r int, exists bool) {
+	v := m.answer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerCount returns the old "answer_count" field's value of the FlashcardRating entity.
+// If the FlashcardRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRatingMutation) OldAnswerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerCount: %w", err)
+	}
+	return oldValue.AnswerCount, nil
+}
+
+// AddAnswerCount adds i to the "answer_count" field.
+func (m *FlashcardRatingMutation) AddAnswerCount(i int) {
+	if m.addanswer_count != nil {
+		*m.addanswer_count += i
+	} else {
+		m.addanswer_count = &i
+	}
+}
+
+// AddedAnswerCount returns the value that was added to the "answer_count" field in this mutation.
+func (m *FlashcardRatingMutation) AddedAnswerCount() (r int, exists bool) {
+	v := m.addanswer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnswerCount resets all changes to the "answer_count" field.
+func (m *FlashcardRatingMutation) ResetAnswerCount() {
+	m.answer_count = nil
+	m.addanswer_count = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FlashcardRatingMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FlashcardRatingMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FlashcardRating entity.
+// If the FlashcardRating object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRatingMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FlashcardRatingMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *FlashcardRatingMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[flashcardrating.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *FlashcardRatingMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *FlashcardRatingMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *FlashcardRatingMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// Where appends a list predicates to the FlashcardRatingMutation builder.
+func (m *FlashcardRatingMutation) Where(ps ...predicate.FlashcardRating) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlashcardRatingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlashcardRatingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlashcardRating, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlashcardRatingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlashcardRatingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlashcardRating).
+func (m *FlashcardRatingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlashcardRatingMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.flashcard != nil {
+		fields = append(fields, flashcardrating.FieldFlashcardID)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, flashcardrating.FieldDifficulty)
+	}
+	if m.answer_count != nil {
+		fields = append(fields, flashcardrating.FieldAnswerCount)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, flashcardrating.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlashcardRatingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardrating.FieldFlashcardID:
+		return m.FlashcardID()
+	case flashcardrating.FieldDifficulty:
+		return m.Difficulty()
+	case flashcardrating.FieldAnswerCount:
+		return m.AnswerCount()
+	case flashcardrating.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlashcardRatingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flashcardrating.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case flashcardrating.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case flashcardrating.FieldAnswerCount:
+		return m.OldAnswerCount(ctx)
+	case flashcardrating.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlashcardRating field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardRatingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flashcardrating.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case flashcardrating.FieldDifficulty:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case flashcardrating.FieldAnswerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerCount(v)
+		return nil
+	case flashcardrating.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRating field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlashcardRatingMutation) AddedFields() []string {
+	var fields []string
+	if m.adddifficulty != nil {
+		fields = append(fields, flashcardrating.FieldDifficulty)
+	}
+	if m.addanswer_count != nil {
+		fields = append(fields, flashcardrating.FieldAnswerCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlashcardRatingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardrating.FieldDifficulty:
+		return m.AddedDifficulty()
+	case flashcardrating.FieldAnswerCount:
+		return m.AddedAnswerCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardRatingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case flashcardrating.FieldDifficulty:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDifficulty(v)
+		return nil
+	case flashcardrating.FieldAnswerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRating numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlashcardRatingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlashcardRatingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlashcardRatingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FlashcardRating nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlashcardRatingMutation) ResetField(name string) error {
+	switch name {
+	case flashcardrating.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case flashcardrating.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case flashcardrating.FieldAnswerCount:
+		m.ResetAnswerCount()
+		return nil
+	case flashcardrating.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRating field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlashcardRatingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.flashcard != nil {
+		edges = append(edges, flashcardrating.EdgeFlashcard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlashcardRatingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flashcardrating.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlashcardRatingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlashcardRatingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlashcardRatingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedflashcard {
+		edges = append(edges, flashcardrating.EdgeFlashcard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlashcardRatingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flashcardrating.EdgeFlashcard:
+		return m.clearedflashcard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlashcardRatingMutation) ClearEdge(name string) error {
+	switch name {
+	case flashcardrating.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRating unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlashcardRatingMutation) ResetEdge(name string) error {
+	switch name {
+	case flashcardrating.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRating edge %s", name)
+}
+
+// FlashcardReviewMutation represents an operation that mutates the FlashcardReview nodes in the graph.
+type FlashcardReviewMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	user_id             *string
+	item                *int
+	additem             *int
+	ease_factor         *float64
+	addease_factor      *float64
+	interval            *int
+	addinterval         *int
+	due_at              *time.Time
+	status              *flashcardreview.Status
+	learning_step       *int
+	addlearning_step    *int
+	review_count        *int
+	addreview_count     *int
+	lapse_count         *int
+	addlapse_count      *int
+	hint_count          *int
+	addhint_count       *int
+	last_reviewed_at    *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	flashcard           *uuid.UUID
+	clearedflashcard    bool
+	quiz_updates        map[uuid.UUID]struct{}
+	removedquiz_updates map[uuid.UUID]struct{}
+	clearedquiz_updates bool
+	done                bool
+	oldValue            func(context.Context) (*FlashcardReview, error)
+	predicates          []predicate.FlashcardReview
+}
+
+var _ ent.Mutation = (*FlashcardReviewMutation)(nil)
+
+// flashcardreviewOption allows management of the mutation configuration using functional options.
+type flashcardreviewOption func(*FlashcardReviewMutation)
+
+// newFlashcardReviewMutation creates new mutation for the FlashcardReview entity.
+func newFlashcardReviewMutation(c config, op Op, opts ...flashcardreviewOption) *FlashcardReviewMutation {
+	m := &FlashcardReviewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlashcardReview,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlashcardReviewID sets the ID field of the mutation.
+func withFlashcardReviewID(id uuid.UUID) flashcardreviewOption {
+	return func(m *FlashcardReviewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlashcardReview
+		)
+		m.oldValue = func(ctx context.Context) (*FlashcardReview, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlashcardReview.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlashcardReview sets the old FlashcardReview of the mutation.
+func withFlashcardReview(node *FlashcardReview) flashcardreviewOption {
+	return func(m *FlashcardReviewMutation) {
+		m.oldValue = func(context.Context) (*FlashcardReview, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlashcardReviewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlashcardReviewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FlashcardReview entities.
+func (m *FlashcardReviewMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlashcardReviewMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlashcardReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlashcardReview.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FlashcardReviewMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FlashcardReviewMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FlashcardReviewMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *FlashcardReviewMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *FlashcardReviewMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *FlashcardReviewMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetItem sets the "item" field.
+func (m *FlashcardReviewMutation) SetItem(i int) {
+	m.item = &i
+	m.additem = nil
+}
+
+// Item returns the value of the "item" field in the mutation.
+func (m *FlashcardReviewMutation) Item() (r int, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItem returns the old "item" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldItem(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItem: %w", err)
+	}
+	return oldValue.Item, nil
+}
+
+// AddItem adds i to the "item" field.
+func (m *FlashcardReviewMutation) AddItem(i int) {
+	if m.additem != nil {
+		*m.additem += i
+	} else {
+		m.additem = &i
+	}
+}
+
+// AddedItem returns the value that was added to the "item" field in this mutation.
+func (m *FlashcardReviewMutation) AddedItem() (r int, exists bool) {
+	v := m.additem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetItem resets all changes to the "item" field.
+func (m *FlashcardReviewMutation) ResetItem() {
+	m.item = nil
+	m.additem = nil
+}
+
+// SetEaseFactor sets the "ease_factor" field.
+func (m *FlashcardReviewMutation) SetEaseFactor(f float64) {
+	m.ease_factor = &f
+	m.addease_factor = nil
+}
+
+// EaseFactor returns the value of the "ease_factor" field in the mutation.
+func (m *FlashcardReviewMutation) EaseFactor() (r float64, exists bool) {
+	v := m.ease_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEaseFactor returns the old "ease_factor" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldEaseFactor(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEaseFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEaseFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEaseFactor: %w", err)
+	}
+	return oldValue.EaseFactor, nil
+}
+
+// AddEaseFactor adds f to the "ease_factor" field.
+func (m *FlashcardReviewMutation) AddEaseFactor(f float64) {
+	if m.addease_factor != nil {
+		*m.addease_factor += f
+	} else {
+		m.addease_factor = &f
+	}
+}
+
+// AddedEaseFactor returns the value that was added to the "ease_factor" field in this mutation.
+func (m *FlashcardReviewMutation) AddedEaseFactor() (r float64, exists bool) {
+	v := m.addease_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEaseFactor resets all changes to the "ease_factor" field.
+func (m *FlashcardReviewMutation) ResetEaseFactor() {
+	m.ease_factor = nil
+	m.addease_factor = nil
+}
+
+// SetInterval sets the "interval" field.
+func (m *FlashcardReviewMutation) SetInterval(i int) {
+	m.interval = &i
+	m.addinterval = nil
+}
+
+// Interval returns the value of the "interval" field in the mutation.
+func (m *FlashcardReviewMutation) Interval() (r int, exists bool) {
+	v := m.interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterval returns the old "interval" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+	}
+	return oldValue.Interval, nil
+}
+
+// AddInterval adds i to the "interval" field.
+func (m *FlashcardReviewMutation) AddInterval(i int) {
+	if m.addinterval != nil {
+		*m.addinterval += i
+	} else {
+		m.addinterval = &i
+	}
+}
+
+// AddedInterval returns the value that was added to the "interval" field in this mutation.
+func (m *FlashcardReviewMutation) AddedInterval() (r int, exists bool) {
+	v := m.addinterval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInterval resets all changes to the "interval" field.
+func (m *FlashcardReviewMutation) ResetInterval() {
+	m.interval = nil
+	m.addinterval = nil
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *FlashcardReviewMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *FlashcardReviewMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldDueAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *FlashcardReviewMutation) ResetDueAt() {
+	m.due_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *FlashcardReviewMutation) SetStatus(f flashcardreview.Status) {
+	m.status = &f
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *FlashcardReviewMutation) Status() (r flashcardreview.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldStatus(ctx context.Context) (v flashcardreview.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *FlashcardReviewMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetLearningStep sets the "learning_step" field.
+func (m *FlashcardReviewMutation) SetLearningStep(i int) {
+	m.learning_step = &i
+	m.addlearning_step = nil
+}
+
+// LearningStep returns the value of the "learning_step" field in the mutation.
+func (m *FlashcardReviewMutation) LearningStep() (r int, exists bool) {
+	v := m.learning_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLearningStep returns the old "learning_step" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldLearningStep(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLearningStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLearningStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLearningStep: %w", err)
+	}
+	return oldValue.LearningStep, nil
+}
+
+// AddLearningStep adds i to the "learning_step" field.
+func (m *FlashcardReviewMutation) AddLearningStep(i int) {
+	if m.addlearning_step != nil {
+		*m.addlearning_step += i
+	} else {
+		m.addlearning_step = &i
+	}
+}
+
+// AddedLearningStep returns the value that was added to the "learning_step" field in this mutation.
+func (m *FlashcardReviewMutation) AddedLearningStep() (r int, exists bool) {
+	v := m.addlearning_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLearningStep resets all changes to the "learning_step" field.
+func (m *FlashcardReviewMutation) ResetLearningStep() {
+	m.learning_step = nil
+	m.addlearning_step = nil
+}
+
+// SetReviewCount sets the "review_count" field.
+func (m *FlashcardReviewMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *FlashcardReviewMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewCount returns the old "review_count" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+	}
+	return oldValue.ReviewCount, nil
+}
+
+// AddReviewCount adds i to the "review_count" field.
+func (m *FlashcardReviewMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
+	} else {
+		m.addreview_count = &i
+	}
+}
+
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *FlashcardReviewMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *FlashcardReviewMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
+}
+
+// SetLapseCount sets the "lapse_count" field.
+func (m *FlashcardReviewMutation) SetLapseCount(i int) {
+	m.lapse_count = &i
+	m.addlapse_count = nil
+}
+
+// LapseCount returns the value of the "lapse_count" field in the mutation.
+func (m *FlashcardReviewMutation) LapseCount() (r int, exists bool) {
+	v := m.lapse_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLapseCount returns the old "lapse_count" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldLapseCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLapseCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLapseCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLapseCount: %w", err)
+	}
+	return oldValue.LapseCount, nil
+}
+
+// AddLapseCount adds i to the "lapse_count" field.
+func (m *FlashcardReviewMutation) AddLapseCount(i int) {
+	if m.addlapse_count != nil {
+		*m.addlapse_count += i
+	} else {
+		m.addlapse_count = &i
+	}
+}
+
+// AddedLapseCount returns the value that was added to the "lapse_count" field in this mutation.
+func (m *FlashcardReviewMutation) AddedLapseCount() (r int, exists bool) {
+	v := m.addlapse_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLapseCount resets all changes to the "lapse_count" field.
+func (m *FlashcardReviewMutation) ResetLapseCount() {
+	m.lapse_count = nil
+	m.addlapse_count = nil
+}
+
+// SetHintCount sets the "hint_count" field.
+func (m *FlashcardReviewMutation) SetHintCount(i int) {
+	m.hint_count = &i
+	m.addhint_count = nil
+}
+
+// HintCount returns the value of the "hint_count" field in the mutation.
+func (m *FlashcardReviewMutation) HintCount() (r int, exists bool) {
+	v := m.hint_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHintCount returns the old "hint_count" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldHintCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHintCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHintCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHintCount: %w", err)
+	}
+	return oldValue.HintCount, nil
+}
+
+// AddHintCount adds i to the "hint_count" field.
+func (m *FlashcardReviewMutation) AddHintCount(i int) {
+	if m.addhint_count != nil {
+		*m.addhint_count += i
+	} else {
+		m.addhint_count = &i
+	}
+}
+
+// AddedHintCount returns the value that was added to the "hint_count" field in this mutation.
+func (m *FlashcardReviewMutation) AddedHintCount() (r int, exists bool) {
+	v := m.addhint_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHintCount resets all changes to the "hint_count" field.
+func (m *FlashcardReviewMutation) ResetHintCount() {
+	m.hint_count = nil
+	m.addhint_count = nil
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (m *FlashcardReviewMutation) SetLastReviewedAt(t time.Time) {
+	m.last_reviewed_at = &t
+}
+
+// LastReviewedAt returns the value of the "last_reviewed_at" field in the mutation.
+func (m *FlashcardReviewMutation) LastReviewedAt() (r time.Time, exists bool) {
+	v := m.last_reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastReviewedAt returns the old "last_reviewed_at" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldLastReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastReviewedAt: %w", err)
+	}
+	return oldValue.LastReviewedAt, nil
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (m *FlashcardReviewMutation) ClearLastReviewedAt() {
+	m.last_reviewed_at = nil
+	m.clearedFields[flashcardreview.FieldLastReviewedAt] = struct{}{}
+}
+
+// LastReviewedAtCleared returns if the "last_reviewed_at" field was cleared in this mutation.
+func (m *FlashcardReviewMutation) LastReviewedAtCleared() bool {
+	_, ok := m.clearedFields[flashcardreview.FieldLastReviewedAt]
+	return ok
+}
+
+// ResetLastReviewedAt resets all changes to the "last_reviewed_at" field.
+func (m *FlashcardReviewMutation) ResetLastReviewedAt() {
+	m.last_reviewed_at = nil
+	delete(m.clearedFields, flashcardreview.FieldLastReviewedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlashcardReviewMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlashcardReviewMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlashcardReviewMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FlashcardReviewMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FlashcardReviewMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FlashcardReview entity.
+// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardReviewMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FlashcardReviewMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *FlashcardReviewMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[flashcardreview.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *FlashcardReviewMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *FlashcardReviewMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *FlashcardReviewMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// AddQuizUpdateIDs adds the "quiz_updates" edge to the QuizReviewUpdate entity by ids.
+func (m *FlashcardReviewMutation) AddQuizUpdateIDs(ids ...uuid.UUID) {
+	if m.quiz_updates == nil {
+		m.quiz_updates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.quiz_updates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuizUpdates clears the "quiz_updates" edge to the QuizReviewUpdate entity.
+func (m *FlashcardReviewMutation) ClearQuizUpdates() {
+	m.clearedquiz_updates = true
+}
+
+// QuizUpdatesCleared reports if the "quiz_updates" edge to the QuizReviewUpdate entity was cleared.
+func (m *FlashcardReviewMutation) QuizUpdatesCleared() bool {
+	return m.clearedquiz_updates
+}
+
+// RemoveQuizUpdateIDs removes the "quiz_updates" edge to the QuizReviewUpdate entity by IDs.
+func (m *FlashcardReviewMutation) RemoveQuizUpdateIDs(ids ...uuid.UUID) {
+	if m.removedquiz_updates == nil {
+		m.removedquiz_updates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.quiz_updates, ids[i])
+		m.removedquiz_updates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizUpdates returns the removed IDs of the "quiz_updates" edge to the QuizReviewUpdate entity.
+func (m *FlashcardReviewMutation) RemovedQuizUpdatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedquiz_updates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizUpdatesIDs returns the "quiz_updates" edge IDs in the mutation.
+func (m *FlashcardReviewMutation) QuizUpdatesIDs() (ids []uuid.UUID) {
+	for id := range m.quiz_updates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizUpdates resets all changes to the "quiz_updates" edge.
+func (m *FlashcardReviewMutation) ResetQuizUpdates() {
+	m.quiz_updates = nil
+	m.clearedquiz_updates = false
+	m.removedquiz_updates = nil
+}
+
+// Where appends a list predicates to the FlashcardReviewMutation builder.
+func (m *FlashcardReviewMutation) Where(ps ...predicate.FlashcardReview) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlashcardReviewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlashcardReviewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlashcardReview, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlashcardReviewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlashcardReviewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlashcardReview).
+func (m *FlashcardReviewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlashcardReviewMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user_id != nil {
+		fields = append(fields, flashcardreview.FieldUserID)
+	}
+	if m.flashcard != nil {
+		fields = append(fields, flashcardreview.FieldFlashcardID)
+	}
+	if m.item != nil {
+		fields = append(fields, flashcardreview.FieldItem)
+	}
+	if m.ease_factor != nil {
+		fields = append(fields, flashcardreview.FieldEaseFactor)
+	}
+	if m.interval != nil {
+		fields = append(fields, flashcardreview.FieldInterval)
+	}
+	if m.due_at != nil {
+		fields = append(fields, flashcardreview.FieldDueAt)
+	}
+	if m.status != nil {
+		fields = append(fields, flashcardreview.FieldStatus)
+	}
+	if m.learning_step != nil {
+		fields = append(fields, flashcardreview.FieldLearningStep)
+	}
+	if m.review_count != nil {
+		fields = append(fields, flashcardreview.FieldReviewCount)
+	}
+	if m.lapse_count != nil {
+		fields = append(fields, flashcardreview.FieldLapseCount)
+	}
+	if m.hint_count != nil {
+		fields = append(fields, flashcardreview.FieldHintCount)
+	}
+	if m.last_reviewed_at != nil {
+		fields = append(fields, flashcardreview.FieldLastReviewedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flashcardreview.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, flashcardreview.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlashcardReviewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardreview.FieldUserID:
+		return m.UserID()
+	case flashcardreview.FieldFlashcardID:
+		return m.FlashcardID()
+	case flashcardreview.FieldItem:
+		return m.Item()
+	case flashcardreview.FieldEaseFactor:
+		return m.EaseFactor()
+	case flashcardreview.FieldInterval:
+		return m.Interval()
+	case flashcardreview.FieldDueAt:
+		return m.DueAt()
+	case flashcardreview.FieldStatus:
+		return m.Status()
+	case flashcardreview.FieldLearningStep:
+		return m.LearningStep()
+	case flashcardreview.FieldReviewCount:
+		return m.ReviewCount()
+	case flashcardreview.FieldLapseCount:
+		return m.LapseCount()
+	case flashcardreview.FieldHintCount:
+		return m.HintCount()
+	case flashcardreview.FieldLastReviewedAt:
+		return m.LastReviewedAt()
+	case flashcardreview.FieldCreatedAt:
+		return m.CreatedAt()
+	case flashcardreview.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlashcardReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flashcardreview.FieldUserID:
+		return m.OldUserID(ctx)
+	case flashcardreview.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case flashcardreview.FieldItem:
+		return m.OldItem(ctx)
+	case flashcardreview.FieldEaseFactor:
+		return m.OldEaseFactor(ctx)
+	case flashcardreview.FieldInterval:
+		return m.OldInterval(ctx)
+	case flashcardreview.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case flashcardreview.FieldStatus:
+		return m.OldStatus(ctx)
+	case flashcardreview.FieldLearningStep:
+		return m.OldLearningStep(ctx)
+	case flashcardreview.FieldReviewCount:
+		return m.OldReviewCount(ctx)
+	case flashcardreview.FieldLapseCount:
+		return m.OldLapseCount(ctx)
+	case flashcardreview.FieldHintCount:
+		return m.OldHintCount(ctx)
+	case flashcardreview.FieldLastReviewedAt:
+		return m.OldLastReviewedAt(ctx)
+	case flashcardreview.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case flashcardreview.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlashcardReview field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardReviewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flashcardreview.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case flashcardreview.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case flashcardreview.FieldItem:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItem(v)
+		return nil
+	case flashcardreview.FieldEaseFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEaseFactor(v)
+		return nil
+	case flashcardreview.FieldInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterval(v)
+		return nil
+	case flashcardreview.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case flashcardreview.FieldStatus:
+		v, ok := value.(flashcardreview.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case flashcardreview.FieldLearningStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLearningStep(v)
+		return nil
+	case flashcardreview.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewCount(v)
+		return nil
+	case flashcardreview.FieldLapseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLapseCount(v)
+		return nil
+	case flashcardreview.FieldHintCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHintCount(v)
+		return nil
+	case flashcardreview.FieldLastReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReviewedAt(v)
+		return nil
+	case flashcardreview.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case flashcardreview.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardReview field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlashcardReviewMutation) AddedFields() []string {
+	var fields []string
+	if m.additem != nil {
+		fields = append(fields, flashcardreview.FieldItem)
+	}
+	if m.addease_factor != nil {
+		fields = append(fields, flashcardreview.FieldEaseFactor)
+	}
+	if m.addinterval != nil {
+		fields = append(fields, flashcardreview.FieldInterval)
+	}
+	if m.addlearning_step != nil {
+		fields = append(fields, flashcardreview.FieldLearningStep)
+	}
+	if m.addreview_count != nil {
+		fields = append(fields, flashcardreview.FieldReviewCount)
+	}
+	if m.addlapse_count != nil {
+		fields = append(fields, flashcardreview.FieldLapseCount)
+	}
+	if m.addhint_count != nil {
+		fields = append(fields, flashcardreview.FieldHintCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlashcardReviewMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardreview.FieldItem:
+		return m.AddedItem()
+	case flashcardreview.FieldEaseFactor:
+		return m.AddedEaseFactor()
+	case flashcardreview.FieldInterval:
+		return m.AddedInterval()
+	case flashcardreview.FieldLearningStep:
+		return m.AddedLearningStep()
+	case flashcardreview.FieldReviewCount:
+		return m.AddedReviewCount()
+	case flashcardreview.FieldLapseCount:
+		return m.AddedLapseCount()
+	case flashcardreview.FieldHintCount:
+		return m.AddedHintCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardReviewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case flashcardreview.FieldItem:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddItem(v)
+		return nil
+	case flashcardreview.FieldEaseFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEaseFactor(v)
+		return nil
+	case flashcardreview.FieldInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInterval(v)
+		return nil
+	case flashcardreview.FieldLearningStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLearningStep(v)
+		return nil
+	case flashcardreview.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewCount(v)
+		return nil
+	case flashcardreview.FieldLapseCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLapseCount(v)
+		return nil
+	case flashcardreview.FieldHintCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHintCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardReview numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlashcardReviewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(flashcardreview.FieldLastReviewedAt) {
+		fields = append(fields, flashcardreview.FieldLastReviewedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlashcardReviewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlashcardReviewMutation) ClearField(name string) error {
+	switch name {
+	case flashcardreview.FieldLastReviewedAt:
+		m.ClearLastReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardReview nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlashcardReviewMutation) ResetField(name string) error {
+	switch name {
+	case flashcardreview.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case flashcardreview.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case flashcardreview.FieldItem:
+		m.ResetItem()
+		return nil
+	case flashcardreview.FieldEaseFactor:
+		m.ResetEaseFactor()
+		return nil
+	case flashcardreview.FieldInterval:
+		m.ResetInterval()
+		return nil
+	case flashcardreview.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case flashcardreview.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case flashcardreview.FieldLearningStep:
+		m.ResetLearningStep()
+		return nil
+	case flashcardreview.FieldReviewCount:
+		m.ResetReviewCount()
+		return nil
+	case flashcardreview.FieldLapseCount:
+		m.ResetLapseCount()
+		return nil
+	case flashcardreview.FieldHintCount:
+		m.ResetHintCount()
+		return nil
+	case flashcardreview.FieldLastReviewedAt:
+		m.ResetLastReviewedAt()
+		return nil
+	case flashcardreview.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case flashcardreview.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardReview field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlashcardReviewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.flashcard != nil {
+		edges = append(edges, flashcardreview.EdgeFlashcard)
+	}
+	if m.quiz_updates != nil {
+		edges = append(edges, flashcardreview.EdgeQuizUpdates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlashcardReviewMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flashcardreview.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	case flashcardreview.EdgeQuizUpdates:
+		ids := make([]ent.Value, 0, len(m.quiz_updates))
+		for id := range m.quiz_updates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlashcardReviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedquiz_updates != nil {
+		edges = append(edges, flashcardreview.EdgeQuizUpdates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlashcardReviewMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case flashcardreview.EdgeQuizUpdates:
+		ids := make([]ent.Value, 0, len(m.removedquiz_updates))
+		for id := range m.removedquiz_updates {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlashcardReviewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedflashcard {
+		edges = append(edges, flashcardreview.EdgeFlashcard)
+	}
+	if m.clearedquiz_updates {
+		edges = append(edges, flashcardreview.EdgeQuizUpdates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlashcardReviewMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flashcardreview.EdgeFlashcard:
+		return m.clearedflashcard
+	case flashcardreview.EdgeQuizUpdates:
+		return m.clearedquiz_updates
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlashcardReviewMutation) ClearEdge(name string) error {
+	switch name {
+	case flashcardreview.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardReview unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlashcardReviewMutation) ResetEdge(name string) error {
+	switch name {
+	case flashcardreview.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	case flashcardreview.EdgeQuizUpdates:
+		m.ResetQuizUpdates()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardReview edge %s", name)
+}
+
+// FlashcardRevisionMutation represents an operation that mutates the FlashcardRevision nodes in the graph.
+type FlashcardRevisionMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	number               *int
+	addnumber            *int
+	author_id            *string
+	changed_fields       *[]string
+	appendchanged_fields []string
+	question             *string
+	answer               *string
+	_type                *string
+	content_format       *string
+	hint                 *string
+	explanation          *string
+	sources              *[]schema.SourceReference
+	appendsources        []schema.SourceReference
+	options              *[]string
+	appendoptions        []string
+	pairs                *[]schema.MatchPair
+	appendpairs          []schema.MatchPair
+	occlusion            **schema.ImageOcclusion
+	media_ids            *[]uuid.UUID
+	appendmedia_ids      []uuid.UUID
+	created_at           *time.Time
+	clearedFields        map[string]struct{}
+	flashcard            *uuid.UUID
+	clearedflashcard     bool
+	done                 bool
+	oldValue             func(context.Context) (*FlashcardRevision, error)
+	predicates           []predicate.FlashcardRevision
+}
+
+var _ ent.Mutation = (*FlashcardRevisionMutation)(nil)
+
+// flashcardrevisionOption allows management of the mutation configuration using functional options.
+type flashcardrevisionOption func(*FlashcardRevisionMutation)
+
+// newFlashcardRevisionMutation creates new mutation for the FlashcardRevision entity.
+func newFlashcardRevisionMutation(c config, op Op, opts ...flashcardrevisionOption) *FlashcardRevisionMutation {
+	m := &FlashcardRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlashcardRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlashcardRevisionID sets the ID field of the mutation.
+func withFlashcardRevisionID(id uuid.UUID) flashcardrevisionOption {
+	return func(m *FlashcardRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlashcardRevision
+		)
+		m.oldValue = func(ctx context.Context) (*FlashcardRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlashcardRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlashcardRevision sets the old FlashcardRevision of the mutation.
+func withFlashcardRevision(node *FlashcardRevision) flashcardrevisionOption {
+	return func(m *FlashcardRevisionMutation) {
+		m.oldValue = func(context.Context) (*FlashcardRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlashcardRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlashcardRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FlashcardRevision entities.
+func (m *FlashcardRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlashcardRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlashcardRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlashcardRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *FlashcardRevisionMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *FlashcardRevisionMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *FlashcardRevisionMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetNumber sets the "number" field.
+func (m *FlashcardRevisionMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *FlashcardRevisionMutation) Number() (r int, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// AddNumber adds i to the "number" field.
+func (m *FlashcardRevisionMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
+}
+
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *FlashcardRevisionMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *FlashcardRevisionMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *FlashcardRevisionMutation) SetAuthorID(s string) {
+	m.author_id = &s
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *FlashcardRevisionMutation) AuthorID() (r string, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldAuthorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *FlashcardRevisionMutation) ResetAuthorID() {
+	m.author_id = nil
+}
+
+// SetChangedFields sets the "changed_fields" field.
+func (m *FlashcardRevisionMutation) SetChangedFields(s []string) {
+	m.changed_fields = &s
+	m.appendchanged_fields = nil
+}
+
+// ChangedFields returns the value of the "changed_fields" field in the mutation.
+func (m *FlashcardRevisionMutation) ChangedFields() (r []string, exists bool) {
+	v := m.changed_fields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedFields returns the old "changed_fields" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldChangedFields(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedFields: %w", err)
+	}
+	return oldValue.ChangedFields, nil
+}
+
+// AppendChangedFields adds s to the "changed_fields" field.
+func (m *FlashcardRevisionMutation) AppendChangedFields(s []string) {
+	m.appendchanged_fields = append(m.appendchanged_fields, s...)
+}
+
+// AppendedChangedFields returns the list of values that were appended to the "changed_fields" field in this mutation.
+func (m *FlashcardRevisionMutation) AppendedChangedFields() ([]string, bool) {
+	if len(m.appendchanged_fields) == 0 {
+		return nil, false
+	}
+	return m.appendchanged_fields, true
+}
+
+// ClearChangedFields clears the value of the "changed_fields" field.
+func (m *FlashcardRevisionMutation) ClearChangedFields() {
+	m.changed_fields = nil
+	m.appendchanged_fields = nil
+	m.clearedFields[flashcardrevision.FieldChangedFields] = struct{}{}
+}
+
+// ChangedFieldsCleared returns if the "changed_fields" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) ChangedFieldsCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldChangedFields]
+	return ok
+}
+
+// ResetChangedFields resets all changes to the "changed_fields" field.
+func (m *FlashcardRevisionMutation) ResetChangedFields() {
+	m.changed_fields = nil
+	m.appendchanged_fields = nil
+	delete(m.clearedFields, flashcardrevision.FieldChangedFields)
+}
+
+// SetQuestion sets the "question" field.
+func (m *FlashcardRevisionMutation) SetQuestion(s string) {
+	m.question = &s
+}
+
+// Question returns the value of the "question" field in the mutation.
+func (m *FlashcardRevisionMutation) Question() (r string, exists bool) {
+	v := m.question
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestion returns the old "question" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldQuestion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestion: %w", err)
+	}
+	return oldValue.Question, nil
+}
+
+// ResetQuestion resets all changes to the "question" field.
+func (m *FlashcardRevisionMutation) ResetQuestion() {
+	m.question = nil
+}
+
+// SetAnswer sets the "answer" field.
+func (m *FlashcardRevisionMutation) SetAnswer(s string) {
+	m.answer = &s
+}
+
+// Answer returns the value of the "answer" field in the mutation.
+func (m *FlashcardRevisionMutation) Answer() (r string, exists bool) {
+	v := m.answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswer returns the old "answer" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldAnswer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswer: %w", err)
+	}
+	return oldValue.Answer, nil
+}
+
+// ResetAnswer resets all changes to the "answer" field.
+func (m *FlashcardRevisionMutation) ResetAnswer() {
+	m.answer = nil
+}
+
+// SetType sets the "type" field.
+func (m *FlashcardRevisionMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *FlashcardRevisionMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *FlashcardRevisionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetContentFormat sets the "content_format" field.
+func (m *FlashcardRevisionMutation) SetContentFormat(s string) {
+	m.content_format = &s
+}
+
+// ContentFormat returns the value of the "content_format" field in the mutation.
+func (m *FlashcardRevisionMutation) ContentFormat() (r string, exists bool) {
+	v := m.content_format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentFormat returns the old "content_format" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldContentFormat(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentFormat: %w", err)
+	}
+	return oldValue.ContentFormat, nil
+}
+
+// ResetContentFormat resets all changes to the "content_format" field.
+func (m *FlashcardRevisionMutation) ResetContentFormat() {
+	m.content_format = nil
+}
+
+// SetHint sets the "hint" field.
+func (m *FlashcardRevisionMutation) SetHint(s string) {
+	m.hint = &s
+}
+
+// Hint returns the value of the "hint" field in the mutation.
+func (m *FlashcardRevisionMutation) Hint() (r string, exists bool) {
+	v := m.hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHint returns the old "hint" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHint: %w", err)
+	}
+	return oldValue.Hint, nil
+}
+
+// ClearHint clears the value of the "hint" field.
+func (m *FlashcardRevisionMutation) ClearHint() {
+	m.hint = nil
+	m.clearedFields[flashcardrevision.FieldHint] = struct{}{}
+}
+
+// HintCleared returns if the "hint" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) HintCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldHint]
+	return ok
+}
+
+// ResetHint resets all changes to the "hint" field.
+func (m *FlashcardRevisionMutation) ResetHint() {
+	m.hint = nil
+	delete(m.clearedFields, flashcardrevision.FieldHint)
+}
+
+// SetExplanation sets the "explanation" field.
+func (m *FlashcardRevisionMutation) SetExplanation(s string) {
+	m.explanation = &s
+}
+
+// Explanation returns the value of the "explanation" field in the mutation.
+func (m *FlashcardRevisionMutation) Explanation() (r string, exists bool) {
+	v := m.explanation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExplanation returns the old "explanation" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldExplanation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExplanation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExplanation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExplanation: %w", err)
+	}
+	return oldValue.Explanation, nil
+}
+
+// ClearExplanation clears the value of the "explanation" field.
+func (m *FlashcardRevisionMutation) ClearExplanation() {
+	m.explanation = nil
+	m.clearedFields[flashcardrevision.FieldExplanation] = struct{}{}
+}
+
+// ExplanationCleared returns if the "explanation" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) ExplanationCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldExplanation]
+	return ok
+}
+
+// ResetExplanation resets all changes to the "explanation" field.
+func (m *FlashcardRevisionMutation) ResetExplanation() {
+	m.explanation = nil
+	delete(m.clearedFields, flashcardrevision.FieldExplanation)
+}
+
+// SetSources sets the "sources" field.
+func (m *FlashcardRevisionMutation) SetSources(sr []schema.SourceReference) {
+	m.sources = &sr
+	m.appendsources = nil
+}
+
+// Sources returns the value of the "sources" field in the mutation.
+func (m *FlashcardRevisionMutation) Sources() (r []schema.SourceReference, exists bool) {
+	v := m.sources
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSources returns the old "sources" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldSources(ctx context.Context) (v []schema.SourceReference, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSources is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSources requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSources: %w", err)
+	}
+	return oldValue.Sources, nil
+}
+
+// AppendSources adds sr to the "sources" field.
+func (m *FlashcardRevisionMutation) AppendSources(sr []schema.SourceReference) {
+	m.appendsources = append(m.appendsources, sr...)
+}
+
+// AppendedSources returns the list of values that were appended to the "sources" field in this mutation.
+func (m *FlashcardRevisionMutation) AppendedSources() ([]schema.SourceReference, bool) {
+	if len(m.appendsources) == 0 {
+		return nil, false
+	}
+	return m.appendsources, true
+}
+
+// ClearSources clears the value of the "sources" field.
+func (m *FlashcardRevisionMutation) ClearSources() {
+	m.sources = nil
+	m.appendsources = nil
+	m.clearedFields[flashcardrevision.FieldSources] = struct{}{}
+}
+
+// SourcesCleared returns if the "sources" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) SourcesCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldSources]
+	return ok
+}
+
+// ResetSources resets all changes to the "sources" field.
+func (m *FlashcardRevisionMutation) ResetSources() {
+	m.sources = nil
+	m.appendsources = nil
+	delete(m.clearedFields, flashcardrevision.FieldSources)
+}
+
+// SetOptions sets the "options" field.
+func (m *FlashcardRevisionMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *FlashcardRevisionMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *FlashcardRevisionMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *FlashcardRevisionMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *FlashcardRevisionMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[flashcardrevision.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *FlashcardRevisionMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, flashcardrevision.FieldOptions)
+}
+
+// SetPairs sets the "pairs" field.
+func (m *FlashcardRevisionMutation) SetPairs(sp []schema.MatchPair) {
+	m.pairs = &sp
+	m.appendpairs = nil
+}
+
+// Pairs returns the value of the "pairs" field in the mutation.
+func (m *FlashcardRevisionMutation) Pairs() (r []schema.MatchPair, exists bool) {
+	v := m.pairs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPairs returns the old "pairs" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldPairs(ctx context.Context) (v []schema.MatchPair, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPairs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPairs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPairs: %w", err)
+	}
+	return oldValue.Pairs, nil
+}
+
+// AppendPairs adds sp to the "pairs" field.
+func (m *FlashcardRevisionMutation) AppendPairs(sp []schema.MatchPair) {
+	m.appendpairs = append(m.appendpairs, sp...)
+}
+
+// AppendedPairs returns the list of values that were appended to the "pairs" field in this mutation.
+func (m *FlashcardRevisionMutation) AppendedPairs() ([]schema.MatchPair, bool) {
+	if len(m.appendpairs) == 0 {
+		return nil, false
+	}
+	return m.appendpairs, true
+}
+
+// ClearPairs clears the value of the "pairs" field.
+func (m *FlashcardRevisionMutation) ClearPairs() {
+	m.pairs = nil
+	m.appendpairs = nil
+	m.clearedFields[flashcardrevision.FieldPairs] = struct{}{}
+}
+
+// PairsCleared returns if the "pairs" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) PairsCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldPairs]
+	return ok
+}
+
+// ResetPairs resets all changes to the "pairs" field.
+func (m *FlashcardRevisionMutation) ResetPairs() {
+	m.pairs = nil
+	m.appendpairs = nil
+	delete(m.clearedFields, flashcardrevision.FieldPairs)
+}
+
+// SetOcclusion sets the "occlusion" field.
+func (m *FlashcardRevisionMutation) SetOcclusion(so *schema.ImageOcclusion) {
+	m.occlusion = &so
+}
+
+// Occlusion returns the value of the "occlusion" field in the mutation.
+func (m *FlashcardRevisionMutation) Occlusion() (r *schema.ImageOcclusion, exists bool) {
+	v := m.occlusion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOcclusion returns the old "occlusion" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldOcclusion(ctx context.Context) (v *schema.ImageOcclusion, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOcclusion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOcclusion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOcclusion: %w", err)
+	}
+	return oldValue.Occlusion, nil
+}
+
+// ClearOcclusion clears the value of the "occlusion" field.
+func (m *FlashcardRevisionMutation) ClearOcclusion() {
+	m.occlusion = nil
+	m.clearedFields[flashcardrevision.FieldOcclusion] = struct{}{}
+}
+
+// OcclusionCleared returns if the "occlusion" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) OcclusionCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldOcclusion]
+	return ok
+}
+
+// ResetOcclusion resets all changes to the "occlusion" field.
+func (m *FlashcardRevisionMutation) ResetOcclusion() {
+	m.occlusion = nil
+	delete(m.clearedFields, flashcardrevision.FieldOcclusion)
+}
+
+// SetMediaIds sets the "media_ids" field.
+func (m *FlashcardRevisionMutation) SetMediaIds(u []uuid.UUID) {
+	m.media_ids = &u
+	m.appendmedia_ids = nil
+}
+
+// MediaIds returns the value of the "media_ids" field in the mutation.
+func (m *FlashcardRevisionMutation) MediaIds() (r []uuid.UUID, exists bool) {
+	v := m.media_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaIds returns the old "media_ids" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldMediaIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaIds: %w", err)
+	}
+	return oldValue.MediaIds, nil
+}
+
+// AppendMediaIds adds u to the "media_ids" field.
+func (m *FlashcardRevisionMutation) AppendMediaIds(u []uuid.UUID) {
+	m.appendmedia_ids = append(m.appendmedia_ids, u...)
+}
+
+// AppendedMediaIds returns the list of values that were appended to the "media_ids" field in this mutation.
+func (m *FlashcardRevisionMutation) AppendedMediaIds() ([]uuid.UUID, bool) {
+	if len(m.appendmedia_ids) == 0 {
+		return nil, false
+	}
+	return m.appendmedia_ids, true
+}
+
+// ClearMediaIds clears the value of the "media_ids" field.
+func (m *FlashcardRevisionMutation) ClearMediaIds() {
+	m.media_ids = nil
+	m.appendmedia_ids = nil
+	m.clearedFields[flashcardrevision.FieldMediaIds] = struct{}{}
+}
+
+// MediaIdsCleared returns if the "media_ids" field was cleared in this mutation.
+func (m *FlashcardRevisionMutation) MediaIdsCleared() bool {
+	_, ok := m.clearedFields[flashcardrevision.FieldMediaIds]
+	return ok
+}
+
+// ResetMediaIds resets all changes to the "media_ids" field.
+func (m *FlashcardRevisionMutation) ResetMediaIds() {
+	m.media_ids = nil
+	m.appendmedia_ids = nil
+	delete(m.clearedFields, flashcardrevision.FieldMediaIds)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlashcardRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlashcardRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FlashcardRevision entity.
+// If the FlashcardRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlashcardRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *FlashcardRevisionMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[flashcardrevision.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *FlashcardRevisionMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *FlashcardRevisionMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *FlashcardRevisionMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// Where appends a list predicates to the FlashcardRevisionMutation builder.
+func (m *FlashcardRevisionMutation) Where(ps ...predicate.FlashcardRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlashcardRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlashcardRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlashcardRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlashcardRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlashcardRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlashcardRevision).
+func (m *FlashcardRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlashcardRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.flashcard != nil {
+		fields = append(fields, flashcardrevision.FieldFlashcardID)
+	}
+	if m.number != nil {
+		fields = append(fields, flashcardrevision.FieldNumber)
+	}
+	if m.author_id != nil {
+		fields = append(fields, flashcardrevision.FieldAuthorID)
+	}
+	if m.changed_fields != nil {
+		fields = append(fields, flashcardrevision.FieldChangedFields)
+	}
+	if m.question != nil {
+		fields = append(fields, flashcardrevision.FieldQuestion)
+	}
+	if m.answer != nil {
+		fields = append(fields, flashcardrevision.FieldAnswer)
+	}
+	if m._type != nil {
+		fields = append(fields, flashcardrevision.FieldType)
+	}
+	if m.content_format != nil {
+		fields = append(fields, flashcardrevision.FieldContentFormat)
+	}
+	if m.hint != nil {
+		fields = append(fields, flashcardrevision.FieldHint)
+	}
+	if m.explanation != nil {
+		fields = append(fields, flashcardrevision.FieldExplanation)
+	}
+	if m.sources != nil {
+		fields = append(fields, flashcardrevision.FieldSources)
+	}
+	if m.options != nil {
+		fields = append(fields, flashcardrevision.FieldOptions)
+	}
+	if m.pairs != nil {
+		fields = append(fields, flashcardrevision.FieldPairs)
+	}
+	if m.occlusion != nil {
+		fields = append(fields, flashcardrevision.FieldOcclusion)
+	}
+	if m.media_ids != nil {
+		fields = append(fields, flashcardrevision.FieldMediaIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flashcardrevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlashcardRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardrevision.FieldFlashcardID:
+		return m.FlashcardID()
+	case flashcardrevision.FieldNumber:
+		return m.Number()
+	case flashcardrevision.FieldAuthorID:
+		return m.AuthorID()
+	case flashcardrevision.FieldChangedFields:
+		return m.ChangedFields()
+	case flashcardrevision.FieldQuestion:
+		return m.Question()
+	case flashcardrevision.FieldAnswer:
+		return m.Answer()
+	case flashcardrevision.FieldType:
+		return m.GetType()
+	case flashcardrevision.FieldContentFormat:
+		return m.ContentFormat()
+	case flashcardrevision.FieldHint:
+		return m.Hint()
+	case flashcardrevision.FieldExplanation:
+		return m.Explanation()
+	case flashcardrevision.FieldSources:
+		return m.Sources()
+	case flashcardrevision.FieldOptions:
+		return m.Options()
+	case flashcardrevision.FieldPairs:
+		return m.Pairs()
+	case flashcardrevision.FieldOcclusion:
+		return m.Occlusion()
+	case flashcardrevision.FieldMediaIds:
+		return m.MediaIds()
+	case flashcardrevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlashcardRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flashcardrevision.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case flashcardrevision.FieldNumber:
+		return m.OldNumber(ctx)
+	case flashcardrevision.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case flashcardrevision.FieldChangedFields:
+		return m.OldChangedFields(ctx)
+	case flashcardrevision.FieldQuestion:
+		return m.OldQuestion(ctx)
+	case flashcardrevision.FieldAnswer:
+		return m.OldAnswer(ctx)
+	case flashcardrevision.FieldType:
+		return m.OldType(ctx)
+	case flashcardrevision.FieldContentFormat:
+		return m.OldContentFormat(ctx)
+	case flashcardrevision.FieldHint:
+		return m.OldHint(ctx)
+	case flashcardrevision.FieldExplanation:
+		return m.OldExplanation(ctx)
+	case flashcardrevision.FieldSources:
+		return m.OldSources(ctx)
+	case flashcardrevision.FieldOptions:
+		return m.OldOptions(ctx)
+	case flashcardrevision.FieldPairs:
+		return m.OldPairs(ctx)
+	case flashcardrevision.FieldOcclusion:
+		return m.OldOcclusion(ctx)
+	case flashcardrevision.FieldMediaIds:
+		return m.OldMediaIds(ctx)
+	case flashcardrevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlashcardRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flashcardrevision.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case flashcardrevision.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case flashcardrevision.FieldAuthorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case flashcardrevision.FieldChangedFields:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedFields(v)
+		return nil
+	case flashcardrevision.FieldQuestion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestion(v)
+		return nil
+	case flashcardrevision.FieldAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswer(v)
+		return nil
+	case flashcardrevision.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case flashcardrevision.FieldContentFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentFormat(v)
+		return nil
+	case flashcardrevision.FieldHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHint(v)
+		return nil
+	case flashcardrevision.FieldExplanation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExplanation(v)
+		return nil
+	case flashcardrevision.FieldSources:
+		v, ok := value.([]schema.SourceReference)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSources(v)
+		return nil
+	case flashcardrevision.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case flashcardrevision.FieldPairs:
+		v, ok := value.([]schema.MatchPair)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPairs(v)
+		return nil
+	case flashcardrevision.FieldOcclusion:
+		v, ok := value.(*schema.ImageOcclusion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOcclusion(v)
+		return nil
+	case flashcardrevision.FieldMediaIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaIds(v)
+		return nil
+	case flashcardrevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlashcardRevisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber != nil {
+		fields = append(fields, flashcardrevision.FieldNumber)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlashcardRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardrevision.FieldNumber:
+		return m.AddedNumber()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case flashcardrevision.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlashcardRevisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(flashcardrevision.FieldChangedFields) {
+		fields = append(fields, flashcardrevision.FieldChangedFields)
+	}
+	if m.FieldCleared(flashcardrevision.FieldHint) {
+		fields = append(fields, flashcardrevision.FieldHint)
+	}
+	if m.FieldCleared(flashcardrevision.FieldExplanation) {
+		fields = append(fields, flashcardrevision.FieldExplanation)
+	}
+	if m.FieldCleared(flashcardrevision.FieldSources) {
+		fields = append(fields, flashcardrevision.FieldSources)
+	}
+	if m.FieldCleared(flashcardrevision.FieldOptions) {
+		fields = append(fields, flashcardrevision.FieldOptions)
+	}
+	if m.FieldCleared(flashcardrevision.FieldPairs) {
+		fields = append(fields, flashcardrevision.FieldPairs)
+	}
+	if m.FieldCleared(flashcardrevision.FieldOcclusion) {
+		fields = append(fields, flashcardrevision.FieldOcclusion)
+	}
+	if m.FieldCleared(flashcardrevision.FieldMediaIds) {
+		fields = append(fields, flashcardrevision.FieldMediaIds)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlashcardRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlashcardRevisionMutation) ClearField(name string) error {
+	switch name {
+	case flashcardrevision.FieldChangedFields:
+		m.ClearChangedFields()
+		return nil
+	case flashcardrevision.FieldHint:
+		m.ClearHint()
+		return nil
+	case flashcardrevision.FieldExplanation:
+		m.ClearExplanation()
+		return nil
+	case flashcardrevision.FieldSources:
+		m.ClearSources()
+		return nil
+	case flashcardrevision.FieldOptions:
+		m.ClearOptions()
+		return nil
+	case flashcardrevision.FieldPairs:
+		m.ClearPairs()
+		return nil
+	case flashcardrevision.FieldOcclusion:
+		m.ClearOcclusion()
+		return nil
+	case flashcardrevision.FieldMediaIds:
+		m.ClearMediaIds()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlashcardRevisionMutation) ResetField(name string) error {
+	switch name {
+	case flashcardrevision.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case flashcardrevision.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case flashcardrevision.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case flashcardrevision.FieldChangedFields:
+		m.ResetChangedFields()
+		return nil
+	case flashcardrevision.FieldQuestion:
+		m.ResetQuestion()
+		return nil
+	case flashcardrevision.FieldAnswer:
+		m.ResetAnswer()
+		return nil
+	case flashcardrevision.FieldType:
+		m.ResetType()
+		return nil
+	case flashcardrevision.FieldContentFormat:
+		m.ResetContentFormat()
+		return nil
+	case flashcardrevision.FieldHint:
+		m.ResetHint()
+		return nil
+	case flashcardrevision.FieldExplanation:
+		m.ResetExplanation()
+		return nil
+	case flashcardrevision.FieldSources:
+		m.ResetSources()
+		return nil
+	case flashcardrevision.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case flashcardrevision.FieldPairs:
+		m.ResetPairs()
+		return nil
+	case flashcardrevision.FieldOcclusion:
+		m.ResetOcclusion()
+		return nil
+	case flashcardrevision.FieldMediaIds:
+		m.ResetMediaIds()
+		return nil
+	case flashcardrevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlashcardRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.flashcard != nil {
+		edges = append(edges, flashcardrevision.EdgeFlashcard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlashcardRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flashcardrevision.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlashcardRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlashcardRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlashcardRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedflashcard {
+		edges = append(edges, flashcardrevision.EdgeFlashcard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlashcardRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flashcardrevision.EdgeFlashcard:
+		return m.clearedflashcard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlashcardRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case flashcardrevision.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlashcardRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case flashcardrevision.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardRevision edge %s", name)
+}
+
+// FlashcardTagMutation represents an operation that mutates the FlashcardTag nodes in the graph.
+type FlashcardTagMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	collection_id    *uuid.UUID
+	name             *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	flashcard        *uuid.UUID
+	clearedflashcard bool
+	done             bool
+	oldValue         func(context.Context) (*FlashcardTag, error)
+	predicates       []predicate.FlashcardTag
+}
+
+var _ ent.Mutation = (*FlashcardTagMutation)(nil)
+
+// flashcardtagOption allows management of the mutation configuration using functional options.
+type flashcardtagOption func(*FlashcardTagMutation)
+
+// newFlashcardTagMutation creates new mutation for the FlashcardTag entity.
+func newFlashcardTagMutation(c config, op Op, opts ...flashcardtagOption) *FlashcardTagMutation {
+	m := &FlashcardTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlashcardTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlashcardTagID sets the ID field of the mutation.
+func withFlashcardTagID(id uuid.UUID) flashcardtagOption {
+	return func(m *FlashcardTagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlashcardTag
+		)
+		m.oldValue = func(ctx context.Context) (*FlashcardTag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlashcardTag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlashcardTag sets the old FlashcardTag of the mutation.
+func withFlashcardTag(node *FlashcardTag) flashcardtagOption {
+	return func(m *FlashcardTagMutation) {
+		m.oldValue = func(context.Context) (*FlashcardTag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlashcardTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlashcardTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of FlashcardTag entities.
+func (m *FlashcardTagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlashcardTagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlashcardTagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlashcardTag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *FlashcardTagMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *FlashcardTagMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the FlashcardTag entity.
+// If the FlashcardTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardTagMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *FlashcardTagMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (m *FlashcardTagMutation) SetCollectionID(u uuid.UUID) {
+	m.collection_id = &u
+}
+
+// CollectionID returns the value of the "collection_id" field in the mutation.
+func (m *FlashcardTagMutation) CollectionID() (r uuid.UUID, exists bool) {
+	v := m.collection_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectionID returns the old "collection_id" field's value of the FlashcardTag entity.
+// If the FlashcardTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardTagMutation) OldCollectionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
+	}
+	return oldValue.CollectionID, nil
+}
+
+// ResetCollectionID resets all changes to the "collection_id" field.
+func (m *FlashcardTagMutation) ResetCollectionID() {
+	m.collection_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *FlashcardTagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *FlashcardTagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the FlashcardTag entity.
+// If the FlashcardTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardTagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *FlashcardTagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlashcardTagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlashcardTagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FlashcardTag entity.
+// If the FlashcardTag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlashcardTagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlashcardTagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *FlashcardTagMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[flashcardtag.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *FlashcardTagMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *FlashcardTagMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *FlashcardTagMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// Where appends a list predicates to the FlashcardTagMutation builder.
+func (m *FlashcardTagMutation) Where(ps ...predicate.FlashcardTag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlashcardTagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlashcardTagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlashcardTag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlashcardTagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlashcardTagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlashcardTag).
+func (m *FlashcardTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlashcardTagMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.flashcard != nil {
+		fields = append(fields, flashcardtag.FieldFlashcardID)
+	}
+	if m.collection_id != nil {
+		fields = append(fields, flashcardtag.FieldCollectionID)
+	}
+	if m.name != nil {
+		fields = append(fields, flashcardtag.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flashcardtag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlashcardTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flashcardtag.FieldFlashcardID:
+		return m.FlashcardID()
+	case flashcardtag.FieldCollectionID:
+		return m.CollectionID()
+	case flashcardtag.FieldName:
+		return m.Name()
+	case flashcardtag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlashcardTagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flashcardtag.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case flashcardtag.FieldCollectionID:
+		return m.OldCollectionID(ctx)
+	case flashcardtag.FieldName:
+		return m.OldName(ctx)
+	case flashcardtag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlashcardTag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flashcardtag.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case flashcardtag.FieldCollectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionID(v)
+		return nil
+	case flashcardtag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case flashcardtag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlashcardTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlashcardTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlashcardTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FlashcardTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlashcardTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlashcardTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlashcardTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FlashcardTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlashcardTagMutation) ResetField(name string) error {
+	switch name {
+	case flashcardtag.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case flashcardtag.FieldCollectionID:
+		m.ResetCollectionID()
+		return nil
+	case flashcardtag.FieldName:
+		m.ResetName()
+		return nil
+	case flashcardtag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlashcardTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.flashcard != nil {
+		edges = append(edges, flashcardtag.EdgeFlashcard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlashcardTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flashcardtag.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlashcardTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlashcardTagMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlashcardTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedflashcard {
+		edges = append(edges, flashcardtag.EdgeFlashcard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlashcardTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flashcardtag.EdgeFlashcard:
+		return m.clearedflashcard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlashcardTagMutation) ClearEdge(name string) error {
+	switch name {
+	case flashcardtag.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlashcardTagMutation) ResetEdge(name string) error {
+	switch name {
+	case flashcardtag.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown FlashcardTag edge %s", name)
+}
+
+// ItemStatisticMutation represents an operation that mutates the ItemStatistic nodes in the graph.
+type ItemStatisticMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	attempt_count          *int
+	addattempt_count       *int
+	answered_count         *int
+	addanswered_count      *int
+	score_sum              *float64
+	addscore_sum           *float64
+	score_squares          *float64
+	addscore_squares       *float64
+	rest_sum               *float64
+	addrest_sum            *float64
+	rest_squares           *float64
+	addrest_squares        *float64
+	score_rest_products    *float64
+	addscore_rest_products *float64
+	time_spent_ms          *int64
+	addtime_spent_ms       *int64
+	option_counts          *map[string]int
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	flashcard              *uuid.UUID
+	clearedflashcard       bool
+	quiz                   *uuid.UUID
+	clearedquiz            bool
+	done                   bool
+	oldValue               func(context.Context) (*ItemStatistic, error)
+	predicates             []predicate.ItemStatistic
+}
+
+var _ ent.Mutation = (*ItemStatisticMutation)(nil)
+
+// itemstatisticOption allows management of the mutation configuration using functional options.
+type itemstatisticOption func(*ItemStatisticMutation)
+
+// newItemStatisticMutation creates new mutation for the ItemStatistic entity.
+func newItemStatisticMutation(c config, op Op, opts ...itemstatisticOption) *ItemStatisticMutation {
+	m := &ItemStatisticMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItemStatistic,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemStatisticID sets the ID field of the mutation.
+func withItemStatisticID(id uuid.UUID) itemstatisticOption {
+	return func(m *ItemStatisticMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ItemStatistic
+		)
+		m.oldValue = func(ctx context.Context) (*ItemStatistic, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ItemStatistic.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItemStatistic sets the old ItemStatistic of the mutation.
+func withItemStatistic(node *ItemStatistic) itemstatisticOption {
+	return func(m *ItemStatisticMutation) {
+		m.oldValue = func(context.Context) (*ItemStatistic, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemStatisticMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemStatisticMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ItemStatistic entities.
+func (m *ItemStatisticMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemStatisticMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemStatisticMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ItemStatistic.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *ItemStatisticMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *ItemStatisticMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *ItemStatisticMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (m *ItemStatisticMutation) SetQuizID(u uuid.UUID) {
+	m.quiz = &u
+}
+
+// QuizID returns the value of the "quiz_id" field in the mutation.
+func (m *ItemStatisticMutation) QuizID() (r uuid.UUID, exists bool) {
+	v := m.quiz
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuizID returns the old "quiz_id" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldQuizID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuizID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuizID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuizID: %w", err)
+	}
+	return oldValue.QuizID, nil
+}
+
+// ClearQuizID clears the value of the "quiz_id" field.
+func (m *ItemStatisticMutation) ClearQuizID() {
+	m.quiz = nil
+	m.clearedFields[itemstatistic.FieldQuizID] = struct{}{}
+}
+
+// QuizIDCleared returns if the "quiz_id" field was cleared in this mutation.
+func (m *ItemStatisticMutation) QuizIDCleared() bool {
+	_, ok := m.clearedFields[itemstatistic.FieldQuizID]
+	return ok
+}
+
+// ResetQuizID resets all changes to the "quiz_id" field.
+func (m *ItemStatisticMutation) ResetQuizID() {
+	m.quiz = nil
+	delete(m.clearedFields, itemstatistic.FieldQuizID)
+}
+
+// SetAttemptCount sets the "attempt_count" field.
+func (m *ItemStatisticMutation) SetAttemptCount(i int) {
+	m.attempt_count = &i
+	m.addattempt_count = nil
+}
+
+// AttemptCount returns the value of the "attempt_count" field in the mutation.
+func (m *ItemStatisticMutation) AttemptCount() (r int, exists bool) {
+	v := m.attempt_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttemptCount returns the old "attempt_count" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldAttemptCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttemptCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttemptCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttemptCount: %w", err)
+	}
+	return oldValue.AttemptCount, nil
+}
+
+// AddAttemptCount adds i to the "attempt_count" field.
+func (m *ItemStatisticMutation) AddAttemptCount(i int) {
+	if m.addattempt_count != nil {
+		*m.addattempt_count += i
+	} else {
+		m.addattempt_count = &i
+	}
+}
+
+// AddedAttemptCount returns the value that was added to the "attempt_count" field in this mutation.
+func (m *ItemStatisticMutation) AddedAttemptCount() (r int, exists bool) {
+	v := m.addattempt_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttemptCount resets all changes to the "attempt_count" field.
+func (m *ItemStatisticMutation) ResetAttemptCount() {
+	m.attempt_count = nil
+	m.addattempt_count = nil
+}
+
+// SetAnsweredCount sets the "answered_count" field.
+func (m *ItemStatisticMutation) SetAnsweredCount(i int) {
+	m.answered_count = &i
+	m.addanswered_count = nil
+}
+
+// AnsweredCount returns the value of the "answered_count" field in the mutation.
+func (m *ItemStatisticMutation) AnsweredCount() (r int, exists bool) {
+	v := m.answered_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnsweredCount returns the old "answered_count" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldAnsweredCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnsweredCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnsweredCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnsweredCount: %w", err)
+	}
+	return oldValue.AnsweredCount, nil
+}
+
+// AddAnsweredCount adds i to the "answered_count" field.
+func (m *ItemStatisticMutation) AddAnsweredCount(i int) {
+	if m.addanswered_count != nil {
+		*m.addanswered_count += i
+	} else {
+		m.addanswered_count = &i
+	}
+}
+
+// AddedAnsweredCount returns the value that was added to the "answered_count" field in this mutation.
+func (m *ItemStatisticMutation) AddedAnsweredCount() (r int, exists bool) {
+	v := m.addanswered_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnsweredCount resets all changes to the "answered_count" field.
+func (m *ItemStatisticMutation) ResetAnsweredCount() {
+	m.answered_count = nil
+	m.addanswered_count = nil
+}
+
+// SetScoreSum sets the "score_sum" field.
+func (m *ItemStatisticMutation) SetScoreSum(f float64) {
+	m.score_sum = &f
+	m.addscore_sum = nil
+}
+
+// ScoreSum returns the value of the "score_sum" field in the mutation.
+func (m *ItemStatisticMutation) ScoreSum() (r float64, exists bool) {
+	v := m.score_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoreSum returns the old "score_sum" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldScoreSum(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoreSum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoreSum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoreSum: %w", err)
+	}
+	return oldValue.ScoreSum, nil
+}
+
+// AddScoreSum adds f to the "score_sum" field.
+func (m *ItemStatisticMutation) AddScoreSum(f float64) {
+	if m.addscore_sum != nil {
+		*m.addscore_sum += f
+	} else {
+		m.addscore_sum = &f
+	}
+}
+
+// AddedScoreSum returns the value that was added to the "score_sum" field in this mutation.
+func (m *ItemStatisticMutation) AddedScoreSum() (r float64, exists bool) {
+	v := m.addscore_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScoreSum resets all changes to the "score_sum" field.
+func (m *ItemStatisticMutation) ResetScoreSum() {
+	m.score_sum = nil
+	m.addscore_sum = nil
+}
+
+// SetScoreSquares sets the "score_squares" field.
+func (m *ItemStatisticMutation) SetScoreSquares(f float64) {
+	m.score_squares = &f
+	m.addscore_squares = nil
+}
+
+// ScoreSquares returns the value of the "score_squares" field in the mutation.
+func (m *ItemStatisticMutation) ScoreSquares() (r float64, exists bool) {
+	v := m.score_squares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoreSquares returns the old "score_squares" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldScoreSquares(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoreSquares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoreSquares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoreSquares: %w", err)
+	}
+	return oldValue.ScoreSquares, nil
+}
+
+// AddScoreSquares adds f to the "score_squares" field.
+func (m *ItemStatisticMutation) AddScoreSquares(f float64) {
+	if m.addscore_squares != nil {
+		*m.addscore_squares += f
+	} else {
+		m.addscore_squares = &f
+	}
+}
+
+// AddedScoreSquares returns the value that was added to the "score_squares" field in this mutation.
+func (m *ItemStatisticMutation) AddedScoreSquares() (r float64, exists bool) {
+	v := m.addscore_squares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScoreSquares resets all changes to the "score_squares" field.
+func (m *ItemStatisticMutation) ResetScoreSquares() {
+	m.score_squares = nil
+	m.addscore_squares = nil
+}
+
+// SetRestSum sets the "rest_sum" field.
+func (m *ItemStatisticMutation) SetRestSum(f float64) {
+	m.rest_sum = &f
+	m.addrest_sum = nil
+}
+
+// RestSum returns the value of the "rest_sum" field in the mutation.
+func (m *ItemStatisticMutation) RestSum() (r float64, exists bool) {
+	v := m.rest_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestSum returns the old "rest_sum" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldRestSum(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestSum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestSum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestSum: %w", err)
+	}
+	return oldValue.RestSum, nil
+}
+
+// AddRestSum adds f to the "rest_sum" field.
+func (m *ItemStatisticMutation) AddRestSum(f float64) {
+	if m.addrest_sum != nil {
+		*m.addrest_sum += f
+	} else {
+		m.addrest_sum = &f
+	}
+}
+
+// AddedRestSum returns the value that was added to the "rest_sum" field in this mutation.
+func (m *ItemStatisticMutation) AddedRestSum() (r float64, exists bool) {
+	v := m.addrest_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRestSum resets all changes to the "rest_sum" field.
+func (m *ItemStatisticMutation) ResetRestSum() {
+	m.rest_sum = nil
+	m.addrest_sum = nil
+}
+
+// SetRestSquares sets the "rest_squares" field.
+func (m *ItemStatisticMutation) SetRestSquares(f float64) {
+	m.rest_squares = &f
+	m.addrest_squares = nil
+}
+
+// RestSquares returns the value of the "rest_squares" field in the mutation.
+func (m *ItemStatisticMutation) RestSquares() (r float64, exists bool) {
+	v := m.rest_squares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestSquares returns the old "rest_squares" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldRestSquares(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestSquares is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestSquares requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestSquares: %w", err)
+	}
+	return oldValue.RestSquares, nil
+}
+
+// AddRestSquares adds f to the "rest_squares" field.
+func (m *ItemStatisticMutation) AddRestSquares(f float64) {
+	if m.addrest_squares != nil {
+		*m.addrest_squares += f
+	} else {
+		m.addrest_squares = &f
+	}
+}
+
+// AddedRestSquares returns the value that was added to the "rest_squares" field in this mutation.
+func (m *ItemStatisticMutation) AddedRestSquares() (r float64, exists bool) {
+	v := m.addrest_squares
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRestSquares resets all changes to the "rest_squares" field.
+func (m *ItemStatisticMutation) ResetRestSquares() {
+	m.rest_squares = nil
+	m.addrest_squares = nil
+}
+
+// SetScoreRestProducts sets the "score_rest_products" field.
+func (m *ItemStatisticMutation) SetScoreRestProducts(f float64) {
+	m.score_rest_products = &f
+	m.addscore_rest_products = nil
+}
+
+// ScoreRestProducts returns the value of the "score_rest_products" field in the mutation.
+func (m *ItemStatisticMutation) ScoreRestProducts() (r float64, exists bool) {
+	v := m.score_rest_products
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoreRestProducts returns the old "score_rest_products" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldScoreRestProducts(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoreRestProducts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoreRestProducts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoreRestProducts: %w", err)
+	}
+	return oldValue.ScoreRestProducts, nil
+}
+
+// AddScoreRestProducts adds f to the "score_rest_products" field.
+func (m *ItemStatisticMutation) AddScoreRestProducts(f float64) {
+	if m.addscore_rest_products != nil {
+		*m.addscore_rest_products += f
+	} else {
+		m.addscore_rest_products = &f
+	}
+}
+
+// AddedScoreRestProducts returns the value that was added to the "score_rest_products" field in this mutation.
+func (m *ItemStatisticMutation) AddedScoreRestProducts() (r float64, exists bool) {
+	v := m.addscore_rest_products
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScoreRestProducts resets all changes to the "score_rest_products" field.
+func (m *ItemStatisticMutation) ResetScoreRestProducts() {
+	m.score_rest_products = nil
+	m.addscore_rest_products = nil
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (m *ItemStatisticMutation) SetTimeSpentMs(i int64) {
+	m.time_spent_ms = &i
+	m.addtime_spent_ms = nil
+}
+
+// TimeSpentMs returns the value of the "time_spent_ms" field in the mutation.
+func (m *ItemStatisticMutation) TimeSpentMs() (r int64, exists bool) {
+	v := m.time_spent_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeSpentMs returns the old "time_spent_ms" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldTimeSpentMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeSpentMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeSpentMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeSpentMs: %w", err)
+	}
+	return oldValue.TimeSpentMs, nil
+}
+
+// AddTimeSpentMs adds i to the "time_spent_ms" field.
+func (m *ItemStatisticMutation) AddTimeSpentMs(i int64) {
+	if m.addtime_spent_ms != nil {
+		*m.addtime_spent_ms += i
+	} else {
+		m.addtime_spent_ms = &i
+	}
+}
+
+// AddedTimeSpentMs returns the value that was added to the "time_spent_ms" field in this mutation.
+func (m *ItemStatisticMutation) AddedTimeSpentMs() (r int64, exists bool) {
+	v := m.addtime_spent_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeSpentMs resets all changes to the "time_spent_ms" field.
+func (m *ItemStatisticMutation) ResetTimeSpentMs() {
+	m.time_spent_ms = nil
+	m.addtime_spent_ms = nil
+}
+
+// SetOptionCounts sets the "option_counts" field.
+func (m *ItemStatisticMutation) SetOptionCounts(value map[string]int) {
+	m.option_counts = &value
+}
+
+// OptionCounts returns the value of the "option_counts" field in the mutation.
+func (m *ItemStatisticMutation) OptionCounts() (r map[string]int, exists bool) {
+	v := m.option_counts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptionCounts returns the old "option_counts" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldOptionCounts(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptionCounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptionCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptionCounts: %w", err)
+	}
+	return oldValue.OptionCounts, nil
+}
+
+// ClearOptionCounts clears the value of the "option_counts" field.
+func (m *ItemStatisticMutation) ClearOptionCounts() {
+	m.option_counts = nil
+	m.clearedFields[itemstatistic.FieldOptionCounts] = struct{}{}
+}
+
+// OptionCountsCleared returns if the "option_counts" field was cleared in this mutation.
+func (m *ItemStatisticMutation) OptionCountsCleared() bool {
+	_, ok := m.clearedFields[itemstatistic.FieldOptionCounts]
+	return ok
+}
+
+// ResetOptionCounts resets all changes to the "option_counts" field.
+func (m *ItemStatisticMutation) ResetOptionCounts() {
+	m.option_counts = nil
+	delete(m.clearedFields, itemstatistic.FieldOptionCounts)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemStatisticMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemStatisticMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ItemStatistic entity.
+// If the ItemStatistic object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemStatisticMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemStatisticMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *ItemStatisticMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[itemstatistic.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *ItemStatisticMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *ItemStatisticMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *ItemStatisticMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (m *ItemStatisticMutation) ClearQuiz() {
+	m.clearedquiz = true
+	m.clearedFields[itemstatistic.FieldQuizID] = struct{}{}
+}
+
+// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
+func (m *ItemStatisticMutation) QuizCleared() bool {
+	return m.QuizIDCleared() || m.clearedquiz
+}
+
+// QuizIDs returns the "quiz" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuizID instead. It exists only for internal usage by the builders.
+func (m *ItemStatisticMutation) QuizIDs() (ids []uuid.UUID) {
+	if id := m.quiz; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuiz resets all changes to the "quiz" edge.
+func (m *ItemStatisticMutation) ResetQuiz() {
+	m.quiz = nil
+	m.clearedquiz = false
+}
+
+// Where appends a list predicates to the ItemStatisticMutation builder.
+func (m *ItemStatisticMutation) Where(ps ...predicate.ItemStatistic) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemStatisticMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemStatisticMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ItemStatistic, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemStatisticMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemStatisticMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ItemStatistic).
+func (m *ItemStatisticMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemStatisticMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.flashcard != nil {
+		fields = append(fields, itemstatistic.FieldFlashcardID)
+	}
+	if m.quiz != nil {
+		fields = append(fields, itemstatistic.FieldQuizID)
+	}
+	if m.attempt_count != nil {
+		fields = append(fields, itemstatistic.FieldAttemptCount)
+	}
+	if m.answered_count != nil {
+		fields = append(fields, itemstatistic.FieldAnsweredCount)
+	}
+	if m.score_sum != nil {
+		fields = append(fields, itemstatistic.FieldScoreSum)
+	}
+	if m.score_squares != nil {
+		fields = append(fields, itemstatistic.FieldScoreSquares)
+	}
+	if m.rest_sum != nil {
+		fields = append(fields, itemstatistic.FieldRestSum)
+	}
+	if m.rest_squares != nil {
+		fields = append(fields, itemstatistic.FieldRestSquares)
+	}
+	if m.score_rest_products != nil {
+		fields = append(fields, itemstatistic.FieldScoreRestProducts)
+	}
+	if m.time_spent_ms != nil {
+		fields = append(fields, itemstatistic.FieldTimeSpentMs)
+	}
+	if m.option_counts != nil {
+		fields = append(fields, itemstatistic.FieldOptionCounts)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, itemstatistic.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemStatisticMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case itemstatistic.FieldFlashcardID:
+		return m.FlashcardID()
+	case itemstatistic.FieldQuizID:
+		return m.QuizID()
+	case itemstatistic.FieldAttemptCount:
+		return m.AttemptCount()
+	case itemstatistic.FieldAnsweredCount:
+		return m.AnsweredCount()
+	case itemstatistic.FieldScoreSum:
+		return m.ScoreSum()
+	case itemstatistic.FieldScoreSquares:
+		return m.ScoreSquares()
+	case itemstatistic.FieldRestSum:
+		return m.RestSum()
+	case itemstatistic.FieldRestSquares:
+		return m.RestSquares()
+	case itemstatistic.FieldScoreRestProducts:
+		return m.ScoreRestProducts()
+	case itemstatistic.FieldTimeSpentMs:
+		return m.TimeSpentMs()
+	case itemstatistic.FieldOptionCounts:
+		return m.OptionCounts()
+	case itemstatistic.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemStatisticMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case itemstatistic.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case itemstatistic.FieldQuizID:
+		return m.OldQuizID(ctx)
+	case itemstatistic.FieldAttemptCount:
+		return m.OldAttemptCount(ctx)
+	case itemstatistic.FieldAnsweredCount:
+		return m.OldAnsweredCount(ctx)
+	case itemstatistic.FieldScoreSum:
+		return m.OldScoreSum(ctx)
+	case itemstatistic.FieldScoreSquares:
+		return m.OldScoreSquares(ctx)
+	case itemstatistic.FieldRestSum:
+		return m.OldRestSum(ctx)
+	case itemstatistic.FieldRestSquares:
+		return m.OldRestSquares(ctx)
+	case itemstatistic.FieldScoreRestProducts:
+		return m.OldScoreRestProducts(ctx)
+	case itemstatistic.FieldTimeSpentMs:
+		return m.OldTimeSpentMs(ctx)
+	case itemstatistic.FieldOptionCounts:
+		return m.OldOptionCounts(ctx)
+	case itemstatistic.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ItemStatistic field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemStatisticMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case itemstatistic.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case itemstatistic.FieldQuizID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuizID(v)
+		return nil
+	case itemstatistic.FieldAttemptCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttemptCount(v)
+		return nil
+	case itemstatistic.FieldAnsweredCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnsweredCount(v)
+		return nil
+	case itemstatistic.FieldScoreSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoreSum(v)
+		return nil
+	case itemstatistic.FieldScoreSquares:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoreSquares(v)
+		return nil
+	case itemstatistic.FieldRestSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestSum(v)
+		return nil
+	case itemstatistic.FieldRestSquares:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestSquares(v)
+		return nil
+	case itemstatistic.FieldScoreRestProducts:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoreRestProducts(v)
+		return nil
+	case itemstatistic.FieldTimeSpentMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeSpentMs(v)
+		return nil
+	case itemstatistic.FieldOptionCounts:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptionCounts(v)
+		return nil
+	case itemstatistic.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemStatistic field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemStatisticMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempt_count != nil {
+		fields = append(fields, itemstatistic.FieldAttemptCount)
+	}
+	if m.addanswered_count != nil {
+		fields = append(fields, itemstatistic.FieldAnsweredCount)
+	}
+	if m.addscore_sum != nil {
+		fields = append(fields, itemstatistic.FieldScoreSum)
+	}
+	if m.addscore_squares != nil {
+		fields = append(fields, itemstatistic.FieldScoreSquares)
+	}
+	if m.addrest_sum != nil {
+		fields = append(fields, itemstatistic.FieldRestSum)
+	}
+	if m.addrest_squares != nil {
+		fields = append(fields, itemstatistic.FieldRestSquares)
+	}
+	if m.addscore_rest_products != nil {
+		fields = append(fields, itemstatistic.FieldScoreRestProducts)
+	}
+	if m.addtime_spent_ms != nil {
+		fields = append(fields, itemstatistic.FieldTimeSpentMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemStatisticMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case itemstatistic.FieldAttemptCount:
+		return m.AddedAttemptCount()
+	case itemstatistic.FieldAnsweredCount:
+		return m.AddedAnsweredCount()
+	case itemstatistic.FieldScoreSum:
+		return m.AddedScoreSum()
+	case itemstatistic.FieldScoreSquares:
+		return m.AddedScoreSquares()
+	case itemstatistic.FieldRestSum:
+		return m.AddedRestSum()
+	case itemstatistic.FieldRestSquares:
+		return m.AddedRestSquares()
+	case itemstatistic.FieldScoreRestProducts:
+		return m.AddedScoreRestProducts()
+	case itemstatistic.FieldTimeSpentMs:
+		return m.AddedTimeSpentMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemStatisticMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case itemstatistic.FieldAttemptCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttemptCount(v)
+		return nil
+	case itemstatistic.FieldAnsweredCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnsweredCount(v)
+		return nil
+	case itemstatistic.FieldScoreSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScoreSum(v)
+		return nil
+	case itemstatistic.FieldScoreSquares:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScoreSquares(v)
+		return nil
+	case itemstatistic.FieldRestSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestSum(v)
+		return nil
+	case itemstatistic.FieldRestSquares:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRestSquares(v)
+		return nil
+	case itemstatistic.FieldScoreRestProducts:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScoreRestProducts(v)
+		return nil
+	case itemstatistic.FieldTimeSpentMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeSpentMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ItemStatistic numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemStatisticMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(itemstatistic.FieldQuizID) {
+		fields = append(fields, itemstatistic.FieldQuizID)
+	}
+	if m.FieldCleared(itemstatistic.FieldOptionCounts) {
+		fields = append(fields, itemstatistic.FieldOptionCounts)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemStatisticMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemStatisticMutation) ClearField(name string) error {
+	switch name {
+	case itemstatistic.FieldQuizID:
+		m.ClearQuizID()
+		return nil
+	case itemstatistic.FieldOptionCounts:
+		m.ClearOptionCounts()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemStatistic nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemStatisticMutation) ResetField(name string) error {
+	switch name {
+	case itemstatistic.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case itemstatistic.FieldQuizID:
+		m.ResetQuizID()
+		return nil
+	case itemstatistic.FieldAttemptCount:
+		m.ResetAttemptCount()
+		return nil
+	case itemstatistic.FieldAnsweredCount:
+		m.ResetAnsweredCount()
+		return nil
+	case itemstatistic.FieldScoreSum:
+		m.ResetScoreSum()
+		return nil
+	case itemstatistic.FieldScoreSquares:
+		m.ResetScoreSquares()
+		return nil
+	case itemstatistic.FieldRestSum:
+		m.ResetRestSum()
+		return nil
+	case itemstatistic.FieldRestSquares:
+		m.ResetRestSquares()
+		return nil
+	case itemstatistic.FieldScoreRestProducts:
+		m.ResetScoreRestProducts()
+		return nil
+	case itemstatistic.FieldTimeSpentMs:
+		m.ResetTimeSpentMs()
+		return nil
+	case itemstatistic.FieldOptionCounts:
+		m.ResetOptionCounts()
+		return nil
+	case itemstatistic.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemStatistic field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemStatisticMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.flashcard != nil {
+		edges = append(edges, itemstatistic.EdgeFlashcard)
+	}
+	if m.quiz != nil {
+		edges = append(edges, itemstatistic.EdgeQuiz)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemStatisticMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case itemstatistic.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	case itemstatistic.EdgeQuiz:
+		if id := m.quiz; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemStatisticMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemStatisticMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemStatisticMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedflashcard {
+		edges = append(edges, itemstatistic.EdgeFlashcard)
+	}
+	if m.clearedquiz {
+		edges = append(edges, itemstatistic.EdgeQuiz)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemStatisticMutation) EdgeCleared(name string) bool {
+	switch name {
+	case itemstatistic.EdgeFlashcard:
+		return m.clearedflashcard
+	case itemstatistic.EdgeQuiz:
+		return m.clearedquiz
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemStatisticMutation) ClearEdge(name string) error {
+	switch name {
+	case itemstatistic.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	case itemstatistic.EdgeQuiz:
+		m.ClearQuiz()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemStatistic unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemStatisticMutation) ResetEdge(name string) error {
+	switch name {
+	case itemstatistic.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	case itemstatistic.EdgeQuiz:
+		m.ResetQuiz()
+		return nil
+	}
+	return fmt.Errorf("unknown ItemStatistic edge %s", name)
+}
+
+// LearnerAbilityMutation represents an operation that mutates the LearnerAbility nodes in the graph.
+type LearnerAbilityMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *string
+	ability           *float64
+	addability        *float64
+	answer_count      *int
+	addanswer_count   *int
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	collection        *uuid.UUID
+	clearedcollection bool
+	done              bool
+	oldValue          func(context.Context) (*LearnerAbility, error)
+	predicates        []predicate.LearnerAbility
+}
+
+var _ ent.Mutation = (*LearnerAbilityMutation)(nil)
+
+// learnerabilityOption allows management of the mutation configuration using functional options.
+type learnerabilityOption func(*LearnerAbilityMutation)
+
+// newLearnerAbilityMutation creates new mutation for the LearnerAbility entity.
+func newLearnerAbilityMutation(c config, op Op, opts ...learnerabilityOption) *LearnerAbilityMutation {
+	m := &LearnerAbilityMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLearnerAbility,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLearnerAbilityID sets the ID field of the mutation.
+func withLearnerAbilityID(id uuid.UUID) learnerabilityOption {
+	return func(m *LearnerAbilityMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LearnerAbility
+		)
+		m.oldValue = func(ctx context.Context) (*LearnerAbility, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LearnerAbility.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLearnerAbility sets the old LearnerAbility of the mutation.
+func withLearnerAbility(node *LearnerAbility) learnerabilityOption {
+	return func(m *LearnerAbilityMutation) {
+		m.oldValue = func(context.Context) (*LearnerAbility, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LearnerAbilityMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LearnerAbilityMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LearnerAbility entities.
+func (m *LearnerAbilityMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LearnerAbilityMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LearnerAbilityMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LearnerAbility.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LearnerAbilityMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LearnerAbilityMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LearnerAbility entity.
+// If the LearnerAbility object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerAbilityMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LearnerAbilityMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (m *LearnerAbilityMutation) SetCollectionID(u uuid.UUID) {
+	m.collection = &u
+}
+
+// CollectionID returns the value of the "collection_id" field in the mutation.
+func (m *LearnerAbilityMutation) CollectionID() (r uuid.UUID, exists bool) {
+	v := m.collection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectionID returns the old "collection_id" field's value of the LearnerAbility entity.
+// If the LearnerAbility object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerAbilityMutation) OldCollectionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
+	}
+	return oldValue.CollectionID, nil
+}
+
+// ResetCollectionID resets all changes to the "collection_id" field.
+func (m *LearnerAbilityMutation) ResetCollectionID() {
+	m.collection = nil
+}
+
+// SetAbility sets the "ability" field.
+func (m *LearnerAbilityMutation) SetAbility(f float64) {
+	m.ability = &f
+	m.addability = nil
+}
+
+// Ability returns the value of the "ability" field in the mutation.
+func (m *LearnerAbilityMutation) Ability() (r float64, exists bool) {
+	v := m.ability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbility returns the old "ability" field's value of the LearnerAbility entity.
+// If the LearnerAbility object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerAbilityMutation) OldAbility(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbility: %w", err)
+	}
+	return oldValue.Ability, nil
+}
+
+// AddAbility adds f to the "ability" field.
+func (m *LearnerAbilityMutation) AddAbility(f float64) {
+	if m.addability != nil {
+		*m.addability += f
+	} else {
+		m.addability = &f
+	}
+}
+
+// AddedAbility returns the value that was added to the "ability" field in this mutation.
+func (m *LearnerAbilityMutation) AddedAbility() (r float64, exists bool) {
+	v := m.addability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAbility resets all changes to the "ability" field.
+func (m *LearnerAbilityMutation) ResetAbility() {
+	m.ability = nil
+	m.addability = nil
+}
+
+// SetAnswerCount sets the "answer_count" field.
+func (m *LearnerAbilityMutation) SetAnswerCount(i int) {
+	m.answer_count = &i
+	m.addanswer_count = nil
+}
+
+// AnswerCount returns the value of the "answer_count" field in the mutation.
+func (m *LearnerAbilityMutation) AnswerCount() (r int, exists bool) {
+	v := m.answer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerCount returns the old "answer_count" field's value of the LearnerAbility entity.
+// If the LearnerAbility object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerAbilityMutation) OldAnswerCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerCount: %w", err)
+	}
+	return oldValue.AnswerCount, nil
+}
+
+// AddAnswerCount adds i to the "answer_count" field.
+func (m *LearnerAbilityMutation) AddAnswerCount(i int) {
+	if m.addanswer_count != nil {
+		*m.addanswer_count += i
+	} else {
+		m.addanswer_count = &i
+	}
+}
+
+// AddedAnswerCount returns the value that was added to the "answer_count" field in this mutation.
+func (m *LearnerAbilityMutation) AddedAnswerCount() (r int, exists bool) {
+	v := m.addanswer_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAnswerCount resets all changes to the "answer_count" field.
+func (m *LearnerAbilityMutation) ResetAnswerCount() {
+	m.answer_count = nil
+	m.addanswer_count = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LearnerAbilityMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LearnerAbilityMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LearnerAbility entity.
+// If the LearnerAbility object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LearnerAbilityMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LearnerAbilityMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (m *LearnerAbilityMutation) ClearCollection() {
+	m.clearedcollection = true
+	m.clearedFields[learnerability.FieldCollectionID] = struct{}{}
+}
+
+// CollectionCleared reports if the "collection" edge to the Collection entity was cleared.
+func (m *LearnerAbilityMutation) CollectionCleared() bool {
+	return m.clearedcollection
+}
+
+// CollectionIDs returns the "collection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CollectionID instead. It exists only for internal usage by the builders.
+func (m *LearnerAbilityMutation) CollectionIDs() (ids []uuid.UUID) {
+	if id := m.collection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCollection resets all changes to the "collection" edge.
+func (m *LearnerAbilityMutation) ResetCollection() {
+	m.collection = nil
+	m.clearedcollection = false
+}
+
+// Where appends a list predicates to the LearnerAbilityMutation builder.
+func (m *LearnerAbilityMutation) Where(ps ...predicate.LearnerAbility) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LearnerAbilityMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LearnerAbilityMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LearnerAbility, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LearnerAbilityMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LearnerAbilityMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LearnerAbility).
+func (m *LearnerAbilityMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LearnerAbilityMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, learnerability.FieldUserID)
+	}
+	if m.collection != nil {
+		fields = append(fields, learnerability.FieldCollectionID)
+	}
+	if m.ability != nil {
+		fields = append(fields, learnerability.FieldAbility)
+	}
+	if m.answer_count != nil {
+		fields = append(fields, learnerability.FieldAnswerCount)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, learnerability.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LearnerAbilityMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case learnerability.FieldUserID:
+		return m.UserID()
+	case learnerability.FieldCollectionID:
+		return m.CollectionID()
+	case learnerability.FieldAbility:
+		return m.Ability()
+	case learnerability.FieldAnswerCount:
+		return m.AnswerCount()
+	case learnerability.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LearnerAbilityMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case learnerability.FieldUserID:
+		return m.OldUserID(ctx)
+	case learnerability.FieldCollectionID:
+		return m.OldCollectionID(ctx)
+	case learnerability.FieldAbility:
+		return m.OldAbility(ctx)
+	case learnerability.FieldAnswerCount:
+		return m.OldAnswerCount(ctx)
+	case learnerability.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LearnerAbility field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LearnerAbilityMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case learnerability.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case learnerability.FieldCollectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionID(v)
+		return nil
+	case learnerability.FieldAbility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbility(v)
+		return nil
+	case learnerability.FieldAnswerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerCount(v)
+		return nil
+	case learnerability.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerAbility field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LearnerAbilityMutation) AddedFields() []string {
+	var fields []string
+	if m.addability != nil {
+		fields = append(fields, learnerability.FieldAbility)
+	}
+	if m.addanswer_count != nil {
+		fields = append(fields, learnerability.FieldAnswerCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LearnerAbilityMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case learnerability.FieldAbility:
+		return m.AddedAbility()
+	case learnerability.FieldAnswerCount:
+		return m.AddedAnswerCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LearnerAbilityMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case learnerability.FieldAbility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAbility(v)
+		return nil
+	case learnerability.FieldAnswerCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerAbility numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LearnerAbilityMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LearnerAbilityMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LearnerAbilityMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LearnerAbility nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LearnerAbilityMutation) ResetField(name string) error {
+	switch name {
+	case learnerability.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case learnerability.FieldCollectionID:
+		m.ResetCollectionID()
+		return nil
+	case learnerability.FieldAbility:
+		m.ResetAbility()
+		return nil
+	case learnerability.FieldAnswerCount:
+		m.ResetAnswerCount()
+		return nil
+	case learnerability.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerAbility field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LearnerAbilityMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.collection != nil {
+		edges = append(edges, learnerability.EdgeCollection)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LearnerAbilityMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case learnerability.EdgeCollection:
+		if id := m.collection; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LearnerAbilityMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LearnerAbilityMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LearnerAbilityMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcollection {
+		edges = append(edges, learnerability.EdgeCollection)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LearnerAbilityMutation) EdgeCleared(name string) bool {
+	switch name {
+	case learnerability.EdgeCollection:
+		return m.clearedcollection
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LearnerAbilityMutation) ClearEdge(name string) error {
+	switch name {
+	case learnerability.EdgeCollection:
+		m.ClearCollection()
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerAbility unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LearnerAbilityMutation) ResetEdge(name string) error {
+	switch name {
+	case learnerability.EdgeCollection:
+		m.ResetCollection()
+		return nil
+	}
+	return fmt.Errorf("unknown LearnerAbility edge %s", name)
+}
+
+// MediaMutation represents an operation that mutates the Media nodes in the graph.
+type MediaMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	hash              *string
+	content_type      *string
+	size              *int64
+	addsize           *int64
+	storage_key       *string
+	uploaded_by       *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	flashcards        map[uuid.UUID]struct{}
+	removedflashcards map[uuid.UUID]struct{}
+	clearedflashcards bool
+	uploads           map[uuid.UUID]struct{}
+	removeduploads    map[uuid.UUID]struct{}
+	cleareduploads    bool
+	done              bool
+	oldValue          func(context.Context) (*Media, error)
+	predicates        []predicate.Media
+}
+
+var _ ent.Mutation = (*MediaMutation)(nil)
+
+// mediaOption allows management of the mutation configuration using functional options.
+type mediaOption func(*MediaMutation)
+
+// newMediaMutation creates new mutation for the Media entity.
+func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
+	m := &MediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaID sets the ID field of the mutation.
+func withMediaID(id uuid.UUID) mediaOption {
+	return func(m *MediaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Media
+		)
+		m.oldValue = func(ctx context.Context) (*Media, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Media.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMedia sets the old Media of the mutation.
+func withMedia(node *Media) mediaOption {
+	return func(m *MediaMutation) {
+		m.oldValue = func(context.Context) (*Media, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Media entities.
+func (m *MediaMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetHash sets the "hash" field.
+func (m *MediaMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the value of the "hash" field in the mutation.
+func (m *MediaMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHash returns the old "hash" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHash: %w", err)
+	}
+	return oldValue.Hash, nil
+}
+
+// ResetHash resets all changes to the "hash" field.
+func (m *MediaMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *MediaMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *MediaMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *MediaMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *MediaMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *MediaMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *MediaMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *MediaMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *MediaMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (m *MediaMutation) SetStorageKey(s string) {
+	m.storage_key = &s
+}
+
+// StorageKey returns the value of the "storage_key" field in the mutation.
+func (m *MediaMutation) StorageKey() (r string, exists bool) {
+	v := m.storage_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStorageKey returns the old "storage_key" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldStorageKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStorageKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStorageKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStorageKey: %w", err)
+	}
+	return oldValue.StorageKey, nil
+}
+
+// ResetStorageKey resets all changes to the "storage_key" field.
+func (m *MediaMutation) ResetStorageKey() {
+	m.storage_key = nil
+}
+
+// SetUploadedBy sets the "uploaded_by" field.
+func (m *MediaMutation) SetUploadedBy(s string) {
+	m.uploaded_by = &s
+}
+
+// UploadedBy returns the value of the "uploaded_by" field in the mutation.
+func (m *MediaMutation) UploadedBy() (r string, exists bool) {
+	v := m.uploaded_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadedBy returns the old "uploaded_by" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldUploadedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedBy: %w", err)
+	}
+	return oldValue.UploadedBy, nil
+}
+
+// ResetUploadedBy resets all changes to the "uploaded_by" field.
+func (m *MediaMutation) ResetUploadedBy() {
+	m.uploaded_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MediaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MediaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddFlashcardIDs adds the "flashcards" edge to the Flashcard entity by ids.
+func (m *MediaMutation) AddFlashcardIDs(ids ...uuid.UUID) {
+	if m.flashcards == nil {
+		m.flashcards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.flashcards[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFlashcards clears the "flashcards" edge to the Flashcard entity.
+func (m *MediaMutation) ClearFlashcards() {
+	m.clearedflashcards = true
+}
+
+// FlashcardsCleared reports if the "flashcards" edge to the Flashcard entity was cleared.
+func (m *MediaMutation) FlashcardsCleared() bool {
+	return m.clearedflashcards
+}
+
+// RemoveFlashcardIDs removes the "flashcards" edge to the Flashcard entity by IDs.
+func (m *MediaMutation) RemoveFlashcardIDs(ids ...uuid.UUID) {
+	if m.removedflashcards == nil {
+		m.removedflashcards = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.flashcards, ids[i])
+		m.removedflashcards[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFlashcards returns the removed IDs of the "flashcards" edge to the Flashcard entity.
+func (m *MediaMutation) RemovedFlashcardsIDs() (ids []uuid.UUID) {
+	for id := range m.removedflashcards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FlashcardsIDs returns the "flashcards" edge IDs in the mutation.
+func (m *MediaMutation) FlashcardsIDs() (ids []uuid.UUID) {
+	for id := range m.flashcards {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFlashcards resets all changes to the "flashcards" edge.
+func (m *MediaMutation) ResetFlashcards() {
+	m.flashcards = nil
+	m.clearedflashcards = false
+	m.removedflashcards = nil
+}
+
+// AddUploadIDs adds the "uploads" edge to the MediaUpload entity by ids.
+func (m *MediaMutation) AddUploadIDs(ids ...uuid.UUID) {
+	if m.uploads == nil {
+		m.uploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.uploads[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUploads clears the "uploads" edge to the MediaUpload entity.
+func (m *MediaMutation) ClearUploads() {
+	m.cleareduploads = true
+}
+
+// UploadsCleared reports if the "uploads" edge to the MediaUpload entity was cleared.
+func (m *MediaMutation) UploadsCleared() bool {
+	return m.cleareduploads
+}
+
+// RemoveUploadIDs removes the "uploads" edge to the MediaUpload entity by IDs.
+func (m *MediaMutation) RemoveUploadIDs(ids ...uuid.UUID) {
+	if m.removeduploads == nil {
+		m.removeduploads = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.uploads, ids[i])
+		m.removeduploads[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUploads returns the removed IDs of the "uploads" edge to the MediaUpload entity.
+func (m *MediaMutation) RemovedUploadsIDs() (ids []uuid.UUID) {
+	for id := range m.removeduploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UploadsIDs returns the "uploads" edge IDs in the mutation.
+func (m *MediaMutation) UploadsIDs() (ids []uuid.UUID) {
+	for id := range m.uploads {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUploads resets all changes to the "uploads" edge.
+func (m *MediaMutation) ResetUploads() {
+	m.uploads = nil
+	m.cleareduploads = false
+	m.removeduploads = nil
+}
+
+// Where appends a list predicates to the MediaMutation builder.
+func (m *MediaMutation) Where(ps ...predicate.Media) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Media, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Media).
+func (m *MediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.hash != nil {
+		fields = append(fields, media.FieldHash)
+	}
+	if m.content_type != nil {
+		fields = append(fields, media.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, media.FieldSize)
+	}
+	if m.storage_key != nil {
+		fields = append(fields, media.FieldStorageKey)
+	}
+	if m.uploaded_by != nil {
+		fields = append(fields, media.FieldUploadedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, media.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldHash:
+		return m.Hash()
+	case media.FieldContentType:
+		return m.ContentType()
+	case media.FieldSize:
+		return m.Size()
+	case media.FieldStorageKey:
+		return m.StorageKey()
+	case media.FieldUploadedBy:
+		return m.UploadedBy()
+	case media.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case media.FieldHash:
+		return m.OldHash(ctx)
+	case media.FieldContentType:
+		return m.OldContentType(ctx)
+	case media.FieldSize:
+		return m.OldSize(ctx)
+	case media.FieldStorageKey:
+		return m.OldStorageKey(ctx)
+	case media.FieldUploadedBy:
+		return m.OldUploadedBy(ctx)
+	case media.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Media field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case media.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case media.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case media.FieldStorageKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageKey(v)
+		return nil
+	case media.FieldUploadedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedBy(v)
+		return nil
+	case media.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, media.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Media nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaMutation) ResetField(name string) error {
+	switch name {
+	case media.FieldHash:
+		m.ResetHash()
+		return nil
+	case media.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case media.FieldSize:
+		m.ResetSize()
+		return nil
+	case media.FieldStorageKey:
+		m.ResetStorageKey()
+		return nil
+	case media.FieldUploadedBy:
+		m.ResetUploadedBy()
+		return nil
+	case media.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.flashcards != nil {
+		edges = append(edges, media.EdgeFlashcards)
+	}
+	if m.uploads != nil {
+		edges = append(edges, media.EdgeUploads)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeFlashcards:
+		ids := make([]ent.Value, 0, len(m.flashcards))
+		for id := range m.flashcards {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeUploads:
+		ids := make([]ent.Value, 0, len(m.uploads))
+		for id := range m.uploads {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedflashcards != nil {
+		edges = append(edges, media.EdgeFlashcards)
+	}
+	if m.removeduploads != nil {
+		edges = append(edges, media.EdgeUploads)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeFlashcards:
+		ids := make([]ent.Value, 0, len(m.removedflashcards))
+		for id := range m.removedflashcards {
+			ids = append(ids, id)
+		}
+		return ids
+	case media.EdgeUploads:
+		ids := make([]ent.Value, 0, len(m.removeduploads))
+		for id := range m.removeduploads {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedflashcards {
+		edges = append(edges, media.EdgeFlashcards)
+	}
+	if m.cleareduploads {
+		edges = append(edges, media.EdgeUploads)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case media.EdgeFlashcards:
+		return m.clearedflashcards
+	case media.EdgeUploads:
+		return m.cleareduploads
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Media unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaMutation) ResetEdge(name string) error {
+	switch name {
+	case media.EdgeFlashcards:
+		m.ResetFlashcards()
+		return nil
+	case media.EdgeUploads:
+		m.ResetUploads()
+		return nil
+	}
+	return fmt.Errorf("unknown Media edge %s", name)
+}
+
+// MediaUploadMutation represents an operation that mutates the MediaUpload nodes in the graph.
+type MediaUploadMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *string
+	uploaded_at   *time.Time
+	clearedFields map[string]struct{}
+	media         *uuid.UUID
+	clearedmedia  bool
+	done          bool
+	oldValue      func(context.Context) (*MediaUpload, error)
+	predicates    []predicate.MediaUpload
+}
+
+var _ ent.Mutation = (*MediaUploadMutation)(nil)
+
+// mediauploadOption allows management of the mutation configuration using functional options.
+type mediauploadOption func(*MediaUploadMutation)
+
+// newMediaUploadMutation creates new mutation for the MediaUpload entity.
+func newMediaUploadMutation(c config, op Op, opts ...mediauploadOption) *MediaUploadMutation {
+	m := &MediaUploadMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMediaUpload,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaUploadID sets the ID field of the mutation.
+func withMediaUploadID(id uuid.UUID) mediauploadOption {
+	return func(m *MediaUploadMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MediaUpload
+		)
+		m.oldValue = func(ctx context.Context) (*MediaUpload, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MediaUpload.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMediaUpload sets the old MediaUpload of the mutation.
+func withMediaUpload(node *MediaUpload) mediauploadOption {
+	return func(m *MediaUploadMutation) {
+		m.oldValue = func(context.Context) (*MediaUpload, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaUploadMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaUploadMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MediaUpload entities.
+func (m *MediaUploadMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaUploadMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaUploadMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MediaUpload.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMediaID sets the "media_id" field.
+func (m *MediaUploadMutation) SetMediaID(u uuid.UUID) {
+	m.media = &u
+}
+
+// MediaID returns the value of the "media_id" field in the mutation.
+func (m *MediaUploadMutation) MediaID() (r uuid.UUID, exists bool) {
+	v := m.media
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMediaID returns the old "media_id" field's value of the MediaUpload entity.
+// If the MediaUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaUploadMutation) OldMediaID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMediaID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMediaID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMediaID: %w", err)
+	}
+	return oldValue.MediaID, nil
+}
+
+// ResetMediaID resets all changes to the "media_id" field.
+func (m *MediaUploadMutation) ResetMediaID() {
+	m.media = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MediaUploadMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MediaUploadMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MediaUpload entity.
+// If the MediaUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaUploadMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MediaUploadMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetUploadedAt sets the "uploaded_at" field.
+func (m *MediaUploadMutation) SetUploadedAt(t time.Time) {
+	m.uploaded_at = &t
+}
+
+// UploadedAt returns the value of the "uploaded_at" field in the mutation.
+func (m *MediaUploadMutation) UploadedAt() (r time.Time, exists bool) {
+	v := m.uploaded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUploadedAt returns the old "uploaded_at" field's value of the MediaUpload entity.
+// If the MediaUpload object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaUploadMutation) OldUploadedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUploadedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUploadedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUploadedAt: %w", err)
+	}
+	return oldValue.UploadedAt, nil
+}
+
+// ResetUploadedAt resets all changes to the "uploaded_at" field.
+func (m *MediaUploadMutation) ResetUploadedAt() {
+	m.uploaded_at = nil
+}
+
+// ClearMedia clears the "media" edge to the Media entity.
+func (m *MediaUploadMutation) ClearMedia() {
+	m.clearedmedia = true
+	m.clearedFields[mediaupload.FieldMediaID] = struct{}{}
+}
+
+// MediaCleared reports if the "media" edge to the Media entity was cleared.
+func (m *MediaUploadMutation) MediaCleared() bool {
+	return m.clearedmedia
+}
+
+// MediaIDs returns the "media" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MediaID instead. It exists only for internal usage by the builders.
+func (m *MediaUploadMutation) MediaIDs() (ids []uuid.UUID) {
+	if id := m.media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMedia resets all changes to the "media" edge.
+func (m *MediaUploadMutation) ResetMedia() {
+	m.media = nil
+	m.clearedmedia = false
+}
+
+// Where appends a list predicates to the MediaUploadMutation builder.
+func (m *MediaUploadMutation) Where(ps ...predicate.MediaUpload) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaUploadMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaUploadMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MediaUpload, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaUploadMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaUploadMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MediaUpload).
+func (m *MediaUploadMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaUploadMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.media != nil {
+		fields = append(fields, mediaupload.FieldMediaID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, mediaupload.FieldUserID)
+	}
+	if m.uploaded_at != nil {
+		fields = append(fields, mediaupload.FieldUploadedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaUploadMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case mediaupload.FieldMediaID:
+		return m.MediaID()
+	case mediaupload.FieldUserID:
+		return m.UserID()
+	case mediaupload.FieldUploadedAt:
+		return m.UploadedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaUploadMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case mediaupload.FieldMediaID:
+		return m.OldMediaID(ctx)
+	case mediaupload.FieldUserID:
+		return m.OldUserID(ctx)
+	case mediaupload.FieldUploadedAt:
+		return m.OldUploadedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MediaUpload field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaUploadMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case mediaupload.FieldMediaID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMediaID(v)
+		return nil
+	case mediaupload.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case mediaupload.FieldUploadedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUploadedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MediaUpload field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaUploadMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaUploadMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaUploadMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MediaUpload numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaUploadMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaUploadMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaUploadMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MediaUpload nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaUploadMutation) ResetField(name string) error {
+	switch name {
+	case mediaupload.FieldMediaID:
+		m.ResetMediaID()
+		return nil
+	case mediaupload.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case mediaupload.FieldUploadedAt:
+		m.ResetUploadedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaUpload field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaUploadMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.media != nil {
+		edges = append(edges, mediaupload.EdgeMedia)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaUploadMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case mediaupload.EdgeMedia:
+		if id := m.media; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaUploadMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaUploadMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaUploadMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmedia {
+		edges = append(edges, mediaupload.EdgeMedia)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaUploadMutation) EdgeCleared(name string) bool {
+	switch name {
+	case mediaupload.EdgeMedia:
+		return m.clearedmedia
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaUploadMutation) ClearEdge(name string) error {
+	switch name {
+	case mediaupload.EdgeMedia:
+		m.ClearMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaUpload unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaUploadMutation) ResetEdge(name string) error {
+	switch name {
+	case mediaupload.EdgeMedia:
+		m.ResetMedia()
+		return nil
+	}
+	return fmt.Errorf("unknown MediaUpload edge %s", name)
+}
+
+// QuizMutation represents an operation that mutates the Quiz nodes in the graph.
+type QuizMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	created_by             *string
+	title                  *string
+	selection              *quiz.Selection
+	question_count         *int
+	addquestion_count      *int
+	adaptive               *bool
+	type_counts            *map[string]int
+	tags                   *[]string
+	appendtags             []string
+	choice_count           *int
+	addchoice_count        *int
+	seed                   *int64
+	addseed                *int64
+	flashcard_ids          *[]uuid.UUID
+	appendflashcard_ids    []uuid.UUID
+	time_limit_seconds     *int
+	addtime_limit_seconds  *int
+	opens_at               *time.Time
+	closes_at              *time.Time
+	max_attempts           *int
+	addmax_attempts        *int
+	review_feedback        *bool
+	slow_answer_seconds    *int
+	addslow_answer_seconds *int
+	shared                 *bool
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	collection             *uuid.UUID
+	clearedcollection      bool
+	attempts               map[uuid.UUID]struct{}
+	removedattempts        map[uuid.UUID]struct{}
+	clearedattempts        bool
+	item_statistics        map[uuid.UUID]struct{}
+	removeditem_statistics map[uuid.UUID]struct{}
+	cleareditem_statistics bool
+	done                   bool
+	oldValue               func(context.Context) (*Quiz, error)
+	predicates             []predicate.Quiz
+}
+
+var _ ent.Mutation = (*QuizMutation)(nil)
+
+// quizOption allows management of the mutation configuration using functional options.
+type quizOption func(*QuizMutation)
+
+// newQuizMutation creates new mutation for the Quiz entity.
+func newQuizMutation(c config, op Op, opts ...quizOption) *QuizMutation {
+	m := &QuizMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuiz,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizID sets the ID field of the mutation.
+func withQuizID(id uuid.UUID) quizOption {
+	return func(m *QuizMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Quiz
+		)
+		m.oldValue = func(ctx context.Context) (*Quiz, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Quiz.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuiz sets the old Quiz of the mutation.
+func withQuiz(node *Quiz) quizOption {
+	return func(m *QuizMutation) {
+		m.oldValue = func(context.Context) (*Quiz, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Quiz entities.
+func (m *QuizMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Quiz.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (m *QuizMutation) SetCollectionID(u uuid.UUID) {
+	m.collection = &u
+}
+
+// CollectionID returns the value of the "collection_id" field in the mutation.
+func (m *QuizMutation) CollectionID() (r uuid.UUID, exists bool) {
+	v := m.collection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCollectionID returns the old "collection_id" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldCollectionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCollectionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCollectionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCollectionID: %w", err)
+	}
+	return oldValue.CollectionID, nil
+}
+
+// ResetCollectionID resets all changes to the "collection_id" field.
+func (m *QuizMutation) ResetCollectionID() {
+	m.collection = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *QuizMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *QuizMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *QuizMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *QuizMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *QuizMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *QuizMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[quiz.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *QuizMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *QuizMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, quiz.FieldTitle)
+}
+
+// SetSelection sets the "selection" field.
+func (m *QuizMutation) SetSelection(q quiz.Selection) {
+	m.selection = &q
+}
+
+// Selection returns the value of the "selection" field in the mutation.
+func (m *QuizMutation) Selection() (r quiz.Selection, exists bool) {
+	v := m.selection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelection returns the old "selection" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldSelection(ctx context.Context) (v quiz.Selection, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelection: %w", err)
+	}
+	return oldValue.Selection, nil
+}
+
+// ResetSelection resets all changes to the "selection" field.
+func (m *QuizMutation) ResetSelection() {
+	m.selection = nil
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (m *QuizMutation) SetQuestionCount(i int) {
+	m.question_count = &i
+	m.addquestion_count = nil
+}
+
+// QuestionCount returns the value of the "question_count" field in the mutation.
+func (m *QuizMutation) QuestionCount() (r int, exists bool) {
+	v := m.question_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionCount returns the old "question_count" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldQuestionCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionCount: %w", err)
+	}
+	return oldValue.QuestionCount, nil
+}
+
+// AddQuestionCount adds i to the "question_count" field.
+func (m *QuizMutation) AddQuestionCount(i int) {
+	if m.addquestion_count != nil {
+		*m.addquestion_count += i
+	} else {
+		m.addquestion_count = &i
+	}
+}
+
+// AddedQuestionCount returns the value that was added to the "question_count" field in this mutation.
+func (m *QuizMutation) AddedQuestionCount() (r int, exists bool) {
+	v := m.addquestion_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionCount resets all changes to the "question_count" field.
+func (m *QuizMutation) ResetQuestionCount() {
+	m.question_count = nil
+	m.addquestion_count = nil
+}
+
+// SetAdaptive sets the "adaptive" field.
+func (m *QuizMutation) SetAdaptive(b bool) {
+	m.adaptive = &b
+}
+
+// Adaptive returns the value of the "adaptive" field in the mutation.
+func (m *QuizMutation) Adaptive() (r bool, exists bool) {
+	v := m.adaptive
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdaptive returns the old "adaptive" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldAdaptive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdaptive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdaptive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdaptive: %w", err)
+	}
+	return oldValue.Adaptive, nil
+}
+
+// ResetAdaptive resets all changes to the "adaptive" field.
+func (m *QuizMutation) ResetAdaptive() {
+	m.adaptive = nil
+}
+
+// SetTypeCounts sets the "type_counts" field.
+func (m *QuizMutation) SetTypeCounts(value map[string]int) {
+	m.type_counts = &value
+}
+
+// TypeCounts returns the value of the "type_counts" field in the mutation.
+func (m *QuizMutation) TypeCounts() (r map[string]int, exists bool) {
+	v := m.type_counts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypeCounts returns the old "type_counts" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTypeCounts(ctx context.Context) (v map[string]int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypeCounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypeCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypeCounts: %w", err)
+	}
+	return oldValue.TypeCounts, nil
+}
+
+// ClearTypeCounts clears the value of the "type_counts" field.
+func (m *QuizMutation) ClearTypeCounts() {
+	m.type_counts = nil
+	m.clearedFields[quiz.FieldTypeCounts] = struct{}{}
+}
+
+// TypeCountsCleared returns if the "type_counts" field was cleared in this mutation.
+func (m *QuizMutation) TypeCountsCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldTypeCounts]
+	return ok
+}
+
+// ResetTypeCounts resets all changes to the "type_counts" field.
+func (m *QuizMutation) ResetTypeCounts() {
+	m.type_counts = nil
+	delete(m.clearedFields, quiz.FieldTypeCounts)
+}
+
+// SetTags sets the "tags" field.
+func (m *QuizMutation) SetTags(s []string) {
+	m.tags = &s
+	m.appendtags = nil
+}
+
+// Tags returns the value of the "tags" field in the mutation.
+func (m *QuizMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTags returns the old "tags" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTags(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTags is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTags requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTags: %w", err)
+	}
+	return oldValue.Tags, nil
+}
+
+// AppendTags adds s to the "tags" field.
+func (m *QuizMutation) AppendTags(s []string) {
+	m.appendtags = append(m.appendtags, s...)
+}
+
+// AppendedTags returns the list of values that were appended to the "tags" field in this mutation.
+func (m *QuizMutation) AppendedTags() ([]string, bool) {
+	if len(m.appendtags) == 0 {
+		return nil, false
+	}
+	return m.appendtags, true
+}
+
+// ClearTags clears the value of the "tags" field.
+func (m *QuizMutation) ClearTags() {
+	m.tags = nil
+	m.appendtags = nil
+	m.clearedFields[quiz.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the "tags" field was cleared in this mutation.
+func (m *QuizMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldTags]
+	return ok
+}
+
+// ResetTags resets all changes to the "tags" field.
+func (m *QuizMutation) ResetTags() {
+	m.tags = nil
+	m.appendtags = nil
+	delete(m.clearedFields, quiz.FieldTags)
+}
+
+// SetChoiceCount sets the "choice_count" field.
+func (m *QuizMutation) SetChoiceCount(i int) {
+	m.choice_count = &i
+	m.addchoice_count = nil
+}
+
+// ChoiceCount returns the value of the "choice_count" field in the mutation.
+func (m *QuizMutation) ChoiceCount() (r int, exists bool) {
+	v := m.choice_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoiceCount returns the old "choice_count" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldChoiceCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoiceCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoiceCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoiceCount: %w", err)
+	}
+	return oldValue.ChoiceCount, nil
+}
+
+// AddChoiceCount adds i to the "choice_count" field.
+func (m *QuizMutation) AddChoiceCount(i int) {
+	if m.addchoice_count != nil {
+		*m.addchoice_count += i
+	} else {
+		m.addchoice_count = &i
+	}
+}
+
+// AddedChoiceCount returns the value that was added to the "choice_count" field in this mutation.
+func (m *QuizMutation) AddedChoiceCount() (r int, exists bool) {
+	v := m.addchoice_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChoiceCount resets all changes to the "choice_count" field.
+func (m *QuizMutation) ResetChoiceCount() {
+	m.choice_count = nil
+	m.addchoice_count = nil
+}
+
+// SetSeed sets the "seed" field.
+func (m *QuizMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *QuizMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *QuizMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *QuizMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *QuizMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetFlashcardIds sets the "flashcard_ids" field.
+func (m *QuizMutation) SetFlashcardIds(u []uuid.UUID) {
+	m.flashcard_ids = &u
+	m.appendflashcard_ids = nil
+}
+
+// FlashcardIds returns the value of the "flashcard_ids" field in the mutation.
+func (m *QuizMutation) FlashcardIds() (r []uuid.UUID, exists bool) {
+	v := m.flashcard_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardIds returns the old "flashcard_ids" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldFlashcardIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardIds: %w", err)
+	}
+	return oldValue.FlashcardIds, nil
+}
+
+// AppendFlashcardIds adds u to the "flashcard_ids" field.
+func (m *QuizMutation) AppendFlashcardIds(u []uuid.UUID) {
+	m.appendflashcard_ids = append(m.appendflashcard_ids, u...)
+}
+
+// AppendedFlashcardIds returns the list of values that were appended to the "flashcard_ids" field in this mutation.
+func (m *QuizMutation) AppendedFlashcardIds() ([]uuid.UUID, bool) {
+	if len(m.appendflashcard_ids) == 0 {
+		return nil, false
+	}
+	return m.appendflashcard_ids, true
+}
+
+// ResetFlashcardIds resets all changes to the "flashcard_ids" field.
+func (m *QuizMutation) ResetFlashcardIds() {
+	m.flashcard_ids = nil
+	m.appendflashcard_ids = nil
+}
+
+// SetTimeLimitSeconds sets the "time_limit_seconds" field.
+func (m *QuizMutation) SetTimeLimitSeconds(i int) {
+	m.time_limit_seconds = &i
+	m.addtime_limit_seconds = nil
+}
+
+// TimeLimitSeconds returns the value of the "time_limit_seconds" field in the mutation.
+func (m *QuizMutation) TimeLimitSeconds() (r int, exists bool) {
+	v := m.time_limit_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeLimitSeconds returns the old "time_limit_seconds" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTimeLimitSeconds(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeLimitSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeLimitSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeLimitSeconds: %w", err)
+	}
+	return oldValue.TimeLimitSeconds, nil
+}
+
+// AddTimeLimitSeconds adds i to the "time_limit_seconds" field.
+func (m *QuizMutation) AddTimeLimitSeconds(i int) {
+	if m.addtime_limit_seconds != nil {
+		*m.addtime_limit_seconds += i
+	} else {
+		m.addtime_limit_seconds = &i
+	}
+}
+
+// AddedTimeLimitSeconds returns the value that was added to the "time_limit_seconds" field in this mutation.
+func (m *QuizMutation) AddedTimeLimitSeconds() (r int, exists bool) {
+	v := m.addtime_limit_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTimeLimitSeconds clears the value of the "time_limit_seconds" field.
+func (m *QuizMutation) ClearTimeLimitSeconds() {
+	m.time_limit_seconds = nil
+	m.addtime_limit_seconds = nil
+	m.clearedFields[quiz.FieldTimeLimitSeconds] = struct{}{}
+}
+
+// TimeLimitSecondsCleared returns if the "time_limit_seconds" field was cleared in this mutation.
+func (m *QuizMutation) TimeLimitSecondsCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldTimeLimitSeconds]
+	return ok
+}
+
+// ResetTimeLimitSeconds resets all changes to the "time_limit_seconds" field.
+func (m *QuizMutation) ResetTimeLimitSeconds() {
+	m.time_limit_seconds = nil
+	m.addtime_limit_seconds = nil
+	delete(m.clearedFields, quiz.FieldTimeLimitSeconds)
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (m *QuizMutation) SetOpensAt(t time.Time) {
+	m.opens_at = &t
+}
+
+// OpensAt returns the value of the "opens_at" field in the mutation.
+func (m *QuizMutation) OpensAt() (r time.Time, exists bool) {
+	v := m.opens_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpensAt returns the old "opens_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldOpensAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpensAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpensAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpensAt: %w", err)
+	}
+	return oldValue.OpensAt, nil
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (m *QuizMutation) ClearOpensAt() {
+	m.opens_at = nil
+	m.clearedFields[quiz.FieldOpensAt] = struct{}{}
+}
+
+// OpensAtCleared returns if the "opens_at" field was cleared in this mutation.
+func (m *QuizMutation) OpensAtCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldOpensAt]
+	return ok
+}
+
+// ResetOpensAt resets all changes to the "opens_at" field.
+func (m *QuizMutation) ResetOpensAt() {
+	m.opens_at = nil
+	delete(m.clearedFields, quiz.FieldOpensAt)
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (m *QuizMutation) SetClosesAt(t time.Time) {
+	m.closes_at = &t
+}
+
+// ClosesAt returns the value of the "closes_at" field in the mutation.
+func (m *QuizMutation) ClosesAt() (r time.Time, exists bool) {
+	v := m.closes_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosesAt returns the old "closes_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldClosesAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosesAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosesAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosesAt: %w", err)
+	}
+	return oldValue.ClosesAt, nil
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (m *QuizMutation) ClearClosesAt() {
+	m.closes_at = nil
+	m.clearedFields[quiz.FieldClosesAt] = struct{}{}
+}
+
+// ClosesAtCleared returns if the "closes_at" field was cleared in this mutation.
+func (m *QuizMutation) ClosesAtCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldClosesAt]
+	return ok
+}
+
+// ResetClosesAt resets all changes to the "closes_at" field.
+func (m *QuizMutation) ResetClosesAt() {
+	m.closes_at = nil
+	delete(m.clearedFields, quiz.FieldClosesAt)
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (m *QuizMutation) SetMaxAttempts(i int) {
+	m.max_attempts = &i
+	m.addmax_attempts = nil
+}
+
+// MaxAttempts returns the value of the "max_attempts" field in the mutation.
+func (m *QuizMutation) MaxAttempts() (r int, exists bool) {
+	v := m.max_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAttempts returns the old "max_attempts" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldMaxAttempts(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAttempts: %w", err)
+	}
+	return oldValue.MaxAttempts, nil
+}
+
+// AddMaxAttempts adds i to the "max_attempts" field.
+func (m *QuizMutation) AddMaxAttempts(i int) {
+	if m.addmax_attempts != nil {
+		*m.addmax_attempts += i
+	} else {
+		m.addmax_attempts = &i
+	}
+}
+
+// AddedMaxAttempts returns the value that was added to the "max_attempts" field in this mutation.
+func (m *QuizMutation) AddedMaxAttempts() (r int, exists bool) {
+	v := m.addmax_attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (m *QuizMutation) ClearMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+	m.clearedFields[quiz.FieldMaxAttempts] = struct{}{}
+}
+
+// MaxAttemptsCleared returns if the "max_attempts" field was cleared in this mutation.
+func (m *QuizMutation) MaxAttemptsCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldMaxAttempts]
+	return ok
+}
+
+// ResetMaxAttempts resets all changes to the "max_attempts" field.
+func (m *QuizMutation) ResetMaxAttempts() {
+	m.max_attempts = nil
+	m.addmax_attempts = nil
+	delete(m.clearedFields, quiz.FieldMaxAttempts)
+}
+
+// SetReviewFeedback sets the "review_feedback" field.
+func (m *QuizMutation) SetReviewFeedback(b bool) {
+	m.review_feedback = &b
+}
+
+// ReviewFeedback returns the value of the "review_feedback" field in the mutation.
+func (m *QuizMutation) ReviewFeedback() (r bool, exists bool) {
+	v := m.review_feedback
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewFeedback returns the old "review_feedback" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldReviewFeedback(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewFeedback is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewFeedback requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewFeedback: %w", err)
+	}
+	return oldValue.ReviewFeedback, nil
+}
+
+// ResetReviewFeedback resets all changes to the "review_feedback" field.
+func (m *QuizMutation) ResetReviewFeedback() {
+	m.review_feedback = nil
+}
+
+// SetSlowAnswerSeconds sets the "slow_answer_seconds" field.
+func (m *QuizMutation) SetSlowAnswerSeconds(i int) {
+	m.slow_answer_seconds = &i
+	m.addslow_answer_seconds = nil
+}
+
+// SlowAnswerSeconds returns the value of the "slow_answer_seconds" field in the mutation.
+func (m *QuizMutation) SlowAnswerSeconds() (r int, exists bool) {
+	v := m.slow_answer_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlowAnswerSeconds returns the old "slow_answer_seconds" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldSlowAnswerSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlowAnswerSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlowAnswerSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlowAnswerSeconds: %w", err)
+	}
+	return oldValue.SlowAnswerSeconds, nil
+}
+
+// AddSlowAnswerSeconds adds i to the "slow_answer_seconds" field.
+func (m *QuizMutation) AddSlowAnswerSeconds(i int) {
+	if m.addslow_answer_seconds != nil {
+		*m.addslow_answer_seconds += i
+	} else {
+		m.addslow_answer_seconds = &i
+	}
+}
+
+// AddedSlowAnswerSeconds returns the value that was added to the "slow_answer_seconds" field in this mutation.
+func (m *QuizMutation) AddedSlowAnswerSeconds() (r int, exists bool) {
+	v := m.addslow_answer_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSlowAnswerSeconds resets all changes to the "slow_answer_seconds" field.
+func (m *QuizMutation) ResetSlowAnswerSeconds() {
+	m.slow_answer_seconds = nil
+	m.addslow_answer_seconds = nil
+}
+
+// SetShared sets the "shared" field.
+func (m *QuizMutation) SetShared(b bool) {
+	m.shared = &b
+}
+
+// Shared returns the value of the "shared" field in the mutation.
+func (m *QuizMutation) Shared() (r bool, exists bool) {
+	v := m.shared
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShared returns the old "shared" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldShared(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShared is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShared requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShared: %w", err)
+	}
+	return oldValue.Shared, nil
+}
+
+// ResetShared resets all changes to the "shared" field.
+func (m *QuizMutation) ResetShared() {
+	m.shared = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuizMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuizMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuizMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *QuizMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *QuizMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *QuizMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (m *QuizMutation) ClearCollection() {
+	m.clearedcollection = true
+	m.clearedFields[quiz.FieldCollectionID] = struct{}{}
+}
+
+// CollectionCleared reports if the "collection" edge to the Collection entity was cleared.
+func (m *QuizMutation) CollectionCleared() bool {
+	return m.clearedcollection
+}
+
+// CollectionIDs returns the "collection" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CollectionID instead. It exists only for internal usage by the builders.
+func (m *QuizMutation) CollectionIDs() (ids []uuid.UUID) {
+	if id := m.collection; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCollection resets all changes to the "collection" edge.
+func (m *QuizMutation) ResetCollection() {
+	m.collection = nil
+	m.clearedcollection = false
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by ids.
+func (m *QuizMutation) AddAttemptIDs(ids ...uuid.UUID) {
+	if m.attempts == nil {
+		m.attempts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attempts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttempts clears the "attempts" edge to the QuizAttempt entity.
+func (m *QuizMutation) ClearAttempts() {
+	m.clearedattempts = true
+}
+
+// AttemptsCleared reports if the "attempts" edge to the QuizAttempt entity was cleared.
+func (m *QuizMutation) AttemptsCleared() bool {
+	return m.clearedattempts
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to the QuizAttempt entity by IDs.
+func (m *QuizMutation) RemoveAttemptIDs(ids ...uuid.UUID) {
+	if m.removedattempts == nil {
+		m.removedattempts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attempts, ids[i])
+		m.removedattempts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttempts returns the removed IDs of the "attempts" edge to the QuizAttempt entity.
+func (m *QuizMutation) RemovedAttemptsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttemptsIDs returns the "attempts" edge IDs in the mutation.
+func (m *QuizMutation) AttemptsIDs() (ids []uuid.UUID) {
+	for id := range m.attempts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttempts resets all changes to the "attempts" edge.
+func (m *QuizMutation) ResetAttempts() {
+	m.attempts = nil
+	m.clearedattempts = false
+	m.removedattempts = nil
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by ids.
+func (m *QuizMutation) AddItemStatisticIDs(ids ...uuid.UUID) {
+	if m.item_statistics == nil {
+		m.item_statistics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.item_statistics[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItemStatistics clears the "item_statistics" edge to the ItemStatistic entity.
+func (m *QuizMutation) ClearItemStatistics() {
+	m.cleareditem_statistics = true
+}
+
+// ItemStatisticsCleared reports if the "item_statistics" edge to the ItemStatistic entity was cleared.
+func (m *QuizMutation) ItemStatisticsCleared() bool {
+	return m.cleareditem_statistics
+}
+
+// RemoveItemStatisticIDs removes the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (m *QuizMutation) RemoveItemStatisticIDs(ids ...uuid.UUID) {
+	if m.removeditem_statistics == nil {
+		m.removeditem_statistics = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.item_statistics, ids[i])
+		m.removeditem_statistics[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItemStatistics returns the removed IDs of the "item_statistics" edge to the ItemStatistic entity.
+func (m *QuizMutation) RemovedItemStatisticsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditem_statistics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemStatisticsIDs returns the "item_statistics" edge IDs in the mutation.
+func (m *QuizMutation) ItemStatisticsIDs() (ids []uuid.UUID) {
+	for id := range m.item_statistics {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItemStatistics resets all changes to the "item_statistics" edge.
+func (m *QuizMutation) ResetItemStatistics() {
+	m.item_statistics = nil
+	m.cleareditem_statistics = false
+	m.removeditem_statistics = nil
+}
+
+// Where appends a list predicates to the QuizMutation builder.
+func (m *QuizMutation) Where(ps ...predicate.Quiz) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Quiz, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Quiz).
+func (m *QuizMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.collection != nil {
+		fields = append(fields, quiz.FieldCollectionID)
+	}
+	if m.created_by != nil {
+		fields = append(fields, quiz.FieldCreatedBy)
+	}
+	if m.title != nil {
+		fields = append(fields, quiz.FieldTitle)
+	}
+	if m.selection != nil {
+		fields = append(fields, quiz.FieldSelection)
+	}
+	if m.question_count != nil {
+		fields = append(fields, quiz.FieldQuestionCount)
+	}
+	if m.adaptive != nil {
+		fields = append(fields, quiz.FieldAdaptive)
+	}
+	if m.type_counts != nil {
+		fields = append(fields, quiz.FieldTypeCounts)
+	}
+	if m.tags != nil {
+		fields = append(fields, quiz.FieldTags)
+	}
+	if m.choice_count != nil {
+		fields = append(fields, quiz.FieldChoiceCount)
+	}
+	if m.seed != nil {
+		fields = append(fields, quiz.FieldSeed)
+	}
+	if m.flashcard_ids != nil {
+		fields = append(fields, quiz.FieldFlashcardIds)
+	}
+	if m.time_limit_seconds != nil {
+		fields = append(fields, quiz.FieldTimeLimitSeconds)
+	}
+	if m.opens_at != nil {
+		fields = append(fields, quiz.FieldOpensAt)
+	}
+	if m.closes_at != nil {
+		fields = append(fields, quiz.FieldClosesAt)
+	}
+	if m.max_attempts != nil {
+		fields = append(fields, quiz.FieldMaxAttempts)
+	}
+	if m.review_feedback != nil {
+		fields = append(fields, quiz.FieldReviewFeedback)
+	}
+	if m.slow_answer_seconds != nil {
+		fields = append(fields, quiz.FieldSlowAnswerSeconds)
+	}
+	if m.shared != nil {
+		fields = append(fields, quiz.FieldShared)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quiz.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, quiz.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quiz.FieldCollectionID:
+		return m.CollectionID()
+	case quiz.FieldCreatedBy:
+		return m.CreatedBy()
+	case quiz.FieldTitle:
+		return m.Title()
+	case quiz.FieldSelection:
+		return m.Selection()
+	case quiz.FieldQuestionCount:
+		return m.QuestionCount()
+	case quiz.FieldAdaptive:
+		return m.Adaptive()
+	case quiz.FieldTypeCounts:
+		return m.TypeCounts()
+	case quiz.FieldTags:
+		return m.Tags()
+	case quiz.FieldChoiceCount:
+		return m.ChoiceCount()
+	case quiz.FieldSeed:
+		return m.Seed()
+	case quiz.FieldFlashcardIds:
+		return m.FlashcardIds()
+	case quiz.FieldTimeLimitSeconds:
+		return m.TimeLimitSeconds()
+	case quiz.FieldOpensAt:
+		return m.OpensAt()
+	case quiz.FieldClosesAt:
+		return m.ClosesAt()
+	case quiz.FieldMaxAttempts:
+		return m.MaxAttempts()
+	case quiz.FieldReviewFeedback:
+		return m.ReviewFeedback()
+	case quiz.FieldSlowAnswerSeconds:
+		return m.SlowAnswerSeconds()
+	case quiz.FieldShared:
+		return m.Shared()
+	case quiz.FieldCreatedAt:
+		return m.CreatedAt()
+	case quiz.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quiz.FieldCollectionID:
+		return m.OldCollectionID(ctx)
+	case quiz.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case quiz.FieldTitle:
+		return m.OldTitle(ctx)
+	case quiz.FieldSelection:
+		return m.OldSelection(ctx)
+	case quiz.FieldQuestionCount:
+		return m.OldQuestionCount(ctx)
+	case quiz.FieldAdaptive:
+		return m.OldAdaptive(ctx)
+	case quiz.FieldTypeCounts:
+		return m.OldTypeCounts(ctx)
+	case quiz.FieldTags:
+		return m.OldTags(ctx)
+	case quiz.FieldChoiceCount:
+		return m.OldChoiceCount(ctx)
+	case quiz.FieldSeed:
+		return m.OldSeed(ctx)
+	case quiz.FieldFlashcardIds:
+		return m.OldFlashcardIds(ctx)
+	case quiz.FieldTimeLimitSeconds:
+		return m.OldTimeLimitSeconds(ctx)
+	case quiz.FieldOpensAt:
+		return m.OldOpensAt(ctx)
+	case quiz.FieldClosesAt:
+		return m.OldClosesAt(ctx)
+	case quiz.FieldMaxAttempts:
+		return m.OldMaxAttempts(ctx)
+	case quiz.FieldReviewFeedback:
+		return m.OldReviewFeedback(ctx)
+	case quiz.FieldSlowAnswerSeconds:
+		return m.OldSlowAnswerSeconds(ctx)
+	case quiz.FieldShared:
+		return m.OldShared(ctx)
+	case quiz.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case quiz.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quiz.FieldCollectionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCollectionID(v)
+		return nil
+	case quiz.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case quiz.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case quiz.FieldSelection:
+		v, ok := value.(quiz.Selection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelection(v)
+		return nil
+	case quiz.FieldQuestionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionCount(v)
+		return nil
+	case quiz.FieldAdaptive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdaptive(v)
+		return nil
+	case quiz.FieldTypeCounts:
+		v, ok := value.(map[string]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypeCounts(v)
+		return nil
+	case quiz.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case quiz.FieldChoiceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoiceCount(v)
+		return nil
+	case quiz.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case quiz.FieldFlashcardIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardIds(v)
+		return nil
+	case quiz.FieldTimeLimitSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeLimitSeconds(v)
+		return nil
+	case quiz.FieldOpensAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpensAt(v)
+		return nil
+	case quiz.FieldClosesAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosesAt(v)
+		return nil
+	case quiz.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAttempts(v)
+		return nil
+	case quiz.FieldReviewFeedback:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewFeedback(v)
+		return nil
+	case quiz.FieldSlowAnswerSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlowAnswerSeconds(v)
+		return nil
+	case quiz.FieldShared:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShared(v)
+		return nil
+	case quiz.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case quiz.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuizMutation) AddedFields() []string {
+	var fields []string
+	if m.addquestion_count != nil {
+		fields = append(fields, quiz.FieldQuestionCount)
+	}
+	if m.addchoice_count != nil {
+		fields = append(fields, quiz.FieldChoiceCount)
+	}
+	if m.addseed != nil {
+		fields = append(fields, quiz.FieldSeed)
+	}
+	if m.addtime_limit_seconds != nil {
+		fields = append(fields, quiz.FieldTimeLimitSeconds)
+	}
+	if m.addmax_attempts != nil {
+		fields = append(fields, quiz.FieldMaxAttempts)
+	}
+	if m.addslow_answer_seconds != nil {
+		fields = append(fields, quiz.FieldSlowAnswerSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuizMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quiz.FieldQuestionCount:
+		return m.AddedQuestionCount()
+	case quiz.FieldChoiceCount:
+		return m.AddedChoiceCount()
+	case quiz.FieldSeed:
+		return m.AddedSeed()
+	case quiz.FieldTimeLimitSeconds:
+		return m.AddedTimeLimitSeconds()
+	case quiz.FieldMaxAttempts:
+		return m.AddedMaxAttempts()
+	case quiz.FieldSlowAnswerSeconds:
+		return m.AddedSlowAnswerSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quiz.FieldQuestionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionCount(v)
+		return nil
+	case quiz.FieldChoiceCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChoiceCount(v)
+		return nil
+	case quiz.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case quiz.FieldTimeLimitSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeLimitSeconds(v)
+		return nil
+	case quiz.FieldMaxAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAttempts(v)
+		return nil
+	case quiz.FieldSlowAnswerSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSlowAnswerSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuizMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quiz.FieldTitle) {
+		fields = append(fields, quiz.FieldTitle)
+	}
+	if m.FieldCleared(quiz.FieldTypeCounts) {
+		fields = append(fields, quiz.FieldTypeCounts)
+	}
+	if m.FieldCleared(quiz.FieldTags) {
+		fields = append(fields, quiz.FieldTags)
+	}
+	if m.FieldCleared(quiz.FieldTimeLimitSeconds) {
+		fields = append(fields, quiz.FieldTimeLimitSeconds)
+	}
+	if m.FieldCleared(quiz.FieldOpensAt) {
+		fields = append(fields, quiz.FieldOpensAt)
+	}
+	if m.FieldCleared(quiz.FieldClosesAt) {
+		fields = append(fields, quiz.FieldClosesAt)
+	}
+	if m.FieldCleared(quiz.FieldMaxAttempts) {
+		fields = append(fields, quiz.FieldMaxAttempts)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuizMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuizMutation) ClearField(name string) error {
+	switch name {
+	case quiz.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case quiz.FieldTypeCounts:
+		m.ClearTypeCounts()
+		return nil
+	case quiz.FieldTags:
+		m.ClearTags()
+		return nil
+	case quiz.FieldTimeLimitSeconds:
+		m.ClearTimeLimitSeconds()
+		return nil
+	case quiz.FieldOpensAt:
+		m.ClearOpensAt()
+		return nil
+	case quiz.FieldClosesAt:
+		m.ClearClosesAt()
+		return nil
+	case quiz.FieldMaxAttempts:
+		m.ClearMaxAttempts()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuizMutation) ResetField(name string) error {
+	switch name {
+	case quiz.FieldCollectionID:
+		m.ResetCollectionID()
+		return nil
+	case quiz.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case quiz.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case quiz.FieldSelection:
+		m.ResetSelection()
+		return nil
+	case quiz.FieldQuestionCount:
+		m.ResetQuestionCount()
+		return nil
+	case quiz.FieldAdaptive:
+		m.ResetAdaptive()
+		return nil
+	case quiz.FieldTypeCounts:
+		m.ResetTypeCounts()
+		return nil
+	case quiz.FieldTags:
+		m.ResetTags()
+		return nil
+	case quiz.FieldChoiceCount:
+		m.ResetChoiceCount()
+		return nil
+	case quiz.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case quiz.FieldFlashcardIds:
+		m.ResetFlashcardIds()
+		return nil
+	case quiz.FieldTimeLimitSeconds:
+		m.ResetTimeLimitSeconds()
+		return nil
+	case quiz.FieldOpensAt:
+		m.ResetOpensAt()
+		return nil
+	case quiz.FieldClosesAt:
+		m.ResetClosesAt()
+		return nil
+	case quiz.FieldMaxAttempts:
+		m.ResetMaxAttempts()
+		return nil
+	case quiz.FieldReviewFeedback:
+		m.ResetReviewFeedback()
+		return nil
+	case quiz.FieldSlowAnswerSeconds:
+		m.ResetSlowAnswerSeconds()
+		return nil
+	case quiz.FieldShared:
+		m.ResetShared()
+		return nil
+	case quiz.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case quiz.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuizMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.collection != nil {
+		edges = append(edges, quiz.EdgeCollection)
+	}
+	if m.attempts != nil {
+		edges = append(edges, quiz.EdgeAttempts)
+	}
+	if m.item_statistics != nil {
+		edges = append(edges, quiz.EdgeItemStatistics)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuizMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case quiz.EdgeCollection:
+		if id := m.collection; id != nil {
+			return []ent.Value{*id}
+		}
+	case quiz.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.attempts))
+		for id := range m.attempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeItemStatistics:
+		ids := make([]ent.Value, 0, len(m.item_statistics))
+		for id := range m.item_statistics {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuizMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedattempts != nil {
+		edges = append(edges, quiz.EdgeAttempts)
+	}
+	if m.removeditem_statistics != nil {
+		edges = append(edges, quiz.EdgeItemStatistics)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuizMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case quiz.EdgeAttempts:
+		ids := make([]ent.Value, 0, len(m.removedattempts))
+		for id := range m.removedattempts {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeItemStatistics:
+		ids := make([]ent.Value, 0, len(m.removeditem_statistics))
+		for id := range m.removeditem_statistics {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuizMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcollection {
+		edges = append(edges, quiz.EdgeCollection)
+	}
+	if m.clearedattempts {
+		edges = append(edges, quiz.EdgeAttempts)
+	}
+	if m.cleareditem_statistics {
+		edges = append(edges, quiz.EdgeItemStatistics)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuizMutation) EdgeCleared(name string) bool {
+	switch name {
+	case quiz.EdgeCollection:
+		return m.clearedcollection
+	case quiz.EdgeAttempts:
+		return m.clearedattempts
+	case quiz.EdgeItemStatistics:
+		return m.cleareditem_statistics
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuizMutation) ClearEdge(name string) error {
+	switch name {
+	case quiz.EdgeCollection:
+		m.ClearCollection()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuizMutation) ResetEdge(name string) error {
+	switch name {
+	case quiz.EdgeCollection:
+		m.ResetCollection()
+		return nil
+	case quiz.EdgeAttempts:
+		m.ResetAttempts()
+		return nil
+	case quiz.EdgeItemStatistics:
+		m.ResetItemStatistics()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz edge %s", name)
+}
+
+// QuizAnswerMutation represents an operation that mutates the QuizAnswer nodes in the graph.
+type QuizAnswerMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	position         *int
+	addposition      *int
+	options          *[]string
+	appendoptions    []string
+	response         **schema.QuizResponse
+	answered_at      *time.Time
+	correct          *bool
+	score            *float64
+	addscore         *float64
+	time_spent_ms    *int
+	addtime_spent_ms *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	attempt          *uuid.UUID
+	clearedattempt   bool
+	flashcard        *uuid.UUID
+	clearedflashcard bool
+	done             bool
+	oldValue         func(context.Context) (*QuizAnswer, error)
+	predicates       []predicate.QuizAnswer
+}
+
+var _ ent.Mutation = (*QuizAnswerMutation)(nil)
+
+// quizanswerOption allows management of the mutation configuration using functional options.
+type quizanswerOption func(*QuizAnswerMutation)
+
+// newQuizAnswerMutation creates new mutation for the QuizAnswer entity.
+func newQuizAnswerMutation(c config, op Op, opts ...quizanswerOption) *QuizAnswerMutation {
+	m := &QuizAnswerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuizAnswer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizAnswerID sets the ID field of the mutation.
+func withQuizAnswerID(id uuid.UUID) quizanswerOption {
+	return func(m *QuizAnswerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuizAnswer
+		)
+		m.oldValue = func(ctx context.Context) (*QuizAnswer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuizAnswer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuizAnswer sets the old QuizAnswer of the mutation.
+func withQuizAnswer(node *QuizAnswer) quizanswerOption {
+	return func(m *QuizAnswerMutation) {
+		m.oldValue = func(context.Context) (*QuizAnswer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizAnswerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizAnswerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of QuizAnswer entities.
+func (m *QuizAnswerMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizAnswerMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizAnswerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuizAnswer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (m *QuizAnswerMutation) SetAttemptID(u uuid.UUID) {
+	m.attempt = &u
+}
+
+// AttemptID returns the value of the "attempt_id" field in the mutation.
+func (m *QuizAnswerMutation) AttemptID() (r uuid.UUID, exists bool) {
+	v := m.attempt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttemptID returns the old "attempt_id" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldAttemptID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttemptID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttemptID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttemptID: %w", err)
+	}
+	return oldValue.AttemptID, nil
+}
+
+// ResetAttemptID resets all changes to the "attempt_id" field.
+func (m *QuizAnswerMutation) ResetAttemptID() {
+	m.attempt = nil
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (m *QuizAnswerMutation) SetFlashcardID(u uuid.UUID) {
+	m.flashcard = &u
+}
+
+// FlashcardID returns the value of the "flashcard_id" field in the mutation.
+func (m *QuizAnswerMutation) FlashcardID() (r uuid.UUID, exists bool) {
+	v := m.flashcard
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlashcardID returns the old "flashcard_id" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+	}
+	return oldValue.FlashcardID, nil
+}
+
+// ResetFlashcardID resets all changes to the "flashcard_id" field.
+func (m *QuizAnswerMutation) ResetFlashcardID() {
+	m.flashcard = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *QuizAnswerMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *QuizAnswerMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *QuizAnswerMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *QuizAnswerMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *QuizAnswerMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *QuizAnswerMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *QuizAnswerMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *QuizAnswerMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *QuizAnswerMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *QuizAnswerMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[quizanswer.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *QuizAnswerMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[quizanswer.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *QuizAnswerMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, quizanswer.FieldOptions)
+}
+
+// SetResponse sets the "response" field.
+func (m *QuizAnswerMutation) SetResponse(sr *schema.QuizResponse) {
+	m.response = &sr
+}
+
+// Response returns the value of the "response" field in the mutation.
+func (m *QuizAnswerMutation) Response() (r *schema.QuizResponse, exists bool) {
+	v := m.response
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponse returns the old "response" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldResponse(ctx context.Context) (v *schema.QuizResponse, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponse: %w", err)
+	}
+	return oldValue.Response, nil
+}
+
+// ClearResponse clears the value of the "response" field.
+func (m *QuizAnswerMutation) ClearResponse() {
+	m.response = nil
+	m.clearedFields[quizanswer.FieldResponse] = struct{}{}
+}
+
+// ResponseCleared returns if the "response" field was cleared in this mutation.
+func (m *QuizAnswerMutation) ResponseCleared() bool {
+	_, ok := m.clearedFields[quizanswer.FieldResponse]
+	return ok
+}
+
+// ResetResponse resets all changes to the "response" field.
+func (m *QuizAnswerMutation) ResetResponse() {
+	m.response = nil
+	delete(m.clearedFields, quizanswer.FieldResponse)
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (m *QuizAnswerMutation) SetAnsweredAt(t time.Time) {
+	m.answered_at = &t
+}
+
+// AnsweredAt returns the value of the "answered_at" field in the mutation.
+func (m *QuizAnswerMutation) AnsweredAt() (r time.Time, exists bool) {
+	v := m.answered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnsweredAt returns the old "answered_at" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldAnsweredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnsweredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnsweredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnsweredAt: %w", err)
+	}
+	return oldValue.AnsweredAt, nil
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (m *QuizAnswerMutation) ClearAnsweredAt() {
+	m.answered_at = nil
+	m.clearedFields[quizanswer.FieldAnsweredAt] = struct{}{}
+}
+
+// AnsweredAtCleared returns if the "answered_at" field was cleared in this mutation.
+func (m *QuizAnswerMutation) AnsweredAtCleared() bool {
+	_, ok := m.clearedFields[quizanswer.FieldAnsweredAt]
+	return ok
+}
+
+// ResetAnsweredAt resets all changes to the "answered_at" field.
+func (m *QuizAnswerMutation) ResetAnsweredAt() {
+	m.answered_at = nil
+	delete(m.clearedFields, quizanswer.FieldAnsweredAt)
+}
+
+// SetCorrect sets the "correct" field.
+func (m *QuizAnswerMutation) SetCorrect(b bool) {
+	m.correct = &b
+}
+
+// Correct returns the value of the "correct" field in the mutation.
+func (m *QuizAnswerMutation) Correct() (r bool, exists bool) {
+	v := m.correct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrect returns the old "correct" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldCorrect(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrect: %w", err)
+	}
+	return oldValue.Correct, nil
+}
+
+// ResetCorrect resets all changes to the "correct" field.
+func (m *QuizAnswerMutation) ResetCorrect() {
+	m.correct = nil
+}
+
+// SetScore sets the "score" field.
+func (m *QuizAnswerMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *QuizAnswerMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *QuizAnswerMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *QuizAnswerMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *QuizAnswerMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (m *QuizAnswerMutation) SetTimeSpentMs(i int) {
+	m.time_spent_ms = &i
+	m.addtime_spent_ms = nil
+}
+
+// TimeSpentMs returns the value of the "time_spent_ms" field in the mutation.
+func (m *QuizAnswerMutation) TimeSpentMs() (r int, exists bool) {
+	v := m.time_spent_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeSpentMs returns the old "time_spent_ms" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldTimeSpentMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeSpentMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeSpentMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeSpentMs: %w", err)
+	}
+	return oldValue.TimeSpentMs, nil
+}
+
+// AddTimeSpentMs adds i to the "time_spent_ms" field.
+func (m *QuizAnswerMutation) AddTimeSpentMs(i int) {
+	if m.addtime_spent_ms != nil {
+		*m.addtime_spent_ms += i
+	} else {
+		m.addtime_spent_ms = &i
+	}
+}
+
+// AddedTimeSpentMs returns the value that was added to the "time_spent_ms" field in this mutation.
+func (m *QuizAnswerMutation) AddedTimeSpentMs() (r int, exists bool) {
+	v := m.addtime_spent_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeSpentMs resets all changes to the "time_spent_ms" field.
+func (m *QuizAnswerMutation) ResetTimeSpentMs() {
+	m.time_spent_ms = nil
+	m.addtime_spent_ms = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuizAnswerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuizAnswerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QuizAnswer entity.
+// If the QuizAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAnswerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuizAnswerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (m *QuizAnswerMutation) ClearAttempt() {
+	m.clearedattempt = true
+	m.clearedFields[quizanswer.FieldAttemptID] = struct{}{}
+}
+
+// AttemptCleared reports if the "attempt" edge to the QuizAttempt entity was cleared.
+func (m *QuizAnswerMutation) AttemptCleared() bool {
+	return m.clearedattempt
+}
+
+// AttemptIDs returns the "attempt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttemptID instead. It exists only for internal usage by the builders.
+func (m *QuizAnswerMutation) AttemptIDs() (ids []uuid.UUID) {
+	if id := m.attempt; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAttempt resets all changes to the "attempt" edge.
+func (m *QuizAnswerMutation) ResetAttempt() {
+	m.attempt = nil
+	m.clearedattempt = false
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (m *QuizAnswerMutation) ClearFlashcard() {
+	m.clearedflashcard = true
+	m.clearedFields[quizanswer.FieldFlashcardID] = struct{}{}
+}
+
+// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
+func (m *QuizAnswerMutation) FlashcardCleared() bool {
+	return m.clearedflashcard
+}
+
+// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlashcardID instead. It exists only for internal usage by the builders.
+func (m *QuizAnswerMutation) FlashcardIDs() (ids []uuid.UUID) {
+	if id := m.flashcard; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlashcard resets all changes to the "flashcard" edge.
+func (m *QuizAnswerMutation) ResetFlashcard() {
+	m.flashcard = nil
+	m.clearedflashcard = false
+}
+
+// Where appends a list predicates to the QuizAnswerMutation builder.
+func (m *QuizAnswerMutation) Where(ps ...predicate.QuizAnswer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizAnswerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizAnswerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuizAnswer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizAnswerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizAnswerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuizAnswer).
+func (m *QuizAnswerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizAnswerMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.attempt != nil {
+		fields = append(fields, quizanswer.FieldAttemptID)
+	}
+	if m.flashcard != nil {
+		fields = append(fields, quizanswer.FieldFlashcardID)
+	}
+	if m.position != nil {
+		fields = append(fields, quizanswer.FieldPosition)
+	}
+	if m.options != nil {
+		fields = append(fields, quizanswer.FieldOptions)
+	}
+	if m.response != nil {
+		fields = append(fields, quizanswer.FieldResponse)
+	}
+	if m.answered_at != nil {
+		fields = append(fields, quizanswer.FieldAnsweredAt)
+	}
+	if m.correct != nil {
+		fields = append(fields, quizanswer.FieldCorrect)
+	}
+	if m.score != nil {
+		fields = append(fields, quizanswer.FieldScore)
+	}
+	if m.time_spent_ms != nil {
+		fields = append(fields, quizanswer.FieldTimeSpentMs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quizanswer.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizAnswerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quizanswer.FieldAttemptID:
+		return m.AttemptID()
+	case quizanswer.FieldFlashcardID:
+		return m.FlashcardID()
+	case quizanswer.FieldPosition:
+		return m.Position()
+	case quizanswer.FieldOptions:
+		return m.Options()
+	case quizanswer.FieldResponse:
+		return m.Response()
+	case quizanswer.FieldAnsweredAt:
+		return m.AnsweredAt()
+	case quizanswer.FieldCorrect:
+		return m.Correct()
+	case quizanswer.FieldScore:
+		return m.Score()
+	case quizanswer.FieldTimeSpentMs:
+		return m.TimeSpentMs()
+	case quizanswer.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizAnswerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quizanswer.FieldAttemptID:
+		return m.OldAttemptID(ctx)
+	case quizanswer.FieldFlashcardID:
+		return m.OldFlashcardID(ctx)
+	case quizanswer.FieldPosition:
+		return m.OldPosition(ctx)
+	case quizanswer.FieldOptions:
+		return m.OldOptions(ctx)
+	case quizanswer.FieldResponse:
+		return m.OldResponse(ctx)
+	case quizanswer.FieldAnsweredAt:
+		return m.OldAnsweredAt(ctx)
+	case quizanswer.FieldCorrect:
+		return m.OldCorrect(ctx)
+	case quizanswer.FieldScore:
+		return m.OldScore(ctx)
+	case quizanswer.FieldTimeSpentMs:
+		return m.OldTimeSpentMs(ctx)
+	case quizanswer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuizAnswer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizAnswerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quizanswer.FieldAttemptID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttemptID(v)
+		return nil
+	case quizanswer.FieldFlashcardID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlashcardID(v)
+		return nil
+	case quizanswer.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case quizanswer.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case quizanswer.FieldResponse:
+		v, ok := value.(*schema.QuizResponse)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponse(v)
+		return nil
+	case quizanswer.FieldAnsweredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnsweredAt(v)
+		return nil
+	case quizanswer.FieldCorrect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrect(v)
+		return nil
+	case quizanswer.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case quizanswer.FieldTimeSpentMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeSpentMs(v)
+		return nil
+	case quizanswer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAnswer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuizAnswerMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, quizanswer.FieldPosition)
+	}
+	if m.addscore != nil {
+		fields = append(fields, quizanswer.FieldScore)
+	}
+	if m.addtime_spent_ms != nil {
+		fields = append(fields, quizanswer.FieldTimeSpentMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuizAnswerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quizanswer.FieldPosition:
+		return m.AddedPosition()
+	case quizanswer.FieldScore:
+		return m.AddedScore()
+	case quizanswer.FieldTimeSpentMs:
+		return m.AddedTimeSpentMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizAnswerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quizanswer.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case quizanswer.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case quizanswer.FieldTimeSpentMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeSpentMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAnswer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuizAnswerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quizanswer.FieldOptions) {
+		fields = append(fields, quizanswer.FieldOptions)
+	}
+	if m.FieldCleared(quizanswer.FieldResponse) {
+		fields = append(fields, quizanswer.FieldResponse)
+	}
+	if m.FieldCleared(quizanswer.FieldAnsweredAt) {
+		fields = append(fields, quizanswer.FieldAnsweredAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuizAnswerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuizAnswerMutation) ClearField(name string) error {
+	switch name {
+	case quizanswer.FieldOptions:
+		m.ClearOptions()
+		return nil
+	case quizanswer.FieldResponse:
+		m.ClearResponse()
+		return nil
+	case quizanswer.FieldAnsweredAt:
+		m.ClearAnsweredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAnswer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuizAnswerMutation) ResetField(name string) error {
+	switch name {
+	case quizanswer.FieldAttemptID:
+		m.ResetAttemptID()
+		return nil
+	case quizanswer.FieldFlashcardID:
+		m.ResetFlashcardID()
+		return nil
+	case quizanswer.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case quizanswer.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case quizanswer.FieldResponse:
+		m.ResetResponse()
+		return nil
+	case quizanswer.FieldAnsweredAt:
+		m.ResetAnsweredAt()
+		return nil
+	case quizanswer.FieldCorrect:
+		m.ResetCorrect()
+		return nil
+	case quizanswer.FieldScore:
+		m.ResetScore()
+		return nil
+	case quizanswer.FieldTimeSpentMs:
+		m.ResetTimeSpentMs()
+		return nil
+	case quizanswer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAnswer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuizAnswerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.attempt != nil {
+		edges = append(edges, quizanswer.EdgeAttempt)
+	}
+	if m.flashcard != nil {
+		edges = append(edges, quizanswer.EdgeFlashcard)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuizAnswerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case quizanswer.EdgeAttempt:
+		if id := m.attempt; id != nil {
+			return []ent.Value{*id}
+		}
+	case quizanswer.EdgeFlashcard:
+		if id := m.flashcard; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuizAnswerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuizAnswerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuizAnswerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedattempt {
+		edges = append(edges, quizanswer.EdgeAttempt)
+	}
+	if m.clearedflashcard {
+		edges = append(edges, quizanswer.EdgeFlashcard)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuizAnswerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case quizanswer.EdgeAttempt:
+		return m.clearedattempt
+	case quizanswer.EdgeFlashcard:
+		return m.clearedflashcard
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuizAnswerMutation) ClearEdge(name string) error {
+	switch name {
+	case quizanswer.EdgeAttempt:
+		m.ClearAttempt()
+		return nil
+	case quizanswer.EdgeFlashcard:
+		m.ClearFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAnswer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuizAnswerMutation) ResetEdge(name string) error {
+	switch name {
+	case quizanswer.EdgeAttempt:
+		m.ResetAttempt()
+		return nil
+	case quizanswer.EdgeFlashcard:
+		m.ResetFlashcard()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAnswer edge %s", name)
+}
+
+// QuizAttemptMutation represents an operation that mutates the QuizAttempt nodes in the graph.
+type QuizAttemptMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	user_id               *string
+	status                *quizattempt.Status
+	started_at            *time.Time
+	deadline_at           *time.Time
+	submitted_at          *time.Time
+	auto_submitted        *bool
+	score                 *float64
+	addscore              *float64
+	max_score             *float64
+	addmax_score          *float64
+	ability               *float64
+	addability            *float64
+	ability_error         *float64
+	addability_error      *float64
+	time_spent_seconds    *int
+	addtime_spent_seconds *int
+	clearedFields         map[string]struct{}
+	quiz                  *uuid.UUID
+	clearedquiz           bool
+	answers               map[uuid.UUID]struct{}
+	removedanswers        map[uuid.UUID]struct{}
+	clearedanswers        bool
+	review_updates        map[uuid.UUID]struct{}
+	removedreview_updates map[uuid.UUID]struct{}
+	clearedreview_updates bool
+	done                  bool
+	oldValue              func(context.Context) (*QuizAttempt, error)
+	predicates            []predicate.QuizAttempt
+}
+
+var _ ent.Mutation = (*QuizAttemptMutation)(nil)
+
+// quizattemptOption allows management of the mutation configuration using functional options.
+type quizattemptOption func(*QuizAttemptMutation)
+
+// newQuizAttemptMutation creates new mutation for the QuizAttempt entity.
+func newQuizAttemptMutation(c config, op Op, opts ...quizattemptOption) *QuizAttemptMutation {
+	m := &QuizAttemptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuizAttempt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizAttemptID sets the ID field of the mutation.
+func withQuizAttemptID(id uuid.UUID) quizattemptOption {
+	return func(m *QuizAttemptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuizAttempt
+		)
+		m.oldValue = func(ctx context.Context) (*QuizAttempt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuizAttempt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuizAttempt sets the old QuizAttempt of the mutation.
+func withQuizAttempt(node *QuizAttempt) quizattemptOption {
+	return func(m *QuizAttemptMutation) {
+		m.oldValue = func(context.Context) (*QuizAttempt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizAttemptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizAttemptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of QuizAttempt entities.
+func (m *QuizAttemptMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizAttemptMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizAttemptMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuizAttempt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (m *QuizAttemptMutation) SetQuizID(u uuid.UUID) {
+	m.quiz = &u
+}
+
+// QuizID returns the value of the "quiz_id" field in the mutation.
+func (m *QuizAttemptMutation) QuizID() (r uuid.UUID, exists bool) {
+	v := m.quiz
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuizID returns the old "quiz_id" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldQuizID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuizID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuizID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuizID: %w", err)
+	}
+	return oldValue.QuizID, nil
+}
+
+// ResetQuizID resets all changes to the "quiz_id" field.
+func (m *QuizAttemptMutation) ResetQuizID() {
+	m.quiz = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *QuizAttemptMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *QuizAttemptMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *QuizAttemptMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *QuizAttemptMutation) SetStatus(q quizattempt.Status) {
+	m.status = &q
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *QuizAttemptMutation) Status() (r quizattempt.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldStatus(ctx context.Context) (v quizattempt.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *QuizAttemptMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *QuizAttemptMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *QuizAttemptMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *QuizAttemptMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetDeadlineAt sets the "deadline_at" field.
+func (m *QuizAttemptMutation) SetDeadlineAt(t time.Time) {
+	m.deadline_at = &t
+}
+
+// DeadlineAt returns the value of the "deadline_at" field in the mutation.
+func (m *QuizAttemptMutation) DeadlineAt() (r time.Time, exists bool) {
+	v := m.deadline_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeadlineAt returns the old "deadline_at" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldDeadlineAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeadlineAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeadlineAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeadlineAt: %w", err)
+	}
+	return oldValue.DeadlineAt, nil
+}
+
+// ClearDeadlineAt clears the value of the "deadline_at" field.
+func (m *QuizAttemptMutation) ClearDeadlineAt() {
+	m.deadline_at = nil
+	m.clearedFields[quizattempt.FieldDeadlineAt] = struct{}{}
+}
+
+// DeadlineAtCleared returns if the "deadline_at" field was cleared in this mutation.
+func (m *QuizAttemptMutation) DeadlineAtCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldDeadlineAt]
+	return ok
+}
+
+// ResetDeadlineAt resets all changes to the "deadline_at" field.
+func (m *QuizAttemptMutation) ResetDeadlineAt() {
+	m.deadline_at = nil
+	delete(m.clearedFields, quizattempt.FieldDeadlineAt)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *QuizAttemptMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *QuizAttemptMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldSubmittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (m *QuizAttemptMutation) ClearSubmittedAt() {
+	m.submitted_at = nil
+	m.clearedFields[quizattempt.FieldSubmittedAt] = struct{}{}
+}
+
+// SubmittedAtCleared returns if the "submitted_at" field was cleared in this mutation.
+func (m *QuizAttemptMutation) SubmittedAtCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldSubmittedAt]
+	return ok
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *QuizAttemptMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+	delete(m.clearedFields, quizattempt.FieldSubmittedAt)
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (m *QuizAttemptMutation) SetAutoSubmitted(b bool) {
+	m.auto_submitted = &b
+}
+
+// AutoSubmitted returns the value of the "auto_submitted" field in the mutation.
+func (m *QuizAttemptMutation) AutoSubmitted() (r bool, exists bool) {
+	v := m.auto_submitted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoSubmitted returns the old "auto_submitted" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldAutoSubmitted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoSubmitted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoSubmitted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoSubmitted: %w", err)
+	}
+	return oldValue.AutoSubmitted, nil
+}
+
+// ResetAutoSubmitted resets all changes to the "auto_submitted" field.
+func (m *QuizAttemptMutation) ResetAutoSubmitted() {
+	m.auto_submitted = nil
+}
+
+// SetScore sets the "score" field.
+func (m *QuizAttemptMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *QuizAttemptMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *QuizAttemptMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *QuizAttemptMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *QuizAttemptMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetMaxScore sets the "max_score" field.
+func (m *QuizAttemptMutation) SetMaxScore(f float64) {
+	m.max_score = &f
+	m.addmax_score = nil
+}
+
+// MaxScore returns the value of the "max_score" field in the mutation.
+func (m *QuizAttemptMutation) MaxScore() (r float64, exists bool) {
+	v := m.max_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxScore returns the old "max_score" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldMaxScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxScore: %w", err)
+	}
+	return oldValue.MaxScore, nil
+}
+
+// AddMaxScore adds f to the "max_score" field.
+func (m *QuizAttemptMutation) AddMaxScore(f float64) {
+	if m.addmax_score != nil {
+		*m.addmax_score += f
+	} else {
+		m.addmax_score = &f
+	}
+}
+
+// AddedMaxScore returns the value that was added to the "max_score" field in this mutation.
+func (m *QuizAttemptMutation) AddedMaxScore() (r float64, exists bool) {
+	v := m.addmax_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxScore resets all changes to the "max_score" field.
+func (m *QuizAttemptMutation) ResetMaxScore() {
+	m.max_score = nil
+	m.addmax_score = nil
+}
+
+// SetAbility sets the "ability" field.
+func (m *QuizAttemptMutation) SetAbility(f float64) {
+	m.ability = &f
+	m.addability = nil
+}
+
+// Ability returns the value of the "ability" field in the mutation.
+func (m *QuizAttemptMutation) Ability() (r float64, exists bool) {
+	v := m.ability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbility returns the old "ability" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldAbility(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbility: %w", err)
+	}
+	return oldValue.Ability, nil
+}
+
+// AddAbility adds f to the "ability" field.
+func (m *QuizAttemptMutation) AddAbility(f float64) {
+	if m.addability != nil {
+		*m.addability += f
+	} else {
+		m.addability = &f
+	}
+}
+
+// AddedAbility returns the value that was added to the "ability" field in this mutation.
+func (m *QuizAttemptMutation) AddedAbility() (r float64, exists bool) {
+	v := m.addability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAbility clears the value of the "ability" field.
+func (m *QuizAttemptMutation) ClearAbility() {
+	m.ability = nil
+	m.addability = nil
+	m.clearedFields[quizattempt.FieldAbility] = struct{}{}
+}
+
+// AbilityCleared returns if the "ability" field was cleared in this mutation.
+func (m *QuizAttemptMutation) AbilityCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldAbility]
+	return ok
+}
+
+// ResetAbility resets all changes to the "ability" field.
+func (m *QuizAttemptMutation) ResetAbility() {
+	m.ability = nil
+	m.addability = nil
+	delete(m.clearedFields, quizattempt.FieldAbility)
+}
+
+// SetAbilityError sets the "ability_error" field.
+func (m *QuizAttemptMutation) SetAbilityError(f float64) {
+	m.ability_error = &f
+	m.addability_error = nil
+}
+
+// AbilityError returns the value of the "ability_error" field in the mutation.
+func (m *QuizAttemptMutation) AbilityError() (r float64, exists bool) {
+	v := m.ability_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAbilityError returns the old "ability_error" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldAbilityError(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbilityError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbilityError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbilityError: %w", err)
+	}
+	return oldValue.AbilityError, nil
+}
+
+// AddAbilityError adds f to the "ability_error" field.
+func (m *QuizAttemptMutation) AddAbilityError(f float64) {
+	if m.addability_error != nil {
+		*m.addability_error += f
+	} else {
+		m.addability_error = &f
+	}
+}
+
+// AddedAbilityError returns the value that was added to the "ability_error" field in this mutation.
+func (m *QuizAttemptMutation) AddedAbilityError() (r float64, exists bool) {
+	v := m.addability_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAbilityError clears the value of the "ability_error" field.
+func (m *QuizAttemptMutation) ClearAbilityError() {
+	m.ability_error = nil
+	m.addability_error = nil
+	m.clearedFields[quizattempt.FieldAbilityError] = struct{}{}
+}
+
+// AbilityErrorCleared returns if the "ability_error" field was cleared in this mutation.
+func (m *QuizAttemptMutation) AbilityErrorCleared() bool {
+	_, ok := m.clearedFields[quizattempt.FieldAbilityError]
+	return ok
+}
+
+// ResetAbilityError resets all changes to the "ability_error" field.
+func (m *QuizAttemptMutation) ResetAbilityError() {
+	m.ability_error = nil
+	m.addability_error = nil
+	delete(m.clearedFields, quizattempt.FieldAbilityError)
+}
+
+// SetTimeSpentSeconds sets the "time_spent_seconds" field.
+func (m *QuizAttemptMutation) SetTimeSpentSeconds(i int) {
+	m.time_spent_seconds = &i
+	m.addtime_spent_seconds = nil
+}
+
+// TimeSpentSeconds returns the value of the "time_spent_seconds" field in the mutation.
+func (m *QuizAttemptMutation) TimeSpentSeconds() (r int, exists bool) {
+	v := m.time_spent_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeSpentSeconds returns the old "time_spent_seconds" field's value of the QuizAttempt entity.
+// If the QuizAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizAttemptMutation) OldTimeSpentSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeSpentSeconds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeSpentSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeSpentSeconds: %w", err)
+	}
+	return oldValue.TimeSpentSeconds, nil
+}
+
+// AddTimeSpentSeconds adds i to the "time_spent_seconds" field.
+func (m *QuizAttemptMutation) AddTimeSpentSeconds(i int) {
+	if m.addtime_spent_seconds != nil {
+		*m.addtime_spent_seconds += i
+	} else {
+		m.addtime_spent_seconds = &i
+	}
+}
+
+// AddedTimeSpentSeconds returns the value that was added to the "time_spent_seconds" field in this mutation.
+func (m *QuizAttemptMutation) AddedTimeSpentSeconds() (r int, exists bool) {
+	v := m.addtime_spent_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeSpentSeconds resets all changes to the "time_spent_seconds" field.
+func (m *QuizAttemptMutation) ResetTimeSpentSeconds() {
+	m.time_spent_seconds = nil
+	m.addtime_spent_seconds = nil
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (m *QuizAttemptMutation) ClearQuiz() {
+	m.clearedquiz = true
+	m.clearedFields[quizattempt.FieldQuizID] = struct{}{}
+}
+
+// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
+func (m *QuizAttemptMutation) QuizCleared() bool {
+	return m.clearedquiz
+}
+
+// QuizIDs returns the "quiz" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuizID instead. It exists only for internal usage by the builders.
+func (m *QuizAttemptMutation) QuizIDs() (ids []uuid.UUID) {
+	if id := m.quiz; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuiz resets all changes to the "quiz" edge.
+func (m *QuizAttemptMutation) ResetQuiz() {
+	m.quiz = nil
+	m.clearedquiz = false
+}
+
+// AddAnswerIDs adds the "answers" edge to the QuizAnswer entity by ids.
+func (m *QuizAttemptMutation) AddAnswerIDs(ids ...uuid.UUID) {
+	if m.answers == nil {
+		m.answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAnswers clears the "answers" edge to the QuizAnswer entity.
+func (m *QuizAttemptMutation) ClearAnswers() {
+	m.clearedanswers = true
+}
+
+// AnswersCleared reports if the "answers" edge to the QuizAnswer entity was cleared.
+func (m *QuizAttemptMutation) AnswersCleared() bool {
+	return m.clearedanswers
+}
+
+// RemoveAnswerIDs removes the "answers" edge to the QuizAnswer entity by IDs.
+func (m *QuizAttemptMutation) RemoveAnswerIDs(ids ...uuid.UUID) {
+	if m.removedanswers == nil {
+		m.removedanswers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.answers, ids[i])
+		m.removedanswers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAnswers returns the removed IDs of the "answers" edge to the QuizAnswer entity.
+func (m *QuizAttemptMutation) RemovedAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.removedanswers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AnswersIDs returns the "answers" edge IDs in the mutation.
+func (m *QuizAttemptMutation) AnswersIDs() (ids []uuid.UUID) {
+	for id := range m.answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAnswers resets all changes to the "answers" edge.
+func (m *QuizAttemptMutation) ResetAnswers() {
+	m.answers = nil
+	m.clearedanswers = false
+	m.removedanswers = nil
+}
+
+// AddReviewUpdateIDs adds the "review_updates" edge to the QuizReviewUpdate entity by ids.
+func (m *QuizAttemptMutation) AddReviewUpdateIDs(ids ...uuid.UUID) {
+	if m.review_updates == nil {
+		m.review_updates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.review_updates[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviewUpdates clears the "review_updates" edge to the QuizReviewUpdate entity.
+func (m *QuizAttemptMutation) ClearReviewUpdates() {
+	m.clearedreview_updates = true
+}
+
+// ReviewUpdatesCleared reports if the "review_updates" edge to the QuizReviewUpdate entity was cleared.
+func (m *QuizAttemptMutation) ReviewUpdatesCleared() bool {
+	return m.clearedreview_updates
+}
+
+// RemoveReviewUpdateIDs removes the "review_updates" edge to the QuizReviewUpdate entity by IDs.
+func (m *QuizAttemptMutation) RemoveReviewUpdateIDs(ids ...uuid.UUID) {
+	if m.removedreview_updates == nil {
+		m.removedreview_updates = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.review_updates, ids[i])
+		m.removedreview_updates[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviewUpdates returns the removed IDs of the "review_updates" edge to the QuizReviewUpdate entity.
+func (m *QuizAttemptMutation) RemovedReviewUpdatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreview_updates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewUpdatesIDs returns the "review_updates" edge IDs in the mutation.
+func (m *QuizAttemptMutation) ReviewUpdatesIDs() (ids []uuid.UUID) {
+	for id := range m.review_updates {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviewUpdates resets all changes to the "review_updates" edge.
+func (m *QuizAttemptMutation) ResetReviewUpdates() {
+	m.review_updates = nil
+	m.clearedreview_updates = false
+	m.removedreview_updates = nil
+}
+
+// Where appends a list predicates to the QuizAttemptMutation builder.
+func (m *QuizAttemptMutation) Where(ps ...predicate.QuizAttempt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizAttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuizAttempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizAttemptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizAttemptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuizAttempt).
+func (m *QuizAttemptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.quiz != nil {
+		fields = append(fields, quizattempt.FieldQuizID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, quizattempt.FieldUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, quizattempt.FieldStatus)
+	}
+	if m.started_at != nil {
+		fields = append(fields, quizattempt.FieldStartedAt)
+	}
+	if m.deadline_at != nil {
+		fields = append(fields, quizattempt.FieldDeadlineAt)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, quizattempt.FieldSubmittedAt)
+	}
+	if m.auto_submitted != nil {
+		fields = append(fields, quizattempt.FieldAutoSubmitted)
+	}
+	if m.score != nil {
+		fields = append(fields, quizattempt.FieldScore)
+	}
+	if m.max_score != nil {
+		fields = append(fields, quizattempt.FieldMaxScore)
+	}
+	if m.ability != nil {
+		fields = append(fields, quizattempt.FieldAbility)
+	}
+	if m.ability_error != nil {
+		fields = append(fields, quizattempt.FieldAbilityError)
+	}
+	if m.time_spent_seconds != nil {
+		fields = append(fields, quizattempt.FieldTimeSpentSeconds)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizAttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quizattempt.FieldQuizID:
+		return m.QuizID()
+	case quizattempt.FieldUserID:
+		return m.UserID()
+	case quizattempt.FieldStatus:
+		return m.Status()
+	case quizattempt.FieldStartedAt:
+		return m.StartedAt()
+	case quizattempt.FieldDeadlineAt:
+		return m.DeadlineAt()
+	case quizattempt.FieldSubmittedAt:
+		return m.SubmittedAt()
+	case quizattempt.FieldAutoSubmitted:
+		return m.AutoSubmitted()
+	case quizattempt.FieldScore:
+		return m.Score()
+	case quizattempt.FieldMaxScore:
+		return m.MaxScore()
+	case quizattempt.FieldAbility:
+		return m.Ability()
+	case quizattempt.FieldAbilityError:
+		return m.AbilityError()
+	case quizattempt.FieldTimeSpentSeconds:
+		return m.TimeSpentSeconds()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quizattempt.FieldQuizID:
+		return m.OldQuizID(ctx)
+	case quizattempt.FieldUserID:
+		return m.OldUserID(ctx)
+	case quizattempt.FieldStatus:
+		return m.OldStatus(ctx)
+	case quizattempt.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case quizattempt.FieldDeadlineAt:
+		return m.OldDeadlineAt(ctx)
+	case quizattempt.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	case quizattempt.FieldAutoSubmitted:
+		return m.OldAutoSubmitted(ctx)
+	case quizattempt.FieldScore:
+		return m.OldScore(ctx)
+	case quizattempt.FieldMaxScore:
+		return m.OldMaxScore(ctx)
+	case quizattempt.FieldAbility:
+		return m.OldAbility(ctx)
+	case quizattempt.FieldAbilityError:
+		return m.OldAbilityError(ctx)
+	case quizattempt.FieldTimeSpentSeconds:
+		return m.OldTimeSpentSeconds(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuizAttempt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizAttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quizattempt.FieldQuizID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuizID(v)
+		return nil
+	case quizattempt.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case quizattempt.FieldStatus:
+		v, ok := value.(quizattempt.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
 		return nil
-	case flashcard.FieldAnswer:
-		v, ok := value.(string)
+	case quizattempt.FieldStartedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAnswer(v)
+		m.SetStartedAt(v)
 		return nil
-	case flashcard.FieldType:
-		v, ok := value.(string)
+	case quizattempt.FieldDeadlineAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetDeadlineAt(v)
 		return nil
-	case flashcard.FieldCollectionID:
-		v, ok := value.(uuid.UUID)
+	case quizattempt.FieldSubmittedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCollectionID(v)
+		m.SetSubmittedAt(v)
 		return nil
-	case flashcard.FieldCreatedBy:
-		v, ok := value.(string)
+	case quizattempt.FieldAutoSubmitted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedBy(v)
+		m.SetAutoSubmitted(v)
 		return nil
-	case flashcard.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case quizattempt.FieldScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetScore(v)
 		return nil
-	case flashcard.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case quizattempt.FieldMaxScore:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetMaxScore(v)
+		return nil
+	case quizattempt.FieldAbility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbility(v)
+		return nil
+	case quizattempt.FieldAbilityError:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAbilityError(v)
+		return nil
+	case quizattempt.FieldTimeSpentSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeSpentSeconds(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Flashcard field %s", name)
+	return fmt.Errorf("unknown QuizAttempt field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FlashcardMutation) AddedFields() []string {
-	return nil
+func (m *QuizAttemptMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, quizattempt.FieldScore)
+	}
+	if m.addmax_score != nil {
+		fields = append(fields, quizattempt.FieldMaxScore)
+	}
+	if m.addability != nil {
+		fields = append(fields, quizattempt.FieldAbility)
+	}
+	if m.addability_error != nil {
+		fields = append(fields, quizattempt.FieldAbilityError)
+	}
+	if m.addtime_spent_seconds != nil {
+		fields = append(fields, quizattempt.FieldTimeSpentSeconds)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FlashcardMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuizAttemptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quizattempt.FieldScore:
+		return m.AddedScore()
+	case quizattempt.FieldMaxScore:
+		return m.AddedMaxScore()
+	case quizattempt.FieldAbility:
+		return m.AddedAbility()
+	case quizattempt.FieldAbilityError:
+		return m.AddedAbilityError()
+	case quizattempt.FieldTimeSpentSeconds:
+		return m.AddedTimeSpentSeconds()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlashcardMutation) AddField(name string, value ent.Value) error {
+func (m *QuizAttemptMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case quizattempt.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case quizattempt.FieldMaxScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxScore(v)
+		return nil
+	case quizattempt.FieldAbility:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAbility(v)
+		return nil
+	case quizattempt.FieldAbilityError:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAbilityError(v)
+		return nil
+	case quizattempt.FieldTimeSpentSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeSpentSeconds(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Flashcard numeric field %s", name)
+	return fmt.Errorf("unknown QuizAttempt numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FlashcardMutation) ClearedFields() []string {
-	return nil
+func (m *QuizAttemptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quizattempt.FieldDeadlineAt) {
+		fields = append(fields, quizattempt.FieldDeadlineAt)
+	}
+	if m.FieldCleared(quizattempt.FieldSubmittedAt) {
+		fields = append(fields, quizattempt.FieldSubmittedAt)
+	}
+	if m.FieldCleared(quizattempt.FieldAbility) {
+		fields = append(fields, quizattempt.FieldAbility)
+	}
+	if m.FieldCleared(quizattempt.FieldAbilityError) {
+		fields = append(fields, quizattempt.FieldAbilityError)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FlashcardMutation) FieldCleared(name string) bool {
+func (m *QuizAttemptMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FlashcardMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Flashcard nullable field %s", name)
+func (m *QuizAttemptMutation) ClearField(name string) error {
+	switch name {
+	case quizattempt.FieldDeadlineAt:
+		m.ClearDeadlineAt()
+		return nil
+	case quizattempt.FieldSubmittedAt:
+		m.ClearSubmittedAt()
+		return nil
+	case quizattempt.FieldAbility:
+		m.ClearAbility()
+		return nil
+	case quizattempt.FieldAbilityError:
+		m.ClearAbilityError()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizAttempt nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FlashcardMutation) ResetField(name string) error {
+func (m *QuizAttemptMutation) ResetField(name string) error {
 	switch name {
-	case flashcard.FieldQuestion:
-		m.ResetQuestion()
+	case quizattempt.FieldQuizID:
+		m.ResetQuizID()
 		return nil
-	case flashcard.FieldAnswer:
-		m.ResetAnswer()
+	case quizattempt.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case flashcard.FieldType:
-		m.ResetType()
+	case quizattempt.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case flashcard.FieldCollectionID:
-		m.ResetCollectionID()
+	case quizattempt.FieldStartedAt:
+		m.ResetStartedAt()
 		return nil
-	case flashcard.FieldCreatedBy:
-		m.ResetCreatedBy()
+	case quizattempt.FieldDeadlineAt:
+		m.ResetDeadlineAt()
 		return nil
-	case flashcard.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case quizattempt.FieldSubmittedAt:
+		m.ResetSubmittedAt()
 		return nil
-	case flashcard.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case quizattempt.FieldAutoSubmitted:
+		m.ResetAutoSubmitted()
+		return nil
+	case quizattempt.FieldScore:
+		m.ResetScore()
+		return nil
+	case quizattempt.FieldMaxScore:
+		m.ResetMaxScore()
+		return nil
+	case quizattempt.FieldAbility:
+		m.ResetAbility()
+		return nil
+	case quizattempt.FieldAbilityError:
+		m.ResetAbilityError()
+		return nil
+	case quizattempt.FieldTimeSpentSeconds:
+		m.ResetTimeSpentSeconds()
 		return nil
 	}
-	return fmt.Errorf("unknown Flashcard field %s", name)
+	return fmt.Errorf("unknown QuizAttempt field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FlashcardMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.collection != nil {
-		edges = append(edges, flashcard.EdgeCollection)
+func (m *QuizAttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.quiz != nil {
+		edges = append(edges, quizattempt.EdgeQuiz)
 	}
-	if m.reviews != nil {
-		edges = append(edges, flashcard.EdgeReviews)
+	if m.answers != nil {
+		edges = append(edges, quizattempt.EdgeAnswers)
+	}
+	if m.review_updates != nil {
+		edges = append(edges, quizattempt.EdgeReviewUpdates)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FlashcardMutation) AddedIDs(name string) []ent.Value {
+func (m *QuizAttemptMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case flashcard.EdgeCollection:
-		if id := m.collection; id != nil {
+	case quizattempt.EdgeQuiz:
+		if id := m.quiz; id != nil {
 			return []ent.Value{*id}
 		}
-	case flashcard.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.reviews))
-		for id := range m.reviews {
+	case quizattempt.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.answers))
+		for id := range m.answers {
+			ids = append(ids, id)
+		}
+		return ids
+	case quizattempt.EdgeReviewUpdates:
+		ids := make([]ent.Value, 0, len(m.review_updates))
+		for id := range m.review_updates {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2106,21 +18057,30 @@ func (m *FlashcardMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FlashcardMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedreviews != nil {
-		edges = append(edges, flashcard.EdgeReviews)
+func (m *QuizAttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedanswers != nil {
+		edges = append(edges, quizattempt.EdgeAnswers)
+	}
+	if m.removedreview_updates != nil {
+		edges = append(edges, quizattempt.EdgeReviewUpdates)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FlashcardMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuizAttemptMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case flashcard.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.removedreviews))
-		for id := range m.removedreviews {
+	case quizattempt.EdgeAnswers:
+		ids := make([]ent.Value, 0, len(m.removedanswers))
+		for id := range m.removedanswers {
+			ids = append(ids, id)
+		}
+		return ids
+	case quizattempt.EdgeReviewUpdates:
+		ids := make([]ent.Value, 0, len(m.removedreview_updates))
+		for id := range m.removedreview_updates {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2129,95 +18089,106 @@ func (m *FlashcardMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FlashcardMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcollection {
-		edges = append(edges, flashcard.EdgeCollection)
+func (m *QuizAttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedquiz {
+		edges = append(edges, quizattempt.EdgeQuiz)
 	}
-	if m.clearedreviews {
-		edges = append(edges, flashcard.EdgeReviews)
+	if m.clearedanswers {
+		edges = append(edges, quizattempt.EdgeAnswers)
+	}
+	if m.clearedreview_updates {
+		edges = append(edges, quizattempt.EdgeReviewUpdates)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FlashcardMutation) EdgeCleared(name string) bool {
+func (m *QuizAttemptMutation) EdgeCleared(name string) bool {
 	switch name {
-	case flashcard.EdgeCollection:
-		return m.clearedcollection
-	case flashcard.EdgeReviews:
-		return m.clearedreviews
+	case quizattempt.EdgeQuiz:
+		return m.clearedquiz
+	case quizattempt.EdgeAnswers:
+		return m.clearedanswers
+	case quizattempt.EdgeReviewUpdates:
+		return m.clearedreview_updates
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FlashcardMutation) ClearEdge(name string) error {
+func (m *QuizAttemptMutation) ClearEdge(name string) error {
 	switch name {
-	case flashcard.EdgeCollection:
-		m.ClearCollection()
+	case quizattempt.EdgeQuiz:
+		m.ClearQuiz()
 		return nil
 	}
-	return fmt.Errorf("unknown Flashcard unique edge %s", name)
+	return fmt.Errorf("unknown QuizAttempt unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FlashcardMutation) ResetEdge(name string) error {
+func (m *QuizAttemptMutation) ResetEdge(name string) error {
 	switch name {
-	case flashcard.EdgeCollection:
-		m.ResetCollection()
+	case quizattempt.EdgeQuiz:
+		m.ResetQuiz()
 		return nil
-	case flashcard.EdgeReviews:
-		m.ResetReviews()
+	case quizattempt.EdgeAnswers:
+		m.ResetAnswers()
+		return nil
+	case quizattempt.EdgeReviewUpdates:
+		m.ResetReviewUpdates()
 		return nil
 	}
-	return fmt.Errorf("unknown Flashcard edge %s", name)
+	return fmt.Errorf("unknown QuizAttempt edge %s", name)
 }
 
-// FlashcardReviewMutation represents an operation that mutates the FlashcardReview nodes in the graph.
-type FlashcardReviewMutation struct {
+// QuizReviewUpdateMutation represents an operation that mutates the QuizReviewUpdate nodes in the graph.
+type QuizReviewUpdateMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	user_id          *string
-	ease_factor      *float64
-	addease_factor   *float64
-	interval         *int
-	addinterval      *int
-	due_at           *time.Time
-	status           *flashcardreview.Status
-	learning_step    *int
-	addlearning_step *int
-	review_count     *int
-	addreview_count  *int
-	lapse_count      *int
-	addlapse_count   *int
-	last_reviewed_at *time.Time
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	flashcard        *uuid.UUID
-	clearedflashcard bool
-	done             bool
-	oldValue         func(context.Context) (*FlashcardReview, error)
-	predicates       []predicate.FlashcardReview
-}
-
-var _ ent.Mutation = (*FlashcardReviewMutation)(nil)
-
-// flashcardreviewOption allows management of the mutation configuration using functional options.
-type flashcardreviewOption func(*FlashcardReviewMutation)
-
-// newFlashcardReviewMutation creates new mutation for the FlashcardReview entity.
-func newFlashcardReviewMutation(c config, op Op, opts ...flashcardreviewOption) *FlashcardReviewMutation {
-	m := &FlashcardReviewMutation{
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	rating                    *int
+	addrating                 *int
+	previous_ease_factor      *float64
+	addprevious_ease_factor   *float64
+	previous_interval         *int
+	addprevious_interval      *int
+	previous_due_at           *time.Time
+	previous_status           *quizreviewupdate.PreviousStatus
+	previous_learning_step    *int
+	addprevious_learning_step *int
+	previous_review_count     *int
+	addprevious_review_count  *int
+	previous_lapse_count      *int
+	addprevious_lapse_count   *int
+	previous_last_reviewed_at *time.Time
+	reverted_at               *time.Time
+	created_at                *time.Time
+	clearedFields             map[string]struct{}
+	attempt                   *uuid.UUID
+	clearedattempt            bool
+	review                    *uuid.UUID
+	clearedreview             bool
+	done                      bool
+	oldValue                  func(context.Context) (*QuizReviewUpdate, error)
+	predicates                []predicate.QuizReviewUpdate
+}
+
+var _ ent.Mutation = (*QuizReviewUpdateMutation)(nil)
+
+// quizreviewupdateOption allows management of the mutation configuration using functional options.
+type quizreviewupdateOption func(*QuizReviewUpdateMutation)
+
+// newQuizReviewUpdateMutation creates new mutation for the QuizReviewUpdate entity.
+func newQuizReviewUpdateMutation(c config, op Op, opts ...quizreviewupdateOption) *QuizReviewUpdateMutation {
+	m := &QuizReviewUpdateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFlashcardReview,
+		typ:           TypeQuizReviewUpdate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2226,20 +18197,20 @@ func newFlashcardReviewMutation(c config, op Op, opts ...flashcardreviewOption)
 	return m
 }
 
-// withFlashcardReviewID sets the ID field of the mutation.
-func withFlashcardReviewID(id uuid.UUID) flashcardreviewOption {
-	return func(m *FlashcardReviewMutation) {
+// withQuizReviewUpdateID sets the ID field of the mutation.
+func withQuizReviewUpdateID(id uuid.UUID) quizreviewupdateOption {
+	return func(m *QuizReviewUpdateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FlashcardReview
+			value *QuizReviewUpdate
 		)
-		m.oldValue = func(ctx context.Context) (*FlashcardReview, error) {
+		m.oldValue = func(ctx context.Context) (*QuizReviewUpdate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FlashcardReview.Get(ctx, id)
+					value, err = m.Client().QuizReviewUpdate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2248,10 +18219,10 @@ func withFlashcardReviewID(id uuid.UUID) flashcardreviewOption {
 	}
 }
 
-// withFlashcardReview sets the old FlashcardReview of the mutation.
-func withFlashcardReview(node *FlashcardReview) flashcardreviewOption {
-	return func(m *FlashcardReviewMutation) {
-		m.oldValue = func(context.Context) (*FlashcardReview, error) {
+// withQuizReviewUpdate sets the old QuizReviewUpdate of the mutation.
+func withQuizReviewUpdate(node *QuizReviewUpdate) quizreviewupdateOption {
+	return func(m *QuizReviewUpdateMutation) {
+		m.oldValue = func(context.Context) (*QuizReviewUpdate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2260,7 +18231,7 @@ func withFlashcardReview(node *FlashcardReview) flashcardreviewOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FlashcardReviewMutation) Client() *Client {
+func (m QuizReviewUpdateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2268,7 +18239,7 @@ func (m FlashcardReviewMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FlashcardReviewMutation) Tx() (*Tx, error) {
+func (m QuizReviewUpdateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2278,14 +18249,14 @@ func (m FlashcardReviewMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FlashcardReview entities.
-func (m *FlashcardReviewMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of QuizReviewUpdate entities.
+func (m *QuizReviewUpdateMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FlashcardReviewMutation) ID() (id uuid.UUID, exists bool) {
+func (m *QuizReviewUpdateMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2296,7 +18267,7 @@ func (m *FlashcardReviewMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FlashcardReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *QuizReviewUpdateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2305,593 +18276,689 @@ func (m *FlashcardReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FlashcardReview.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().QuizReviewUpdate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *FlashcardReviewMutation) SetUserID(s string) {
-	m.user_id = &s
+// SetAttemptID sets the "attempt_id" field.
+func (m *QuizReviewUpdateMutation) SetAttemptID(u uuid.UUID) {
+	m.attempt = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *FlashcardReviewMutation) UserID() (r string, exists bool) {
-	v := m.user_id
+// AttemptID returns the value of the "attempt_id" field in the mutation.
+func (m *QuizReviewUpdateMutation) AttemptID() (r uuid.UUID, exists bool) {
+	v := m.attempt
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldAttemptID returns the old "attempt_id" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *QuizReviewUpdateMutation) OldAttemptID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttemptID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldAttemptID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttemptID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.AttemptID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *FlashcardReviewMutation) ResetUserID() {
-	m.user_id = nil
+// ResetAttemptID resets all changes to the "attempt_id" field.
+func (m *QuizReviewUpdateMutation) ResetAttemptID() {
+	m.attempt = nil
 }
 
-// SetFlashcardID sets the "flashcard_id" field.
-func (m *FlashcardReviewMutation) SetFlashcardID(u uuid.UUID) {
-	m.flashcard = &u
+// SetReviewID sets the "review_id" field.
+func (m *QuizReviewUpdateMutation) SetReviewID(u uuid.UUID) {
+	m.review = &u
 }
 
-// FlashcardID returns the value of the "flashcard_id" field in the mutation.
-func (m *FlashcardReviewMutation) FlashcardID() (r uuid.UUID, exists bool) {
-	v := m.flashcard
+// ReviewID returns the value of the "review_id" field in the mutation.
+func (m *QuizReviewUpdateMutation) ReviewID() (r uuid.UUID, exists bool) {
+	v := m.review
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlashcardID returns the old "flashcard_id" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewID returns the old "review_id" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizReviewUpdateMutation) OldReviewID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewID: %w", err)
+	}
+	return oldValue.ReviewID, nil
+}
+
+// ResetReviewID resets all changes to the "review_id" field.
+func (m *QuizReviewUpdateMutation) ResetReviewID() {
+	m.review = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *QuizReviewUpdateMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *QuizReviewUpdateMutation) Rating() (r int, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldFlashcardID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *QuizReviewUpdateMutation) OldRating(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlashcardID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlashcardID requires an ID field in the mutation")
+		return v, errors.New("OldRating requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlashcardID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
 	}
-	return oldValue.FlashcardID, nil
+	return oldValue.Rating, nil
 }
 
-// ResetFlashcardID resets all changes to the "flashcard_id" field.
-func (m *FlashcardReviewMutation) ResetFlashcardID() {
-	m.flashcard = nil
+// AddRating adds i to the "rating" field.
+func (m *QuizReviewUpdateMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
+	}
 }
 
-// SetEaseFactor sets the "ease_factor" field.
-func (m *FlashcardReviewMutation) SetEaseFactor(f float64) {
-	m.ease_factor = &f
-	m.addease_factor = nil
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *QuizReviewUpdateMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// EaseFactor returns the value of the "ease_factor" field in the mutation.
-func (m *FlashcardReviewMutation) EaseFactor() (r float64, exists bool) {
-	v := m.ease_factor
+// ResetRating resets all changes to the "rating" field.
+func (m *QuizReviewUpdateMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetPreviousEaseFactor sets the "previous_ease_factor" field.
+func (m *QuizReviewUpdateMutation) SetPreviousEaseFactor(f float64) {
+	m.previous_ease_factor = &f
+	m.addprevious_ease_factor = nil
+}
+
+// PreviousEaseFactor returns the value of the "previous_ease_factor" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousEaseFactor() (r float64, exists bool) {
+	v := m.previous_ease_factor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEaseFactor returns the old "ease_factor" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousEaseFactor returns the old "previous_ease_factor" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldEaseFactor(ctx context.Context) (v float64, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousEaseFactor(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEaseFactor is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousEaseFactor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEaseFactor requires an ID field in the mutation")
+		return v, errors.New("OldPreviousEaseFactor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEaseFactor: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousEaseFactor: %w", err)
 	}
-	return oldValue.EaseFactor, nil
+	return oldValue.PreviousEaseFactor, nil
 }
 
-// AddEaseFactor adds f to the "ease_factor" field.
-func (m *FlashcardReviewMutation) AddEaseFactor(f float64) {
-	if m.addease_factor != nil {
-		*m.addease_factor += f
+// AddPreviousEaseFactor adds f to the "previous_ease_factor" field.
+func (m *QuizReviewUpdateMutation) AddPreviousEaseFactor(f float64) {
+	if m.addprevious_ease_factor != nil {
+		*m.addprevious_ease_factor += f
 	} else {
-		m.addease_factor = &f
+		m.addprevious_ease_factor = &f
 	}
 }
 
-// AddedEaseFactor returns the value that was added to the "ease_factor" field in this mutation.
-func (m *FlashcardReviewMutation) AddedEaseFactor() (r float64, exists bool) {
-	v := m.addease_factor
+// AddedPreviousEaseFactor returns the value that was added to the "previous_ease_factor" field in this mutation.
+func (m *QuizReviewUpdateMutation) AddedPreviousEaseFactor() (r float64, exists bool) {
+	v := m.addprevious_ease_factor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetEaseFactor resets all changes to the "ease_factor" field.
-func (m *FlashcardReviewMutation) ResetEaseFactor() {
-	m.ease_factor = nil
-	m.addease_factor = nil
+// ResetPreviousEaseFactor resets all changes to the "previous_ease_factor" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousEaseFactor() {
+	m.previous_ease_factor = nil
+	m.addprevious_ease_factor = nil
 }
 
-// SetInterval sets the "interval" field.
-func (m *FlashcardReviewMutation) SetInterval(i int) {
-	m.interval = &i
-	m.addinterval = nil
+// SetPreviousInterval sets the "previous_interval" field.
+func (m *QuizReviewUpdateMutation) SetPreviousInterval(i int) {
+	m.previous_interval = &i
+	m.addprevious_interval = nil
 }
 
-// Interval returns the value of the "interval" field in the mutation.
-func (m *FlashcardReviewMutation) Interval() (r int, exists bool) {
-	v := m.interval
+// PreviousInterval returns the value of the "previous_interval" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousInterval() (r int, exists bool) {
+	v := m.previous_interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInterval returns the old "interval" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousInterval returns the old "previous_interval" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldInterval(ctx context.Context) (v int, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousInterval(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousInterval is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInterval requires an ID field in the mutation")
+		return v, errors.New("OldPreviousInterval requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousInterval: %w", err)
 	}
-	return oldValue.Interval, nil
+	return oldValue.PreviousInterval, nil
 }
 
-// AddInterval adds i to the "interval" field.
-func (m *FlashcardReviewMutation) AddInterval(i int) {
-	if m.addinterval != nil {
-		*m.addinterval += i
+// AddPreviousInterval adds i to the "previous_interval" field.
+func (m *QuizReviewUpdateMutation) AddPreviousInterval(i int) {
+	if m.addprevious_interval != nil {
+		*m.addprevious_interval += i
 	} else {
-		m.addinterval = &i
+		m.addprevious_interval = &i
 	}
 }
 
-// AddedInterval returns the value that was added to the "interval" field in this mutation.
-func (m *FlashcardReviewMutation) AddedInterval() (r int, exists bool) {
-	v := m.addinterval
+// AddedPreviousInterval returns the value that was added to the "previous_interval" field in this mutation.
+func (m *QuizReviewUpdateMutation) AddedPreviousInterval() (r int, exists bool) {
+	v := m.addprevious_interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetInterval resets all changes to the "interval" field.
-func (m *FlashcardReviewMutation) ResetInterval() {
-	m.interval = nil
-	m.addinterval = nil
+// ResetPreviousInterval resets all changes to the "previous_interval" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousInterval() {
+	m.previous_interval = nil
+	m.addprevious_interval = nil
 }
 
-// SetDueAt sets the "due_at" field.
-func (m *FlashcardReviewMutation) SetDueAt(t time.Time) {
-	m.due_at = &t
+// SetPreviousDueAt sets the "previous_due_at" field.
+func (m *QuizReviewUpdateMutation) SetPreviousDueAt(t time.Time) {
+	m.previous_due_at = &t
 }
 
-// DueAt returns the value of the "due_at" field in the mutation.
-func (m *FlashcardReviewMutation) DueAt() (r time.Time, exists bool) {
-	v := m.due_at
+// PreviousDueAt returns the value of the "previous_due_at" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousDueAt() (r time.Time, exists bool) {
+	v := m.previous_due_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDueAt returns the old "due_at" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousDueAt returns the old "previous_due_at" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldDueAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousDueAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousDueAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDueAt requires an ID field in the mutation")
+		return v, errors.New("OldPreviousDueAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousDueAt: %w", err)
 	}
-	return oldValue.DueAt, nil
+	return oldValue.PreviousDueAt, nil
 }
 
-// ResetDueAt resets all changes to the "due_at" field.
-func (m *FlashcardReviewMutation) ResetDueAt() {
-	m.due_at = nil
+// ResetPreviousDueAt resets all changes to the "previous_due_at" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousDueAt() {
+	m.previous_due_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *FlashcardReviewMutation) SetStatus(f flashcardreview.Status) {
-	m.status = &f
+// SetPreviousStatus sets the "previous_status" field.
+func (m *QuizReviewUpdateMutation) SetPreviousStatus(qs quizreviewupdate.PreviousStatus) {
+	m.previous_status = &qs
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *FlashcardReviewMutation) Status() (r flashcardreview.Status, exists bool) {
-	v := m.status
+// PreviousStatus returns the value of the "previous_status" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousStatus() (r quizreviewupdate.PreviousStatus, exists bool) {
+	v := m.previous_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousStatus returns the old "previous_status" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldStatus(ctx context.Context) (v flashcardreview.Status, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousStatus(ctx context.Context) (v quizreviewupdate.PreviousStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPreviousStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousStatus: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.PreviousStatus, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *FlashcardReviewMutation) ResetStatus() {
-	m.status = nil
+// ResetPreviousStatus resets all changes to the "previous_status" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousStatus() {
+	m.previous_status = nil
 }
 
-// SetLearningStep sets the "learning_step" field.
-func (m *FlashcardReviewMutation) SetLearningStep(i int) {
-	m.learning_step = &i
-	m.addlearning_step = nil
+// SetPreviousLearningStep sets the "previous_learning_step" field.
+func (m *QuizReviewUpdateMutation) SetPreviousLearningStep(i int) {
+	m.previous_learning_step = &i
+	m.addprevious_learning_step = nil
 }
 
-// LearningStep returns the value of the "learning_step" field in the mutation.
-func (m *FlashcardReviewMutation) LearningStep() (r int, exists bool) {
-	v := m.learning_step
+// PreviousLearningStep returns the value of the "previous_learning_step" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousLearningStep() (r int, exists bool) {
+	v := m.previous_learning_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLearningStep returns the old "learning_step" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousLearningStep returns the old "previous_learning_step" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldLearningStep(ctx context.Context) (v int, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousLearningStep(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLearningStep is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousLearningStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLearningStep requires an ID field in the mutation")
+		return v, errors.New("OldPreviousLearningStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLearningStep: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousLearningStep: %w", err)
 	}
-	return oldValue.LearningStep, nil
+	return oldValue.PreviousLearningStep, nil
 }
 
-// AddLearningStep adds i to the "learning_step" field.
-func (m *FlashcardReviewMutation) AddLearningStep(i int) {
-	if m.addlearning_step != nil {
-		*m.addlearning_step += i
+// AddPreviousLearningStep adds i to the "previous_learning_step" field.
+func (m *QuizReviewUpdateMutation) AddPreviousLearningStep(i int) {
+	if m.addprevious_learning_step != nil {
+		*m.addprevious_learning_step += i
 	} else {
-		m.addlearning_step = &i
+		m.addprevious_learning_step = &i
 	}
 }
 
-// AddedLearningStep returns the value that was added to the "learning_step" field in this mutation.
-func (m *FlashcardReviewMutation) AddedLearningStep() (r int, exists bool) {
-	v := m.addlearning_step
+// AddedPreviousLearningStep returns the value that was added to the "previous_learning_step" field in this mutation.
+func (m *QuizReviewUpdateMutation) AddedPreviousLearningStep() (r int, exists bool) {
+	v := m.addprevious_learning_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLearningStep resets all changes to the "learning_step" field.
-func (m *FlashcardReviewMutation) ResetLearningStep() {
-	m.learning_step = nil
-	m.addlearning_step = nil
+// ResetPreviousLearningStep resets all changes to the "previous_learning_step" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousLearningStep() {
+	m.previous_learning_step = nil
+	m.addprevious_learning_step = nil
 }
 
-// SetReviewCount sets the "review_count" field.
-func (m *FlashcardReviewMutation) SetReviewCount(i int) {
-	m.review_count = &i
-	m.addreview_count = nil
+// SetPreviousReviewCount sets the "previous_review_count" field.
+func (m *QuizReviewUpdateMutation) SetPreviousReviewCount(i int) {
+	m.previous_review_count = &i
+	m.addprevious_review_count = nil
 }
 
-// ReviewCount returns the value of the "review_count" field in the mutation.
-func (m *FlashcardReviewMutation) ReviewCount() (r int, exists bool) {
-	v := m.review_count
+// PreviousReviewCount returns the value of the "previous_review_count" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousReviewCount() (r int, exists bool) {
+	v := m.previous_review_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewCount returns the old "review_count" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousReviewCount returns the old "previous_review_count" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousReviewCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousReviewCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+		return v, errors.New("OldPreviousReviewCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousReviewCount: %w", err)
 	}
-	return oldValue.ReviewCount, nil
+	return oldValue.PreviousReviewCount, nil
 }
 
-// AddReviewCount adds i to the "review_count" field.
-func (m *FlashcardReviewMutation) AddReviewCount(i int) {
-	if m.addreview_count != nil {
-		*m.addreview_count += i
+// AddPreviousReviewCount adds i to the "previous_review_count" field.
+func (m *QuizReviewUpdateMutation) AddPreviousReviewCount(i int) {
+	if m.addprevious_review_count != nil {
+		*m.addprevious_review_count += i
 	} else {
-		m.addreview_count = &i
+		m.addprevious_review_count = &i
 	}
 }
 
-// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
-func (m *FlashcardReviewMutation) AddedReviewCount() (r int, exists bool) {
-	v := m.addreview_count
+// AddedPreviousReviewCount returns the value that was added to the "previous_review_count" field in this mutation.
+func (m *QuizReviewUpdateMutation) AddedPreviousReviewCount() (r int, exists bool) {
+	v := m.addprevious_review_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetReviewCount resets all changes to the "review_count" field.
-func (m *FlashcardReviewMutation) ResetReviewCount() {
-	m.review_count = nil
-	m.addreview_count = nil
+// ResetPreviousReviewCount resets all changes to the "previous_review_count" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousReviewCount() {
+	m.previous_review_count = nil
+	m.addprevious_review_count = nil
 }
 
-// SetLapseCount sets the "lapse_count" field.
-func (m *FlashcardReviewMutation) SetLapseCount(i int) {
-	m.lapse_count = &i
-	m.addlapse_count = nil
+// SetPreviousLapseCount sets the "previous_lapse_count" field.
+func (m *QuizReviewUpdateMutation) SetPreviousLapseCount(i int) {
+	m.previous_lapse_count = &i
+	m.addprevious_lapse_count = nil
 }
 
-// LapseCount returns the value of the "lapse_count" field in the mutation.
-func (m *FlashcardReviewMutation) LapseCount() (r int, exists bool) {
-	v := m.lapse_count
+// PreviousLapseCount returns the value of the "previous_lapse_count" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousLapseCount() (r int, exists bool) {
+	v := m.previous_lapse_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLapseCount returns the old "lapse_count" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousLapseCount returns the old "previous_lapse_count" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldLapseCount(ctx context.Context) (v int, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousLapseCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLapseCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousLapseCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLapseCount requires an ID field in the mutation")
+		return v, errors.New("OldPreviousLapseCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLapseCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousLapseCount: %w", err)
 	}
-	return oldValue.LapseCount, nil
+	return oldValue.PreviousLapseCount, nil
 }
 
-// AddLapseCount adds i to the "lapse_count" field.
-func (m *FlashcardReviewMutation) AddLapseCount(i int) {
-	if m.addlapse_count != nil {
-		*m.addlapse_count += i
+// AddPreviousLapseCount adds i to the "previous_lapse_count" field.
+func (m *QuizReviewUpdateMutation) AddPreviousLapseCount(i int) {
+	if m.addprevious_lapse_count != nil {
+		*m.addprevious_lapse_count += i
 	} else {
-		m.addlapse_count = &i
+		m.addprevious_lapse_count = &i
 	}
 }
 
-// AddedLapseCount returns the value that was added to the "lapse_count" field in this mutation.
-func (m *FlashcardReviewMutation) AddedLapseCount() (r int, exists bool) {
-	v := m.addlapse_count
+// AddedPreviousLapseCount returns the value that was added to the "previous_lapse_count" field in this mutation.
+func (m *QuizReviewUpdateMutation) AddedPreviousLapseCount() (r int, exists bool) {
+	v := m.addprevious_lapse_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetLapseCount resets all changes to the "lapse_count" field.
-func (m *FlashcardReviewMutation) ResetLapseCount() {
-	m.lapse_count = nil
-	m.addlapse_count = nil
+// ResetPreviousLapseCount resets all changes to the "previous_lapse_count" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousLapseCount() {
+	m.previous_lapse_count = nil
+	m.addprevious_lapse_count = nil
 }
 
-// SetLastReviewedAt sets the "last_reviewed_at" field.
-func (m *FlashcardReviewMutation) SetLastReviewedAt(t time.Time) {
-	m.last_reviewed_at = &t
+// SetPreviousLastReviewedAt sets the "previous_last_reviewed_at" field.
+func (m *QuizReviewUpdateMutation) SetPreviousLastReviewedAt(t time.Time) {
+	m.previous_last_reviewed_at = &t
 }
 
-// LastReviewedAt returns the value of the "last_reviewed_at" field in the mutation.
-func (m *FlashcardReviewMutation) LastReviewedAt() (r time.Time, exists bool) {
-	v := m.last_reviewed_at
+// PreviousLastReviewedAt returns the value of the "previous_last_reviewed_at" field in the mutation.
+func (m *QuizReviewUpdateMutation) PreviousLastReviewedAt() (r time.Time, exists bool) {
+	v := m.previous_last_reviewed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastReviewedAt returns the old "last_reviewed_at" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousLastReviewedAt returns the old "previous_last_reviewed_at" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldLastReviewedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *QuizReviewUpdateMutation) OldPreviousLastReviewedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastReviewedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousLastReviewedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastReviewedAt requires an ID field in the mutation")
+		return v, errors.New("OldPreviousLastReviewedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastReviewedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousLastReviewedAt: %w", err)
 	}
-	return oldValue.LastReviewedAt, nil
+	return oldValue.PreviousLastReviewedAt, nil
 }
 
-// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
-func (m *FlashcardReviewMutation) ClearLastReviewedAt() {
-	m.last_reviewed_at = nil
-	m.clearedFields[flashcardreview.FieldLastReviewedAt] = struct{}{}
+// ClearPreviousLastReviewedAt clears the value of the "previous_last_reviewed_at" field.
+func (m *QuizReviewUpdateMutation) ClearPreviousLastReviewedAt() {
+	m.previous_last_reviewed_at = nil
+	m.clearedFields[quizreviewupdate.FieldPreviousLastReviewedAt] = struct{}{}
 }
 
-// LastReviewedAtCleared returns if the "last_reviewed_at" field was cleared in this mutation.
-func (m *FlashcardReviewMutation) LastReviewedAtCleared() bool {
-	_, ok := m.clearedFields[flashcardreview.FieldLastReviewedAt]
+// PreviousLastReviewedAtCleared returns if the "previous_last_reviewed_at" field was cleared in this mutation.
+func (m *QuizReviewUpdateMutation) PreviousLastReviewedAtCleared() bool {
+	_, ok := m.clearedFields[quizreviewupdate.FieldPreviousLastReviewedAt]
 	return ok
 }
 
-// ResetLastReviewedAt resets all changes to the "last_reviewed_at" field.
-func (m *FlashcardReviewMutation) ResetLastReviewedAt() {
-	m.last_reviewed_at = nil
-	delete(m.clearedFields, flashcardreview.FieldLastReviewedAt)
+// ResetPreviousLastReviewedAt resets all changes to the "previous_last_reviewed_at" field.
+func (m *QuizReviewUpdateMutation) ResetPreviousLastReviewedAt() {
+	m.previous_last_reviewed_at = nil
+	delete(m.clearedFields, quizreviewupdate.FieldPreviousLastReviewedAt)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FlashcardReviewMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRevertedAt sets the "reverted_at" field.
+func (m *QuizReviewUpdateMutation) SetRevertedAt(t time.Time) {
+	m.reverted_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FlashcardReviewMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// RevertedAt returns the value of the "reverted_at" field in the mutation.
+func (m *QuizReviewUpdateMutation) RevertedAt() (r time.Time, exists bool) {
+	v := m.reverted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldRevertedAt returns the old "reverted_at" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuizReviewUpdateMutation) OldRevertedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevertedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevertedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevertedAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RevertedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FlashcardReviewMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (m *QuizReviewUpdateMutation) ClearRevertedAt() {
+	m.reverted_at = nil
+	m.clearedFields[quizreviewupdate.FieldRevertedAt] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FlashcardReviewMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RevertedAtCleared returns if the "reverted_at" field was cleared in this mutation.
+func (m *QuizReviewUpdateMutation) RevertedAtCleared() bool {
+	_, ok := m.clearedFields[quizreviewupdate.FieldRevertedAt]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FlashcardReviewMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetRevertedAt resets all changes to the "reverted_at" field.
+func (m *QuizReviewUpdateMutation) ResetRevertedAt() {
+	m.reverted_at = nil
+	delete(m.clearedFields, quizreviewupdate.FieldRevertedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuizReviewUpdateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuizReviewUpdateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the FlashcardReview entity.
-// If the FlashcardReview object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the QuizReviewUpdate entity.
+// If the QuizReviewUpdate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlashcardReviewMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *QuizReviewUpdateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuizReviewUpdateMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (m *QuizReviewUpdateMutation) ClearAttempt() {
+	m.clearedattempt = true
+	m.clearedFields[quizreviewupdate.FieldAttemptID] = struct{}{}
+}
+
+// AttemptCleared reports if the "attempt" edge to the QuizAttempt entity was cleared.
+func (m *QuizReviewUpdateMutation) AttemptCleared() bool {
+	return m.clearedattempt
+}
+
+// AttemptIDs returns the "attempt" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AttemptID instead. It exists only for internal usage by the builders.
+func (m *QuizReviewUpdateMutation) AttemptIDs() (ids []uuid.UUID) {
+	if id := m.attempt; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FlashcardReviewMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetAttempt resets all changes to the "attempt" edge.
+func (m *QuizReviewUpdateMutation) ResetAttempt() {
+	m.attempt = nil
+	m.clearedattempt = false
 }
 
-// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
-func (m *FlashcardReviewMutation) ClearFlashcard() {
-	m.clearedflashcard = true
-	m.clearedFields[flashcardreview.FieldFlashcardID] = struct{}{}
+// ClearReview clears the "review" edge to the FlashcardReview entity.
+func (m *QuizReviewUpdateMutation) ClearReview() {
+	m.clearedreview = true
+	m.clearedFields[quizreviewupdate.FieldReviewID] = struct{}{}
 }
 
-// FlashcardCleared reports if the "flashcard" edge to the Flashcard entity was cleared.
-func (m *FlashcardReviewMutation) FlashcardCleared() bool {
-	return m.clearedflashcard
+// ReviewCleared reports if the "review" edge to the FlashcardReview entity was cleared.
+func (m *QuizReviewUpdateMutation) ReviewCleared() bool {
+	return m.clearedreview
 }
 
-// FlashcardIDs returns the "flashcard" edge IDs in the mutation.
+// ReviewIDs returns the "review" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FlashcardID instead. It exists only for internal usage by the builders.
-func (m *FlashcardReviewMutation) FlashcardIDs() (ids []uuid.UUID) {
-	if id := m.flashcard; id != nil {
+// ReviewID instead. It exists only for internal usage by the builders.
+func (m *QuizReviewUpdateMutation) ReviewIDs() (ids []uuid.UUID) {
+	if id := m.review; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFlashcard resets all changes to the "flashcard" edge.
-func (m *FlashcardReviewMutation) ResetFlashcard() {
-	m.flashcard = nil
-	m.clearedflashcard = false
+// ResetReview resets all changes to the "review" edge.
+func (m *QuizReviewUpdateMutation) ResetReview() {
+	m.review = nil
+	m.clearedreview = false
 }
 
-// Where appends a list predicates to the FlashcardReviewMutation builder.
-func (m *FlashcardReviewMutation) Where(ps ...predicate.FlashcardReview) {
+// Where appends a list predicates to the QuizReviewUpdateMutation builder.
+func (m *QuizReviewUpdateMutation) Where(ps ...predicate.QuizReviewUpdate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FlashcardReviewMutation builder. Using this method,
+// WhereP appends storage-level predicates to the QuizReviewUpdateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FlashcardReviewMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FlashcardReview, len(ps))
+func (m *QuizReviewUpdateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuizReviewUpdate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2899,60 +18966,63 @@ func (m *FlashcardReviewMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FlashcardReviewMutation) Op() Op {
+func (m *QuizReviewUpdateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FlashcardReviewMutation) SetOp(op Op) {
+func (m *QuizReviewUpdateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FlashcardReview).
-func (m *FlashcardReviewMutation) Type() string {
+// Type returns the node type of this mutation (QuizReviewUpdate).
+func (m *QuizReviewUpdateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FlashcardReviewMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.user_id != nil {
-		fields = append(fields, flashcardreview.FieldUserID)
+func (m *QuizReviewUpdateMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.attempt != nil {
+		fields = append(fields, quizreviewupdate.FieldAttemptID)
 	}
-	if m.flashcard != nil {
-		fields = append(fields, flashcardreview.FieldFlashcardID)
+	if m.review != nil {
+		fields = append(fields, quizreviewupdate.FieldReviewID)
 	}
-	if m.ease_factor != nil {
-		fields = append(fields, flashcardreview.FieldEaseFactor)
+	if m.rating != nil {
+		fields = append(fields, quizreviewupdate.FieldRating)
 	}
-	if m.interval != nil {
-		fields = append(fields, flashcardreview.FieldInterval)
+	if m.previous_ease_factor != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousEaseFactor)
 	}
-	if m.due_at != nil {
-		fields = append(fields, flashcardreview.FieldDueAt)
+	if m.previous_interval != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousInterval)
 	}
-	if m.status != nil {
-		fields = append(fields, flashcardreview.FieldStatus)
+	if m.previous_due_at != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousDueAt)
 	}
-	if m.learning_step != nil {
-		fields = append(fields, flashcardreview.FieldLearningStep)
+	if m.previous_status != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousStatus)
 	}
-	if m.review_count != nil {
-		fields = append(fields, flashcardreview.FieldReviewCount)
+	if m.previous_learning_step != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousLearningStep)
 	}
-	if m.lapse_count != nil {
-		fields = append(fields, flashcardreview.FieldLapseCount)
+	if m.previous_review_count != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousReviewCount)
 	}
-	if m.last_reviewed_at != nil {
-		fields = append(fields, flashcardreview.FieldLastReviewedAt)
+	if m.previous_lapse_count != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousLapseCount)
 	}
-	if m.created_at != nil {
-		fields = append(fields, flashcardreview.FieldCreatedAt)
+	if m.previous_last_reviewed_at != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousLastReviewedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, flashcardreview.FieldUpdatedAt)
+	if m.reverted_at != nil {
+		fields = append(fields, quizreviewupdate.FieldRevertedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quizreviewupdate.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2960,32 +19030,34 @@ func (m *FlashcardReviewMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FlashcardReviewMutation) Field(name string) (ent.Value, bool) {
+func (m *QuizReviewUpdateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case flashcardreview.FieldUserID:
-		return m.UserID()
-	case flashcardreview.FieldFlashcardID:
-		return m.FlashcardID()
-	case flashcardreview.FieldEaseFactor:
-		return m.EaseFactor()
-	case flashcardreview.FieldInterval:
-		return m.Interval()
-	case flashcardreview.FieldDueAt:
-		return m.DueAt()
-	case flashcardreview.FieldStatus:
-		return m.Status()
-	case flashcardreview.FieldLearningStep:
-		return m.LearningStep()
-	case flashcardreview.FieldReviewCount:
-		return m.ReviewCount()
-	case flashcardreview.FieldLapseCount:
-		return m.LapseCount()
-	case flashcardreview.FieldLastReviewedAt:
-		return m.LastReviewedAt()
-	case flashcardreview.FieldCreatedAt:
+	case quizreviewupdate.FieldAttemptID:
+		return m.AttemptID()
+	case quizreviewupdate.FieldReviewID:
+		return m.ReviewID()
+	case quizreviewupdate.FieldRating:
+		return m.Rating()
+	case quizreviewupdate.FieldPreviousEaseFactor:
+		return m.PreviousEaseFactor()
+	case quizreviewupdate.FieldPreviousInterval:
+		return m.PreviousInterval()
+	case quizreviewupdate.FieldPreviousDueAt:
+		return m.PreviousDueAt()
+	case quizreviewupdate.FieldPreviousStatus:
+		return m.PreviousStatus()
+	case quizreviewupdate.FieldPreviousLearningStep:
+		return m.PreviousLearningStep()
+	case quizreviewupdate.FieldPreviousReviewCount:
+		return m.PreviousReviewCount()
+	case quizreviewupdate.FieldPreviousLapseCount:
+		return m.PreviousLapseCount()
+	case quizreviewupdate.FieldPreviousLastReviewedAt:
+		return m.PreviousLastReviewedAt()
+	case quizreviewupdate.FieldRevertedAt:
+		return m.RevertedAt()
+	case quizreviewupdate.FieldCreatedAt:
 		return m.CreatedAt()
-	case flashcardreview.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2993,147 +19065,159 @@ func (m *FlashcardReviewMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FlashcardReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *QuizReviewUpdateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case flashcardreview.FieldUserID:
-		return m.OldUserID(ctx)
-	case flashcardreview.FieldFlashcardID:
-		return m.OldFlashcardID(ctx)
-	case flashcardreview.FieldEaseFactor:
-		return m.OldEaseFactor(ctx)
-	case flashcardreview.FieldInterval:
-		return m.OldInterval(ctx)
-	case flashcardreview.FieldDueAt:
-		return m.OldDueAt(ctx)
-	case flashcardreview.FieldStatus:
-		return m.OldStatus(ctx)
-	case flashcardreview.FieldLearningStep:
-		return m.OldLearningStep(ctx)
-	case flashcardreview.FieldReviewCount:
-		return m.OldReviewCount(ctx)
-	case flashcardreview.FieldLapseCount:
-		return m.OldLapseCount(ctx)
-	case flashcardreview.FieldLastReviewedAt:
-		return m.OldLastReviewedAt(ctx)
-	case flashcardreview.FieldCreatedAt:
+	case quizreviewupdate.FieldAttemptID:
+		return m.OldAttemptID(ctx)
+	case quizreviewupdate.FieldReviewID:
+		return m.OldReviewID(ctx)
+	case quizreviewupdate.FieldRating:
+		return m.OldRating(ctx)
+	case quizreviewupdate.FieldPreviousEaseFactor:
+		return m.OldPreviousEaseFactor(ctx)
+	case quizreviewupdate.FieldPreviousInterval:
+		return m.OldPreviousInterval(ctx)
+	case quizreviewupdate.FieldPreviousDueAt:
+		return m.OldPreviousDueAt(ctx)
+	case quizreviewupdate.FieldPreviousStatus:
+		return m.OldPreviousStatus(ctx)
+	case quizreviewupdate.FieldPreviousLearningStep:
+		return m.OldPreviousLearningStep(ctx)
+	case quizreviewupdate.FieldPreviousReviewCount:
+		return m.OldPreviousReviewCount(ctx)
+	case quizreviewupdate.FieldPreviousLapseCount:
+		return m.OldPreviousLapseCount(ctx)
+	case quizreviewupdate.FieldPreviousLastReviewedAt:
+		return m.OldPreviousLastReviewedAt(ctx)
+	case quizreviewupdate.FieldRevertedAt:
+		return m.OldRevertedAt(ctx)
+	case quizreviewupdate.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case flashcardreview.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FlashcardReview field %s", name)
+	return nil, fmt.Errorf("unknown QuizReviewUpdate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlashcardReviewMutation) SetField(name string, value ent.Value) error {
+func (m *QuizReviewUpdateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case flashcardreview.FieldUserID:
-		v, ok := value.(string)
+	case quizreviewupdate.FieldAttemptID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetAttemptID(v)
 		return nil
-	case flashcardreview.FieldFlashcardID:
+	case quizreviewupdate.FieldReviewID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlashcardID(v)
+		m.SetReviewID(v)
 		return nil
-	case flashcardreview.FieldEaseFactor:
+	case quizreviewupdate.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case quizreviewupdate.FieldPreviousEaseFactor:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEaseFactor(v)
+		m.SetPreviousEaseFactor(v)
 		return nil
-	case flashcardreview.FieldInterval:
+	case quizreviewupdate.FieldPreviousInterval:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInterval(v)
+		m.SetPreviousInterval(v)
 		return nil
-	case flashcardreview.FieldDueAt:
+	case quizreviewupdate.FieldPreviousDueAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDueAt(v)
+		m.SetPreviousDueAt(v)
 		return nil
-	case flashcardreview.FieldStatus:
-		v, ok := value.(flashcardreview.Status)
+	case quizreviewupdate.FieldPreviousStatus:
+		v, ok := value.(quizreviewupdate.PreviousStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetPreviousStatus(v)
 		return nil
-	case flashcardreview.FieldLearningStep:
+	case quizreviewupdate.FieldPreviousLearningStep:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLearningStep(v)
+		m.SetPreviousLearningStep(v)
 		return nil
-	case flashcardreview.FieldReviewCount:
+	case quizreviewupdate.FieldPreviousReviewCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewCount(v)
+		m.SetPreviousReviewCount(v)
 		return nil
-	case flashcardreview.FieldLapseCount:
+	case quizreviewupdate.FieldPreviousLapseCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLapseCount(v)
+		m.SetPreviousLapseCount(v)
 		return nil
-	case flashcardreview.FieldLastReviewedAt:
+	case quizreviewupdate.FieldPreviousLastReviewedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastReviewedAt(v)
+		m.SetPreviousLastReviewedAt(v)
 		return nil
-	case flashcardreview.FieldCreatedAt:
+	case quizreviewupdate.FieldRevertedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRevertedAt(v)
 		return nil
-	case flashcardreview.FieldUpdatedAt:
+	case quizreviewupdate.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FlashcardReview field %s", name)
+	return fmt.Errorf("unknown QuizReviewUpdate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FlashcardReviewMutation) AddedFields() []string {
+func (m *QuizReviewUpdateMutation) AddedFields() []string {
 	var fields []string
-	if m.addease_factor != nil {
-		fields = append(fields, flashcardreview.FieldEaseFactor)
+	if m.addrating != nil {
+		fields = append(fields, quizreviewupdate.FieldRating)
 	}
-	if m.addinterval != nil {
-		fields = append(fields, flashcardreview.FieldInterval)
+	if m.addprevious_ease_factor != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousEaseFactor)
 	}
-	if m.addlearning_step != nil {
-		fields = append(fields, flashcardreview.FieldLearningStep)
+	if m.addprevious_interval != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousInterval)
 	}
-	if m.addreview_count != nil {
-		fields = append(fields, flashcardreview.FieldReviewCount)
+	if m.addprevious_learning_step != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousLearningStep)
 	}
-	if m.addlapse_count != nil {
-		fields = append(fields, flashcardreview.FieldLapseCount)
+	if m.addprevious_review_count != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousReviewCount)
+	}
+	if m.addprevious_lapse_count != nil {
+		fields = append(fields, quizreviewupdate.FieldPreviousLapseCount)
 	}
 	return fields
 }
@@ -3141,18 +19225,20 @@ func (m *FlashcardReviewMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FlashcardReviewMutation) AddedField(name string) (ent.Value, bool) {
+func (m *QuizReviewUpdateMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case flashcardreview.FieldEaseFactor:
-		return m.AddedEaseFactor()
-	case flashcardreview.FieldInterval:
-		return m.AddedInterval()
-	case flashcardreview.FieldLearningStep:
-		return m.AddedLearningStep()
-	case flashcardreview.FieldReviewCount:
-		return m.AddedReviewCount()
-	case flashcardreview.FieldLapseCount:
-		return m.AddedLapseCount()
+	case quizreviewupdate.FieldRating:
+		return m.AddedRating()
+	case quizreviewupdate.FieldPreviousEaseFactor:
+		return m.AddedPreviousEaseFactor()
+	case quizreviewupdate.FieldPreviousInterval:
+		return m.AddedPreviousInterval()
+	case quizreviewupdate.FieldPreviousLearningStep:
+		return m.AddedPreviousLearningStep()
+	case quizreviewupdate.FieldPreviousReviewCount:
+		return m.AddedPreviousReviewCount()
+	case quizreviewupdate.FieldPreviousLapseCount:
+		return m.AddedPreviousLapseCount()
 	}
 	return nil, false
 }
@@ -3160,134 +19246,157 @@ func (m *FlashcardReviewMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlashcardReviewMutation) AddField(name string, value ent.Value) error {
+func (m *QuizReviewUpdateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case flashcardreview.FieldEaseFactor:
+	case quizreviewupdate.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case quizreviewupdate.FieldPreviousEaseFactor:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddEaseFactor(v)
+		m.AddPreviousEaseFactor(v)
 		return nil
-	case flashcardreview.FieldInterval:
+	case quizreviewupdate.FieldPreviousInterval:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInterval(v)
+		m.AddPreviousInterval(v)
 		return nil
-	case flashcardreview.FieldLearningStep:
+	case quizreviewupdate.FieldPreviousLearningStep:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLearningStep(v)
+		m.AddPreviousLearningStep(v)
 		return nil
-	case flashcardreview.FieldReviewCount:
+	case quizreviewupdate.FieldPreviousReviewCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddReviewCount(v)
+		m.AddPreviousReviewCount(v)
 		return nil
-	case flashcardreview.FieldLapseCount:
+	case quizreviewupdate.FieldPreviousLapseCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddLapseCount(v)
+		m.AddPreviousLapseCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FlashcardReview numeric field %s", name)
+	return fmt.Errorf("unknown QuizReviewUpdate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FlashcardReviewMutation) ClearedFields() []string {
+func (m *QuizReviewUpdateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(flashcardreview.FieldLastReviewedAt) {
-		fields = append(fields, flashcardreview.FieldLastReviewedAt)
+	if m.FieldCleared(quizreviewupdate.FieldPreviousLastReviewedAt) {
+		fields = append(fields, quizreviewupdate.FieldPreviousLastReviewedAt)
+	}
+	if m.FieldCleared(quizreviewupdate.FieldRevertedAt) {
+		fields = append(fields, quizreviewupdate.FieldRevertedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FlashcardReviewMutation) FieldCleared(name string) bool {
+func (m *QuizReviewUpdateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FlashcardReviewMutation) ClearField(name string) error {
+func (m *QuizReviewUpdateMutation) ClearField(name string) error {
 	switch name {
-	case flashcardreview.FieldLastReviewedAt:
-		m.ClearLastReviewedAt()
+	case quizreviewupdate.FieldPreviousLastReviewedAt:
+		m.ClearPreviousLastReviewedAt()
+		return nil
+	case quizreviewupdate.FieldRevertedAt:
+		m.ClearRevertedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FlashcardReview nullable field %s", name)
+	return fmt.Errorf("unknown QuizReviewUpdate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FlashcardReviewMutation) ResetField(name string) error {
+func (m *QuizReviewUpdateMutation) ResetField(name string) error {
 	switch name {
-	case flashcardreview.FieldUserID:
-		m.ResetUserID()
+	case quizreviewupdate.FieldAttemptID:
+		m.ResetAttemptID()
 		return nil
-	case flashcardreview.FieldFlashcardID:
-		m.ResetFlashcardID()
+	case quizreviewupdate.FieldReviewID:
+		m.ResetReviewID()
 		return nil
-	case flashcardreview.FieldEaseFactor:
-		m.ResetEaseFactor()
+	case quizreviewupdate.FieldRating:
+		m.ResetRating()
 		return nil
-	case flashcardreview.FieldInterval:
-		m.ResetInterval()
+	case quizreviewupdate.FieldPreviousEaseFactor:
+		m.ResetPreviousEaseFactor()
 		return nil
-	case flashcardreview.FieldDueAt:
-		m.ResetDueAt()
+	case quizreviewupdate.FieldPreviousInterval:
+		m.ResetPreviousInterval()
 		return nil
-	case flashcardreview.FieldStatus:
-		m.ResetStatus()
+	case quizreviewupdate.FieldPreviousDueAt:
+		m.ResetPreviousDueAt()
 		return nil
-	case flashcardreview.FieldLearningStep:
-		m.ResetLearningStep()
+	case quizreviewupdate.FieldPreviousStatus:
+		m.ResetPreviousStatus()
 		return nil
-	case flashcardreview.FieldReviewCount:
-		m.ResetReviewCount()
+	case quizreviewupdate.FieldPreviousLearningStep:
+		m.ResetPreviousLearningStep()
 		return nil
-	case flashcardreview.FieldLapseCount:
-		m.ResetLapseCount()
+	case quizreviewupdate.FieldPreviousReviewCount:
+		m.ResetPreviousReviewCount()
 		return nil
-	case flashcardreview.FieldLastReviewedAt:
-		m.ResetLastReviewedAt()
+	case quizreviewupdate.FieldPreviousLapseCount:
+		m.ResetPreviousLapseCount()
 		return nil
-	case flashcardreview.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case quizreviewupdate.FieldPreviousLastReviewedAt:
+		m.ResetPreviousLastReviewedAt()
 		return nil
-	case flashcardreview.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case quizreviewupdate.FieldRevertedAt:
+		m.ResetRevertedAt()
+		return nil
+	case quizreviewupdate.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FlashcardReview field %s", name)
+	return fmt.Errorf("unknown QuizReviewUpdate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FlashcardReviewMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.flashcard != nil {
-		edges = append(edges, flashcardreview.EdgeFlashcard)
+func (m *QuizReviewUpdateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.attempt != nil {
+		edges = append(edges, quizreviewupdate.EdgeAttempt)
+	}
+	if m.review != nil {
+		edges = append(edges, quizreviewupdate.EdgeReview)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FlashcardReviewMutation) AddedIDs(name string) []ent.Value {
+func (m *QuizReviewUpdateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case flashcardreview.EdgeFlashcard:
-		if id := m.flashcard; id != nil {
+	case quizreviewupdate.EdgeAttempt:
+		if id := m.attempt; id != nil {
+			return []ent.Value{*id}
+		}
+	case quizreviewupdate.EdgeReview:
+		if id := m.review; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3295,54 +19404,65 @@ func (m *FlashcardReviewMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FlashcardReviewMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *QuizReviewUpdateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FlashcardReviewMutation) RemovedIDs(name string) []ent.Value {
+func (m *QuizReviewUpdateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FlashcardReviewMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedflashcard {
-		edges = append(edges, flashcardreview.EdgeFlashcard)
+func (m *QuizReviewUpdateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedattempt {
+		edges = append(edges, quizreviewupdate.EdgeAttempt)
+	}
+	if m.clearedreview {
+		edges = append(edges, quizreviewupdate.EdgeReview)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FlashcardReviewMutation) EdgeCleared(name string) bool {
+func (m *QuizReviewUpdateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case flashcardreview.EdgeFlashcard:
-		return m.clearedflashcard
+	case quizreviewupdate.EdgeAttempt:
+		return m.clearedattempt
+	case quizreviewupdate.EdgeReview:
+		return m.clearedreview
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FlashcardReviewMutation) ClearEdge(name string) error {
+func (m *QuizReviewUpdateMutation) ClearEdge(name string) error {
 	switch name {
-	case flashcardreview.EdgeFlashcard:
-		m.ClearFlashcard()
+	case quizreviewupdate.EdgeAttempt:
+		m.ClearAttempt()
+		return nil
+	case quizreviewupdate.EdgeReview:
+		m.ClearReview()
 		return nil
 	}
-	return fmt.Errorf("unknown FlashcardReview unique edge %s", name)
+	return fmt.Errorf("unknown QuizReviewUpdate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FlashcardReviewMutation) ResetEdge(name string) error {
+func (m *QuizReviewUpdateMutation) ResetEdge(name string) error {
 	switch name {
-	case flashcardreview.EdgeFlashcard:
-		m.ResetFlashcard()
+	case quizreviewupdate.EdgeAttempt:
+		m.ResetAttempt()
+		return nil
+	case quizreviewupdate.EdgeReview:
+		m.ResetReview()
 		return nil
 	}
-	return fmt.Errorf("unknown FlashcardReview edge %s", name)
+	return fmt.Errorf("unknown QuizReviewUpdate edge %s", name)
 }
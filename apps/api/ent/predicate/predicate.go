@@ -12,8 +12,47 @@ type Collection func(*sql.Selector)
 // CollectionCollaborator is the predicate function for collectioncollaborator builders.
 type CollectionCollaborator func(*sql.Selector)
 
+// DeletionJob is the predicate function for deletionjob builders.
+type DeletionJob func(*sql.Selector)
+
 // Flashcard is the predicate function for flashcard builders.
 type Flashcard func(*sql.Selector)
 
+// FlashcardAnnotation is the predicate function for flashcardannotation builders.
+type FlashcardAnnotation func(*sql.Selector)
+
+// FlashcardRating is the predicate function for flashcardrating builders.
+type FlashcardRating func(*sql.Selector)
+
 // FlashcardReview is the predicate function for flashcardreview builders.
 type FlashcardReview func(*sql.Selector)
+
+// FlashcardRevision is the predicate function for flashcardrevision builders.
+type FlashcardRevision func(*sql.Selector)
+
+// FlashcardTag is the predicate function for flashcardtag builders.
+type FlashcardTag func(*sql.Selector)
+
+// ItemStatistic is the predicate function for itemstatistic builders.
+type ItemStatistic func(*sql.Selector)
+
+// LearnerAbility is the predicate function for learnerability builders.
+type LearnerAbility func(*sql.Selector)
+
+// Media is the predicate function for media builders.
+type Media func(*sql.Selector)
+
+// MediaUpload is the predicate function for mediaupload builders.
+type MediaUpload func(*sql.Selector)
+
+// Quiz is the predicate function for quiz builders.
+type Quiz func(*sql.Selector)
+
+// QuizAnswer is the predicate function for quizanswer builders.
+type QuizAnswer func(*sql.Selector)
+
+// QuizAttempt is the predicate function for quizattempt builders.
+type QuizAttempt func(*sql.Selector)
+
+// QuizReviewUpdate is the predicate function for quizreviewupdate builders.
+type QuizReviewUpdate func(*sql.Selector)
@@ -0,0 +1,402 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+)
+
+// Quiz is the model entity for the Quiz schema.
+type Quiz struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CollectionID holds the value of the "collection_id" field.
+	CollectionID uuid.UUID `json:"collection_id,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// How flashcards are picked: at random, the creator's weakest first, or from tags
+	Selection quiz.Selection `json:"selection,omitempty"`
+	// For adaptive quizzes, the most questions an attempt asks
+	QuestionCount int `json:"question_count,omitempty"`
+	// Pick each question from the flashcards by the learner's ability; flashcard_ids holds the candidates
+	Adaptive bool `json:"adaptive,omitempty"`
+	// Number of questions of each flashcard type; the rest may be of any type
+	TypeCounts map[string]int `json:"type_counts,omitempty"`
+	// Only flashcards carrying one of these tags or their descendants
+	Tags []string `json:"tags,omitempty"`
+	// Simple cards are asked as multiple choice with this many generated options; 0 asks for a typed answer
+	ChoiceCount int `json:"choice_count,omitempty"`
+	// Seed of the selection and shuffling, so a quiz can be regenerated identically
+	Seed int64 `json:"seed,omitempty"`
+	// Selected flashcards in question order
+	FlashcardIds []uuid.UUID `json:"flashcard_ids,omitempty"`
+	// Attempts are due this long after they start; unset for untimed quizzes
+	TimeLimitSeconds *int `json:"time_limit_seconds,omitempty"`
+	// Attempts cannot be started before this time
+	OpensAt *time.Time `json:"opens_at,omitempty"`
+	// Attempts cannot be started after this time
+	ClosesAt *time.Time `json:"closes_at,omitempty"`
+	// Attempts allowed per user; unset for unlimited
+	MaxAttempts *int `json:"max_attempts,omitempty"`
+	// Submitted answers update the takers' spaced repetition: wrong ones as Again, slow correct ones as Hard
+	ReviewFeedback bool `json:"review_feedback,omitempty"`
+	// Correct answers that took longer count as Hard when feeding spaced repetition
+	SlowAnswerSeconds int `json:"slow_answer_seconds,omitempty"`
+	// Shared quizzes can be taken by everyone who can see the collection
+	Shared bool `json:"shared,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuizQuery when eager-loading is set.
+	Edges        QuizEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuizEdges holds the relations/edges for other nodes in the graph.
+type QuizEdges struct {
+	// Collection holds the value of the collection edge.
+	Collection *Collection `json:"collection,omitempty"`
+	// Attempts holds the value of the attempts edge.
+	Attempts []*QuizAttempt `json:"attempts,omitempty"`
+	// ItemStatistics holds the value of the item_statistics edge.
+	ItemStatistics []*ItemStatistic `json:"item_statistics,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// CollectionOrErr returns the Collection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizEdges) CollectionOrErr() (*Collection, error) {
+	if e.Collection != nil {
+		return e.Collection, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: collection.Label}
+	}
+	return nil, &NotLoadedError{edge: "collection"}
+}
+
+// AttemptsOrErr returns the Attempts value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizEdges) AttemptsOrErr() ([]*QuizAttempt, error) {
+	if e.loadedTypes[1] {
+		return e.Attempts, nil
+	}
+	return nil, &NotLoadedError{edge: "attempts"}
+}
+
+// ItemStatisticsOrErr returns the ItemStatistics value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizEdges) ItemStatisticsOrErr() ([]*ItemStatistic, error) {
+	if e.loadedTypes[2] {
+		return e.ItemStatistics, nil
+	}
+	return nil, &NotLoadedError{edge: "item_statistics"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Quiz) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quiz.FieldTypeCounts, quiz.FieldTags, quiz.FieldFlashcardIds:
+			values[i] = new([]byte)
+		case quiz.FieldAdaptive, quiz.FieldReviewFeedback, quiz.FieldShared:
+			values[i] = new(sql.NullBool)
+		case quiz.FieldQuestionCount, quiz.FieldChoiceCount, quiz.FieldSeed, quiz.FieldTimeLimitSeconds, quiz.FieldMaxAttempts, quiz.FieldSlowAnswerSeconds:
+			values[i] = new(sql.NullInt64)
+		case quiz.FieldCreatedBy, quiz.FieldTitle, quiz.FieldSelection:
+			values[i] = new(sql.NullString)
+		case quiz.FieldOpensAt, quiz.FieldClosesAt, quiz.FieldCreatedAt, quiz.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case quiz.FieldID, quiz.FieldCollectionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Quiz fields.
+func (_m *Quiz) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quiz.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case quiz.FieldCollectionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field collection_id", values[i])
+			} else if value != nil {
+				_m.CollectionID = *value
+			}
+		case quiz.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case quiz.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case quiz.FieldSelection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selection", values[i])
+			} else if value.Valid {
+				_m.Selection = quiz.Selection(value.String)
+			}
+		case quiz.FieldQuestionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field question_count", values[i])
+			} else if value.Valid {
+				_m.QuestionCount = int(value.Int64)
+			}
+		case quiz.FieldAdaptive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field adaptive", values[i])
+			} else if value.Valid {
+				_m.Adaptive = value.Bool
+			}
+		case quiz.FieldTypeCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field type_counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TypeCounts); err != nil {
+					return fmt.Errorf("unmarshal field type_counts: %w", err)
+				}
+			}
+		case quiz.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case quiz.FieldChoiceCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field choice_count", values[i])
+			} else if value.Valid {
+				_m.ChoiceCount = int(value.Int64)
+			}
+		case quiz.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				_m.Seed = value.Int64
+			}
+		case quiz.FieldFlashcardIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FlashcardIds); err != nil {
+					return fmt.Errorf("unmarshal field flashcard_ids: %w", err)
+				}
+			}
+		case quiz.FieldTimeLimitSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_limit_seconds", values[i])
+			} else if value.Valid {
+				_m.TimeLimitSeconds = new(int)
+				*_m.TimeLimitSeconds = int(value.Int64)
+			}
+		case quiz.FieldOpensAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opens_at", values[i])
+			} else if value.Valid {
+				_m.OpensAt = new(time.Time)
+				*_m.OpensAt = value.Time
+			}
+		case quiz.FieldClosesAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closes_at", values[i])
+			} else if value.Valid {
+				_m.ClosesAt = new(time.Time)
+				*_m.ClosesAt = value.Time
+			}
+		case quiz.FieldMaxAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_attempts", values[i])
+			} else if value.Valid {
+				_m.MaxAttempts = new(int)
+				*_m.MaxAttempts = int(value.Int64)
+			}
+		case quiz.FieldReviewFeedback:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field review_feedback", values[i])
+			} else if value.Valid {
+				_m.ReviewFeedback = value.Bool
+			}
+		case quiz.FieldSlowAnswerSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field slow_answer_seconds", values[i])
+			} else if value.Valid {
+				_m.SlowAnswerSeconds = int(value.Int64)
+			}
+		case quiz.FieldShared:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shared", values[i])
+			} else if value.Valid {
+				_m.Shared = value.Bool
+			}
+		case quiz.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case quiz.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Quiz.
+// This includes values selected through modifiers, order, etc.
+func (_m *Quiz) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCollection queries the "collection" edge of the Quiz entity.
+func (_m *Quiz) QueryCollection() *CollectionQuery {
+	return NewQuizClient(_m.config).QueryCollection(_m)
+}
+
+// QueryAttempts queries the "attempts" edge of the Quiz entity.
+func (_m *Quiz) QueryAttempts() *QuizAttemptQuery {
+	return NewQuizClient(_m.config).QueryAttempts(_m)
+}
+
+// QueryItemStatistics queries the "item_statistics" edge of the Quiz entity.
+func (_m *Quiz) QueryItemStatistics() *ItemStatisticQuery {
+	return NewQuizClient(_m.config).QueryItemStatistics(_m)
+}
+
+// Update returns a builder for updating this Quiz.
+// Note that you need to call Quiz.Unwrap() before calling this method if this Quiz
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Quiz) Update() *QuizUpdateOne {
+	return NewQuizClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Quiz entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Quiz) Unwrap() *Quiz {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Quiz is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Quiz) String() string {
+	var builder strings.Builder
+	builder.WriteString("Quiz(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("collection_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CollectionID))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("selection=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Selection))
+	builder.WriteString(", ")
+	builder.WriteString("question_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuestionCount))
+	builder.WriteString(", ")
+	builder.WriteString("adaptive=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Adaptive))
+	builder.WriteString(", ")
+	builder.WriteString("type_counts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TypeCounts))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("choice_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChoiceCount))
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("flashcard_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardIds))
+	builder.WriteString(", ")
+	if v := _m.TimeLimitSeconds; v != nil {
+		builder.WriteString("time_limit_seconds=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OpensAt; v != nil {
+		builder.WriteString("opens_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ClosesAt; v != nil {
+		builder.WriteString("closes_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.MaxAttempts; v != nil {
+		builder.WriteString("max_attempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_feedback=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewFeedback))
+	builder.WriteString(", ")
+	builder.WriteString("slow_answer_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SlowAnswerSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("shared=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Shared))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Quizs is a parsable slice of Quiz.
+type Quizs []*Quiz
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quiz
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the quiz type in the database.
+	Label = "quiz"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCollectionID holds the string denoting the collection_id field in the database.
+	FieldCollectionID = "collection_id"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldSelection holds the string denoting the selection field in the database.
+	FieldSelection = "selection"
+	// FieldQuestionCount holds the string denoting the question_count field in the database.
+	FieldQuestionCount = "question_count"
+	// FieldAdaptive holds the string denoting the adaptive field in the database.
+	FieldAdaptive = "adaptive"
+	// FieldTypeCounts holds the string denoting the type_counts field in the database.
+	FieldTypeCounts = "type_counts"
+	// FieldTags holds the string denoting the tags field in the database.
+	FieldTags = "tags"
+	// FieldChoiceCount holds the string denoting the choice_count field in the database.
+	FieldChoiceCount = "choice_count"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldFlashcardIds holds the string denoting the flashcard_ids field in the database.
+	FieldFlashcardIds = "flashcard_ids"
+	// FieldTimeLimitSeconds holds the string denoting the time_limit_seconds field in the database.
+	FieldTimeLimitSeconds = "time_limit_seconds"
+	// FieldOpensAt holds the string denoting the opens_at field in the database.
+	FieldOpensAt = "opens_at"
+	// FieldClosesAt holds the string denoting the closes_at field in the database.
+	FieldClosesAt = "closes_at"
+	// FieldMaxAttempts holds the string denoting the max_attempts field in the database.
+	FieldMaxAttempts = "max_attempts"
+	// FieldReviewFeedback holds the string denoting the review_feedback field in the database.
+	FieldReviewFeedback = "review_feedback"
+	// FieldSlowAnswerSeconds holds the string denoting the slow_answer_seconds field in the database.
+	FieldSlowAnswerSeconds = "slow_answer_seconds"
+	// FieldShared holds the string denoting the shared field in the database.
+	FieldShared = "shared"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCollection holds the string denoting the collection edge name in mutations.
+	EdgeCollection = "collection"
+	// EdgeAttempts holds the string denoting the attempts edge name in mutations.
+	EdgeAttempts = "attempts"
+	// EdgeItemStatistics holds the string denoting the item_statistics edge name in mutations.
+	EdgeItemStatistics = "item_statistics"
+	// Table holds the table name of the quiz in the database.
+	Table = "quizs"
+	// CollectionTable is the table that holds the collection relation/edge.
+	CollectionTable = "quizs"
+	// CollectionInverseTable is the table name for the Collection entity.
+	// It exists in this package in order to avoid circular dependency with the "collection" package.
+	CollectionInverseTable = "collections"
+	// CollectionColumn is the table column denoting the collection relation/edge.
+	CollectionColumn = "collection_id"
+	// AttemptsTable is the table that holds the attempts relation/edge.
+	AttemptsTable = "quiz_attempts"
+	// AttemptsInverseTable is the table name for the QuizAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "quizattempt" package.
+	AttemptsInverseTable = "quiz_attempts"
+	// AttemptsColumn is the table column denoting the attempts relation/edge.
+	AttemptsColumn = "quiz_id"
+	// ItemStatisticsTable is the table that holds the item_statistics relation/edge.
+	ItemStatisticsTable = "item_statistics"
+	// ItemStatisticsInverseTable is the table name for the ItemStatistic entity.
+	// It exists in this package in order to avoid circular dependency with the "itemstatistic" package.
+	ItemStatisticsInverseTable = "item_statistics"
+	// ItemStatisticsColumn is the table column denoting the item_statistics relation/edge.
+	ItemStatisticsColumn = "quiz_id"
+)
+
+// Columns holds all SQL columns for quiz fields.
+var Columns = []string{
+	FieldID,
+	FieldCollectionID,
+	FieldCreatedBy,
+	FieldTitle,
+	FieldSelection,
+	FieldQuestionCount,
+	FieldAdaptive,
+	FieldTypeCounts,
+	FieldTags,
+	FieldChoiceCount,
+	FieldSeed,
+	FieldFlashcardIds,
+	FieldTimeLimitSeconds,
+	FieldOpensAt,
+	FieldClosesAt,
+	FieldMaxAttempts,
+	FieldReviewFeedback,
+	FieldSlowAnswerSeconds,
+	FieldShared,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	CreatedByValidator func(string) error
+	// DefaultTitle holds the default value on creation for the "title" field.
+	DefaultTitle string
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// QuestionCountValidator is a validator for the "question_count" field. It is called by the builders before save.
+	QuestionCountValidator func(int) error
+	// DefaultAdaptive holds the default value on creation for the "adaptive" field.
+	DefaultAdaptive bool
+	// DefaultChoiceCount holds the default value on creation for the "choice_count" field.
+	DefaultChoiceCount int
+	// ChoiceCountValidator is a validator for the "choice_count" field. It is called by the builders before save.
+	ChoiceCountValidator func(int) error
+	// TimeLimitSecondsValidator is a validator for the "time_limit_seconds" field. It is called by the builders before save.
+	TimeLimitSecondsValidator func(int) error
+	// MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	MaxAttemptsValidator func(int) error
+	// DefaultReviewFeedback holds the default value on creation for the "review_feedback" field.
+	DefaultReviewFeedback bool
+	// DefaultSlowAnswerSeconds holds the default value on creation for the "slow_answer_seconds" field.
+	DefaultSlowAnswerSeconds int
+	// SlowAnswerSecondsValidator is a validator for the "slow_answer_seconds" field. It is called by the builders before save.
+	SlowAnswerSecondsValidator func(int) error
+	// DefaultShared holds the default value on creation for the "shared" field.
+	DefaultShared bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Selection defines the type for the "selection" enum field.
+type Selection string
+
+// SelectionRandom is the default value of the Selection enum.
+const DefaultSelection = SelectionRandom
+
+// Selection values.
+const (
+	SelectionRandom  Selection = "random"
+	SelectionWeakest Selection = "weakest"
+	SelectionTagged  Selection = "tagged"
+)
+
+func (s Selection) String() string {
+	return string(s)
+}
+
+// SelectionValidator is a validator for the "selection" field enum values. It is called by the builders before save.
+func SelectionValidator(s Selection) error {
+	switch s {
+	case SelectionRandom, SelectionWeakest, SelectionTagged:
+		return nil
+	default:
+		return fmt.Errorf("quiz: invalid enum value for selection field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Quiz queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCollectionID orders the results by the collection_id field.
+func ByCollectionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCollectionID, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// BySelection orders the results by the selection field.
+func BySelection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelection, opts...).ToFunc()
+}
+
+// ByQuestionCount orders the results by the question_count field.
+func ByQuestionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionCount, opts...).ToFunc()
+}
+
+// ByAdaptive orders the results by the adaptive field.
+func ByAdaptive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAdaptive, opts...).ToFunc()
+}
+
+// ByChoiceCount orders the results by the choice_count field.
+func ByChoiceCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChoiceCount, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByTimeLimitSeconds orders the results by the time_limit_seconds field.
+func ByTimeLimitSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeLimitSeconds, opts...).ToFunc()
+}
+
+// ByOpensAt orders the results by the opens_at field.
+func ByOpensAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpensAt, opts...).ToFunc()
+}
+
+// ByClosesAt orders the results by the closes_at field.
+func ByClosesAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosesAt, opts...).ToFunc()
+}
+
+// ByMaxAttempts orders the results by the max_attempts field.
+func ByMaxAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxAttempts, opts...).ToFunc()
+}
+
+// ByReviewFeedback orders the results by the review_feedback field.
+func ByReviewFeedback(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewFeedback, opts...).ToFunc()
+}
+
+// BySlowAnswerSeconds orders the results by the slow_answer_seconds field.
+func BySlowAnswerSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlowAnswerSeconds, opts...).ToFunc()
+}
+
+// ByShared orders the results by the shared field.
+func ByShared(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShared, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCollectionField orders the results by collection field.
+func ByCollectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCollectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAttemptsCount orders the results by attempts count.
+func ByAttemptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAttemptsStep(), opts...)
+	}
+}
+
+// ByAttempts orders the results by attempts terms.
+func ByAttempts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByItemStatisticsCount orders the results by item_statistics count.
+func ByItemStatisticsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newItemStatisticsStep(), opts...)
+	}
+}
+
+// ByItemStatistics orders the results by item_statistics terms.
+func ByItemStatistics(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStatisticsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCollectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CollectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CollectionTable, CollectionColumn),
+	)
+}
+func newAttemptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+	)
+}
+func newItemStatisticsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemStatisticsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ItemStatisticsTable, ItemStatisticsColumn),
+	)
+}
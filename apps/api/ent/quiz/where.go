@@ -0,0 +1,891 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quiz
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldID, id))
+}
+
+// CollectionID applies equality check predicate on the "collection_id" field. It's identical to CollectionIDEQ.
+func CollectionID(v uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTitle, v))
+}
+
+// QuestionCount applies equality check predicate on the "question_count" field. It's identical to QuestionCountEQ.
+func QuestionCount(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionCount, v))
+}
+
+// Adaptive applies equality check predicate on the "adaptive" field. It's identical to AdaptiveEQ.
+func Adaptive(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldAdaptive, v))
+}
+
+// ChoiceCount applies equality check predicate on the "choice_count" field. It's identical to ChoiceCountEQ.
+func ChoiceCount(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldChoiceCount, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSeed, v))
+}
+
+// TimeLimitSeconds applies equality check predicate on the "time_limit_seconds" field. It's identical to TimeLimitSecondsEQ.
+func TimeLimitSeconds(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimitSeconds, v))
+}
+
+// OpensAt applies equality check predicate on the "opens_at" field. It's identical to OpensAtEQ.
+func OpensAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldOpensAt, v))
+}
+
+// ClosesAt applies equality check predicate on the "closes_at" field. It's identical to ClosesAtEQ.
+func ClosesAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldClosesAt, v))
+}
+
+// MaxAttempts applies equality check predicate on the "max_attempts" field. It's identical to MaxAttemptsEQ.
+func MaxAttempts(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// ReviewFeedback applies equality check predicate on the "review_feedback" field. It's identical to ReviewFeedbackEQ.
+func ReviewFeedback(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldReviewFeedback, v))
+}
+
+// SlowAnswerSeconds applies equality check predicate on the "slow_answer_seconds" field. It's identical to SlowAnswerSecondsEQ.
+func SlowAnswerSeconds(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSlowAnswerSeconds, v))
+}
+
+// Shared applies equality check predicate on the "shared" field. It's identical to SharedEQ.
+func Shared(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldShared, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CollectionIDEQ applies the EQ predicate on the "collection_id" field.
+func CollectionIDEQ(v uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCollectionID, v))
+}
+
+// CollectionIDNEQ applies the NEQ predicate on the "collection_id" field.
+func CollectionIDNEQ(v uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldCollectionID, v))
+}
+
+// CollectionIDIn applies the In predicate on the "collection_id" field.
+func CollectionIDIn(vs ...uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldCollectionID, vs...))
+}
+
+// CollectionIDNotIn applies the NotIn predicate on the "collection_id" field.
+func CollectionIDNotIn(vs ...uuid.UUID) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldCollectionID, vs...))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SelectionEQ applies the EQ predicate on the "selection" field.
+func SelectionEQ(v Selection) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSelection, v))
+}
+
+// SelectionNEQ applies the NEQ predicate on the "selection" field.
+func SelectionNEQ(v Selection) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldSelection, v))
+}
+
+// SelectionIn applies the In predicate on the "selection" field.
+func SelectionIn(vs ...Selection) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldSelection, vs...))
+}
+
+// SelectionNotIn applies the NotIn predicate on the "selection" field.
+func SelectionNotIn(vs ...Selection) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldSelection, vs...))
+}
+
+// QuestionCountEQ applies the EQ predicate on the "question_count" field.
+func QuestionCountEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionCount, v))
+}
+
+// QuestionCountNEQ applies the NEQ predicate on the "question_count" field.
+func QuestionCountNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldQuestionCount, v))
+}
+
+// QuestionCountIn applies the In predicate on the "question_count" field.
+func QuestionCountIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldQuestionCount, vs...))
+}
+
+// QuestionCountNotIn applies the NotIn predicate on the "question_count" field.
+func QuestionCountNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldQuestionCount, vs...))
+}
+
+// QuestionCountGT applies the GT predicate on the "question_count" field.
+func QuestionCountGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldQuestionCount, v))
+}
+
+// QuestionCountGTE applies the GTE predicate on the "question_count" field.
+func QuestionCountGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldQuestionCount, v))
+}
+
+// QuestionCountLT applies the LT predicate on the "question_count" field.
+func QuestionCountLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldQuestionCount, v))
+}
+
+// QuestionCountLTE applies the LTE predicate on the "question_count" field.
+func QuestionCountLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldQuestionCount, v))
+}
+
+// AdaptiveEQ applies the EQ predicate on the "adaptive" field.
+func AdaptiveEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldAdaptive, v))
+}
+
+// AdaptiveNEQ applies the NEQ predicate on the "adaptive" field.
+func AdaptiveNEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldAdaptive, v))
+}
+
+// TypeCountsIsNil applies the IsNil predicate on the "type_counts" field.
+func TypeCountsIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldTypeCounts))
+}
+
+// TypeCountsNotNil applies the NotNil predicate on the "type_counts" field.
+func TypeCountsNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldTypeCounts))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldTags))
+}
+
+// ChoiceCountEQ applies the EQ predicate on the "choice_count" field.
+func ChoiceCountEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldChoiceCount, v))
+}
+
+// ChoiceCountNEQ applies the NEQ predicate on the "choice_count" field.
+func ChoiceCountNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldChoiceCount, v))
+}
+
+// ChoiceCountIn applies the In predicate on the "choice_count" field.
+func ChoiceCountIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldChoiceCount, vs...))
+}
+
+// ChoiceCountNotIn applies the NotIn predicate on the "choice_count" field.
+func ChoiceCountNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldChoiceCount, vs...))
+}
+
+// ChoiceCountGT applies the GT predicate on the "choice_count" field.
+func ChoiceCountGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldChoiceCount, v))
+}
+
+// ChoiceCountGTE applies the GTE predicate on the "choice_count" field.
+func ChoiceCountGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldChoiceCount, v))
+}
+
+// ChoiceCountLT applies the LT predicate on the "choice_count" field.
+func ChoiceCountLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldChoiceCount, v))
+}
+
+// ChoiceCountLTE applies the LTE predicate on the "choice_count" field.
+func ChoiceCountLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldChoiceCount, v))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldSeed, v))
+}
+
+// TimeLimitSecondsEQ applies the EQ predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimitSeconds, v))
+}
+
+// TimeLimitSecondsNEQ applies the NEQ predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTimeLimitSeconds, v))
+}
+
+// TimeLimitSecondsIn applies the In predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTimeLimitSeconds, vs...))
+}
+
+// TimeLimitSecondsNotIn applies the NotIn predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTimeLimitSeconds, vs...))
+}
+
+// TimeLimitSecondsGT applies the GT predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTimeLimitSeconds, v))
+}
+
+// TimeLimitSecondsGTE applies the GTE predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTimeLimitSeconds, v))
+}
+
+// TimeLimitSecondsLT applies the LT predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTimeLimitSeconds, v))
+}
+
+// TimeLimitSecondsLTE applies the LTE predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTimeLimitSeconds, v))
+}
+
+// TimeLimitSecondsIsNil applies the IsNil predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldTimeLimitSeconds))
+}
+
+// TimeLimitSecondsNotNil applies the NotNil predicate on the "time_limit_seconds" field.
+func TimeLimitSecondsNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldTimeLimitSeconds))
+}
+
+// OpensAtEQ applies the EQ predicate on the "opens_at" field.
+func OpensAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldOpensAt, v))
+}
+
+// OpensAtNEQ applies the NEQ predicate on the "opens_at" field.
+func OpensAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldOpensAt, v))
+}
+
+// OpensAtIn applies the In predicate on the "opens_at" field.
+func OpensAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldOpensAt, vs...))
+}
+
+// OpensAtNotIn applies the NotIn predicate on the "opens_at" field.
+func OpensAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldOpensAt, vs...))
+}
+
+// OpensAtGT applies the GT predicate on the "opens_at" field.
+func OpensAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldOpensAt, v))
+}
+
+// OpensAtGTE applies the GTE predicate on the "opens_at" field.
+func OpensAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldOpensAt, v))
+}
+
+// OpensAtLT applies the LT predicate on the "opens_at" field.
+func OpensAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldOpensAt, v))
+}
+
+// OpensAtLTE applies the LTE predicate on the "opens_at" field.
+func OpensAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldOpensAt, v))
+}
+
+// OpensAtIsNil applies the IsNil predicate on the "opens_at" field.
+func OpensAtIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldOpensAt))
+}
+
+// OpensAtNotNil applies the NotNil predicate on the "opens_at" field.
+func OpensAtNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldOpensAt))
+}
+
+// ClosesAtEQ applies the EQ predicate on the "closes_at" field.
+func ClosesAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldClosesAt, v))
+}
+
+// ClosesAtNEQ applies the NEQ predicate on the "closes_at" field.
+func ClosesAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldClosesAt, v))
+}
+
+// ClosesAtIn applies the In predicate on the "closes_at" field.
+func ClosesAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldClosesAt, vs...))
+}
+
+// ClosesAtNotIn applies the NotIn predicate on the "closes_at" field.
+func ClosesAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldClosesAt, vs...))
+}
+
+// ClosesAtGT applies the GT predicate on the "closes_at" field.
+func ClosesAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldClosesAt, v))
+}
+
+// ClosesAtGTE applies the GTE predicate on the "closes_at" field.
+func ClosesAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldClosesAt, v))
+}
+
+// ClosesAtLT applies the LT predicate on the "closes_at" field.
+func ClosesAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldClosesAt, v))
+}
+
+// ClosesAtLTE applies the LTE predicate on the "closes_at" field.
+func ClosesAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldClosesAt, v))
+}
+
+// ClosesAtIsNil applies the IsNil predicate on the "closes_at" field.
+func ClosesAtIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldClosesAt))
+}
+
+// ClosesAtNotNil applies the NotNil predicate on the "closes_at" field.
+func ClosesAtNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldClosesAt))
+}
+
+// MaxAttemptsEQ applies the EQ predicate on the "max_attempts" field.
+func MaxAttemptsEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsNEQ applies the NEQ predicate on the "max_attempts" field.
+func MaxAttemptsNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIn applies the In predicate on the "max_attempts" field.
+func MaxAttemptsIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsNotIn applies the NotIn predicate on the "max_attempts" field.
+func MaxAttemptsNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldMaxAttempts, vs...))
+}
+
+// MaxAttemptsGT applies the GT predicate on the "max_attempts" field.
+func MaxAttemptsGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsGTE applies the GTE predicate on the "max_attempts" field.
+func MaxAttemptsGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLT applies the LT predicate on the "max_attempts" field.
+func MaxAttemptsLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsLTE applies the LTE predicate on the "max_attempts" field.
+func MaxAttemptsLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldMaxAttempts, v))
+}
+
+// MaxAttemptsIsNil applies the IsNil predicate on the "max_attempts" field.
+func MaxAttemptsIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldMaxAttempts))
+}
+
+// MaxAttemptsNotNil applies the NotNil predicate on the "max_attempts" field.
+func MaxAttemptsNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldMaxAttempts))
+}
+
+// ReviewFeedbackEQ applies the EQ predicate on the "review_feedback" field.
+func ReviewFeedbackEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldReviewFeedback, v))
+}
+
+// ReviewFeedbackNEQ applies the NEQ predicate on the "review_feedback" field.
+func ReviewFeedbackNEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldReviewFeedback, v))
+}
+
+// SlowAnswerSecondsEQ applies the EQ predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldSlowAnswerSeconds, v))
+}
+
+// SlowAnswerSecondsNEQ applies the NEQ predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldSlowAnswerSeconds, v))
+}
+
+// SlowAnswerSecondsIn applies the In predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldSlowAnswerSeconds, vs...))
+}
+
+// SlowAnswerSecondsNotIn applies the NotIn predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldSlowAnswerSeconds, vs...))
+}
+
+// SlowAnswerSecondsGT applies the GT predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldSlowAnswerSeconds, v))
+}
+
+// SlowAnswerSecondsGTE applies the GTE predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldSlowAnswerSeconds, v))
+}
+
+// SlowAnswerSecondsLT applies the LT predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldSlowAnswerSeconds, v))
+}
+
+// SlowAnswerSecondsLTE applies the LTE predicate on the "slow_answer_seconds" field.
+func SlowAnswerSecondsLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldSlowAnswerSeconds, v))
+}
+
+// SharedEQ applies the EQ predicate on the "shared" field.
+func SharedEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldShared, v))
+}
+
+// SharedNEQ applies the NEQ predicate on the "shared" field.
+func SharedNEQ(v bool) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldShared, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCollection applies the HasEdge predicate on the "collection" edge.
+func HasCollection() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CollectionTable, CollectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCollectionWith applies the HasEdge predicate on the "collection" edge with a given conditions (other predicates).
+func HasCollectionWith(preds ...predicate.Collection) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newCollectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAttempts applies the HasEdge predicate on the "attempts" edge.
+func HasAttempts() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AttemptsTable, AttemptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptsWith applies the HasEdge predicate on the "attempts" edge with a given conditions (other predicates).
+func HasAttemptsWith(preds ...predicate.QuizAttempt) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newAttemptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItemStatistics applies the HasEdge predicate on the "item_statistics" edge.
+func HasItemStatistics() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemStatisticsTable, ItemStatisticsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemStatisticsWith applies the HasEdge predicate on the "item_statistics" edge with a given conditions (other predicates).
+func HasItemStatisticsWith(preds ...predicate.ItemStatistic) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newItemStatisticsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Quiz) predicate.Quiz {
+	return predicate.Quiz(sql.NotPredicates(p))
+}
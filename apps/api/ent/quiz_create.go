@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+)
+
+// QuizCreate is the builder for creating a Quiz entity.
+type QuizCreate struct {
+	config
+	mutation *QuizMutation
+	hooks    []Hook
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_c *QuizCreate) SetCollectionID(v uuid.UUID) *QuizCreate {
+	_c.mutation.SetCollectionID(v)
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *QuizCreate) SetCreatedBy(v string) *QuizCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *QuizCreate) SetTitle(v string) *QuizCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableTitle(v *string) *QuizCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetSelection sets the "selection" field.
+func (_c *QuizCreate) SetSelection(v quiz.Selection) *QuizCreate {
+	_c.mutation.SetSelection(v)
+	return _c
+}
+
+// SetNillableSelection sets the "selection" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableSelection(v *quiz.Selection) *QuizCreate {
+	if v != nil {
+		_c.SetSelection(*v)
+	}
+	return _c
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (_c *QuizCreate) SetQuestionCount(v int) *QuizCreate {
+	_c.mutation.SetQuestionCount(v)
+	return _c
+}
+
+// SetAdaptive sets the "adaptive" field.
+func (_c *QuizCreate) SetAdaptive(v bool) *QuizCreate {
+	_c.mutation.SetAdaptive(v)
+	return _c
+}
+
+// SetNillableAdaptive sets the "adaptive" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableAdaptive(v *bool) *QuizCreate {
+	if v != nil {
+		_c.SetAdaptive(*v)
+	}
+	return _c
+}
+
+// SetTypeCounts sets the "type_counts" field.
+func (_c *QuizCreate) SetTypeCounts(v map[string]int) *QuizCreate {
+	_c.mutation.SetTypeCounts(v)
+	return _c
+}
+
+// SetTags sets the "tags" field.
+func (_c *QuizCreate) SetTags(v []string) *QuizCreate {
+	_c.mutation.SetTags(v)
+	return _c
+}
+
+// SetChoiceCount sets the "choice_count" field.
+func (_c *QuizCreate) SetChoiceCount(v int) *QuizCreate {
+	_c.mutation.SetChoiceCount(v)
+	return _c
+}
+
+// SetNillableChoiceCount sets the "choice_count" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableChoiceCount(v *int) *QuizCreate {
+	if v != nil {
+		_c.SetChoiceCount(*v)
+	}
+	return _c
+}
+
+// SetSeed sets the "seed" field.
+func (_c *QuizCreate) SetSeed(v int64) *QuizCreate {
+	_c.mutation.SetSeed(v)
+	return _c
+}
+
+// SetFlashcardIds sets the "flashcard_ids" field.
+func (_c *QuizCreate) SetFlashcardIds(v []uuid.UUID) *QuizCreate {
+	_c.mutation.SetFlashcardIds(v)
+	return _c
+}
+
+// SetTimeLimitSeconds sets the "time_limit_seconds" field.
+func (_c *QuizCreate) SetTimeLimitSeconds(v int) *QuizCreate {
+	_c.mutation.SetTimeLimitSeconds(v)
+	return _c
+}
+
+// SetNillableTimeLimitSeconds sets the "time_limit_seconds" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableTimeLimitSeconds(v *int) *QuizCreate {
+	if v != nil {
+		_c.SetTimeLimitSeconds(*v)
+	}
+	return _c
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_c *QuizCreate) SetOpensAt(v time.Time) *QuizCreate {
+	_c.mutation.SetOpensAt(v)
+	return _c
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableOpensAt(v *time.Time) *QuizCreate {
+	if v != nil {
+		_c.SetOpensAt(*v)
+	}
+	return _c
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_c *QuizCreate) SetClosesAt(v time.Time) *QuizCreate {
+	_c.mutation.SetClosesAt(v)
+	return _c
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableClosesAt(v *time.Time) *QuizCreate {
+	if v != nil {
+		_c.SetClosesAt(*v)
+	}
+	return _c
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_c *QuizCreate) SetMaxAttempts(v int) *QuizCreate {
+	_c.mutation.SetMaxAttempts(v)
+	return _c
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableMaxAttempts(v *int) *QuizCreate {
+	if v != nil {
+		_c.SetMaxAttempts(*v)
+	}
+	return _c
+}
+
+// SetReviewFeedback sets the "review_feedback" field.
+func (_c *QuizCreate) SetReviewFeedback(v bool) *QuizCreate {
+	_c.mutation.SetReviewFeedback(v)
+	return _c
+}
+
+// SetNillableReviewFeedback sets the "review_feedback" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableReviewFeedback(v *bool) *QuizCreate {
+	if v != nil {
+		_c.SetReviewFeedback(*v)
+	}
+	return _c
+}
+
+// SetSlowAnswerSeconds sets the "slow_answer_seconds" field.
+func (_c *QuizCreate) SetSlowAnswerSeconds(v int) *QuizCreate {
+	_c.mutation.SetSlowAnswerSeconds(v)
+	return _c
+}
+
+// SetNillableSlowAnswerSeconds sets the "slow_answer_seconds" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableSlowAnswerSeconds(v *int) *QuizCreate {
+	if v != nil {
+		_c.SetSlowAnswerSeconds(*v)
+	}
+	return _c
+}
+
+// SetShared sets the "shared" field.
+func (_c *QuizCreate) SetShared(v bool) *QuizCreate {
+	_c.mutation.SetShared(v)
+	return _c
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableShared(v *bool) *QuizCreate {
+	if v != nil {
+		_c.SetShared(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuizCreate) SetCreatedAt(v time.Time) *QuizCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableCreatedAt(v *time.Time) *QuizCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *QuizCreate) SetUpdatedAt(v time.Time) *QuizCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableUpdatedAt(v *time.Time) *QuizCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuizCreate) SetID(v uuid.UUID) *QuizCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuizCreate) SetNillableID(v *uuid.UUID) *QuizCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_c *QuizCreate) SetCollection(v *Collection) *QuizCreate {
+	return _c.SetCollectionID(v.ID)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by IDs.
+func (_c *QuizCreate) AddAttemptIDs(ids ...uuid.UUID) *QuizCreate {
+	_c.mutation.AddAttemptIDs(ids...)
+	return _c
+}
+
+// AddAttempts adds the "attempts" edges to the QuizAttempt entity.
+func (_c *QuizCreate) AddAttempts(v ...*QuizAttempt) *QuizCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAttemptIDs(ids...)
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (_c *QuizCreate) AddItemStatisticIDs(ids ...uuid.UUID) *QuizCreate {
+	_c.mutation.AddItemStatisticIDs(ids...)
+	return _c
+}
+
+// AddItemStatistics adds the "item_statistics" edges to the ItemStatistic entity.
+func (_c *QuizCreate) AddItemStatistics(v ...*ItemStatistic) *QuizCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemStatisticIDs(ids...)
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (_c *QuizCreate) Mutation() *QuizMutation {
+	return _c.mutation
+}
+
+// Save creates the Quiz in the database.
+func (_c *QuizCreate) Save(ctx context.Context) (*Quiz, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuizCreate) SaveX(ctx context.Context) *Quiz {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuizCreate) defaults() {
+	if _, ok := _c.mutation.Title(); !ok {
+		v := quiz.DefaultTitle
+		_c.mutation.SetTitle(v)
+	}
+	if _, ok := _c.mutation.Selection(); !ok {
+		v := quiz.DefaultSelection
+		_c.mutation.SetSelection(v)
+	}
+	if _, ok := _c.mutation.Adaptive(); !ok {
+		v := quiz.DefaultAdaptive
+		_c.mutation.SetAdaptive(v)
+	}
+	if _, ok := _c.mutation.ChoiceCount(); !ok {
+		v := quiz.DefaultChoiceCount
+		_c.mutation.SetChoiceCount(v)
+	}
+	if _, ok := _c.mutation.ReviewFeedback(); !ok {
+		v := quiz.DefaultReviewFeedback
+		_c.mutation.SetReviewFeedback(v)
+	}
+	if _, ok := _c.mutation.SlowAnswerSeconds(); !ok {
+		v := quiz.DefaultSlowAnswerSeconds
+		_c.mutation.SetSlowAnswerSeconds(v)
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		v := quiz.DefaultShared
+		_c.mutation.SetShared(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := quiz.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := quiz.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := quiz.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuizCreate) check() error {
+	if _, ok := _c.mutation.CollectionID(); !ok {
+		return &ValidationError{Name: "collection_id", err: errors.New(`ent: missing required field "Quiz.collection_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Quiz.created_by"`)}
+	}
+	if v, ok := _c.mutation.CreatedBy(); ok {
+		if err := quiz.CreatedByValidator(v); err != nil {
+			return &ValidationError{Name: "created_by", err: fmt.Errorf(`ent: validator failed for field "Quiz.created_by": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Selection(); !ok {
+		return &ValidationError{Name: "selection", err: errors.New(`ent: missing required field "Quiz.selection"`)}
+	}
+	if v, ok := _c.mutation.Selection(); ok {
+		if err := quiz.SelectionValidator(v); err != nil {
+			return &ValidationError{Name: "selection", err: fmt.Errorf(`ent: validator failed for field "Quiz.selection": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QuestionCount(); !ok {
+		return &ValidationError{Name: "question_count", err: errors.New(`ent: missing required field "Quiz.question_count"`)}
+	}
+	if v, ok := _c.mutation.QuestionCount(); ok {
+		if err := quiz.QuestionCountValidator(v); err != nil {
+			return &ValidationError{Name: "question_count", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Adaptive(); !ok {
+		return &ValidationError{Name: "adaptive", err: errors.New(`ent: missing required field "Quiz.adaptive"`)}
+	}
+	if _, ok := _c.mutation.ChoiceCount(); !ok {
+		return &ValidationError{Name: "choice_count", err: errors.New(`ent: missing required field "Quiz.choice_count"`)}
+	}
+	if v, ok := _c.mutation.ChoiceCount(); ok {
+		if err := quiz.ChoiceCountValidator(v); err != nil {
+			return &ValidationError{Name: "choice_count", err: fmt.Errorf(`ent: validator failed for field "Quiz.choice_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "Quiz.seed"`)}
+	}
+	if _, ok := _c.mutation.FlashcardIds(); !ok {
+		return &ValidationError{Name: "flashcard_ids", err: errors.New(`ent: missing required field "Quiz.flashcard_ids"`)}
+	}
+	if v, ok := _c.mutation.TimeLimitSeconds(); ok {
+		if err := quiz.TimeLimitSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_seconds", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReviewFeedback(); !ok {
+		return &ValidationError{Name: "review_feedback", err: errors.New(`ent: missing required field "Quiz.review_feedback"`)}
+	}
+	if _, ok := _c.mutation.SlowAnswerSeconds(); !ok {
+		return &ValidationError{Name: "slow_answer_seconds", err: errors.New(`ent: missing required field "Quiz.slow_answer_seconds"`)}
+	}
+	if v, ok := _c.mutation.SlowAnswerSeconds(); ok {
+		if err := quiz.SlowAnswerSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "slow_answer_seconds", err: fmt.Errorf(`ent: validator failed for field "Quiz.slow_answer_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		return &ValidationError{Name: "shared", err: errors.New(`ent: missing required field "Quiz.shared"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quiz.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Quiz.updated_at"`)}
+	}
+	if len(_c.mutation.CollectionIDs()) == 0 {
+		return &ValidationError{Name: "collection", err: errors.New(`ent: missing required edge "Quiz.collection"`)}
+	}
+	return nil
+}
+
+func (_c *QuizCreate) sqlSave(ctx context.Context) (*Quiz, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuizCreate) createSpec() (*Quiz, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Quiz{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(quiz.Table, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(quiz.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Selection(); ok {
+		_spec.SetField(quiz.FieldSelection, field.TypeEnum, value)
+		_node.Selection = value
+	}
+	if value, ok := _c.mutation.QuestionCount(); ok {
+		_spec.SetField(quiz.FieldQuestionCount, field.TypeInt, value)
+		_node.QuestionCount = value
+	}
+	if value, ok := _c.mutation.Adaptive(); ok {
+		_spec.SetField(quiz.FieldAdaptive, field.TypeBool, value)
+		_node.Adaptive = value
+	}
+	if value, ok := _c.mutation.TypeCounts(); ok {
+		_spec.SetField(quiz.FieldTypeCounts, field.TypeJSON, value)
+		_node.TypeCounts = value
+	}
+	if value, ok := _c.mutation.Tags(); ok {
+		_spec.SetField(quiz.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := _c.mutation.ChoiceCount(); ok {
+		_spec.SetField(quiz.FieldChoiceCount, field.TypeInt, value)
+		_node.ChoiceCount = value
+	}
+	if value, ok := _c.mutation.Seed(); ok {
+		_spec.SetField(quiz.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := _c.mutation.FlashcardIds(); ok {
+		_spec.SetField(quiz.FieldFlashcardIds, field.TypeJSON, value)
+		_node.FlashcardIds = value
+	}
+	if value, ok := _c.mutation.TimeLimitSeconds(); ok {
+		_spec.SetField(quiz.FieldTimeLimitSeconds, field.TypeInt, value)
+		_node.TimeLimitSeconds = &value
+	}
+	if value, ok := _c.mutation.OpensAt(); ok {
+		_spec.SetField(quiz.FieldOpensAt, field.TypeTime, value)
+		_node.OpensAt = &value
+	}
+	if value, ok := _c.mutation.ClosesAt(); ok {
+		_spec.SetField(quiz.FieldClosesAt, field.TypeTime, value)
+		_node.ClosesAt = &value
+	}
+	if value, ok := _c.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+		_node.MaxAttempts = &value
+	}
+	if value, ok := _c.mutation.ReviewFeedback(); ok {
+		_spec.SetField(quiz.FieldReviewFeedback, field.TypeBool, value)
+		_node.ReviewFeedback = value
+	}
+	if value, ok := _c.mutation.SlowAnswerSeconds(); ok {
+		_spec.SetField(quiz.FieldSlowAnswerSeconds, field.TypeInt, value)
+		_node.SlowAnswerSeconds = value
+	}
+	if value, ok := _c.mutation.Shared(); ok {
+		_spec.SetField(quiz.FieldShared, field.TypeBool, value)
+		_node.Shared = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(quiz.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.CollectionTable,
+			Columns: []string{quiz.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CollectionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemStatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuizCreateBulk is the builder for creating many Quiz entities in bulk.
+type QuizCreateBulk struct {
+	config
+	err      error
+	builders []*QuizCreate
+}
+
+// Save creates the Quiz entities in the database.
+func (_c *QuizCreateBulk) Save(ctx context.Context) ([]*Quiz, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Quiz, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuizCreateBulk) SaveX(ctx context.Context) []*Quiz {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+)
+
+// QuizUpdate is the builder for updating Quiz entities.
+type QuizUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuizMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuizUpdate builder.
+func (_u *QuizUpdate) Where(ps ...predicate.Quiz) *QuizUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *QuizUpdate) SetCollectionID(v uuid.UUID) *QuizUpdate {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableCollectionID(v *uuid.UUID) *QuizUpdate {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *QuizUpdate) SetTitle(v string) *QuizUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableTitle(v *string) *QuizUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *QuizUpdate) ClearTitle() *QuizUpdate {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetSelection sets the "selection" field.
+func (_u *QuizUpdate) SetSelection(v quiz.Selection) *QuizUpdate {
+	_u.mutation.SetSelection(v)
+	return _u
+}
+
+// SetNillableSelection sets the "selection" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableSelection(v *quiz.Selection) *QuizUpdate {
+	if v != nil {
+		_u.SetSelection(*v)
+	}
+	return _u
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (_u *QuizUpdate) SetQuestionCount(v int) *QuizUpdate {
+	_u.mutation.ResetQuestionCount()
+	_u.mutation.SetQuestionCount(v)
+	return _u
+}
+
+// SetNillableQuestionCount sets the "question_count" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableQuestionCount(v *int) *QuizUpdate {
+	if v != nil {
+		_u.SetQuestionCount(*v)
+	}
+	return _u
+}
+
+// AddQuestionCount adds value to the "question_count" field.
+func (_u *QuizUpdate) AddQuestionCount(v int) *QuizUpdate {
+	_u.mutation.AddQuestionCount(v)
+	return _u
+}
+
+// SetAdaptive sets the "adaptive" field.
+func (_u *QuizUpdate) SetAdaptive(v bool) *QuizUpdate {
+	_u.mutation.SetAdaptive(v)
+	return _u
+}
+
+// SetNillableAdaptive sets the "adaptive" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableAdaptive(v *bool) *QuizUpdate {
+	if v != nil {
+		_u.SetAdaptive(*v)
+	}
+	return _u
+}
+
+// SetTypeCounts sets the "type_counts" field.
+func (_u *QuizUpdate) SetTypeCounts(v map[string]int) *QuizUpdate {
+	_u.mutation.SetTypeCounts(v)
+	return _u
+}
+
+// ClearTypeCounts clears the value of the "type_counts" field.
+func (_u *QuizUpdate) ClearTypeCounts() *QuizUpdate {
+	_u.mutation.ClearTypeCounts()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *QuizUpdate) SetTags(v []string) *QuizUpdate {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *QuizUpdate) AppendTags(v []string) *QuizUpdate {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *QuizUpdate) ClearTags() *QuizUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetChoiceCount sets the "choice_count" field.
+func (_u *QuizUpdate) SetChoiceCount(v int) *QuizUpdate {
+	_u.mutation.ResetChoiceCount()
+	_u.mutation.SetChoiceCount(v)
+	return _u
+}
+
+// SetNillableChoiceCount sets the "choice_count" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableChoiceCount(v *int) *QuizUpdate {
+	if v != nil {
+		_u.SetChoiceCount(*v)
+	}
+	return _u
+}
+
+// AddChoiceCount adds value to the "choice_count" field.
+func (_u *QuizUpdate) AddChoiceCount(v int) *QuizUpdate {
+	_u.mutation.AddChoiceCount(v)
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *QuizUpdate) SetSeed(v int64) *QuizUpdate {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableSeed(v *int64) *QuizUpdate {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *QuizUpdate) AddSeed(v int64) *QuizUpdate {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetFlashcardIds sets the "flashcard_ids" field.
+func (_u *QuizUpdate) SetFlashcardIds(v []uuid.UUID) *QuizUpdate {
+	_u.mutation.SetFlashcardIds(v)
+	return _u
+}
+
+// AppendFlashcardIds appends value to the "flashcard_ids" field.
+func (_u *QuizUpdate) AppendFlashcardIds(v []uuid.UUID) *QuizUpdate {
+	_u.mutation.AppendFlashcardIds(v)
+	return _u
+}
+
+// SetTimeLimitSeconds sets the "time_limit_seconds" field.
+func (_u *QuizUpdate) SetTimeLimitSeconds(v int) *QuizUpdate {
+	_u.mutation.ResetTimeLimitSeconds()
+	_u.mutation.SetTimeLimitSeconds(v)
+	return _u
+}
+
+// SetNillableTimeLimitSeconds sets the "time_limit_seconds" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableTimeLimitSeconds(v *int) *QuizUpdate {
+	if v != nil {
+		_u.SetTimeLimitSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeLimitSeconds adds value to the "time_limit_seconds" field.
+func (_u *QuizUpdate) AddTimeLimitSeconds(v int) *QuizUpdate {
+	_u.mutation.AddTimeLimitSeconds(v)
+	return _u
+}
+
+// ClearTimeLimitSeconds clears the value of the "time_limit_seconds" field.
+func (_u *QuizUpdate) ClearTimeLimitSeconds() *QuizUpdate {
+	_u.mutation.ClearTimeLimitSeconds()
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *QuizUpdate) SetOpensAt(v time.Time) *QuizUpdate {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableOpensAt(v *time.Time) *QuizUpdate {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *QuizUpdate) ClearOpensAt() *QuizUpdate {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *QuizUpdate) SetClosesAt(v time.Time) *QuizUpdate {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableClosesAt(v *time.Time) *QuizUpdate {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *QuizUpdate) ClearClosesAt() *QuizUpdate {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *QuizUpdate) SetMaxAttempts(v int) *QuizUpdate {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableMaxAttempts(v *int) *QuizUpdate {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *QuizUpdate) AddMaxAttempts(v int) *QuizUpdate {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (_u *QuizUpdate) ClearMaxAttempts() *QuizUpdate {
+	_u.mutation.ClearMaxAttempts()
+	return _u
+}
+
+// SetReviewFeedback sets the "review_feedback" field.
+func (_u *QuizUpdate) SetReviewFeedback(v bool) *QuizUpdate {
+	_u.mutation.SetReviewFeedback(v)
+	return _u
+}
+
+// SetNillableReviewFeedback sets the "review_feedback" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableReviewFeedback(v *bool) *QuizUpdate {
+	if v != nil {
+		_u.SetReviewFeedback(*v)
+	}
+	return _u
+}
+
+// SetSlowAnswerSeconds sets the "slow_answer_seconds" field.
+func (_u *QuizUpdate) SetSlowAnswerSeconds(v int) *QuizUpdate {
+	_u.mutation.ResetSlowAnswerSeconds()
+	_u.mutation.SetSlowAnswerSeconds(v)
+	return _u
+}
+
+// SetNillableSlowAnswerSeconds sets the "slow_answer_seconds" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableSlowAnswerSeconds(v *int) *QuizUpdate {
+	if v != nil {
+		_u.SetSlowAnswerSeconds(*v)
+	}
+	return _u
+}
+
+// AddSlowAnswerSeconds adds value to the "slow_answer_seconds" field.
+func (_u *QuizUpdate) AddSlowAnswerSeconds(v int) *QuizUpdate {
+	_u.mutation.AddSlowAnswerSeconds(v)
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *QuizUpdate) SetShared(v bool) *QuizUpdate {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *QuizUpdate) SetNillableShared(v *bool) *QuizUpdate {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuizUpdate) SetUpdatedAt(v time.Time) *QuizUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_u *QuizUpdate) SetCollection(v *Collection) *QuizUpdate {
+	return _u.SetCollectionID(v.ID)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by IDs.
+func (_u *QuizUpdate) AddAttemptIDs(ids ...uuid.UUID) *QuizUpdate {
+	_u.mutation.AddAttemptIDs(ids...)
+	return _u
+}
+
+// AddAttempts adds the "attempts" edges to the QuizAttempt entity.
+func (_u *QuizUpdate) AddAttempts(v ...*QuizAttempt) *QuizUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptIDs(ids...)
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (_u *QuizUpdate) AddItemStatisticIDs(ids ...uuid.UUID) *QuizUpdate {
+	_u.mutation.AddItemStatisticIDs(ids...)
+	return _u
+}
+
+// AddItemStatistics adds the "item_statistics" edges to the ItemStatistic entity.
+func (_u *QuizUpdate) AddItemStatistics(v ...*ItemStatistic) *QuizUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemStatisticIDs(ids...)
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (_u *QuizUpdate) Mutation() *QuizMutation {
+	return _u.mutation
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (_u *QuizUpdate) ClearCollection() *QuizUpdate {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// ClearAttempts clears all "attempts" edges to the QuizAttempt entity.
+func (_u *QuizUpdate) ClearAttempts() *QuizUpdate {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to QuizAttempt entities by IDs.
+func (_u *QuizUpdate) RemoveAttemptIDs(ids ...uuid.UUID) *QuizUpdate {
+	_u.mutation.RemoveAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveAttempts removes "attempts" edges to QuizAttempt entities.
+func (_u *QuizUpdate) RemoveAttempts(v ...*QuizAttempt) *QuizUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptIDs(ids...)
+}
+
+// ClearItemStatistics clears all "item_statistics" edges to the ItemStatistic entity.
+func (_u *QuizUpdate) ClearItemStatistics() *QuizUpdate {
+	_u.mutation.ClearItemStatistics()
+	return _u
+}
+
+// RemoveItemStatisticIDs removes the "item_statistics" edge to ItemStatistic entities by IDs.
+func (_u *QuizUpdate) RemoveItemStatisticIDs(ids ...uuid.UUID) *QuizUpdate {
+	_u.mutation.RemoveItemStatisticIDs(ids...)
+	return _u
+}
+
+// RemoveItemStatistics removes "item_statistics" edges to ItemStatistic entities.
+func (_u *QuizUpdate) RemoveItemStatistics(v ...*ItemStatistic) *QuizUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemStatisticIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuizUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuizUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuizUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := quiz.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selection(); ok {
+		if err := quiz.SelectionValidator(v); err != nil {
+			return &ValidationError{Name: "selection", err: fmt.Errorf(`ent: validator failed for field "Quiz.selection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuestionCount(); ok {
+		if err := quiz.QuestionCountValidator(v); err != nil {
+			return &ValidationError{Name: "question_count", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChoiceCount(); ok {
+		if err := quiz.ChoiceCountValidator(v); err != nil {
+			return &ValidationError{Name: "choice_count", err: fmt.Errorf(`ent: validator failed for field "Quiz.choice_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeLimitSeconds(); ok {
+		if err := quiz.TimeLimitSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_seconds", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SlowAnswerSeconds(); ok {
+		if err := quiz.SlowAnswerSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "slow_answer_seconds", err: fmt.Errorf(`ent: validator failed for field "Quiz.slow_answer_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Quiz.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quiz.Table, quiz.Columns, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(quiz.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Selection(); ok {
+		_spec.SetField(quiz.FieldSelection, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuestionCount(); ok {
+		_spec.SetField(quiz.FieldQuestionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuestionCount(); ok {
+		_spec.AddField(quiz.FieldQuestionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Adaptive(); ok {
+		_spec.SetField(quiz.FieldAdaptive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TypeCounts(); ok {
+		_spec.SetField(quiz.FieldTypeCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.TypeCountsCleared() {
+		_spec.ClearField(quiz.FieldTypeCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(quiz.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quiz.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(quiz.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChoiceCount(); ok {
+		_spec.SetField(quiz.FieldChoiceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChoiceCount(); ok {
+		_spec.AddField(quiz.FieldChoiceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(quiz.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(quiz.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FlashcardIds(); ok {
+		_spec.SetField(quiz.FieldFlashcardIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFlashcardIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quiz.FieldFlashcardIds, value)
+		})
+	}
+	if value, ok := _u.mutation.TimeLimitSeconds(); ok {
+		_spec.SetField(quiz.FieldTimeLimitSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeLimitSeconds(); ok {
+		_spec.AddField(quiz.FieldTimeLimitSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.TimeLimitSecondsCleared() {
+		_spec.ClearField(quiz.FieldTimeLimitSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(quiz.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(quiz.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(quiz.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(quiz.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(quiz.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReviewFeedback(); ok {
+		_spec.SetField(quiz.FieldReviewFeedback, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SlowAnswerSeconds(); ok {
+		_spec.SetField(quiz.FieldSlowAnswerSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlowAnswerSeconds(); ok {
+		_spec.AddField(quiz.FieldSlowAnswerSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(quiz.FieldShared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.CollectionTable,
+			Columns: []string{quiz.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.CollectionTable,
+			Columns: []string{quiz.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !_u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemStatisticsIDs(); len(nodes) > 0 && !_u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemStatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quiz.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuizUpdateOne is the builder for updating a single Quiz entity.
+type QuizUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuizMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCollectionID sets the "collection_id" field.
+func (_u *QuizUpdateOne) SetCollectionID(v uuid.UUID) *QuizUpdateOne {
+	_u.mutation.SetCollectionID(v)
+	return _u
+}
+
+// SetNillableCollectionID sets the "collection_id" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableCollectionID(v *uuid.UUID) *QuizUpdateOne {
+	if v != nil {
+		_u.SetCollectionID(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *QuizUpdateOne) SetTitle(v string) *QuizUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableTitle(v *string) *QuizUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *QuizUpdateOne) ClearTitle() *QuizUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetSelection sets the "selection" field.
+func (_u *QuizUpdateOne) SetSelection(v quiz.Selection) *QuizUpdateOne {
+	_u.mutation.SetSelection(v)
+	return _u
+}
+
+// SetNillableSelection sets the "selection" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableSelection(v *quiz.Selection) *QuizUpdateOne {
+	if v != nil {
+		_u.SetSelection(*v)
+	}
+	return _u
+}
+
+// SetQuestionCount sets the "question_count" field.
+func (_u *QuizUpdateOne) SetQuestionCount(v int) *QuizUpdateOne {
+	_u.mutation.ResetQuestionCount()
+	_u.mutation.SetQuestionCount(v)
+	return _u
+}
+
+// SetNillableQuestionCount sets the "question_count" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableQuestionCount(v *int) *QuizUpdateOne {
+	if v != nil {
+		_u.SetQuestionCount(*v)
+	}
+	return _u
+}
+
+// AddQuestionCount adds value to the "question_count" field.
+func (_u *QuizUpdateOne) AddQuestionCount(v int) *QuizUpdateOne {
+	_u.mutation.AddQuestionCount(v)
+	return _u
+}
+
+// SetAdaptive sets the "adaptive" field.
+func (_u *QuizUpdateOne) SetAdaptive(v bool) *QuizUpdateOne {
+	_u.mutation.SetAdaptive(v)
+	return _u
+}
+
+// SetNillableAdaptive sets the "adaptive" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableAdaptive(v *bool) *QuizUpdateOne {
+	if v != nil {
+		_u.SetAdaptive(*v)
+	}
+	return _u
+}
+
+// SetTypeCounts sets the "type_counts" field.
+func (_u *QuizUpdateOne) SetTypeCounts(v map[string]int) *QuizUpdateOne {
+	_u.mutation.SetTypeCounts(v)
+	return _u
+}
+
+// ClearTypeCounts clears the value of the "type_counts" field.
+func (_u *QuizUpdateOne) ClearTypeCounts() *QuizUpdateOne {
+	_u.mutation.ClearTypeCounts()
+	return _u
+}
+
+// SetTags sets the "tags" field.
+func (_u *QuizUpdateOne) SetTags(v []string) *QuizUpdateOne {
+	_u.mutation.SetTags(v)
+	return _u
+}
+
+// AppendTags appends value to the "tags" field.
+func (_u *QuizUpdateOne) AppendTags(v []string) *QuizUpdateOne {
+	_u.mutation.AppendTags(v)
+	return _u
+}
+
+// ClearTags clears the value of the "tags" field.
+func (_u *QuizUpdateOne) ClearTags() *QuizUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// SetChoiceCount sets the "choice_count" field.
+func (_u *QuizUpdateOne) SetChoiceCount(v int) *QuizUpdateOne {
+	_u.mutation.ResetChoiceCount()
+	_u.mutation.SetChoiceCount(v)
+	return _u
+}
+
+// SetNillableChoiceCount sets the "choice_count" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableChoiceCount(v *int) *QuizUpdateOne {
+	if v != nil {
+		_u.SetChoiceCount(*v)
+	}
+	return _u
+}
+
+// AddChoiceCount adds value to the "choice_count" field.
+func (_u *QuizUpdateOne) AddChoiceCount(v int) *QuizUpdateOne {
+	_u.mutation.AddChoiceCount(v)
+	return _u
+}
+
+// SetSeed sets the "seed" field.
+func (_u *QuizUpdateOne) SetSeed(v int64) *QuizUpdateOne {
+	_u.mutation.ResetSeed()
+	_u.mutation.SetSeed(v)
+	return _u
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableSeed(v *int64) *QuizUpdateOne {
+	if v != nil {
+		_u.SetSeed(*v)
+	}
+	return _u
+}
+
+// AddSeed adds value to the "seed" field.
+func (_u *QuizUpdateOne) AddSeed(v int64) *QuizUpdateOne {
+	_u.mutation.AddSeed(v)
+	return _u
+}
+
+// SetFlashcardIds sets the "flashcard_ids" field.
+func (_u *QuizUpdateOne) SetFlashcardIds(v []uuid.UUID) *QuizUpdateOne {
+	_u.mutation.SetFlashcardIds(v)
+	return _u
+}
+
+// AppendFlashcardIds appends value to the "flashcard_ids" field.
+func (_u *QuizUpdateOne) AppendFlashcardIds(v []uuid.UUID) *QuizUpdateOne {
+	_u.mutation.AppendFlashcardIds(v)
+	return _u
+}
+
+// SetTimeLimitSeconds sets the "time_limit_seconds" field.
+func (_u *QuizUpdateOne) SetTimeLimitSeconds(v int) *QuizUpdateOne {
+	_u.mutation.ResetTimeLimitSeconds()
+	_u.mutation.SetTimeLimitSeconds(v)
+	return _u
+}
+
+// SetNillableTimeLimitSeconds sets the "time_limit_seconds" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableTimeLimitSeconds(v *int) *QuizUpdateOne {
+	if v != nil {
+		_u.SetTimeLimitSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeLimitSeconds adds value to the "time_limit_seconds" field.
+func (_u *QuizUpdateOne) AddTimeLimitSeconds(v int) *QuizUpdateOne {
+	_u.mutation.AddTimeLimitSeconds(v)
+	return _u
+}
+
+// ClearTimeLimitSeconds clears the value of the "time_limit_seconds" field.
+func (_u *QuizUpdateOne) ClearTimeLimitSeconds() *QuizUpdateOne {
+	_u.mutation.ClearTimeLimitSeconds()
+	return _u
+}
+
+// SetOpensAt sets the "opens_at" field.
+func (_u *QuizUpdateOne) SetOpensAt(v time.Time) *QuizUpdateOne {
+	_u.mutation.SetOpensAt(v)
+	return _u
+}
+
+// SetNillableOpensAt sets the "opens_at" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableOpensAt(v *time.Time) *QuizUpdateOne {
+	if v != nil {
+		_u.SetOpensAt(*v)
+	}
+	return _u
+}
+
+// ClearOpensAt clears the value of the "opens_at" field.
+func (_u *QuizUpdateOne) ClearOpensAt() *QuizUpdateOne {
+	_u.mutation.ClearOpensAt()
+	return _u
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (_u *QuizUpdateOne) SetClosesAt(v time.Time) *QuizUpdateOne {
+	_u.mutation.SetClosesAt(v)
+	return _u
+}
+
+// SetNillableClosesAt sets the "closes_at" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableClosesAt(v *time.Time) *QuizUpdateOne {
+	if v != nil {
+		_u.SetClosesAt(*v)
+	}
+	return _u
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (_u *QuizUpdateOne) ClearClosesAt() *QuizUpdateOne {
+	_u.mutation.ClearClosesAt()
+	return _u
+}
+
+// SetMaxAttempts sets the "max_attempts" field.
+func (_u *QuizUpdateOne) SetMaxAttempts(v int) *QuizUpdateOne {
+	_u.mutation.ResetMaxAttempts()
+	_u.mutation.SetMaxAttempts(v)
+	return _u
+}
+
+// SetNillableMaxAttempts sets the "max_attempts" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableMaxAttempts(v *int) *QuizUpdateOne {
+	if v != nil {
+		_u.SetMaxAttempts(*v)
+	}
+	return _u
+}
+
+// AddMaxAttempts adds value to the "max_attempts" field.
+func (_u *QuizUpdateOne) AddMaxAttempts(v int) *QuizUpdateOne {
+	_u.mutation.AddMaxAttempts(v)
+	return _u
+}
+
+// ClearMaxAttempts clears the value of the "max_attempts" field.
+func (_u *QuizUpdateOne) ClearMaxAttempts() *QuizUpdateOne {
+	_u.mutation.ClearMaxAttempts()
+	return _u
+}
+
+// SetReviewFeedback sets the "review_feedback" field.
+func (_u *QuizUpdateOne) SetReviewFeedback(v bool) *QuizUpdateOne {
+	_u.mutation.SetReviewFeedback(v)
+	return _u
+}
+
+// SetNillableReviewFeedback sets the "review_feedback" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableReviewFeedback(v *bool) *QuizUpdateOne {
+	if v != nil {
+		_u.SetReviewFeedback(*v)
+	}
+	return _u
+}
+
+// SetSlowAnswerSeconds sets the "slow_answer_seconds" field.
+func (_u *QuizUpdateOne) SetSlowAnswerSeconds(v int) *QuizUpdateOne {
+	_u.mutation.ResetSlowAnswerSeconds()
+	_u.mutation.SetSlowAnswerSeconds(v)
+	return _u
+}
+
+// SetNillableSlowAnswerSeconds sets the "slow_answer_seconds" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableSlowAnswerSeconds(v *int) *QuizUpdateOne {
+	if v != nil {
+		_u.SetSlowAnswerSeconds(*v)
+	}
+	return _u
+}
+
+// AddSlowAnswerSeconds adds value to the "slow_answer_seconds" field.
+func (_u *QuizUpdateOne) AddSlowAnswerSeconds(v int) *QuizUpdateOne {
+	_u.mutation.AddSlowAnswerSeconds(v)
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *QuizUpdateOne) SetShared(v bool) *QuizUpdateOne {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *QuizUpdateOne) SetNillableShared(v *bool) *QuizUpdateOne {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *QuizUpdateOne) SetUpdatedAt(v time.Time) *QuizUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetCollection sets the "collection" edge to the Collection entity.
+func (_u *QuizUpdateOne) SetCollection(v *Collection) *QuizUpdateOne {
+	return _u.SetCollectionID(v.ID)
+}
+
+// AddAttemptIDs adds the "attempts" edge to the QuizAttempt entity by IDs.
+func (_u *QuizUpdateOne) AddAttemptIDs(ids ...uuid.UUID) *QuizUpdateOne {
+	_u.mutation.AddAttemptIDs(ids...)
+	return _u
+}
+
+// AddAttempts adds the "attempts" edges to the QuizAttempt entity.
+func (_u *QuizUpdateOne) AddAttempts(v ...*QuizAttempt) *QuizUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAttemptIDs(ids...)
+}
+
+// AddItemStatisticIDs adds the "item_statistics" edge to the ItemStatistic entity by IDs.
+func (_u *QuizUpdateOne) AddItemStatisticIDs(ids ...uuid.UUID) *QuizUpdateOne {
+	_u.mutation.AddItemStatisticIDs(ids...)
+	return _u
+}
+
+// AddItemStatistics adds the "item_statistics" edges to the ItemStatistic entity.
+func (_u *QuizUpdateOne) AddItemStatistics(v ...*ItemStatistic) *QuizUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemStatisticIDs(ids...)
+}
+
+// Mutation returns the QuizMutation object of the builder.
+func (_u *QuizUpdateOne) Mutation() *QuizMutation {
+	return _u.mutation
+}
+
+// ClearCollection clears the "collection" edge to the Collection entity.
+func (_u *QuizUpdateOne) ClearCollection() *QuizUpdateOne {
+	_u.mutation.ClearCollection()
+	return _u
+}
+
+// ClearAttempts clears all "attempts" edges to the QuizAttempt entity.
+func (_u *QuizUpdateOne) ClearAttempts() *QuizUpdateOne {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// RemoveAttemptIDs removes the "attempts" edge to QuizAttempt entities by IDs.
+func (_u *QuizUpdateOne) RemoveAttemptIDs(ids ...uuid.UUID) *QuizUpdateOne {
+	_u.mutation.RemoveAttemptIDs(ids...)
+	return _u
+}
+
+// RemoveAttempts removes "attempts" edges to QuizAttempt entities.
+func (_u *QuizUpdateOne) RemoveAttempts(v ...*QuizAttempt) *QuizUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAttemptIDs(ids...)
+}
+
+// ClearItemStatistics clears all "item_statistics" edges to the ItemStatistic entity.
+func (_u *QuizUpdateOne) ClearItemStatistics() *QuizUpdateOne {
+	_u.mutation.ClearItemStatistics()
+	return _u
+}
+
+// RemoveItemStatisticIDs removes the "item_statistics" edge to ItemStatistic entities by IDs.
+func (_u *QuizUpdateOne) RemoveItemStatisticIDs(ids ...uuid.UUID) *QuizUpdateOne {
+	_u.mutation.RemoveItemStatisticIDs(ids...)
+	return _u
+}
+
+// RemoveItemStatistics removes "item_statistics" edges to ItemStatistic entities.
+func (_u *QuizUpdateOne) RemoveItemStatistics(v ...*ItemStatistic) *QuizUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemStatisticIDs(ids...)
+}
+
+// Where appends a list predicates to the QuizUpdate builder.
+func (_u *QuizUpdateOne) Where(ps ...predicate.Quiz) *QuizUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuizUpdateOne) Select(field string, fields ...string) *QuizUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Quiz entity.
+func (_u *QuizUpdateOne) Save(ctx context.Context) (*Quiz, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizUpdateOne) SaveX(ctx context.Context) *Quiz {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuizUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *QuizUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := quiz.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := quiz.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Quiz.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Selection(); ok {
+		if err := quiz.SelectionValidator(v); err != nil {
+			return &ValidationError{Name: "selection", err: fmt.Errorf(`ent: validator failed for field "Quiz.selection": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.QuestionCount(); ok {
+		if err := quiz.QuestionCountValidator(v); err != nil {
+			return &ValidationError{Name: "question_count", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChoiceCount(); ok {
+		if err := quiz.ChoiceCountValidator(v); err != nil {
+			return &ValidationError{Name: "choice_count", err: fmt.Errorf(`ent: validator failed for field "Quiz.choice_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeLimitSeconds(); ok {
+		if err := quiz.TimeLimitSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_seconds", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_seconds": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxAttempts(); ok {
+		if err := quiz.MaxAttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "max_attempts", err: fmt.Errorf(`ent: validator failed for field "Quiz.max_attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SlowAnswerSeconds(); ok {
+		if err := quiz.SlowAnswerSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "slow_answer_seconds", err: fmt.Errorf(`ent: validator failed for field "Quiz.slow_answer_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.CollectionCleared() && len(_u.mutation.CollectionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Quiz.collection"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizUpdateOne) sqlSave(ctx context.Context) (_node *Quiz, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quiz.Table, quiz.Columns, sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Quiz.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quiz.FieldID)
+		for _, f := range fields {
+			if !quiz.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quiz.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(quiz.FieldTitle, field.TypeString, value)
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(quiz.FieldTitle, field.TypeString)
+	}
+	if value, ok := _u.mutation.Selection(); ok {
+		_spec.SetField(quiz.FieldSelection, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.QuestionCount(); ok {
+		_spec.SetField(quiz.FieldQuestionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuestionCount(); ok {
+		_spec.AddField(quiz.FieldQuestionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Adaptive(); ok {
+		_spec.SetField(quiz.FieldAdaptive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TypeCounts(); ok {
+		_spec.SetField(quiz.FieldTypeCounts, field.TypeJSON, value)
+	}
+	if _u.mutation.TypeCountsCleared() {
+		_spec.ClearField(quiz.FieldTypeCounts, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Tags(); ok {
+		_spec.SetField(quiz.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quiz.FieldTags, value)
+		})
+	}
+	if _u.mutation.TagsCleared() {
+		_spec.ClearField(quiz.FieldTags, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ChoiceCount(); ok {
+		_spec.SetField(quiz.FieldChoiceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChoiceCount(); ok {
+		_spec.AddField(quiz.FieldChoiceCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Seed(); ok {
+		_spec.SetField(quiz.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSeed(); ok {
+		_spec.AddField(quiz.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.FlashcardIds(); ok {
+		_spec.SetField(quiz.FieldFlashcardIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFlashcardIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quiz.FieldFlashcardIds, value)
+		})
+	}
+	if value, ok := _u.mutation.TimeLimitSeconds(); ok {
+		_spec.SetField(quiz.FieldTimeLimitSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeLimitSeconds(); ok {
+		_spec.AddField(quiz.FieldTimeLimitSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.TimeLimitSecondsCleared() {
+		_spec.ClearField(quiz.FieldTimeLimitSeconds, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OpensAt(); ok {
+		_spec.SetField(quiz.FieldOpensAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpensAtCleared() {
+		_spec.ClearField(quiz.FieldOpensAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ClosesAt(); ok {
+		_spec.SetField(quiz.FieldClosesAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosesAtCleared() {
+		_spec.ClearField(quiz.FieldClosesAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxAttempts(); ok {
+		_spec.SetField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAttempts(); ok {
+		_spec.AddField(quiz.FieldMaxAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.MaxAttemptsCleared() {
+		_spec.ClearField(quiz.FieldMaxAttempts, field.TypeInt)
+	}
+	if value, ok := _u.mutation.ReviewFeedback(); ok {
+		_spec.SetField(quiz.FieldReviewFeedback, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SlowAnswerSeconds(); ok {
+		_spec.SetField(quiz.FieldSlowAnswerSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSlowAnswerSeconds(); ok {
+		_spec.AddField(quiz.FieldSlowAnswerSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(quiz.FieldShared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(quiz.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CollectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.CollectionTable,
+			Columns: []string{quiz.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CollectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.CollectionTable,
+			Columns: []string{quiz.CollectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(collection.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAttemptsIDs(); len(nodes) > 0 && !_u.mutation.AttemptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.AttemptsTable,
+			Columns: []string{quiz.AttemptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemStatisticsIDs(); len(nodes) > 0 && !_u.mutation.ItemStatisticsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemStatisticsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quiz.ItemStatisticsTable,
+			Columns: []string{quiz.ItemStatisticsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(itemstatistic.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Quiz{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quiz.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,269 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// QuizAnswer is the model entity for the QuizAnswer schema.
+type QuizAnswer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AttemptID holds the value of the "attempt_id" field.
+	AttemptID uuid.UUID `json:"attempt_id,omitempty"`
+	// FlashcardID holds the value of the "flashcard_id" field.
+	FlashcardID uuid.UUID `json:"flashcard_id,omitempty"`
+	// Question number within the attempt, starting at 0
+	Position int `json:"position,omitempty"`
+	// Options of a multiple choice question, or generated for a simple card asked as multiple choice, in display order
+	Options []string `json:"options,omitempty"`
+	// Response holds the value of the "response" field.
+	Response *schema.QuizResponse `json:"response,omitempty"`
+	// Unset while the question is unanswered
+	AnsweredAt *time.Time `json:"answered_at,omitempty"`
+	// Correct holds the value of the "correct" field.
+	Correct bool `json:"correct,omitempty"`
+	// From 0 to 1, with partial credit for ordering and matching cards
+	Score float64 `json:"score,omitempty"`
+	// Time since the previous answer of the attempt, measured by the server
+	TimeSpentMs int `json:"time_spent_ms,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuizAnswerQuery when eager-loading is set.
+	Edges        QuizAnswerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuizAnswerEdges holds the relations/edges for other nodes in the graph.
+type QuizAnswerEdges struct {
+	// Attempt holds the value of the attempt edge.
+	Attempt *QuizAttempt `json:"attempt,omitempty"`
+	// Flashcard holds the value of the flashcard edge.
+	Flashcard *Flashcard `json:"flashcard,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttemptOrErr returns the Attempt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizAnswerEdges) AttemptOrErr() (*QuizAttempt, error) {
+	if e.Attempt != nil {
+		return e.Attempt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: quizattempt.Label}
+	}
+	return nil, &NotLoadedError{edge: "attempt"}
+}
+
+// FlashcardOrErr returns the Flashcard value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizAnswerEdges) FlashcardOrErr() (*Flashcard, error) {
+	if e.Flashcard != nil {
+		return e.Flashcard, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: flashcard.Label}
+	}
+	return nil, &NotLoadedError{edge: "flashcard"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuizAnswer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quizanswer.FieldOptions, quizanswer.FieldResponse:
+			values[i] = new([]byte)
+		case quizanswer.FieldCorrect:
+			values[i] = new(sql.NullBool)
+		case quizanswer.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case quizanswer.FieldPosition, quizanswer.FieldTimeSpentMs:
+			values[i] = new(sql.NullInt64)
+		case quizanswer.FieldAnsweredAt, quizanswer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case quizanswer.FieldID, quizanswer.FieldAttemptID, quizanswer.FieldFlashcardID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuizAnswer fields.
+func (_m *QuizAnswer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quizanswer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case quizanswer.FieldAttemptID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_id", values[i])
+			} else if value != nil {
+				_m.AttemptID = *value
+			}
+		case quizanswer.FieldFlashcardID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field flashcard_id", values[i])
+			} else if value != nil {
+				_m.FlashcardID = *value
+			}
+		case quizanswer.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case quizanswer.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case quizanswer.FieldResponse:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Response); err != nil {
+					return fmt.Errorf("unmarshal field response: %w", err)
+				}
+			}
+		case quizanswer.FieldAnsweredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field answered_at", values[i])
+			} else if value.Valid {
+				_m.AnsweredAt = new(time.Time)
+				*_m.AnsweredAt = value.Time
+			}
+		case quizanswer.FieldCorrect:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field correct", values[i])
+			} else if value.Valid {
+				_m.Correct = value.Bool
+			}
+		case quizanswer.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		case quizanswer.FieldTimeSpentMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_spent_ms", values[i])
+			} else if value.Valid {
+				_m.TimeSpentMs = int(value.Int64)
+			}
+		case quizanswer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuizAnswer.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuizAnswer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttempt queries the "attempt" edge of the QuizAnswer entity.
+func (_m *QuizAnswer) QueryAttempt() *QuizAttemptQuery {
+	return NewQuizAnswerClient(_m.config).QueryAttempt(_m)
+}
+
+// QueryFlashcard queries the "flashcard" edge of the QuizAnswer entity.
+func (_m *QuizAnswer) QueryFlashcard() *FlashcardQuery {
+	return NewQuizAnswerClient(_m.config).QueryFlashcard(_m)
+}
+
+// Update returns a builder for updating this QuizAnswer.
+// Note that you need to call QuizAnswer.Unwrap() before calling this method if this QuizAnswer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuizAnswer) Update() *QuizAnswerUpdateOne {
+	return NewQuizAnswerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuizAnswer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuizAnswer) Unwrap() *QuizAnswer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuizAnswer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuizAnswer) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuizAnswer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("attempt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptID))
+	builder.WriteString(", ")
+	builder.WriteString("flashcard_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlashcardID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Options))
+	builder.WriteString(", ")
+	builder.WriteString("response=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Response))
+	builder.WriteString(", ")
+	if v := _m.AnsweredAt; v != nil {
+		builder.WriteString("answered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("correct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Correct))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("time_spent_ms=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeSpentMs))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuizAnswers is a parsable slice of QuizAnswer.
+type QuizAnswers []*QuizAnswer
@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizanswer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the quizanswer type in the database.
+	Label = "quiz_answer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAttemptID holds the string denoting the attempt_id field in the database.
+	FieldAttemptID = "attempt_id"
+	// FieldFlashcardID holds the string denoting the flashcard_id field in the database.
+	FieldFlashcardID = "flashcard_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldOptions holds the string denoting the options field in the database.
+	FieldOptions = "options"
+	// FieldResponse holds the string denoting the response field in the database.
+	FieldResponse = "response"
+	// FieldAnsweredAt holds the string denoting the answered_at field in the database.
+	FieldAnsweredAt = "answered_at"
+	// FieldCorrect holds the string denoting the correct field in the database.
+	FieldCorrect = "correct"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldTimeSpentMs holds the string denoting the time_spent_ms field in the database.
+	FieldTimeSpentMs = "time_spent_ms"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAttempt holds the string denoting the attempt edge name in mutations.
+	EdgeAttempt = "attempt"
+	// EdgeFlashcard holds the string denoting the flashcard edge name in mutations.
+	EdgeFlashcard = "flashcard"
+	// Table holds the table name of the quizanswer in the database.
+	Table = "quiz_answers"
+	// AttemptTable is the table that holds the attempt relation/edge.
+	AttemptTable = "quiz_answers"
+	// AttemptInverseTable is the table name for the QuizAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "quizattempt" package.
+	AttemptInverseTable = "quiz_attempts"
+	// AttemptColumn is the table column denoting the attempt relation/edge.
+	AttemptColumn = "attempt_id"
+	// FlashcardTable is the table that holds the flashcard relation/edge.
+	FlashcardTable = "quiz_answers"
+	// FlashcardInverseTable is the table name for the Flashcard entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcard" package.
+	FlashcardInverseTable = "flashcards"
+	// FlashcardColumn is the table column denoting the flashcard relation/edge.
+	FlashcardColumn = "flashcard_id"
+)
+
+// Columns holds all SQL columns for quizanswer fields.
+var Columns = []string{
+	FieldID,
+	FieldAttemptID,
+	FieldFlashcardID,
+	FieldPosition,
+	FieldOptions,
+	FieldResponse,
+	FieldAnsweredAt,
+	FieldCorrect,
+	FieldScore,
+	FieldTimeSpentMs,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultCorrect holds the default value on creation for the "correct" field.
+	DefaultCorrect bool
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore float64
+	// DefaultTimeSpentMs holds the default value on creation for the "time_spent_ms" field.
+	DefaultTimeSpentMs int
+	// TimeSpentMsValidator is a validator for the "time_spent_ms" field. It is called by the builders before save.
+	TimeSpentMsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the QuizAnswer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAttemptID orders the results by the attempt_id field.
+func ByAttemptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptID, opts...).ToFunc()
+}
+
+// ByFlashcardID orders the results by the flashcard_id field.
+func ByFlashcardID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlashcardID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByAnsweredAt orders the results by the answered_at field.
+func ByAnsweredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnsweredAt, opts...).ToFunc()
+}
+
+// ByCorrect orders the results by the correct field.
+func ByCorrect(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCorrect, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByTimeSpentMs orders the results by the time_spent_ms field.
+func ByTimeSpentMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeSpentMs, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAttemptField orders the results by attempt field.
+func ByAttemptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFlashcardField orders the results by flashcard field.
+func ByFlashcardField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlashcardStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttemptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+	)
+}
+func newFlashcardStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlashcardInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+	)
+}
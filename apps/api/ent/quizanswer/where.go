@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizanswer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLTE(FieldID, id))
+}
+
+// AttemptID applies equality check predicate on the "attempt_id" field. It's identical to AttemptIDEQ.
+func AttemptID(v uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// FlashcardID applies equality check predicate on the "flashcard_id" field. It's identical to FlashcardIDEQ.
+func FlashcardID(v uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldPosition, v))
+}
+
+// AnsweredAt applies equality check predicate on the "answered_at" field. It's identical to AnsweredAtEQ.
+func AnsweredAt(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldAnsweredAt, v))
+}
+
+// Correct applies equality check predicate on the "correct" field. It's identical to CorrectEQ.
+func Correct(v bool) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldCorrect, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldScore, v))
+}
+
+// TimeSpentMs applies equality check predicate on the "time_spent_ms" field. It's identical to TimeSpentMsEQ.
+func TimeSpentMs(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldTimeSpentMs, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AttemptIDEQ applies the EQ predicate on the "attempt_id" field.
+func AttemptIDEQ(v uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// AttemptIDNEQ applies the NEQ predicate on the "attempt_id" field.
+func AttemptIDNEQ(v uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldAttemptID, v))
+}
+
+// AttemptIDIn applies the In predicate on the "attempt_id" field.
+func AttemptIDIn(vs ...uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldAttemptID, vs...))
+}
+
+// AttemptIDNotIn applies the NotIn predicate on the "attempt_id" field.
+func AttemptIDNotIn(vs ...uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldAttemptID, vs...))
+}
+
+// FlashcardIDEQ applies the EQ predicate on the "flashcard_id" field.
+func FlashcardIDEQ(v uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDNEQ applies the NEQ predicate on the "flashcard_id" field.
+func FlashcardIDNEQ(v uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldFlashcardID, v))
+}
+
+// FlashcardIDIn applies the In predicate on the "flashcard_id" field.
+func FlashcardIDIn(vs ...uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldFlashcardID, vs...))
+}
+
+// FlashcardIDNotIn applies the NotIn predicate on the "flashcard_id" field.
+func FlashcardIDNotIn(vs ...uuid.UUID) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldFlashcardID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLTE(FieldPosition, v))
+}
+
+// OptionsIsNil applies the IsNil predicate on the "options" field.
+func OptionsIsNil() predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIsNull(FieldOptions))
+}
+
+// OptionsNotNil applies the NotNil predicate on the "options" field.
+func OptionsNotNil() predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotNull(FieldOptions))
+}
+
+// ResponseIsNil applies the IsNil predicate on the "response" field.
+func ResponseIsNil() predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIsNull(FieldResponse))
+}
+
+// ResponseNotNil applies the NotNil predicate on the "response" field.
+func ResponseNotNil() predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotNull(FieldResponse))
+}
+
+// AnsweredAtEQ applies the EQ predicate on the "answered_at" field.
+func AnsweredAtEQ(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldAnsweredAt, v))
+}
+
+// AnsweredAtNEQ applies the NEQ predicate on the "answered_at" field.
+func AnsweredAtNEQ(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldAnsweredAt, v))
+}
+
+// AnsweredAtIn applies the In predicate on the "answered_at" field.
+func AnsweredAtIn(vs ...time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldAnsweredAt, vs...))
+}
+
+// AnsweredAtNotIn applies the NotIn predicate on the "answered_at" field.
+func AnsweredAtNotIn(vs ...time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldAnsweredAt, vs...))
+}
+
+// AnsweredAtGT applies the GT predicate on the "answered_at" field.
+func AnsweredAtGT(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGT(FieldAnsweredAt, v))
+}
+
+// AnsweredAtGTE applies the GTE predicate on the "answered_at" field.
+func AnsweredAtGTE(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGTE(FieldAnsweredAt, v))
+}
+
+// AnsweredAtLT applies the LT predicate on the "answered_at" field.
+func AnsweredAtLT(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLT(FieldAnsweredAt, v))
+}
+
+// AnsweredAtLTE applies the LTE predicate on the "answered_at" field.
+func AnsweredAtLTE(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLTE(FieldAnsweredAt, v))
+}
+
+// AnsweredAtIsNil applies the IsNil predicate on the "answered_at" field.
+func AnsweredAtIsNil() predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIsNull(FieldAnsweredAt))
+}
+
+// AnsweredAtNotNil applies the NotNil predicate on the "answered_at" field.
+func AnsweredAtNotNil() predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotNull(FieldAnsweredAt))
+}
+
+// CorrectEQ applies the EQ predicate on the "correct" field.
+func CorrectEQ(v bool) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldCorrect, v))
+}
+
+// CorrectNEQ applies the NEQ predicate on the "correct" field.
+func CorrectNEQ(v bool) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldCorrect, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLTE(FieldScore, v))
+}
+
+// TimeSpentMsEQ applies the EQ predicate on the "time_spent_ms" field.
+func TimeSpentMsEQ(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsNEQ applies the NEQ predicate on the "time_spent_ms" field.
+func TimeSpentMsNEQ(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsIn applies the In predicate on the "time_spent_ms" field.
+func TimeSpentMsIn(vs ...int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldTimeSpentMs, vs...))
+}
+
+// TimeSpentMsNotIn applies the NotIn predicate on the "time_spent_ms" field.
+func TimeSpentMsNotIn(vs ...int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldTimeSpentMs, vs...))
+}
+
+// TimeSpentMsGT applies the GT predicate on the "time_spent_ms" field.
+func TimeSpentMsGT(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGT(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsGTE applies the GTE predicate on the "time_spent_ms" field.
+func TimeSpentMsGTE(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGTE(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsLT applies the LT predicate on the "time_spent_ms" field.
+func TimeSpentMsLT(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLT(FieldTimeSpentMs, v))
+}
+
+// TimeSpentMsLTE applies the LTE predicate on the "time_spent_ms" field.
+func TimeSpentMsLTE(v int) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLTE(FieldTimeSpentMs, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAttempt applies the HasEdge predicate on the "attempt" edge.
+func HasAttempt() predicate.QuizAnswer {
+	return predicate.QuizAnswer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptWith applies the HasEdge predicate on the "attempt" edge with a given conditions (other predicates).
+func HasAttemptWith(preds ...predicate.QuizAttempt) predicate.QuizAnswer {
+	return predicate.QuizAnswer(func(s *sql.Selector) {
+		step := newAttemptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFlashcard applies the HasEdge predicate on the "flashcard" edge.
+func HasFlashcard() predicate.QuizAnswer {
+	return predicate.QuizAnswer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlashcardTable, FlashcardColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlashcardWith applies the HasEdge predicate on the "flashcard" edge with a given conditions (other predicates).
+func HasFlashcardWith(preds ...predicate.Flashcard) predicate.QuizAnswer {
+	return predicate.QuizAnswer(func(s *sql.Selector) {
+		step := newFlashcardStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuizAnswer) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuizAnswer) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuizAnswer) predicate.QuizAnswer {
+	return predicate.QuizAnswer(sql.NotPredicates(p))
+}
@@ -0,0 +1,433 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// QuizAnswerCreate is the builder for creating a QuizAnswer entity.
+type QuizAnswerCreate struct {
+	config
+	mutation *QuizAnswerMutation
+	hooks    []Hook
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_c *QuizAnswerCreate) SetAttemptID(v uuid.UUID) *QuizAnswerCreate {
+	_c.mutation.SetAttemptID(v)
+	return _c
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_c *QuizAnswerCreate) SetFlashcardID(v uuid.UUID) *QuizAnswerCreate {
+	_c.mutation.SetFlashcardID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *QuizAnswerCreate) SetPosition(v int) *QuizAnswerCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetOptions sets the "options" field.
+func (_c *QuizAnswerCreate) SetOptions(v []string) *QuizAnswerCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetResponse sets the "response" field.
+func (_c *QuizAnswerCreate) SetResponse(v *schema.QuizResponse) *QuizAnswerCreate {
+	_c.mutation.SetResponse(v)
+	return _c
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (_c *QuizAnswerCreate) SetAnsweredAt(v time.Time) *QuizAnswerCreate {
+	_c.mutation.SetAnsweredAt(v)
+	return _c
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (_c *QuizAnswerCreate) SetNillableAnsweredAt(v *time.Time) *QuizAnswerCreate {
+	if v != nil {
+		_c.SetAnsweredAt(*v)
+	}
+	return _c
+}
+
+// SetCorrect sets the "correct" field.
+func (_c *QuizAnswerCreate) SetCorrect(v bool) *QuizAnswerCreate {
+	_c.mutation.SetCorrect(v)
+	return _c
+}
+
+// SetNillableCorrect sets the "correct" field if the given value is not nil.
+func (_c *QuizAnswerCreate) SetNillableCorrect(v *bool) *QuizAnswerCreate {
+	if v != nil {
+		_c.SetCorrect(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *QuizAnswerCreate) SetScore(v float64) *QuizAnswerCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *QuizAnswerCreate) SetNillableScore(v *float64) *QuizAnswerCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (_c *QuizAnswerCreate) SetTimeSpentMs(v int) *QuizAnswerCreate {
+	_c.mutation.SetTimeSpentMs(v)
+	return _c
+}
+
+// SetNillableTimeSpentMs sets the "time_spent_ms" field if the given value is not nil.
+func (_c *QuizAnswerCreate) SetNillableTimeSpentMs(v *int) *QuizAnswerCreate {
+	if v != nil {
+		_c.SetTimeSpentMs(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuizAnswerCreate) SetCreatedAt(v time.Time) *QuizAnswerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuizAnswerCreate) SetNillableCreatedAt(v *time.Time) *QuizAnswerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuizAnswerCreate) SetID(v uuid.UUID) *QuizAnswerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuizAnswerCreate) SetNillableID(v *uuid.UUID) *QuizAnswerCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (_c *QuizAnswerCreate) SetAttempt(v *QuizAttempt) *QuizAnswerCreate {
+	return _c.SetAttemptID(v.ID)
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_c *QuizAnswerCreate) SetFlashcard(v *Flashcard) *QuizAnswerCreate {
+	return _c.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the QuizAnswerMutation object of the builder.
+func (_c *QuizAnswerCreate) Mutation() *QuizAnswerMutation {
+	return _c.mutation
+}
+
+// Save creates the QuizAnswer in the database.
+func (_c *QuizAnswerCreate) Save(ctx context.Context) (*QuizAnswer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuizAnswerCreate) SaveX(ctx context.Context) *QuizAnswer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizAnswerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizAnswerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuizAnswerCreate) defaults() {
+	if _, ok := _c.mutation.Correct(); !ok {
+		v := quizanswer.DefaultCorrect
+		_c.mutation.SetCorrect(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := quizanswer.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.TimeSpentMs(); !ok {
+		v := quizanswer.DefaultTimeSpentMs
+		_c.mutation.SetTimeSpentMs(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := quizanswer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := quizanswer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuizAnswerCreate) check() error {
+	if _, ok := _c.mutation.AttemptID(); !ok {
+		return &ValidationError{Name: "attempt_id", err: errors.New(`ent: missing required field "QuizAnswer.attempt_id"`)}
+	}
+	if _, ok := _c.mutation.FlashcardID(); !ok {
+		return &ValidationError{Name: "flashcard_id", err: errors.New(`ent: missing required field "QuizAnswer.flashcard_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "QuizAnswer.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := quizanswer.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "QuizAnswer.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Correct(); !ok {
+		return &ValidationError{Name: "correct", err: errors.New(`ent: missing required field "QuizAnswer.correct"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "QuizAnswer.score"`)}
+	}
+	if _, ok := _c.mutation.TimeSpentMs(); !ok {
+		return &ValidationError{Name: "time_spent_ms", err: errors.New(`ent: missing required field "QuizAnswer.time_spent_ms"`)}
+	}
+	if v, ok := _c.mutation.TimeSpentMs(); ok {
+		if err := quizanswer.TimeSpentMsValidator(v); err != nil {
+			return &ValidationError{Name: "time_spent_ms", err: fmt.Errorf(`ent: validator failed for field "QuizAnswer.time_spent_ms": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuizAnswer.created_at"`)}
+	}
+	if len(_c.mutation.AttemptIDs()) == 0 {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required edge "QuizAnswer.attempt"`)}
+	}
+	if len(_c.mutation.FlashcardIDs()) == 0 {
+		return &ValidationError{Name: "flashcard", err: errors.New(`ent: missing required edge "QuizAnswer.flashcard"`)}
+	}
+	return nil
+}
+
+func (_c *QuizAnswerCreate) sqlSave(ctx context.Context) (*QuizAnswer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuizAnswerCreate) createSpec() (*QuizAnswer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuizAnswer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(quizanswer.Table, sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(quizanswer.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(quizanswer.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.Response(); ok {
+		_spec.SetField(quizanswer.FieldResponse, field.TypeJSON, value)
+		_node.Response = value
+	}
+	if value, ok := _c.mutation.AnsweredAt(); ok {
+		_spec.SetField(quizanswer.FieldAnsweredAt, field.TypeTime, value)
+		_node.AnsweredAt = &value
+	}
+	if value, ok := _c.mutation.Correct(); ok {
+		_spec.SetField(quizanswer.FieldCorrect, field.TypeBool, value)
+		_node.Correct = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(quizanswer.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.TimeSpentMs(); ok {
+		_spec.SetField(quizanswer.FieldTimeSpentMs, field.TypeInt, value)
+		_node.TimeSpentMs = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(quizanswer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.AttemptTable,
+			Columns: []string{quizanswer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttemptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.FlashcardTable,
+			Columns: []string{quizanswer.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlashcardID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuizAnswerCreateBulk is the builder for creating many QuizAnswer entities in bulk.
+type QuizAnswerCreateBulk struct {
+	config
+	err      error
+	builders []*QuizAnswerCreate
+}
+
+// Save creates the QuizAnswer entities in the database.
+func (_c *QuizAnswerCreateBulk) Save(ctx context.Context) ([]*QuizAnswer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuizAnswer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizAnswerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuizAnswerCreateBulk) SaveX(ctx context.Context) []*QuizAnswer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizAnswerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizAnswerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// QuizAnswerUpdate is the builder for updating QuizAnswer entities.
+type QuizAnswerUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuizAnswerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuizAnswerUpdate builder.
+func (_u *QuizAnswerUpdate) Where(ps ...predicate.QuizAnswer) *QuizAnswerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *QuizAnswerUpdate) SetAttemptID(v uuid.UUID) *QuizAnswerUpdate {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillableAttemptID(v *uuid.UUID) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_u *QuizAnswerUpdate) SetFlashcardID(v uuid.UUID) *QuizAnswerUpdate {
+	_u.mutation.SetFlashcardID(v)
+	return _u
+}
+
+// SetNillableFlashcardID sets the "flashcard_id" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillableFlashcardID(v *uuid.UUID) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetFlashcardID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *QuizAnswerUpdate) SetPosition(v int) *QuizAnswerUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillablePosition(v *int) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *QuizAnswerUpdate) AddPosition(v int) *QuizAnswerUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *QuizAnswerUpdate) SetOptions(v []string) *QuizAnswerUpdate {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *QuizAnswerUpdate) AppendOptions(v []string) *QuizAnswerUpdate {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *QuizAnswerUpdate) ClearOptions() *QuizAnswerUpdate {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetResponse sets the "response" field.
+func (_u *QuizAnswerUpdate) SetResponse(v *schema.QuizResponse) *QuizAnswerUpdate {
+	_u.mutation.SetResponse(v)
+	return _u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (_u *QuizAnswerUpdate) ClearResponse() *QuizAnswerUpdate {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (_u *QuizAnswerUpdate) SetAnsweredAt(v time.Time) *QuizAnswerUpdate {
+	_u.mutation.SetAnsweredAt(v)
+	return _u
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillableAnsweredAt(v *time.Time) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetAnsweredAt(*v)
+	}
+	return _u
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (_u *QuizAnswerUpdate) ClearAnsweredAt() *QuizAnswerUpdate {
+	_u.mutation.ClearAnsweredAt()
+	return _u
+}
+
+// SetCorrect sets the "correct" field.
+func (_u *QuizAnswerUpdate) SetCorrect(v bool) *QuizAnswerUpdate {
+	_u.mutation.SetCorrect(v)
+	return _u
+}
+
+// SetNillableCorrect sets the "correct" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillableCorrect(v *bool) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetCorrect(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *QuizAnswerUpdate) SetScore(v float64) *QuizAnswerUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillableScore(v *float64) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *QuizAnswerUpdate) AddScore(v float64) *QuizAnswerUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (_u *QuizAnswerUpdate) SetTimeSpentMs(v int) *QuizAnswerUpdate {
+	_u.mutation.ResetTimeSpentMs()
+	_u.mutation.SetTimeSpentMs(v)
+	return _u
+}
+
+// SetNillableTimeSpentMs sets the "time_spent_ms" field if the given value is not nil.
+func (_u *QuizAnswerUpdate) SetNillableTimeSpentMs(v *int) *QuizAnswerUpdate {
+	if v != nil {
+		_u.SetTimeSpentMs(*v)
+	}
+	return _u
+}
+
+// AddTimeSpentMs adds value to the "time_spent_ms" field.
+func (_u *QuizAnswerUpdate) AddTimeSpentMs(v int) *QuizAnswerUpdate {
+	_u.mutation.AddTimeSpentMs(v)
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (_u *QuizAnswerUpdate) SetAttempt(v *QuizAttempt) *QuizAnswerUpdate {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_u *QuizAnswerUpdate) SetFlashcard(v *Flashcard) *QuizAnswerUpdate {
+	return _u.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the QuizAnswerMutation object of the builder.
+func (_u *QuizAnswerUpdate) Mutation() *QuizAnswerMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (_u *QuizAnswerUpdate) ClearAttempt() *QuizAnswerUpdate {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (_u *QuizAnswerUpdate) ClearFlashcard() *QuizAnswerUpdate {
+	_u.mutation.ClearFlashcard()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuizAnswerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizAnswerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuizAnswerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizAnswerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizAnswerUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := quizanswer.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "QuizAnswer.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeSpentMs(); ok {
+		if err := quizanswer.TimeSpentMsValidator(v); err != nil {
+			return &ValidationError{Name: "time_spent_ms", err: fmt.Errorf(`ent: validator failed for field "QuizAnswer.time_spent_ms": %w`, err)}
+		}
+	}
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAnswer.attempt"`)
+	}
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAnswer.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizAnswerUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizAnswerUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizAnswerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizanswer.Table, quizanswer.Columns, sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(quizanswer.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(quizanswer.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(quizanswer.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizanswer.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(quizanswer.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Response(); ok {
+		_spec.SetField(quizanswer.FieldResponse, field.TypeJSON, value)
+	}
+	if _u.mutation.ResponseCleared() {
+		_spec.ClearField(quizanswer.FieldResponse, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AnsweredAt(); ok {
+		_spec.SetField(quizanswer.FieldAnsweredAt, field.TypeTime, value)
+	}
+	if _u.mutation.AnsweredAtCleared() {
+		_spec.ClearField(quizanswer.FieldAnsweredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Correct(); ok {
+		_spec.SetField(quizanswer.FieldCorrect, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(quizanswer.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(quizanswer.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TimeSpentMs(); ok {
+		_spec.SetField(quizanswer.FieldTimeSpentMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeSpentMs(); ok {
+		_spec.AddField(quizanswer.FieldTimeSpentMs, field.TypeInt, value)
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.AttemptTable,
+			Columns: []string{quizanswer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.AttemptTable,
+			Columns: []string{quizanswer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FlashcardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.FlashcardTable,
+			Columns: []string{quizanswer.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.FlashcardTable,
+			Columns: []string{quizanswer.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizanswer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuizAnswerUpdateOne is the builder for updating a single QuizAnswer entity.
+type QuizAnswerUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuizAnswerMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_u *QuizAnswerUpdateOne) SetAttemptID(v uuid.UUID) *QuizAnswerUpdateOne {
+	_u.mutation.SetAttemptID(v)
+	return _u
+}
+
+// SetNillableAttemptID sets the "attempt_id" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillableAttemptID(v *uuid.UUID) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetAttemptID(*v)
+	}
+	return _u
+}
+
+// SetFlashcardID sets the "flashcard_id" field.
+func (_u *QuizAnswerUpdateOne) SetFlashcardID(v uuid.UUID) *QuizAnswerUpdateOne {
+	_u.mutation.SetFlashcardID(v)
+	return _u
+}
+
+// SetNillableFlashcardID sets the "flashcard_id" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillableFlashcardID(v *uuid.UUID) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetFlashcardID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *QuizAnswerUpdateOne) SetPosition(v int) *QuizAnswerUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillablePosition(v *int) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *QuizAnswerUpdateOne) AddPosition(v int) *QuizAnswerUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *QuizAnswerUpdateOne) SetOptions(v []string) *QuizAnswerUpdateOne {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *QuizAnswerUpdateOne) AppendOptions(v []string) *QuizAnswerUpdateOne {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *QuizAnswerUpdateOne) ClearOptions() *QuizAnswerUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetResponse sets the "response" field.
+func (_u *QuizAnswerUpdateOne) SetResponse(v *schema.QuizResponse) *QuizAnswerUpdateOne {
+	_u.mutation.SetResponse(v)
+	return _u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (_u *QuizAnswerUpdateOne) ClearResponse() *QuizAnswerUpdateOne {
+	_u.mutation.ClearResponse()
+	return _u
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (_u *QuizAnswerUpdateOne) SetAnsweredAt(v time.Time) *QuizAnswerUpdateOne {
+	_u.mutation.SetAnsweredAt(v)
+	return _u
+}
+
+// SetNillableAnsweredAt sets the "answered_at" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillableAnsweredAt(v *time.Time) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetAnsweredAt(*v)
+	}
+	return _u
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (_u *QuizAnswerUpdateOne) ClearAnsweredAt() *QuizAnswerUpdateOne {
+	_u.mutation.ClearAnsweredAt()
+	return _u
+}
+
+// SetCorrect sets the "correct" field.
+func (_u *QuizAnswerUpdateOne) SetCorrect(v bool) *QuizAnswerUpdateOne {
+	_u.mutation.SetCorrect(v)
+	return _u
+}
+
+// SetNillableCorrect sets the "correct" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillableCorrect(v *bool) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetCorrect(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *QuizAnswerUpdateOne) SetScore(v float64) *QuizAnswerUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillableScore(v *float64) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *QuizAnswerUpdateOne) AddScore(v float64) *QuizAnswerUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetTimeSpentMs sets the "time_spent_ms" field.
+func (_u *QuizAnswerUpdateOne) SetTimeSpentMs(v int) *QuizAnswerUpdateOne {
+	_u.mutation.ResetTimeSpentMs()
+	_u.mutation.SetTimeSpentMs(v)
+	return _u
+}
+
+// SetNillableTimeSpentMs sets the "time_spent_ms" field if the given value is not nil.
+func (_u *QuizAnswerUpdateOne) SetNillableTimeSpentMs(v *int) *QuizAnswerUpdateOne {
+	if v != nil {
+		_u.SetTimeSpentMs(*v)
+	}
+	return _u
+}
+
+// AddTimeSpentMs adds value to the "time_spent_ms" field.
+func (_u *QuizAnswerUpdateOne) AddTimeSpentMs(v int) *QuizAnswerUpdateOne {
+	_u.mutation.AddTimeSpentMs(v)
+	return _u
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (_u *QuizAnswerUpdateOne) SetAttempt(v *QuizAttempt) *QuizAnswerUpdateOne {
+	return _u.SetAttemptID(v.ID)
+}
+
+// SetFlashcard sets the "flashcard" edge to the Flashcard entity.
+func (_u *QuizAnswerUpdateOne) SetFlashcard(v *Flashcard) *QuizAnswerUpdateOne {
+	return _u.SetFlashcardID(v.ID)
+}
+
+// Mutation returns the QuizAnswerMutation object of the builder.
+func (_u *QuizAnswerUpdateOne) Mutation() *QuizAnswerMutation {
+	return _u.mutation
+}
+
+// ClearAttempt clears the "attempt" edge to the QuizAttempt entity.
+func (_u *QuizAnswerUpdateOne) ClearAttempt() *QuizAnswerUpdateOne {
+	_u.mutation.ClearAttempt()
+	return _u
+}
+
+// ClearFlashcard clears the "flashcard" edge to the Flashcard entity.
+func (_u *QuizAnswerUpdateOne) ClearFlashcard() *QuizAnswerUpdateOne {
+	_u.mutation.ClearFlashcard()
+	return _u
+}
+
+// Where appends a list predicates to the QuizAnswerUpdate builder.
+func (_u *QuizAnswerUpdateOne) Where(ps ...predicate.QuizAnswer) *QuizAnswerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuizAnswerUpdateOne) Select(field string, fields ...string) *QuizAnswerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuizAnswer entity.
+func (_u *QuizAnswerUpdateOne) Save(ctx context.Context) (*QuizAnswer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizAnswerUpdateOne) SaveX(ctx context.Context) *QuizAnswer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuizAnswerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizAnswerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizAnswerUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := quizanswer.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "QuizAnswer.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeSpentMs(); ok {
+		if err := quizanswer.TimeSpentMsValidator(v); err != nil {
+			return &ValidationError{Name: "time_spent_ms", err: fmt.Errorf(`ent: validator failed for field "QuizAnswer.time_spent_ms": %w`, err)}
+		}
+	}
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAnswer.attempt"`)
+	}
+	if _u.mutation.FlashcardCleared() && len(_u.mutation.FlashcardIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAnswer.flashcard"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizAnswerUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizAnswerUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizAnswerUpdateOne) sqlSave(ctx context.Context) (_node *QuizAnswer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizanswer.Table, quizanswer.Columns, sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuizAnswer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizanswer.FieldID)
+		for _, f := range fields {
+			if !quizanswer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quizanswer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(quizanswer.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(quizanswer.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(quizanswer.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizanswer.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(quizanswer.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Response(); ok {
+		_spec.SetField(quizanswer.FieldResponse, field.TypeJSON, value)
+	}
+	if _u.mutation.ResponseCleared() {
+		_spec.ClearField(quizanswer.FieldResponse, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AnsweredAt(); ok {
+		_spec.SetField(quizanswer.FieldAnsweredAt, field.TypeTime, value)
+	}
+	if _u.mutation.AnsweredAtCleared() {
+		_spec.ClearField(quizanswer.FieldAnsweredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Correct(); ok {
+		_spec.SetField(quizanswer.FieldCorrect, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(quizanswer.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(quizanswer.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TimeSpentMs(); ok {
+		_spec.SetField(quizanswer.FieldTimeSpentMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeSpentMs(); ok {
+		_spec.AddField(quizanswer.FieldTimeSpentMs, field.TypeInt, value)
+	}
+	if _u.mutation.AttemptCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.AttemptTable,
+			Columns: []string{quizanswer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.AttemptTable,
+			Columns: []string{quizanswer.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FlashcardCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.FlashcardTable,
+			Columns: []string{quizanswer.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlashcardIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizanswer.FlashcardTable,
+			Columns: []string{quizanswer.FlashcardColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcard.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &QuizAnswer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizanswer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
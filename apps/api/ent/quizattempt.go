@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+)
+
+// QuizAttempt is the model entity for the QuizAttempt schema.
+type QuizAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// QuizID holds the value of the "quiz_id" field.
+	QuizID uuid.UUID `json:"quiz_id,omitempty"`
+	// Clerk user ID
+	UserID string `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status quizattempt.Status `json:"status,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Set from the time limit of the quiz when the attempt starts
+	DeadlineAt *time.Time `json:"deadline_at,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// Submitted by the server when the deadline passed
+	AutoSubmitted bool `json:"auto_submitted,omitempty"`
+	// Sum of the question scores, with partial credit
+	Score float64 `json:"score,omitempty"`
+	// Number of questions
+	MaxScore float64 `json:"max_score,omitempty"`
+	// Ability estimate after the last answer of an adaptive attempt
+	Ability *float64 `json:"ability,omitempty"`
+	// Standard error of the ability estimate; adaptive attempts stop when it is small enough
+	AbilityError *float64 `json:"ability_error,omitempty"`
+	// From the start to the submission of the attempt
+	TimeSpentSeconds int `json:"time_spent_seconds,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuizAttemptQuery when eager-loading is set.
+	Edges        QuizAttemptEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuizAttemptEdges holds the relations/edges for other nodes in the graph.
+type QuizAttemptEdges struct {
+	// Quiz holds the value of the quiz edge.
+	Quiz *Quiz `json:"quiz,omitempty"`
+	// Answers holds the value of the answers edge.
+	Answers []*QuizAnswer `json:"answers,omitempty"`
+	// ReviewUpdates holds the value of the review_updates edge.
+	ReviewUpdates []*QuizReviewUpdate `json:"review_updates,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// QuizOrErr returns the Quiz value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizAttemptEdges) QuizOrErr() (*Quiz, error) {
+	if e.Quiz != nil {
+		return e.Quiz, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: quiz.Label}
+	}
+	return nil, &NotLoadedError{edge: "quiz"}
+}
+
+// AnswersOrErr returns the Answers value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizAttemptEdges) AnswersOrErr() ([]*QuizAnswer, error) {
+	if e.loadedTypes[1] {
+		return e.Answers, nil
+	}
+	return nil, &NotLoadedError{edge: "answers"}
+}
+
+// ReviewUpdatesOrErr returns the ReviewUpdates value or an error if the edge
+// was not loaded in eager-loading.
+func (e QuizAttemptEdges) ReviewUpdatesOrErr() ([]*QuizReviewUpdate, error) {
+	if e.loadedTypes[2] {
+		return e.ReviewUpdates, nil
+	}
+	return nil, &NotLoadedError{edge: "review_updates"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuizAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quizattempt.FieldAutoSubmitted:
+			values[i] = new(sql.NullBool)
+		case quizattempt.FieldScore, quizattempt.FieldMaxScore, quizattempt.FieldAbility, quizattempt.FieldAbilityError:
+			values[i] = new(sql.NullFloat64)
+		case quizattempt.FieldTimeSpentSeconds:
+			values[i] = new(sql.NullInt64)
+		case quizattempt.FieldUserID, quizattempt.FieldStatus:
+			values[i] = new(sql.NullString)
+		case quizattempt.FieldStartedAt, quizattempt.FieldDeadlineAt, quizattempt.FieldSubmittedAt:
+			values[i] = new(sql.NullTime)
+		case quizattempt.FieldID, quizattempt.FieldQuizID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuizAttempt fields.
+func (_m *QuizAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quizattempt.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case quizattempt.FieldQuizID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field quiz_id", values[i])
+			} else if value != nil {
+				_m.QuizID = *value
+			}
+		case quizattempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case quizattempt.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = quizattempt.Status(value.String)
+			}
+		case quizattempt.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case quizattempt.FieldDeadlineAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deadline_at", values[i])
+			} else if value.Valid {
+				_m.DeadlineAt = new(time.Time)
+				*_m.DeadlineAt = value.Time
+			}
+		case quizattempt.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = new(time.Time)
+				*_m.SubmittedAt = value.Time
+			}
+		case quizattempt.FieldAutoSubmitted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_submitted", values[i])
+			} else if value.Valid {
+				_m.AutoSubmitted = value.Bool
+			}
+		case quizattempt.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				_m.Score = value.Float64
+			}
+		case quizattempt.FieldMaxScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_score", values[i])
+			} else if value.Valid {
+				_m.MaxScore = value.Float64
+			}
+		case quizattempt.FieldAbility:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ability", values[i])
+			} else if value.Valid {
+				_m.Ability = new(float64)
+				*_m.Ability = value.Float64
+			}
+		case quizattempt.FieldAbilityError:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ability_error", values[i])
+			} else if value.Valid {
+				_m.AbilityError = new(float64)
+				*_m.AbilityError = value.Float64
+			}
+		case quizattempt.FieldTimeSpentSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_spent_seconds", values[i])
+			} else if value.Valid {
+				_m.TimeSpentSeconds = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuizAttempt.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuizAttempt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryQuiz queries the "quiz" edge of the QuizAttempt entity.
+func (_m *QuizAttempt) QueryQuiz() *QuizQuery {
+	return NewQuizAttemptClient(_m.config).QueryQuiz(_m)
+}
+
+// QueryAnswers queries the "answers" edge of the QuizAttempt entity.
+func (_m *QuizAttempt) QueryAnswers() *QuizAnswerQuery {
+	return NewQuizAttemptClient(_m.config).QueryAnswers(_m)
+}
+
+// QueryReviewUpdates queries the "review_updates" edge of the QuizAttempt entity.
+func (_m *QuizAttempt) QueryReviewUpdates() *QuizReviewUpdateQuery {
+	return NewQuizAttemptClient(_m.config).QueryReviewUpdates(_m)
+}
+
+// Update returns a builder for updating this QuizAttempt.
+// Note that you need to call QuizAttempt.Unwrap() before calling this method if this QuizAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuizAttempt) Update() *QuizAttemptUpdateOne {
+	return NewQuizAttemptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuizAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuizAttempt) Unwrap() *QuizAttempt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuizAttempt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuizAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuizAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quiz_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuizID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeadlineAt; v != nil {
+		builder.WriteString("deadline_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_submitted=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoSubmitted))
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Score))
+	builder.WriteString(", ")
+	builder.WriteString("max_score=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxScore))
+	builder.WriteString(", ")
+	if v := _m.Ability; v != nil {
+		builder.WriteString("ability=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AbilityError; v != nil {
+		builder.WriteString("ability_error=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("time_spent_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeSpentSeconds))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuizAttempts is a parsable slice of QuizAttempt.
+type QuizAttempts []*QuizAttempt
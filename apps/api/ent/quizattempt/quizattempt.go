@@ -0,0 +1,268 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizattempt
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the quizattempt type in the database.
+	Label = "quiz_attempt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuizID holds the string denoting the quiz_id field in the database.
+	FieldQuizID = "quiz_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldDeadlineAt holds the string denoting the deadline_at field in the database.
+	FieldDeadlineAt = "deadline_at"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldAutoSubmitted holds the string denoting the auto_submitted field in the database.
+	FieldAutoSubmitted = "auto_submitted"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldMaxScore holds the string denoting the max_score field in the database.
+	FieldMaxScore = "max_score"
+	// FieldAbility holds the string denoting the ability field in the database.
+	FieldAbility = "ability"
+	// FieldAbilityError holds the string denoting the ability_error field in the database.
+	FieldAbilityError = "ability_error"
+	// FieldTimeSpentSeconds holds the string denoting the time_spent_seconds field in the database.
+	FieldTimeSpentSeconds = "time_spent_seconds"
+	// EdgeQuiz holds the string denoting the quiz edge name in mutations.
+	EdgeQuiz = "quiz"
+	// EdgeAnswers holds the string denoting the answers edge name in mutations.
+	EdgeAnswers = "answers"
+	// EdgeReviewUpdates holds the string denoting the review_updates edge name in mutations.
+	EdgeReviewUpdates = "review_updates"
+	// Table holds the table name of the quizattempt in the database.
+	Table = "quiz_attempts"
+	// QuizTable is the table that holds the quiz relation/edge.
+	QuizTable = "quiz_attempts"
+	// QuizInverseTable is the table name for the Quiz entity.
+	// It exists in this package in order to avoid circular dependency with the "quiz" package.
+	QuizInverseTable = "quizs"
+	// QuizColumn is the table column denoting the quiz relation/edge.
+	QuizColumn = "quiz_id"
+	// AnswersTable is the table that holds the answers relation/edge.
+	AnswersTable = "quiz_answers"
+	// AnswersInverseTable is the table name for the QuizAnswer entity.
+	// It exists in this package in order to avoid circular dependency with the "quizanswer" package.
+	AnswersInverseTable = "quiz_answers"
+	// AnswersColumn is the table column denoting the answers relation/edge.
+	AnswersColumn = "attempt_id"
+	// ReviewUpdatesTable is the table that holds the review_updates relation/edge.
+	ReviewUpdatesTable = "quiz_review_updates"
+	// ReviewUpdatesInverseTable is the table name for the QuizReviewUpdate entity.
+	// It exists in this package in order to avoid circular dependency with the "quizreviewupdate" package.
+	ReviewUpdatesInverseTable = "quiz_review_updates"
+	// ReviewUpdatesColumn is the table column denoting the review_updates relation/edge.
+	ReviewUpdatesColumn = "attempt_id"
+)
+
+// Columns holds all SQL columns for quizattempt fields.
+var Columns = []string{
+	FieldID,
+	FieldQuizID,
+	FieldUserID,
+	FieldStatus,
+	FieldStartedAt,
+	FieldDeadlineAt,
+	FieldSubmittedAt,
+	FieldAutoSubmitted,
+	FieldScore,
+	FieldMaxScore,
+	FieldAbility,
+	FieldAbilityError,
+	FieldTimeSpentSeconds,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	UserIDValidator func(string) error
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultAutoSubmitted holds the default value on creation for the "auto_submitted" field.
+	DefaultAutoSubmitted bool
+	// DefaultScore holds the default value on creation for the "score" field.
+	DefaultScore float64
+	// DefaultMaxScore holds the default value on creation for the "max_score" field.
+	DefaultMaxScore float64
+	// DefaultTimeSpentSeconds holds the default value on creation for the "time_spent_seconds" field.
+	DefaultTimeSpentSeconds int
+	// TimeSpentSecondsValidator is a validator for the "time_spent_seconds" field. It is called by the builders before save.
+	TimeSpentSecondsValidator func(int) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusInProgress is the default value of the Status enum.
+const DefaultStatus = StatusInProgress
+
+// Status values.
+const (
+	StatusInProgress Status = "in_progress"
+	StatusSubmitted  Status = "submitted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusInProgress, StatusSubmitted:
+		return nil
+	default:
+		return fmt.Errorf("quizattempt: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the QuizAttempt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuizID orders the results by the quiz_id field.
+func ByQuizID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuizID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByDeadlineAt orders the results by the deadline_at field.
+func ByDeadlineAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeadlineAt, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByAutoSubmitted orders the results by the auto_submitted field.
+func ByAutoSubmitted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoSubmitted, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByMaxScore orders the results by the max_score field.
+func ByMaxScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxScore, opts...).ToFunc()
+}
+
+// ByAbility orders the results by the ability field.
+func ByAbility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbility, opts...).ToFunc()
+}
+
+// ByAbilityError orders the results by the ability_error field.
+func ByAbilityError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbilityError, opts...).ToFunc()
+}
+
+// ByTimeSpentSeconds orders the results by the time_spent_seconds field.
+func ByTimeSpentSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeSpentSeconds, opts...).ToFunc()
+}
+
+// ByQuizField orders the results by quiz field.
+func ByQuizField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newQuizStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByAnswersCount orders the results by answers count.
+func ByAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAnswersStep(), opts...)
+	}
+}
+
+// ByAnswers orders the results by answers terms.
+func ByAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewUpdatesCount orders the results by review_updates count.
+func ByReviewUpdatesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewUpdatesStep(), opts...)
+	}
+}
+
+// ByReviewUpdates orders the results by review_updates terms.
+func ByReviewUpdates(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewUpdatesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newQuizStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(QuizInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+	)
+}
+func newAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+	)
+}
+func newReviewUpdatesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewUpdatesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewUpdatesTable, ReviewUpdatesColumn),
+	)
+}
@@ -0,0 +1,671 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizattempt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldID, id))
+}
+
+// QuizID applies equality check predicate on the "quiz_id" field. It's identical to QuizIDEQ.
+func QuizID(v uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldQuizID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// DeadlineAt applies equality check predicate on the "deadline_at" field. It's identical to DeadlineAtEQ.
+func DeadlineAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldDeadlineAt, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// AutoSubmitted applies equality check predicate on the "auto_submitted" field. It's identical to AutoSubmittedEQ.
+func AutoSubmitted(v bool) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAutoSubmitted, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// MaxScore applies equality check predicate on the "max_score" field. It's identical to MaxScoreEQ.
+func MaxScore(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// Ability applies equality check predicate on the "ability" field. It's identical to AbilityEQ.
+func Ability(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAbility, v))
+}
+
+// AbilityError applies equality check predicate on the "ability_error" field. It's identical to AbilityErrorEQ.
+func AbilityError(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAbilityError, v))
+}
+
+// TimeSpentSeconds applies equality check predicate on the "time_spent_seconds" field. It's identical to TimeSpentSecondsEQ.
+func TimeSpentSeconds(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldTimeSpentSeconds, v))
+}
+
+// QuizIDEQ applies the EQ predicate on the "quiz_id" field.
+func QuizIDEQ(v uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldQuizID, v))
+}
+
+// QuizIDNEQ applies the NEQ predicate on the "quiz_id" field.
+func QuizIDNEQ(v uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldQuizID, v))
+}
+
+// QuizIDIn applies the In predicate on the "quiz_id" field.
+func QuizIDIn(vs ...uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldQuizID, vs...))
+}
+
+// QuizIDNotIn applies the NotIn predicate on the "quiz_id" field.
+func QuizIDNotIn(vs ...uuid.UUID) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldQuizID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// DeadlineAtEQ applies the EQ predicate on the "deadline_at" field.
+func DeadlineAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldDeadlineAt, v))
+}
+
+// DeadlineAtNEQ applies the NEQ predicate on the "deadline_at" field.
+func DeadlineAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldDeadlineAt, v))
+}
+
+// DeadlineAtIn applies the In predicate on the "deadline_at" field.
+func DeadlineAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldDeadlineAt, vs...))
+}
+
+// DeadlineAtNotIn applies the NotIn predicate on the "deadline_at" field.
+func DeadlineAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldDeadlineAt, vs...))
+}
+
+// DeadlineAtGT applies the GT predicate on the "deadline_at" field.
+func DeadlineAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldDeadlineAt, v))
+}
+
+// DeadlineAtGTE applies the GTE predicate on the "deadline_at" field.
+func DeadlineAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldDeadlineAt, v))
+}
+
+// DeadlineAtLT applies the LT predicate on the "deadline_at" field.
+func DeadlineAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldDeadlineAt, v))
+}
+
+// DeadlineAtLTE applies the LTE predicate on the "deadline_at" field.
+func DeadlineAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldDeadlineAt, v))
+}
+
+// DeadlineAtIsNil applies the IsNil predicate on the "deadline_at" field.
+func DeadlineAtIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldDeadlineAt))
+}
+
+// DeadlineAtNotNil applies the NotNil predicate on the "deadline_at" field.
+func DeadlineAtNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldDeadlineAt))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// AutoSubmittedEQ applies the EQ predicate on the "auto_submitted" field.
+func AutoSubmittedEQ(v bool) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAutoSubmitted, v))
+}
+
+// AutoSubmittedNEQ applies the NEQ predicate on the "auto_submitted" field.
+func AutoSubmittedNEQ(v bool) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldAutoSubmitted, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldScore, v))
+}
+
+// MaxScoreEQ applies the EQ predicate on the "max_score" field.
+func MaxScoreEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldMaxScore, v))
+}
+
+// MaxScoreNEQ applies the NEQ predicate on the "max_score" field.
+func MaxScoreNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldMaxScore, v))
+}
+
+// MaxScoreIn applies the In predicate on the "max_score" field.
+func MaxScoreIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreNotIn applies the NotIn predicate on the "max_score" field.
+func MaxScoreNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldMaxScore, vs...))
+}
+
+// MaxScoreGT applies the GT predicate on the "max_score" field.
+func MaxScoreGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldMaxScore, v))
+}
+
+// MaxScoreGTE applies the GTE predicate on the "max_score" field.
+func MaxScoreGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldMaxScore, v))
+}
+
+// MaxScoreLT applies the LT predicate on the "max_score" field.
+func MaxScoreLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldMaxScore, v))
+}
+
+// MaxScoreLTE applies the LTE predicate on the "max_score" field.
+func MaxScoreLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldMaxScore, v))
+}
+
+// AbilityEQ applies the EQ predicate on the "ability" field.
+func AbilityEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAbility, v))
+}
+
+// AbilityNEQ applies the NEQ predicate on the "ability" field.
+func AbilityNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldAbility, v))
+}
+
+// AbilityIn applies the In predicate on the "ability" field.
+func AbilityIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldAbility, vs...))
+}
+
+// AbilityNotIn applies the NotIn predicate on the "ability" field.
+func AbilityNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldAbility, vs...))
+}
+
+// AbilityGT applies the GT predicate on the "ability" field.
+func AbilityGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldAbility, v))
+}
+
+// AbilityGTE applies the GTE predicate on the "ability" field.
+func AbilityGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldAbility, v))
+}
+
+// AbilityLT applies the LT predicate on the "ability" field.
+func AbilityLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldAbility, v))
+}
+
+// AbilityLTE applies the LTE predicate on the "ability" field.
+func AbilityLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldAbility, v))
+}
+
+// AbilityIsNil applies the IsNil predicate on the "ability" field.
+func AbilityIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldAbility))
+}
+
+// AbilityNotNil applies the NotNil predicate on the "ability" field.
+func AbilityNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldAbility))
+}
+
+// AbilityErrorEQ applies the EQ predicate on the "ability_error" field.
+func AbilityErrorEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldAbilityError, v))
+}
+
+// AbilityErrorNEQ applies the NEQ predicate on the "ability_error" field.
+func AbilityErrorNEQ(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldAbilityError, v))
+}
+
+// AbilityErrorIn applies the In predicate on the "ability_error" field.
+func AbilityErrorIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldAbilityError, vs...))
+}
+
+// AbilityErrorNotIn applies the NotIn predicate on the "ability_error" field.
+func AbilityErrorNotIn(vs ...float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldAbilityError, vs...))
+}
+
+// AbilityErrorGT applies the GT predicate on the "ability_error" field.
+func AbilityErrorGT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldAbilityError, v))
+}
+
+// AbilityErrorGTE applies the GTE predicate on the "ability_error" field.
+func AbilityErrorGTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldAbilityError, v))
+}
+
+// AbilityErrorLT applies the LT predicate on the "ability_error" field.
+func AbilityErrorLT(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldAbilityError, v))
+}
+
+// AbilityErrorLTE applies the LTE predicate on the "ability_error" field.
+func AbilityErrorLTE(v float64) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldAbilityError, v))
+}
+
+// AbilityErrorIsNil applies the IsNil predicate on the "ability_error" field.
+func AbilityErrorIsNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIsNull(FieldAbilityError))
+}
+
+// AbilityErrorNotNil applies the NotNil predicate on the "ability_error" field.
+func AbilityErrorNotNil() predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotNull(FieldAbilityError))
+}
+
+// TimeSpentSecondsEQ applies the EQ predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldEQ(FieldTimeSpentSeconds, v))
+}
+
+// TimeSpentSecondsNEQ applies the NEQ predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsNEQ(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNEQ(FieldTimeSpentSeconds, v))
+}
+
+// TimeSpentSecondsIn applies the In predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldIn(FieldTimeSpentSeconds, vs...))
+}
+
+// TimeSpentSecondsNotIn applies the NotIn predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsNotIn(vs ...int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldNotIn(FieldTimeSpentSeconds, vs...))
+}
+
+// TimeSpentSecondsGT applies the GT predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsGT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGT(FieldTimeSpentSeconds, v))
+}
+
+// TimeSpentSecondsGTE applies the GTE predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsGTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldGTE(FieldTimeSpentSeconds, v))
+}
+
+// TimeSpentSecondsLT applies the LT predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsLT(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLT(FieldTimeSpentSeconds, v))
+}
+
+// TimeSpentSecondsLTE applies the LTE predicate on the "time_spent_seconds" field.
+func TimeSpentSecondsLTE(v int) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.FieldLTE(FieldTimeSpentSeconds, v))
+}
+
+// HasQuiz applies the HasEdge predicate on the "quiz" edge.
+func HasQuiz() predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, QuizTable, QuizColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizWith applies the HasEdge predicate on the "quiz" edge with a given conditions (other predicates).
+func HasQuizWith(preds ...predicate.Quiz) predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := newQuizStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAnswers applies the HasEdge predicate on the "answers" edge.
+func HasAnswers() predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AnswersTable, AnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAnswersWith applies the HasEdge predicate on the "answers" edge with a given conditions (other predicates).
+func HasAnswersWith(preds ...predicate.QuizAnswer) predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := newAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviewUpdates applies the HasEdge predicate on the "review_updates" edge.
+func HasReviewUpdates() predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewUpdatesTable, ReviewUpdatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewUpdatesWith applies the HasEdge predicate on the "review_updates" edge with a given conditions (other predicates).
+func HasReviewUpdatesWith(preds ...predicate.QuizReviewUpdate) predicate.QuizAttempt {
+	return predicate.QuizAttempt(func(s *sql.Selector) {
+		step := newReviewUpdatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuizAttempt) predicate.QuizAttempt {
+	return predicate.QuizAttempt(sql.NotPredicates(p))
+}
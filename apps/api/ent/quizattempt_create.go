@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizAttemptCreate is the builder for creating a QuizAttempt entity.
+type QuizAttemptCreate struct {
+	config
+	mutation *QuizAttemptMutation
+	hooks    []Hook
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (_c *QuizAttemptCreate) SetQuizID(v uuid.UUID) *QuizAttemptCreate {
+	_c.mutation.SetQuizID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *QuizAttemptCreate) SetUserID(v string) *QuizAttemptCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *QuizAttemptCreate) SetStatus(v quizattempt.Status) *QuizAttemptCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableStatus(v *quizattempt.Status) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *QuizAttemptCreate) SetStartedAt(v time.Time) *QuizAttemptCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableStartedAt(v *time.Time) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetDeadlineAt sets the "deadline_at" field.
+func (_c *QuizAttemptCreate) SetDeadlineAt(v time.Time) *QuizAttemptCreate {
+	_c.mutation.SetDeadlineAt(v)
+	return _c
+}
+
+// SetNillableDeadlineAt sets the "deadline_at" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableDeadlineAt(v *time.Time) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetDeadlineAt(*v)
+	}
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *QuizAttemptCreate) SetSubmittedAt(v time.Time) *QuizAttemptCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableSubmittedAt(v *time.Time) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (_c *QuizAttemptCreate) SetAutoSubmitted(v bool) *QuizAttemptCreate {
+	_c.mutation.SetAutoSubmitted(v)
+	return _c
+}
+
+// SetNillableAutoSubmitted sets the "auto_submitted" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableAutoSubmitted(v *bool) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetAutoSubmitted(*v)
+	}
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *QuizAttemptCreate) SetScore(v float64) *QuizAttemptCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableScore(v *float64) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetMaxScore sets the "max_score" field.
+func (_c *QuizAttemptCreate) SetMaxScore(v float64) *QuizAttemptCreate {
+	_c.mutation.SetMaxScore(v)
+	return _c
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableMaxScore(v *float64) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetMaxScore(*v)
+	}
+	return _c
+}
+
+// SetAbility sets the "ability" field.
+func (_c *QuizAttemptCreate) SetAbility(v float64) *QuizAttemptCreate {
+	_c.mutation.SetAbility(v)
+	return _c
+}
+
+// SetNillableAbility sets the "ability" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableAbility(v *float64) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetAbility(*v)
+	}
+	return _c
+}
+
+// SetAbilityError sets the "ability_error" field.
+func (_c *QuizAttemptCreate) SetAbilityError(v float64) *QuizAttemptCreate {
+	_c.mutation.SetAbilityError(v)
+	return _c
+}
+
+// SetNillableAbilityError sets the "ability_error" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableAbilityError(v *float64) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetAbilityError(*v)
+	}
+	return _c
+}
+
+// SetTimeSpentSeconds sets the "time_spent_seconds" field.
+func (_c *QuizAttemptCreate) SetTimeSpentSeconds(v int) *QuizAttemptCreate {
+	_c.mutation.SetTimeSpentSeconds(v)
+	return _c
+}
+
+// SetNillableTimeSpentSeconds sets the "time_spent_seconds" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableTimeSpentSeconds(v *int) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetTimeSpentSeconds(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuizAttemptCreate) SetID(v uuid.UUID) *QuizAttemptCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuizAttemptCreate) SetNillableID(v *uuid.UUID) *QuizAttemptCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (_c *QuizAttemptCreate) SetQuiz(v *Quiz) *QuizAttemptCreate {
+	return _c.SetQuizID(v.ID)
+}
+
+// AddAnswerIDs adds the "answers" edge to the QuizAnswer entity by IDs.
+func (_c *QuizAttemptCreate) AddAnswerIDs(ids ...uuid.UUID) *QuizAttemptCreate {
+	_c.mutation.AddAnswerIDs(ids...)
+	return _c
+}
+
+// AddAnswers adds the "answers" edges to the QuizAnswer entity.
+func (_c *QuizAttemptCreate) AddAnswers(v ...*QuizAnswer) *QuizAttemptCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAnswerIDs(ids...)
+}
+
+// AddReviewUpdateIDs adds the "review_updates" edge to the QuizReviewUpdate entity by IDs.
+func (_c *QuizAttemptCreate) AddReviewUpdateIDs(ids ...uuid.UUID) *QuizAttemptCreate {
+	_c.mutation.AddReviewUpdateIDs(ids...)
+	return _c
+}
+
+// AddReviewUpdates adds the "review_updates" edges to the QuizReviewUpdate entity.
+func (_c *QuizAttemptCreate) AddReviewUpdates(v ...*QuizReviewUpdate) *QuizAttemptCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewUpdateIDs(ids...)
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (_c *QuizAttemptCreate) Mutation() *QuizAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the QuizAttempt in the database.
+func (_c *QuizAttemptCreate) Save(ctx context.Context) (*QuizAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuizAttemptCreate) SaveX(ctx context.Context) *QuizAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuizAttemptCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := quizattempt.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := quizattempt.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.AutoSubmitted(); !ok {
+		v := quizattempt.DefaultAutoSubmitted
+		_c.mutation.SetAutoSubmitted(v)
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		v := quizattempt.DefaultScore
+		_c.mutation.SetScore(v)
+	}
+	if _, ok := _c.mutation.MaxScore(); !ok {
+		v := quizattempt.DefaultMaxScore
+		_c.mutation.SetMaxScore(v)
+	}
+	if _, ok := _c.mutation.TimeSpentSeconds(); !ok {
+		v := quizattempt.DefaultTimeSpentSeconds
+		_c.mutation.SetTimeSpentSeconds(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := quizattempt.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuizAttemptCreate) check() error {
+	if _, ok := _c.mutation.QuizID(); !ok {
+		return &ValidationError{Name: "quiz_id", err: errors.New(`ent: missing required field "QuizAttempt.quiz_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "QuizAttempt.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := quizattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "QuizAttempt.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := quizattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "QuizAttempt.started_at"`)}
+	}
+	if _, ok := _c.mutation.AutoSubmitted(); !ok {
+		return &ValidationError{Name: "auto_submitted", err: errors.New(`ent: missing required field "QuizAttempt.auto_submitted"`)}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "QuizAttempt.score"`)}
+	}
+	if _, ok := _c.mutation.MaxScore(); !ok {
+		return &ValidationError{Name: "max_score", err: errors.New(`ent: missing required field "QuizAttempt.max_score"`)}
+	}
+	if _, ok := _c.mutation.TimeSpentSeconds(); !ok {
+		return &ValidationError{Name: "time_spent_seconds", err: errors.New(`ent: missing required field "QuizAttempt.time_spent_seconds"`)}
+	}
+	if v, ok := _c.mutation.TimeSpentSeconds(); ok {
+		if err := quizattempt.TimeSpentSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "time_spent_seconds", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.time_spent_seconds": %w`, err)}
+		}
+	}
+	if len(_c.mutation.QuizIDs()) == 0 {
+		return &ValidationError{Name: "quiz", err: errors.New(`ent: missing required edge "QuizAttempt.quiz"`)}
+	}
+	return nil
+}
+
+func (_c *QuizAttemptCreate) sqlSave(ctx context.Context) (*QuizAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuizAttemptCreate) createSpec() (*QuizAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuizAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(quizattempt.Table, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(quizattempt.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(quizattempt.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.DeadlineAt(); ok {
+		_spec.SetField(quizattempt.FieldDeadlineAt, field.TypeTime, value)
+		_node.DeadlineAt = &value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := _c.mutation.AutoSubmitted(); ok {
+		_spec.SetField(quizattempt.FieldAutoSubmitted, field.TypeBool, value)
+		_node.AutoSubmitted = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+		_node.MaxScore = value
+	}
+	if value, ok := _c.mutation.Ability(); ok {
+		_spec.SetField(quizattempt.FieldAbility, field.TypeFloat64, value)
+		_node.Ability = &value
+	}
+	if value, ok := _c.mutation.AbilityError(); ok {
+		_spec.SetField(quizattempt.FieldAbilityError, field.TypeFloat64, value)
+		_node.AbilityError = &value
+	}
+	if value, ok := _c.mutation.TimeSpentSeconds(); ok {
+		_spec.SetField(quizattempt.FieldTimeSpentSeconds, field.TypeInt, value)
+		_node.TimeSpentSeconds = value
+	}
+	if nodes := _c.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.QuizID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewUpdatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuizAttemptCreateBulk is the builder for creating many QuizAttempt entities in bulk.
+type QuizAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*QuizAttemptCreate
+}
+
+// Save creates the QuizAttempt entities in the database.
+func (_c *QuizAttemptCreateBulk) Save(ctx context.Context) ([]*QuizAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuizAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuizAttemptCreateBulk) SaveX(ctx context.Context) []*QuizAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizAttemptUpdate is the builder for updating QuizAttempt entities.
+type QuizAttemptUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuizAttemptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuizAttemptUpdate builder.
+func (_u *QuizAttemptUpdate) Where(ps ...predicate.QuizAttempt) *QuizAttemptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (_u *QuizAttemptUpdate) SetQuizID(v uuid.UUID) *QuizAttemptUpdate {
+	_u.mutation.SetQuizID(v)
+	return _u
+}
+
+// SetNillableQuizID sets the "quiz_id" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableQuizID(v *uuid.UUID) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetQuizID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *QuizAttemptUpdate) SetUserID(v string) *QuizAttemptUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableUserID(v *string) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *QuizAttemptUpdate) SetStatus(v quizattempt.Status) *QuizAttemptUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableStatus(v *quizattempt.Status) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *QuizAttemptUpdate) SetSubmittedAt(v time.Time) *QuizAttemptUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableSubmittedAt(v *time.Time) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *QuizAttemptUpdate) ClearSubmittedAt() *QuizAttemptUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (_u *QuizAttemptUpdate) SetAutoSubmitted(v bool) *QuizAttemptUpdate {
+	_u.mutation.SetAutoSubmitted(v)
+	return _u
+}
+
+// SetNillableAutoSubmitted sets the "auto_submitted" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableAutoSubmitted(v *bool) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetAutoSubmitted(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *QuizAttemptUpdate) SetScore(v float64) *QuizAttemptUpdate {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableScore(v *float64) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *QuizAttemptUpdate) AddScore(v float64) *QuizAttemptUpdate {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetMaxScore sets the "max_score" field.
+func (_u *QuizAttemptUpdate) SetMaxScore(v float64) *QuizAttemptUpdate {
+	_u.mutation.ResetMaxScore()
+	_u.mutation.SetMaxScore(v)
+	return _u
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableMaxScore(v *float64) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetMaxScore(*v)
+	}
+	return _u
+}
+
+// AddMaxScore adds value to the "max_score" field.
+func (_u *QuizAttemptUpdate) AddMaxScore(v float64) *QuizAttemptUpdate {
+	_u.mutation.AddMaxScore(v)
+	return _u
+}
+
+// SetAbility sets the "ability" field.
+func (_u *QuizAttemptUpdate) SetAbility(v float64) *QuizAttemptUpdate {
+	_u.mutation.ResetAbility()
+	_u.mutation.SetAbility(v)
+	return _u
+}
+
+// SetNillableAbility sets the "ability" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableAbility(v *float64) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetAbility(*v)
+	}
+	return _u
+}
+
+// AddAbility adds value to the "ability" field.
+func (_u *QuizAttemptUpdate) AddAbility(v float64) *QuizAttemptUpdate {
+	_u.mutation.AddAbility(v)
+	return _u
+}
+
+// ClearAbility clears the value of the "ability" field.
+func (_u *QuizAttemptUpdate) ClearAbility() *QuizAttemptUpdate {
+	_u.mutation.ClearAbility()
+	return _u
+}
+
+// SetAbilityError sets the "ability_error" field.
+func (_u *QuizAttemptUpdate) SetAbilityError(v float64) *QuizAttemptUpdate {
+	_u.mutation.ResetAbilityError()
+	_u.mutation.SetAbilityError(v)
+	return _u
+}
+
+// SetNillableAbilityError sets the "ability_error" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableAbilityError(v *float64) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetAbilityError(*v)
+	}
+	return _u
+}
+
+// AddAbilityError adds value to the "ability_error" field.
+func (_u *QuizAttemptUpdate) AddAbilityError(v float64) *QuizAttemptUpdate {
+	_u.mutation.AddAbilityError(v)
+	return _u
+}
+
+// ClearAbilityError clears the value of the "ability_error" field.
+func (_u *QuizAttemptUpdate) ClearAbilityError() *QuizAttemptUpdate {
+	_u.mutation.ClearAbilityError()
+	return _u
+}
+
+// SetTimeSpentSeconds sets the "time_spent_seconds" field.
+func (_u *QuizAttemptUpdate) SetTimeSpentSeconds(v int) *QuizAttemptUpdate {
+	_u.mutation.ResetTimeSpentSeconds()
+	_u.mutation.SetTimeSpentSeconds(v)
+	return _u
+}
+
+// SetNillableTimeSpentSeconds sets the "time_spent_seconds" field if the given value is not nil.
+func (_u *QuizAttemptUpdate) SetNillableTimeSpentSeconds(v *int) *QuizAttemptUpdate {
+	if v != nil {
+		_u.SetTimeSpentSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeSpentSeconds adds value to the "time_spent_seconds" field.
+func (_u *QuizAttemptUpdate) AddTimeSpentSeconds(v int) *QuizAttemptUpdate {
+	_u.mutation.AddTimeSpentSeconds(v)
+	return _u
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (_u *QuizAttemptUpdate) SetQuiz(v *Quiz) *QuizAttemptUpdate {
+	return _u.SetQuizID(v.ID)
+}
+
+// AddAnswerIDs adds the "answers" edge to the QuizAnswer entity by IDs.
+func (_u *QuizAttemptUpdate) AddAnswerIDs(ids ...uuid.UUID) *QuizAttemptUpdate {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the QuizAnswer entity.
+func (_u *QuizAttemptUpdate) AddAnswers(v ...*QuizAnswer) *QuizAttemptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
+// AddReviewUpdateIDs adds the "review_updates" edge to the QuizReviewUpdate entity by IDs.
+func (_u *QuizAttemptUpdate) AddReviewUpdateIDs(ids ...uuid.UUID) *QuizAttemptUpdate {
+	_u.mutation.AddReviewUpdateIDs(ids...)
+	return _u
+}
+
+// AddReviewUpdates adds the "review_updates" edges to the QuizReviewUpdate entity.
+func (_u *QuizAttemptUpdate) AddReviewUpdates(v ...*QuizReviewUpdate) *QuizAttemptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewUpdateIDs(ids...)
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (_u *QuizAttemptUpdate) Mutation() *QuizAttemptMutation {
+	return _u.mutation
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (_u *QuizAttemptUpdate) ClearQuiz() *QuizAttemptUpdate {
+	_u.mutation.ClearQuiz()
+	return _u
+}
+
+// ClearAnswers clears all "answers" edges to the QuizAnswer entity.
+func (_u *QuizAttemptUpdate) ClearAnswers() *QuizAttemptUpdate {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to QuizAnswer entities by IDs.
+func (_u *QuizAttemptUpdate) RemoveAnswerIDs(ids ...uuid.UUID) *QuizAttemptUpdate {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to QuizAnswer entities.
+func (_u *QuizAttemptUpdate) RemoveAnswers(v ...*QuizAnswer) *QuizAttemptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
+// ClearReviewUpdates clears all "review_updates" edges to the QuizReviewUpdate entity.
+func (_u *QuizAttemptUpdate) ClearReviewUpdates() *QuizAttemptUpdate {
+	_u.mutation.ClearReviewUpdates()
+	return _u
+}
+
+// RemoveReviewUpdateIDs removes the "review_updates" edge to QuizReviewUpdate entities by IDs.
+func (_u *QuizAttemptUpdate) RemoveReviewUpdateIDs(ids ...uuid.UUID) *QuizAttemptUpdate {
+	_u.mutation.RemoveReviewUpdateIDs(ids...)
+	return _u
+}
+
+// RemoveReviewUpdates removes "review_updates" edges to QuizReviewUpdate entities.
+func (_u *QuizAttemptUpdate) RemoveReviewUpdates(v ...*QuizReviewUpdate) *QuizAttemptUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewUpdateIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuizAttemptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizAttemptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuizAttemptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizAttemptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizAttemptUpdate) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := quizattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := quizattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeSpentSeconds(); ok {
+		if err := quizattempt.TimeSpentSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "time_spent_seconds", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.time_spent_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.QuizCleared() && len(_u.mutation.QuizIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAttempt.quiz"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizAttemptUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizAttemptUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizAttemptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(quizattempt.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.DeadlineAtCleared() {
+		_spec.ClearField(quizattempt.FieldDeadlineAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(quizattempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoSubmitted(); ok {
+		_spec.SetField(quizattempt.FieldAutoSubmitted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxScore(); ok {
+		_spec.AddField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Ability(); ok {
+		_spec.SetField(quizattempt.FieldAbility, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAbility(); ok {
+		_spec.AddField(quizattempt.FieldAbility, field.TypeFloat64, value)
+	}
+	if _u.mutation.AbilityCleared() {
+		_spec.ClearField(quizattempt.FieldAbility, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AbilityError(); ok {
+		_spec.SetField(quizattempt.FieldAbilityError, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAbilityError(); ok {
+		_spec.AddField(quizattempt.FieldAbilityError, field.TypeFloat64, value)
+	}
+	if _u.mutation.AbilityErrorCleared() {
+		_spec.ClearField(quizattempt.FieldAbilityError, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TimeSpentSeconds(); ok {
+		_spec.SetField(quizattempt.FieldTimeSpentSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeSpentSeconds(); ok {
+		_spec.AddField(quizattempt.FieldTimeSpentSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewUpdatesIDs(); len(nodes) > 0 && !_u.mutation.ReviewUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewUpdatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuizAttemptUpdateOne is the builder for updating a single QuizAttempt entity.
+type QuizAttemptUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuizAttemptMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (_u *QuizAttemptUpdateOne) SetQuizID(v uuid.UUID) *QuizAttemptUpdateOne {
+	_u.mutation.SetQuizID(v)
+	return _u
+}
+
+// SetNillableQuizID sets the "quiz_id" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableQuizID(v *uuid.UUID) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetQuizID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *QuizAttemptUpdateOne) SetUserID(v string) *QuizAttemptUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableUserID(v *string) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *QuizAttemptUpdateOne) SetStatus(v quizattempt.Status) *QuizAttemptUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableStatus(v *quizattempt.Status) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *QuizAttemptUpdateOne) SetSubmittedAt(v time.Time) *QuizAttemptUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableSubmittedAt(v *time.Time) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *QuizAttemptUpdateOne) ClearSubmittedAt() *QuizAttemptUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetAutoSubmitted sets the "auto_submitted" field.
+func (_u *QuizAttemptUpdateOne) SetAutoSubmitted(v bool) *QuizAttemptUpdateOne {
+	_u.mutation.SetAutoSubmitted(v)
+	return _u
+}
+
+// SetNillableAutoSubmitted sets the "auto_submitted" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableAutoSubmitted(v *bool) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetAutoSubmitted(*v)
+	}
+	return _u
+}
+
+// SetScore sets the "score" field.
+func (_u *QuizAttemptUpdateOne) SetScore(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.ResetScore()
+	_u.mutation.SetScore(v)
+	return _u
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableScore(v *float64) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetScore(*v)
+	}
+	return _u
+}
+
+// AddScore adds value to the "score" field.
+func (_u *QuizAttemptUpdateOne) AddScore(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.AddScore(v)
+	return _u
+}
+
+// SetMaxScore sets the "max_score" field.
+func (_u *QuizAttemptUpdateOne) SetMaxScore(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.ResetMaxScore()
+	_u.mutation.SetMaxScore(v)
+	return _u
+}
+
+// SetNillableMaxScore sets the "max_score" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableMaxScore(v *float64) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetMaxScore(*v)
+	}
+	return _u
+}
+
+// AddMaxScore adds value to the "max_score" field.
+func (_u *QuizAttemptUpdateOne) AddMaxScore(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.AddMaxScore(v)
+	return _u
+}
+
+// SetAbility sets the "ability" field.
+func (_u *QuizAttemptUpdateOne) SetAbility(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.ResetAbility()
+	_u.mutation.SetAbility(v)
+	return _u
+}
+
+// SetNillableAbility sets the "ability" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableAbility(v *float64) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetAbility(*v)
+	}
+	return _u
+}
+
+// AddAbility adds value to the "ability" field.
+func (_u *QuizAttemptUpdateOne) AddAbility(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.AddAbility(v)
+	return _u
+}
+
+// ClearAbility clears the value of the "ability" field.
+func (_u *QuizAttemptUpdateOne) ClearAbility() *QuizAttemptUpdateOne {
+	_u.mutation.ClearAbility()
+	return _u
+}
+
+// SetAbilityError sets the "ability_error" field.
+func (_u *QuizAttemptUpdateOne) SetAbilityError(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.ResetAbilityError()
+	_u.mutation.SetAbilityError(v)
+	return _u
+}
+
+// SetNillableAbilityError sets the "ability_error" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableAbilityError(v *float64) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetAbilityError(*v)
+	}
+	return _u
+}
+
+// AddAbilityError adds value to the "ability_error" field.
+func (_u *QuizAttemptUpdateOne) AddAbilityError(v float64) *QuizAttemptUpdateOne {
+	_u.mutation.AddAbilityError(v)
+	return _u
+}
+
+// ClearAbilityError clears the value of the "ability_error" field.
+func (_u *QuizAttemptUpdateOne) ClearAbilityError() *QuizAttemptUpdateOne {
+	_u.mutation.ClearAbilityError()
+	return _u
+}
+
+// SetTimeSpentSeconds sets the "time_spent_seconds" field.
+func (_u *QuizAttemptUpdateOne) SetTimeSpentSeconds(v int) *QuizAttemptUpdateOne {
+	_u.mutation.ResetTimeSpentSeconds()
+	_u.mutation.SetTimeSpentSeconds(v)
+	return _u
+}
+
+// SetNillableTimeSpentSeconds sets the "time_spent_seconds" field if the given value is not nil.
+func (_u *QuizAttemptUpdateOne) SetNillableTimeSpentSeconds(v *int) *QuizAttemptUpdateOne {
+	if v != nil {
+		_u.SetTimeSpentSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeSpentSeconds adds value to the "time_spent_seconds" field.
+func (_u *QuizAttemptUpdateOne) AddTimeSpentSeconds(v int) *QuizAttemptUpdateOne {
+	_u.mutation.AddTimeSpentSeconds(v)
+	return _u
+}
+
+// SetQuiz sets the "quiz" edge to the Quiz entity.
+func (_u *QuizAttemptUpdateOne) SetQuiz(v *Quiz) *QuizAttemptUpdateOne {
+	return _u.SetQuizID(v.ID)
+}
+
+// AddAnswerIDs adds the "answers" edge to the QuizAnswer entity by IDs.
+func (_u *QuizAttemptUpdateOne) AddAnswerIDs(ids ...uuid.UUID) *QuizAttemptUpdateOne {
+	_u.mutation.AddAnswerIDs(ids...)
+	return _u
+}
+
+// AddAnswers adds the "answers" edges to the QuizAnswer entity.
+func (_u *QuizAttemptUpdateOne) AddAnswers(v ...*QuizAnswer) *QuizAttemptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAnswerIDs(ids...)
+}
+
+// AddReviewUpdateIDs adds the "review_updates" edge to the QuizReviewUpdate entity by IDs.
+func (_u *QuizAttemptUpdateOne) AddReviewUpdateIDs(ids ...uuid.UUID) *QuizAttemptUpdateOne {
+	_u.mutation.AddReviewUpdateIDs(ids...)
+	return _u
+}
+
+// AddReviewUpdates adds the "review_updates" edges to the QuizReviewUpdate entity.
+func (_u *QuizAttemptUpdateOne) AddReviewUpdates(v ...*QuizReviewUpdate) *QuizAttemptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewUpdateIDs(ids...)
+}
+
+// Mutation returns the QuizAttemptMutation object of the builder.
+func (_u *QuizAttemptUpdateOne) Mutation() *QuizAttemptMutation {
+	return _u.mutation
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (_u *QuizAttemptUpdateOne) ClearQuiz() *QuizAttemptUpdateOne {
+	_u.mutation.ClearQuiz()
+	return _u
+}
+
+// ClearAnswers clears all "answers" edges to the QuizAnswer entity.
+func (_u *QuizAttemptUpdateOne) ClearAnswers() *QuizAttemptUpdateOne {
+	_u.mutation.ClearAnswers()
+	return _u
+}
+
+// RemoveAnswerIDs removes the "answers" edge to QuizAnswer entities by IDs.
+func (_u *QuizAttemptUpdateOne) RemoveAnswerIDs(ids ...uuid.UUID) *QuizAttemptUpdateOne {
+	_u.mutation.RemoveAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveAnswers removes "answers" edges to QuizAnswer entities.
+func (_u *QuizAttemptUpdateOne) RemoveAnswers(v ...*QuizAnswer) *QuizAttemptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAnswerIDs(ids...)
+}
+
+// ClearReviewUpdates clears all "review_updates" edges to the QuizReviewUpdate entity.
+func (_u *QuizAttemptUpdateOne) ClearReviewUpdates() *QuizAttemptUpdateOne {
+	_u.mutation.ClearReviewUpdates()
+	return _u
+}
+
+// RemoveReviewUpdateIDs removes the "review_updates" edge to QuizReviewUpdate entities by IDs.
+func (_u *QuizAttemptUpdateOne) RemoveReviewUpdateIDs(ids ...uuid.UUID) *QuizAttemptUpdateOne {
+	_u.mutation.RemoveReviewUpdateIDs(ids...)
+	return _u
+}
+
+// RemoveReviewUpdates removes "review_updates" edges to QuizReviewUpdate entities.
+func (_u *QuizAttemptUpdateOne) RemoveReviewUpdates(v ...*QuizReviewUpdate) *QuizAttemptUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewUpdateIDs(ids...)
+}
+
+// Where appends a list predicates to the QuizAttemptUpdate builder.
+func (_u *QuizAttemptUpdateOne) Where(ps ...predicate.QuizAttempt) *QuizAttemptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuizAttemptUpdateOne) Select(field string, fields ...string) *QuizAttemptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuizAttempt entity.
+func (_u *QuizAttemptUpdateOne) Save(ctx context.Context) (*QuizAttempt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizAttemptUpdateOne) SaveX(ctx context.Context) *QuizAttempt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuizAttemptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizAttemptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizAttemptUpdateOne) check() error {
+	if v, ok := _u.mutation.UserID(); ok {
+		if err := quizattempt.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.user_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := quizattempt.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeSpentSeconds(); ok {
+		if err := quizattempt.TimeSpentSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "time_spent_seconds", err: fmt.Errorf(`ent: validator failed for field "QuizAttempt.time_spent_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.QuizCleared() && len(_u.mutation.QuizIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizAttempt.quiz"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizAttemptUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizAttemptUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizAttemptUpdateOne) sqlSave(ctx context.Context) (_node *QuizAttempt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizattempt.Table, quizattempt.Columns, sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuizAttempt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizattempt.FieldID)
+		for _, f := range fields {
+			if !quizattempt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quizattempt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(quizattempt.FieldUserID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(quizattempt.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.DeadlineAtCleared() {
+		_spec.ClearField(quizattempt.FieldDeadlineAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(quizattempt.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(quizattempt.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AutoSubmitted(); ok {
+		_spec.SetField(quizattempt.FieldAutoSubmitted, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Score(); ok {
+		_spec.SetField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedScore(); ok {
+		_spec.AddField(quizattempt.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxScore(); ok {
+		_spec.SetField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxScore(); ok {
+		_spec.AddField(quizattempt.FieldMaxScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Ability(); ok {
+		_spec.SetField(quizattempt.FieldAbility, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAbility(); ok {
+		_spec.AddField(quizattempt.FieldAbility, field.TypeFloat64, value)
+	}
+	if _u.mutation.AbilityCleared() {
+		_spec.ClearField(quizattempt.FieldAbility, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AbilityError(); ok {
+		_spec.SetField(quizattempt.FieldAbilityError, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAbilityError(); ok {
+		_spec.AddField(quizattempt.FieldAbilityError, field.TypeFloat64, value)
+	}
+	if _u.mutation.AbilityErrorCleared() {
+		_spec.ClearField(quizattempt.FieldAbilityError, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.TimeSpentSeconds(); ok {
+		_spec.SetField(quizattempt.FieldTimeSpentSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeSpentSeconds(); ok {
+		_spec.AddField(quizattempt.FieldTimeSpentSeconds, field.TypeInt, value)
+	}
+	if _u.mutation.QuizCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.QuizIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizattempt.QuizTable,
+			Columns: []string{quizattempt.QuizColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAnswersIDs(); len(nodes) > 0 && !_u.mutation.AnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.AnswersTable,
+			Columns: []string{quizattempt.AnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewUpdatesIDs(); len(nodes) > 0 && !_u.mutation.ReviewUpdatesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewUpdatesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   quizattempt.ReviewUpdatesTable,
+			Columns: []string{quizattempt.ReviewUpdatesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &QuizAttempt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizattempt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
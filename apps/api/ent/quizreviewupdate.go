@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizReviewUpdate is the model entity for the QuizReviewUpdate schema.
+type QuizReviewUpdate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AttemptID holds the value of the "attempt_id" field.
+	AttemptID uuid.UUID `json:"attempt_id,omitempty"`
+	// ReviewID holds the value of the "review_id" field.
+	ReviewID uuid.UUID `json:"review_id,omitempty"`
+	// Rating the answer counted as: Again for wrong answers, Hard for slow correct ones
+	Rating int `json:"rating,omitempty"`
+	// PreviousEaseFactor holds the value of the "previous_ease_factor" field.
+	PreviousEaseFactor float64 `json:"previous_ease_factor,omitempty"`
+	// PreviousInterval holds the value of the "previous_interval" field.
+	PreviousInterval int `json:"previous_interval,omitempty"`
+	// PreviousDueAt holds the value of the "previous_due_at" field.
+	PreviousDueAt time.Time `json:"previous_due_at,omitempty"`
+	// PreviousStatus holds the value of the "previous_status" field.
+	PreviousStatus quizreviewupdate.PreviousStatus `json:"previous_status,omitempty"`
+	// PreviousLearningStep holds the value of the "previous_learning_step" field.
+	PreviousLearningStep int `json:"previous_learning_step,omitempty"`
+	// The update can only be reverted while the review count is one more
+	PreviousReviewCount int `json:"previous_review_count,omitempty"`
+	// PreviousLapseCount holds the value of the "previous_lapse_count" field.
+	PreviousLapseCount int `json:"previous_lapse_count,omitempty"`
+	// PreviousLastReviewedAt holds the value of the "previous_last_reviewed_at" field.
+	PreviousLastReviewedAt *time.Time `json:"previous_last_reviewed_at,omitempty"`
+	// RevertedAt holds the value of the "reverted_at" field.
+	RevertedAt *time.Time `json:"reverted_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the QuizReviewUpdateQuery when eager-loading is set.
+	Edges        QuizReviewUpdateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// QuizReviewUpdateEdges holds the relations/edges for other nodes in the graph.
+type QuizReviewUpdateEdges struct {
+	// Attempt holds the value of the attempt edge.
+	Attempt *QuizAttempt `json:"attempt,omitempty"`
+	// Review holds the value of the review edge.
+	Review *FlashcardReview `json:"review,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// AttemptOrErr returns the Attempt value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizReviewUpdateEdges) AttemptOrErr() (*QuizAttempt, error) {
+	if e.Attempt != nil {
+		return e.Attempt, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: quizattempt.Label}
+	}
+	return nil, &NotLoadedError{edge: "attempt"}
+}
+
+// ReviewOrErr returns the Review value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizReviewUpdateEdges) ReviewOrErr() (*FlashcardReview, error) {
+	if e.Review != nil {
+		return e.Review, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: flashcardreview.Label}
+	}
+	return nil, &NotLoadedError{edge: "review"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*QuizReviewUpdate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case quizreviewupdate.FieldPreviousEaseFactor:
+			values[i] = new(sql.NullFloat64)
+		case quizreviewupdate.FieldRating, quizreviewupdate.FieldPreviousInterval, quizreviewupdate.FieldPreviousLearningStep, quizreviewupdate.FieldPreviousReviewCount, quizreviewupdate.FieldPreviousLapseCount:
+			values[i] = new(sql.NullInt64)
+		case quizreviewupdate.FieldPreviousStatus:
+			values[i] = new(sql.NullString)
+		case quizreviewupdate.FieldPreviousDueAt, quizreviewupdate.FieldPreviousLastReviewedAt, quizreviewupdate.FieldRevertedAt, quizreviewupdate.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case quizreviewupdate.FieldID, quizreviewupdate.FieldAttemptID, quizreviewupdate.FieldReviewID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the QuizReviewUpdate fields.
+func (_m *QuizReviewUpdate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case quizreviewupdate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case quizreviewupdate.FieldAttemptID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field attempt_id", values[i])
+			} else if value != nil {
+				_m.AttemptID = *value
+			}
+		case quizreviewupdate.FieldReviewID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field review_id", values[i])
+			} else if value != nil {
+				_m.ReviewID = *value
+			}
+		case quizreviewupdate.FieldRating:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rating", values[i])
+			} else if value.Valid {
+				_m.Rating = int(value.Int64)
+			}
+		case quizreviewupdate.FieldPreviousEaseFactor:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_ease_factor", values[i])
+			} else if value.Valid {
+				_m.PreviousEaseFactor = value.Float64
+			}
+		case quizreviewupdate.FieldPreviousInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_interval", values[i])
+			} else if value.Valid {
+				_m.PreviousInterval = int(value.Int64)
+			}
+		case quizreviewupdate.FieldPreviousDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_due_at", values[i])
+			} else if value.Valid {
+				_m.PreviousDueAt = value.Time
+			}
+		case quizreviewupdate.FieldPreviousStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_status", values[i])
+			} else if value.Valid {
+				_m.PreviousStatus = quizreviewupdate.PreviousStatus(value.String)
+			}
+		case quizreviewupdate.FieldPreviousLearningStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_learning_step", values[i])
+			} else if value.Valid {
+				_m.PreviousLearningStep = int(value.Int64)
+			}
+		case quizreviewupdate.FieldPreviousReviewCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_review_count", values[i])
+			} else if value.Valid {
+				_m.PreviousReviewCount = int(value.Int64)
+			}
+		case quizreviewupdate.FieldPreviousLapseCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_lapse_count", values[i])
+			} else if value.Valid {
+				_m.PreviousLapseCount = int(value.Int64)
+			}
+		case quizreviewupdate.FieldPreviousLastReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_last_reviewed_at", values[i])
+			} else if value.Valid {
+				_m.PreviousLastReviewedAt = new(time.Time)
+				*_m.PreviousLastReviewedAt = value.Time
+			}
+		case quizreviewupdate.FieldRevertedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reverted_at", values[i])
+			} else if value.Valid {
+				_m.RevertedAt = new(time.Time)
+				*_m.RevertedAt = value.Time
+			}
+		case quizreviewupdate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the QuizReviewUpdate.
+// This includes values selected through modifiers, order, etc.
+func (_m *QuizReviewUpdate) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAttempt queries the "attempt" edge of the QuizReviewUpdate entity.
+func (_m *QuizReviewUpdate) QueryAttempt() *QuizAttemptQuery {
+	return NewQuizReviewUpdateClient(_m.config).QueryAttempt(_m)
+}
+
+// QueryReview queries the "review" edge of the QuizReviewUpdate entity.
+func (_m *QuizReviewUpdate) QueryReview() *FlashcardReviewQuery {
+	return NewQuizReviewUpdateClient(_m.config).QueryReview(_m)
+}
+
+// Update returns a builder for updating this QuizReviewUpdate.
+// Note that you need to call QuizReviewUpdate.Unwrap() before calling this method if this QuizReviewUpdate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *QuizReviewUpdate) Update() *QuizReviewUpdateUpdateOne {
+	return NewQuizReviewUpdateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the QuizReviewUpdate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *QuizReviewUpdate) Unwrap() *QuizReviewUpdate {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: QuizReviewUpdate is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *QuizReviewUpdate) String() string {
+	var builder strings.Builder
+	builder.WriteString("QuizReviewUpdate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("attempt_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AttemptID))
+	builder.WriteString(", ")
+	builder.WriteString("review_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewID))
+	builder.WriteString(", ")
+	builder.WriteString("rating=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Rating))
+	builder.WriteString(", ")
+	builder.WriteString("previous_ease_factor=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousEaseFactor))
+	builder.WriteString(", ")
+	builder.WriteString("previous_interval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousInterval))
+	builder.WriteString(", ")
+	builder.WriteString("previous_due_at=")
+	builder.WriteString(_m.PreviousDueAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("previous_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousStatus))
+	builder.WriteString(", ")
+	builder.WriteString("previous_learning_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousLearningStep))
+	builder.WriteString(", ")
+	builder.WriteString("previous_review_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousReviewCount))
+	builder.WriteString(", ")
+	builder.WriteString("previous_lapse_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PreviousLapseCount))
+	builder.WriteString(", ")
+	if v := _m.PreviousLastReviewedAt; v != nil {
+		builder.WriteString("previous_last_reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RevertedAt; v != nil {
+		builder.WriteString("reverted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// QuizReviewUpdates is a parsable slice of QuizReviewUpdate.
+type QuizReviewUpdates []*QuizReviewUpdate
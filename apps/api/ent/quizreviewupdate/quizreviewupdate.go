@@ -0,0 +1,228 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizreviewupdate
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the quizreviewupdate type in the database.
+	Label = "quiz_review_update"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAttemptID holds the string denoting the attempt_id field in the database.
+	FieldAttemptID = "attempt_id"
+	// FieldReviewID holds the string denoting the review_id field in the database.
+	FieldReviewID = "review_id"
+	// FieldRating holds the string denoting the rating field in the database.
+	FieldRating = "rating"
+	// FieldPreviousEaseFactor holds the string denoting the previous_ease_factor field in the database.
+	FieldPreviousEaseFactor = "previous_ease_factor"
+	// FieldPreviousInterval holds the string denoting the previous_interval field in the database.
+	FieldPreviousInterval = "previous_interval"
+	// FieldPreviousDueAt holds the string denoting the previous_due_at field in the database.
+	FieldPreviousDueAt = "previous_due_at"
+	// FieldPreviousStatus holds the string denoting the previous_status field in the database.
+	FieldPreviousStatus = "previous_status"
+	// FieldPreviousLearningStep holds the string denoting the previous_learning_step field in the database.
+	FieldPreviousLearningStep = "previous_learning_step"
+	// FieldPreviousReviewCount holds the string denoting the previous_review_count field in the database.
+	FieldPreviousReviewCount = "previous_review_count"
+	// FieldPreviousLapseCount holds the string denoting the previous_lapse_count field in the database.
+	FieldPreviousLapseCount = "previous_lapse_count"
+	// FieldPreviousLastReviewedAt holds the string denoting the previous_last_reviewed_at field in the database.
+	FieldPreviousLastReviewedAt = "previous_last_reviewed_at"
+	// FieldRevertedAt holds the string denoting the reverted_at field in the database.
+	FieldRevertedAt = "reverted_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeAttempt holds the string denoting the attempt edge name in mutations.
+	EdgeAttempt = "attempt"
+	// EdgeReview holds the string denoting the review edge name in mutations.
+	EdgeReview = "review"
+	// Table holds the table name of the quizreviewupdate in the database.
+	Table = "quiz_review_updates"
+	// AttemptTable is the table that holds the attempt relation/edge.
+	AttemptTable = "quiz_review_updates"
+	// AttemptInverseTable is the table name for the QuizAttempt entity.
+	// It exists in this package in order to avoid circular dependency with the "quizattempt" package.
+	AttemptInverseTable = "quiz_attempts"
+	// AttemptColumn is the table column denoting the attempt relation/edge.
+	AttemptColumn = "attempt_id"
+	// ReviewTable is the table that holds the review relation/edge.
+	ReviewTable = "quiz_review_updates"
+	// ReviewInverseTable is the table name for the FlashcardReview entity.
+	// It exists in this package in order to avoid circular dependency with the "flashcardreview" package.
+	ReviewInverseTable = "flashcard_reviews"
+	// ReviewColumn is the table column denoting the review relation/edge.
+	ReviewColumn = "review_id"
+)
+
+// Columns holds all SQL columns for quizreviewupdate fields.
+var Columns = []string{
+	FieldID,
+	FieldAttemptID,
+	FieldReviewID,
+	FieldRating,
+	FieldPreviousEaseFactor,
+	FieldPreviousInterval,
+	FieldPreviousDueAt,
+	FieldPreviousStatus,
+	FieldPreviousLearningStep,
+	FieldPreviousReviewCount,
+	FieldPreviousLapseCount,
+	FieldPreviousLastReviewedAt,
+	FieldRevertedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	RatingValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// PreviousStatus defines the type for the "previous_status" enum field.
+type PreviousStatus string
+
+// PreviousStatus values.
+const (
+	PreviousStatusNew        PreviousStatus = "new"
+	PreviousStatusLearning   PreviousStatus = "learning"
+	PreviousStatusReview     PreviousStatus = "review"
+	PreviousStatusRelearning PreviousStatus = "relearning"
+)
+
+func (ps PreviousStatus) String() string {
+	return string(ps)
+}
+
+// PreviousStatusValidator is a validator for the "previous_status" field enum values. It is called by the builders before save.
+func PreviousStatusValidator(ps PreviousStatus) error {
+	switch ps {
+	case PreviousStatusNew, PreviousStatusLearning, PreviousStatusReview, PreviousStatusRelearning:
+		return nil
+	default:
+		return fmt.Errorf("quizreviewupdate: invalid enum value for previous_status field: %q", ps)
+	}
+}
+
+// OrderOption defines the ordering options for the QuizReviewUpdate queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAttemptID orders the results by the attempt_id field.
+func ByAttemptID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttemptID, opts...).ToFunc()
+}
+
+// ByReviewID orders the results by the review_id field.
+func ByReviewID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewID, opts...).ToFunc()
+}
+
+// ByRating orders the results by the rating field.
+func ByRating(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRating, opts...).ToFunc()
+}
+
+// ByPreviousEaseFactor orders the results by the previous_ease_factor field.
+func ByPreviousEaseFactor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousEaseFactor, opts...).ToFunc()
+}
+
+// ByPreviousInterval orders the results by the previous_interval field.
+func ByPreviousInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousInterval, opts...).ToFunc()
+}
+
+// ByPreviousDueAt orders the results by the previous_due_at field.
+func ByPreviousDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousDueAt, opts...).ToFunc()
+}
+
+// ByPreviousStatus orders the results by the previous_status field.
+func ByPreviousStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousStatus, opts...).ToFunc()
+}
+
+// ByPreviousLearningStep orders the results by the previous_learning_step field.
+func ByPreviousLearningStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousLearningStep, opts...).ToFunc()
+}
+
+// ByPreviousReviewCount orders the results by the previous_review_count field.
+func ByPreviousReviewCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousReviewCount, opts...).ToFunc()
+}
+
+// ByPreviousLapseCount orders the results by the previous_lapse_count field.
+func ByPreviousLapseCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousLapseCount, opts...).ToFunc()
+}
+
+// ByPreviousLastReviewedAt orders the results by the previous_last_reviewed_at field.
+func ByPreviousLastReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousLastReviewedAt, opts...).ToFunc()
+}
+
+// ByRevertedAt orders the results by the reverted_at field.
+func ByRevertedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevertedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByAttemptField orders the results by attempt field.
+func ByAttemptField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAttemptStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReviewField orders the results by review field.
+func ByReviewField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAttemptStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AttemptInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+	)
+}
+func newReviewStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReviewTable, ReviewColumn),
+	)
+}
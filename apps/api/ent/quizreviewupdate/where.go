@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package quizreviewupdate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldID, id))
+}
+
+// AttemptID applies equality check predicate on the "attempt_id" field. It's identical to AttemptIDEQ.
+func AttemptID(v uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// ReviewID applies equality check predicate on the "review_id" field. It's identical to ReviewIDEQ.
+func ReviewID(v uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldReviewID, v))
+}
+
+// Rating applies equality check predicate on the "rating" field. It's identical to RatingEQ.
+func Rating(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldRating, v))
+}
+
+// PreviousEaseFactor applies equality check predicate on the "previous_ease_factor" field. It's identical to PreviousEaseFactorEQ.
+func PreviousEaseFactor(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousEaseFactor, v))
+}
+
+// PreviousInterval applies equality check predicate on the "previous_interval" field. It's identical to PreviousIntervalEQ.
+func PreviousInterval(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousInterval, v))
+}
+
+// PreviousDueAt applies equality check predicate on the "previous_due_at" field. It's identical to PreviousDueAtEQ.
+func PreviousDueAt(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousDueAt, v))
+}
+
+// PreviousLearningStep applies equality check predicate on the "previous_learning_step" field. It's identical to PreviousLearningStepEQ.
+func PreviousLearningStep(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousLearningStep, v))
+}
+
+// PreviousReviewCount applies equality check predicate on the "previous_review_count" field. It's identical to PreviousReviewCountEQ.
+func PreviousReviewCount(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousReviewCount, v))
+}
+
+// PreviousLapseCount applies equality check predicate on the "previous_lapse_count" field. It's identical to PreviousLapseCountEQ.
+func PreviousLapseCount(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousLapseCount, v))
+}
+
+// PreviousLastReviewedAt applies equality check predicate on the "previous_last_reviewed_at" field. It's identical to PreviousLastReviewedAtEQ.
+func PreviousLastReviewedAt(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousLastReviewedAt, v))
+}
+
+// RevertedAt applies equality check predicate on the "reverted_at" field. It's identical to RevertedAtEQ.
+func RevertedAt(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldRevertedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// AttemptIDEQ applies the EQ predicate on the "attempt_id" field.
+func AttemptIDEQ(v uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldAttemptID, v))
+}
+
+// AttemptIDNEQ applies the NEQ predicate on the "attempt_id" field.
+func AttemptIDNEQ(v uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldAttemptID, v))
+}
+
+// AttemptIDIn applies the In predicate on the "attempt_id" field.
+func AttemptIDIn(vs ...uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldAttemptID, vs...))
+}
+
+// AttemptIDNotIn applies the NotIn predicate on the "attempt_id" field.
+func AttemptIDNotIn(vs ...uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldAttemptID, vs...))
+}
+
+// ReviewIDEQ applies the EQ predicate on the "review_id" field.
+func ReviewIDEQ(v uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldReviewID, v))
+}
+
+// ReviewIDNEQ applies the NEQ predicate on the "review_id" field.
+func ReviewIDNEQ(v uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldReviewID, v))
+}
+
+// ReviewIDIn applies the In predicate on the "review_id" field.
+func ReviewIDIn(vs ...uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldReviewID, vs...))
+}
+
+// ReviewIDNotIn applies the NotIn predicate on the "review_id" field.
+func ReviewIDNotIn(vs ...uuid.UUID) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldReviewID, vs...))
+}
+
+// RatingEQ applies the EQ predicate on the "rating" field.
+func RatingEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldRating, v))
+}
+
+// RatingNEQ applies the NEQ predicate on the "rating" field.
+func RatingNEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldRating, v))
+}
+
+// RatingIn applies the In predicate on the "rating" field.
+func RatingIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldRating, vs...))
+}
+
+// RatingNotIn applies the NotIn predicate on the "rating" field.
+func RatingNotIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldRating, vs...))
+}
+
+// RatingGT applies the GT predicate on the "rating" field.
+func RatingGT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldRating, v))
+}
+
+// RatingGTE applies the GTE predicate on the "rating" field.
+func RatingGTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldRating, v))
+}
+
+// RatingLT applies the LT predicate on the "rating" field.
+func RatingLT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldRating, v))
+}
+
+// RatingLTE applies the LTE predicate on the "rating" field.
+func RatingLTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldRating, v))
+}
+
+// PreviousEaseFactorEQ applies the EQ predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorEQ(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousEaseFactor, v))
+}
+
+// PreviousEaseFactorNEQ applies the NEQ predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorNEQ(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousEaseFactor, v))
+}
+
+// PreviousEaseFactorIn applies the In predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorIn(vs ...float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousEaseFactor, vs...))
+}
+
+// PreviousEaseFactorNotIn applies the NotIn predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorNotIn(vs ...float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousEaseFactor, vs...))
+}
+
+// PreviousEaseFactorGT applies the GT predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorGT(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousEaseFactor, v))
+}
+
+// PreviousEaseFactorGTE applies the GTE predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorGTE(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousEaseFactor, v))
+}
+
+// PreviousEaseFactorLT applies the LT predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorLT(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousEaseFactor, v))
+}
+
+// PreviousEaseFactorLTE applies the LTE predicate on the "previous_ease_factor" field.
+func PreviousEaseFactorLTE(v float64) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousEaseFactor, v))
+}
+
+// PreviousIntervalEQ applies the EQ predicate on the "previous_interval" field.
+func PreviousIntervalEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousInterval, v))
+}
+
+// PreviousIntervalNEQ applies the NEQ predicate on the "previous_interval" field.
+func PreviousIntervalNEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousInterval, v))
+}
+
+// PreviousIntervalIn applies the In predicate on the "previous_interval" field.
+func PreviousIntervalIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousInterval, vs...))
+}
+
+// PreviousIntervalNotIn applies the NotIn predicate on the "previous_interval" field.
+func PreviousIntervalNotIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousInterval, vs...))
+}
+
+// PreviousIntervalGT applies the GT predicate on the "previous_interval" field.
+func PreviousIntervalGT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousInterval, v))
+}
+
+// PreviousIntervalGTE applies the GTE predicate on the "previous_interval" field.
+func PreviousIntervalGTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousInterval, v))
+}
+
+// PreviousIntervalLT applies the LT predicate on the "previous_interval" field.
+func PreviousIntervalLT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousInterval, v))
+}
+
+// PreviousIntervalLTE applies the LTE predicate on the "previous_interval" field.
+func PreviousIntervalLTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousInterval, v))
+}
+
+// PreviousDueAtEQ applies the EQ predicate on the "previous_due_at" field.
+func PreviousDueAtEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousDueAt, v))
+}
+
+// PreviousDueAtNEQ applies the NEQ predicate on the "previous_due_at" field.
+func PreviousDueAtNEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousDueAt, v))
+}
+
+// PreviousDueAtIn applies the In predicate on the "previous_due_at" field.
+func PreviousDueAtIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousDueAt, vs...))
+}
+
+// PreviousDueAtNotIn applies the NotIn predicate on the "previous_due_at" field.
+func PreviousDueAtNotIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousDueAt, vs...))
+}
+
+// PreviousDueAtGT applies the GT predicate on the "previous_due_at" field.
+func PreviousDueAtGT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousDueAt, v))
+}
+
+// PreviousDueAtGTE applies the GTE predicate on the "previous_due_at" field.
+func PreviousDueAtGTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousDueAt, v))
+}
+
+// PreviousDueAtLT applies the LT predicate on the "previous_due_at" field.
+func PreviousDueAtLT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousDueAt, v))
+}
+
+// PreviousDueAtLTE applies the LTE predicate on the "previous_due_at" field.
+func PreviousDueAtLTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousDueAt, v))
+}
+
+// PreviousStatusEQ applies the EQ predicate on the "previous_status" field.
+func PreviousStatusEQ(v PreviousStatus) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousStatus, v))
+}
+
+// PreviousStatusNEQ applies the NEQ predicate on the "previous_status" field.
+func PreviousStatusNEQ(v PreviousStatus) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousStatus, v))
+}
+
+// PreviousStatusIn applies the In predicate on the "previous_status" field.
+func PreviousStatusIn(vs ...PreviousStatus) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousStatus, vs...))
+}
+
+// PreviousStatusNotIn applies the NotIn predicate on the "previous_status" field.
+func PreviousStatusNotIn(vs ...PreviousStatus) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousStatus, vs...))
+}
+
+// PreviousLearningStepEQ applies the EQ predicate on the "previous_learning_step" field.
+func PreviousLearningStepEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousLearningStep, v))
+}
+
+// PreviousLearningStepNEQ applies the NEQ predicate on the "previous_learning_step" field.
+func PreviousLearningStepNEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousLearningStep, v))
+}
+
+// PreviousLearningStepIn applies the In predicate on the "previous_learning_step" field.
+func PreviousLearningStepIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousLearningStep, vs...))
+}
+
+// PreviousLearningStepNotIn applies the NotIn predicate on the "previous_learning_step" field.
+func PreviousLearningStepNotIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousLearningStep, vs...))
+}
+
+// PreviousLearningStepGT applies the GT predicate on the "previous_learning_step" field.
+func PreviousLearningStepGT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousLearningStep, v))
+}
+
+// PreviousLearningStepGTE applies the GTE predicate on the "previous_learning_step" field.
+func PreviousLearningStepGTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousLearningStep, v))
+}
+
+// PreviousLearningStepLT applies the LT predicate on the "previous_learning_step" field.
+func PreviousLearningStepLT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousLearningStep, v))
+}
+
+// PreviousLearningStepLTE applies the LTE predicate on the "previous_learning_step" field.
+func PreviousLearningStepLTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousLearningStep, v))
+}
+
+// PreviousReviewCountEQ applies the EQ predicate on the "previous_review_count" field.
+func PreviousReviewCountEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousReviewCount, v))
+}
+
+// PreviousReviewCountNEQ applies the NEQ predicate on the "previous_review_count" field.
+func PreviousReviewCountNEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousReviewCount, v))
+}
+
+// PreviousReviewCountIn applies the In predicate on the "previous_review_count" field.
+func PreviousReviewCountIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousReviewCount, vs...))
+}
+
+// PreviousReviewCountNotIn applies the NotIn predicate on the "previous_review_count" field.
+func PreviousReviewCountNotIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousReviewCount, vs...))
+}
+
+// PreviousReviewCountGT applies the GT predicate on the "previous_review_count" field.
+func PreviousReviewCountGT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousReviewCount, v))
+}
+
+// PreviousReviewCountGTE applies the GTE predicate on the "previous_review_count" field.
+func PreviousReviewCountGTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousReviewCount, v))
+}
+
+// PreviousReviewCountLT applies the LT predicate on the "previous_review_count" field.
+func PreviousReviewCountLT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousReviewCount, v))
+}
+
+// PreviousReviewCountLTE applies the LTE predicate on the "previous_review_count" field.
+func PreviousReviewCountLTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousReviewCount, v))
+}
+
+// PreviousLapseCountEQ applies the EQ predicate on the "previous_lapse_count" field.
+func PreviousLapseCountEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousLapseCount, v))
+}
+
+// PreviousLapseCountNEQ applies the NEQ predicate on the "previous_lapse_count" field.
+func PreviousLapseCountNEQ(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousLapseCount, v))
+}
+
+// PreviousLapseCountIn applies the In predicate on the "previous_lapse_count" field.
+func PreviousLapseCountIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousLapseCount, vs...))
+}
+
+// PreviousLapseCountNotIn applies the NotIn predicate on the "previous_lapse_count" field.
+func PreviousLapseCountNotIn(vs ...int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousLapseCount, vs...))
+}
+
+// PreviousLapseCountGT applies the GT predicate on the "previous_lapse_count" field.
+func PreviousLapseCountGT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousLapseCount, v))
+}
+
+// PreviousLapseCountGTE applies the GTE predicate on the "previous_lapse_count" field.
+func PreviousLapseCountGTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousLapseCount, v))
+}
+
+// PreviousLapseCountLT applies the LT predicate on the "previous_lapse_count" field.
+func PreviousLapseCountLT(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousLapseCount, v))
+}
+
+// PreviousLapseCountLTE applies the LTE predicate on the "previous_lapse_count" field.
+func PreviousLapseCountLTE(v int) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousLapseCount, v))
+}
+
+// PreviousLastReviewedAtEQ applies the EQ predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldPreviousLastReviewedAt, v))
+}
+
+// PreviousLastReviewedAtNEQ applies the NEQ predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtNEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldPreviousLastReviewedAt, v))
+}
+
+// PreviousLastReviewedAtIn applies the In predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldPreviousLastReviewedAt, vs...))
+}
+
+// PreviousLastReviewedAtNotIn applies the NotIn predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtNotIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldPreviousLastReviewedAt, vs...))
+}
+
+// PreviousLastReviewedAtGT applies the GT predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtGT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldPreviousLastReviewedAt, v))
+}
+
+// PreviousLastReviewedAtGTE applies the GTE predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtGTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldPreviousLastReviewedAt, v))
+}
+
+// PreviousLastReviewedAtLT applies the LT predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtLT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldPreviousLastReviewedAt, v))
+}
+
+// PreviousLastReviewedAtLTE applies the LTE predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtLTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldPreviousLastReviewedAt, v))
+}
+
+// PreviousLastReviewedAtIsNil applies the IsNil predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtIsNil() predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIsNull(FieldPreviousLastReviewedAt))
+}
+
+// PreviousLastReviewedAtNotNil applies the NotNil predicate on the "previous_last_reviewed_at" field.
+func PreviousLastReviewedAtNotNil() predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotNull(FieldPreviousLastReviewedAt))
+}
+
+// RevertedAtEQ applies the EQ predicate on the "reverted_at" field.
+func RevertedAtEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldRevertedAt, v))
+}
+
+// RevertedAtNEQ applies the NEQ predicate on the "reverted_at" field.
+func RevertedAtNEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldRevertedAt, v))
+}
+
+// RevertedAtIn applies the In predicate on the "reverted_at" field.
+func RevertedAtIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldRevertedAt, vs...))
+}
+
+// RevertedAtNotIn applies the NotIn predicate on the "reverted_at" field.
+func RevertedAtNotIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldRevertedAt, vs...))
+}
+
+// RevertedAtGT applies the GT predicate on the "reverted_at" field.
+func RevertedAtGT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldRevertedAt, v))
+}
+
+// RevertedAtGTE applies the GTE predicate on the "reverted_at" field.
+func RevertedAtGTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldRevertedAt, v))
+}
+
+// RevertedAtLT applies the LT predicate on the "reverted_at" field.
+func RevertedAtLT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldRevertedAt, v))
+}
+
+// RevertedAtLTE applies the LTE predicate on the "reverted_at" field.
+func RevertedAtLTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldRevertedAt, v))
+}
+
+// RevertedAtIsNil applies the IsNil predicate on the "reverted_at" field.
+func RevertedAtIsNil() predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIsNull(FieldRevertedAt))
+}
+
+// RevertedAtNotNil applies the NotNil predicate on the "reverted_at" field.
+func RevertedAtNotNil() predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotNull(FieldRevertedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasAttempt applies the HasEdge predicate on the "attempt" edge.
+func HasAttempt() predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AttemptTable, AttemptColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAttemptWith applies the HasEdge predicate on the "attempt" edge with a given conditions (other predicates).
+func HasAttemptWith(preds ...predicate.QuizAttempt) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(func(s *sql.Selector) {
+		step := newAttemptStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReview applies the HasEdge predicate on the "review" edge.
+func HasReview() predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReviewTable, ReviewColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewWith applies the HasEdge predicate on the "review" edge with a given conditions (other predicates).
+func HasReviewWith(preds ...predicate.FlashcardReview) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(func(s *sql.Selector) {
+		step := newReviewStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.QuizReviewUpdate) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.QuizReviewUpdate) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.QuizReviewUpdate) predicate.QuizReviewUpdate {
+	return predicate.QuizReviewUpdate(sql.NotPredicates(p))
+}
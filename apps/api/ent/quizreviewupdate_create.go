@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizReviewUpdateCreate is the builder for creating a QuizReviewUpdate entity.
+type QuizReviewUpdateCreate struct {
+	config
+	mutation *QuizReviewUpdateMutation
+	hooks    []Hook
+}
+
+// SetAttemptID sets the "attempt_id" field.
+func (_c *QuizReviewUpdateCreate) SetAttemptID(v uuid.UUID) *QuizReviewUpdateCreate {
+	_c.mutation.SetAttemptID(v)
+	return _c
+}
+
+// SetReviewID sets the "review_id" field.
+func (_c *QuizReviewUpdateCreate) SetReviewID(v uuid.UUID) *QuizReviewUpdateCreate {
+	_c.mutation.SetReviewID(v)
+	return _c
+}
+
+// SetRating sets the "rating" field.
+func (_c *QuizReviewUpdateCreate) SetRating(v int) *QuizReviewUpdateCreate {
+	_c.mutation.SetRating(v)
+	return _c
+}
+
+// SetPreviousEaseFactor sets the "previous_ease_factor" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousEaseFactor(v float64) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousEaseFactor(v)
+	return _c
+}
+
+// SetPreviousInterval sets the "previous_interval" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousInterval(v int) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousInterval(v)
+	return _c
+}
+
+// SetPreviousDueAt sets the "previous_due_at" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousDueAt(v time.Time) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousDueAt(v)
+	return _c
+}
+
+// SetPreviousStatus sets the "previous_status" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousStatus(v quizreviewupdate.PreviousStatus) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousStatus(v)
+	return _c
+}
+
+// SetPreviousLearningStep sets the "previous_learning_step" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousLearningStep(v int) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousLearningStep(v)
+	return _c
+}
+
+// SetPreviousReviewCount sets the "previous_review_count" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousReviewCount(v int) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousReviewCount(v)
+	return _c
+}
+
+// SetPreviousLapseCount sets the "previous_lapse_count" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousLapseCount(v int) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousLapseCount(v)
+	return _c
+}
+
+// SetPreviousLastReviewedAt sets the "previous_last_reviewed_at" field.
+func (_c *QuizReviewUpdateCreate) SetPreviousLastReviewedAt(v time.Time) *QuizReviewUpdateCreate {
+	_c.mutation.SetPreviousLastReviewedAt(v)
+	return _c
+}
+
+// SetNillablePreviousLastReviewedAt sets the "previous_last_reviewed_at" field if the given value is not nil.
+func (_c *QuizReviewUpdateCreate) SetNillablePreviousLastReviewedAt(v *time.Time) *QuizReviewUpdateCreate {
+	if v != nil {
+		_c.SetPreviousLastReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (_c *QuizReviewUpdateCreate) SetRevertedAt(v time.Time) *QuizReviewUpdateCreate {
+	_c.mutation.SetRevertedAt(v)
+	return _c
+}
+
+// SetNillableRevertedAt sets the "reverted_at" field if the given value is not nil.
+func (_c *QuizReviewUpdateCreate) SetNillableRevertedAt(v *time.Time) *QuizReviewUpdateCreate {
+	if v != nil {
+		_c.SetRevertedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *QuizReviewUpdateCreate) SetCreatedAt(v time.Time) *QuizReviewUpdateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *QuizReviewUpdateCreate) SetNillableCreatedAt(v *time.Time) *QuizReviewUpdateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *QuizReviewUpdateCreate) SetID(v uuid.UUID) *QuizReviewUpdateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *QuizReviewUpdateCreate) SetNillableID(v *uuid.UUID) *QuizReviewUpdateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAttempt sets the "attempt" edge to the QuizAttempt entity.
+func (_c *QuizReviewUpdateCreate) SetAttempt(v *QuizAttempt) *QuizReviewUpdateCreate {
+	return _c.SetAttemptID(v.ID)
+}
+
+// SetReview sets the "review" edge to the FlashcardReview entity.
+func (_c *QuizReviewUpdateCreate) SetReview(v *FlashcardReview) *QuizReviewUpdateCreate {
+	return _c.SetReviewID(v.ID)
+}
+
+// Mutation returns the QuizReviewUpdateMutation object of the builder.
+func (_c *QuizReviewUpdateCreate) Mutation() *QuizReviewUpdateMutation {
+	return _c.mutation
+}
+
+// Save creates the QuizReviewUpdate in the database.
+func (_c *QuizReviewUpdateCreate) Save(ctx context.Context) (*QuizReviewUpdate, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *QuizReviewUpdateCreate) SaveX(ctx context.Context) *QuizReviewUpdate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizReviewUpdateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizReviewUpdateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *QuizReviewUpdateCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := quizreviewupdate.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := quizreviewupdate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *QuizReviewUpdateCreate) check() error {
+	if _, ok := _c.mutation.AttemptID(); !ok {
+		return &ValidationError{Name: "attempt_id", err: errors.New(`ent: missing required field "QuizReviewUpdate.attempt_id"`)}
+	}
+	if _, ok := _c.mutation.ReviewID(); !ok {
+		return &ValidationError{Name: "review_id", err: errors.New(`ent: missing required field "QuizReviewUpdate.review_id"`)}
+	}
+	if _, ok := _c.mutation.Rating(); !ok {
+		return &ValidationError{Name: "rating", err: errors.New(`ent: missing required field "QuizReviewUpdate.rating"`)}
+	}
+	if v, ok := _c.mutation.Rating(); ok {
+		if err := quizreviewupdate.RatingValidator(v); err != nil {
+			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "QuizReviewUpdate.rating": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviousEaseFactor(); !ok {
+		return &ValidationError{Name: "previous_ease_factor", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_ease_factor"`)}
+	}
+	if _, ok := _c.mutation.PreviousInterval(); !ok {
+		return &ValidationError{Name: "previous_interval", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_interval"`)}
+	}
+	if _, ok := _c.mutation.PreviousDueAt(); !ok {
+		return &ValidationError{Name: "previous_due_at", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_due_at"`)}
+	}
+	if _, ok := _c.mutation.PreviousStatus(); !ok {
+		return &ValidationError{Name: "previous_status", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_status"`)}
+	}
+	if v, ok := _c.mutation.PreviousStatus(); ok {
+		if err := quizreviewupdate.PreviousStatusValidator(v); err != nil {
+			return &ValidationError{Name: "previous_status", err: fmt.Errorf(`ent: validator failed for field "QuizReviewUpdate.previous_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PreviousLearningStep(); !ok {
+		return &ValidationError{Name: "previous_learning_step", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_learning_step"`)}
+	}
+	if _, ok := _c.mutation.PreviousReviewCount(); !ok {
+		return &ValidationError{Name: "previous_review_count", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_review_count"`)}
+	}
+	if _, ok := _c.mutation.PreviousLapseCount(); !ok {
+		return &ValidationError{Name: "previous_lapse_count", err: errors.New(`ent: missing required field "QuizReviewUpdate.previous_lapse_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuizReviewUpdate.created_at"`)}
+	}
+	if len(_c.mutation.AttemptIDs()) == 0 {
+		return &ValidationError{Name: "attempt", err: errors.New(`ent: missing required edge "QuizReviewUpdate.attempt"`)}
+	}
+	if len(_c.mutation.ReviewIDs()) == 0 {
+		return &ValidationError{Name: "review", err: errors.New(`ent: missing required edge "QuizReviewUpdate.review"`)}
+	}
+	return nil
+}
+
+func (_c *QuizReviewUpdateCreate) sqlSave(ctx context.Context) (*QuizReviewUpdate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *QuizReviewUpdateCreate) createSpec() (*QuizReviewUpdate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &QuizReviewUpdate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(quizreviewupdate.Table, sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Rating(); ok {
+		_spec.SetField(quizreviewupdate.FieldRating, field.TypeInt, value)
+		_node.Rating = value
+	}
+	if value, ok := _c.mutation.PreviousEaseFactor(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousEaseFactor, field.TypeFloat64, value)
+		_node.PreviousEaseFactor = value
+	}
+	if value, ok := _c.mutation.PreviousInterval(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousInterval, field.TypeInt, value)
+		_node.PreviousInterval = value
+	}
+	if value, ok := _c.mutation.PreviousDueAt(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousDueAt, field.TypeTime, value)
+		_node.PreviousDueAt = value
+	}
+	if value, ok := _c.mutation.PreviousStatus(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousStatus, field.TypeEnum, value)
+		_node.PreviousStatus = value
+	}
+	if value, ok := _c.mutation.PreviousLearningStep(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousLearningStep, field.TypeInt, value)
+		_node.PreviousLearningStep = value
+	}
+	if value, ok := _c.mutation.PreviousReviewCount(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousReviewCount, field.TypeInt, value)
+		_node.PreviousReviewCount = value
+	}
+	if value, ok := _c.mutation.PreviousLapseCount(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousLapseCount, field.TypeInt, value)
+		_node.PreviousLapseCount = value
+	}
+	if value, ok := _c.mutation.PreviousLastReviewedAt(); ok {
+		_spec.SetField(quizreviewupdate.FieldPreviousLastReviewedAt, field.TypeTime, value)
+		_node.PreviousLastReviewedAt = &value
+	}
+	if value, ok := _c.mutation.RevertedAt(); ok {
+		_spec.SetField(quizreviewupdate.FieldRevertedAt, field.TypeTime, value)
+		_node.RevertedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(quizreviewupdate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.AttemptIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizreviewupdate.AttemptTable,
+			Columns: []string{quizreviewupdate.AttemptColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quizattempt.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AttemptID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quizreviewupdate.ReviewTable,
+			Columns: []string{quizreviewupdate.ReviewColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flashcardreview.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReviewID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// QuizReviewUpdateCreateBulk is the builder for creating many QuizReviewUpdate entities in bulk.
+type QuizReviewUpdateCreateBulk struct {
+	config
+	err      error
+	builders []*QuizReviewUpdateCreate
+}
+
+// Save creates the QuizReviewUpdate entities in the database.
+func (_c *QuizReviewUpdateCreateBulk) Save(ctx context.Context) ([]*QuizReviewUpdate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*QuizReviewUpdate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*QuizReviewUpdateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *QuizReviewUpdateCreateBulk) SaveX(ctx context.Context) []*QuizReviewUpdate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *QuizReviewUpdateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *QuizReviewUpdateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizReviewUpdateQuery is the builder for querying QuizReviewUpdate entities.
+type QuizReviewUpdateQuery struct {
+	config
+	ctx         *QueryContext
+	order       []quizreviewupdate.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.QuizReviewUpdate
+	withAttempt *QuizAttemptQuery
+	withReview  *FlashcardReviewQuery
+	modifiers   []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the QuizReviewUpdateQuery builder.
+func (_q *QuizReviewUpdateQuery) Where(ps ...predicate.QuizReviewUpdate) *QuizReviewUpdateQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *QuizReviewUpdateQuery) Limit(limit int) *QuizReviewUpdateQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *QuizReviewUpdateQuery) Offset(offset int) *QuizReviewUpdateQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *QuizReviewUpdateQuery) Unique(unique bool) *QuizReviewUpdateQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *QuizReviewUpdateQuery) Order(o ...quizreviewupdate.OrderOption) *QuizReviewUpdateQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryAttempt chains the current query on the "attempt" edge.
+func (_q *QuizReviewUpdateQuery) QueryAttempt() *QuizAttemptQuery {
+	query := (&QuizAttemptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizreviewupdate.Table, quizreviewupdate.FieldID, selector),
+			sqlgraph.To(quizattempt.Table, quizattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizreviewupdate.AttemptTable, quizreviewupdate.AttemptColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReview chains the current query on the "review" edge.
+func (_q *QuizReviewUpdateQuery) QueryReview() *FlashcardReviewQuery {
+	query := (&FlashcardReviewClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quizreviewupdate.Table, quizreviewupdate.FieldID, selector),
+			sqlgraph.To(flashcardreview.Table, flashcardreview.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quizreviewupdate.ReviewTable, quizreviewupdate.ReviewColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first QuizReviewUpdate entity from the query.
+// Returns a *NotFoundError when no QuizReviewUpdate was found.
+func (_q *QuizReviewUpdateQuery) First(ctx context.Context) (*QuizReviewUpdate, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{quizreviewupdate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) FirstX(ctx context.Context) *QuizReviewUpdate {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first QuizReviewUpdate ID from the query.
+// Returns a *NotFoundError when no QuizReviewUpdate ID was found.
+func (_q *QuizReviewUpdateQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{quizreviewupdate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single QuizReviewUpdate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one QuizReviewUpdate entity is found.
+// Returns a *NotFoundError when no QuizReviewUpdate entities are found.
+func (_q *QuizReviewUpdateQuery) Only(ctx context.Context) (*QuizReviewUpdate, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{quizreviewupdate.Label}
+	default:
+		return nil, &NotSingularError{quizreviewupdate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) OnlyX(ctx context.Context) *QuizReviewUpdate {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only QuizReviewUpdate ID in the query.
+// Returns a *NotSingularError when more than one QuizReviewUpdate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *QuizReviewUpdateQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{quizreviewupdate.Label}
+	default:
+		err = &NotSingularError{quizreviewupdate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of QuizReviewUpdates.
+func (_q *QuizReviewUpdateQuery) All(ctx context.Context) ([]*QuizReviewUpdate, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*QuizReviewUpdate, *QuizReviewUpdateQuery]()
+	return withInterceptors[[]*QuizReviewUpdate](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) AllX(ctx context.Context) []*QuizReviewUpdate {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of QuizReviewUpdate IDs.
+func (_q *QuizReviewUpdateQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(quizreviewupdate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *QuizReviewUpdateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*QuizReviewUpdateQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *QuizReviewUpdateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *QuizReviewUpdateQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the QuizReviewUpdateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *QuizReviewUpdateQuery) Clone() *QuizReviewUpdateQuery {
+	if _q == nil {
+		return nil
+	}
+	return &QuizReviewUpdateQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]quizreviewupdate.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.QuizReviewUpdate{}, _q.predicates...),
+		withAttempt: _q.withAttempt.Clone(),
+		withReview:  _q.withReview.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithAttempt tells the query-builder to eager-load the nodes that are connected to
+// the "attempt" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuizReviewUpdateQuery) WithAttempt(opts ...func(*QuizAttemptQuery)) *QuizReviewUpdateQuery {
+	query := (&QuizAttemptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAttempt = query
+	return _q
+}
+
+// WithReview tells the query-builder to eager-load the nodes that are connected to
+// the "review" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *QuizReviewUpdateQuery) WithReview(opts ...func(*FlashcardReviewQuery)) *QuizReviewUpdateQuery {
+	query := (&FlashcardReviewClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReview = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		AttemptID uuid.UUID `json:"attempt_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.QuizReviewUpdate.Query().
+//		GroupBy(quizreviewupdate.FieldAttemptID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *QuizReviewUpdateQuery) GroupBy(field string, fields ...string) *QuizReviewUpdateGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &QuizReviewUpdateGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = quizreviewupdate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		AttemptID uuid.UUID `json:"attempt_id,omitempty"`
+//	}
+//
+//	client.QuizReviewUpdate.Query().
+//		Select(quizreviewupdate.FieldAttemptID).
+//		Scan(ctx, &v)
+func (_q *QuizReviewUpdateQuery) Select(fields ...string) *QuizReviewUpdateSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &QuizReviewUpdateSelect{QuizReviewUpdateQuery: _q}
+	sbuild.label = quizreviewupdate.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a QuizReviewUpdateSelect configured with the given aggregations.
+func (_q *QuizReviewUpdateQuery) Aggregate(fns ...AggregateFunc) *QuizReviewUpdateSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *QuizReviewUpdateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !quizreviewupdate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *QuizReviewUpdateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*QuizReviewUpdate, error) {
+	var (
+		nodes       = []*QuizReviewUpdate{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withAttempt != nil,
+			_q.withReview != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*QuizReviewUpdate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &QuizReviewUpdate{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withAttempt; query != nil {
+		if err := _q.loadAttempt(ctx, query, nodes, nil,
+			func(n *QuizReviewUpdate, e *QuizAttempt) { n.Edges.Attempt = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReview; query != nil {
+		if err := _q.loadReview(ctx, query, nodes, nil,
+			func(n *QuizReviewUpdate, e *FlashcardReview) { n.Edges.Review = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *QuizReviewUpdateQuery) loadAttempt(ctx context.Context, query *QuizAttemptQuery, nodes []*QuizReviewUpdate, init func(*QuizReviewUpdate), assign func(*QuizReviewUpdate, *QuizAttempt)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuizReviewUpdate)
+	for i := range nodes {
+		fk := nodes[i].AttemptID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(quizattempt.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "attempt_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *QuizReviewUpdateQuery) loadReview(ctx context.Context, query *FlashcardReviewQuery, nodes []*QuizReviewUpdate, init func(*QuizReviewUpdate), assign func(*QuizReviewUpdate, *FlashcardReview)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*QuizReviewUpdate)
+	for i := range nodes {
+		fk := nodes[i].ReviewID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(flashcardreview.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "review_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *QuizReviewUpdateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *QuizReviewUpdateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(quizreviewupdate.Table, quizreviewupdate.Columns, sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizreviewupdate.FieldID)
+		for i := range fields {
+			if fields[i] != quizreviewupdate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withAttempt != nil {
+			_spec.Node.AddColumnOnce(quizreviewupdate.FieldAttemptID)
+		}
+		if _q.withReview != nil {
+			_spec.Node.AddColumnOnce(quizreviewupdate.FieldReviewID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *QuizReviewUpdateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(quizreviewupdate.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = quizreviewupdate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *QuizReviewUpdateQuery) ForUpdate(opts ...sql.LockOption) *QuizReviewUpdateQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *QuizReviewUpdateQuery) ForShare(opts ...sql.LockOption) *QuizReviewUpdateQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *QuizReviewUpdateQuery) Modify(modifiers ...func(s *sql.Selector)) *QuizReviewUpdateSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// QuizReviewUpdateGroupBy is the group-by builder for QuizReviewUpdate entities.
+type QuizReviewUpdateGroupBy struct {
+	selector
+	build *QuizReviewUpdateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *QuizReviewUpdateGroupBy) Aggregate(fns ...AggregateFunc) *QuizReviewUpdateGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *QuizReviewUpdateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuizReviewUpdateQuery, *QuizReviewUpdateGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *QuizReviewUpdateGroupBy) sqlScan(ctx context.Context, root *QuizReviewUpdateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// QuizReviewUpdateSelect is the builder for selecting fields of QuizReviewUpdate entities.
+type QuizReviewUpdateSelect struct {
+	*QuizReviewUpdateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *QuizReviewUpdateSelect) Aggregate(fns ...AggregateFunc) *QuizReviewUpdateSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *QuizReviewUpdateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*QuizReviewUpdateQuery, *QuizReviewUpdateSelect](ctx, _s.QuizReviewUpdateQuery, _s, _s.inters, v)
+}
+
+func (_s *QuizReviewUpdateSelect) sqlScan(ctx context.Context, root *QuizReviewUpdateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *QuizReviewUpdateSelect) Modify(modifiers ...func(s *sql.Selector)) *QuizReviewUpdateSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
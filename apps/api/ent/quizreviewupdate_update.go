@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizReviewUpdateUpdate is the builder for updating QuizReviewUpdate entities.
+type QuizReviewUpdateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *QuizReviewUpdateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the QuizReviewUpdateUpdate builder.
+func (_u *QuizReviewUpdateUpdate) Where(ps ...predicate.QuizReviewUpdate) *QuizReviewUpdateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (_u *QuizReviewUpdateUpdate) SetRevertedAt(v time.Time) *QuizReviewUpdateUpdate {
+	_u.mutation.SetRevertedAt(v)
+	return _u
+}
+
+// SetNillableRevertedAt sets the "reverted_at" field if the given value is not nil.
+func (_u *QuizReviewUpdateUpdate) SetNillableRevertedAt(v *time.Time) *QuizReviewUpdateUpdate {
+	if v != nil {
+		_u.SetRevertedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (_u *QuizReviewUpdateUpdate) ClearRevertedAt() *QuizReviewUpdateUpdate {
+	_u.mutation.ClearRevertedAt()
+	return _u
+}
+
+// Mutation returns the QuizReviewUpdateMutation object of the builder.
+func (_u *QuizReviewUpdateUpdate) Mutation() *QuizReviewUpdateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *QuizReviewUpdateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizReviewUpdateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *QuizReviewUpdateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizReviewUpdateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizReviewUpdateUpdate) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizReviewUpdate.attempt"`)
+	}
+	if _u.mutation.ReviewCleared() && len(_u.mutation.ReviewIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizReviewUpdate.review"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizReviewUpdateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizReviewUpdateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizReviewUpdateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizreviewupdate.Table, quizreviewupdate.Columns, sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PreviousLastReviewedAtCleared() {
+		_spec.ClearField(quizreviewupdate.FieldPreviousLastReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevertedAt(); ok {
+		_spec.SetField(quizreviewupdate.FieldRevertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevertedAtCleared() {
+		_spec.ClearField(quizreviewupdate.FieldRevertedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizreviewupdate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// QuizReviewUpdateUpdateOne is the builder for updating a single QuizReviewUpdate entity.
+type QuizReviewUpdateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *QuizReviewUpdateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRevertedAt sets the "reverted_at" field.
+func (_u *QuizReviewUpdateUpdateOne) SetRevertedAt(v time.Time) *QuizReviewUpdateUpdateOne {
+	_u.mutation.SetRevertedAt(v)
+	return _u
+}
+
+// SetNillableRevertedAt sets the "reverted_at" field if the given value is not nil.
+func (_u *QuizReviewUpdateUpdateOne) SetNillableRevertedAt(v *time.Time) *QuizReviewUpdateUpdateOne {
+	if v != nil {
+		_u.SetRevertedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevertedAt clears the value of the "reverted_at" field.
+func (_u *QuizReviewUpdateUpdateOne) ClearRevertedAt() *QuizReviewUpdateUpdateOne {
+	_u.mutation.ClearRevertedAt()
+	return _u
+}
+
+// Mutation returns the QuizReviewUpdateMutation object of the builder.
+func (_u *QuizReviewUpdateUpdateOne) Mutation() *QuizReviewUpdateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the QuizReviewUpdateUpdate builder.
+func (_u *QuizReviewUpdateUpdateOne) Where(ps ...predicate.QuizReviewUpdate) *QuizReviewUpdateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *QuizReviewUpdateUpdateOne) Select(field string, fields ...string) *QuizReviewUpdateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated QuizReviewUpdate entity.
+func (_u *QuizReviewUpdateUpdateOne) Save(ctx context.Context) (*QuizReviewUpdate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *QuizReviewUpdateUpdateOne) SaveX(ctx context.Context) *QuizReviewUpdate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *QuizReviewUpdateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *QuizReviewUpdateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *QuizReviewUpdateUpdateOne) check() error {
+	if _u.mutation.AttemptCleared() && len(_u.mutation.AttemptIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizReviewUpdate.attempt"`)
+	}
+	if _u.mutation.ReviewCleared() && len(_u.mutation.ReviewIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "QuizReviewUpdate.review"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *QuizReviewUpdateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *QuizReviewUpdateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *QuizReviewUpdateUpdateOne) sqlSave(ctx context.Context) (_node *QuizReviewUpdate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(quizreviewupdate.Table, quizreviewupdate.Columns, sqlgraph.NewFieldSpec(quizreviewupdate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "QuizReviewUpdate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, quizreviewupdate.FieldID)
+		for _, f := range fields {
+			if !quizreviewupdate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != quizreviewupdate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.PreviousLastReviewedAtCleared() {
+		_spec.ClearField(quizreviewupdate.FieldPreviousLastReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevertedAt(); ok {
+		_spec.SetField(quizreviewupdate.FieldRevertedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevertedAtCleared() {
+		_spec.ClearField(quizreviewupdate.FieldRevertedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &QuizReviewUpdate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{quizreviewupdate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
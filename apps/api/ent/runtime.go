@@ -8,8 +8,21 @@ import (
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
 	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
@@ -64,15 +77,19 @@ func init() {
 	// collection.DefaultIsPublic holds the default value on creation for the is_public field.
 	collection.DefaultIsPublic = collectionDescIsPublic.Default.(bool)
 	// collectionDescCreatedAt is the schema descriptor for created_at field.
-	collectionDescCreatedAt := collectionFields[5].Descriptor()
+	collectionDescCreatedAt := collectionFields[6].Descriptor()
 	// collection.DefaultCreatedAt holds the default value on creation for the created_at field.
 	collection.DefaultCreatedAt = collectionDescCreatedAt.Default.(func() time.Time)
 	// collectionDescUpdatedAt is the schema descriptor for updated_at field.
-	collectionDescUpdatedAt := collectionFields[6].Descriptor()
+	collectionDescUpdatedAt := collectionFields[7].Descriptor()
 	// collection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	collection.DefaultUpdatedAt = collectionDescUpdatedAt.Default.(func() time.Time)
 	// collection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	collection.UpdateDefaultUpdatedAt = collectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// collectionDescDeletedBy is the schema descriptor for deleted_by field.
+	collectionDescDeletedBy := collectionFields[9].Descriptor()
+	// collection.DeletedByValidator is a validator for the "deleted_by" field. It is called by the builders before save.
+	collection.DeletedByValidator = collectionDescDeletedBy.Validators[0].(func(string) error)
 	// collectionDescID is the schema descriptor for id field.
 	collectionDescID := collectionFields[0].Descriptor()
 	// collection.DefaultID holds the default value on creation for the id field.
@@ -111,6 +128,56 @@ func init() {
 	collectioncollaboratorDescID := collectioncollaboratorFields[0].Descriptor()
 	// collectioncollaborator.DefaultID holds the default value on creation for the id field.
 	collectioncollaborator.DefaultID = collectioncollaboratorDescID.Default.(func() uuid.UUID)
+	deletionjobFields := schema.DeletionJob{}.Fields()
+	_ = deletionjobFields
+	// deletionjobDescRequestedBy is the schema descriptor for requested_by field.
+	deletionjobDescRequestedBy := deletionjobFields[2].Descriptor()
+	// deletionjob.RequestedByValidator is a validator for the "requested_by" field. It is called by the builders before save.
+	deletionjob.RequestedByValidator = func() func(string) error {
+		validators := deletionjobDescRequestedBy.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(requested_by string) error {
+			for _, fn := range fns {
+				if err := fn(requested_by); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// deletionjobDescTotalFlashcards is the schema descriptor for total_flashcards field.
+	deletionjobDescTotalFlashcards := deletionjobFields[4].Descriptor()
+	// deletionjob.DefaultTotalFlashcards holds the default value on creation for the total_flashcards field.
+	deletionjob.DefaultTotalFlashcards = deletionjobDescTotalFlashcards.Default.(int)
+	// deletionjob.TotalFlashcardsValidator is a validator for the "total_flashcards" field. It is called by the builders before save.
+	deletionjob.TotalFlashcardsValidator = deletionjobDescTotalFlashcards.Validators[0].(func(int) error)
+	// deletionjobDescDeletedFlashcards is the schema descriptor for deleted_flashcards field.
+	deletionjobDescDeletedFlashcards := deletionjobFields[5].Descriptor()
+	// deletionjob.DefaultDeletedFlashcards holds the default value on creation for the deleted_flashcards field.
+	deletionjob.DefaultDeletedFlashcards = deletionjobDescDeletedFlashcards.Default.(int)
+	// deletionjob.DeletedFlashcardsValidator is a validator for the "deleted_flashcards" field. It is called by the builders before save.
+	deletionjob.DeletedFlashcardsValidator = deletionjobDescDeletedFlashcards.Validators[0].(func(int) error)
+	// deletionjobDescError is the schema descriptor for error field.
+	deletionjobDescError := deletionjobFields[6].Descriptor()
+	// deletionjob.DefaultError holds the default value on creation for the error field.
+	deletionjob.DefaultError = deletionjobDescError.Default.(string)
+	// deletionjobDescCreatedAt is the schema descriptor for created_at field.
+	deletionjobDescCreatedAt := deletionjobFields[7].Descriptor()
+	// deletionjob.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deletionjob.DefaultCreatedAt = deletionjobDescCreatedAt.Default.(func() time.Time)
+	// deletionjobDescUpdatedAt is the schema descriptor for updated_at field.
+	deletionjobDescUpdatedAt := deletionjobFields[8].Descriptor()
+	// deletionjob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deletionjob.DefaultUpdatedAt = deletionjobDescUpdatedAt.Default.(func() time.Time)
+	// deletionjob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deletionjob.UpdateDefaultUpdatedAt = deletionjobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// deletionjobDescID is the schema descriptor for id field.
+	deletionjobDescID := deletionjobFields[0].Descriptor()
+	// deletionjob.DefaultID holds the default value on creation for the id field.
+	deletionjob.DefaultID = deletionjobDescID.Default.(func() uuid.UUID)
 	flashcardFields := schema.Flashcard{}.Fields()
 	_ = flashcardFields
 	// flashcardDescQuestion is the schema descriptor for question field.
@@ -127,8 +194,46 @@ func init() {
 	flashcard.DefaultType = flashcardDescType.Default.(string)
 	// flashcard.TypeValidator is a validator for the "type" field. It is called by the builders before save.
 	flashcard.TypeValidator = flashcardDescType.Validators[0].(func(string) error)
+	// flashcardDescContentFormat is the schema descriptor for content_format field.
+	flashcardDescContentFormat := flashcardFields[7].Descriptor()
+	// flashcard.DefaultContentFormat holds the default value on creation for the content_format field.
+	flashcard.DefaultContentFormat = flashcardDescContentFormat.Default.(string)
+	// flashcard.ContentFormatValidator is a validator for the "content_format" field. It is called by the builders before save.
+	flashcard.ContentFormatValidator = flashcardDescContentFormat.Validators[0].(func(string) error)
+	// flashcardDescQuestionHTML is the schema descriptor for question_html field.
+	flashcardDescQuestionHTML := flashcardFields[8].Descriptor()
+	// flashcard.DefaultQuestionHTML holds the default value on creation for the question_html field.
+	flashcard.DefaultQuestionHTML = flashcardDescQuestionHTML.Default.(string)
+	// flashcardDescAnswerHTML is the schema descriptor for answer_html field.
+	flashcardDescAnswerHTML := flashcardFields[9].Descriptor()
+	// flashcard.DefaultAnswerHTML holds the default value on creation for the answer_html field.
+	flashcard.DefaultAnswerHTML = flashcardDescAnswerHTML.Default.(string)
+	// flashcardDescHint is the schema descriptor for hint field.
+	flashcardDescHint := flashcardFields[10].Descriptor()
+	// flashcard.DefaultHint holds the default value on creation for the hint field.
+	flashcard.DefaultHint = flashcardDescHint.Default.(string)
+	// flashcardDescHintHTML is the schema descriptor for hint_html field.
+	flashcardDescHintHTML := flashcardFields[11].Descriptor()
+	// flashcard.DefaultHintHTML holds the default value on creation for the hint_html field.
+	flashcard.DefaultHintHTML = flashcardDescHintHTML.Default.(string)
+	// flashcardDescExplanation is the schema descriptor for explanation field.
+	flashcardDescExplanation := flashcardFields[12].Descriptor()
+	// flashcard.DefaultExplanation holds the default value on creation for the explanation field.
+	flashcard.DefaultExplanation = flashcardDescExplanation.Default.(string)
+	// flashcardDescExplanationHTML is the schema descriptor for explanation_html field.
+	flashcardDescExplanationHTML := flashcardFields[13].Descriptor()
+	// flashcard.DefaultExplanationHTML holds the default value on creation for the explanation_html field.
+	flashcard.DefaultExplanationHTML = flashcardDescExplanationHTML.Default.(string)
+	// flashcardDescQuestionKey is the schema descriptor for question_key field.
+	flashcardDescQuestionKey := flashcardFields[15].Descriptor()
+	// flashcard.DefaultQuestionKey holds the default value on creation for the question_key field.
+	flashcard.DefaultQuestionKey = flashcardDescQuestionKey.Default.(string)
+	// flashcardDescPosition is the schema descriptor for position field.
+	flashcardDescPosition := flashcardFields[21].Descriptor()
+	// flashcard.DefaultPosition holds the default value on creation for the position field.
+	flashcard.DefaultPosition = flashcardDescPosition.Default.(float64)
 	// flashcardDescCreatedBy is the schema descriptor for created_by field.
-	flashcardDescCreatedBy := flashcardFields[5].Descriptor()
+	flashcardDescCreatedBy := flashcardFields[22].Descriptor()
 	// flashcard.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
 	flashcard.CreatedByValidator = func() func(string) error {
 		validators := flashcardDescCreatedBy.Validators
@@ -146,19 +251,87 @@ func init() {
 		}
 	}()
 	// flashcardDescCreatedAt is the schema descriptor for created_at field.
-	flashcardDescCreatedAt := flashcardFields[6].Descriptor()
+	flashcardDescCreatedAt := flashcardFields[23].Descriptor()
 	// flashcard.DefaultCreatedAt holds the default value on creation for the created_at field.
 	flashcard.DefaultCreatedAt = flashcardDescCreatedAt.Default.(func() time.Time)
 	// flashcardDescUpdatedAt is the schema descriptor for updated_at field.
-	flashcardDescUpdatedAt := flashcardFields[7].Descriptor()
+	flashcardDescUpdatedAt := flashcardFields[24].Descriptor()
 	// flashcard.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	flashcard.DefaultUpdatedAt = flashcardDescUpdatedAt.Default.(func() time.Time)
 	// flashcard.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	flashcard.UpdateDefaultUpdatedAt = flashcardDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// flashcardDescDeletedBy is the schema descriptor for deleted_by field.
+	flashcardDescDeletedBy := flashcardFields[26].Descriptor()
+	// flashcard.DeletedByValidator is a validator for the "deleted_by" field. It is called by the builders before save.
+	flashcard.DeletedByValidator = flashcardDescDeletedBy.Validators[0].(func(string) error)
 	// flashcardDescID is the schema descriptor for id field.
 	flashcardDescID := flashcardFields[0].Descriptor()
 	// flashcard.DefaultID holds the default value on creation for the id field.
 	flashcard.DefaultID = flashcardDescID.Default.(func() uuid.UUID)
+	flashcardannotationFields := schema.FlashcardAnnotation{}.Fields()
+	_ = flashcardannotationFields
+	// flashcardannotationDescUserID is the schema descriptor for user_id field.
+	flashcardannotationDescUserID := flashcardannotationFields[1].Descriptor()
+	// flashcardannotation.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	flashcardannotation.UserIDValidator = func() func(string) error {
+		validators := flashcardannotationDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// flashcardannotationDescNote is the schema descriptor for note field.
+	flashcardannotationDescNote := flashcardannotationFields[3].Descriptor()
+	// flashcardannotation.DefaultNote holds the default value on creation for the note field.
+	flashcardannotation.DefaultNote = flashcardannotationDescNote.Default.(string)
+	// flashcardannotation.NoteValidator is a validator for the "note" field. It is called by the builders before save.
+	flashcardannotation.NoteValidator = flashcardannotationDescNote.Validators[0].(func(string) error)
+	// flashcardannotationDescBookmarked is the schema descriptor for bookmarked field.
+	flashcardannotationDescBookmarked := flashcardannotationFields[5].Descriptor()
+	// flashcardannotation.DefaultBookmarked holds the default value on creation for the bookmarked field.
+	flashcardannotation.DefaultBookmarked = flashcardannotationDescBookmarked.Default.(bool)
+	// flashcardannotationDescCreatedAt is the schema descriptor for created_at field.
+	flashcardannotationDescCreatedAt := flashcardannotationFields[6].Descriptor()
+	// flashcardannotation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	flashcardannotation.DefaultCreatedAt = flashcardannotationDescCreatedAt.Default.(func() time.Time)
+	// flashcardannotationDescUpdatedAt is the schema descriptor for updated_at field.
+	flashcardannotationDescUpdatedAt := flashcardannotationFields[7].Descriptor()
+	// flashcardannotation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	flashcardannotation.DefaultUpdatedAt = flashcardannotationDescUpdatedAt.Default.(func() time.Time)
+	// flashcardannotation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	flashcardannotation.UpdateDefaultUpdatedAt = flashcardannotationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// flashcardannotationDescID is the schema descriptor for id field.
+	flashcardannotationDescID := flashcardannotationFields[0].Descriptor()
+	// flashcardannotation.DefaultID holds the default value on creation for the id field.
+	flashcardannotation.DefaultID = flashcardannotationDescID.Default.(func() uuid.UUID)
+	flashcardratingFields := schema.FlashcardRating{}.Fields()
+	_ = flashcardratingFields
+	// flashcardratingDescDifficulty is the schema descriptor for difficulty field.
+	flashcardratingDescDifficulty := flashcardratingFields[2].Descriptor()
+	// flashcardrating.DefaultDifficulty holds the default value on creation for the difficulty field.
+	flashcardrating.DefaultDifficulty = flashcardratingDescDifficulty.Default.(float64)
+	// flashcardratingDescAnswerCount is the schema descriptor for answer_count field.
+	flashcardratingDescAnswerCount := flashcardratingFields[3].Descriptor()
+	// flashcardrating.DefaultAnswerCount holds the default value on creation for the answer_count field.
+	flashcardrating.DefaultAnswerCount = flashcardratingDescAnswerCount.Default.(int)
+	// flashcardratingDescUpdatedAt is the schema descriptor for updated_at field.
+	flashcardratingDescUpdatedAt := flashcardratingFields[4].Descriptor()
+	// flashcardrating.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	flashcardrating.DefaultUpdatedAt = flashcardratingDescUpdatedAt.Default.(func() time.Time)
+	// flashcardrating.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	flashcardrating.UpdateDefaultUpdatedAt = flashcardratingDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// flashcardratingDescID is the schema descriptor for id field.
+	flashcardratingDescID := flashcardratingFields[0].Descriptor()
+	// flashcardrating.DefaultID holds the default value on creation for the id field.
+	flashcardrating.DefaultID = flashcardratingDescID.Default.(func() uuid.UUID)
 	flashcardreviewFields := schema.FlashcardReview{}.Fields()
 	_ = flashcardreviewFields
 	// flashcardreviewDescUserID is the schema descriptor for user_id field.
@@ -179,46 +352,58 @@ func init() {
 			return nil
 		}
 	}()
+	// flashcardreviewDescItem is the schema descriptor for item field.
+	flashcardreviewDescItem := flashcardreviewFields[3].Descriptor()
+	// flashcardreview.DefaultItem holds the default value on creation for the item field.
+	flashcardreview.DefaultItem = flashcardreviewDescItem.Default.(int)
+	// flashcardreview.ItemValidator is a validator for the "item" field. It is called by the builders before save.
+	flashcardreview.ItemValidator = flashcardreviewDescItem.Validators[0].(func(int) error)
 	// flashcardreviewDescEaseFactor is the schema descriptor for ease_factor field.
-	flashcardreviewDescEaseFactor := flashcardreviewFields[3].Descriptor()
+	flashcardreviewDescEaseFactor := flashcardreviewFields[4].Descriptor()
 	// flashcardreview.DefaultEaseFactor holds the default value on creation for the ease_factor field.
 	flashcardreview.DefaultEaseFactor = flashcardreviewDescEaseFactor.Default.(float64)
 	// flashcardreview.EaseFactorValidator is a validator for the "ease_factor" field. It is called by the builders before save.
 	flashcardreview.EaseFactorValidator = flashcardreviewDescEaseFactor.Validators[0].(func(float64) error)
 	// flashcardreviewDescInterval is the schema descriptor for interval field.
-	flashcardreviewDescInterval := flashcardreviewFields[4].Descriptor()
+	flashcardreviewDescInterval := flashcardreviewFields[5].Descriptor()
 	// flashcardreview.DefaultInterval holds the default value on creation for the interval field.
 	flashcardreview.DefaultInterval = flashcardreviewDescInterval.Default.(int)
 	// flashcardreview.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
 	flashcardreview.IntervalValidator = flashcardreviewDescInterval.Validators[0].(func(int) error)
 	// flashcardreviewDescDueAt is the schema descriptor for due_at field.
-	flashcardreviewDescDueAt := flashcardreviewFields[5].Descriptor()
+	flashcardreviewDescDueAt := flashcardreviewFields[6].Descriptor()
 	// flashcardreview.DefaultDueAt holds the default value on creation for the due_at field.
 	flashcardreview.DefaultDueAt = flashcardreviewDescDueAt.Default.(func() time.Time)
 	// flashcardreviewDescLearningStep is the schema descriptor for learning_step field.
-	flashcardreviewDescLearningStep := flashcardreviewFields[7].Descriptor()
+	flashcardreviewDescLearningStep := flashcardreviewFields[8].Descriptor()
 	// flashcardreview.DefaultLearningStep holds the default value on creation for the learning_step field.
 	flashcardreview.DefaultLearningStep = flashcardreviewDescLearningStep.Default.(int)
 	// flashcardreview.LearningStepValidator is a validator for the "learning_step" field. It is called by the builders before save.
 	flashcardreview.LearningStepValidator = flashcardreviewDescLearningStep.Validators[0].(func(int) error)
 	// flashcardreviewDescReviewCount is the schema descriptor for review_count field.
-	flashcardreviewDescReviewCount := flashcardreviewFields[8].Descriptor()
+	flashcardreviewDescReviewCount := flashcardreviewFields[9].Descriptor()
 	// flashcardreview.DefaultReviewCount holds the default value on creation for the review_count field.
 	flashcardreview.DefaultReviewCount = flashcardreviewDescReviewCount.Default.(int)
 	// flashcardreview.ReviewCountValidator is a validator for the "review_count" field. It is called by the builders before save.
 	flashcardreview.ReviewCountValidator = flashcardreviewDescReviewCount.Validators[0].(func(int) error)
 	// flashcardreviewDescLapseCount is the schema descriptor for lapse_count field.
-	flashcardreviewDescLapseCount := flashcardreviewFields[9].Descriptor()
+	flashcardreviewDescLapseCount := flashcardreviewFields[10].Descriptor()
 	// flashcardreview.DefaultLapseCount holds the default value on creation for the lapse_count field.
 	flashcardreview.DefaultLapseCount = flashcardreviewDescLapseCount.Default.(int)
 	// flashcardreview.LapseCountValidator is a validator for the "lapse_count" field. It is called by the builders before save.
 	flashcardreview.LapseCountValidator = flashcardreviewDescLapseCount.Validators[0].(func(int) error)
+	// flashcardreviewDescHintCount is the schema descriptor for hint_count field.
+	flashcardreviewDescHintCount := flashcardreviewFields[11].Descriptor()
+	// flashcardreview.DefaultHintCount holds the default value on creation for the hint_count field.
+	flashcardreview.DefaultHintCount = flashcardreviewDescHintCount.Default.(int)
+	// flashcardreview.HintCountValidator is a validator for the "hint_count" field. It is called by the builders before save.
+	flashcardreview.HintCountValidator = flashcardreviewDescHintCount.Validators[0].(func(int) error)
 	// flashcardreviewDescCreatedAt is the schema descriptor for created_at field.
-	flashcardreviewDescCreatedAt := flashcardreviewFields[11].Descriptor()
+	flashcardreviewDescCreatedAt := flashcardreviewFields[13].Descriptor()
 	// flashcardreview.DefaultCreatedAt holds the default value on creation for the created_at field.
 	flashcardreview.DefaultCreatedAt = flashcardreviewDescCreatedAt.Default.(func() time.Time)
 	// flashcardreviewDescUpdatedAt is the schema descriptor for updated_at field.
-	flashcardreviewDescUpdatedAt := flashcardreviewFields[12].Descriptor()
+	flashcardreviewDescUpdatedAt := flashcardreviewFields[14].Descriptor()
 	// flashcardreview.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	flashcardreview.DefaultUpdatedAt = flashcardreviewDescUpdatedAt.Default.(func() time.Time)
 	// flashcardreview.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -227,4 +412,446 @@ func init() {
 	flashcardreviewDescID := flashcardreviewFields[0].Descriptor()
 	// flashcardreview.DefaultID holds the default value on creation for the id field.
 	flashcardreview.DefaultID = flashcardreviewDescID.Default.(func() uuid.UUID)
+	flashcardrevisionFields := schema.FlashcardRevision{}.Fields()
+	_ = flashcardrevisionFields
+	// flashcardrevisionDescNumber is the schema descriptor for number field.
+	flashcardrevisionDescNumber := flashcardrevisionFields[2].Descriptor()
+	// flashcardrevision.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	flashcardrevision.NumberValidator = flashcardrevisionDescNumber.Validators[0].(func(int) error)
+	// flashcardrevisionDescAuthorID is the schema descriptor for author_id field.
+	flashcardrevisionDescAuthorID := flashcardrevisionFields[3].Descriptor()
+	// flashcardrevision.AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
+	flashcardrevision.AuthorIDValidator = func() func(string) error {
+		validators := flashcardrevisionDescAuthorID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(author_id string) error {
+			for _, fn := range fns {
+				if err := fn(author_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// flashcardrevisionDescType is the schema descriptor for type field.
+	flashcardrevisionDescType := flashcardrevisionFields[7].Descriptor()
+	// flashcardrevision.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	flashcardrevision.TypeValidator = flashcardrevisionDescType.Validators[0].(func(string) error)
+	// flashcardrevisionDescContentFormat is the schema descriptor for content_format field.
+	flashcardrevisionDescContentFormat := flashcardrevisionFields[8].Descriptor()
+	// flashcardrevision.ContentFormatValidator is a validator for the "content_format" field. It is called by the builders before save.
+	flashcardrevision.ContentFormatValidator = flashcardrevisionDescContentFormat.Validators[0].(func(string) error)
+	// flashcardrevisionDescCreatedAt is the schema descriptor for created_at field.
+	flashcardrevisionDescCreatedAt := flashcardrevisionFields[16].Descriptor()
+	// flashcardrevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	flashcardrevision.DefaultCreatedAt = flashcardrevisionDescCreatedAt.Default.(func() time.Time)
+	// flashcardrevisionDescID is the schema descriptor for id field.
+	flashcardrevisionDescID := flashcardrevisionFields[0].Descriptor()
+	// flashcardrevision.DefaultID holds the default value on creation for the id field.
+	flashcardrevision.DefaultID = flashcardrevisionDescID.Default.(func() uuid.UUID)
+	flashcardtagFields := schema.FlashcardTag{}.Fields()
+	_ = flashcardtagFields
+	// flashcardtagDescName is the schema descriptor for name field.
+	flashcardtagDescName := flashcardtagFields[3].Descriptor()
+	// flashcardtag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	flashcardtag.NameValidator = func() func(string) error {
+		validators := flashcardtagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// flashcardtagDescCreatedAt is the schema descriptor for created_at field.
+	flashcardtagDescCreatedAt := flashcardtagFields[4].Descriptor()
+	// flashcardtag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	flashcardtag.DefaultCreatedAt = flashcardtagDescCreatedAt.Default.(func() time.Time)
+	// flashcardtagDescID is the schema descriptor for id field.
+	flashcardtagDescID := flashcardtagFields[0].Descriptor()
+	// flashcardtag.DefaultID holds the default value on creation for the id field.
+	flashcardtag.DefaultID = flashcardtagDescID.Default.(func() uuid.UUID)
+	itemstatisticFields := schema.ItemStatistic{}.Fields()
+	_ = itemstatisticFields
+	// itemstatisticDescAttemptCount is the schema descriptor for attempt_count field.
+	itemstatisticDescAttemptCount := itemstatisticFields[3].Descriptor()
+	// itemstatistic.DefaultAttemptCount holds the default value on creation for the attempt_count field.
+	itemstatistic.DefaultAttemptCount = itemstatisticDescAttemptCount.Default.(int)
+	// itemstatisticDescAnsweredCount is the schema descriptor for answered_count field.
+	itemstatisticDescAnsweredCount := itemstatisticFields[4].Descriptor()
+	// itemstatistic.DefaultAnsweredCount holds the default value on creation for the answered_count field.
+	itemstatistic.DefaultAnsweredCount = itemstatisticDescAnsweredCount.Default.(int)
+	// itemstatisticDescScoreSum is the schema descriptor for score_sum field.
+	itemstatisticDescScoreSum := itemstatisticFields[5].Descriptor()
+	// itemstatistic.DefaultScoreSum holds the default value on creation for the score_sum field.
+	itemstatistic.DefaultScoreSum = itemstatisticDescScoreSum.Default.(float64)
+	// itemstatisticDescScoreSquares is the schema descriptor for score_squares field.
+	itemstatisticDescScoreSquares := itemstatisticFields[6].Descriptor()
+	// itemstatistic.DefaultScoreSquares holds the default value on creation for the score_squares field.
+	itemstatistic.DefaultScoreSquares = itemstatisticDescScoreSquares.Default.(float64)
+	// itemstatisticDescRestSum is the schema descriptor for rest_sum field.
+	itemstatisticDescRestSum := itemstatisticFields[7].Descriptor()
+	// itemstatistic.DefaultRestSum holds the default value on creation for the rest_sum field.
+	itemstatistic.DefaultRestSum = itemstatisticDescRestSum.Default.(float64)
+	// itemstatisticDescRestSquares is the schema descriptor for rest_squares field.
+	itemstatisticDescRestSquares := itemstatisticFields[8].Descriptor()
+	// itemstatistic.DefaultRestSquares holds the default value on creation for the rest_squares field.
+	itemstatistic.DefaultRestSquares = itemstatisticDescRestSquares.Default.(float64)
+	// itemstatisticDescScoreRestProducts is the schema descriptor for score_rest_products field.
+	itemstatisticDescScoreRestProducts := itemstatisticFields[9].Descriptor()
+	// itemstatistic.DefaultScoreRestProducts holds the default value on creation for the score_rest_products field.
+	itemstatistic.DefaultScoreRestProducts = itemstatisticDescScoreRestProducts.Default.(float64)
+	// itemstatisticDescTimeSpentMs is the schema descriptor for time_spent_ms field.
+	itemstatisticDescTimeSpentMs := itemstatisticFields[10].Descriptor()
+	// itemstatistic.DefaultTimeSpentMs holds the default value on creation for the time_spent_ms field.
+	itemstatistic.DefaultTimeSpentMs = itemstatisticDescTimeSpentMs.Default.(int64)
+	// itemstatisticDescUpdatedAt is the schema descriptor for updated_at field.
+	itemstatisticDescUpdatedAt := itemstatisticFields[12].Descriptor()
+	// itemstatistic.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	itemstatistic.DefaultUpdatedAt = itemstatisticDescUpdatedAt.Default.(func() time.Time)
+	// itemstatistic.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	itemstatistic.UpdateDefaultUpdatedAt = itemstatisticDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// itemstatisticDescID is the schema descriptor for id field.
+	itemstatisticDescID := itemstatisticFields[0].Descriptor()
+	// itemstatistic.DefaultID holds the default value on creation for the id field.
+	itemstatistic.DefaultID = itemstatisticDescID.Default.(func() uuid.UUID)
+	learnerabilityFields := schema.LearnerAbility{}.Fields()
+	_ = learnerabilityFields
+	// learnerabilityDescUserID is the schema descriptor for user_id field.
+	learnerabilityDescUserID := learnerabilityFields[1].Descriptor()
+	// learnerability.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	learnerability.UserIDValidator = func() func(string) error {
+		validators := learnerabilityDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// learnerabilityDescAbility is the schema descriptor for ability field.
+	learnerabilityDescAbility := learnerabilityFields[3].Descriptor()
+	// learnerability.DefaultAbility holds the default value on creation for the ability field.
+	learnerability.DefaultAbility = learnerabilityDescAbility.Default.(float64)
+	// learnerabilityDescAnswerCount is the schema descriptor for answer_count field.
+	learnerabilityDescAnswerCount := learnerabilityFields[4].Descriptor()
+	// learnerability.DefaultAnswerCount holds the default value on creation for the answer_count field.
+	learnerability.DefaultAnswerCount = learnerabilityDescAnswerCount.Default.(int)
+	// learnerabilityDescUpdatedAt is the schema descriptor for updated_at field.
+	learnerabilityDescUpdatedAt := learnerabilityFields[5].Descriptor()
+	// learnerability.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	learnerability.DefaultUpdatedAt = learnerabilityDescUpdatedAt.Default.(func() time.Time)
+	// learnerability.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	learnerability.UpdateDefaultUpdatedAt = learnerabilityDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// learnerabilityDescID is the schema descriptor for id field.
+	learnerabilityDescID := learnerabilityFields[0].Descriptor()
+	// learnerability.DefaultID holds the default value on creation for the id field.
+	learnerability.DefaultID = learnerabilityDescID.Default.(func() uuid.UUID)
+	mediaFields := schema.Media{}.Fields()
+	_ = mediaFields
+	// mediaDescHash is the schema descriptor for hash field.
+	mediaDescHash := mediaFields[1].Descriptor()
+	// media.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	media.HashValidator = func() func(string) error {
+		validators := mediaDescHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(hash string) error {
+			for _, fn := range fns {
+				if err := fn(hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mediaDescContentType is the schema descriptor for content_type field.
+	mediaDescContentType := mediaFields[2].Descriptor()
+	// media.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	media.ContentTypeValidator = func() func(string) error {
+		validators := mediaDescContentType.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(content_type string) error {
+			for _, fn := range fns {
+				if err := fn(content_type); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mediaDescSize is the schema descriptor for size field.
+	mediaDescSize := mediaFields[3].Descriptor()
+	// media.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	media.SizeValidator = mediaDescSize.Validators[0].(func(int64) error)
+	// mediaDescStorageKey is the schema descriptor for storage_key field.
+	mediaDescStorageKey := mediaFields[4].Descriptor()
+	// media.StorageKeyValidator is a validator for the "storage_key" field. It is called by the builders before save.
+	media.StorageKeyValidator = func() func(string) error {
+		validators := mediaDescStorageKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(storage_key string) error {
+			for _, fn := range fns {
+				if err := fn(storage_key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mediaDescUploadedBy is the schema descriptor for uploaded_by field.
+	mediaDescUploadedBy := mediaFields[5].Descriptor()
+	// media.UploadedByValidator is a validator for the "uploaded_by" field. It is called by the builders before save.
+	media.UploadedByValidator = func() func(string) error {
+		validators := mediaDescUploadedBy.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(uploaded_by string) error {
+			for _, fn := range fns {
+				if err := fn(uploaded_by); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mediaDescCreatedAt is the schema descriptor for created_at field.
+	mediaDescCreatedAt := mediaFields[6].Descriptor()
+	// media.DefaultCreatedAt holds the default value on creation for the created_at field.
+	media.DefaultCreatedAt = mediaDescCreatedAt.Default.(func() time.Time)
+	// mediaDescID is the schema descriptor for id field.
+	mediaDescID := mediaFields[0].Descriptor()
+	// media.DefaultID holds the default value on creation for the id field.
+	media.DefaultID = mediaDescID.Default.(func() uuid.UUID)
+	mediauploadFields := schema.MediaUpload{}.Fields()
+	_ = mediauploadFields
+	// mediauploadDescUserID is the schema descriptor for user_id field.
+	mediauploadDescUserID := mediauploadFields[2].Descriptor()
+	// mediaupload.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	mediaupload.UserIDValidator = func() func(string) error {
+		validators := mediauploadDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mediauploadDescUploadedAt is the schema descriptor for uploaded_at field.
+	mediauploadDescUploadedAt := mediauploadFields[3].Descriptor()
+	// mediaupload.DefaultUploadedAt holds the default value on creation for the uploaded_at field.
+	mediaupload.DefaultUploadedAt = mediauploadDescUploadedAt.Default.(func() time.Time)
+	// mediauploadDescID is the schema descriptor for id field.
+	mediauploadDescID := mediauploadFields[0].Descriptor()
+	// mediaupload.DefaultID holds the default value on creation for the id field.
+	mediaupload.DefaultID = mediauploadDescID.Default.(func() uuid.UUID)
+	quizFields := schema.Quiz{}.Fields()
+	_ = quizFields
+	// quizDescCreatedBy is the schema descriptor for created_by field.
+	quizDescCreatedBy := quizFields[2].Descriptor()
+	// quiz.CreatedByValidator is a validator for the "created_by" field. It is called by the builders before save.
+	quiz.CreatedByValidator = func() func(string) error {
+		validators := quizDescCreatedBy.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(created_by string) error {
+			for _, fn := range fns {
+				if err := fn(created_by); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// quizDescTitle is the schema descriptor for title field.
+	quizDescTitle := quizFields[3].Descriptor()
+	// quiz.DefaultTitle holds the default value on creation for the title field.
+	quiz.DefaultTitle = quizDescTitle.Default.(string)
+	// quiz.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	quiz.TitleValidator = quizDescTitle.Validators[0].(func(string) error)
+	// quizDescQuestionCount is the schema descriptor for question_count field.
+	quizDescQuestionCount := quizFields[5].Descriptor()
+	// quiz.QuestionCountValidator is a validator for the "question_count" field. It is called by the builders before save.
+	quiz.QuestionCountValidator = quizDescQuestionCount.Validators[0].(func(int) error)
+	// quizDescAdaptive is the schema descriptor for adaptive field.
+	quizDescAdaptive := quizFields[6].Descriptor()
+	// quiz.DefaultAdaptive holds the default value on creation for the adaptive field.
+	quiz.DefaultAdaptive = quizDescAdaptive.Default.(bool)
+	// quizDescChoiceCount is the schema descriptor for choice_count field.
+	quizDescChoiceCount := quizFields[9].Descriptor()
+	// quiz.DefaultChoiceCount holds the default value on creation for the choice_count field.
+	quiz.DefaultChoiceCount = quizDescChoiceCount.Default.(int)
+	// quiz.ChoiceCountValidator is a validator for the "choice_count" field. It is called by the builders before save.
+	quiz.ChoiceCountValidator = quizDescChoiceCount.Validators[0].(func(int) error)
+	// quizDescTimeLimitSeconds is the schema descriptor for time_limit_seconds field.
+	quizDescTimeLimitSeconds := quizFields[12].Descriptor()
+	// quiz.TimeLimitSecondsValidator is a validator for the "time_limit_seconds" field. It is called by the builders before save.
+	quiz.TimeLimitSecondsValidator = quizDescTimeLimitSeconds.Validators[0].(func(int) error)
+	// quizDescMaxAttempts is the schema descriptor for max_attempts field.
+	quizDescMaxAttempts := quizFields[15].Descriptor()
+	// quiz.MaxAttemptsValidator is a validator for the "max_attempts" field. It is called by the builders before save.
+	quiz.MaxAttemptsValidator = quizDescMaxAttempts.Validators[0].(func(int) error)
+	// quizDescReviewFeedback is the schema descriptor for review_feedback field.
+	quizDescReviewFeedback := quizFields[16].Descriptor()
+	// quiz.DefaultReviewFeedback holds the default value on creation for the review_feedback field.
+	quiz.DefaultReviewFeedback = quizDescReviewFeedback.Default.(bool)
+	// quizDescSlowAnswerSeconds is the schema descriptor for slow_answer_seconds field.
+	quizDescSlowAnswerSeconds := quizFields[17].Descriptor()
+	// quiz.DefaultSlowAnswerSeconds holds the default value on creation for the slow_answer_seconds field.
+	quiz.DefaultSlowAnswerSeconds = quizDescSlowAnswerSeconds.Default.(int)
+	// quiz.SlowAnswerSecondsValidator is a validator for the "slow_answer_seconds" field. It is called by the builders before save.
+	quiz.SlowAnswerSecondsValidator = quizDescSlowAnswerSeconds.Validators[0].(func(int) error)
+	// quizDescShared is the schema descriptor for shared field.
+	quizDescShared := quizFields[18].Descriptor()
+	// quiz.DefaultShared holds the default value on creation for the shared field.
+	quiz.DefaultShared = quizDescShared.Default.(bool)
+	// quizDescCreatedAt is the schema descriptor for created_at field.
+	quizDescCreatedAt := quizFields[19].Descriptor()
+	// quiz.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quiz.DefaultCreatedAt = quizDescCreatedAt.Default.(func() time.Time)
+	// quizDescUpdatedAt is the schema descriptor for updated_at field.
+	quizDescUpdatedAt := quizFields[20].Descriptor()
+	// quiz.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	quiz.DefaultUpdatedAt = quizDescUpdatedAt.Default.(func() time.Time)
+	// quiz.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	quiz.UpdateDefaultUpdatedAt = quizDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// quizDescID is the schema descriptor for id field.
+	quizDescID := quizFields[0].Descriptor()
+	// quiz.DefaultID holds the default value on creation for the id field.
+	quiz.DefaultID = quizDescID.Default.(func() uuid.UUID)
+	quizanswerFields := schema.QuizAnswer{}.Fields()
+	_ = quizanswerFields
+	// quizanswerDescPosition is the schema descriptor for position field.
+	quizanswerDescPosition := quizanswerFields[3].Descriptor()
+	// quizanswer.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	quizanswer.PositionValidator = quizanswerDescPosition.Validators[0].(func(int) error)
+	// quizanswerDescCorrect is the schema descriptor for correct field.
+	quizanswerDescCorrect := quizanswerFields[7].Descriptor()
+	// quizanswer.DefaultCorrect holds the default value on creation for the correct field.
+	quizanswer.DefaultCorrect = quizanswerDescCorrect.Default.(bool)
+	// quizanswerDescScore is the schema descriptor for score field.
+	quizanswerDescScore := quizanswerFields[8].Descriptor()
+	// quizanswer.DefaultScore holds the default value on creation for the score field.
+	quizanswer.DefaultScore = quizanswerDescScore.Default.(float64)
+	// quizanswerDescTimeSpentMs is the schema descriptor for time_spent_ms field.
+	quizanswerDescTimeSpentMs := quizanswerFields[9].Descriptor()
+	// quizanswer.DefaultTimeSpentMs holds the default value on creation for the time_spent_ms field.
+	quizanswer.DefaultTimeSpentMs = quizanswerDescTimeSpentMs.Default.(int)
+	// quizanswer.TimeSpentMsValidator is a validator for the "time_spent_ms" field. It is called by the builders before save.
+	quizanswer.TimeSpentMsValidator = quizanswerDescTimeSpentMs.Validators[0].(func(int) error)
+	// quizanswerDescCreatedAt is the schema descriptor for created_at field.
+	quizanswerDescCreatedAt := quizanswerFields[10].Descriptor()
+	// quizanswer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quizanswer.DefaultCreatedAt = quizanswerDescCreatedAt.Default.(func() time.Time)
+	// quizanswerDescID is the schema descriptor for id field.
+	quizanswerDescID := quizanswerFields[0].Descriptor()
+	// quizanswer.DefaultID holds the default value on creation for the id field.
+	quizanswer.DefaultID = quizanswerDescID.Default.(func() uuid.UUID)
+	quizattemptFields := schema.QuizAttempt{}.Fields()
+	_ = quizattemptFields
+	// quizattemptDescUserID is the schema descriptor for user_id field.
+	quizattemptDescUserID := quizattemptFields[2].Descriptor()
+	// quizattempt.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	quizattempt.UserIDValidator = func() func(string) error {
+		validators := quizattemptDescUserID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(user_id string) error {
+			for _, fn := range fns {
+				if err := fn(user_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// quizattemptDescStartedAt is the schema descriptor for started_at field.
+	quizattemptDescStartedAt := quizattemptFields[4].Descriptor()
+	// quizattempt.DefaultStartedAt holds the default value on creation for the started_at field.
+	quizattempt.DefaultStartedAt = quizattemptDescStartedAt.Default.(func() time.Time)
+	// quizattemptDescAutoSubmitted is the schema descriptor for auto_submitted field.
+	quizattemptDescAutoSubmitted := quizattemptFields[7].Descriptor()
+	// quizattempt.DefaultAutoSubmitted holds the default value on creation for the auto_submitted field.
+	quizattempt.DefaultAutoSubmitted = quizattemptDescAutoSubmitted.Default.(bool)
+	// quizattemptDescScore is the schema descriptor for score field.
+	quizattemptDescScore := quizattemptFields[8].Descriptor()
+	// quizattempt.DefaultScore holds the default value on creation for the score field.
+	quizattempt.DefaultScore = quizattemptDescScore.Default.(float64)
+	// quizattemptDescMaxScore is the schema descriptor for max_score field.
+	quizattemptDescMaxScore := quizattemptFields[9].Descriptor()
+	// quizattempt.DefaultMaxScore holds the default value on creation for the max_score field.
+	quizattempt.DefaultMaxScore = quizattemptDescMaxScore.Default.(float64)
+	// quizattemptDescTimeSpentSeconds is the schema descriptor for time_spent_seconds field.
+	quizattemptDescTimeSpentSeconds := quizattemptFields[12].Descriptor()
+	// quizattempt.DefaultTimeSpentSeconds holds the default value on creation for the time_spent_seconds field.
+	quizattempt.DefaultTimeSpentSeconds = quizattemptDescTimeSpentSeconds.Default.(int)
+	// quizattempt.TimeSpentSecondsValidator is a validator for the "time_spent_seconds" field. It is called by the builders before save.
+	quizattempt.TimeSpentSecondsValidator = quizattemptDescTimeSpentSeconds.Validators[0].(func(int) error)
+	// quizattemptDescID is the schema descriptor for id field.
+	quizattemptDescID := quizattemptFields[0].Descriptor()
+	// quizattempt.DefaultID holds the default value on creation for the id field.
+	quizattempt.DefaultID = quizattemptDescID.Default.(func() uuid.UUID)
+	quizreviewupdateFields := schema.QuizReviewUpdate{}.Fields()
+	_ = quizreviewupdateFields
+	// quizreviewupdateDescRating is the schema descriptor for rating field.
+	quizreviewupdateDescRating := quizreviewupdateFields[3].Descriptor()
+	// quizreviewupdate.RatingValidator is a validator for the "rating" field. It is called by the builders before save.
+	quizreviewupdate.RatingValidator = func() func(int) error {
+		validators := quizreviewupdateDescRating.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(rating int) error {
+			for _, fn := range fns {
+				if err := fn(rating); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// quizreviewupdateDescCreatedAt is the schema descriptor for created_at field.
+	quizreviewupdateDescCreatedAt := quizreviewupdateFields[13].Descriptor()
+	// quizreviewupdate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	quizreviewupdate.DefaultCreatedAt = quizreviewupdateDescCreatedAt.Default.(func() time.Time)
+	// quizreviewupdateDescID is the schema descriptor for id field.
+	quizreviewupdateDescID := quizreviewupdateFields[0].Descriptor()
+	// quizreviewupdate.DefaultID holds the default value on creation for the id field.
+	quizreviewupdate.DefaultID = quizreviewupdateDescID.Default.(func() uuid.UUID)
 }
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -32,25 +33,51 @@ func (Collection) Fields() []ent.Field {
 			MaxLen(255),
 		field.Bool("is_public").
 			Default(false),
+		field.UUID("parent_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Parent collection; nil for top-level collections"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set while the collection is in the trash"),
+		field.String("deleted_by").
+			Optional().
+			MaxLen(255),
 	}
 }
 
 func (Collection) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("owner_id"),
+		index.Fields("parent_id"),
+		// Index for purging the trash
+		index.Fields("deleted_at"),
 	}
 }
 
 // Edges of the Collection.
 func (Collection) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("collaborators", CollectionCollaborator.Type),
-		edge.To("flashcards", Flashcard.Type),
+		edge.To("collaborators", CollectionCollaborator.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("flashcards", Flashcard.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("quizzes", Quiz.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("abilities", LearnerAbility.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("children", Collection.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			From("parent").
+			Unique().
+			Field("parent_id").
+			Comment("Sub-collections, which inherit the permissions of their parent"),
 	}
 }
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// DeletionJob holds the schema definition for the DeletionJob entity.
+// Collections too large to delete in one transaction are deleted in batches by a
+// background job that records its progress here.
+type DeletionJob struct {
+	ent.Schema
+}
+
+// Fields of the DeletionJob.
+func (DeletionJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("collection_id", uuid.UUID{}).
+			Immutable().
+			Comment("Collection being deleted; not an edge since the collection is removed"),
+		field.String("requested_by").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "running", "completed", "failed").
+			Default("pending"),
+		field.Int("total_flashcards").
+			Default(0).
+			Min(0),
+		field.Int("deleted_flashcards").
+			Default(0).
+			Min(0),
+		field.String("error").
+			Optional().
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the DeletionJob.
+func (DeletionJob) Indexes() []ent.Index {
+	return []ent.Index{
+		// Index for resuming unfinished jobs
+		index.Fields("status"),
+	}
+}
@@ -4,11 +4,57 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
+// MatchPair is a left/right pair of a "matching" flashcard.
+type MatchPair struct {
+	Left  string `json:"left"`
+	Right string `json:"right"`
+}
+
+// SourceReference cites where the content of a flashcard comes from.
+type SourceReference struct {
+	Citation string `json:"citation"`
+	Page     string `json:"page,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// ImageOcclusion is the image and mask geometry of an "image_occlusion" flashcard.
+// Coordinates are fractions of the image width and height (0 to 1).
+type ImageOcclusion struct {
+	ImageURL string          `json:"image_url"`
+	Width    int             `json:"width,omitempty"`
+	Height   int             `json:"height,omitempty"`
+	Mode     string          `json:"mode"`
+	Masks    []OcclusionMask `json:"masks"`
+}
+
+// ReviewItemCount returns the number of review items, one per mask.
+func (o *ImageOcclusion) ReviewItemCount() int {
+	if o == nil || len(o.Masks) == 0 {
+		return 1
+	}
+	return len(o.Masks)
+}
+
+// OcclusionMask is a rectangle or polygon hiding one label of the image.
+type OcclusionMask struct {
+	ID     string       `json:"id"`
+	Shape  string       `json:"shape"`
+	X      float64      `json:"x,omitempty"`
+	Y      float64      `json:"y,omitempty"`
+	Width  float64      `json:"width,omitempty"`
+	Height float64      `json:"height,omitempty"`
+	Points [][2]float64 `json:"points,omitempty"`
+	Label  string       `json:"label"`
+}
+
 // Flashcard holds the schema definition for the Flashcard entity.
 type Flashcard struct {
 	ent.Schema
@@ -27,7 +73,73 @@ func (Flashcard) Fields() []ent.Field {
 		field.String("type").
 			Default("simple").
 			MaxLen(50),
+		field.JSON("options", []string{}).
+			Optional().
+			Comment("Choices for multiple_choice cards, items in correct order for ordering cards"),
+		field.JSON("pairs", []MatchPair{}).
+			Optional().
+			Comment("Left/right pairs in correct correspondence for matching cards"),
+		field.JSON("occlusion", &ImageOcclusion{}).
+			Optional().
+			Comment("Image and masks for image_occlusion cards, one review item per mask"),
+		field.String("content_format").
+			Default("plain").
+			MaxLen(20).
+			Comment("Format of question and answer: plain, markdown or html"),
+		field.String("question_html").
+			Optional().
+			Default("").
+			Comment("Sanitized HTML rendering of the question"),
+		field.String("answer_html").
+			Optional().
+			Default("").
+			Comment("Sanitized HTML rendering of the answer"),
+		field.String("hint").
+			Optional().
+			Default("").
+			Comment("Optional hint the learner can reveal before answering"),
+		field.String("hint_html").
+			Optional().
+			Default("").
+			Comment("Sanitized HTML rendering of the hint"),
+		field.String("explanation").
+			Optional().
+			Default("").
+			Comment("Longer explanation shown after answering"),
+		field.String("explanation_html").
+			Optional().
+			Default("").
+			Comment("Sanitized HTML rendering of the explanation"),
+		field.JSON("sources", []SourceReference{}).
+			Optional().
+			Comment("Citations of the material the flashcard is based on"),
+		field.String("question_key").
+			Optional().
+			Default("").
+			Comment("Normalized plain text of the question for duplicate detection"),
+		field.String("question_text").
+			Optional().
+			Nillable().
+			StructTag(`json:"-"`).
+			Comment("Plain text of the question for full-text search, nil until computed"),
+		field.String("answer_text").
+			Optional().
+			Nillable().
+			StructTag(`json:"-"`).
+			Comment("Plain text of the answer for full-text search, nil until computed"),
+		field.String("search_vector").
+			SchemaType(map[string]string{dialect.Postgres: "tsvector"}).
+			Optional().
+			StructTag(`json:"-"`).
+			Comment("Full-text search document of the plain text, maintained by a database trigger"),
 		field.UUID("collection_id", uuid.UUID{}),
+		field.Enum("status").
+			Values("draft", "published").
+			Default("published").
+			Comment("Drafts are only visible to owners and editors and are never reviewed"),
+		field.Float("position").
+			Default(0).
+			Comment("Sort key within the collection; cards are ordered by position, then ID"),
 		field.String("created_by").
 			NotEmpty().
 			MaxLen(255),
@@ -37,6 +149,13 @@ func (Flashcard) Fields() []ent.Field {
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set while the flashcard is in the trash"),
+		field.String("deleted_by").
+			Optional().
+			MaxLen(255),
 	}
 }
 
@@ -49,6 +168,38 @@ func (Flashcard) Edges() []ent.Edge {
 			Required().
 			Field("collection_id"),
 		edge.To("reviews", FlashcardReview.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
 			Comment("Reviews for this flashcard across different users"),
+		edge.To("media", Media.Type).
+			Comment("Image and audio attachments"),
+		edge.To("tags", FlashcardTag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", FlashcardRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Edit history"),
+		edge.To("annotations", FlashcardAnnotation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Private notes, flags and bookmarks of users"),
+		edge.To("quiz_answers", QuizAnswer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("item_statistics", ItemStatistic.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("rating", FlashcardRating.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Flashcard.
+func (Flashcard) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("search_vector").
+			Annotations(entsql.IndexType("GIN")),
+		// Trigram index for finding near-duplicate questions
+		index.Fields("question_key").
+			Annotations(entsql.IndexType("GIN"), entsql.OpClass("gin_trgm_ops")),
+		index.Fields("collection_id", "position"),
+		// Index for purging the trash
+		index.Fields("deleted_at"),
 	}
 }
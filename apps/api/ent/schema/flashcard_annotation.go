@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FlashcardAnnotation holds the schema definition for the FlashcardAnnotation entity.
+// Annotations are private to their user, so viewers of a shared collection can take
+// notes on, flag and bookmark flashcards without editing them for everyone.
+type FlashcardAnnotation struct {
+	ent.Schema
+}
+
+// Fields of the FlashcardAnnotation.
+func (FlashcardAnnotation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Clerk user ID"),
+		field.UUID("flashcard_id", uuid.UUID{}),
+		field.String("note").
+			Optional().
+			Default("").
+			MaxLen(10000).
+			Comment("Private note of the user"),
+		field.Enum("flag").
+			Values("red", "orange", "green", "blue", "pink", "turquoise", "purple").
+			Optional().
+			Nillable().
+			Comment("Colored flag, like Anki's card flags"),
+		field.Bool("bookmarked").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the FlashcardAnnotation.
+func (FlashcardAnnotation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flashcard", Flashcard.Type).
+			Ref("annotations").
+			Unique().
+			Required().
+			Field("flashcard_id"),
+	}
+}
+
+// Indexes of the FlashcardAnnotation.
+func (FlashcardAnnotation) Indexes() []ent.Index {
+	return []ent.Index{
+		// One annotation per user per flashcard
+		index.Fields("user_id", "flashcard_id").
+			Unique(),
+		// Index for filtering by flag
+		index.Fields("user_id", "flag"),
+	}
+}
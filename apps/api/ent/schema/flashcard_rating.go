@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// FlashcardRating holds the schema definition for the FlashcardRating entity.
+// It is the Elo-style difficulty estimate of a flashcard, on the same logit scale
+// as the ability of learners, updated after each adaptive answer. It is kept apart
+// from the flashcard so that ratings do not count as edits.
+type FlashcardRating struct {
+	ent.Schema
+}
+
+// Fields of the FlashcardRating.
+func (FlashcardRating) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("flashcard_id", uuid.UUID{}).
+			Unique().
+			Immutable(),
+		field.Float("difficulty").
+			Default(0),
+		field.Int("answer_count").
+			Default(0).
+			Comment("Answers the estimate is based on; later answers move it less"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the FlashcardRating.
+func (FlashcardRating) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flashcard", Flashcard.Type).
+			Ref("rating").
+			Unique().
+			Required().
+			Immutable().
+			Field("flashcard_id"),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -28,6 +29,10 @@ func (FlashcardReview) Fields() []ent.Field {
 			Comment("Clerk user ID"),
 		field.UUID("flashcard_id", uuid.UUID{}).
 			Comment("Foreign key to the flashcard being reviewed"),
+		field.Int("item").
+			Default(0).
+			Min(0).
+			Comment("Review item within the flashcard (the mask index for image occlusion cards)"),
 		// SM-2 Algorithm fields
 		field.Float("ease_factor").
 			Default(2.5).
@@ -56,6 +61,10 @@ func (FlashcardReview) Fields() []ent.Field {
 			Default(0).
 			Min(0).
 			Comment("Number of times the card was forgotten (rated 'Again')"),
+		field.Int("hint_count").
+			Default(0).
+			Min(0).
+			Comment("Number of reviews answered after revealing the hint"),
 		field.Time("last_reviewed_at").
 			Optional().
 			Nillable().
@@ -77,14 +86,16 @@ func (FlashcardReview) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Field("flashcard_id"),
+		edge.To("quiz_updates", QuizReviewUpdate.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
 
 // Indexes of the FlashcardReview.
 func (FlashcardReview) Indexes() []ent.Index {
 	return []ent.Index{
-		// Unique constraint: one review entry per user per flashcard
-		index.Fields("user_id", "flashcard_id").
+		// Unique constraint: one review entry per user per flashcard item
+		index.Fields("user_id", "flashcard_id", "item").
 			Unique(),
 		// Index for querying due cards by user
 		index.Fields("user_id", "due_at"),
@@ -0,0 +1,94 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FlashcardRevision holds the schema definition for the FlashcardRevision entity.
+// Each revision is a snapshot of the flashcard content after an edit.
+type FlashcardRevision struct {
+	ent.Schema
+}
+
+// Fields of the FlashcardRevision.
+func (FlashcardRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("flashcard_id", uuid.UUID{}).
+			Immutable(),
+		field.Int("number").
+			Positive().
+			Immutable().
+			Comment("Revision number, starting at 1 for each flashcard"),
+		field.String("author_id").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.Strings("changed_fields").
+			Optional().
+			Immutable().
+			Comment("Fields changed compared to the previous revision"),
+		field.String("question").
+			Immutable(),
+		field.String("answer").
+			Immutable(),
+		field.String("type").
+			MaxLen(50).
+			Immutable(),
+		field.String("content_format").
+			MaxLen(20).
+			Immutable(),
+		field.String("hint").
+			Optional().
+			Immutable(),
+		field.String("explanation").
+			Optional().
+			Immutable(),
+		field.JSON("sources", []SourceReference{}).
+			Optional().
+			Immutable(),
+		field.JSON("options", []string{}).
+			Optional().
+			Immutable(),
+		field.JSON("pairs", []MatchPair{}).
+			Optional().
+			Immutable(),
+		field.JSON("occlusion", &ImageOcclusion{}).
+			Optional().
+			Immutable(),
+		field.JSON("media_ids", []uuid.UUID{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the FlashcardRevision.
+func (FlashcardRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flashcard", Flashcard.Type).
+			Ref("revisions").
+			Unique().
+			Required().
+			Immutable().
+			Field("flashcard_id"),
+	}
+}
+
+// Indexes of the FlashcardRevision.
+func (FlashcardRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("flashcard_id", "number").
+			Unique(),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FlashcardTag holds the schema definition for the FlashcardTag entity.
+// Tags are hierarchical paths separated by "::", e.g. "bio::cell::mitochondria".
+type FlashcardTag struct {
+	ent.Schema
+}
+
+// Fields of the FlashcardTag.
+func (FlashcardTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("flashcard_id", uuid.UUID{}),
+		field.UUID("collection_id", uuid.UUID{}).
+			Comment("Collection of the flashcard, denormalized for collection-wide tag queries"),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Full tag path"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the FlashcardTag.
+func (FlashcardTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flashcard", Flashcard.Type).
+			Ref("tags").
+			Unique().
+			Required().
+			Field("flashcard_id"),
+	}
+}
+
+// Indexes of the FlashcardTag.
+func (FlashcardTag) Indexes() []ent.Index {
+	return []ent.Index{
+		// One tag of each name per flashcard
+		index.Fields("flashcard_id", "name").
+			Unique(),
+		// Index for listing and filtering tags in a collection
+		index.Fields("collection_id", "name"),
+	}
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ItemStatistic holds the schema definition for the ItemStatistic entity.
+// It keeps running sums over the submitted attempts that asked a flashcard, either
+// in one quiz or across all quizzes, from which the classical item statistics are
+// derived. The sums are updated as attempts are submitted.
+type ItemStatistic struct {
+	ent.Schema
+}
+
+// Fields of the ItemStatistic.
+func (ItemStatistic) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("flashcard_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("quiz_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Unset for the statistics of the flashcard across all quizzes"),
+		field.Int("attempt_count").
+			Default(0).
+			Comment("Submitted attempts that asked the flashcard"),
+		field.Int("answered_count").
+			Default(0),
+		field.Float("score_sum").
+			Default(0),
+		field.Float("score_squares").
+			Default(0),
+		field.Float("rest_sum").
+			Default(0).
+			Comment("Sum of the attempt scores without this question, as a fraction of the maximum"),
+		field.Float("rest_squares").
+			Default(0),
+		field.Float("score_rest_products").
+			Default(0),
+		field.Int64("time_spent_ms").
+			Default(0).
+			Comment("Total time spent on the answered questions"),
+		field.JSON("option_counts", map[string]int{}).
+			Optional().
+			Comment("Times each option was chosen, for questions asked with options"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ItemStatistic.
+func (ItemStatistic) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flashcard", Flashcard.Type).
+			Ref("item_statistics").
+			Unique().
+			Required().
+			Immutable().
+			Field("flashcard_id"),
+		edge.From("quiz", Quiz.Type).
+			Ref("item_statistics").
+			Unique().
+			Immutable().
+			Field("quiz_id"),
+	}
+}
+
+// Indexes of the ItemStatistic.
+func (ItemStatistic) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("quiz_id", "flashcard_id").
+			Unique(),
+		// One row across all quizzes per flashcard
+		index.Fields("flashcard_id").
+			Unique().
+			Annotations(entsql.IndexWhere("quiz_id IS NULL")),
+	}
+}
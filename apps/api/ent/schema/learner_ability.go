@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LearnerAbility holds the schema definition for the LearnerAbility entity.
+// It is the Elo-style ability estimate of a user in a collection, on the same
+// logit scale as the difficulty of flashcards, updated after each adaptive answer.
+type LearnerAbility struct {
+	ent.Schema
+}
+
+// Fields of the LearnerAbility.
+func (LearnerAbility) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("Clerk user ID"),
+		field.UUID("collection_id", uuid.UUID{}).
+			Immutable(),
+		field.Float("ability").
+			Default(0),
+		field.Int("answer_count").
+			Default(0).
+			Comment("Answers the estimate is based on; later answers move it less"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the LearnerAbility.
+func (LearnerAbility) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("collection", Collection.Type).
+			Ref("abilities").
+			Unique().
+			Required().
+			Immutable().
+			Field("collection_id"),
+	}
+}
+
+// Indexes of the LearnerAbility.
+func (LearnerAbility) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "collection_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Media holds the schema definition for the Media entity.
+// A media blob is stored once per content hash and may be attached to many flashcards.
+type Media struct {
+	ent.Schema
+}
+
+// Fields of the Media.
+func (Media) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.String("hash").
+			NotEmpty().
+			MaxLen(64).
+			Immutable().
+			Comment("Hex-encoded SHA-256 of the content"),
+		field.String("content_type").
+			NotEmpty().
+			MaxLen(100).
+			Immutable(),
+		field.Int64("size").
+			Min(0).
+			Immutable(),
+		field.String("storage_key").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("Key of the blob in the blob store"),
+		field.String("uploaded_by").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("First uploader; later uploaders of the same content get a MediaUpload"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Media.
+func (Media) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flashcards", Flashcard.Type).
+			Ref("media"),
+		edge.To("uploads", MediaUpload.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Media.
+func (Media) Indexes() []ent.Index {
+	return []ent.Index{
+		// Content-hash deduplication
+		index.Fields("hash").
+			Unique(),
+	}
+}
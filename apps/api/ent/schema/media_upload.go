@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MediaUpload holds the schema definition for the MediaUpload entity.
+// Media is stored once per content hash, so every user who uploaded the content
+// gets an upload record that lets them attach and download it.
+type MediaUpload struct {
+	ent.Schema
+}
+
+// Fields of the MediaUpload.
+func (MediaUpload) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("media_id", uuid.UUID{}).
+			Immutable(),
+		field.String("user_id").
+			NotEmpty().
+			MaxLen(255).
+			Immutable().
+			Comment("Clerk user ID"),
+		field.Time("uploaded_at").
+			Default(time.Now).
+			Comment("Last upload of the content by the user; recent uploads are kept by the garbage collector"),
+	}
+}
+
+// Edges of the MediaUpload.
+func (MediaUpload) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("media", Media.Type).
+			Ref("uploads").
+			Unique().
+			Required().
+			Immutable().
+			Field("media_id"),
+	}
+}
+
+// Indexes of the MediaUpload.
+func (MediaUpload) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("media_id", "user_id").
+			Unique(),
+		index.Fields("media_id", "uploaded_at"),
+	}
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Quiz holds the schema definition for the Quiz entity.
+// A quiz is a fixed selection of flashcards from a collection, generated once from
+// its settings and then attempted any number of times.
+type Quiz struct {
+	ent.Schema
+}
+
+// Fields of the Quiz.
+func (Quiz) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("collection_id", uuid.UUID{}),
+		field.String("created_by").
+			NotEmpty().
+			MaxLen(255).
+			Immutable(),
+		field.String("title").
+			Optional().
+			Default("").
+			MaxLen(255),
+		field.Enum("selection").
+			Values("random", "weakest", "tagged").
+			Default("random").
+			Comment("How flashcards are picked: at random, the creator's weakest first, or from tags"),
+		field.Int("question_count").
+			Positive().
+			Comment("For adaptive quizzes, the most questions an attempt asks"),
+		field.Bool("adaptive").
+			Default(false).
+			Comment("Pick each question from the flashcards by the learner's ability; flashcard_ids holds the candidates"),
+		field.JSON("type_counts", map[string]int{}).
+			Optional().
+			Comment("Number of questions of each flashcard type; the rest may be of any type"),
+		field.Strings("tags").
+			Optional().
+			Comment("Only flashcards carrying one of these tags or their descendants"),
+		field.Int("choice_count").
+			Default(0).
+			Min(0).
+			Comment("Simple cards are asked as multiple choice with this many generated options; 0 asks for a typed answer"),
+		field.Int64("seed").
+			Comment("Seed of the selection and shuffling, so a quiz can be regenerated identically"),
+		field.JSON("flashcard_ids", []uuid.UUID{}).
+			Comment("Selected flashcards in question order"),
+		field.Int("time_limit_seconds").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Attempts are due this long after they start; unset for untimed quizzes"),
+		field.Time("opens_at").
+			Optional().
+			Nillable().
+			Comment("Attempts cannot be started before this time"),
+		field.Time("closes_at").
+			Optional().
+			Nillable().
+			Comment("Attempts cannot be started after this time"),
+		field.Int("max_attempts").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("Attempts allowed per user; unset for unlimited"),
+		field.Bool("review_feedback").
+			Default(false).
+			Comment("Submitted answers update the takers' spaced repetition: wrong ones as Again, slow correct ones as Hard"),
+		field.Int("slow_answer_seconds").
+			Default(30).
+			Positive().
+			Comment("Correct answers that took longer count as Hard when feeding spaced repetition"),
+		field.Bool("shared").
+			Default(false).
+			Comment("Shared quizzes can be taken by everyone who can see the collection"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Quiz.
+func (Quiz) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("collection", Collection.Type).
+			Ref("quizzes").
+			Unique().
+			Required().
+			Field("collection_id"),
+		edge.To("attempts", QuizAttempt.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("item_statistics", ItemStatistic.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Quiz.
+func (Quiz) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("collection_id", "created_at"),
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuizResponse is a learner's answer to a quiz question; which field is used
+// depends on the flashcard type.
+type QuizResponse struct {
+	Answer  string      `json:"answer,omitempty"`
+	Order   []string    `json:"order,omitempty"`
+	Matches []MatchPair `json:"matches,omitempty"`
+}
+
+// QuizAnswer holds the schema definition for the QuizAnswer entity.
+// Answers are graded by the server as they are given.
+type QuizAnswer struct {
+	ent.Schema
+}
+
+// Fields of the QuizAnswer.
+func (QuizAnswer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("attempt_id", uuid.UUID{}),
+		field.UUID("flashcard_id", uuid.UUID{}),
+		field.Int("position").
+			Min(0).
+			Comment("Question number within the attempt, starting at 0"),
+		field.Strings("options").
+			Optional().
+			Comment("Options of a multiple choice question, or generated for a simple card asked as multiple choice, in display order"),
+		field.JSON("response", &QuizResponse{}).
+			Optional(),
+		field.Time("answered_at").
+			Optional().
+			Nillable().
+			Comment("Unset while the question is unanswered"),
+		field.Bool("correct").
+			Default(false),
+		field.Float("score").
+			Default(0).
+			Comment("From 0 to 1, with partial credit for ordering and matching cards"),
+		field.Int("time_spent_ms").
+			Default(0).
+			Min(0).
+			Comment("Time since the previous answer of the attempt, measured by the server"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the QuizAnswer.
+func (QuizAnswer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attempt", QuizAttempt.Type).
+			Ref("answers").
+			Unique().
+			Required().
+			Field("attempt_id"),
+		edge.From("flashcard", Flashcard.Type).
+			Ref("quiz_answers").
+			Unique().
+			Required().
+			Field("flashcard_id"),
+	}
+}
+
+// Indexes of the QuizAnswer.
+func (QuizAnswer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attempt_id", "position").
+			Unique(),
+		// Index for per-flashcard statistics
+		index.Fields("flashcard_id"),
+	}
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuizAttempt holds the schema definition for the QuizAttempt entity.
+// An attempt is one user taking a quiz; it has one answer row per question.
+type QuizAttempt struct {
+	ent.Schema
+}
+
+// Fields of the QuizAttempt.
+func (QuizAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("quiz_id", uuid.UUID{}),
+		field.String("user_id").
+			NotEmpty().
+			MaxLen(255).
+			Comment("Clerk user ID"),
+		field.Enum("status").
+			Values("in_progress", "submitted").
+			Default("in_progress"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("deadline_at").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Set from the time limit of the quiz when the attempt starts"),
+		field.Time("submitted_at").
+			Optional().
+			Nillable(),
+		field.Bool("auto_submitted").
+			Default(false).
+			Comment("Submitted by the server when the deadline passed"),
+		field.Float("score").
+			Default(0).
+			Comment("Sum of the question scores, with partial credit"),
+		field.Float("max_score").
+			Default(0).
+			Comment("Number of questions"),
+		field.Float("ability").
+			Optional().
+			Nillable().
+			Comment("Ability estimate after the last answer of an adaptive attempt"),
+		field.Float("ability_error").
+			Optional().
+			Nillable().
+			Comment("Standard error of the ability estimate; adaptive attempts stop when it is small enough"),
+		field.Int("time_spent_seconds").
+			Default(0).
+			Min(0).
+			Comment("From the start to the submission of the attempt"),
+	}
+}
+
+// Edges of the QuizAttempt.
+func (QuizAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("quiz", Quiz.Type).
+			Ref("attempts").
+			Unique().
+			Required().
+			Field("quiz_id"),
+		edge.To("answers", QuizAnswer.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("review_updates", QuizReviewUpdate.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the QuizAttempt.
+func (QuizAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("quiz_id", "user_id"),
+		index.Fields("user_id", "status"),
+		// Index for submitting expired attempts
+		index.Fields("status", "deadline_at"),
+	}
+}
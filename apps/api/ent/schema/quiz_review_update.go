@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// QuizReviewUpdate holds the schema definition for the QuizReviewUpdate entity.
+// It records how an answer of a submitted quiz attempt changed the spaced
+// repetition schedule of a flashcard, with the schedule before the change so that
+// it can be reverted.
+type QuizReviewUpdate struct {
+	ent.Schema
+}
+
+// Fields of the QuizReviewUpdate.
+func (QuizReviewUpdate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(NewUUIDV7).
+			Immutable(),
+		field.UUID("attempt_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("review_id", uuid.UUID{}).
+			Immutable(),
+		field.Int("rating").
+			Min(0).
+			Max(3).
+			Immutable().
+			Comment("Rating the answer counted as: Again for wrong answers, Hard for slow correct ones"),
+		// Schedule of the review before the update
+		field.Float("previous_ease_factor").
+			Immutable(),
+		field.Int("previous_interval").
+			Immutable(),
+		field.Time("previous_due_at").
+			Immutable(),
+		field.Enum("previous_status").
+			Values("new", "learning", "review", "relearning").
+			Immutable(),
+		field.Int("previous_learning_step").
+			Immutable(),
+		field.Int("previous_review_count").
+			Immutable().
+			Comment("The update can only be reverted while the review count is one more"),
+		field.Int("previous_lapse_count").
+			Immutable(),
+		field.Time("previous_last_reviewed_at").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Time("reverted_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the QuizReviewUpdate.
+func (QuizReviewUpdate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("attempt", QuizAttempt.Type).
+			Ref("review_updates").
+			Unique().
+			Required().
+			Immutable().
+			Field("attempt_id"),
+		edge.From("review", FlashcardReview.Type).
+			Ref("quiz_updates").
+			Unique().
+			Required().
+			Immutable().
+			Field("review_id"),
+	}
+}
+
+// Indexes of the QuizReviewUpdate.
+func (QuizReviewUpdate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("attempt_id"),
+		index.Fields("review_id"),
+	}
+}
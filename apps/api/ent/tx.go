@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -16,10 +18,36 @@ type Tx struct {
 	Collection *CollectionClient
 	// CollectionCollaborator is the client for interacting with the CollectionCollaborator builders.
 	CollectionCollaborator *CollectionCollaboratorClient
+	// DeletionJob is the client for interacting with the DeletionJob builders.
+	DeletionJob *DeletionJobClient
 	// Flashcard is the client for interacting with the Flashcard builders.
 	Flashcard *FlashcardClient
+	// FlashcardAnnotation is the client for interacting with the FlashcardAnnotation builders.
+	FlashcardAnnotation *FlashcardAnnotationClient
+	// FlashcardRating is the client for interacting with the FlashcardRating builders.
+	FlashcardRating *FlashcardRatingClient
 	// FlashcardReview is the client for interacting with the FlashcardReview builders.
 	FlashcardReview *FlashcardReviewClient
+	// FlashcardRevision is the client for interacting with the FlashcardRevision builders.
+	FlashcardRevision *FlashcardRevisionClient
+	// FlashcardTag is the client for interacting with the FlashcardTag builders.
+	FlashcardTag *FlashcardTagClient
+	// ItemStatistic is the client for interacting with the ItemStatistic builders.
+	ItemStatistic *ItemStatisticClient
+	// LearnerAbility is the client for interacting with the LearnerAbility builders.
+	LearnerAbility *LearnerAbilityClient
+	// Media is the client for interacting with the Media builders.
+	Media *MediaClient
+	// MediaUpload is the client for interacting with the MediaUpload builders.
+	MediaUpload *MediaUploadClient
+	// Quiz is the client for interacting with the Quiz builders.
+	Quiz *QuizClient
+	// QuizAnswer is the client for interacting with the QuizAnswer builders.
+	QuizAnswer *QuizAnswerClient
+	// QuizAttempt is the client for interacting with the QuizAttempt builders.
+	QuizAttempt *QuizAttemptClient
+	// QuizReviewUpdate is the client for interacting with the QuizReviewUpdate builders.
+	QuizReviewUpdate *QuizReviewUpdateClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,8 +181,21 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Collection = NewCollectionClient(tx.config)
 	tx.CollectionCollaborator = NewCollectionCollaboratorClient(tx.config)
+	tx.DeletionJob = NewDeletionJobClient(tx.config)
 	tx.Flashcard = NewFlashcardClient(tx.config)
+	tx.FlashcardAnnotation = NewFlashcardAnnotationClient(tx.config)
+	tx.FlashcardRating = NewFlashcardRatingClient(tx.config)
 	tx.FlashcardReview = NewFlashcardReviewClient(tx.config)
+	tx.FlashcardRevision = NewFlashcardRevisionClient(tx.config)
+	tx.FlashcardTag = NewFlashcardTagClient(tx.config)
+	tx.ItemStatistic = NewItemStatisticClient(tx.config)
+	tx.LearnerAbility = NewLearnerAbilityClient(tx.config)
+	tx.Media = NewMediaClient(tx.config)
+	tx.MediaUpload = NewMediaUploadClient(tx.config)
+	tx.Quiz = NewQuizClient(tx.config)
+	tx.QuizAnswer = NewQuizAnswerClient(tx.config)
+	tx.QuizAttempt = NewQuizAttemptClient(tx.config)
+	tx.QuizReviewUpdate = NewQuizReviewUpdateClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -217,3 +258,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,180 @@
+// Package content sanitizes and renders the rich text of flashcards.
+package content
+
+import (
+	"bytes"
+	"errors"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	mdhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+// Content formats
+const (
+	FormatPlain    = "plain"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+// ErrInvalidFormat is returned for an unknown content format
+var ErrInvalidFormat = errors.New("content format must be plain, markdown or html")
+
+var (
+	// Classes kept by the sanitizer: code block languages from fenced code blocks
+	// ("language-go") and math markers for the client-side LaTeX renderer
+	allowedClasses = regexp.MustCompile(`^(language-[A-Za-z0-9_+#-]+|math|math math-inline|math math-display)$`)
+
+	// LaTeX math: $$...$$, \[...\], $...$ and \(...\)
+	displayMath = regexp.MustCompile(`(?s)\$\$(.+?)\$\$|\\\[(.+?)\\\]`)
+	inlineMath  = regexp.MustCompile(`\$([^\s$](?:[^$\n]*[^\s$])?)\$|\\\((.+?)\\\)`)
+
+	mathPlaceholder = regexp.MustCompile(`MATHPLACEHOLDER(\d+)X`)
+
+	policy = newPolicy()
+	strict = bluemonday.StrictPolicy()
+
+	markdown = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(mdhtml.WithUnsafe()),
+	)
+)
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(allowedClasses).OnElements("code", "pre", "span", "div")
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// ValidFormat reports whether format is a known content format
+func ValidFormat(format string) bool {
+	switch format {
+	case FormatPlain, FormatMarkdown, FormatHTML:
+		return true
+	}
+	return false
+}
+
+// Sanitize returns the text to store for the given format. HTML is cleaned with the
+// allowlist policy; plain text and markdown sources are stored as written and are
+// sanitized when rendered.
+func Sanitize(format, text string) (string, error) {
+	switch format {
+	case FormatPlain, FormatMarkdown:
+		return text, nil
+	case FormatHTML:
+		return policy.Sanitize(text), nil
+	}
+	return "", ErrInvalidFormat
+}
+
+// Render returns the sanitized HTML for the text so that every client displays
+// the card the same way
+func Render(format, text string) (string, error) {
+	switch format {
+	case FormatPlain:
+		paragraphs := strings.Split(strings.TrimSpace(text), "\n\n")
+		for i, paragraph := range paragraphs {
+			paragraphs[i] = "<p>" + strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>") + "</p>"
+		}
+		return strings.Join(paragraphs, "\n"), nil
+
+	case FormatMarkdown:
+		source, math := extractMath(text)
+
+		var buf bytes.Buffer
+		if err := markdown.Convert([]byte(source), &buf); err != nil {
+			return "", err
+		}
+
+		return policy.Sanitize(restoreMath(buf.String(), math)), nil
+
+	case FormatHTML:
+		return policy.Sanitize(text), nil
+	}
+
+	return "", ErrInvalidFormat
+}
+
+// PlainText strips all markup so the text can be compared as a typed answer
+func PlainText(format, text string) string {
+	switch format {
+	case FormatMarkdown, FormatHTML:
+		rendered, err := Render(format, text)
+		if err != nil {
+			return text
+		}
+		return strings.TrimSpace(html.UnescapeString(strict.Sanitize(rendered)))
+	}
+	return text
+}
+
+// Highlight turns a search snippet into safe HTML: markup in the card content is
+// stripped and escaped, and the text between start and end markers is wrapped in <mark>
+func Highlight(snippet, start, end string) string {
+	escaped := strict.Sanitize(snippet)
+	escaped = strings.ReplaceAll(escaped, start, "<mark>")
+	return strings.ReplaceAll(escaped, end, "</mark>")
+}
+
+// extractMath replaces LaTeX math with placeholders so that markdown does not turn
+// underscores and asterisks inside formulas into emphasis. Math inside code spans
+// and fenced code blocks is left alone.
+func extractMath(text string) (string, []string) {
+	var math []string
+	var out strings.Builder
+
+	var prose strings.Builder
+
+	inFence := false
+	for _, line := range strings.SplitAfter(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		isFence := strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+
+		if inFence || isFence {
+			out.WriteString(replaceOutsideCodeSpans(prose.String(), &math))
+			prose.Reset()
+			out.WriteString(line)
+			if isFence {
+				inFence = !inFence
+			}
+			continue
+		}
+		prose.WriteString(line)
+	}
+	out.WriteString(replaceOutsideCodeSpans(prose.String(), &math))
+
+	return out.String(), math
+}
+
+func replaceOutsideCodeSpans(line string, math *[]string) string {
+	parts := strings.Split(line, "`")
+	for i := 0; i < len(parts); i += 2 {
+		parts[i] = displayMath.ReplaceAllStringFunc(parts[i], func(m string) string {
+			*math = append(*math, `<div class="math math-display">`+html.EscapeString(m)+`</div>`)
+			return "MATHPLACEHOLDER" + strconv.Itoa(len(*math)-1) + "X"
+		})
+		parts[i] = inlineMath.ReplaceAllStringFunc(parts[i], func(m string) string {
+			*math = append(*math, `<span class="math math-inline">`+html.EscapeString(m)+`</span>`)
+			return "MATHPLACEHOLDER" + strconv.Itoa(len(*math)-1) + "X"
+		})
+	}
+	return strings.Join(parts, "`")
+}
+
+func restoreMath(rendered string, math []string) string {
+	return mathPlaceholder.ReplaceAllStringFunc(rendered, func(m string) string {
+		index, err := strconv.Atoi(mathPlaceholder.FindStringSubmatch(m)[1])
+		if err != nil || index >= len(math) {
+			return m
+		}
+		return math[index]
+	})
+}
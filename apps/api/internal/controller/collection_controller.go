@@ -80,9 +80,9 @@ func (c *CollectionController) CreateCollection(ctx *gin.Context) {
 		return
 	}
 
-	collection, err := c.collectionService.CreateCollection(ctx.Request.Context(), req.Name, req.Description, userID, req.IsPublic)
+	collection, err := c.collectionService.CreateCollection(ctx.Request.Context(), req.Name, req.Description, userID, req.IsPublic, req.ParentID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": "Failed to create collection"})
+		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
 		return
 	}
 
@@ -124,6 +124,109 @@ func (c *CollectionController) UpdateCollection(ctx *gin.Context) {
 	})
 }
 
+func (c *CollectionController) MoveCollection(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.MoveCollectionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	collection, err := c.collectionService.MoveCollection(ctx.Request.Context(), collectionID, userID, req.ParentID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"collection":   collection,
+		"errorMessage": "",
+	})
+}
+
+func (c *CollectionController) MergeCollections(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.MergeCollectionsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	plan, err := c.collectionService.MergeCollections(ctx.Request.Context(), collectionID, userID, req.SourceIDs, req.Preview)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"merge":        plan,
+		"errorMessage": "",
+	})
+}
+
+func (c *CollectionController) SplitCollection(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.SplitCollectionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	parts := make([]service.SplitPart, len(req.Parts))
+	for i, part := range req.Parts {
+		parts[i] = service.SplitPart{
+			Name:         part.Name,
+			Description:  part.Description,
+			Tags:         part.Tags,
+			FlashcardIDs: part.FlashcardIDs,
+		}
+	}
+
+	plan, err := c.collectionService.SplitCollection(ctx.Request.Context(), collectionID, userID, parts, req.Preview)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"split":        plan,
+		"errorMessage": "",
+	})
+}
+
 func (c *CollectionController) DeleteCollection(ctx *gin.Context) {
 	userID, ok := middleware.GetUserIDFromContext(ctx)
 	if !ok {
@@ -1,12 +1,17 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
 	"github.com/quanphung1120/advanced-quiz-be/internal/data/request"
 	"github.com/quanphung1120/advanced-quiz-be/internal/middleware"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
 	"github.com/quanphung1120/advanced-quiz-be/internal/service"
 )
 
@@ -34,7 +39,13 @@ func (c *FlashcardController) GetCollectionFlashcards(ctx *gin.Context) {
 		return
 	}
 
-	flashcards, role, err := c.flashcardService.GetCollectionFlashcards(ctx.Request.Context(), collectionID, userID)
+	// Repeated ?tag= parameters match flashcards carrying any of the tags or their descendants
+	filter := repository.FlashcardFilter{
+		Tags:   ctx.QueryArray("tag"),
+		Status: ctx.Query("status"), // Ignored for viewers, who only see published cards
+	}
+
+	flashcards, role, err := c.flashcardService.GetCollectionFlashcards(ctx.Request.Context(), collectionID, userID, filter)
 	if err != nil {
 		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
 		return
@@ -94,7 +105,30 @@ func (c *FlashcardController) CreateFlashcard(ctx *gin.Context) {
 		return
 	}
 
-	flashcard, err := c.flashcardService.CreateFlashcard(ctx.Request.Context(), collectionID, userID, req.Question, req.Answer, req.Type)
+	flashcard, err := c.flashcardService.CreateFlashcard(ctx.Request.Context(), collectionID, userID, repository.FlashcardFields{
+		Question:      req.Question,
+		Answer:        req.Answer,
+		Type:          req.Type,
+		ContentFormat: req.ContentFormat,
+		Options:       req.Options,
+		Pairs:         req.Pairs,
+		Occlusion:     req.Occlusion,
+		MediaIDs:      req.MediaIDs,
+		Hint:          req.Hint,
+		Explanation:   req.Explanation,
+		Sources:       req.Sources,
+		Status:        req.Status,
+	}, req.AllowDuplicates)
+
+	// Similar questions exist: the client confirms by resending with allow_duplicates
+	var duplicates *service.DuplicateFlashcardsError
+	if errors.As(err, &duplicates) {
+		ctx.JSON(http.StatusConflict, gin.H{
+			"duplicates":   duplicates.Candidates,
+			"errorMessage": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
 		return
@@ -126,7 +160,19 @@ func (c *FlashcardController) UpdateFlashcard(ctx *gin.Context) {
 		return
 	}
 
-	flashcard, err := c.flashcardService.UpdateFlashcard(ctx.Request.Context(), flashcardID, userID, req.Question, req.Answer, req.Type)
+	flashcard, err := c.flashcardService.UpdateFlashcard(ctx.Request.Context(), flashcardID, userID, repository.FlashcardFields{
+		Question:      req.Question,
+		Answer:        req.Answer,
+		Type:          req.Type,
+		ContentFormat: req.ContentFormat,
+		Options:       req.Options,
+		Pairs:         req.Pairs,
+		Occlusion:     req.Occlusion,
+		MediaIDs:      req.MediaIDs,
+		Hint:          req.Hint,
+		Explanation:   req.Explanation,
+		Sources:       req.Sources,
+	})
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
 		return
@@ -163,3 +209,679 @@ func (c *FlashcardController) DeleteFlashcard(ctx *gin.Context) {
 		"errorMessage": "",
 	})
 }
+
+// MoveFlashcards handles POST /api/v1/collections/:id/flashcards/move
+func (c *FlashcardController) MoveFlashcards(ctx *gin.Context) {
+	c.transferFlashcards(ctx, false)
+}
+
+// CopyFlashcards handles POST /api/v1/collections/:id/flashcards/copy
+func (c *FlashcardController) CopyFlashcards(ctx *gin.Context) {
+	c.transferFlashcards(ctx, true)
+}
+
+func (c *FlashcardController) transferFlashcards(ctx *gin.Context, copying bool) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.TransferFlashcardsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	var flashcards []*ent.Flashcard
+	if copying {
+		flashcards, err = c.flashcardService.CopyFlashcards(ctx.Request.Context(), collectionID, userID, req.FlashcardIDs, req.TargetCollectionID, req.CopyProgress)
+	} else {
+		flashcards, err = c.flashcardService.MoveFlashcards(ctx.Request.Context(), collectionID, userID, req.FlashcardIDs, req.TargetCollectionID)
+	}
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"flashcards":   flashcards,
+		"errorMessage": "",
+	})
+}
+
+// BatchFlashcards handles POST /api/v1/collections/:id/flashcards/batch
+func (c *FlashcardController) BatchFlashcards(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.FlashcardBatchRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	atomic := req.Atomic == nil || *req.Atomic
+
+	ops := make([]repository.BatchOperation, len(req.Operations))
+	for i, op := range req.Operations {
+		ops[i] = repository.BatchOperation{
+			Op:          op.Op,
+			FlashcardID: op.ID,
+			Fields: repository.FlashcardFields{
+				Question:      op.Question,
+				Answer:        op.Answer,
+				Type:          op.Type,
+				ContentFormat: op.ContentFormat,
+				Options:       op.Options,
+				Pairs:         op.Pairs,
+				Occlusion:     op.Occlusion,
+				MediaIDs:      op.MediaIDs,
+				Hint:          op.Hint,
+				Explanation:   op.Explanation,
+				Sources:       op.Sources,
+				Status:        op.Status,
+			},
+		}
+	}
+
+	result, err := c.flashcardService.BatchFlashcards(ctx.Request.Context(), collectionID, userID, ops, atomic)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	if !result.Committed {
+		ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+			"batch":        result,
+			"errorMessage": "Batch rolled back because some operations failed",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"batch":        result,
+		"errorMessage": "",
+	})
+}
+
+// MoveFlashcard handles POST /api/v1/collections/:id/flashcards/:flashcardId/move
+func (c *FlashcardController) MoveFlashcard(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("flashcardId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	var req request.MoveFlashcardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	flashcard, err := c.flashcardService.MoveFlashcard(ctx.Request.Context(), collectionID, flashcardID, userID, req.CollectionID, req.AfterID, req.BeforeID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"flashcard":    flashcard,
+		"errorMessage": "",
+	})
+}
+
+func (c *FlashcardController) GetOcclusionItems(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardIDStr := ctx.Param("id")
+	flashcardID, err := uuid.Parse(flashcardIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	items, err := c.flashcardService.GetOcclusionItems(ctx.Request.Context(), flashcardID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"items":        items,
+		"errorMessage": "",
+	})
+}
+
+// ListTags handles GET /api/v1/collections/:id/tags
+func (c *FlashcardController) ListTags(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	tags, err := c.flashcardService.ListTags(ctx.Request.Context(), collectionID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"tags":         tags,
+		"errorMessage": "",
+	})
+}
+
+// AddTags handles POST /api/v1/collections/:id/tags/add
+func (c *FlashcardController) AddTags(ctx *gin.Context) {
+	c.changeTags(ctx, c.flashcardService.AddTags)
+}
+
+// RemoveTags handles POST /api/v1/collections/:id/tags/remove
+func (c *FlashcardController) RemoveTags(ctx *gin.Context) {
+	c.changeTags(ctx, c.flashcardService.RemoveTags)
+}
+
+func (c *FlashcardController) changeTags(
+	ctx *gin.Context,
+	change func(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, tags []string) (int, error),
+) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.TagFlashcardsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	count, err := change(ctx.Request.Context(), collectionID, userID, req.FlashcardIDs, req.Tags)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"count":        count,
+		"errorMessage": "",
+	})
+}
+
+// RenameTag handles POST /api/v1/collections/:id/tags/rename
+func (c *FlashcardController) RenameTag(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.RenameTagRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	count, err := c.flashcardService.RenameTag(ctx.Request.Context(), collectionID, userID, req.From, req.To)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"count":        count,
+		"errorMessage": "",
+	})
+}
+
+// FindDuplicates handles GET /api/v1/collections/:id/duplicates
+func (c *FlashcardController) FindDuplicates(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	groups, err := c.flashcardService.FindDuplicates(ctx.Request.Context(), collectionID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"groups":       groups,
+		"errorMessage": "",
+	})
+}
+
+// MergeDuplicates handles POST /api/v1/collections/:id/duplicates/merge
+func (c *FlashcardController) MergeDuplicates(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.MergeDuplicatesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	flashcard, err := c.flashcardService.MergeDuplicates(ctx.Request.Context(), collectionID, userID, req.KeepID, req.DuplicateIDs)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"flashcard":    flashcard,
+		"errorMessage": "",
+	})
+}
+
+// SearchFlashcards handles GET /api/v1/flashcards/search
+func (c *FlashcardController) SearchFlashcards(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	search := repository.FlashcardSearch{
+		Query:       ctx.Query("q"),
+		UserID:      userID,
+		Type:        ctx.Query("type"),
+		Tags:        ctx.QueryArray("tag"),
+		Annotations: annotationFilterFromQuery(ctx),
+	}
+
+	if collectionIDStr := ctx.Query("collection_id"); collectionIDStr != "" {
+		collectionID, err := uuid.Parse(collectionIDStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+			return
+		}
+		search.CollectionID = &collectionID
+	}
+
+	// Parse optional pagination parameters
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		if parsedLimit, err := strconv.Atoi(limitStr); err == nil && parsedLimit > 0 {
+			search.Limit = parsedLimit
+		}
+	}
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		if parsedOffset, err := strconv.Atoi(offsetStr); err == nil && parsedOffset > 0 {
+			search.Offset = parsedOffset
+		}
+	}
+
+	results, total, err := c.flashcardService.SearchFlashcards(ctx.Request.Context(), search)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"results":      results,
+		"total":        total,
+		"errorMessage": "",
+	})
+}
+
+// ListRevisions handles GET /api/v1/flashcards/:id/revisions
+func (c *FlashcardController) ListRevisions(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	revisions, err := c.flashcardService.ListRevisions(ctx.Request.Context(), flashcardID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Flashcard not found or access denied"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"revisions":    revisions,
+		"errorMessage": "",
+	})
+}
+
+// DiffRevisions handles GET /api/v1/flashcards/:id/revisions/diff?from=&to=
+func (c *FlashcardController) DiffRevisions(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	from, fromErr := strconv.Atoi(ctx.Query("from"))
+	to, toErr := strconv.Atoi(ctx.Query("to"))
+	if fromErr != nil || toErr != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "from and to revision numbers are required"})
+		return
+	}
+
+	diff, err := c.flashcardService.DiffRevisions(ctx.Request.Context(), flashcardID, userID, from, to)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"diff":         diff,
+		"errorMessage": "",
+	})
+}
+
+// RestoreRevision handles POST /api/v1/flashcards/:id/revisions/:number/restore
+func (c *FlashcardController) RestoreRevision(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	number, err := strconv.Atoi(ctx.Param("number"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid revision number"})
+		return
+	}
+
+	flashcard, err := c.flashcardService.RestoreRevision(ctx.Request.Context(), flashcardID, userID, number)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"flashcard":    flashcard,
+		"errorMessage": "",
+	})
+}
+
+// annotationFilterFromQuery reads the repeated flag parameter and the bookmarked
+// parameter of a listing
+func annotationFilterFromQuery(ctx *gin.Context) repository.AnnotationFilter {
+	bookmarked, _ := strconv.ParseBool(ctx.Query("bookmarked"))
+	return repository.AnnotationFilter{
+		Flags:      ctx.QueryArray("flag"),
+		Bookmarked: bookmarked,
+	}
+}
+
+// GetAnnotation handles GET /api/v1/flashcards/:id/annotation
+func (c *FlashcardController) GetAnnotation(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	annotation, err := c.flashcardService.GetAnnotation(ctx.Request.Context(), flashcardID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"annotation":   annotation,
+		"errorMessage": "",
+	})
+}
+
+// AnnotateFlashcard handles PUT /api/v1/flashcards/:id/annotation
+func (c *FlashcardController) AnnotateFlashcard(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	var req request.AnnotateFlashcardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	fields := repository.AnnotationFields{
+		Note:       req.Note,
+		Flag:       req.Flag,
+		Bookmarked: req.Bookmarked,
+	}
+
+	annotation, err := c.flashcardService.AnnotateFlashcard(ctx.Request.Context(), flashcardID, userID, fields)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"annotation":   annotation,
+		"errorMessage": "",
+	})
+}
+
+// DeleteAnnotation handles DELETE /api/v1/flashcards/:id/annotation
+func (c *FlashcardController) DeleteAnnotation(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	if err := c.flashcardService.DeleteAnnotation(ctx.Request.Context(), flashcardID, userID); err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":      "Annotation deleted successfully",
+		"errorMessage": "",
+	})
+}
+
+// ListAnnotations handles GET /api/v1/flashcards/annotations
+func (c *FlashcardController) ListAnnotations(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	annotations, err := c.flashcardService.ListAnnotations(ctx.Request.Context(), userID, annotationFilterFromQuery(ctx))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"annotations":  annotations,
+		"errorMessage": "",
+	})
+}
+
+// PublishFlashcards handles POST /api/v1/collections/:id/flashcards/publish
+func (c *FlashcardController) PublishFlashcards(ctx *gin.Context) {
+	c.setFlashcardsStatus(ctx, service.FlashcardStatusPublished)
+}
+
+// UnpublishFlashcards handles POST /api/v1/collections/:id/flashcards/unpublish
+func (c *FlashcardController) UnpublishFlashcards(ctx *gin.Context) {
+	c.setFlashcardsStatus(ctx, service.FlashcardStatusDraft)
+}
+
+func (c *FlashcardController) setFlashcardsStatus(ctx *gin.Context, status string) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.FlashcardStatusRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	changed, err := c.flashcardService.SetFlashcardStatus(ctx.Request.Context(), collectionID, userID, req.FlashcardIDs, req.All, status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"changed":      changed,
+		"errorMessage": "",
+	})
+}
+
+// PublishFlashcard handles POST /api/v1/collections/:id/flashcards/:flashcardId/publish
+func (c *FlashcardController) PublishFlashcard(ctx *gin.Context) {
+	c.setFlashcardStatus(ctx, service.FlashcardStatusPublished)
+}
+
+// UnpublishFlashcard handles POST /api/v1/collections/:id/flashcards/:flashcardId/unpublish
+func (c *FlashcardController) UnpublishFlashcard(ctx *gin.Context) {
+	c.setFlashcardStatus(ctx, service.FlashcardStatusDraft)
+}
+
+func (c *FlashcardController) setFlashcardStatus(ctx *gin.Context, status string) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("flashcardId"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	changed, err := c.flashcardService.SetFlashcardStatus(ctx.Request.Context(), collectionID, userID, []uuid.UUID{flashcardID}, false, status)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"changed":      changed,
+		"errorMessage": "",
+	})
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 	"github.com/quanphung1120/advanced-quiz-be/internal/data/request"
 	"github.com/quanphung1120/advanced-quiz-be/internal/middleware"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
 	"github.com/quanphung1120/advanced-quiz-be/internal/service"
 )
 
@@ -49,7 +51,7 @@ func (c *FlashcardReviewController) SubmitReview(ctx *gin.Context) {
 		return
 	}
 
-	review, err := c.reviewService.SubmitReview(ctx.Request.Context(), flashcardID, userID, rating)
+	review, err := c.reviewService.SubmitReview(ctx.Request.Context(), flashcardID, userID, req.Item, rating, req.HintUsed)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
 		return
@@ -61,6 +63,90 @@ func (c *FlashcardReviewController) SubmitReview(ctx *gin.Context) {
 	})
 }
 
+// GradeAnswer handles POST /api/v1/flashcards/:id/grade
+func (c *FlashcardReviewController) GradeAnswer(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardIDStr := ctx.Param("id")
+	flashcardID, err := uuid.Parse(flashcardIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	var req request.GradeAnswerRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	result, err := c.reviewService.GradeAnswer(ctx.Request.Context(), flashcardID, userID, service.AnswerSubmission{
+		Answer:  req.Answer,
+		Order:   req.Order,
+		Matches: req.Matches,
+		Item:    req.Item,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Flashcard not found or access denied"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"result":       result,
+		"errorMessage": "",
+	})
+}
+
+// GetChoices handles GET /api/v1/flashcards/:id/choices
+func (c *FlashcardReviewController) GetChoices(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	count := 0
+	if countStr := ctx.Query("count"); countStr != "" {
+		count, err = strconv.Atoi(countStr)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid count"})
+			return
+		}
+	}
+
+	var seed *int64
+	if seedStr := ctx.Query("seed"); seedStr != "" {
+		parsed, err := strconv.ParseInt(seedStr, 10, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid seed"})
+			return
+		}
+		seed = &parsed
+	}
+
+	choices, usedSeed, err := c.reviewService.GetChoices(ctx.Request.Context(), flashcardID, userID, count, seed)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"choices":      choices,
+		"seed":         usedSeed,
+		"errorMessage": "",
+	})
+}
+
 // GetDueCards handles GET /api/v1/collections/:id/due
 func (c *FlashcardReviewController) GetDueCards(ctx *gin.Context) {
 	userID, ok := middleware.GetUserIDFromContext(ctx)
@@ -84,7 +170,12 @@ func (c *FlashcardReviewController) GetDueCards(ctx *gin.Context) {
 		}
 	}
 
-	reviews, err := c.reviewService.GetDueCards(ctx.Request.Context(), collectionID, userID, limit)
+	filter := repository.FlashcardFilter{
+		Tags:        ctx.QueryArray("tag"),
+		Annotations: annotationFilterFromQuery(ctx),
+	}
+
+	reviews, err := c.reviewService.GetDueCards(ctx.Request.Context(), collectionID, userID, limit, filter)
 	if err != nil {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
 		return
@@ -127,6 +218,7 @@ func (c *FlashcardReviewController) GetCollectionStats(ctx *gin.Context) {
 			"average_ease":   stats.AverageEase,
 			"total_reviews":  stats.TotalReviews,
 			"total_lapses":   stats.TotalLapses,
+			"hinted_reviews": stats.HintedReviews,
 			"mature_cards":   stats.MatureCards,
 		},
 		"errorMessage": "",
@@ -218,27 +310,42 @@ func (c *FlashcardReviewController) ClearProgress(ctx *gin.Context) {
 // Helper types and functions for response formatting
 
 type flashcardReviewResponse struct {
-	ID             string              `json:"id"`
-	UserID         string              `json:"user_id"`
-	FlashcardID    string              `json:"flashcard_id"`
-	EaseFactor     float64             `json:"ease_factor"`
-	Interval       int                 `json:"interval"`
-	DueAt          string              `json:"due_at"`
-	Status         string              `json:"status"`
-	LearningStep   int                 `json:"learning_step"`
-	ReviewCount    int                 `json:"review_count"`
-	LapseCount     int                 `json:"lapse_count"`
-	LastReviewedAt *string             `json:"last_reviewed_at,omitempty"`
-	CreatedAt      string              `json:"created_at"`
-	UpdatedAt      string              `json:"updated_at"`
-	Flashcard      *flashcardInReview  `json:"flashcard,omitempty"`
+	ID             string             `json:"id"`
+	UserID         string             `json:"user_id"`
+	FlashcardID    string             `json:"flashcard_id"`
+	Item           int                `json:"item"`
+	EaseFactor     float64            `json:"ease_factor"`
+	Interval       int                `json:"interval"`
+	DueAt          string             `json:"due_at"`
+	Status         string             `json:"status"`
+	LearningStep   int                `json:"learning_step"`
+	ReviewCount    int                `json:"review_count"`
+	LapseCount     int                `json:"lapse_count"`
+	HintCount      int                `json:"hint_count"`
+	LastReviewedAt *string            `json:"last_reviewed_at,omitempty"`
+	CreatedAt      string             `json:"created_at"`
+	UpdatedAt      string             `json:"updated_at"`
+	Flashcard      *flashcardInReview `json:"flashcard,omitempty"`
 }
 
 type flashcardInReview struct {
-	ID       string `json:"id"`
-	Question string `json:"question"`
-	Answer   string `json:"answer"`
-	Type     string `json:"type"`
+	ID              string                   `json:"id"`
+	Question        string                   `json:"question"`
+	Answer          string                   `json:"answer"`
+	Type            string                   `json:"type"`
+	Format          string                   `json:"content_format"`
+	QuestionHTML    string                   `json:"question_html"`
+	AnswerHTML      string                   `json:"answer_html"`
+	Hint            string                   `json:"hint,omitempty"`
+	HintHTML        string                   `json:"hint_html,omitempty"`
+	Explanation     string                   `json:"explanation,omitempty"`
+	ExplanationHTML string                   `json:"explanation_html,omitempty"`
+	Sources         []schema.SourceReference `json:"sources,omitempty"`
+	Options         []string                 `json:"options,omitempty"`
+	Pairs           []schema.MatchPair       `json:"pairs,omitempty"`
+	Occlusion       *service.OcclusionRender `json:"occlusion,omitempty"`
+	Tags            []string                 `json:"tags,omitempty"`
+	Annotation      *ent.FlashcardAnnotation `json:"annotation,omitempty"` // The learner's own
 }
 
 func toReviewResponse(review *ent.FlashcardReview) flashcardReviewResponse {
@@ -246,6 +353,7 @@ func toReviewResponse(review *ent.FlashcardReview) flashcardReviewResponse {
 		ID:           review.ID.String(),
 		UserID:       review.UserID,
 		FlashcardID:  review.FlashcardID.String(),
+		Item:         review.Item,
 		EaseFactor:   review.EaseFactor,
 		Interval:     review.Interval,
 		DueAt:        review.DueAt.Format("2006-01-02T15:04:05Z07:00"),
@@ -253,6 +361,7 @@ func toReviewResponse(review *ent.FlashcardReview) flashcardReviewResponse {
 		LearningStep: review.LearningStep,
 		ReviewCount:  review.ReviewCount,
 		LapseCount:   review.LapseCount,
+		HintCount:    review.HintCount,
 		CreatedAt:    review.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:    review.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
@@ -265,10 +374,30 @@ func toReviewResponse(review *ent.FlashcardReview) flashcardReviewResponse {
 	// Include flashcard if loaded
 	if review.Edges.Flashcard != nil {
 		response.Flashcard = &flashcardInReview{
-			ID:       review.Edges.Flashcard.ID.String(),
-			Question: review.Edges.Flashcard.Question,
-			Answer:   review.Edges.Flashcard.Answer,
-			Type:     review.Edges.Flashcard.Type,
+			ID:              review.Edges.Flashcard.ID.String(),
+			Question:        review.Edges.Flashcard.Question,
+			Answer:          review.Edges.Flashcard.Answer,
+			Type:            review.Edges.Flashcard.Type,
+			Format:          review.Edges.Flashcard.ContentFormat,
+			QuestionHTML:    review.Edges.Flashcard.QuestionHTML,
+			AnswerHTML:      review.Edges.Flashcard.AnswerHTML,
+			Hint:            review.Edges.Flashcard.Hint,
+			HintHTML:        review.Edges.Flashcard.HintHTML,
+			Explanation:     review.Edges.Flashcard.Explanation,
+			ExplanationHTML: review.Edges.Flashcard.ExplanationHTML,
+			Sources:         review.Edges.Flashcard.Sources,
+			Options:         review.Edges.Flashcard.Options,
+			Pairs:           review.Edges.Flashcard.Pairs,
+		}
+		if render, ok := service.RenderOcclusionItem(review.Edges.Flashcard, review.Item); ok {
+			response.Flashcard.Occlusion = render
+		}
+		for _, tag := range review.Edges.Flashcard.Edges.Tags {
+			response.Flashcard.Tags = append(response.Flashcard.Tags, tag.Name)
+		}
+		// Only the learner's annotation is loaded with the due queue
+		if annotations := review.Edges.Flashcard.Edges.Annotations; len(annotations) > 0 {
+			response.Flashcard.Annotation = annotations[0]
 		}
 	}
 
@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/internal/middleware"
+	"github.com/quanphung1120/advanced-quiz-be/internal/service"
+)
+
+// Room for the multipart headers and boundaries around an uploaded file
+const multipartOverhead = 1 << 20
+
+type MediaController struct {
+	mediaService service.MediaService
+}
+
+func NewMediaController(mediaService service.MediaService) *MediaController {
+	return &MediaController{
+		mediaService: mediaService,
+	}
+}
+
+// UploadMedia handles POST /api/v1/media
+func (c *MediaController) UploadMedia(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	// Stops reading oversized uploads instead of spooling them to disk first
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, service.MaxMediaSize+multipartOverhead)
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			ctx.JSON(http.StatusRequestEntityTooLarge, gin.H{"errorMessage": fmt.Sprintf("File exceeds the %d MB limit", service.MaxMediaSize>>20)})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "A file is required"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid file"})
+		return
+	}
+	defer file.Close()
+
+	media, err := c.mediaService.Upload(ctx.Request.Context(), userID, file)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"media":        media,
+		"errorMessage": "",
+	})
+}
+
+// GetDownloadURL handles GET /api/v1/media/:id/url
+func (c *MediaController) GetDownloadURL(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	mediaIDStr := ctx.Param("id")
+	mediaID, err := uuid.Parse(mediaIDStr)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid media ID"})
+		return
+	}
+
+	url, expiresAt, err := c.mediaService.GetDownloadURL(ctx.Request.Context(), mediaID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Media not found or access denied"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"url":          url,
+		"expires_at":   expiresAt.Format("2006-01-02T15:04:05Z07:00"),
+		"errorMessage": "",
+	})
+}
+
+// Download handles GET /media/:id using a signed URL from GetDownloadURL
+func (c *MediaController) Download(ctx *gin.Context) {
+	mediaID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid media ID"})
+		return
+	}
+
+	expires, err := strconv.ParseInt(ctx.Query("expires"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid download link"})
+		return
+	}
+
+	media, content, err := c.mediaService.OpenSigned(ctx.Request.Context(), mediaID, expires, ctx.Query("signature"))
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
+		return
+	}
+	defer content.Close()
+
+	ctx.Header("Cache-Control", "private, max-age=900")
+	ctx.Header("ETag", `"`+media.Hash+`"`)
+	ctx.DataFromReader(http.StatusOK, media.Size, media.ContentType, io.NopCloser(content), nil)
+}
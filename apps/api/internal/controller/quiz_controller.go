@@ -0,0 +1,421 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/internal/data/request"
+	"github.com/quanphung1120/advanced-quiz-be/internal/middleware"
+	"github.com/quanphung1120/advanced-quiz-be/internal/service"
+)
+
+type QuizController struct {
+	quizService service.QuizService
+}
+
+func NewQuizController(quizService service.QuizService) *QuizController {
+	return &QuizController{
+		quizService: quizService,
+	}
+}
+
+// CreateQuiz handles POST /api/v1/collections/:id/quizzes
+func (c *QuizController) CreateQuiz(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	var req request.CreateQuizRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	quiz, err := c.quizService.CreateQuiz(ctx.Request.Context(), collectionID, userID, service.QuizSettings{
+		Title:         req.Title,
+		Selection:     req.Selection,
+		QuestionCount: req.QuestionCount,
+		TypeCounts:    req.TypeCounts,
+		Tags:          req.Tags,
+		ChoiceCount:   req.ChoiceCount,
+		Seed:          req.Seed,
+		Shared:        req.Shared,
+		Adaptive:      req.Adaptive,
+
+		TimeLimitSeconds: req.TimeLimitSeconds,
+		OpensAt:          req.OpensAt,
+		ClosesAt:         req.ClosesAt,
+		MaxAttempts:      req.MaxAttempts,
+
+		ReviewFeedback:    req.ReviewFeedback,
+		SlowAnswerSeconds: req.SlowAnswerSeconds,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"quiz":         quiz,
+		"errorMessage": "",
+	})
+}
+
+// ListQuizzes handles GET /api/v1/collections/:id/quizzes
+func (c *QuizController) ListQuizzes(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	quizzes, err := c.quizService.ListQuizzes(ctx.Request.Context(), collectionID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Collection not found or access denied"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"quizzes":      quizzes,
+		"errorMessage": "",
+	})
+}
+
+// GetQuiz handles GET /api/v1/quizzes/:id
+func (c *QuizController) GetQuiz(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	quizID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid quiz ID"})
+		return
+	}
+
+	quiz, err := c.quizService.GetQuiz(ctx.Request.Context(), quizID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Quiz not found or access denied"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"quiz":         quiz,
+		"errorMessage": "",
+	})
+}
+
+// DeleteQuiz handles DELETE /api/v1/quizzes/:id
+func (c *QuizController) DeleteQuiz(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	quizID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid quiz ID"})
+		return
+	}
+
+	if err := c.quizService.DeleteQuiz(ctx.Request.Context(), quizID, userID); err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":      "Quiz deleted successfully",
+		"errorMessage": "",
+	})
+}
+
+// StartAttempt handles POST /api/v1/quizzes/:id/attempts
+func (c *QuizController) StartAttempt(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	quizID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid quiz ID"})
+		return
+	}
+
+	attempt, err := c.quizService.StartAttempt(ctx.Request.Context(), quizID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, gin.H{
+		"attempt":      attempt,
+		"errorMessage": "",
+	})
+}
+
+// ListAttempts handles GET /api/v1/quizzes/:id/attempts
+func (c *QuizController) ListAttempts(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	quizID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid quiz ID"})
+		return
+	}
+
+	attempts, err := c.quizService.ListAttempts(ctx.Request.Context(), quizID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Quiz not found or access denied"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"attempts":     attempts,
+		"errorMessage": "",
+	})
+}
+
+// GetAttempt handles GET /api/v1/quiz-attempts/:id
+func (c *QuizController) GetAttempt(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	attemptID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid attempt ID"})
+		return
+	}
+
+	attempt, err := c.quizService.GetAttempt(ctx.Request.Context(), attemptID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Attempt not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"attempt":      attempt,
+		"errorMessage": "",
+	})
+}
+
+// AnswerQuestion handles POST /api/v1/quiz-attempts/:id/answers
+func (c *QuizController) AnswerQuestion(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	attemptID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid attempt ID"})
+		return
+	}
+
+	var req request.AnswerQuizQuestionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid input"})
+		return
+	}
+
+	result, err := c.quizService.AnswerQuestion(ctx.Request.Context(), attemptID, userID, req.Position, service.AnswerSubmission{
+		Answer:  req.Answer,
+		Order:   req.Order,
+		Matches: req.Matches,
+	})
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"question":      result.Question,
+		"next_question": result.Next,
+		"finished":      result.Finished,
+		"errorMessage":  "",
+	})
+}
+
+// SubmitAttempt handles POST /api/v1/quiz-attempts/:id/submit
+func (c *QuizController) SubmitAttempt(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	attemptID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid attempt ID"})
+		return
+	}
+
+	attempt, err := c.quizService.SubmitAttempt(ctx.Request.Context(), attemptID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"attempt":      attempt,
+		"errorMessage": "",
+	})
+}
+
+// ReviewAttempt handles GET /api/v1/quiz-attempts/:id/review
+func (c *QuizController) ReviewAttempt(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	attemptID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid attempt ID"})
+		return
+	}
+
+	attempt, err := c.quizService.ReviewAttempt(ctx.Request.Context(), attemptID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"attempt":      attempt,
+		"errorMessage": "",
+	})
+}
+
+// ListReviewUpdates handles GET /api/v1/quiz-attempts/:id/review-updates
+func (c *QuizController) ListReviewUpdates(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	attemptID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid attempt ID"})
+		return
+	}
+
+	updates, err := c.quizService.ListReviewUpdates(ctx.Request.Context(), attemptID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": "Attempt not found"})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"updates":      updates,
+		"errorMessage": "",
+	})
+}
+
+// RevertReviewUpdates handles POST /api/v1/quiz-attempts/:id/review-updates/revert
+func (c *QuizController) RevertReviewUpdates(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	attemptID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid attempt ID"})
+		return
+	}
+
+	reverted, kept, err := c.quizService.RevertReviewUpdates(ctx.Request.Context(), attemptID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"reverted":     reverted,
+		"kept":         kept,
+		"errorMessage": "",
+	})
+}
+
+// GetQuizItemAnalysis handles GET /api/v1/quizzes/:id/item-analysis
+func (c *QuizController) GetQuizItemAnalysis(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	quizID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid quiz ID"})
+		return
+	}
+
+	items, err := c.quizService.GetQuizItemAnalysis(ctx.Request.Context(), quizID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"items":        items,
+		"errorMessage": "",
+	})
+}
+
+// GetFlashcardItemAnalysis handles GET /api/v1/flashcards/:id/item-analysis
+func (c *QuizController) GetFlashcardItemAnalysis(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	items, err := c.quizService.GetFlashcardItemAnalysis(ctx.Request.Context(), flashcardID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"items":        items,
+		"errorMessage": "",
+	})
+}
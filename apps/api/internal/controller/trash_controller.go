@@ -0,0 +1,158 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/internal/middleware"
+	"github.com/quanphung1120/advanced-quiz-be/internal/service"
+)
+
+type TrashController struct {
+	trashService service.TrashService
+}
+
+func NewTrashController(trashService service.TrashService) *TrashController {
+	return &TrashController{
+		trashService: trashService,
+	}
+}
+
+// ListTrash handles GET /api/v1/trash
+func (c *TrashController) ListTrash(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	trash, err := c.trashService.ListTrash(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"collections":    trash.Collections,
+		"flashcards":     trash.Flashcards,
+		"retention_days": int(service.TrashRetention.Hours() / 24),
+		"errorMessage":   "",
+	})
+}
+
+// RestoreCollection handles POST /api/v1/trash/collections/:id/restore
+func (c *TrashController) RestoreCollection(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	if err := c.trashService.RestoreCollection(ctx.Request.Context(), collectionID, userID); err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":      "Collection restored successfully",
+		"errorMessage": "",
+	})
+}
+
+// RestoreFlashcard handles POST /api/v1/trash/flashcards/:id/restore
+func (c *TrashController) RestoreFlashcard(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	flashcardID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid flashcard ID"})
+		return
+	}
+
+	if err := c.trashService.RestoreFlashcard(ctx.Request.Context(), flashcardID, userID); err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":      "Flashcard restored successfully",
+		"errorMessage": "",
+	})
+}
+
+// DeleteCollection handles DELETE /api/v1/trash/collections/:id
+func (c *TrashController) DeleteCollection(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	collectionID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid collection ID"})
+		return
+	}
+
+	job, err := c.trashService.DeleteCollectionPermanently(ctx.Request.Context(), collectionID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	if job != nil {
+		ctx.JSON(http.StatusAccepted, gin.H{
+			"job":          job,
+			"errorMessage": "",
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"message":      "Collection deleted permanently",
+		"errorMessage": "",
+	})
+}
+
+// GetDeletionJob handles GET /api/v1/trash/jobs/:id
+func (c *TrashController) GetDeletionJob(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	jobID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"errorMessage": "Invalid job ID"})
+		return
+	}
+
+	job, err := c.trashService.GetDeletionJob(ctx.Request.Context(), jobID, userID)
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	progress := 1.0
+	if job.TotalFlashcards > 0 {
+		progress = min(float64(job.DeletedFlashcards)/float64(job.TotalFlashcards), 1)
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"job":          job,
+		"progress":     progress,
+		"errorMessage": "",
+	})
+}
@@ -79,3 +79,23 @@ func (c *UserController) SearchEmailAddresses(ctx *gin.Context) {
 		"emails": emails,
 	})
 }
+
+// ExportPersonalData handles GET /api/v1/users/me/export
+func (c *UserController) ExportPersonalData(ctx *gin.Context) {
+	userID, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		ctx.JSON(http.StatusUnauthorized, gin.H{"errorMessage": "Unauthorized"})
+		return
+	}
+
+	export, err := c.userService.ExportPersonalData(ctx.Request.Context(), userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"errorMessage": err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{
+		"export":       export,
+		"errorMessage": "",
+	})
+}
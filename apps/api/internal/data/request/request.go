@@ -1,10 +1,25 @@
 package request
 
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
 // CreateCollectionRequest represents a collection creation request
 type CreateCollectionRequest struct {
 	Name        string `json:"name" binding:"required"`
 	Description string `json:"description"`
 	IsPublic    bool   `json:"is_public"`
+
+	ParentID *uuid.UUID `json:"parent_id"` // Optional, creates a sub-collection
+}
+
+// MoveCollectionRequest represents moving a collection under a new parent. A null
+// parent_id moves it to the top level.
+type MoveCollectionRequest struct {
+	ParentID *uuid.UUID `json:"parent_id"`
 }
 
 // UpdateCollectionRequest represents a collection update request
@@ -14,6 +29,27 @@ type UpdateCollectionRequest struct {
 	IsPublic    *bool  `json:"is_public"`
 }
 
+// MergeCollectionsRequest represents merging collections into the one in the path
+type MergeCollectionsRequest struct {
+	SourceIDs []uuid.UUID `json:"source_ids" binding:"required"`
+	Preview   bool        `json:"preview"` // Only describe the result
+}
+
+// SplitCollectionRequest represents splitting flashcards off into new collections
+type SplitCollectionRequest struct {
+	Parts   []SplitPartRequest `json:"parts" binding:"required"`
+	Preview bool               `json:"preview"` // Only describe the result
+}
+
+// SplitPartRequest represents one new collection of a split, selecting flashcards
+// by tag (including descendant tags) and/or by ID
+type SplitPartRequest struct {
+	Name         string      `json:"name" binding:"required"`
+	Description  string      `json:"description"`
+	Tags         []string    `json:"tags"`
+	FlashcardIDs []uuid.UUID `json:"flashcard_ids"`
+}
+
 // AddCollaboratorRequest represents a request to add a collaborator
 type AddCollaboratorRequest struct {
 	Email  string `json:"email" binding:"required"`
@@ -23,19 +59,156 @@ type AddCollaboratorRequest struct {
 
 // CreateFlashcardRequest represents a flashcard creation request
 type CreateFlashcardRequest struct {
-	Question string `json:"question" binding:"required"`
-	Answer   string `json:"answer" binding:"required"`
-	Type     string `json:"type"` // Optional, defaults to "simple"
+	Question      string                   `json:"question" binding:"required"`
+	Answer        string                   `json:"answer"`         // Derived from options/pairs for ordering and matching cards
+	Type          string                   `json:"type"`           // Optional, defaults to "simple"
+	ContentFormat string                   `json:"content_format"` // plain (default), markdown or html
+	Options       []string                 `json:"options"`        // multiple_choice and ordering cards
+	Pairs         []schema.MatchPair       `json:"pairs"`          // matching cards
+	Occlusion     *schema.ImageOcclusion   `json:"occlusion"`      // image_occlusion cards
+	MediaIDs      []uuid.UUID              `json:"media_ids"`      // Uploaded image and audio attachments
+	Hint          *string                  `json:"hint"`           // Revealed on request before answering
+	Explanation   *string                  `json:"explanation"`    // Shown after answering
+	Sources       []schema.SourceReference `json:"sources"`        // Citations such as a book and page
+	Status        string                   `json:"status"`         // draft or published (default)
+
+	AllowDuplicates bool `json:"allow_duplicates"` // Create even if similar questions exist
 }
 
 // UpdateFlashcardRequest represents a flashcard update request
 type UpdateFlashcardRequest struct {
-	Question string `json:"question"`
-	Answer   string `json:"answer"`
-	Type     string `json:"type"`
+	Question      string                   `json:"question"`
+	Answer        string                   `json:"answer"`
+	Type          string                   `json:"type"`
+	ContentFormat string                   `json:"content_format"`
+	Options       []string                 `json:"options"`
+	Pairs         []schema.MatchPair       `json:"pairs"`
+	Occlusion     *schema.ImageOcclusion   `json:"occlusion"`
+	MediaIDs      []uuid.UUID              `json:"media_ids"` // Omit to keep the current attachments
+	Hint          *string                  `json:"hint"`      // Omit to keep the current hint, "" to remove it
+	Explanation   *string                  `json:"explanation"`
+	Sources       []schema.SourceReference `json:"sources"` // Omit to keep the current sources
+}
+
+// TransferFlashcardsRequest represents moving or copying flashcards to another
+// collection
+type TransferFlashcardsRequest struct {
+	FlashcardIDs       []uuid.UUID `json:"flashcard_ids" binding:"required"`
+	TargetCollectionID uuid.UUID   `json:"target_collection_id" binding:"required"`
+	CopyProgress       bool        `json:"copy_progress"` // Copy only: duplicate your own review progress
+}
+
+// FlashcardBatchRequest represents a batch of flashcard operations
+type FlashcardBatchRequest struct {
+	Atomic     *bool                       `json:"atomic"` // Optional, defaults to true (all-or-nothing)
+	Operations []FlashcardOperationRequest `json:"operations" binding:"required"`
+}
+
+// FlashcardOperationRequest represents one operation of a flashcard batch. The
+// content fields apply to create and update, as in the single flashcard requests.
+type FlashcardOperationRequest struct {
+	Op            string                   `json:"op" binding:"required"` // create, update or delete
+	ID            uuid.UUID                `json:"id"`                    // update and delete
+	Question      string                   `json:"question"`
+	Answer        string                   `json:"answer"`
+	Type          string                   `json:"type"`
+	ContentFormat string                   `json:"content_format"`
+	Options       []string                 `json:"options"`
+	Pairs         []schema.MatchPair       `json:"pairs"`
+	Occlusion     *schema.ImageOcclusion   `json:"occlusion"`
+	MediaIDs      []uuid.UUID              `json:"media_ids"`
+	Hint          *string                  `json:"hint"`
+	Explanation   *string                  `json:"explanation"`
+	Sources       []schema.SourceReference `json:"sources"`
+	Status        string                   `json:"status"` // create only: draft or published (default)
 }
 
 // SubmitReviewRequest represents a flashcard review submission
 type SubmitReviewRequest struct {
-	Rating int `json:"rating" binding:"gte=0,lte=3"`
+	Rating   int  `json:"rating" binding:"gte=0,lte=3"`
+	Item     int  `json:"item" binding:"gte=0"` // Review item, the mask index for image occlusion cards
+	HintUsed bool `json:"hint_used"`            // The hint was revealed before answering
+}
+
+// GradeAnswerRequest represents an answer to be graded; which field is used
+// depends on the flashcard type
+type GradeAnswerRequest struct {
+	Answer  string             `json:"answer" binding:"max=1000"`
+	Order   []string           `json:"order"`
+	Matches []schema.MatchPair `json:"matches"`
+	Item    int                `json:"item"`
+}
+
+// TagFlashcardsRequest represents adding or removing tags on flashcards in bulk
+type TagFlashcardsRequest struct {
+	FlashcardIDs []uuid.UUID `json:"flashcard_ids" binding:"required,min=1,max=1000"`
+	Tags         []string    `json:"tags" binding:"required,min=1"`
+}
+
+// FlashcardStatusRequest represents publishing or unpublishing flashcards in bulk.
+// Set all to change every flashcard of the collection instead.
+type FlashcardStatusRequest struct {
+	FlashcardIDs []uuid.UUID `json:"flashcard_ids"`
+	All          bool        `json:"all"`
+}
+
+// RenameTagRequest represents renaming or merging a tag across a collection
+type RenameTagRequest struct {
+	From string `json:"from" binding:"required"`
+	To   string `json:"to" binding:"required"`
+}
+
+// MoveFlashcardRequest represents moving a flashcard between new neighbors. Omit
+// after_id to move it to the start, or before_id to move it to the end. Set
+// collection_id to move it to another collection, such as a sub-collection.
+type MoveFlashcardRequest struct {
+	CollectionID *uuid.UUID `json:"collection_id"`
+	AfterID      *uuid.UUID `json:"after_id"`
+	BeforeID     *uuid.UUID `json:"before_id"`
+}
+
+// MergeDuplicatesRequest represents merging duplicate flashcards into one
+type MergeDuplicatesRequest struct {
+	KeepID       uuid.UUID   `json:"keep_id" binding:"required"`
+	DuplicateIDs []uuid.UUID `json:"duplicate_ids" binding:"required"`
+}
+
+// AnnotateFlashcardRequest represents the private note, flag and bookmark of a
+// flashcard. It replaces the previous annotation; leave flag empty for no flag.
+type AnnotateFlashcardRequest struct {
+	Note       string `json:"note"`
+	Flag       string `json:"flag"`
+	Bookmarked bool   `json:"bookmarked"`
+}
+
+// CreateQuizRequest represents generating a quiz from a collection
+type CreateQuizRequest struct {
+	Title         string         `json:"title"`
+	Selection     string         `json:"selection"` // random (default), weakest or tagged
+	QuestionCount int            `json:"question_count" binding:"required,min=1"`
+	TypeCounts    map[string]int `json:"type_counts"` // e.g. {"multiple_choice": 5}; the rest may be of any type
+	Tags          []string       `json:"tags"`
+	ChoiceCount   int            `json:"choice_count"` // Ask simple cards as multiple choice with this many options
+	Seed          *int64         `json:"seed"`         // Omit for a random seed
+	Shared        bool           `json:"shared"`       // Editors only
+	Adaptive      bool           `json:"adaptive"`     // Pick questions by ability; question_count is then the most asked
+
+	// Exam settings; omit for an unrestricted quiz
+	TimeLimitSeconds int        `json:"time_limit_seconds" binding:"gte=0"`
+	OpensAt          *time.Time `json:"opens_at"`
+	ClosesAt         *time.Time `json:"closes_at"`
+	MaxAttempts      int        `json:"max_attempts" binding:"gte=0"`
+
+	// Spaced repetition feedback; off by default
+	ReviewFeedback    bool `json:"review_feedback"`                     // Wrong answers count as Again, slow correct ones as Hard
+	SlowAnswerSeconds int  `json:"slow_answer_seconds" binding:"gte=0"` // Defaults to 30
+}
+
+// AnswerQuizQuestionRequest represents an answer to a question of a quiz attempt;
+// which field is used depends on the flashcard type
+type AnswerQuizQuestionRequest struct {
+	Position int                `json:"position" binding:"gte=0"`
+	Answer   string             `json:"answer" binding:"max=1000"`
+	Order    []string           `json:"order"`
+	Matches  []schema.MatchPair `json:"matches"`
 }
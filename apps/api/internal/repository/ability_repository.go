@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// AbilityRepository defines the interface for access to the Elo-style estimates of
+// learner ability and flashcard difficulty used by adaptive quizzes
+type AbilityRepository interface {
+	// GetAbility returns the user's ability estimate in a collection, or a new one
+	// at 0 when the user has not answered adaptively yet
+	GetAbility(ctx context.Context, userID string, collectionID uuid.UUID) (*ent.LearnerAbility, error)
+
+	// ListDifficulties returns the difficulty estimates of the flashcards that have
+	// one, by flashcard ID
+	ListDifficulties(ctx context.Context, flashcardIDs []uuid.UUID) (map[uuid.UUID]*ent.FlashcardRating, error)
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrating"
+	"github.com/quanphung1120/advanced-quiz-be/ent/learnerability"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// AbilityRepositoryImpl implements AbilityRepository using Ent ORM
+type AbilityRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewAbilityRepository(client *ent.Client) AbilityRepository {
+	return &AbilityRepositoryImpl{client: client}
+}
+
+func (r *AbilityRepositoryImpl) GetAbility(ctx context.Context, userID string, collectionID uuid.UUID) (*ent.LearnerAbility, error) {
+	ability, err := r.client.LearnerAbility.
+		Query().
+		Where(
+			learnerability.UserID(userID),
+			learnerability.CollectionID(collectionID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return &ent.LearnerAbility{UserID: userID, CollectionID: collectionID}, nil
+	}
+	return ability, err
+}
+
+func (r *AbilityRepositoryImpl) ListDifficulties(ctx context.Context, flashcardIDs []uuid.UUID) (map[uuid.UUID]*ent.FlashcardRating, error) {
+	ratings, err := r.client.FlashcardRating.
+		Query().
+		Where(flashcardrating.FlashcardIDIn(flashcardIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byFlashcard := make(map[uuid.UUID]*ent.FlashcardRating, len(ratings))
+	for _, rating := range ratings {
+		byFlashcard[rating.FlashcardID] = rating
+	}
+	return byFlashcard, nil
+}
+
+// lockRatings returns the ability estimate of the user in the collection and the
+// difficulty estimate of the flashcard, creating them at 0 when missing, and locks
+// both so that concurrent answers update them one at a time
+func lockRatings(ctx context.Context, client *ent.Client, userID string, collectionID, flashcardID uuid.UUID) (*ent.LearnerAbility, *ent.FlashcardRating, error) {
+	// Concurrent first answers would both create the rows otherwise
+	if _, err := client.ExecContext(ctx, `
+		INSERT INTO learner_abilities (id, user_id, collection_id, ability, answer_count, updated_at)
+		VALUES ($1, $2, $3, 0, 0, now())
+		ON CONFLICT (user_id, collection_id) DO NOTHING`,
+		schema.NewUUIDV7(), userID, collectionID,
+	); err != nil {
+		return nil, nil, err
+	}
+	if _, err := client.ExecContext(ctx, `
+		INSERT INTO flashcard_ratings (id, flashcard_id, difficulty, answer_count, updated_at)
+		VALUES ($1, $2, 0, 0, now())
+		ON CONFLICT (flashcard_id) DO NOTHING`,
+		schema.NewUUIDV7(), flashcardID,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	learner, err := client.LearnerAbility.
+		Query().
+		Where(
+			learnerability.UserID(userID),
+			learnerability.CollectionID(collectionID),
+		).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rating, err := client.FlashcardRating.
+		Query().
+		Where(flashcardrating.FlashcardID(flashcardID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return learner, rating, nil
+}
+
+// recordRatings stores the estimates updated after an answer and counts the answer
+// towards both
+func recordRatings(ctx context.Context, learner *ent.LearnerAbility, rating *ent.FlashcardRating, ability, difficulty float64) error {
+	if err := learner.Update().SetAbility(ability).AddAnswerCount(1).Exec(ctx); err != nil {
+		return err
+	}
+	return rating.Update().SetDifficulty(difficulty).AddAnswerCount(1).Exec(ctx)
+}
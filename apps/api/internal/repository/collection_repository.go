@@ -2,21 +2,70 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
 )
 
+// MaxCollectionDepth is the maximum number of levels in a collection tree
+const MaxCollectionDepth = 10
+
+// CollaboratorRole is a user's role on a collection
+type CollaboratorRole struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// CollectionSplitPart is a new collection split off an existing one
+type CollectionSplitPart struct {
+	Name         string
+	Description  string
+	FlashcardIDs []uuid.UUID
+}
+
 // CollectionRepository defines the interface for collection data access
 type CollectionRepository interface {
-	Create(ctx context.Context, name, description, ownerID string, isPublic bool) (*ent.Collection, error)
+	Create(ctx context.Context, name, description, ownerID string, isPublic bool, parentID *uuid.UUID) (*ent.Collection, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Collection, error)
 	Update(ctx context.Context, id uuid.UUID, name, description string, isPublic bool) (*ent.Collection, error)
 	Delete(ctx context.Context, id uuid.UUID) error
+	CountFlashcards(ctx context.Context, id uuid.UUID) (int, error)
+	CountLiveFlashcards(ctx context.Context, id uuid.UUID) (int, error)
+	DeleteFlashcardBatch(ctx context.Context, id uuid.UUID, limit int) (int, error)
 	ListByOwner(ctx context.Context, ownerID string) ([]*ent.Collection, error)
 	ListSharedWithUser(ctx context.Context, userID string) ([]*ent.Collection, error)
 	UpdateVisibility(ctx context.Context, id uuid.UUID, isPublic bool) error
 
+	// Hierarchy methods
+	SetParent(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*ent.Collection, error)
+
+	// ListAncestors returns the live ancestors of a collection with their
+	// collaborators, nearest first
+	ListAncestors(ctx context.Context, id uuid.UUID) ([]*ent.Collection, error)
+
+	// ListDescendantLevels returns the IDs of the live descendants of a collection,
+	// one slice per level below it
+	ListDescendantLevels(ctx context.Context, id uuid.UUID) ([][]uuid.UUID, error)
+
+	// Merge moves every flashcard, tag and sub-collection of the sources into the
+	// target, sets the target's collaborators to the given roles and moves the
+	// emptied sources to the trash, in one transaction
+	Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID, collaborators []CollaboratorRole, deletedBy string) error
+
+	// Split creates a sibling collection of the source for every part, with the
+	// same owner, visibility and collaborators, and moves the part's flashcards to
+	// it, in one transaction
+	Split(ctx context.Context, source *ent.Collection, parts []CollectionSplitPart) ([]*ent.Collection, error)
+
+	// Trash methods. A collection is trashed and restored together with its
+	// sub-collections.
+	SoftDelete(ctx context.Context, id uuid.UUID, deletedBy string) error
+	Restore(ctx context.Context, id uuid.UUID) error
+	GetTrashedByID(ctx context.Context, id uuid.UUID) (*ent.Collection, error)
+	ListTrashedByOwner(ctx context.Context, ownerID string) ([]*ent.Collection, error)
+	ListTrashedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*ent.Collection, error)
+
 	// Collaborator methods
 	AddCollaborator(ctx context.Context, collectionID uuid.UUID, userID, role string) (*ent.CollectionCollaborator, error)
 	RemoveCollaborator(ctx context.Context, collectionID uuid.UUID, userID string) error
@@ -2,11 +2,17 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/collectioncollaborator"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
 )
 
 // CollectionRepositoryImpl implements CollectionRepository using Ent ORM
@@ -18,21 +24,28 @@ func NewCollectionRepository(client *ent.Client) CollectionRepository {
 	return &CollectionRepositoryImpl{client: client}
 }
 
-func (r *CollectionRepositoryImpl) Create(ctx context.Context, name, description, ownerID string, isPublic bool) (*ent.Collection, error) {
+func (r *CollectionRepositoryImpl) Create(ctx context.Context, name, description, ownerID string, isPublic bool, parentID *uuid.UUID) (*ent.Collection, error) {
 	return r.client.Collection.
 		Create().
 		SetName(name).
 		SetDescription(description).
 		SetOwnerID(ownerID).
 		SetIsPublic(isPublic).
+		SetNillableParentID(parentID).
 		Save(ctx)
 }
 
 func (r *CollectionRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.Collection, error) {
 	return r.client.Collection.
 		Query().
-		Where(collection.ID(id)).
+		Where(
+			collection.ID(id),
+			collection.DeletedAtIsNil(),
+		).
 		WithCollaborators().
+		WithChildren(func(q *ent.CollectionQuery) {
+			q.Where(collection.DeletedAtIsNil()).Order(collection.ByName())
+		}).
 		Only(ctx)
 }
 
@@ -45,16 +58,63 @@ func (r *CollectionRepositoryImpl) Update(ctx context.Context, id uuid.UUID, nam
 		Save(ctx)
 }
 
+// Delete permanently deletes a collection in a single statement. Its collaborators,
+// flashcards and everything attached to them (reviews, tags, revisions and media
+// links) are removed by the database through cascading foreign keys, so the delete
+// either fully happens or not at all. Unreferenced media blobs are then collected by
+// the media garbage collector.
 func (r *CollectionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.client.Collection.
 		DeleteOneID(id).
 		Exec(ctx)
 }
 
+// CountFlashcards counts the flashcards of a collection and its sub-collections,
+// including trashed ones
+func (r *CollectionRepositoryImpl) CountFlashcards(ctx context.Context, id uuid.UUID) (int, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(predicate.Flashcard(inTree(flashcard.FieldCollectionID, treeRoot(id), false))).
+		Count(ctx)
+}
+
+// CountLiveFlashcards counts the flashcards of a collection outside the trash
+func (r *CollectionRepositoryImpl) CountLiveFlashcards(ctx context.Context, id uuid.UUID) (int, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.CollectionID(id),
+			flashcard.DeletedAtIsNil(),
+		).
+		Count(ctx)
+}
+
+// DeleteFlashcardBatch permanently deletes up to limit flashcards of a collection
+// and its sub-collections, so that a large collection can be deleted in several
+// short transactions
+func (r *CollectionRepositoryImpl) DeleteFlashcardBatch(ctx context.Context, id uuid.UUID, limit int) (int, error) {
+	ids, err := r.client.Flashcard.
+		Query().
+		Where(predicate.Flashcard(inTree(flashcard.FieldCollectionID, treeRoot(id), false))).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+
+	return r.client.Flashcard.
+		Delete().
+		Where(flashcard.IDIn(ids...)).
+		Exec(ctx)
+}
+
 func (r *CollectionRepositoryImpl) ListByOwner(ctx context.Context, ownerID string) ([]*ent.Collection, error) {
 	return r.client.Collection.
 		Query().
-		Where(collection.OwnerID(ownerID)).
+		Where(
+			collection.OwnerID(ownerID),
+			collection.DeletedAtIsNil(),
+		).
 		WithCollaborators().
 		All(ctx)
 }
@@ -66,6 +126,7 @@ func (r *CollectionRepositoryImpl) ListSharedWithUser(ctx context.Context, userI
 			collection.HasCollaboratorsWith(
 				collectioncollaborator.UserID(userID),
 			),
+			collection.DeletedAtIsNil(),
 		).
 		WithCollaborators().
 		All(ctx)
@@ -78,6 +139,407 @@ func (r *CollectionRepositoryImpl) UpdateVisibility(ctx context.Context, id uuid
 		Exec(ctx)
 }
 
+// Hierarchy methods
+
+func (r *CollectionRepositoryImpl) SetParent(ctx context.Context, id uuid.UUID, parentID *uuid.UUID) (*ent.Collection, error) {
+	update := r.client.Collection.UpdateOneID(id)
+	if parentID != nil {
+		update.SetParentID(*parentID)
+	} else {
+		update.ClearParentID()
+	}
+
+	return update.Save(ctx)
+}
+
+func (r *CollectionRepositoryImpl) ListAncestors(ctx context.Context, id uuid.UUID) ([]*ent.Collection, error) {
+	current, err := r.client.Collection.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var ancestors []*ent.Collection
+	for parentID := current.ParentID; parentID != nil; parentID = current.ParentID {
+		// A cycle cannot be created through the API, but a bound keeps a corrupted
+		// tree from looping forever
+		if len(ancestors) >= MaxCollectionDepth {
+			return nil, errors.New("collection tree is too deep")
+		}
+
+		current, err = r.client.Collection.
+			Query().
+			Where(
+				collection.ID(*parentID),
+				collection.DeletedAtIsNil(),
+			).
+			WithCollaborators().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ancestors = append(ancestors, current)
+	}
+
+	return ancestors, nil
+}
+
+func (r *CollectionRepositoryImpl) ListDescendantLevels(ctx context.Context, id uuid.UUID) ([][]uuid.UUID, error) {
+	var levels [][]uuid.UUID
+
+	parents := []uuid.UUID{id}
+	for len(parents) > 0 {
+		if len(levels) >= MaxCollectionDepth {
+			return nil, errors.New("collection tree is too deep")
+		}
+
+		children, err := r.client.Collection.
+			Query().
+			Where(
+				collection.ParentIDIn(parents...),
+				collection.DeletedAtIsNil(),
+			).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(children) > 0 {
+			levels = append(levels, children)
+		}
+		parents = children
+	}
+
+	return levels, nil
+}
+
+func (r *CollectionRepositoryImpl) Merge(ctx context.Context, targetID uuid.UUID, sourceIDs []uuid.UUID, collaborators []CollaboratorRole, deletedBy string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := mergeCollections(ctx, tx.Client(), targetID, sourceIDs, collaborators, deletedBy); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func mergeCollections(ctx context.Context, client *ent.Client, targetID uuid.UUID, sourceIDs []uuid.UUID, collaborators []CollaboratorRole, deletedBy string) error {
+	if _, err := client.Collection.
+		Query().
+		Where(collection.IDIn(append([]uuid.UUID{targetID}, sourceIDs...)...)).
+		ForUpdate().
+		All(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, sourceID := range sourceIDs {
+		// Each source keeps its order and goes after what is already in the target
+		offset, err := lastPosition(ctx, client, targetID)
+		if err != nil {
+			return err
+		}
+
+		// Trashed flashcards move too, so they can still be restored
+		if err := client.Flashcard.
+			Update().
+			Where(flashcard.CollectionID(sourceID)).
+			SetCollectionID(targetID).
+			AddPosition(offset).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := client.FlashcardTag.
+			Update().
+			Where(flashcardtag.CollectionID(sourceID)).
+			SetCollectionID(targetID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := client.Collection.
+			Update().
+			Where(collection.ParentID(sourceID)).
+			SetParentID(targetID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if err := client.Collection.
+			UpdateOneID(sourceID).
+			SetDeletedAt(now).
+			SetDeletedBy(deletedBy).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return setCollaborators(ctx, client, targetID, collaborators)
+}
+
+// setCollaborators gives each user the role on the collection, adding them as
+// collaborators when needed
+func setCollaborators(ctx context.Context, client *ent.Client, collectionID uuid.UUID, collaborators []CollaboratorRole) error {
+	existing, err := client.CollectionCollaborator.
+		Query().
+		Where(collectioncollaborator.CollectionID(collectionID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	byUser := make(map[string]*ent.CollectionCollaborator, len(existing))
+	for _, c := range existing {
+		byUser[c.UserID] = c
+	}
+
+	for _, c := range collaborators {
+		current, ok := byUser[c.UserID]
+		switch {
+		case !ok:
+			err = client.CollectionCollaborator.
+				Create().
+				SetCollectionID(collectionID).
+				SetUserID(c.UserID).
+				SetRole(c.Role).
+				Exec(ctx)
+		case current.Role != c.Role:
+			err = client.CollectionCollaborator.
+				UpdateOneID(current.ID).
+				SetRole(c.Role).
+				Exec(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *CollectionRepositoryImpl) Split(ctx context.Context, source *ent.Collection, parts []CollectionSplitPart) ([]*ent.Collection, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := splitCollection(ctx, tx.Client(), source, parts)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return created, tx.Commit()
+}
+
+func splitCollection(ctx context.Context, client *ent.Client, source *ent.Collection, parts []CollectionSplitPart) ([]*ent.Collection, error) {
+	collaborators, err := client.CollectionCollaborator.
+		Query().
+		Where(collectioncollaborator.CollectionID(source.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]CollaboratorRole, len(collaborators))
+	for i, c := range collaborators {
+		roles[i] = CollaboratorRole{UserID: c.UserID, Role: c.Role}
+	}
+
+	created := make([]*ent.Collection, len(parts))
+	for i, part := range parts {
+		created[i], err = client.Collection.
+			Create().
+			SetName(part.Name).
+			SetDescription(part.Description).
+			SetOwnerID(source.OwnerID).
+			SetIsPublic(source.IsPublic).
+			SetNillableParentID(source.ParentID).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := setCollaborators(ctx, client, created[i].ID, roles); err != nil {
+			return nil, err
+		}
+
+		if len(part.FlashcardIDs) > 0 {
+			if _, err := moveToCollection(ctx, client, part.FlashcardIDs, created[i].ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return created, nil
+}
+
+// treeRoot selects a single collection as the root of a tree
+func treeRoot(id uuid.UUID) func(*sql.Builder) {
+	return func(b *sql.Builder) {
+		b.WriteString("id = ").Arg(id)
+	}
+}
+
+// sharedWith selects the collections the user owns or collaborates on with one of
+// the roles; no roles means any role
+func sharedWith(userID string, roles ...string) func(*sql.Builder) {
+	return func(b *sql.Builder) {
+		b.WriteString("owner_id = ").Arg(userID).
+			WriteString(" OR id IN (SELECT collection_id FROM " + collectioncollaborator.Table + " WHERE user_id = ").Arg(userID)
+		if len(roles) > 0 {
+			b.WriteString(" AND role IN (")
+			for i, role := range roles {
+				if i > 0 {
+					b.Comma()
+				}
+				b.Arg(role)
+			}
+			b.WriteString(")")
+		}
+		b.WriteString(")")
+	}
+}
+
+// inTree matches rows whose column holds a collection in the trees rooted at the
+// collections selected by roots. With live set, trashed sub-collections and
+// everything below them are left out.
+func inTree(column string, roots func(*sql.Builder), live bool) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(column)).
+				WriteString(" IN (WITH RECURSIVE tree(id) AS (SELECT id FROM " + collection.Table + " WHERE (")
+			roots(b)
+			b.WriteString(") UNION SELECT child.id FROM " + collection.Table + " AS child JOIN tree ON child.parent_id = tree.id")
+			if live {
+				b.WriteString(" WHERE child.deleted_at IS NULL")
+			}
+			b.WriteString(") SELECT id FROM tree)")
+		}))
+	}
+}
+
+// FlashcardInTree matches the live flashcards of a collection and its live
+// sub-collections
+func FlashcardInTree(collectionID uuid.UUID) predicate.Flashcard {
+	return flashcard.And(
+		flashcard.DeletedAtIsNil(),
+		predicate.Flashcard(inTree(flashcard.FieldCollectionID, treeRoot(collectionID), true)),
+	)
+}
+
+// trashRoot matches trashed collections that were not trashed as part of their
+// parent, so that a trashed tree shows up as a single item
+func trashRoot() predicate.Collection {
+	return collection.Or(
+		collection.ParentIDIsNil(),
+		collection.HasParentWith(collection.DeletedAtIsNil()),
+	)
+}
+
+// Trash methods
+
+// SoftDelete moves a collection and its live sub-collections to the trash at the
+// same instant, which marks them as trashed together
+func (r *CollectionRepositoryImpl) SoftDelete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	return r.client.Collection.
+		Update().
+		Where(
+			predicate.Collection(inTree(collection.FieldID, treeRoot(id), true)),
+			collection.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		SetDeletedBy(deletedBy).
+		Exec(ctx)
+}
+
+// Restore takes a collection and the sub-collections trashed with it out of the
+// trash. A collection whose parent is still in the trash becomes top-level.
+func (r *CollectionRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := restoreCollection(ctx, tx.Client(), id); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func restoreCollection(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	trashed, err := client.Collection.
+		Query().
+		Where(
+			collection.ID(id),
+			collection.DeletedAtNotNil(),
+		).
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.Collection.
+		Update().
+		Where(
+			predicate.Collection(inTree(collection.FieldID, treeRoot(id), false)),
+			collection.DeletedAt(*trashed.DeletedAt),
+		).
+		ClearDeletedAt().
+		ClearDeletedBy().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if parent := trashed.Edges.Parent; parent != nil && parent.DeletedAt != nil {
+		return client.Collection.UpdateOneID(id).ClearParentID().Exec(ctx)
+	}
+
+	return nil
+}
+
+func (r *CollectionRepositoryImpl) GetTrashedByID(ctx context.Context, id uuid.UUID) (*ent.Collection, error) {
+	return r.client.Collection.
+		Query().
+		Where(
+			collection.ID(id),
+			collection.DeletedAtNotNil(),
+		).
+		Only(ctx)
+}
+
+func (r *CollectionRepositoryImpl) ListTrashedByOwner(ctx context.Context, ownerID string) ([]*ent.Collection, error) {
+	return r.client.Collection.
+		Query().
+		Where(
+			collection.OwnerID(ownerID),
+			collection.DeletedAtNotNil(),
+			trashRoot(),
+		).
+		Order(ent.Desc(collection.FieldDeletedAt)).
+		All(ctx)
+}
+
+func (r *CollectionRepositoryImpl) ListTrashedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*ent.Collection, error) {
+	return r.client.Collection.
+		Query().
+		Where(
+			collection.DeletedAtLT(deletedBefore),
+			trashRoot(),
+		).
+		Limit(limit).
+		All(ctx)
+}
+
 // Collaborator methods
 
 func (r *CollectionRepositoryImpl) AddCollaborator(ctx context.Context, collectionID uuid.UUID, userID, role string) (*ent.CollectionCollaborator, error) {
@@ -116,3 +578,12 @@ func (r *CollectionRepositoryImpl) GetCollaborator(ctx context.Context, collecti
 		).
 		Only(ctx)
 }
+
+// EditableBy matches live collections the user owns or may edit as a collaborator,
+// directly or through an ancestor
+func EditableBy(userID string) predicate.Collection {
+	return collection.And(
+		collection.DeletedAtIsNil(),
+		predicate.Collection(inTree(collection.FieldID, sharedWith(userID, "editor", "admin"), true)),
+	)
+}
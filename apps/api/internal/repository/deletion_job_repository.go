@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// DeletionJobRepository defines the interface for collection deletion job data access
+type DeletionJobRepository interface {
+	Create(ctx context.Context, collectionID uuid.UUID, requestedBy string, totalFlashcards int) (*ent.DeletionJob, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.DeletionJob, error)
+
+	// GetActiveByCollection returns the pending or running job of a collection
+	GetActiveByCollection(ctx context.Context, collectionID uuid.UUID) (*ent.DeletionJob, error)
+
+	// ListStale returns unfinished jobs that have made no progress since staleBefore,
+	// e.g. because the server restarted while they were running
+	ListStale(ctx context.Context, staleBefore time.Time) ([]*ent.DeletionJob, error)
+
+	// Claim marks a pending job, or a running one that went stale before
+	// staleBefore, as running, and reports whether it did. Only the runner that
+	// claimed a job may run it.
+	Claim(ctx context.Context, id uuid.UUID, staleBefore time.Time) (bool, error)
+
+	// AddProgress adds flashcards deleted by the job to its progress
+	AddProgress(ctx context.Context, id uuid.UUID, deletedFlashcards int) error
+
+	Complete(ctx context.Context, id uuid.UUID) error
+	Fail(ctx context.Context, id uuid.UUID, message string) error
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/deletionjob"
+)
+
+// DeletionJobRepositoryImpl implements DeletionJobRepository using Ent ORM
+type DeletionJobRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewDeletionJobRepository(client *ent.Client) DeletionJobRepository {
+	return &DeletionJobRepositoryImpl{client: client}
+}
+
+func (r *DeletionJobRepositoryImpl) Create(ctx context.Context, collectionID uuid.UUID, requestedBy string, totalFlashcards int) (*ent.DeletionJob, error) {
+	return r.client.DeletionJob.
+		Create().
+		SetCollectionID(collectionID).
+		SetRequestedBy(requestedBy).
+		SetTotalFlashcards(totalFlashcards).
+		Save(ctx)
+}
+
+func (r *DeletionJobRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.DeletionJob, error) {
+	return r.client.DeletionJob.Get(ctx, id)
+}
+
+func (r *DeletionJobRepositoryImpl) GetActiveByCollection(ctx context.Context, collectionID uuid.UUID) (*ent.DeletionJob, error) {
+	return r.client.DeletionJob.
+		Query().
+		Where(
+			deletionjob.CollectionID(collectionID),
+			deletionjob.StatusIn(deletionjob.StatusPending, deletionjob.StatusRunning),
+		).
+		First(ctx)
+}
+
+func (r *DeletionJobRepositoryImpl) ListStale(ctx context.Context, staleBefore time.Time) ([]*ent.DeletionJob, error) {
+	return r.client.DeletionJob.
+		Query().
+		Where(
+			deletionjob.StatusIn(deletionjob.StatusPending, deletionjob.StatusRunning),
+			deletionjob.UpdatedAtLT(staleBefore),
+		).
+		All(ctx)
+}
+
+func (r *DeletionJobRepositoryImpl) Claim(ctx context.Context, id uuid.UUID, staleBefore time.Time) (bool, error) {
+	claimed, err := r.client.DeletionJob.
+		Update().
+		Where(
+			deletionjob.ID(id),
+			deletionjob.Or(
+				deletionjob.StatusEQ(deletionjob.StatusPending),
+				deletionjob.And(
+					deletionjob.StatusEQ(deletionjob.StatusRunning),
+					deletionjob.UpdatedAtLT(staleBefore),
+				),
+			),
+		).
+		SetStatus(deletionjob.StatusRunning).
+		Save(ctx)
+	return claimed > 0, err
+}
+
+func (r *DeletionJobRepositoryImpl) AddProgress(ctx context.Context, id uuid.UUID, deletedFlashcards int) error {
+	return r.client.DeletionJob.
+		UpdateOneID(id).
+		AddDeletedFlashcards(deletedFlashcards).
+		Exec(ctx)
+}
+
+func (r *DeletionJobRepositoryImpl) Complete(ctx context.Context, id uuid.UUID) error {
+	return r.client.DeletionJob.
+		UpdateOneID(id).
+		SetStatus(deletionjob.StatusCompleted).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *DeletionJobRepositoryImpl) Fail(ctx context.Context, id uuid.UUID, message string) error {
+	return r.client.DeletionJob.
+		UpdateOneID(id).
+		SetStatus(deletionjob.StatusFailed).
+		SetError(message).
+		SetCompletedAt(time.Now()).
+		Exec(ctx)
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// AnnotationFields represents the private annotation of a flashcard. An empty Flag
+// means no flag.
+type AnnotationFields struct {
+	Note       string
+	Flag       string
+	Bookmarked bool
+}
+
+// IsEmpty reports whether the annotation carries nothing worth storing
+func (f AnnotationFields) IsEmpty() bool {
+	return f.Note == "" && f.Flag == "" && !f.Bookmarked
+}
+
+// AnnotationFilter selects flashcards by the user's own annotations. Flashcards
+// match when they carry any of the flags, and a bookmark if Bookmarked is set.
+type AnnotationFilter struct {
+	Flags      []string
+	Bookmarked bool
+}
+
+// IsEmpty reports whether the filter matches every flashcard
+func (f AnnotationFilter) IsEmpty() bool {
+	return len(f.Flags) == 0 && !f.Bookmarked
+}
+
+// FlashcardAnnotationRepository defines the interface for access to the private
+// notes, flags and bookmarks users put on flashcards
+type FlashcardAnnotationRepository interface {
+	Get(ctx context.Context, userID string, flashcardID uuid.UUID) (*ent.FlashcardAnnotation, error)
+
+	// Save creates or replaces the user's annotation of a flashcard
+	Save(ctx context.Context, userID string, flashcardID uuid.UUID, fields AnnotationFields) (*ent.FlashcardAnnotation, error)
+	Delete(ctx context.Context, userID string, flashcardID uuid.UUID) error
+
+	// ListAccessible returns the user's annotations on live flashcards the user can
+	// still access, with the flashcards, most recently updated first
+	ListAccessible(ctx context.Context, userID string, filter AnnotationFilter) ([]*ent.FlashcardAnnotation, error)
+
+	// ListByUser returns every annotation of the user, including those on trashed
+	// or no longer accessible flashcards
+	ListByUser(ctx context.Context, userID string) ([]*ent.FlashcardAnnotation, error)
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// FlashcardAnnotationRepositoryImpl implements FlashcardAnnotationRepository using Ent ORM
+type FlashcardAnnotationRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewFlashcardAnnotationRepository(client *ent.Client) FlashcardAnnotationRepository {
+	return &FlashcardAnnotationRepositoryImpl{client: client}
+}
+
+// AnnotatedBy matches flashcards whose annotation by the user passes the filter
+func AnnotatedBy(userID string, filter AnnotationFilter) predicate.Flashcard {
+	predicates := []predicate.FlashcardAnnotation{flashcardannotation.UserID(userID)}
+	if len(filter.Flags) > 0 {
+		flags := make([]flashcardannotation.Flag, len(filter.Flags))
+		for i, flag := range filter.Flags {
+			flags[i] = flashcardannotation.Flag(flag)
+		}
+		predicates = append(predicates, flashcardannotation.FlagIn(flags...))
+	}
+	if filter.Bookmarked {
+		predicates = append(predicates, flashcardannotation.Bookmarked(true))
+	}
+	return flashcard.HasAnnotationsWith(predicates...)
+}
+
+// withAnnotationOf loads only the user's own annotation of each flashcard, so
+// annotations of other users are never exposed
+func withAnnotationOf(userID string) func(*ent.FlashcardAnnotationQuery) {
+	return func(q *ent.FlashcardAnnotationQuery) {
+		q.Where(flashcardannotation.UserID(userID))
+	}
+}
+
+func (r *FlashcardAnnotationRepositoryImpl) Get(ctx context.Context, userID string, flashcardID uuid.UUID) (*ent.FlashcardAnnotation, error) {
+	return r.client.FlashcardAnnotation.
+		Query().
+		Where(
+			flashcardannotation.UserID(userID),
+			flashcardannotation.FlashcardID(flashcardID),
+		).
+		Only(ctx)
+}
+
+func (r *FlashcardAnnotationRepositoryImpl) Save(ctx context.Context, userID string, flashcardID uuid.UUID, fields AnnotationFields) (*ent.FlashcardAnnotation, error) {
+	var flag *flashcardannotation.Flag
+	if fields.Flag != "" {
+		f := flashcardannotation.Flag(fields.Flag)
+		flag = &f
+	}
+
+	existing, err := r.Get(ctx, userID, flashcardID)
+	if err == nil {
+		update := existing.Update().
+			SetNote(fields.Note).
+			SetBookmarked(fields.Bookmarked)
+		if flag != nil {
+			update.SetFlag(*flag)
+		} else {
+			update.ClearFlag()
+		}
+		return update.Save(ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	return r.client.FlashcardAnnotation.
+		Create().
+		SetUserID(userID).
+		SetFlashcardID(flashcardID).
+		SetNote(fields.Note).
+		SetNillableFlag(flag).
+		SetBookmarked(fields.Bookmarked).
+		Save(ctx)
+}
+
+func (r *FlashcardAnnotationRepositoryImpl) Delete(ctx context.Context, userID string, flashcardID uuid.UUID) error {
+	_, err := r.client.FlashcardAnnotation.
+		Delete().
+		Where(
+			flashcardannotation.UserID(userID),
+			flashcardannotation.FlashcardID(flashcardID),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *FlashcardAnnotationRepositoryImpl) ListAccessible(ctx context.Context, userID string, filter AnnotationFilter) ([]*ent.FlashcardAnnotation, error) {
+	return r.client.FlashcardAnnotation.
+		Query().
+		Where(
+			flashcardannotation.UserID(userID),
+			flashcardannotation.HasFlashcardWith(
+				AccessibleBy(userID),
+				VisibleTo(userID),
+				flashcard.DeletedAtIsNil(),
+				AnnotatedBy(userID, filter),
+			),
+		).
+		WithFlashcard(func(q *ent.FlashcardQuery) {
+			q.WithTags()
+		}).
+		Order(ent.Desc(flashcardannotation.FieldUpdatedAt)).
+		All(ctx)
+}
+
+func (r *FlashcardAnnotationRepositoryImpl) ListByUser(ctx context.Context, userID string) ([]*ent.FlashcardAnnotation, error) {
+	return r.client.FlashcardAnnotation.
+		Query().
+		Where(flashcardannotation.UserID(userID)).
+		Order(ent.Asc(flashcardannotation.FieldCreatedAt)).
+		All(ctx)
+}
@@ -2,16 +2,178 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
+// FlashcardFields contains the editable content of a flashcard
+type FlashcardFields struct {
+	Question        string
+	Answer          string
+	Type            string
+	ContentFormat   string
+	QuestionHTML    string
+	AnswerHTML      string
+	QuestionKey     string  // Normalized question text for duplicate detection
+	QuestionText    *string // Plain text of the question for full-text search
+	AnswerText      *string // Plain text of the answer for full-text search
+	Hint            *string // nil keeps the hint of an existing flashcard
+	HintHTML        string
+	Explanation     *string // nil keeps the explanation of an existing flashcard
+	ExplanationHTML string
+	Sources         []schema.SourceReference
+	Options         []string
+	Pairs           []schema.MatchPair
+	Occlusion       *schema.ImageOcclusion
+	MediaIDs        []uuid.UUID // nil leaves the attachments of an existing flashcard unchanged
+	Status          string      // draft or published, set on create only; see SetStatus
+}
+
+// Flashcards are ordered by position. New cards are appended PositionStep after the
+// last one; moved cards take the midpoint of their neighbors until the gap falls
+// below minPositionGap and the collection is renumbered.
+const (
+	PositionStep   = 1024.0
+	minPositionGap = 1e-6
+)
+
+// Flashcard batch operations
+const (
+	BatchCreate = "create"
+	BatchUpdate = "update"
+	BatchDelete = "delete"
+)
+
+// BatchOperation is one validated operation of a flashcard batch
+type BatchOperation struct {
+	Op          string
+	FlashcardID uuid.UUID       // update and delete
+	Fields      FlashcardFields // create and update
+}
+
+// BatchOutcome is the result of one batch operation: the created or updated
+// flashcard, or the error it failed with
+type BatchOutcome struct {
+	Flashcard *ent.Flashcard
+	Err       error
+}
+
+// FlashcardFilter narrows down the flashcards of a collection
+type FlashcardFilter struct {
+	Tags   []string // Flashcards carrying any of these tags or their descendants
+	Status string   // draft or published; empty for both
+
+	// Annotations filters by the private annotations of the learner; it applies to
+	// the due queue, where the learner is known
+	Annotations AnnotationFilter
+}
+
+// Markers around matched terms in search snippets. Private-use characters are used
+// so that they cannot clash with card content before the snippet is escaped.
+const (
+	SnippetMatchStart = "\ue000"
+	SnippetMatchEnd   = "\ue001"
+)
+
+// FlashcardSearch describes a full-text search over the flashcards a user can access
+type FlashcardSearch struct {
+	Query        string
+	UserID       string
+	CollectionID *uuid.UUID
+	Type         string
+	Tags         []string
+	Annotations  AnnotationFilter // Of the searching user
+	Limit        int
+	Offset       int
+}
+
+// FlashcardSearchHit is a flashcard matching a search with its relevance and
+// snippets of the question and answer around the matched terms
+type FlashcardSearchHit struct {
+	Flashcard       *ent.Flashcard
+	Rank            float64
+	QuestionSnippet string
+	AnswerSnippet   string
+}
+
+// SimilarFlashcard is a flashcard whose question resembles another one
+type SimilarFlashcard struct {
+	Flashcard  *ent.Flashcard `json:"flashcard"`
+	Similarity float64        `json:"similarity"` // Trigram similarity, 1 for identical questions
+}
+
+// SimilarPair is a pair of flashcards in a collection with similar questions
+type SimilarPair struct {
+	FlashcardID uuid.UUID `sql:"flashcard_id"`
+	DuplicateID uuid.UUID `sql:"duplicate_id"`
+	Similarity  float64   `sql:"similarity"`
+}
+
 // FlashcardRepository defines the interface for flashcard data access
 type FlashcardRepository interface {
-	Create(ctx context.Context, question, answer, flashcardType string, collectionID uuid.UUID, createdBy string) (*ent.Flashcard, error)
+	Create(ctx context.Context, fields FlashcardFields, collectionID uuid.UUID, createdBy string) (*ent.Flashcard, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.Flashcard, error)
-	Update(ctx context.Context, id uuid.UUID, question, answer, flashcardType string) (*ent.Flashcard, error)
+	Update(ctx context.Context, id uuid.UUID, fields FlashcardFields, editorID string) (*ent.Flashcard, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	ListByCollection(ctx context.Context, collectionID uuid.UUID) ([]*ent.Flashcard, error)
+	ListByCollection(ctx context.Context, collectionID uuid.UUID, filter FlashcardFilter) ([]*ent.Flashcard, error)
+
+	// Trash methods
+	SoftDelete(ctx context.Context, id uuid.UUID, deletedBy string) error
+	Restore(ctx context.Context, id uuid.UUID) error
+	GetTrashedByID(ctx context.Context, id uuid.UUID) (*ent.Flashcard, error)
+	ListTrashedEditableBy(ctx context.Context, userID string) ([]*ent.Flashcard, error)
+	ListTrashedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*ent.Flashcard, error)
+
+	// Search returns a page of matching flashcards ordered by relevance and the total
+	// number of matches
+	Search(ctx context.Context, search FlashcardSearch) ([]FlashcardSearchHit, int, error)
+
+	// ApplyBatch runs the operations on the flashcards of a collection in one
+	// transaction. Atomic batches stop and roll back at the first failing
+	// operation; otherwise each operation runs in a savepoint and failures are
+	// skipped. Deleted flashcards are moved to the trash.
+	ApplyBatch(ctx context.Context, collectionID uuid.UUID, userID string, ops []BatchOperation, atomic bool) ([]BatchOutcome, error)
+
+	// MoveToCollection moves flashcards to the end of another collection, keeping
+	// their order. Reviews, revisions and media stay attached to the flashcards.
+	MoveToCollection(ctx context.Context, ids []uuid.UUID, targetCollectionID uuid.UUID) ([]*ent.Flashcard, error)
+
+	// CopyToCollection copies flashcards with their tags and media to the end of
+	// another collection. When progressOf is set, that user's reviews are copied
+	// to the new flashcards too.
+	CopyToCollection(ctx context.Context, ids []uuid.UUID, targetCollectionID uuid.UUID, createdBy, progressOf string) ([]*ent.Flashcard, error)
+
+	// SetStatus publishes or unpublishes flashcards of a collection, or every
+	// flashcard of the collection when ids is nil, and returns the number changed
+	SetStatus(ctx context.Context, collectionID uuid.UUID, ids []uuid.UUID, status string) (int, error)
+
+	// Move places a flashcard between its new neighbors in the order of the target
+	// collection, moving it from another collection if needed; either neighbor may
+	// be nil at the start or end of the collection
+	Move(ctx context.Context, collectionID, id uuid.UUID, afterID, beforeID *uuid.UUID) (*ent.Flashcard, error)
+
+	// Duplicate detection methods
+	FindSimilar(ctx context.Context, collectionID uuid.UUID, questionKey string, minSimilarity float64, limit int) ([]SimilarFlashcard, error)
+	ListSimilarPairs(ctx context.Context, collectionID uuid.UUID, minSimilarity float64, limit int, publishedOnly bool) ([]SimilarPair, error)
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Flashcard, error)
+	// ListByIDsInTree returns those of the flashcards that are still live in the
+	// collection or one of its live sub-collections
+	ListByIDsInTree(ctx context.Context, collectionID uuid.UUID, ids []uuid.UUID) ([]*ent.Flashcard, error)
+	ListMissingQuestionKey(ctx context.Context, after uuid.UUID, limit int) ([]*ent.Flashcard, error)
+	SetQuestionKey(ctx context.Context, id uuid.UUID, questionKey string) error
+
+	// ListMissingSearchText returns flashcards after the ID, in ID order, whose
+	// plain text for full-text search has not been computed yet
+	ListMissingSearchText(ctx context.Context, after uuid.UUID, limit int) ([]*ent.Flashcard, error)
+	SetSearchText(ctx context.Context, id uuid.UUID, questionText, answerText string) error
+
+	// ReviewCounts returns the number of reviews of each flashcard summed over all users
+	ReviewCounts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]int, error)
+
+	// MergeDuplicates moves the review progress and tags of the duplicates to the kept
+	// flashcard and moves the duplicates to the trash
+	MergeDuplicates(ctx context.Context, keepID uuid.UUID, duplicateIDs []uuid.UUID, deletedBy string) error
 }
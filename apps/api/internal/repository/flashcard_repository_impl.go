@@ -2,12 +2,24 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/collection"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
 )
 
+// ts_headline options for search snippets
+const snippetOptions = `StartSel="` + SnippetMatchStart + `", StopSel="` + SnippetMatchEnd + `"` +
+	", MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=\" … \""
+
 // FlashcardRepositoryImpl implements FlashcardRepository using Ent ORM
 type FlashcardRepositoryImpl struct {
 	client *ent.Client
@@ -17,42 +29,1259 @@ func NewFlashcardRepository(client *ent.Client) FlashcardRepository {
 	return &FlashcardRepositoryImpl{client: client}
 }
 
-func (r *FlashcardRepositoryImpl) Create(ctx context.Context, question, answer, flashcardType string, collectionID uuid.UUID, createdBy string) (*ent.Flashcard, error) {
-	return r.client.Flashcard.
+// Create creates a flashcard and records it as its first revision
+func (r *FlashcardRepositoryImpl) Create(ctx context.Context, fields FlashcardFields, collectionID uuid.UUID, createdBy string) (*ent.Flashcard, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := createFlashcard(ctx, tx.Client(), fields, collectionID, createdBy)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return created, tx.Commit()
+}
+
+func createFlashcard(ctx context.Context, client *ent.Client, fields FlashcardFields, collectionID uuid.UUID, createdBy string) (*ent.Flashcard, error) {
+	// New cards go to the end of the collection
+	position, err := lastPosition(ctx, client, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	create := client.Flashcard.
 		Create().
-		SetQuestion(question).
-		SetAnswer(answer).
-		SetType(flashcardType).
+		SetQuestion(fields.Question).
+		SetAnswer(fields.Answer).
+		SetType(fields.Type).
+		SetContentFormat(fields.ContentFormat).
+		SetQuestionHTML(fields.QuestionHTML).
+		SetAnswerHTML(fields.AnswerHTML).
+		SetQuestionKey(fields.QuestionKey).
+		SetNillableQuestionText(fields.QuestionText).
+		SetNillableAnswerText(fields.AnswerText).
+		SetNillableHint(fields.Hint).
+		SetHintHTML(fields.HintHTML).
+		SetNillableExplanation(fields.Explanation).
+		SetExplanationHTML(fields.ExplanationHTML).
+		SetSources(fields.Sources).
+		SetOptions(fields.Options).
+		SetPairs(fields.Pairs).
+		SetOcclusion(fields.Occlusion).
+		AddMediumIDs(fields.MediaIDs...).
 		SetCollectionID(collectionID).
-		SetCreatedBy(createdBy).
-		Save(ctx)
+		SetPosition(position + PositionStep).
+		SetCreatedBy(createdBy)
+	if fields.Status != "" {
+		create.SetStatus(flashcard.Status(fields.Status))
+	}
+
+	created, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first revision records every field that was set
+	changed := changedFields(&ent.Flashcard{}, fields)
+	if err := createRevision(ctx, client, created, 1, fields.MediaIDs, createdBy, changed, created.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return created, nil
 }
 
 func (r *FlashcardRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.Flashcard, error) {
 	return r.client.Flashcard.
 		Query().
-		Where(flashcard.ID(id)).
+		Where(
+			flashcard.ID(id),
+			flashcard.DeletedAtIsNil(),
+		).
+		WithMedia().
+		WithTags().
 		Only(ctx)
 }
 
-func (r *FlashcardRepositoryImpl) Update(ctx context.Context, id uuid.UUID, question, answer, flashcardType string) (*ent.Flashcard, error) {
-	return r.client.Flashcard.
+// Update updates a flashcard and records the change as a new revision
+func (r *FlashcardRepositoryImpl) Update(ctx context.Context, id uuid.UUID, fields FlashcardFields, editorID string) (*ent.Flashcard, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := updateFlashcard(ctx, tx.Client(), id, fields, editorID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return updated, tx.Commit()
+}
+
+func updateFlashcard(ctx context.Context, client *ent.Client, id uuid.UUID, fields FlashcardFields, editorID string) (*ent.Flashcard, error) {
+	current, err := client.Flashcard.
+		Query().
+		Where(flashcard.ID(id)).
+		WithMedia().
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := lastRevisionNumber(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	if last == 0 {
+		// Flashcards created before revisions were recorded get their current state
+		// as the baseline, so the first edit can be diffed and undone
+		if err := createRevision(ctx, client, current, 1, mediaIDsOf(current), current.CreatedBy, nil, current.UpdatedAt); err != nil {
+			return nil, err
+		}
+		last = 1
+	}
+
+	update := client.Flashcard.
 		UpdateOneID(id).
-		SetQuestion(question).
-		SetAnswer(answer).
-		SetType(flashcardType).
-		Save(ctx)
+		SetQuestion(fields.Question).
+		SetAnswer(fields.Answer).
+		SetType(fields.Type).
+		SetContentFormat(fields.ContentFormat).
+		SetQuestionHTML(fields.QuestionHTML).
+		SetAnswerHTML(fields.AnswerHTML).
+		SetQuestionKey(fields.QuestionKey).
+		SetNillableQuestionText(fields.QuestionText).
+		SetNillableAnswerText(fields.AnswerText).
+		SetNillableHint(fields.Hint).
+		SetHintHTML(fields.HintHTML).
+		SetNillableExplanation(fields.Explanation).
+		SetExplanationHTML(fields.ExplanationHTML).
+		SetSources(fields.Sources).
+		SetOptions(fields.Options).
+		SetPairs(fields.Pairs).
+		SetOcclusion(fields.Occlusion)
+
+	mediaIDs := mediaIDsOf(current)
+	if fields.MediaIDs != nil {
+		update = update.
+			ClearMedia().
+			AddMediumIDs(fields.MediaIDs...)
+		mediaIDs = fields.MediaIDs
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := reconcileOcclusionReviews(ctx, client, id, current.Occlusion, fields.Occlusion); err != nil {
+		return nil, err
+	}
+
+	if changed := changedFields(current, fields); len(changed) > 0 {
+		if err := createRevision(ctx, client, updated, last+1, mediaIDs, editorID, changed, updated.UpdatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// occlusionRemapOffset is added to the review items of moved masks while they are
+// renumbered, so that they do not collide with the items still in place
+const occlusionRemapOffset = 1 << 20
+
+// reconcileOcclusionReviews keeps the reviews of an image occlusion card with their
+// masks when the masks are edited. Reviews are keyed by mask index, so the reviews
+// of reordered masks follow their mask ID to its new index and the reviews of
+// removed masks are deleted, as are any reviews beyond the new review items.
+func reconcileOcclusionReviews(ctx context.Context, client *ent.Client, flashcardID uuid.UUID, before, after *schema.ImageOcclusion) error {
+	newIndex := make(map[string]int)
+	if after != nil {
+		for j, mask := range after.Masks {
+			newIndex[mask.ID] = j
+		}
+	}
+
+	moved := false
+	if before != nil {
+		for i, mask := range before.Masks {
+			j, kept := newIndex[mask.ID]
+			if kept && j == i {
+				continue
+			}
+
+			item := []predicate.FlashcardReview{
+				flashcardreview.FlashcardID(flashcardID),
+				flashcardreview.Item(i),
+			}
+			if !kept {
+				if _, err := client.FlashcardReview.Delete().Where(item...).Exec(ctx); err != nil {
+					return err
+				}
+				continue
+			}
+
+			_, err := client.FlashcardReview.
+				Update().
+				Where(item...).
+				SetItem(j + occlusionRemapOffset).
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+			moved = true
+		}
+	}
+
+	if moved {
+		_, err := client.FlashcardReview.
+			Update().
+			Where(
+				flashcardreview.FlashcardID(flashcardID),
+				flashcardreview.ItemGTE(occlusionRemapOffset),
+			).
+			AddItem(-occlusionRemapOffset).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := client.FlashcardReview.
+		Delete().
+		Where(
+			flashcardreview.FlashcardID(flashcardID),
+			flashcardreview.ItemGTE(after.ReviewItemCount()),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *FlashcardRepositoryImpl) ApplyBatch(ctx context.Context, collectionID uuid.UUID, userID string, ops []BatchOperation, atomic bool) ([]BatchOutcome, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	outcomes := make([]BatchOutcome, len(ops))
+	for i, op := range ops {
+		if !atomic {
+			// A failed statement aborts a Postgres transaction, so every operation
+			// gets a savepoint to roll back to
+			if _, err := tx.ExecContext(ctx, "SAVEPOINT batch_operation"); err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+		}
+
+		outcomes[i].Flashcard, outcomes[i].Err = applyBatchOperation(ctx, tx.Client(), collectionID, userID, op)
+
+		switch {
+		case outcomes[i].Err == nil && !atomic:
+			_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT batch_operation")
+		case outcomes[i].Err != nil && !atomic:
+			_, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT batch_operation")
+		case outcomes[i].Err != nil:
+			tx.Rollback()
+			return outcomes[:i+1], nil
+		}
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	return outcomes, tx.Commit()
+}
+
+func applyBatchOperation(ctx context.Context, client *ent.Client, collectionID uuid.UUID, userID string, op BatchOperation) (*ent.Flashcard, error) {
+	switch op.Op {
+	case BatchCreate:
+		return createFlashcard(ctx, client, op.Fields, collectionID, userID)
+
+	case BatchUpdate:
+		if _, err := liveFlashcardIn(ctx, client, collectionID, op.FlashcardID); err != nil {
+			return nil, err
+		}
+		return updateFlashcard(ctx, client, op.FlashcardID, op.Fields, userID)
+
+	case BatchDelete:
+		n, err := client.Flashcard.
+			Update().
+			Where(
+				flashcard.ID(op.FlashcardID),
+				flashcard.CollectionID(collectionID),
+				flashcard.DeletedAtIsNil(),
+			).
+			SetDeletedAt(time.Now()).
+			SetDeletedBy(userID).
+			Save(ctx)
+		if err == nil && n == 0 {
+			err = errors.New("flashcard not found")
+		}
+		return nil, err
+	}
+
+	return nil, errors.New("invalid operation")
 }
 
+// Delete permanently deletes a flashcard. Its reviews, tags, revisions and media
+// links are removed by the database through cascading foreign keys.
 func (r *FlashcardRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.client.Flashcard.
 		DeleteOneID(id).
 		Exec(ctx)
 }
 
-func (r *FlashcardRepositoryImpl) ListByCollection(ctx context.Context, collectionID uuid.UUID) ([]*ent.Flashcard, error) {
+// SoftDelete moves a flashcard to the trash. Its review progress is kept so that
+// restoring the flashcard brings it back.
+func (r *FlashcardRepositoryImpl) SoftDelete(ctx context.Context, id uuid.UUID, deletedBy string) error {
+	return r.client.Flashcard.
+		Update().
+		Where(
+			flashcard.ID(id),
+			flashcard.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		SetDeletedBy(deletedBy).
+		Exec(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.client.Flashcard.
+		UpdateOneID(id).
+		ClearDeletedAt().
+		ClearDeletedBy().
+		Exec(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) GetTrashedByID(ctx context.Context, id uuid.UUID) (*ent.Flashcard, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.ID(id),
+			flashcard.DeletedAtNotNil(),
+		).
+		Only(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ListTrashedEditableBy(ctx context.Context, userID string) ([]*ent.Flashcard, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.DeletedAtNotNil(),
+			flashcard.HasCollectionWith(EditableBy(userID)),
+		).
+		Order(ent.Desc(flashcard.FieldDeletedAt)).
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ListTrashedBefore(ctx context.Context, deletedBefore time.Time, limit int) ([]*ent.Flashcard, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(flashcard.DeletedAtLT(deletedBefore)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ListByCollection(ctx context.Context, collectionID uuid.UUID, filter FlashcardFilter) ([]*ent.Flashcard, error) {
+	query := r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+		).
+		WithMedia().
+		WithTags().
+		Order(flashcard.ByPosition(), flashcard.ByID())
+
+	if len(filter.Tags) > 0 {
+		query = query.Where(HasTagsUnder(filter.Tags))
+	}
+	if filter.Status != "" {
+		query = query.Where(flashcard.StatusEQ(flashcard.Status(filter.Status)))
+	}
+
+	return query.All(ctx)
+}
+
+// VisibleTo matches published flashcards and the drafts of collections the user
+// can edit. It is combined with AccessibleBy.
+func VisibleTo(userID string) predicate.Flashcard {
+	return flashcard.Or(
+		flashcard.StatusEQ(flashcard.StatusPublished),
+		flashcard.HasCollectionWith(EditableBy(userID)),
+	)
+}
+
+func (r *FlashcardRepositoryImpl) SetStatus(ctx context.Context, collectionID uuid.UUID, ids []uuid.UUID, status string) (int, error) {
+	update := r.client.Flashcard.
+		Update().
+		Where(
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+			flashcard.StatusNEQ(flashcard.Status(status)),
+		).
+		SetStatus(flashcard.Status(status))
+	if ids != nil {
+		update.Where(flashcard.IDIn(ids...))
+	}
+
+	return update.Save(ctx)
+}
+
+// AccessibleBy matches flashcards in collections that are public or that the user
+// owns or collaborates on, directly or through an ancestor
+func AccessibleBy(userID string) predicate.Flashcard {
+	return flashcard.HasCollectionWith(
+		collection.DeletedAtIsNil(),
+		collection.Or(
+			collection.IsPublic(true),
+			predicate.Collection(inTree(collection.FieldID, sharedWith(userID), true)),
+		),
+	)
+}
+
+// searchQuery writes the tsquery of the search text. websearch_to_tsquery accepts
+// any user input, including quotes, "or" and "-" for exclusion.
+func searchQuery(b *sql.Builder, text string) {
+	b.WriteString("websearch_to_tsquery('simple', ").Arg(text).WriteString(")")
+}
+
+func (r *FlashcardRepositoryImpl) Search(ctx context.Context, search FlashcardSearch) ([]FlashcardSearchHit, int, error) {
+	query := r.client.Flashcard.
+		Query().
+		Where(
+			AccessibleBy(search.UserID),
+			VisibleTo(search.UserID),
+			flashcard.DeletedAtIsNil(),
+			// Backed by the GIN index on search_vector
+			func(s *sql.Selector) {
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.Ident(s.C(flashcard.FieldSearchVector)).WriteString(" @@ ")
+					searchQuery(b, search.Query)
+				}))
+			},
+		)
+
+	if search.CollectionID != nil {
+		query = query.Where(FlashcardInTree(*search.CollectionID))
+	}
+	if search.Type != "" {
+		query = query.Where(flashcard.Type(search.Type))
+	}
+	if len(search.Tags) > 0 {
+		query = query.Where(HasTagsUnder(search.Tags))
+	}
+	if !search.Annotations.IsEmpty() {
+		query = query.Where(AnnotatedBy(search.UserID, search.Annotations))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		ID              uuid.UUID `sql:"id"`
+		Rank            float64   `sql:"rank"`
+		QuestionSnippet string    `sql:"question_snippet"`
+		AnswerSnippet   string    `sql:"answer_snippet"`
+	}
+
+	err = query.
+		Order(func(s *sql.Selector) {
+			s.OrderBy(sql.Desc("rank"), s.C(flashcard.FieldID))
+		}).
+		Limit(search.Limit).
+		Offset(search.Offset).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(flashcard.FieldID))
+			s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("ts_rank(").Ident(s.C(flashcard.FieldSearchVector)).Comma()
+				searchQuery(b, search.Query)
+				b.WriteString(")")
+			}), "rank")
+			for column, alias := range map[string]string{
+				flashcard.FieldQuestionText: "question_snippet",
+				flashcard.FieldAnswerText:   "answer_snippet",
+			} {
+				s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+					b.WriteString("ts_headline('simple', coalesce(").Ident(s.C(column)).WriteString(", '')").Comma()
+					searchQuery(b, search.Query)
+					b.Comma().Arg(snippetOptions).WriteString(")")
+				}), alias)
+			}
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	flashcards, err := r.client.Flashcard.
+		Query().
+		Where(flashcard.IDIn(ids...)).
+		WithTags().
+		WithAnnotations(withAnnotationOf(search.UserID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byID := make(map[uuid.UUID]*ent.Flashcard, len(flashcards))
+	for _, fc := range flashcards {
+		byID[fc.ID] = fc
+	}
+
+	hits := make([]FlashcardSearchHit, 0, len(rows))
+	for _, row := range rows {
+		fc, ok := byID[row.ID]
+		if !ok {
+			// Deleted between the two queries
+			continue
+		}
+		hits = append(hits, FlashcardSearchHit{
+			Flashcard:       fc,
+			Rank:            row.Rank,
+			QuestionSnippet: row.QuestionSnippet,
+			AnswerSnippet:   row.AnswerSnippet,
+		})
+	}
+
+	return hits, total, nil
+}
+
+// similarTo matches questions whose key is identical or trigram-similar to key; the
+// % operator is backed by the trigram index on question_key
+func similarTo(column, key string, minSimilarity float64) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("(").Ident(s.C(column)).WriteString(" = ").Arg(key).
+				WriteString(" OR (").Ident(s.C(column)).WriteString(" % ").Arg(key).
+				WriteString(" AND similarity(").Ident(s.C(column)).Comma().Arg(key).
+				WriteString(") >= ").Arg(minSimilarity).WriteString("))")
+		}))
+	}
+}
+
+func (r *FlashcardRepositoryImpl) FindSimilar(ctx context.Context, collectionID uuid.UUID, questionKey string, minSimilarity float64, limit int) ([]SimilarFlashcard, error) {
+	if questionKey == "" {
+		return nil, nil
+	}
+
+	var rows []struct {
+		ID         uuid.UUID `sql:"id"`
+		Similarity float64   `sql:"similarity"`
+	}
+
+	err := r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+			similarTo(flashcard.FieldQuestionKey, questionKey, minSimilarity),
+		).
+		Order(func(s *sql.Selector) {
+			s.OrderBy(sql.Desc("similarity"), s.C(flashcard.FieldID))
+		}).
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			s.Select(s.C(flashcard.FieldID))
+			s.AppendSelectExprAs(sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("similarity(").Ident(s.C(flashcard.FieldQuestionKey)).Comma().Arg(questionKey).WriteString(")")
+			}), "similarity")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	flashcards, err := r.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*ent.Flashcard, len(flashcards))
+	for _, fc := range flashcards {
+		byID[fc.ID] = fc
+	}
+
+	similar := make([]SimilarFlashcard, 0, len(rows))
+	for _, row := range rows {
+		if fc, ok := byID[row.ID]; ok {
+			similar = append(similar, SimilarFlashcard{Flashcard: fc, Similarity: row.Similarity})
+		}
+	}
+
+	return similar, nil
+}
+
+func (r *FlashcardRepositoryImpl) ListSimilarPairs(ctx context.Context, collectionID uuid.UUID, minSimilarity float64, limit int, publishedOnly bool) ([]SimilarPair, error) {
+	var pairs []SimilarPair
+
+	query := r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+			flashcard.QuestionKeyNEQ(""),
+		)
+	if publishedOnly {
+		query = query.Where(flashcard.StatusEQ(flashcard.StatusPublished))
+	}
+
+	err := query.
+		Order(func(s *sql.Selector) {
+			s.OrderBy(sql.Desc("similarity"))
+		}).
+		Limit(limit).
+		Modify(func(s *sql.Selector) {
+			// Self-join each flashcard with the similar flashcards after it, so that
+			// every pair is reported once
+			d := sql.Table(flashcard.Table).As("duplicate")
+			similarity := sql.ExprFunc(func(b *sql.Builder) {
+				b.WriteString("similarity(").Ident(s.C(flashcard.FieldQuestionKey)).Comma().
+					Ident(d.C(flashcard.FieldQuestionKey)).WriteString(")")
+			})
+
+			joined := []*sql.Predicate{
+				sql.ColumnsEQ(s.C(flashcard.FieldCollectionID), d.C(flashcard.FieldCollectionID)),
+				sql.ColumnsLT(s.C(flashcard.FieldID), d.C(flashcard.FieldID)),
+				sql.IsNull(d.C(flashcard.FieldDeletedAt)),
+				sql.P(func(b *sql.Builder) {
+					b.Ident(s.C(flashcard.FieldQuestionKey)).WriteString(" % ").Ident(d.C(flashcard.FieldQuestionKey))
+				}),
+			}
+			if publishedOnly {
+				joined = append(joined, sql.EQ(d.C(flashcard.FieldStatus), flashcard.StatusPublished.String()))
+			}
+			s.Join(d).OnP(sql.And(joined...))
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.Join(similarity).WriteString(" >= ").Arg(minSimilarity)
+			}))
+			s.Select(
+				sql.As(s.C(flashcard.FieldID), "flashcard_id"),
+				sql.As(d.C(flashcard.FieldID), "duplicate_id"),
+			)
+			s.AppendSelectExprAs(similarity, "similarity")
+		}).
+		Scan(ctx, &pairs)
+
+	return pairs, err
+}
+
+func (r *FlashcardRepositoryImpl) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*ent.Flashcard, error) {
 	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.IDIn(ids...),
+			flashcard.DeletedAtIsNil(),
+		).
+		WithTags().
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ListByIDsInTree(ctx context.Context, collectionID uuid.UUID, ids []uuid.UUID) ([]*ent.Flashcard, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.IDIn(ids...),
+			FlashcardInTree(collectionID),
+		).
+		WithTags().
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ListMissingQuestionKey(ctx context.Context, after uuid.UUID, limit int) ([]*ent.Flashcard, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.QuestionKey(""),
+			flashcard.QuestionNEQ(""),
+			flashcard.IDGT(after),
+		).
+		Order(ent.Asc(flashcard.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) SetQuestionKey(ctx context.Context, id uuid.UUID, questionKey string) error {
+	return r.client.Flashcard.
+		UpdateOneID(id).
+		SetQuestionKey(questionKey).
+		Exec(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ListMissingSearchText(ctx context.Context, after uuid.UUID, limit int) ([]*ent.Flashcard, error) {
+	return r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.QuestionTextIsNil(),
+			flashcard.IDGT(after),
+		).
+		Order(ent.Asc(flashcard.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) SetSearchText(ctx context.Context, id uuid.UUID, questionText, answerText string) error {
+	return r.client.Flashcard.
+		UpdateOneID(id).
+		SetQuestionText(questionText).
+		SetAnswerText(answerText).
+		Exec(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) MergeDuplicates(ctx context.Context, keepID uuid.UUID, duplicateIDs []uuid.UUID, deletedBy string) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := mergeDuplicates(ctx, tx.Client(), keepID, duplicateIDs, deletedBy); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func mergeDuplicates(ctx context.Context, client *ent.Client, keepID uuid.UUID, duplicateIDs []uuid.UUID, deletedBy string) error {
+	keep, err := client.Flashcard.
+		Query().
+		Where(flashcard.ID(keepID)).
+		WithReviews().
+		WithTags().
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	// For every user and review item keep the review with the longest history
+	type reviewKey struct {
+		userID string
+		item   int
+	}
+	kept := make(map[reviewKey]*ent.FlashcardReview, len(keep.Edges.Reviews))
+	for _, review := range keep.Edges.Reviews {
+		kept[reviewKey{review.UserID, review.Item}] = review
+	}
+
+	reviews, err := client.FlashcardReview.
+		Query().
+		Where(flashcardreview.FlashcardIDIn(duplicateIDs...)).
+		Order(ent.Desc(flashcardreview.FieldReviewCount)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	itemCount := keep.Occlusion.ReviewItemCount()
+	for _, review := range reviews {
+		if review.Item >= itemCount {
+			continue
+		}
+
+		key := reviewKey{review.UserID, review.Item}
+		if existing, ok := kept[key]; ok {
+			if existing.ReviewCount >= review.ReviewCount {
+				continue
+			}
+			if err := client.FlashcardReview.DeleteOneID(existing.ID).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		if err := client.FlashcardReview.UpdateOneID(review.ID).SetFlashcardID(keepID).Exec(ctx); err != nil {
+			return err
+		}
+		kept[key] = review
+	}
+
+	tagged := make(map[string]bool, len(keep.Edges.Tags))
+	for _, tag := range keep.Edges.Tags {
+		tagged[tag.Name] = true
+	}
+
+	tags, err := client.FlashcardTag.
+		Query().
+		Where(flashcardtag.FlashcardIDIn(duplicateIDs...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, tag := range tags {
+		if tagged[tag.Name] {
+			continue
+		}
+		tagged[tag.Name] = true
+
+		if err := client.FlashcardTag.
+			Create().
+			SetFlashcardID(keepID).
+			SetCollectionID(keep.CollectionID).
+			SetName(tag.Name).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return client.Flashcard.
+		Update().
+		Where(
+			flashcard.IDIn(duplicateIDs...),
+			flashcard.DeletedAtIsNil(),
+		).
+		SetDeletedAt(time.Now()).
+		SetDeletedBy(deletedBy).
+		Exec(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) ReviewCounts(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]int, error) {
+	var rows []struct {
+		FlashcardID uuid.UUID `json:"flashcard_id"`
+		Count       int       `json:"count"`
+	}
+	err := r.client.FlashcardReview.
+		Query().
+		Where(flashcardreview.FlashcardIDIn(ids...)).
+		GroupBy(flashcardreview.FieldFlashcardID).
+		Aggregate(ent.As(ent.Sum(flashcardreview.FieldReviewCount), "count")).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]int, len(rows))
+	for _, row := range rows {
+		counts[row.FlashcardID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// lastPosition returns the highest position in a collection, or 0 if it is empty
+func lastPosition(ctx context.Context, client *ent.Client, collectionID uuid.UUID) (float64, error) {
+	last, err := client.Flashcard.
 		Query().
 		Where(flashcard.CollectionID(collectionID)).
+		Order(ent.Desc(flashcard.FieldPosition)).
+		Select(flashcard.FieldPosition).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return last.Position, nil
+}
+
+// Move places a flashcard between two neighbors of the target collection, moving it
+// there if it belongs to another collection. A missing neighbor is looked up next to
+// the given one, and without neighbors the flashcard goes to the end. The new
+// position is the midpoint of the neighbors, and the collection is renumbered when
+// there is no room left between them.
+func (r *FlashcardRepositoryImpl) Move(ctx context.Context, collectionID, id uuid.UUID, afterID, beforeID *uuid.UUID) (*ent.Flashcard, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, err := moveFlashcard(ctx, tx.Client(), collectionID, id, afterID, beforeID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return moved, tx.Commit()
+}
+
+func moveFlashcard(ctx context.Context, client *ent.Client, collectionID, id uuid.UUID, afterID, beforeID *uuid.UUID) (*ent.Flashcard, error) {
+	// Locking the collection serializes moves, so that concurrent renumbering
+	// cannot interleave
+	if _, err := client.Collection.
+		Query().
+		Where(collection.ID(collectionID)).
+		ForUpdate().
+		Only(ctx); err != nil {
+		return nil, err
+	}
+
+	moving, err := client.Flashcard.
+		Query().
+		Where(
+			flashcard.ID(id),
+			flashcard.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	position, ok, err := positionBetween(ctx, client, collectionID, id, afterID, beforeID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := renumberPositions(ctx, client, collectionID); err != nil {
+			return nil, err
+		}
+		if position, ok, err = positionBetween(ctx, client, collectionID, id, afterID, beforeID); err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errors.New("neighbors are out of order")
+		}
+	}
+
+	if err := client.Flashcard.
+		UpdateOneID(id).
+		SetCollectionID(collectionID).
+		SetPosition(position).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	// Tags are scoped to the collection of their flashcard; review progress and
+	// history stay attached to the flashcard itself
+	if moving.CollectionID != collectionID {
+		if err := client.FlashcardTag.
+			Update().
+			Where(flashcardtag.FlashcardID(id)).
+			SetCollectionID(collectionID).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return client.Flashcard.
+		Query().
+		Where(flashcard.ID(id)).
+		WithMedia().
+		WithTags().
+		Only(ctx)
+}
+
+// positionBetween returns a position between the neighbors, or false if they are
+// too close together or out of order
+func positionBetween(ctx context.Context, client *ent.Client, collectionID, id uuid.UUID, afterID, beforeID *uuid.UUID) (float64, bool, error) {
+	if (afterID != nil && *afterID == id) || (beforeID != nil && *beforeID == id) {
+		return 0, false, errors.New("a flashcard cannot be its own neighbor")
+	}
+
+	var after, before *ent.Flashcard
+	var err error
+
+	if afterID != nil {
+		if after, err = liveFlashcardIn(ctx, client, collectionID, *afterID); err != nil {
+			return 0, false, err
+		}
+	}
+	if beforeID != nil {
+		if before, err = liveFlashcardIn(ctx, client, collectionID, *beforeID); err != nil {
+			return 0, false, err
+		}
+	}
+
+	switch {
+	case after == nil && before == nil:
+		// Without neighbors the flashcard goes to the end
+		last, err := lastPosition(ctx, client, collectionID)
+		return last + PositionStep, true, err
+	case before == nil:
+		if before, err = neighbor(ctx, client, collectionID, id, after, true); err != nil {
+			return 0, false, err
+		}
+	case after == nil:
+		if after, err = neighbor(ctx, client, collectionID, id, before, false); err != nil {
+			return 0, false, err
+		}
+	}
+
+	switch {
+	case after == nil && before == nil:
+		// The flashcard is the only one in the collection
+		return PositionStep, true, nil
+	case before == nil:
+		return after.Position + PositionStep, true, nil
+	case after == nil:
+		return before.Position - PositionStep, true, nil
+	}
+
+	if before.Position-after.Position < minPositionGap {
+		return 0, false, nil
+	}
+
+	return after.Position + (before.Position-after.Position)/2, true, nil
+}
+
+// neighbor returns the live flashcard right after (or before) the given one,
+// skipping the card being moved
+func neighbor(ctx context.Context, client *ent.Client, collectionID, id uuid.UUID, of *ent.Flashcard, next bool) (*ent.Flashcard, error) {
+	query := client.Flashcard.
+		Query().
+		Where(
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+			flashcard.IDNEQ(id),
+		)
+
+	if next {
+		query = query.
+			Where(flashcard.Or(
+				flashcard.PositionGT(of.Position),
+				flashcard.And(flashcard.Position(of.Position), flashcard.IDGT(of.ID)),
+			)).
+			Order(flashcard.ByPosition(), flashcard.ByID())
+	} else {
+		query = query.
+			Where(flashcard.Or(
+				flashcard.PositionLT(of.Position),
+				flashcard.And(flashcard.Position(of.Position), flashcard.IDLT(of.ID)),
+			)).
+			Order(ent.Desc(flashcard.FieldPosition), ent.Desc(flashcard.FieldID))
+	}
+
+	found, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return found, err
+}
+
+func liveFlashcardIn(ctx context.Context, client *ent.Client, collectionID, id uuid.UUID) (*ent.Flashcard, error) {
+	return client.Flashcard.
+		Query().
+		Where(
+			flashcard.ID(id),
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+		).
+		Only(ctx)
+}
+
+// renumberPositions spreads the positions of a collection evenly in their current
+// order, in a single statement
+func renumberPositions(ctx context.Context, client *ent.Client, collectionID uuid.UUID) error {
+	_, err := client.ExecContext(ctx, `
+		UPDATE flashcards SET position = ranked.n * $2
+		FROM (
+			SELECT id, row_number() OVER (ORDER BY position, id) AS n
+			FROM flashcards WHERE collection_id = $1
+		) AS ranked
+		WHERE flashcards.id = ranked.id`,
+		collectionID, PositionStep,
+	)
+	return err
+}
+
+func (r *FlashcardRepositoryImpl) MoveToCollection(ctx context.Context, ids []uuid.UUID, targetCollectionID uuid.UUID) ([]*ent.Flashcard, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	moved, err := moveToCollection(ctx, tx.Client(), ids, targetCollectionID)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return moved, tx.Commit()
+}
+
+func moveToCollection(ctx context.Context, client *ent.Client, ids []uuid.UUID, targetCollectionID uuid.UUID) ([]*ent.Flashcard, error) {
+	// Serializes with reordering in the target collection
+	if _, err := client.Collection.
+		Query().
+		Where(collection.ID(targetCollectionID)).
+		ForUpdate().
+		Only(ctx); err != nil {
+		return nil, err
+	}
+
+	flashcards, err := client.Flashcard.
+		Query().
+		Where(
+			flashcard.IDIn(ids...),
+			flashcard.DeletedAtIsNil(),
+		).
+		Order(flashcard.ByCollectionID(), flashcard.ByPosition(), flashcard.ByID()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := lastPosition(ctx, client, targetCollectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fc := range flashcards {
+		position += PositionStep
+		if err := client.Flashcard.
+			UpdateOneID(fc.ID).
+			SetCollectionID(targetCollectionID).
+			SetPosition(position).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// Tags are scoped to the collection of their flashcard
+	if err := client.FlashcardTag.
+		Update().
+		Where(flashcardtag.FlashcardIDIn(ids...)).
+		SetCollectionID(targetCollectionID).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return client.Flashcard.
+		Query().
+		Where(flashcard.IDIn(ids...)).
+		WithMedia().
+		WithTags().
+		Order(flashcard.ByPosition()).
+		All(ctx)
+}
+
+func (r *FlashcardRepositoryImpl) CopyToCollection(ctx context.Context, ids []uuid.UUID, targetCollectionID uuid.UUID, createdBy, progressOf string) ([]*ent.Flashcard, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	copies, err := copyToCollection(ctx, tx.Client(), ids, targetCollectionID, createdBy, progressOf)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return copies, tx.Commit()
+}
+
+func copyToCollection(ctx context.Context, client *ent.Client, ids []uuid.UUID, targetCollectionID uuid.UUID, createdBy, progressOf string) ([]*ent.Flashcard, error) {
+	if _, err := client.Collection.
+		Query().
+		Where(collection.ID(targetCollectionID)).
+		ForUpdate().
+		Only(ctx); err != nil {
+		return nil, err
+	}
+
+	query := client.Flashcard.
+		Query().
+		Where(
+			flashcard.IDIn(ids...),
+			flashcard.DeletedAtIsNil(),
+		).
+		WithMedia().
+		WithTags().
+		Order(flashcard.ByCollectionID(), flashcard.ByPosition(), flashcard.ByID())
+	if progressOf != "" {
+		query = query.WithReviews(func(q *ent.FlashcardReviewQuery) {
+			q.Where(flashcardreview.UserID(progressOf))
+		})
+	}
+
+	flashcards, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	copies := make([]*ent.Flashcard, 0, len(flashcards))
+	for _, fc := range flashcards {
+		copied, err := createFlashcard(ctx, client, FlashcardFields{
+			Question:        fc.Question,
+			Answer:          fc.Answer,
+			Type:            fc.Type,
+			ContentFormat:   fc.ContentFormat,
+			QuestionHTML:    fc.QuestionHTML,
+			AnswerHTML:      fc.AnswerHTML,
+			QuestionKey:     fc.QuestionKey,
+			QuestionText:    fc.QuestionText,
+			AnswerText:      fc.AnswerText,
+			Hint:            &fc.Hint,
+			HintHTML:        fc.HintHTML,
+			Explanation:     &fc.Explanation,
+			ExplanationHTML: fc.ExplanationHTML,
+			Sources:         fc.Sources,
+			Options:         fc.Options,
+			Pairs:           fc.Pairs,
+			Occlusion:       fc.Occlusion,
+			MediaIDs:        mediaIDsOf(fc),
+			Status:          fc.Status.String(),
+		}, targetCollectionID, createdBy)
+		if err != nil {
+			return nil, err
+		}
+
+		tags := make([]*ent.FlashcardTagCreate, len(fc.Edges.Tags))
+		for i, tag := range fc.Edges.Tags {
+			tags[i] = client.FlashcardTag.
+				Create().
+				SetFlashcardID(copied.ID).
+				SetCollectionID(targetCollectionID).
+				SetName(tag.Name)
+		}
+		if err := client.FlashcardTag.CreateBulk(tags...).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		// Reviews are only loaded for progressOf
+		reviews := make([]*ent.FlashcardReviewCreate, 0, len(fc.Edges.Reviews))
+		for _, review := range fc.Edges.Reviews {
+			reviews = append(reviews, client.FlashcardReview.
+				Create().
+				SetUserID(review.UserID).
+				SetFlashcardID(copied.ID).
+				SetItem(review.Item).
+				SetEaseFactor(review.EaseFactor).
+				SetInterval(review.Interval).
+				SetDueAt(review.DueAt).
+				SetStatus(review.Status).
+				SetLearningStep(review.LearningStep).
+				SetReviewCount(review.ReviewCount).
+				SetLapseCount(review.LapseCount).
+				SetNillableLastReviewedAt(review.LastReviewedAt))
+		}
+		if err := client.FlashcardReview.CreateBulk(reviews...).Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		copies = append(copies, copied)
+	}
+
+	copiedIDs := make([]uuid.UUID, len(copies))
+	for i, copied := range copies {
+		copiedIDs[i] = copied.ID
+	}
+
+	return client.Flashcard.
+		Query().
+		Where(flashcard.IDIn(copiedIDs...)).
+		WithMedia().
+		WithTags().
+		Order(flashcard.ByPosition()).
 		All(ctx)
 }
@@ -18,6 +18,7 @@ type FlashcardReviewUpdate struct {
 	LearningStep   int
 	ReviewCount    int
 	LapseCount     int
+	HintCount      int
 	LastReviewedAt time.Time
 }
 
@@ -31,25 +32,31 @@ type CollectionStats struct {
 	AverageEase   float64
 	TotalReviews  int
 	TotalLapses   int
+	HintedReviews int // Reviews answered after revealing the hint
 	MatureCards   int // Cards with interval >= 21 days
 }
 
 // FlashcardReviewRepository defines the interface for flashcard review data access
 type FlashcardReviewRepository interface {
-	// GetOrCreate returns an existing review or creates a new one for user-flashcard pair
-	GetOrCreate(ctx context.Context, userID string, flashcardID uuid.UUID) (*ent.FlashcardReview, error)
+	// GetOrCreate returns an existing review or creates a new one for a user-flashcard item
+	GetOrCreate(ctx context.Context, userID string, flashcardID uuid.UUID, item int) (*ent.FlashcardReview, error)
 
 	// GetByID returns a review by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*ent.FlashcardReview, error)
 
-	// GetByUserAndFlashcard returns a review for a specific user-flashcard pair
-	GetByUserAndFlashcard(ctx context.Context, userID string, flashcardID uuid.UUID) (*ent.FlashcardReview, error)
+	// GetByUserAndFlashcard returns a review for a specific user-flashcard item
+	GetByUserAndFlashcard(ctx context.Context, userID string, flashcardID uuid.UUID, item int) (*ent.FlashcardReview, error)
 
 	// Update updates a review with new SRS data
 	Update(ctx context.Context, id uuid.UUID, update FlashcardReviewUpdate) (*ent.FlashcardReview, error)
 
+	// ListByUser returns every review of a user, in any collection
+	ListByUser(ctx context.Context, userID string) ([]*ent.FlashcardReview, error)
+
+	// The collection methods below cover the collection and all its sub-collections
+
 	// ListDueByCollection returns all reviews due for a user in a specific collection
-	ListDueByCollection(ctx context.Context, userID string, collectionID uuid.UUID, limit int) ([]*ent.FlashcardReview, error)
+	ListDueByCollection(ctx context.Context, userID string, collectionID uuid.UUID, limit int, filter FlashcardFilter) ([]*ent.FlashcardReview, error)
 
 	// ListByCollection returns all reviews for a user in a specific collection
 	ListByCollection(ctx context.Context, userID string, collectionID uuid.UUID) ([]*ent.FlashcardReview, error)
@@ -57,7 +64,7 @@ type FlashcardReviewRepository interface {
 	// GetCollectionStats returns learning statistics for a collection
 	GetCollectionStats(ctx context.Context, userID string, collectionID uuid.UUID) (*CollectionStats, error)
 
-	// CreateBulkForCollection creates review entries for all flashcards in a collection for a user
+	// CreateBulkForCollection creates review entries for all flashcard items in a collection for a user
 	CreateBulkForCollection(ctx context.Context, userID string, collectionID uuid.UUID) error
 
 	// DeleteByCollection deletes all review entries for a user in a specific collection
@@ -4,10 +4,12 @@ import (
 	"context"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
 	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
 )
 
 // FlashcardReviewRepositoryImpl implements FlashcardReviewRepository using Ent ORM
@@ -19,12 +21,13 @@ func NewFlashcardReviewRepository(client *ent.Client) FlashcardReviewRepository
 	return &FlashcardReviewRepositoryImpl{client: client}
 }
 
-func (r *FlashcardReviewRepositoryImpl) GetOrCreate(ctx context.Context, userID string, flashcardID uuid.UUID) (*ent.FlashcardReview, error) {
+func (r *FlashcardReviewRepositoryImpl) GetOrCreate(ctx context.Context, userID string, flashcardID uuid.UUID, item int) (*ent.FlashcardReview, error) {
 	review, err := r.client.FlashcardReview.
 		Query().
 		Where(
 			flashcardreview.UserID(userID),
 			flashcardreview.FlashcardID(flashcardID),
+			flashcardreview.Item(item),
 		).
 		Only(ctx)
 
@@ -37,6 +40,7 @@ func (r *FlashcardReviewRepositoryImpl) GetOrCreate(ctx context.Context, userID
 			Create().
 			SetUserID(userID).
 			SetFlashcardID(flashcardID).
+			SetItem(item).
 			Save(ctx)
 	}
 
@@ -51,12 +55,13 @@ func (r *FlashcardReviewRepositoryImpl) GetByID(ctx context.Context, id uuid.UUI
 		Only(ctx)
 }
 
-func (r *FlashcardReviewRepositoryImpl) GetByUserAndFlashcard(ctx context.Context, userID string, flashcardID uuid.UUID) (*ent.FlashcardReview, error) {
+func (r *FlashcardReviewRepositoryImpl) GetByUserAndFlashcard(ctx context.Context, userID string, flashcardID uuid.UUID, item int) (*ent.FlashcardReview, error) {
 	return r.client.FlashcardReview.
 		Query().
 		Where(
 			flashcardreview.UserID(userID),
 			flashcardreview.FlashcardID(flashcardID),
+			flashcardreview.Item(item),
 		).
 		WithFlashcard().
 		Only(ctx)
@@ -72,20 +77,47 @@ func (r *FlashcardReviewRepositoryImpl) Update(ctx context.Context, id uuid.UUID
 		SetLearningStep(update.LearningStep).
 		SetReviewCount(update.ReviewCount).
 		SetLapseCount(update.LapseCount).
+		SetHintCount(update.HintCount).
 		SetLastReviewedAt(update.LastReviewedAt).
 		Save(ctx)
 }
 
-func (r *FlashcardReviewRepositoryImpl) ListDueByCollection(ctx context.Context, userID string, collectionID uuid.UUID, limit int) ([]*ent.FlashcardReview, error) {
+func (r *FlashcardReviewRepositoryImpl) ListByUser(ctx context.Context, userID string) ([]*ent.FlashcardReview, error) {
+	return r.client.FlashcardReview.
+		Query().
+		Where(flashcardreview.UserID(userID)).
+		Order(ent.Asc(flashcardreview.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r *FlashcardReviewRepositoryImpl) ListDueByCollection(ctx context.Context, userID string, collectionID uuid.UUID, limit int, filter FlashcardFilter) ([]*ent.FlashcardReview, error) {
+	cardPredicates := []predicate.Flashcard{
+		FlashcardInTree(collectionID),
+		flashcard.StatusEQ(flashcard.StatusPublished),
+	}
+	if len(filter.Tags) > 0 {
+		cardPredicates = append(cardPredicates, HasTagsUnder(filter.Tags))
+	}
+	if !filter.Annotations.IsEmpty() {
+		cardPredicates = append(cardPredicates, AnnotatedBy(userID, filter.Annotations))
+	}
+
 	query := r.client.FlashcardReview.
 		Query().
 		Where(
 			flashcardreview.UserID(userID),
 			flashcardreview.DueAtLTE(time.Now()),
-			flashcardreview.HasFlashcardWith(flashcard.CollectionID(collectionID)),
+			flashcardreview.HasFlashcardWith(cardPredicates...),
 		).
-		WithFlashcard().
-		Order(flashcardreview.ByDueAt())
+		WithFlashcard(func(q *ent.FlashcardQuery) {
+			q.WithTags()
+			q.WithAnnotations(withAnnotationOf(userID))
+		}).
+		Order(
+			dueCardsFirst,
+			flashcardreview.ByFlashcardField(flashcard.FieldPosition),
+			flashcardreview.ByItem(),
+		)
 
 	if limit > 0 {
 		query = query.Limit(limit)
@@ -94,12 +126,25 @@ func (r *FlashcardReviewRepositoryImpl) ListDueByCollection(ctx context.Context,
 	return query.All(ctx)
 }
 
+// dueCardsFirst orders cards already being learned by due date, followed by new
+// cards, which are introduced in collection order
+func dueCardsFirst(s *sql.Selector) {
+	isNew := sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(s.C(flashcardreview.FieldStatus)).WriteString(" = ").Arg(flashcardreview.StatusNew.String())
+	})
+	s.OrderExpr(isNew)
+	s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("CASE WHEN ").Join(isNew).WriteString(" THEN NULL ELSE ").
+			Ident(s.C(flashcardreview.FieldDueAt)).WriteString(" END")
+	}))
+}
+
 func (r *FlashcardReviewRepositoryImpl) ListByCollection(ctx context.Context, userID string, collectionID uuid.UUID) ([]*ent.FlashcardReview, error) {
 	return r.client.FlashcardReview.
 		Query().
 		Where(
 			flashcardreview.UserID(userID),
-			flashcardreview.HasFlashcardWith(flashcard.CollectionID(collectionID)),
+			flashcardreview.HasFlashcardWith(FlashcardInTree(collectionID)),
 		).
 		WithFlashcard().
 		All(ctx)
@@ -140,6 +185,7 @@ func (r *FlashcardReviewRepositoryImpl) GetCollectionStats(ctx context.Context,
 
 		stats.TotalReviews += review.ReviewCount
 		stats.TotalLapses += review.LapseCount
+		stats.HintedReviews += review.HintCount
 
 		// Mature cards have interval >= 21 days (30240 minutes)
 		if review.Interval >= 30240 {
@@ -157,7 +203,10 @@ func (r *FlashcardReviewRepositoryImpl) GetCollectionStats(ctx context.Context,
 func (r *FlashcardReviewRepositoryImpl) CreateBulkForCollection(ctx context.Context, userID string, collectionID uuid.UUID) error {
 	flashcards, err := r.client.Flashcard.
 		Query().
-		Where(flashcard.CollectionID(collectionID)).
+		Where(
+			FlashcardInTree(collectionID),
+			flashcard.StatusEQ(flashcard.StatusPublished),
+		).
 		All(ctx)
 
 	if err != nil {
@@ -165,9 +214,11 @@ func (r *FlashcardReviewRepositoryImpl) CreateBulkForCollection(ctx context.Cont
 	}
 
 	for _, fc := range flashcards {
-		_, err := r.GetOrCreate(ctx, userID, fc.ID)
-		if err != nil {
-			return err
+		for item := 0; item < fc.Occlusion.ReviewItemCount(); item++ {
+			_, err := r.GetOrCreate(ctx, userID, fc.ID, item)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -179,7 +230,8 @@ func (r *FlashcardReviewRepositoryImpl) DeleteByCollection(ctx context.Context,
 		Delete().
 		Where(
 			flashcardreview.UserID(userID),
-			flashcardreview.HasFlashcardWith(flashcard.CollectionID(collectionID)),
+			// Progress on trashed flashcards is cleared as well
+			flashcardreview.HasFlashcardWith(predicate.Flashcard(inTree(flashcard.FieldCollectionID, treeRoot(collectionID), true))),
 		).
 		Exec(ctx)
 
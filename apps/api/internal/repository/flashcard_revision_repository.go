@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// Names of the flashcard fields tracked by revisions
+const (
+	RevisionFieldQuestion      = "question"
+	RevisionFieldAnswer        = "answer"
+	RevisionFieldType          = "type"
+	RevisionFieldContentFormat = "content_format"
+	RevisionFieldHint          = "hint"
+	RevisionFieldExplanation   = "explanation"
+	RevisionFieldSources       = "sources"
+	RevisionFieldOptions       = "options"
+	RevisionFieldPairs         = "pairs"
+	RevisionFieldOcclusion     = "occlusion"
+	RevisionFieldMedia         = "media"
+)
+
+// FlashcardRevisionRepository defines the interface for flashcard revision data access.
+// Revisions are written by FlashcardRepository together with the edit they record.
+type FlashcardRevisionRepository interface {
+	// ListByFlashcard returns the revisions of a flashcard, newest first
+	ListByFlashcard(ctx context.Context, flashcardID uuid.UUID) ([]*ent.FlashcardRevision, error)
+	GetByNumber(ctx context.Context, flashcardID uuid.UUID, number int) (*ent.FlashcardRevision, error)
+}
@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardrevision"
+)
+
+// FlashcardRevisionRepositoryImpl implements FlashcardRevisionRepository using Ent ORM
+type FlashcardRevisionRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewFlashcardRevisionRepository(client *ent.Client) FlashcardRevisionRepository {
+	return &FlashcardRevisionRepositoryImpl{client: client}
+}
+
+func (r *FlashcardRevisionRepositoryImpl) ListByFlashcard(ctx context.Context, flashcardID uuid.UUID) ([]*ent.FlashcardRevision, error) {
+	return r.client.FlashcardRevision.
+		Query().
+		Where(flashcardrevision.FlashcardID(flashcardID)).
+		Order(ent.Desc(flashcardrevision.FieldNumber)).
+		All(ctx)
+}
+
+func (r *FlashcardRevisionRepositoryImpl) GetByNumber(ctx context.Context, flashcardID uuid.UUID, number int) (*ent.FlashcardRevision, error) {
+	return r.client.FlashcardRevision.
+		Query().
+		Where(
+			flashcardrevision.FlashcardID(flashcardID),
+			flashcardrevision.Number(number),
+		).
+		Only(ctx)
+}
+
+// lastRevisionNumber returns the number of the latest revision, or 0 for a flashcard
+// without history
+func lastRevisionNumber(ctx context.Context, client *ent.Client, flashcardID uuid.UUID) (int, error) {
+	last, err := client.FlashcardRevision.
+		Query().
+		Where(flashcardrevision.FlashcardID(flashcardID)).
+		Order(ent.Desc(flashcardrevision.FieldNumber)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return last.Number, nil
+}
+
+// createRevision records a snapshot of the flashcard as revision number
+func createRevision(ctx context.Context, client *ent.Client, fc *ent.Flashcard, number int, mediaIDs []uuid.UUID, authorID string, changed []string, createdAt time.Time) error {
+	return client.FlashcardRevision.
+		Create().
+		SetFlashcardID(fc.ID).
+		SetNumber(number).
+		SetAuthorID(authorID).
+		SetChangedFields(changed).
+		SetQuestion(fc.Question).
+		SetAnswer(fc.Answer).
+		SetType(fc.Type).
+		SetContentFormat(fc.ContentFormat).
+		SetHint(fc.Hint).
+		SetExplanation(fc.Explanation).
+		SetSources(fc.Sources).
+		SetOptions(fc.Options).
+		SetPairs(fc.Pairs).
+		SetOcclusion(fc.Occlusion).
+		SetMediaIds(mediaIDs).
+		SetCreatedAt(createdAt).
+		Exec(ctx)
+}
+
+// changedFields lists the tracked fields that differ between the flashcard and the
+// new content. A nil MediaIDs leaves the attachments unchanged.
+func changedFields(fc *ent.Flashcard, fields FlashcardFields) []string {
+	var changed []string
+
+	if fc.Question != fields.Question {
+		changed = append(changed, RevisionFieldQuestion)
+	}
+	if fc.Answer != fields.Answer {
+		changed = append(changed, RevisionFieldAnswer)
+	}
+	if fc.Type != fields.Type {
+		changed = append(changed, RevisionFieldType)
+	}
+	if fc.ContentFormat != fields.ContentFormat {
+		changed = append(changed, RevisionFieldContentFormat)
+	}
+	if fields.Hint != nil && fc.Hint != *fields.Hint {
+		changed = append(changed, RevisionFieldHint)
+	}
+	if fields.Explanation != nil && fc.Explanation != *fields.Explanation {
+		changed = append(changed, RevisionFieldExplanation)
+	}
+	if !equalJSON(fc.Sources, fields.Sources) {
+		changed = append(changed, RevisionFieldSources)
+	}
+	if !equalJSON(fc.Options, fields.Options) {
+		changed = append(changed, RevisionFieldOptions)
+	}
+	if !equalJSON(fc.Pairs, fields.Pairs) {
+		changed = append(changed, RevisionFieldPairs)
+	}
+	if !reflect.DeepEqual(fc.Occlusion, fields.Occlusion) {
+		changed = append(changed, RevisionFieldOcclusion)
+	}
+	if fields.MediaIDs != nil && !sameIDs(mediaIDsOf(fc), fields.MediaIDs) {
+		changed = append(changed, RevisionFieldMedia)
+	}
+
+	return changed
+}
+
+// equalJSON compares two slices stored as JSON, treating nil and empty as equal
+func equalJSON[T any](a, b []T) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func sameIDs(a, b []uuid.UUID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[uuid.UUID]bool, len(a))
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
+
+func mediaIDsOf(fc *ent.Flashcard) []uuid.UUID {
+	ids := make([]uuid.UUID, len(fc.Edges.Media))
+	for i, m := range fc.Edges.Media {
+		ids[i] = m.ID
+	}
+	return ids
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// ItemStatisticRepository defines the interface for access to the statistics of
+// quiz questions. The statistics are recorded as attempts are submitted.
+type ItemStatisticRepository interface {
+	// ListByQuiz returns the statistics of the questions of a quiz with their flashcards
+	ListByQuiz(ctx context.Context, quizID uuid.UUID) ([]*ent.ItemStatistic, error)
+
+	// ListByFlashcard returns the statistics of a flashcard across all quizzes,
+	// if any, followed by those of each quiz that asked it
+	ListByFlashcard(ctx context.Context, flashcardID uuid.UUID) ([]*ent.ItemStatistic, error)
+}
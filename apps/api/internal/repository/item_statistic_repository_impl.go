@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/itemstatistic"
+)
+
+// ItemStatisticRepositoryImpl implements ItemStatisticRepository using Ent ORM
+type ItemStatisticRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewItemStatisticRepository(client *ent.Client) ItemStatisticRepository {
+	return &ItemStatisticRepositoryImpl{client: client}
+}
+
+func (r *ItemStatisticRepositoryImpl) ListByQuiz(ctx context.Context, quizID uuid.UUID) ([]*ent.ItemStatistic, error) {
+	return r.client.ItemStatistic.
+		Query().
+		Where(itemstatistic.QuizID(quizID)).
+		WithFlashcard().
+		All(ctx)
+}
+
+func (r *ItemStatisticRepositoryImpl) ListByFlashcard(ctx context.Context, flashcardID uuid.UUID) ([]*ent.ItemStatistic, error) {
+	return r.client.ItemStatistic.
+		Query().
+		Where(itemstatistic.FlashcardID(flashcardID)).
+		Order(itemstatistic.ByQuizID(sql.OrderNullsFirst())).
+		All(ctx)
+}
+
+// recordItemStatistics adds the answers of a submitted attempt to the statistics
+// of its questions, in the quiz and across all quizzes. Unanswered questions count
+// with a score of 0. The flashcards are locked first, in a fixed order, so that
+// concurrent submissions update each statistics row one at a time.
+func recordItemStatistics(ctx context.Context, client *ent.Client, quizID uuid.UUID, score float64, answers []*ent.QuizAnswer) error {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(answers))
+	for i, answer := range answers {
+		ids[i] = answer.FlashcardID
+	}
+	if _, err := client.Flashcard.
+		Query().
+		Where(flashcard.IDIn(ids...)).
+		Order(flashcard.ByID()).
+		ForUpdate().
+		IDs(ctx); err != nil {
+		return err
+	}
+
+	for _, answer := range answers {
+		// The rest score relates the question to the rest of the attempt for the
+		// discrimination index
+		rest := 0.0
+		if len(answers) > 1 {
+			rest = (score - answer.Score) / float64(len(answers)-1)
+		}
+
+		for _, scope := range []*uuid.UUID{&quizID, nil} {
+			if err := addItemObservation(ctx, client, answer, scope, rest); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func addItemObservation(ctx context.Context, client *ent.Client, answer *ent.QuizAnswer, quizID *uuid.UUID, rest float64) error {
+	query := client.ItemStatistic.
+		Query().
+		Where(itemstatistic.FlashcardID(answer.FlashcardID))
+	if quizID != nil {
+		query.Where(itemstatistic.QuizID(*quizID))
+	} else {
+		query.Where(itemstatistic.QuizIDIsNil())
+	}
+
+	stat, err := query.Only(ctx)
+	if ent.IsNotFound(err) {
+		stat, err = client.ItemStatistic.
+			Create().
+			SetFlashcardID(answer.FlashcardID).
+			SetNillableQuizID(quizID).
+			Save(ctx)
+	}
+	if err != nil {
+		return err
+	}
+
+	update := stat.Update().
+		AddAttemptCount(1).
+		AddScoreSum(answer.Score).
+		AddScoreSquares(answer.Score * answer.Score).
+		AddRestSum(rest).
+		AddRestSquares(rest * rest).
+		AddScoreRestProducts(answer.Score * rest)
+
+	if answer.AnsweredAt != nil {
+		update.
+			AddAnsweredCount(1).
+			AddTimeSpentMs(int64(answer.TimeSpentMs))
+
+		if choice, ok := chosenOption(answer); ok {
+			counts := make(map[string]int, len(stat.OptionCounts)+1)
+			for option, count := range stat.OptionCounts {
+				counts[option] = count
+			}
+			counts[choice]++
+			update.SetOptionCounts(counts)
+		}
+	}
+
+	return update.Exec(ctx)
+}
+
+// chosenOption returns the option picked in an answer to a question asked with
+// options
+func chosenOption(answer *ent.QuizAnswer) (string, bool) {
+	if answer.Response == nil {
+		return "", false
+	}
+	given := strings.TrimSpace(answer.Response.Answer)
+	for _, option := range answer.Options {
+		if option == given {
+			return option, true
+		}
+	}
+	return "", false
+}
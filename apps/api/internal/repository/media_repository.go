@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// MediaRepository defines the interface for media attachment data access
+type MediaRepository interface {
+	Create(ctx context.Context, hash, contentType string, size int64, storageKey, uploadedBy string) (*ent.Media, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.Media, error)
+	GetByHash(ctx context.Context, hash string) (*ent.Media, error)
+
+	// RecordUpload records that the user uploaded the media content now
+	RecordUpload(ctx context.Context, id uuid.UUID, userID string) error
+
+	// IsUploader reports whether the user uploaded the media content
+	IsUploader(ctx context.Context, m *ent.Media, userID string) (bool, error)
+
+	// ListCollectionIDs returns the collections containing flashcards that reference the media
+	ListCollectionIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error)
+
+	// ListUnreferenced returns media that no flashcard references and that was
+	// neither created nor uploaded again since the given time
+	ListUnreferenced(ctx context.Context, before time.Time, limit int) ([]*ent.Media, error)
+
+	// DeleteUnreferenced deletes the media if it is still unreferenced as of the
+	// given time, and reports whether it was deleted
+	DeleteUnreferenced(ctx context.Context, id uuid.UUID, before time.Time) (bool, error)
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/media"
+	"github.com/quanphung1120/advanced-quiz-be/ent/mediaupload"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// MediaRepositoryImpl implements MediaRepository using Ent ORM
+type MediaRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewMediaRepository(client *ent.Client) MediaRepository {
+	return &MediaRepositoryImpl{client: client}
+}
+
+func (r *MediaRepositoryImpl) Create(ctx context.Context, hash, contentType string, size int64, storageKey, uploadedBy string) (*ent.Media, error) {
+	return r.client.Media.
+		Create().
+		SetHash(hash).
+		SetContentType(contentType).
+		SetSize(size).
+		SetStorageKey(storageKey).
+		SetUploadedBy(uploadedBy).
+		Save(ctx)
+}
+
+func (r *MediaRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.Media, error) {
+	return r.client.Media.
+		Query().
+		Where(media.ID(id)).
+		Only(ctx)
+}
+
+func (r *MediaRepositoryImpl) GetByHash(ctx context.Context, hash string) (*ent.Media, error) {
+	return r.client.Media.
+		Query().
+		Where(media.Hash(hash)).
+		Only(ctx)
+}
+
+func (r *MediaRepositoryImpl) RecordUpload(ctx context.Context, id uuid.UUID, userID string) error {
+	updated, err := r.client.MediaUpload.
+		Update().
+		Where(
+			mediaupload.MediaID(id),
+			mediaupload.UserID(userID),
+		).
+		SetUploadedAt(time.Now()).
+		Save(ctx)
+	if err != nil || updated > 0 {
+		return err
+	}
+
+	err = r.client.MediaUpload.
+		Create().
+		SetMediaID(id).
+		SetUserID(userID).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		// Either a concurrent upload by the same user recorded it first, or the
+		// media was deleted
+		exists, existsErr := r.client.MediaUpload.
+			Query().
+			Where(
+				mediaupload.MediaID(id),
+				mediaupload.UserID(userID),
+			).
+			Exist(ctx)
+		if existsErr == nil && exists {
+			return nil
+		}
+	}
+	return err
+}
+
+func (r *MediaRepositoryImpl) IsUploader(ctx context.Context, m *ent.Media, userID string) (bool, error) {
+	if m.UploadedBy == userID {
+		return true, nil
+	}
+
+	return r.client.MediaUpload.
+		Query().
+		Where(
+			mediaupload.MediaID(m.ID),
+			mediaupload.UserID(userID),
+		).
+		Exist(ctx)
+}
+
+func (r *MediaRepositoryImpl) ListCollectionIDs(ctx context.Context, id uuid.UUID) ([]uuid.UUID, error) {
+	flashcards, err := r.client.Flashcard.
+		Query().
+		Where(flashcard.HasMediaWith(media.ID(id))).
+		Select(flashcard.FieldCollectionID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var collectionIDs []uuid.UUID
+	for _, fc := range flashcards {
+		if !seen[fc.CollectionID] {
+			seen[fc.CollectionID] = true
+			collectionIDs = append(collectionIDs, fc.CollectionID)
+		}
+	}
+
+	return collectionIDs, nil
+}
+
+func (r *MediaRepositoryImpl) ListUnreferenced(ctx context.Context, before time.Time, limit int) ([]*ent.Media, error) {
+	return r.client.Media.
+		Query().
+		Where(unreferencedMedia(before)...).
+		Limit(limit).
+		All(ctx)
+}
+
+func (r *MediaRepositoryImpl) DeleteUnreferenced(ctx context.Context, id uuid.UUID, before time.Time) (bool, error) {
+	// The conditions are checked by the delete statement itself, so that media
+	// attached or uploaded again since it was listed is kept
+	deleted, err := r.client.Media.
+		Delete().
+		Where(append(unreferencedMedia(before), media.ID(id))...).
+		Exec(ctx)
+	return deleted > 0, err
+}
+
+// unreferencedMedia matches media that no flashcard references and that was neither
+// created nor uploaded again since the given time
+func unreferencedMedia(before time.Time) []predicate.Media {
+	return []predicate.Media{
+		media.Not(media.HasFlashcards()),
+		media.CreatedAtLT(before),
+		media.Not(media.HasUploadsWith(mediaupload.UploadedAtGTE(before))),
+	}
+}
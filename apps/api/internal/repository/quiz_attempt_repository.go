@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+)
+
+// AttemptQuestion is a question of a new quiz attempt
+type AttemptQuestion struct {
+	FlashcardID uuid.UUID
+	Options     []string // Generated choices of a simple card asked as multiple choice
+}
+
+// AttemptLimits restricts the attempts at a quiz
+type AttemptLimits struct {
+	TimeLimit   time.Duration // Time from the start to the deadline, 0 for none
+	ClosesAt    *time.Time    // When the quiz closes, which no deadline is after
+	MaxAttempts int           // Attempts allowed per user, 0 for unlimited
+}
+
+// QuizAnswerGrade is a learner's answer to a quiz question with its grade
+type QuizAnswerGrade struct {
+	Response *schema.QuizResponse
+	Correct  bool
+	Score    float64 // From 0 to 1
+
+	// Only accept the answer while the question is unanswered, as in adaptive
+	// attempts, where each answer picks the next question
+	AnswerOnce bool
+}
+
+// AdaptiveStep is what follows an answer to an adaptive attempt
+type AdaptiveStep struct {
+	Ability      float64          // Updated ability estimate of the learner
+	AbilityError float64          // Standard error of the ability estimate
+	Difficulty   float64          // Updated difficulty estimate of the flashcard answered
+	Next         *AttemptQuestion // Question to add, nil to submit the attempt
+}
+
+// AdaptiveAdvance computes the step after an answer from the current estimates of
+// the learner's ability and of the difficulty of the flashcard answered
+type AdaptiveAdvance func(learner *ent.LearnerAbility, rating *ent.FlashcardRating) (*AdaptiveStep, error)
+
+// AdaptiveAnswer is the outcome of an answer to an adaptive attempt
+type AdaptiveAnswer struct {
+	Answer    *ent.QuizAnswer
+	Next      *ent.QuizAnswer  // Question added, nil when the attempt was submitted
+	Submitted *ent.QuizAttempt // Attempt when it was submitted
+}
+
+// QuizAttemptRepository defines the interface for quiz attempt data access
+type QuizAttemptRepository interface {
+	// Start creates an attempt with the questions unanswered, in order. The start
+	// time and deadline are taken from the server clock; the deadline is the end of
+	// the time limit or the closing of the quiz, whichever comes first. The attempt
+	// is refused once the user has used up the allowed attempts.
+	Start(ctx context.Context, quizID uuid.UUID, userID string, questions []AttemptQuestion, limits AttemptLimits) (*ent.QuizAttempt, error)
+
+	// GetByID returns an attempt with its answers in question order and their
+	// flashcards, including trashed ones
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.QuizAttempt, error)
+
+	// ListByQuizAndUser returns the user's attempts at a quiz, newest first
+	ListByQuizAndUser(ctx context.Context, quizID uuid.UUID, userID string) ([]*ent.QuizAttempt, error)
+
+	// SaveAnswer records the graded answer to a question of an attempt in progress,
+	// replacing any previous answer. The time since the previous answer of the
+	// attempt, or since its start, is added to the time spent on the question.
+	SaveAnswer(ctx context.Context, attemptID uuid.UUID, position int, grade QuizAnswerGrade, answeredAt time.Time) (*ent.QuizAnswer, error)
+
+	// AnswerAdaptive records the graded answer to an unanswered question of an
+	// adaptive attempt, updates the ability and difficulty estimates with the step
+	// computed by advance while they are locked, and adds the next question or
+	// submits the attempt, all in one transaction
+	AnswerAdaptive(ctx context.Context, attemptID, collectionID uuid.UUID, position int, grade QuizAnswerGrade, answeredAt time.Time, advance AdaptiveAdvance) (*AdaptiveAnswer, error)
+
+	// Submit finishes an attempt in progress and totals the scores of its answers.
+	// Attempts submitted after their deadline are recorded as submitted at the
+	// deadline; automatic submissions are marked as such. The answers are added to
+	// the item statistics of the quiz and of the flashcards.
+	Submit(ctx context.Context, attemptID uuid.UUID, submittedAt time.Time, automatic bool) (*ent.QuizAttempt, error)
+
+	// ListExpired returns attempts still in progress whose deadline is before the time
+	ListExpired(ctx context.Context, before time.Time, limit int) ([]*ent.QuizAttempt, error)
+}
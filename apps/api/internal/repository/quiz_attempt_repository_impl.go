@@ -0,0 +1,353 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizanswer"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+)
+
+// QuizAttemptRepositoryImpl implements QuizAttemptRepository using Ent ORM
+type QuizAttemptRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewQuizAttemptRepository(client *ent.Client) QuizAttemptRepository {
+	return &QuizAttemptRepositoryImpl{client: client}
+}
+
+func (r *QuizAttemptRepositoryImpl) Start(ctx context.Context, quizID uuid.UUID, userID string, questions []AttemptQuestion, limits AttemptLimits) (*ent.QuizAttempt, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limits.MaxAttempts > 0 {
+		// Lock the quiz so that concurrent starts are counted one at a time
+		if _, err := tx.Quiz.Query().Where(quiz.ID(quizID)).ForUpdate().Only(ctx); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		count, err := tx.QuizAttempt.
+			Query().
+			Where(
+				quizattempt.QuizID(quizID),
+				quizattempt.UserID(userID),
+			).
+			Count(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if count >= limits.MaxAttempts {
+			tx.Rollback()
+			return nil, errors.New("maximum number of attempts reached")
+		}
+	}
+
+	startedAt := time.Now()
+	create := tx.QuizAttempt.
+		Create().
+		SetQuizID(quizID).
+		SetUserID(userID).
+		SetStartedAt(startedAt).
+		SetMaxScore(float64(len(questions)))
+	var deadline *time.Time
+	if limits.TimeLimit > 0 {
+		end := startedAt.Add(limits.TimeLimit)
+		deadline = &end
+	}
+	if limits.ClosesAt != nil && (deadline == nil || limits.ClosesAt.Before(*deadline)) {
+		deadline = limits.ClosesAt
+	}
+	create.SetNillableDeadlineAt(deadline)
+
+	attempt, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	builders := make([]*ent.QuizAnswerCreate, len(questions))
+	for i, question := range questions {
+		builders[i] = tx.QuizAnswer.
+			Create().
+			SetAttemptID(attempt.ID).
+			SetFlashcardID(question.FlashcardID).
+			SetPosition(i).
+			SetOptions(question.Options)
+	}
+	if _, err := tx.QuizAnswer.CreateBulk(builders...).Save(ctx); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return attempt, tx.Commit()
+}
+
+func (r *QuizAttemptRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.QuizAttempt, error) {
+	return r.client.QuizAttempt.
+		Query().
+		Where(quizattempt.ID(id)).
+		WithAnswers(func(q *ent.QuizAnswerQuery) {
+			q.WithFlashcard().
+				Order(quizanswer.ByPosition())
+		}).
+		Only(ctx)
+}
+
+func (r *QuizAttemptRepositoryImpl) ListByQuizAndUser(ctx context.Context, quizID uuid.UUID, userID string) ([]*ent.QuizAttempt, error) {
+	return r.client.QuizAttempt.
+		Query().
+		Where(
+			quizattempt.QuizID(quizID),
+			quizattempt.UserID(userID),
+		).
+		Order(ent.Desc(quizattempt.FieldStartedAt)).
+		All(ctx)
+}
+
+// lockAttemptInProgress locks an attempt so that answers and the submission are
+// applied one at a time, and checks that it is still in progress
+func lockAttemptInProgress(ctx context.Context, client *ent.Client, id uuid.UUID) (*ent.QuizAttempt, error) {
+	attempt, err := client.QuizAttempt.
+		Query().
+		Where(quizattempt.ID(id)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if attempt.Status != quizattempt.StatusInProgress {
+		return nil, errors.New("attempt is already submitted")
+	}
+	return attempt, nil
+}
+
+func (r *QuizAttemptRepositoryImpl) SaveAnswer(ctx context.Context, attemptID uuid.UUID, position int, grade QuizAnswerGrade, answeredAt time.Time) (*ent.QuizAnswer, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := saveAnswer(ctx, tx.Client(), attemptID, position, grade, answeredAt)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return answer, tx.Commit()
+}
+
+func saveAnswer(ctx context.Context, client *ent.Client, attemptID uuid.UUID, position int, grade QuizAnswerGrade, answeredAt time.Time) (*ent.QuizAnswer, error) {
+	attempt, err := lockAttemptInProgress(ctx, client, attemptID)
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := client.QuizAnswer.
+		Query().
+		Where(
+			quizanswer.AttemptID(attemptID),
+			quizanswer.Position(position),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Checked under the attempt lock, so that concurrent answers cannot both pass
+	if grade.AnswerOnce && answer.AnsweredAt != nil {
+		return nil, errors.New("question is already answered")
+	}
+
+	// The question was being worked on since the previous answer of the attempt
+	since := attempt.StartedAt
+	previous, err := client.QuizAnswer.
+		Query().
+		Where(
+			quizanswer.AttemptID(attemptID),
+			quizanswer.AnsweredAtNotNil(),
+		).
+		Order(ent.Desc(quizanswer.FieldAnsweredAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if previous != nil && previous.AnsweredAt.After(since) {
+		since = *previous.AnsweredAt
+	}
+	elapsed := max(answeredAt.Sub(since).Milliseconds(), 0)
+
+	return answer.Update().
+		SetResponse(grade.Response).
+		SetCorrect(grade.Correct).
+		SetScore(grade.Score).
+		SetAnsweredAt(answeredAt).
+		SetTimeSpentMs(answer.TimeSpentMs + int(elapsed)).
+		Save(ctx)
+}
+
+func (r *QuizAttemptRepositoryImpl) AnswerAdaptive(ctx context.Context, attemptID, collectionID uuid.UUID, position int, grade QuizAnswerGrade, answeredAt time.Time, advance AdaptiveAdvance) (*AdaptiveAnswer, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	answered, err := answerAdaptive(ctx, tx.Client(), attemptID, collectionID, position, grade, answeredAt, advance)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return answered, tx.Commit()
+}
+
+func answerAdaptive(ctx context.Context, client *ent.Client, attemptID, collectionID uuid.UUID, position int, grade QuizAnswerGrade, answeredAt time.Time, advance AdaptiveAdvance) (*AdaptiveAnswer, error) {
+	// Each answer picks the next question, so it cannot be changed
+	grade.AnswerOnce = true
+	answer, err := saveAnswer(ctx, client, attemptID, position, grade, answeredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := client.QuizAttempt.Get(ctx, attemptID)
+	if err != nil {
+		return nil, err
+	}
+
+	learner, rating, err := lockRatings(ctx, client, attempt.UserID, collectionID, answer.FlashcardID)
+	if err != nil {
+		return nil, err
+	}
+
+	step, err := advance(learner, rating)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordRatings(ctx, learner, rating, step.Ability, step.Difficulty); err != nil {
+		return nil, err
+	}
+
+	if err := attempt.Update().
+		SetAbility(step.Ability).
+		SetAbilityError(step.AbilityError).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	answered := &AdaptiveAnswer{Answer: answer}
+	if step.Next != nil {
+		answered.Next, err = addQuestion(ctx, client, attemptID, *step.Next)
+	} else {
+		answered.Submitted, err = submitAttempt(ctx, client, attemptID, answeredAt, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return answered, nil
+}
+
+func addQuestion(ctx context.Context, client *ent.Client, attemptID uuid.UUID, question AttemptQuestion) (*ent.QuizAnswer, error) {
+	attempt, err := lockAttemptInProgress(ctx, client, attemptID)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := client.QuizAnswer.
+		Query().
+		Where(quizanswer.AttemptID(attemptID)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attempt.Update().SetMaxScore(float64(count + 1)).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return client.QuizAnswer.
+		Create().
+		SetAttemptID(attemptID).
+		SetFlashcardID(question.FlashcardID).
+		SetPosition(count).
+		SetOptions(question.Options).
+		Save(ctx)
+}
+
+func (r *QuizAttemptRepositoryImpl) Submit(ctx context.Context, attemptID uuid.UUID, submittedAt time.Time, automatic bool) (*ent.QuizAttempt, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submitted, err := submitAttempt(ctx, tx.Client(), attemptID, submittedAt, automatic)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return submitted, tx.Commit()
+}
+
+func submitAttempt(ctx context.Context, client *ent.Client, attemptID uuid.UUID, submittedAt time.Time, automatic bool) (*ent.QuizAttempt, error) {
+	attempt, err := lockAttemptInProgress(ctx, client, attemptID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.DeadlineAt != nil && submittedAt.After(*attempt.DeadlineAt) {
+		submittedAt = *attempt.DeadlineAt
+	}
+
+	answers, err := client.QuizAnswer.
+		Query().
+		Where(quizanswer.AttemptID(attemptID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	score := 0.0
+	for _, answer := range answers {
+		score += answer.Score
+	}
+
+	submitted, err := attempt.Update().
+		SetStatus(quizattempt.StatusSubmitted).
+		SetSubmittedAt(submittedAt).
+		SetAutoSubmitted(automatic).
+		SetScore(score).
+		SetMaxScore(float64(len(answers))).
+		SetTimeSpentSeconds(int(max(submittedAt.Sub(attempt.StartedAt).Seconds(), 0))).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := recordItemStatistics(ctx, client, attempt.QuizID, score, answers); err != nil {
+		return nil, err
+	}
+
+	return submitted, nil
+}
+
+func (r *QuizAttemptRepositoryImpl) ListExpired(ctx context.Context, before time.Time, limit int) ([]*ent.QuizAttempt, error) {
+	return r.client.QuizAttempt.
+		Query().
+		Where(
+			quizattempt.StatusEQ(quizattempt.StatusInProgress),
+			quizattempt.DeadlineAtLT(before),
+		).
+		Order(quizattempt.ByDeadlineAt()).
+		Limit(limit).
+		All(ctx)
+}
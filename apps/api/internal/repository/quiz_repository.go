@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// QuizFields contains the settings of a quiz and the flashcards selected from them
+type QuizFields struct {
+	Title         string
+	Selection     string         // random, weakest or tagged
+	QuestionCount int            // Number of selected flashcards, or the most questions of an adaptive attempt
+	TypeCounts    map[string]int // Questions requested per flashcard type
+	Tags          []string
+	ChoiceCount   int // Options of simple cards asked as multiple choice, 0 for typed answers
+	Seed          int64
+	FlashcardIDs  []uuid.UUID // In question order, or the candidates of an adaptive quiz
+	Shared        bool
+	Adaptive      bool
+
+	// Spaced repetition feedback of submitted answers
+	ReviewFeedback    bool
+	SlowAnswerSeconds int
+
+	// Exam settings, nil when unrestricted
+	TimeLimitSeconds *int
+	OpensAt          *time.Time
+	ClosesAt         *time.Time
+	MaxAttempts      *int
+}
+
+// QuizRepository defines the interface for quiz data access
+type QuizRepository interface {
+	Create(ctx context.Context, collectionID uuid.UUID, createdBy string, fields QuizFields) (*ent.Quiz, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*ent.Quiz, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListByCollection returns the quizzes of a collection created by the user or
+	// shared with everyone, newest first
+	ListByCollection(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.Quiz, error)
+
+	// ListQuestionCandidates returns the live, published flashcards of a collection
+	// and its sub-collections, ordered by ID, with the user's reviews
+	ListQuestionCandidates(ctx context.Context, collectionID uuid.UUID, userID string, tags []string) ([]*ent.Flashcard, error)
+}
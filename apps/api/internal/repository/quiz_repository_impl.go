@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quiz"
+)
+
+// QuizRepositoryImpl implements QuizRepository using Ent ORM
+type QuizRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewQuizRepository(client *ent.Client) QuizRepository {
+	return &QuizRepositoryImpl{client: client}
+}
+
+func (r *QuizRepositoryImpl) Create(ctx context.Context, collectionID uuid.UUID, createdBy string, fields QuizFields) (*ent.Quiz, error) {
+	return r.client.Quiz.
+		Create().
+		SetCollectionID(collectionID).
+		SetCreatedBy(createdBy).
+		SetTitle(fields.Title).
+		SetSelection(quiz.Selection(fields.Selection)).
+		SetQuestionCount(fields.QuestionCount).
+		SetTypeCounts(fields.TypeCounts).
+		SetTags(fields.Tags).
+		SetChoiceCount(fields.ChoiceCount).
+		SetSeed(fields.Seed).
+		SetFlashcardIds(fields.FlashcardIDs).
+		SetShared(fields.Shared).
+		SetAdaptive(fields.Adaptive).
+		SetReviewFeedback(fields.ReviewFeedback).
+		SetSlowAnswerSeconds(fields.SlowAnswerSeconds).
+		SetNillableTimeLimitSeconds(fields.TimeLimitSeconds).
+		SetNillableOpensAt(fields.OpensAt).
+		SetNillableClosesAt(fields.ClosesAt).
+		SetNillableMaxAttempts(fields.MaxAttempts).
+		Save(ctx)
+}
+
+func (r *QuizRepositoryImpl) GetByID(ctx context.Context, id uuid.UUID) (*ent.Quiz, error) {
+	return r.client.Quiz.Get(ctx, id)
+}
+
+func (r *QuizRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.client.Quiz.DeleteOneID(id).Exec(ctx)
+}
+
+func (r *QuizRepositoryImpl) ListByCollection(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.Quiz, error) {
+	return r.client.Quiz.
+		Query().
+		Where(
+			quiz.CollectionID(collectionID),
+			quiz.Or(
+				quiz.CreatedBy(userID),
+				quiz.Shared(true),
+			),
+		).
+		Order(ent.Desc(quiz.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r *QuizRepositoryImpl) ListQuestionCandidates(ctx context.Context, collectionID uuid.UUID, userID string, tags []string) ([]*ent.Flashcard, error) {
+	query := r.client.Flashcard.
+		Query().
+		Where(
+			FlashcardInTree(collectionID),
+			flashcard.StatusEQ(flashcard.StatusPublished),
+		).
+		WithReviews(func(q *ent.FlashcardReviewQuery) {
+			q.Where(flashcardreview.UserID(userID))
+		}).
+		Order(flashcard.ByID())
+
+	if len(tags) > 0 {
+		query = query.Where(HasTagsUnder(tags))
+	}
+
+	return query.All(ctx)
+}
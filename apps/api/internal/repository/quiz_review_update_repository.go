@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// ErrReviewChanged is returned when a review changed after a quiz update to it was
+// calculated
+var ErrReviewChanged = errors.New("review was changed concurrently")
+
+// QuizReviewUpdateRepository defines the interface for access to the spaced
+// repetition updates made from quiz answers
+type QuizReviewUpdateRepository interface {
+	// Apply updates a review from an answer of a quiz attempt and records the
+	// update with the schedule of the review it was calculated from. It fails with
+	// ErrReviewChanged if the review was changed in the meantime.
+	Apply(ctx context.Context, attemptID uuid.UUID, review *ent.FlashcardReview, rating int, update FlashcardReviewUpdate) (*ent.QuizReviewUpdate, error)
+
+	// ListByAttempt returns the updates made from an attempt with their reviews
+	ListByAttempt(ctx context.Context, attemptID uuid.UUID) ([]*ent.QuizReviewUpdate, error)
+
+	// RevertByAttempt restores the schedule of the reviews updated from an attempt.
+	// Reviews that were reviewed again since are kept as they are. It returns how
+	// many updates were reverted and how many were kept.
+	RevertByAttempt(ctx context.Context, attemptID uuid.UUID, revertedAt time.Time) (int, int, error)
+}
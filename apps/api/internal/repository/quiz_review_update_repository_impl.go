@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardreview"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizreviewupdate"
+)
+
+// QuizReviewUpdateRepositoryImpl implements QuizReviewUpdateRepository using Ent ORM
+type QuizReviewUpdateRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewQuizReviewUpdateRepository(client *ent.Client) QuizReviewUpdateRepository {
+	return &QuizReviewUpdateRepositoryImpl{client: client}
+}
+
+func (r *QuizReviewUpdateRepositoryImpl) Apply(ctx context.Context, attemptID uuid.UUID, review *ent.FlashcardReview, rating int, update FlashcardReviewUpdate) (*ent.QuizReviewUpdate, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := applyQuizReviewUpdate(ctx, tx.Client(), attemptID, review, rating, update)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	return applied, tx.Commit()
+}
+
+func applyQuizReviewUpdate(ctx context.Context, client *ent.Client, attemptID uuid.UUID, review *ent.FlashcardReview, rating int, update FlashcardReviewUpdate) (*ent.QuizReviewUpdate, error) {
+	// Every review increments the review count, so an unchanged count means the
+	// update was calculated from the current schedule
+	updated, err := client.FlashcardReview.
+		Update().
+		Where(
+			flashcardreview.ID(review.ID),
+			flashcardreview.ReviewCount(review.ReviewCount),
+		).
+		SetEaseFactor(update.EaseFactor).
+		SetInterval(update.Interval).
+		SetDueAt(update.DueAt).
+		SetStatus(update.Status).
+		SetLearningStep(update.LearningStep).
+		SetReviewCount(update.ReviewCount).
+		SetLapseCount(update.LapseCount).
+		SetHintCount(update.HintCount).
+		SetLastReviewedAt(update.LastReviewedAt).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrReviewChanged
+	}
+
+	return client.QuizReviewUpdate.
+		Create().
+		SetAttemptID(attemptID).
+		SetReviewID(review.ID).
+		SetRating(rating).
+		SetPreviousEaseFactor(review.EaseFactor).
+		SetPreviousInterval(review.Interval).
+		SetPreviousDueAt(review.DueAt).
+		SetPreviousStatus(quizreviewupdate.PreviousStatus(review.Status.String())).
+		SetPreviousLearningStep(review.LearningStep).
+		SetPreviousReviewCount(review.ReviewCount).
+		SetPreviousLapseCount(review.LapseCount).
+		SetNillablePreviousLastReviewedAt(review.LastReviewedAt).
+		Save(ctx)
+}
+
+func (r *QuizReviewUpdateRepositoryImpl) ListByAttempt(ctx context.Context, attemptID uuid.UUID) ([]*ent.QuizReviewUpdate, error) {
+	return r.client.QuizReviewUpdate.
+		Query().
+		Where(quizreviewupdate.AttemptID(attemptID)).
+		WithReview().
+		Order(ent.Asc(quizreviewupdate.FieldCreatedAt)).
+		All(ctx)
+}
+
+func (r *QuizReviewUpdateRepositoryImpl) RevertByAttempt(ctx context.Context, attemptID uuid.UUID, revertedAt time.Time) (int, int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reverted, kept, err := revertQuizReviewUpdates(ctx, tx.Client(), attemptID, revertedAt)
+	if err != nil {
+		tx.Rollback()
+		return 0, 0, err
+	}
+
+	return reverted, kept, tx.Commit()
+}
+
+func revertQuizReviewUpdates(ctx context.Context, client *ent.Client, attemptID uuid.UUID, revertedAt time.Time) (int, int, error) {
+	updates, err := client.QuizReviewUpdate.
+		Query().
+		Where(
+			quizreviewupdate.AttemptID(attemptID),
+			quizreviewupdate.RevertedAtIsNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	reverted, kept := 0, 0
+	for _, u := range updates {
+		restore := client.FlashcardReview.
+			Update().
+			Where(
+				flashcardreview.ID(u.ReviewID),
+				flashcardreview.ReviewCount(u.PreviousReviewCount+1),
+			).
+			SetEaseFactor(u.PreviousEaseFactor).
+			SetInterval(u.PreviousInterval).
+			SetDueAt(u.PreviousDueAt).
+			SetStatus(flashcardreview.Status(u.PreviousStatus.String())).
+			SetLearningStep(u.PreviousLearningStep).
+			SetReviewCount(u.PreviousReviewCount).
+			SetLapseCount(u.PreviousLapseCount)
+		if u.PreviousLastReviewedAt != nil {
+			restore.SetLastReviewedAt(*u.PreviousLastReviewedAt)
+		} else {
+			restore.ClearLastReviewedAt()
+		}
+
+		restored, err := restore.Save(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+		if restored == 0 {
+			kept++
+			continue
+		}
+
+		if err := u.Update().SetRevertedAt(revertedAt).Exec(ctx); err != nil {
+			return 0, 0, err
+		}
+		reverted++
+	}
+
+	return reverted, kept, nil
+}
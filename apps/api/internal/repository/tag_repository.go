@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// TagSeparator separates the levels of a hierarchical tag
+const TagSeparator = "::"
+
+// TagCount is a tag with the number of flashcards carrying it
+type TagCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// TagRepository defines the interface for flashcard tag data access
+type TagRepository interface {
+	// AddTags tags the flashcards of the collection and returns the number of tags added
+	AddTags(ctx context.Context, collectionID uuid.UUID, flashcardIDs []uuid.UUID, tags []string) (int, error)
+
+	// RemoveTags removes the tags from the flashcards of the collection
+	RemoveTags(ctx context.Context, collectionID uuid.UUID, flashcardIDs []uuid.UUID, tags []string) (int, error)
+
+	// Rename renames a tag and all its descendants in a collection, merging into
+	// existing tags with the new name
+	Rename(ctx context.Context, collectionID uuid.UUID, from, to string) (int, error)
+
+	// ListWithCounts returns every tag used in a collection with its flashcard count,
+	// counting only published flashcards with publishedOnly
+	ListWithCounts(ctx context.Context, collectionID uuid.UUID, publishedOnly bool) ([]TagCount, error)
+
+	// ListByFlashcards returns the sorted tags of those of the flashcards the user
+	// can still see, by flashcard ID
+	ListByFlashcards(ctx context.Context, userID string, flashcardIDs []uuid.UUID) (map[uuid.UUID][]string, error)
+}
@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcard"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardtag"
+	"github.com/quanphung1120/advanced-quiz-be/ent/predicate"
+)
+
+// TagRepositoryImpl implements TagRepository using Ent ORM
+type TagRepositoryImpl struct {
+	client *ent.Client
+}
+
+func NewTagRepository(client *ent.Client) TagRepository {
+	return &TagRepositoryImpl{client: client}
+}
+
+// tagSubtree matches a tag and all of its descendants
+func tagSubtree(tag string) predicate.FlashcardTag {
+	return flashcardtag.Or(
+		flashcardtag.Name(tag),
+		flashcardtag.NameHasPrefix(tag+TagSeparator),
+	)
+}
+
+// HasTagsUnder matches flashcards carrying any of the tags or one of their descendants
+func HasTagsUnder(tags []string) predicate.Flashcard {
+	subtrees := make([]predicate.FlashcardTag, len(tags))
+	for i, tag := range tags {
+		subtrees[i] = tagSubtree(tag)
+	}
+	return flashcard.HasTagsWith(flashcardtag.Or(subtrees...))
+}
+
+func (r *TagRepositoryImpl) AddTags(ctx context.Context, collectionID uuid.UUID, flashcardIDs []uuid.UUID, tags []string) (int, error) {
+	flashcards, err := r.client.Flashcard.
+		Query().
+		Where(
+			flashcard.IDIn(flashcardIDs...),
+			flashcard.CollectionID(collectionID),
+			flashcard.DeletedAtIsNil(),
+		).
+		WithTags().
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var builders []*ent.FlashcardTagCreate
+	for _, fc := range flashcards {
+		existing := make(map[string]bool, len(fc.Edges.Tags))
+		for _, t := range fc.Edges.Tags {
+			existing[t.Name] = true
+		}
+
+		for _, tag := range tags {
+			if existing[tag] {
+				continue
+			}
+			existing[tag] = true
+			builders = append(builders, r.client.FlashcardTag.
+				Create().
+				SetFlashcardID(fc.ID).
+				SetCollectionID(collectionID).
+				SetName(tag))
+		}
+	}
+
+	if len(builders) == 0 {
+		return 0, nil
+	}
+
+	created, err := r.client.FlashcardTag.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(created), nil
+}
+
+func (r *TagRepositoryImpl) RemoveTags(ctx context.Context, collectionID uuid.UUID, flashcardIDs []uuid.UUID, tags []string) (int, error) {
+	return r.client.FlashcardTag.
+		Delete().
+		Where(
+			flashcardtag.CollectionID(collectionID),
+			flashcardtag.FlashcardIDIn(flashcardIDs...),
+			flashcardtag.NameIn(tags...),
+		).
+		Exec(ctx)
+}
+
+func (r *TagRepositoryImpl) Rename(ctx context.Context, collectionID uuid.UUID, from, to string) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	renamed, err := renameTags(ctx, tx.Client(), collectionID, from, to)
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+
+	return renamed, tx.Commit()
+}
+
+func renameTags(ctx context.Context, client *ent.Client, collectionID uuid.UUID, from, to string) (int, error) {
+	tags, err := client.FlashcardTag.
+		Query().
+		Where(
+			flashcardtag.CollectionID(collectionID),
+			tagSubtree(from),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Tags the flashcards will carry after renaming, to merge duplicates
+	targets := make(map[uuid.UUID]map[string]bool)
+	existing, err := client.FlashcardTag.
+		Query().
+		Where(
+			flashcardtag.CollectionID(collectionID),
+			tagSubtree(to),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, t := range existing {
+		if targets[t.FlashcardID] == nil {
+			targets[t.FlashcardID] = make(map[string]bool)
+		}
+		targets[t.FlashcardID][t.Name] = true
+	}
+
+	for _, t := range tags {
+		name := to + strings.TrimPrefix(t.Name, from)
+
+		if targets[t.FlashcardID][name] {
+			// The flashcard already has the target tag: merge by dropping this one
+			if err := client.FlashcardTag.DeleteOneID(t.ID).Exec(ctx); err != nil {
+				return 0, err
+			}
+			continue
+		}
+
+		if err := client.FlashcardTag.UpdateOneID(t.ID).SetName(name).Exec(ctx); err != nil {
+			return 0, err
+		}
+		if targets[t.FlashcardID] == nil {
+			targets[t.FlashcardID] = make(map[string]bool)
+		}
+		targets[t.FlashcardID][name] = true
+	}
+
+	return len(tags), nil
+}
+
+func (r *TagRepositoryImpl) ListWithCounts(ctx context.Context, collectionID uuid.UUID, publishedOnly bool) ([]TagCount, error) {
+	counted := []predicate.Flashcard{flashcard.DeletedAtIsNil()}
+	if publishedOnly {
+		counted = append(counted, flashcard.StatusEQ(flashcard.StatusPublished))
+	}
+
+	var counts []TagCount
+	err := r.client.FlashcardTag.
+		Query().
+		Where(
+			flashcardtag.CollectionID(collectionID),
+			flashcardtag.HasFlashcardWith(counted...),
+		).
+		GroupBy(flashcardtag.FieldName).
+		Aggregate(ent.As(ent.Count(), "count")).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Name < counts[j].Name
+	})
+
+	return counts, nil
+}
+
+func (r *TagRepositoryImpl) ListByFlashcards(ctx context.Context, userID string, flashcardIDs []uuid.UUID) (map[uuid.UUID][]string, error) {
+	tags, err := r.client.FlashcardTag.
+		Query().
+		Where(
+			flashcardtag.FlashcardIDIn(flashcardIDs...),
+			flashcardtag.HasFlashcardWith(
+				AccessibleBy(userID),
+				VisibleTo(userID),
+			),
+		).
+		Order(ent.Asc(flashcardtag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byFlashcard := make(map[uuid.UUID][]string)
+	for _, tag := range tags {
+		byFlashcard[tag.FlashcardID] = append(byFlashcard[tag.FlashcardID], tag.Name)
+	}
+	return byFlashcard, nil
+}
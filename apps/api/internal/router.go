@@ -11,6 +11,9 @@ type Router struct {
 	flashcardController       *controller.FlashcardController
 	flashcardReviewController *controller.FlashcardReviewController
 	userController            *controller.UserController
+	mediaController           *controller.MediaController
+	trashController           *controller.TrashController
+	quizController            *controller.QuizController
 }
 
 func NewRouter(
@@ -18,18 +21,25 @@ func NewRouter(
 	flashcardController *controller.FlashcardController,
 	flashcardReviewController *controller.FlashcardReviewController,
 	userController *controller.UserController,
+	mediaController *controller.MediaController,
+	trashController *controller.TrashController,
+	quizController *controller.QuizController,
 ) *Router {
 	return &Router{
 		collectionController:      collectionController,
 		flashcardController:       flashcardController,
 		flashcardReviewController: flashcardReviewController,
 		userController:            userController,
+		mediaController:           mediaController,
+		trashController:           trashController,
+		quizController:            quizController,
 	}
 }
 
 func (r *Router) SetupRoutes(router *gin.Engine) {
 	router.GET("/", controller.HomeController)
 	router.GET("/health", controller.HealthController)
+	router.GET("/media/:id", r.mediaController.Download)
 
 	v1 := router.Group("/api/v1")
 
@@ -39,6 +49,7 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 		{
 			users.GET("/me", r.userController.Me)
 			users.GET("/search-email-addresses", r.userController.SearchEmailAddresses)
+			users.GET("/me/export", r.userController.ExportPersonalData)
 		}
 
 		collections := v1.Group("/collections")
@@ -49,8 +60,24 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 			collections.GET("/:id/flashcards", r.flashcardController.GetCollectionFlashcards)
 			collections.GET("/:id/flashcards/:flashcardId", r.flashcardController.GetFlashcard)
 			collections.POST("/:id/flashcards", r.flashcardController.CreateFlashcard)
+			collections.POST("/:id/flashcards/batch", r.flashcardController.BatchFlashcards)
+			collections.POST("/:id/flashcards/move", r.flashcardController.MoveFlashcards)
+			collections.POST("/:id/flashcards/copy", r.flashcardController.CopyFlashcards)
+			collections.POST("/:id/flashcards/publish", r.flashcardController.PublishFlashcards)
+			collections.POST("/:id/flashcards/unpublish", r.flashcardController.UnpublishFlashcards)
 			collections.PUT("/:id/flashcards/:flashcardId", r.flashcardController.UpdateFlashcard)
 			collections.DELETE("/:id/flashcards/:flashcardId", r.flashcardController.DeleteFlashcard)
+			collections.POST("/:id/flashcards/:flashcardId/move", r.flashcardController.MoveFlashcard)
+			collections.POST("/:id/flashcards/:flashcardId/publish", r.flashcardController.PublishFlashcard)
+			collections.POST("/:id/flashcards/:flashcardId/unpublish", r.flashcardController.UnpublishFlashcard)
+
+			collections.GET("/:id/tags", r.flashcardController.ListTags)
+			collections.POST("/:id/tags/add", r.flashcardController.AddTags)
+			collections.POST("/:id/tags/remove", r.flashcardController.RemoveTags)
+			collections.POST("/:id/tags/rename", r.flashcardController.RenameTag)
+
+			collections.GET("/:id/duplicates", r.flashcardController.FindDuplicates)
+			collections.POST("/:id/duplicates/merge", r.flashcardController.MergeDuplicates)
 
 			collections.POST("/:id/start-session", r.flashcardReviewController.StartSession)
 			collections.GET("/:id/due", r.flashcardReviewController.GetDueCards)
@@ -58,16 +85,68 @@ func (r *Router) SetupRoutes(router *gin.Engine) {
 			collections.GET("/:id/reviews", r.flashcardReviewController.GetAllReviews)
 			collections.DELETE("/:id/progress", r.flashcardReviewController.ClearProgress)
 
+			collections.GET("/:id/quizzes", r.quizController.ListQuizzes)
+			collections.POST("/:id/quizzes", r.quizController.CreateQuiz)
+
 			collections.GET("/me", r.collectionController.GetMyCollections)
 			collections.POST("/", r.collectionController.CreateCollection)
 			collections.GET("/:id", r.collectionController.GetCollection)
 			collections.PUT("/:id", r.collectionController.UpdateCollection)
+			collections.POST("/:id/move", r.collectionController.MoveCollection)
+			collections.POST("/:id/merge", r.collectionController.MergeCollections)
+			collections.POST("/:id/split", r.collectionController.SplitCollection)
 			collections.DELETE("/:id", r.collectionController.DeleteCollection)
 		}
 
 		flashcards := v1.Group("/flashcards")
 		{
+			flashcards.GET("/search", r.flashcardController.SearchFlashcards)
+			flashcards.GET("/annotations", r.flashcardController.ListAnnotations)
+			flashcards.GET("/:id/annotation", r.flashcardController.GetAnnotation)
+			flashcards.PUT("/:id/annotation", r.flashcardController.AnnotateFlashcard)
+			flashcards.DELETE("/:id/annotation", r.flashcardController.DeleteAnnotation)
 			flashcards.POST("/:id/review", r.flashcardReviewController.SubmitReview)
+			flashcards.POST("/:id/grade", r.flashcardReviewController.GradeAnswer)
+			flashcards.GET("/:id/choices", r.flashcardReviewController.GetChoices)
+			flashcards.GET("/:id/item-analysis", r.quizController.GetFlashcardItemAnalysis)
+			flashcards.GET("/:id/occlusions", r.flashcardController.GetOcclusionItems)
+			flashcards.GET("/:id/revisions", r.flashcardController.ListRevisions)
+			flashcards.GET("/:id/revisions/diff", r.flashcardController.DiffRevisions)
+			flashcards.POST("/:id/revisions/:number/restore", r.flashcardController.RestoreRevision)
+		}
+
+		quizzes := v1.Group("/quizzes")
+		{
+			quizzes.GET("/:id", r.quizController.GetQuiz)
+			quizzes.DELETE("/:id", r.quizController.DeleteQuiz)
+			quizzes.POST("/:id/attempts", r.quizController.StartAttempt)
+			quizzes.GET("/:id/attempts", r.quizController.ListAttempts)
+			quizzes.GET("/:id/item-analysis", r.quizController.GetQuizItemAnalysis)
+		}
+
+		quizAttempts := v1.Group("/quiz-attempts")
+		{
+			quizAttempts.GET("/:id", r.quizController.GetAttempt)
+			quizAttempts.POST("/:id/answers", r.quizController.AnswerQuestion)
+			quizAttempts.POST("/:id/submit", r.quizController.SubmitAttempt)
+			quizAttempts.GET("/:id/review", r.quizController.ReviewAttempt)
+			quizAttempts.GET("/:id/review-updates", r.quizController.ListReviewUpdates)
+			quizAttempts.POST("/:id/review-updates/revert", r.quizController.RevertReviewUpdates)
+		}
+
+		media := v1.Group("/media")
+		{
+			media.POST("/", r.mediaController.UploadMedia)
+			media.GET("/:id/url", r.mediaController.GetDownloadURL)
+		}
+
+		trash := v1.Group("/trash")
+		{
+			trash.GET("/", r.trashController.ListTrash)
+			trash.POST("/collections/:id/restore", r.trashController.RestoreCollection)
+			trash.POST("/flashcards/:id/restore", r.trashController.RestoreFlashcard)
+			trash.DELETE("/collections/:id", r.trashController.DeleteCollection)
+			trash.GET("/jobs/:id", r.trashController.GetDeletionJob)
 		}
 	}
 }
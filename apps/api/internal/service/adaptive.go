@@ -0,0 +1,106 @@
+package service
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// Adaptive quizzes estimate the ability of learners and the difficulty of
+// flashcards on the same logit scale (the Rasch model): a learner answers a
+// flashcard correctly with probability 1/(1+e^-(ability-difficulty)). Both
+// estimates move Elo-style after every answer, by less the more answers they are
+// already based on.
+
+// maxAdaptiveCandidates is the most flashcards an adaptive quiz picks from
+const maxAdaptiveCandidates = 1000
+
+// An adaptive attempt stops once it asked at least adaptiveMinQuestions and the
+// standard error of the ability estimate is at most adaptiveTargetError
+const (
+	adaptiveMinQuestions = 5
+	adaptiveTargetError  = 0.35
+)
+
+// maxAbilityError caps the standard error of ability estimates based on too little
+// information
+const maxAbilityError = 10.0
+
+// Step size of the Elo updates: eloInitialK for a new estimate, decaying with the
+// number of answers down to eloMinK so that estimates can still follow learning
+const (
+	eloInitialK = 0.8
+	eloKDecay   = 0.05
+	eloMinK     = 0.1
+)
+
+// adaptiveStream is the first random stream of the adaptive question picks, apart
+// from the streams that shuffle the choices of each question
+const adaptiveStream = 1 << 32
+
+// expectedScore returns the probability that a learner of the ability answers a
+// flashcard of the difficulty correctly
+func expectedScore(ability, difficulty float64) float64 {
+	return 1 / (1 + math.Exp(difficulty-ability))
+}
+
+func eloK(answers int) float64 {
+	return max(eloMinK, eloInitialK/(1+eloKDecay*float64(answers)))
+}
+
+// updateElo returns the ability and difficulty estimates after an answer with the
+// score, between 0 and 1
+func updateElo(ability float64, abilityAnswers int, difficulty float64, difficultyAnswers int, score float64) (float64, float64) {
+	surprise := score - expectedScore(ability, difficulty)
+	return ability + eloK(abilityAnswers)*surprise, difficulty - eloK(difficultyAnswers)*surprise
+}
+
+// itemInformation returns how much an answer to a flashcard of the difficulty
+// tells about a learner of the ability. It is highest when the learner is as
+// likely to fail as to succeed.
+func itemInformation(ability, difficulty float64) float64 {
+	p := expectedScore(ability, difficulty)
+	return p * (1 - p)
+}
+
+// abilityError returns the standard error of an ability estimate after answering
+// flashcards of the difficulties. It is capped so that it can be stored and shown
+// before anything is known.
+func abilityError(ability float64, difficulties []float64) float64 {
+	information := 0.0
+	for _, difficulty := range difficulties {
+		information += itemInformation(ability, difficulty)
+	}
+	return min(1/math.Sqrt(information), maxAbilityError)
+}
+
+// difficultyOf returns the difficulty estimate of a flashcard and the number of
+// answers it is based on; flashcards never answered adaptively start at 0
+func difficultyOf(ratings map[uuid.UUID]*ent.FlashcardRating, flashcardID uuid.UUID) (float64, int) {
+	if rating, ok := ratings[flashcardID]; ok {
+		return rating.Difficulty, rating.AnswerCount
+	}
+	return 0, 0
+}
+
+// mostInformative returns the candidate with the most information at the ability,
+// or nil when there is none. Ties are broken at random.
+func mostInformative(candidates []*ent.Flashcard, ability float64, ratings map[uuid.UUID]*ent.FlashcardRating, rng *rand.Rand) *ent.Flashcard {
+	shuffled := make([]*ent.Flashcard, len(candidates))
+	copy(shuffled, candidates)
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	var best *ent.Flashcard
+	bestInformation := -1.0
+	for _, fc := range shuffled {
+		difficulty, _ := difficultyOf(ratings, fc.ID)
+		if information := itemInformation(ability, difficulty); information > bestInformation {
+			best, bestInformation = fc, information
+		}
+	}
+	return best
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/flashcardannotation"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+const maxNoteLength = 10000
+
+// normalizeAnnotationFilter validates and deduplicates the flags of a filter
+func normalizeAnnotationFilter(filter repository.AnnotationFilter) (repository.AnnotationFilter, error) {
+	seen := make(map[string]bool, len(filter.Flags))
+	flags := make([]string, 0, len(filter.Flags))
+	for _, flag := range filter.Flags {
+		flag = strings.ToLower(strings.TrimSpace(flag))
+		if err := flashcardannotation.FlagValidator(flashcardannotation.Flag(flag)); err != nil {
+			return filter, errors.New("invalid flag")
+		}
+		if !seen[flag] {
+			seen[flag] = true
+			flags = append(flags, flag)
+		}
+	}
+	filter.Flags = flags
+	return filter, nil
+}
+
+// GetAnnotation returns the user's private annotation of a flashcard, or nil when
+// there is none
+func (s *flashcardServiceImpl) GetAnnotation(ctx context.Context, flashcardID uuid.UUID, userID string) (*ent.FlashcardAnnotation, error) {
+	if _, _, err := s.GetFlashcard(ctx, flashcardID, userID); err != nil {
+		return nil, err
+	}
+
+	annotation, err := s.annotationRepo.Get(ctx, userID, flashcardID)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return annotation, err
+}
+
+// AnnotateFlashcard replaces the user's private annotation of a flashcard. Any
+// user who can see the flashcard can annotate it; an empty annotation is removed.
+func (s *flashcardServiceImpl) AnnotateFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string, fields repository.AnnotationFields) (*ent.FlashcardAnnotation, error) {
+	if _, _, err := s.GetFlashcard(ctx, flashcardID, userID); err != nil {
+		return nil, err
+	}
+
+	fields.Note = strings.TrimSpace(fields.Note)
+	if len(fields.Note) > maxNoteLength {
+		return nil, errors.New("note is too long")
+	}
+
+	fields.Flag = strings.ToLower(strings.TrimSpace(fields.Flag))
+	if fields.Flag != "" {
+		if err := flashcardannotation.FlagValidator(flashcardannotation.Flag(fields.Flag)); err != nil {
+			return nil, errors.New("invalid flag")
+		}
+	}
+
+	if fields.IsEmpty() {
+		return nil, s.annotationRepo.Delete(ctx, userID, flashcardID)
+	}
+
+	return s.annotationRepo.Save(ctx, userID, flashcardID, fields)
+}
+
+// DeleteAnnotation removes the user's private annotation of a flashcard
+func (s *flashcardServiceImpl) DeleteAnnotation(ctx context.Context, flashcardID uuid.UUID, userID string) error {
+	if _, _, err := s.GetFlashcard(ctx, flashcardID, userID); err != nil {
+		return err
+	}
+
+	return s.annotationRepo.Delete(ctx, userID, flashcardID)
+}
+
+// ListAnnotations returns the user's annotations on flashcards the user can see,
+// such as every bookmarked flashcard
+func (s *flashcardServiceImpl) ListAnnotations(ctx context.Context, userID string, filter repository.AnnotationFilter) ([]*ent.FlashcardAnnotation, error) {
+	filter, err := normalizeAnnotationFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.annotationRepo.ListAccessible(ctx, userID, filter)
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Alternative answers are stored in Flashcard.answer separated by this delimiter,
+// e.g. "colour | color". Runs of it, as in "a || b", are part of the answer.
+const answerAlternativeDelimiter = '|'
+
+// thousandsGrouping matches numbers whose commas separate groups of thousands, as
+// in "1,000,000.5"; other commas, as in the decimal "3,14", are not numbers
+var thousandsGrouping = regexp.MustCompile(`^[+-]?\d{1,3}(,\d{3})+(\.\d*)?$`)
+
+// Maximum number of runes compared by the character diff; longer inputs are
+// reported as a single replacement to keep the diff cheap
+const maxDiffLength = 1000
+
+// Diff operations
+const (
+	DiffEqual  = "equal"
+	DiffInsert = "insert"
+	DiffDelete = "delete"
+)
+
+// DiffSegment is a run of characters that are equal, missing from the typed
+// answer (delete) or extra in the typed answer (insert)
+type DiffSegment struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// GradeResult is the outcome of grading an answer against a flashcard
+type GradeResult struct {
+	Correct         bool          `json:"correct"`
+	Exact           bool          `json:"exact"`
+	Numeric         bool          `json:"numeric"`
+	Score           float64       `json:"score"`
+	Distance        int           `json:"distance"`
+	Similarity      float64       `json:"similarity"`
+	MatchedAnswer   string        `json:"matched_answer"`
+	Diff            []DiffSegment `json:"diff"`
+	SuggestedRating ReviewRating  `json:"suggested_rating"`
+}
+
+// GradeTypedAnswer compares a typed answer against the expected answer, accepting
+// any of its alternatives, numeric answers within tolerance and small typos
+func GradeTypedAnswer(expected, given string) *GradeResult {
+	// The whole answer is a candidate too, for answers containing the delimiter
+	candidates := splitAlternatives(expected)
+	if whole := strings.TrimSpace(expected); !slices.Contains(candidates, whole) {
+		candidates = append(candidates, whole)
+	}
+
+	var best *GradeResult
+	for _, candidate := range candidates {
+		result := gradeAlternative(candidate, given)
+		if best == nil || betterGrade(result, best) {
+			best = result
+		}
+	}
+
+	best.Score = boolScore(best.Correct)
+	best.Diff = diffRunes(best.MatchedAnswer, strings.TrimSpace(given))
+	best.SuggestedRating = suggestRating(best)
+
+	return best
+}
+
+// splitAlternatives splits an expected answer at the delimiters that stand alone
+func splitAlternatives(expected string) []string {
+	var alternatives []string
+	add := func(part string) {
+		if part = strings.TrimSpace(part); part != "" {
+			alternatives = append(alternatives, part)
+		}
+	}
+
+	start := 0
+	for i := 0; i < len(expected); i++ {
+		if expected[i] != answerAlternativeDelimiter {
+			continue
+		}
+		if (i > 0 && expected[i-1] == answerAlternativeDelimiter) ||
+			(i+1 < len(expected) && expected[i+1] == answerAlternativeDelimiter) {
+			continue
+		}
+		add(expected[start:i])
+		start = i + 1
+	}
+	add(expected[start:])
+
+	return alternatives
+}
+
+func gradeAlternative(expected, given string) *GradeResult {
+	result := &GradeResult{MatchedAnswer: expected}
+
+	if value, tolerance, ok := parseNumericAnswer(expected); ok {
+		if typed, err := parseNumber(given); err == nil {
+			result.Numeric = true
+			if display, _, found := strings.Cut(strings.ReplaceAll(expected, "+/-", "±"), "±"); found {
+				result.MatchedAnswer = strings.TrimSpace(display)
+			}
+			result.Correct = math.Abs(typed-value) <= tolerance
+			result.Exact = typed == value
+			if result.Correct {
+				result.Similarity = 1
+			}
+			return result
+		}
+	}
+
+	normalizedExpected := []rune(NormalizeAnswer(expected))
+	normalizedGiven := []rune(NormalizeAnswer(given))
+
+	longest := max(len(normalizedExpected), len(normalizedGiven))
+	if longest == 0 {
+		result.Exact, result.Correct, result.Similarity = true, true, 1
+		return result
+	}
+
+	// The length difference is a lower bound of the distance, so long answers that
+	// differ in length by more than the typo budget are wrong whatever the distance;
+	// like the diff, they are reported as a single replacement
+	difference := abs(len(normalizedExpected) - len(normalizedGiven))
+	if difference > maxTypoDistance(len(normalizedExpected)) && longest > maxDiffLength {
+		result.Distance = longest
+		return result
+	}
+
+	result.Distance = levenshtein(normalizedExpected, normalizedGiven)
+	result.Exact = result.Distance == 0
+	result.Correct = result.Distance <= maxTypoDistance(len(normalizedExpected))
+	result.Similarity = 1 - float64(result.Distance)/float64(longest)
+
+	return result
+}
+
+func betterGrade(a, b *GradeResult) bool {
+	if a.Correct != b.Correct {
+		return a.Correct
+	}
+	if a.Exact != b.Exact {
+		return a.Exact
+	}
+	return a.Similarity > b.Similarity
+}
+
+// maxTypoDistance returns how many edits are tolerated for an answer of n runes
+func maxTypoDistance(n int) int {
+	switch {
+	case n <= 3:
+		return 0
+	case n <= 7:
+		return 1
+	default:
+		return min(n/6+1, 3)
+	}
+}
+
+func suggestRating(result *GradeResult) ReviewRating {
+	switch {
+	case !result.Correct:
+		return RatingAgain
+	case result.Exact:
+		return RatingGood
+	default:
+		return RatingHard
+	}
+}
+
+// NormalizeAnswer lowercases the text, strips diacritics and punctuation and
+// collapses whitespace so that answers can be compared loosely
+func NormalizeAnswer(s string) string {
+	var b strings.Builder
+	space := false
+
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			continue
+		case unicode.IsSpace(r), unicode.IsPunct(r):
+			space = b.Len() > 0
+		default:
+			if space {
+				b.WriteRune(' ')
+				space = false
+			}
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	return norm.NFC.String(b.String())
+}
+
+// parseNumericAnswer parses "42", "42 ± 0.5" or "42 +/- 0.5" into the value and
+// its tolerance. Without a declared tolerance numbers must match exactly, since
+// years, IDs and counts are never approximate.
+func parseNumericAnswer(s string) (float64, float64, bool) {
+	s = strings.ReplaceAll(s, "+/-", "±")
+	valuePart, tolerancePart, hasTolerance := strings.Cut(s, "±")
+
+	value, err := parseNumber(valuePart)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	if hasTolerance {
+		tolerance, err := parseNumber(tolerancePart)
+		if err != nil {
+			return 0, 0, false
+		}
+		return value, math.Abs(tolerance), true
+	}
+
+	return value, 0, true
+}
+
+func parseNumber(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	s = strings.ReplaceAll(s, " ", "")
+	if strings.Contains(s, ",") {
+		if !thousandsGrouping.MatchString(s) {
+			return 0, errors.New("not a number")
+		}
+		s = strings.ReplaceAll(s, ",", "")
+	}
+
+	// ParseFloat also accepts "inf" and "nan", which are words rather than numbers
+	value, err := strconv.ParseFloat(s, 64)
+	if err == nil && (math.IsNaN(value) || math.IsInf(value, 0)) {
+		return 0, errors.New("not a finite number")
+	}
+	return value, err
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// levenshtein returns the edit distance between two rune slices
+func levenshtein(a, b []rune) int {
+	if len(a) == 0 {
+		return len(b)
+	}
+	if len(b) == 0 {
+		return len(a)
+	}
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+// diffRunes builds a character-level diff from the expected to the typed answer
+// using the longest common subsequence
+func diffRunes(expected, given string) []DiffSegment {
+	a := []rune(expected)
+	b := []rune(given)
+
+	if len(a) > maxDiffLength || len(b) > maxDiffLength {
+		return appendSegment(appendSegment(nil, DiffDelete, expected), DiffInsert, given)
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var segments []DiffSegment
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			segments = appendSegment(segments, DiffEqual, string(a[i]))
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			segments = appendSegment(segments, DiffDelete, string(a[i]))
+			i++
+		default:
+			segments = appendSegment(segments, DiffInsert, string(b[j]))
+			j++
+		}
+	}
+	segments = appendSegment(segments, DiffDelete, string(a[i:]))
+	segments = appendSegment(segments, DiffInsert, string(b[j:]))
+
+	return segments
+}
+
+// appendSegment appends text to the diff, merging it into the previous segment
+// when the operation is the same
+func appendSegment(segments []DiffSegment, op, text string) []DiffSegment {
+	if text == "" {
+		return segments
+	}
+	if n := len(segments); n > 0 && segments[n-1].Op == op {
+		segments[n-1].Text += text
+		return segments
+	}
+	return append(segments, DiffSegment{Op: op, Text: text})
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGradeTypedAnswer(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		given    string
+		correct  bool
+		exact    bool
+		numeric  bool
+	}{
+		{"exact", "photosynthesis", "photosynthesis", true, true, false},
+		{"case and punctuation", "Paris, France", "paris france", true, true, false},
+		{"diacritics", "café", "cafe", true, true, false},
+		{"typo within budget", "photosynthesis", "photosyntesis", true, false, false},
+		{"typo beyond budget", "photosynthesis", "fotosintesys", false, false, false},
+		{"short answers allow no typo", "cat", "car", false, false, false},
+		{"alternative", "colour | color", "color", true, true, false},
+		{"doubled delimiter is part of the answer", "a || b", "a || b", true, true, false},
+		{"whole answer with delimiter", "x | y", "x | y", true, true, false},
+		{"empty answer", "paris", "", false, false, false},
+		{"number exact", "1969", "1969", true, true, true},
+		{"number without tolerance", "1969", "1970", false, false, true},
+		{"number within declared tolerance", "9.81 ± 0.05", "9.8", true, false, true},
+		{"number outside declared tolerance", "9.81 +/- 0.05", "9.7", false, false, true},
+		{"thousands grouping", "1000000", "1,000,000", true, true, true},
+		{"decimal comma is not grouping", "314", "3,14", false, false, false},
+		{"long answer far off", "paris", strings.Repeat("x", 2000), false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GradeTypedAnswer(tt.expected, tt.given)
+			if result.Correct != tt.correct || result.Exact != tt.exact || result.Numeric != tt.numeric {
+				t.Errorf("GradeTypedAnswer(%q, %q) = correct %v, exact %v, numeric %v; want %v, %v, %v",
+					tt.expected, tt.given, result.Correct, result.Exact, result.Numeric, tt.correct, tt.exact, tt.numeric)
+			}
+		})
+	}
+}
+
+func TestSplitAlternatives(t *testing.T) {
+	tests := []struct {
+		expected string
+		want     []string
+	}{
+		{"color", []string{"color"}},
+		{"colour | color", []string{"colour", "color"}},
+		{"a|b|c", []string{"a", "b", "c"}},
+		{"a || b", []string{"a || b"}},
+		{"a || b | c", []string{"a || b", "c"}},
+		{" | a | ", []string{"a"}},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		if got := splitAlternatives(tt.expected); !slices.Equal(got, tt.want) {
+			t.Errorf("splitAlternatives(%q) = %q, want %q", tt.expected, got, tt.want)
+		}
+	}
+}
+
+func TestParseNumericAnswer(t *testing.T) {
+	tests := []struct {
+		answer    string
+		value     float64
+		tolerance float64
+		ok        bool
+	}{
+		{"42", 42, 0, true},
+		{"-3.5", -3.5, 0, true},
+		{"42 ± 0.5", 42, 0.5, true},
+		{"42 +/- -0.5", 42, 0.5, true},
+		{"1,234,567.5", 1234567.5, 0, true},
+		{"1 000", 1000, 0, true},
+		{"3,14", 0, 0, false},
+		{"1,23,456", 0, 0, false},
+		{"NaN", 0, 0, false},
+		{"inf", 0, 0, false},
+		{"42 ± x", 0, 0, false},
+		{"forty-two", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		value, tolerance, ok := parseNumericAnswer(tt.answer)
+		if ok != tt.ok || (ok && (value != tt.value || tolerance != tt.tolerance)) {
+			t.Errorf("parseNumericAnswer(%q) = %v, %v, %v; want %v, %v, %v",
+				tt.answer, value, tolerance, ok, tt.value, tt.tolerance, tt.ok)
+		}
+	}
+}
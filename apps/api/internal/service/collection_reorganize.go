@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// Limits on merging and splitting collections
+const (
+	maxMergeSources = 20
+	maxSplitParts   = 20
+)
+
+// CollectionMergePlan describes the result of merging collections into a target
+type CollectionMergePlan struct {
+	Target         *ent.Collection               `json:"target"`
+	Sources        []MergeSource                 `json:"sources"`
+	FlashcardCount int                           `json:"flashcard_count"` // In the target after the merge
+	Collaborators  []repository.CollaboratorRole `json:"collaborators"`   // Of the target after the merge
+	Applied        bool                          `json:"applied"`
+}
+
+// MergeSource is a collection merged into the target and then moved to the trash
+type MergeSource struct {
+	Collection     *ent.Collection `json:"collection"`
+	FlashcardCount int             `json:"flashcard_count"`
+	SubCollections int             `json:"sub_collection_count"`
+}
+
+// SplitPart selects the flashcards of a new collection by tag, including
+// descendant tags, or by ID
+type SplitPart struct {
+	Name         string
+	Description  string
+	Tags         []string
+	FlashcardIDs []uuid.UUID
+}
+
+// CollectionSplitPlan describes the result of splitting a collection
+type CollectionSplitPlan struct {
+	Source         *ent.Collection   `json:"source"`
+	Parts          []SplitPlanPart   `json:"parts"`
+	RemainingCount int               `json:"remaining_flashcard_count"` // Left in the source
+	Collections    []*ent.Collection `json:"collections,omitempty"`     // Created when applied
+	Applied        bool              `json:"applied"`
+}
+
+// SplitPlanPart is a new collection with the flashcards it receives
+type SplitPlanPart struct {
+	Name           string      `json:"name"`
+	Description    string      `json:"description"`
+	FlashcardIDs   []uuid.UUID `json:"flashcard_ids"`
+	FlashcardCount int         `json:"flashcard_count"`
+}
+
+// MergeCollections merges the sources into the target. Flashcards keep their IDs,
+// so every learner's review state is preserved; sub-collections move under the
+// target and collaborators are merged with the highest role winning. Owners of the
+// sources become admins of the target. With preview, nothing is changed.
+func (s *collectionServiceImpl) MergeCollections(ctx context.Context, targetID uuid.UUID, userID string, sourceIDs []uuid.UUID, preview bool) (*CollectionMergePlan, error) {
+	target, role, err := s.GetCollection(ctx, targetID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role != "owner" {
+		return nil, errors.New("permission denied")
+	}
+
+	sourceIDs = uniqueIDs(sourceIDs)
+	if len(sourceIDs) == 0 {
+		return nil, errors.New("source_ids are required")
+	}
+	if len(sourceIDs) > maxMergeSources {
+		return nil, errors.New("at most 20 collections can be merged at once")
+	}
+
+	ancestors, err := s.collectionRepo.ListAncestors(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	isAncestor := make(map[uuid.UUID]bool, len(ancestors))
+	for _, ancestor := range ancestors {
+		isAncestor[ancestor.ID] = true
+	}
+
+	// Highest role of every user across the target and the sources
+	roles := make(map[string]string)
+	grant := func(user, userRole string) {
+		if user != target.OwnerID && roleRank[userRole] > roleRank[roles[user]] {
+			roles[user] = userRole
+		}
+	}
+	for _, c := range target.Edges.Collaborators {
+		grant(c.UserID, c.Role)
+	}
+
+	plan := &CollectionMergePlan{Target: target}
+
+	count, err := s.collectionRepo.CountLiveFlashcards(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	plan.FlashcardCount = count
+
+	for _, sourceID := range sourceIDs {
+		if sourceID == targetID {
+			return nil, errors.New("a collection cannot be merged into itself")
+		}
+		if isAncestor[sourceID] {
+			return nil, errors.New("a collection cannot be merged into its own sub-collection")
+		}
+
+		source, role, err := s.GetCollection(ctx, sourceID, userID)
+		if err != nil {
+			return nil, errors.New("source collection not found")
+		}
+		if role != "owner" {
+			return nil, errors.New("permission denied")
+		}
+
+		levels, err := s.collectionRepo.ListDescendantLevels(ctx, sourceID)
+		if err != nil {
+			return nil, err
+		}
+		// The sub-collections of the source move one level below the target
+		if len(ancestors)+1+len(levels) > repository.MaxCollectionDepth {
+			return nil, errors.New("collections can be nested at most 10 levels deep")
+		}
+
+		grant(source.OwnerID, "admin")
+		for _, c := range source.Edges.Collaborators {
+			grant(c.UserID, c.Role)
+		}
+
+		count, err := s.collectionRepo.CountLiveFlashcards(ctx, sourceID)
+		if err != nil {
+			return nil, err
+		}
+		plan.FlashcardCount += count
+
+		plan.Sources = append(plan.Sources, MergeSource{
+			Collection:     source,
+			FlashcardCount: count,
+			SubCollections: len(source.Edges.Children),
+		})
+	}
+
+	plan.Collaborators = make([]repository.CollaboratorRole, 0, len(roles))
+	for user, userRole := range roles {
+		plan.Collaborators = append(plan.Collaborators, repository.CollaboratorRole{UserID: user, Role: userRole})
+	}
+	sort.Slice(plan.Collaborators, func(i, j int) bool {
+		return plan.Collaborators[i].UserID < plan.Collaborators[j].UserID
+	})
+
+	if preview {
+		return plan, nil
+	}
+
+	if err := s.collectionRepo.Merge(ctx, targetID, sourceIDs, plan.Collaborators, userID); err != nil {
+		return nil, err
+	}
+
+	if plan.Target, err = s.collectionRepo.GetByID(ctx, targetID); err != nil {
+		return nil, err
+	}
+	plan.Applied = true
+
+	return plan, nil
+}
+
+// SplitCollection moves flashcards of a collection into new sibling collections,
+// one per part, which get the same owner, visibility and collaborators. A flashcard
+// selected by several parts goes to the first one. Flashcards keep their IDs, so
+// every learner's review state is preserved. With preview, nothing is changed.
+func (s *collectionServiceImpl) SplitCollection(ctx context.Context, collectionID uuid.UUID, userID string, parts []SplitPart, preview bool) (*CollectionSplitPlan, error) {
+	source, role, err := s.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role != "owner" {
+		return nil, errors.New("permission denied")
+	}
+
+	if len(parts) == 0 {
+		return nil, errors.New("parts are required")
+	}
+	if len(parts) > maxSplitParts {
+		return nil, errors.New("a collection can be split into at most 20 parts")
+	}
+
+	flashcards, err := s.flashcardRepo.ListByCollection(ctx, collectionID, repository.FlashcardFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	inSource := make(map[uuid.UUID]bool, len(flashcards))
+	for _, fc := range flashcards {
+		inSource[fc.ID] = true
+	}
+
+	plan := &CollectionSplitPlan{
+		Source: source,
+		Parts:  make([]SplitPlanPart, len(parts)),
+	}
+	assigned := make(map[uuid.UUID]bool, len(flashcards))
+
+	for i, part := range parts {
+		name := strings.TrimSpace(part.Name)
+		if name == "" {
+			return nil, errors.New("every part needs a name")
+		}
+
+		tags, err := NormalizeTags(part.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if len(tags) == 0 && len(part.FlashcardIDs) == 0 {
+			return nil, errors.New("every part needs tags or flashcard_ids")
+		}
+
+		selected := make(map[uuid.UUID]bool, len(part.FlashcardIDs))
+		for _, id := range part.FlashcardIDs {
+			if !inSource[id] {
+				return nil, errors.New("flashcard not found")
+			}
+			selected[id] = true
+		}
+
+		ids := []uuid.UUID{}
+		// Walking the flashcards keeps each part in collection order
+		for _, fc := range flashcards {
+			if assigned[fc.ID] || !(selected[fc.ID] || hasTagUnder(fc, tags)) {
+				continue
+			}
+			assigned[fc.ID] = true
+			ids = append(ids, fc.ID)
+		}
+
+		plan.Parts[i] = SplitPlanPart{
+			Name:           name,
+			Description:    part.Description,
+			FlashcardIDs:   ids,
+			FlashcardCount: len(ids),
+		}
+	}
+
+	plan.RemainingCount = len(flashcards) - len(assigned)
+
+	if preview {
+		return plan, nil
+	}
+
+	splitParts := make([]repository.CollectionSplitPart, len(plan.Parts))
+	for i, part := range plan.Parts {
+		splitParts[i] = repository.CollectionSplitPart{
+			Name:         part.Name,
+			Description:  part.Description,
+			FlashcardIDs: part.FlashcardIDs,
+		}
+	}
+
+	if plan.Collections, err = s.collectionRepo.Split(ctx, source, splitParts); err != nil {
+		return nil, err
+	}
+	plan.Applied = true
+
+	return plan, nil
+}
+
+// hasTagUnder reports whether the flashcard carries one of the tags or one of
+// their descendants
+func hasTagUnder(fc *ent.Flashcard, tags []string) bool {
+	for _, t := range fc.Edges.Tags {
+		for _, tag := range tags {
+			if t.Name == tag || strings.HasPrefix(t.Name, tag+repository.TagSeparator) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
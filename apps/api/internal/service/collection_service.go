@@ -12,7 +12,10 @@ import (
 type CollectionService interface {
 	GetMyCollections(ctx context.Context, userID string) ([]*ent.Collection, []*ent.Collection, error)
 	GetCollection(ctx context.Context, collectionID uuid.UUID, userID string) (*ent.Collection, string, error)
-	CreateCollection(ctx context.Context, name, description, ownerID string, isPublic bool) (*ent.Collection, error)
+	CreateCollection(ctx context.Context, name, description, ownerID string, isPublic bool, parentID *uuid.UUID) (*ent.Collection, error)
+	MoveCollection(ctx context.Context, collectionID uuid.UUID, userID string, parentID *uuid.UUID) (*ent.Collection, error)
+	MergeCollections(ctx context.Context, targetID uuid.UUID, userID string, sourceIDs []uuid.UUID, preview bool) (*CollectionMergePlan, error)
+	SplitCollection(ctx context.Context, collectionID uuid.UUID, userID string, parts []SplitPart, preview bool) (*CollectionSplitPlan, error)
 	UpdateCollection(ctx context.Context, collectionID uuid.UUID, userID, name, description string, isPublic *bool) (*ent.Collection, error)
 	DeleteCollection(ctx context.Context, collectionID uuid.UUID, userID string) error
 	AddCollaborator(ctx context.Context, collectionID uuid.UUID, userID, email, role string) (*ent.CollectionCollaborator, error)
@@ -20,9 +23,10 @@ type CollectionService interface {
 }
 
 // NewCollectionService creates a new CollectionService instance
-func NewCollectionService(collectionRepo repository.CollectionRepository, userRepo repository.UserRepository) CollectionService {
+func NewCollectionService(collectionRepo repository.CollectionRepository, flashcardRepo repository.FlashcardRepository, userRepo repository.UserRepository) CollectionService {
 	return &collectionServiceImpl{
 		collectionRepo: collectionRepo,
+		flashcardRepo:  flashcardRepo,
 		userRepo:       userRepo,
 	}
 }
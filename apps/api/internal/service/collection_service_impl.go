@@ -11,6 +11,7 @@ import (
 
 type collectionServiceImpl struct {
 	collectionRepo repository.CollectionRepository
+	flashcardRepo  repository.FlashcardRepository
 	userRepo       repository.UserRepository
 }
 
@@ -28,21 +29,32 @@ func (s *collectionServiceImpl) GetMyCollections(ctx context.Context, userID str
 	return owned, shared, nil
 }
 
+// roleRank orders roles from least to most privileged
+var roleRank = map[string]int{
+	"viewer": 1,
+	"editor": 2,
+	"admin":  3,
+	"owner":  4,
+}
+
+// GetCollection returns a collection with the user's role on it. Roles are
+// inherited from ancestors, and the most privileged role along the path applies.
 func (s *collectionServiceImpl) GetCollection(ctx context.Context, collectionID uuid.UUID, userID string) (*ent.Collection, string, error) {
 	collection, err := s.collectionRepo.GetByID(ctx, collectionID)
 	if err != nil {
 		return nil, "", err
 	}
 
-	role := ""
-	if collection.OwnerID == userID {
-		role = "owner"
-	} else {
-		// Check collaborators
-		for _, c := range collection.Edges.Collaborators {
-			if c.UserID == userID {
-				role = c.Role
-				break
+	role := roleIn(collection, userID)
+
+	if collection.ParentID != nil && role != "owner" {
+		ancestors, err := s.collectionRepo.ListAncestors(ctx, collectionID)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, ancestor := range ancestors {
+			if inherited := roleIn(ancestor, userID); roleRank[inherited] > roleRank[role] {
+				role = inherited
 			}
 		}
 	}
@@ -54,8 +66,93 @@ func (s *collectionServiceImpl) GetCollection(ctx context.Context, collectionID
 	return collection, role, nil
 }
 
-func (s *collectionServiceImpl) CreateCollection(ctx context.Context, name, description, ownerID string, isPublic bool) (*ent.Collection, error) {
-	return s.collectionRepo.Create(ctx, name, description, ownerID, isPublic)
+// roleIn returns the user's own role on a collection, ignoring ancestors
+func roleIn(collection *ent.Collection, userID string) string {
+	if collection.OwnerID == userID {
+		return "owner"
+	}
+
+	for _, c := range collection.Edges.Collaborators {
+		if c.UserID == userID {
+			return c.Role
+		}
+	}
+
+	return ""
+}
+
+// CreateCollection creates a collection, as a sub-collection of parentID if set
+func (s *collectionServiceImpl) CreateCollection(ctx context.Context, name, description, ownerID string, isPublic bool, parentID *uuid.UUID) (*ent.Collection, error) {
+	if parentID != nil {
+		if err := s.checkCanNestUnder(ctx, *parentID, ownerID, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.collectionRepo.Create(ctx, name, description, ownerID, isPublic, parentID)
+}
+
+// MoveCollection moves a collection and its sub-collections under a new parent, or
+// to the top level when parentID is nil
+func (s *collectionServiceImpl) MoveCollection(ctx context.Context, collectionID uuid.UUID, userID string, parentID *uuid.UUID) (*ent.Collection, error) {
+	_, role, err := s.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Moving changes the permissions the collection inherits
+	if role != "owner" && role != "admin" {
+		return nil, errors.New("permission denied")
+	}
+
+	levels, err := s.collectionRepo.ListDescendantLevels(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parentID != nil {
+		if *parentID == collectionID {
+			return nil, errors.New("a collection cannot be its own parent")
+		}
+		for _, level := range levels {
+			for _, id := range level {
+				if id == *parentID {
+					return nil, errors.New("a collection cannot be moved into its own sub-collection")
+				}
+			}
+		}
+
+		if err := s.checkCanNestUnder(ctx, *parentID, userID, len(levels)+1); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.collectionRepo.SetParent(ctx, collectionID, parentID)
+}
+
+// checkCanNestUnder checks that the user can edit the parent and that a subtree of
+// the given height fits below it
+func (s *collectionServiceImpl) checkCanNestUnder(ctx context.Context, parentID uuid.UUID, userID string, height int) error {
+	_, role, err := s.GetCollection(ctx, parentID, userID)
+	if err != nil {
+		return errors.New("parent collection not found")
+	}
+
+	if role == "viewer" {
+		return errors.New("permission denied")
+	}
+
+	ancestors, err := s.collectionRepo.ListAncestors(ctx, parentID)
+	if err != nil {
+		return err
+	}
+
+	// The parent and its ancestors are levels above the subtree
+	if len(ancestors)+1+height > repository.MaxCollectionDepth {
+		return errors.New("collections can be nested at most 10 levels deep")
+	}
+
+	return nil
 }
 
 func (s *collectionServiceImpl) UpdateCollection(ctx context.Context, collectionID uuid.UUID, userID, name, description string, isPublic *bool) (*ent.Collection, error) {
@@ -94,7 +191,8 @@ func (s *collectionServiceImpl) DeleteCollection(ctx context.Context, collection
 		return errors.New("permission denied")
 	}
 
-	return s.collectionRepo.Delete(ctx, collectionID)
+	// Moved to the trash; TrashService purges it after the retention window
+	return s.collectionRepo.SoftDelete(ctx, collectionID, userID)
 }
 
 func (s *collectionServiceImpl) AddCollaborator(ctx context.Context, collectionID uuid.UUID, userID, email, role string) (*ent.CollectionCollaborator, error) {
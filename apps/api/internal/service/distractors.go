@@ -0,0 +1,145 @@
+package service
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/content"
+)
+
+// Number of options when a simple flashcard is presented as multiple choice,
+// including the correct answer
+const (
+	DefaultGeneratedChoices = 4
+	maxGeneratedChoices     = 10
+)
+
+// Weights of the similarity of a distractor to the correct answer. The jitter lets
+// different seeds pick different distractors among similar ones.
+const (
+	distractorLengthWeight = 1.0
+	distractorWordWeight   = 1.0
+	distractorTagWeight    = 0.5
+	distractorJitter       = 0.25
+)
+
+func validChoiceCount(count int) bool {
+	return count >= minChoiceCount && count <= maxGeneratedChoices
+}
+
+// choiceText returns the answer of a flashcard as it is shown among choices: the
+// plain text of its first accepted alternative
+func choiceText(fc *ent.Flashcard) string {
+	answer := content.PlainText(fc.ContentFormat, fc.Answer)
+	if alternatives := splitAlternatives(answer); len(alternatives) > 0 {
+		return alternatives[0]
+	}
+	return strings.TrimSpace(answer)
+}
+
+// GenerateChoices presents a simple flashcard as multiple choice. Distractors are
+// taken from the answers of sibling flashcards, preferring those of similar length,
+// sharing words with the answer and sharing a tag with the flashcard; answers that
+// would be graded as correct are left out. The same siblings and random source
+// always give the same choices, in shuffled order with the correct answer among
+// them. Fewer choices are returned when there are not enough distinct answers.
+func GenerateChoices(fc *ent.Flashcard, siblings []*ent.Flashcard, count int, rng *rand.Rand) []string {
+	answer := choiceText(fc)
+	if answer == "" {
+		return nil
+	}
+
+	expected := content.PlainText(fc.ContentFormat, fc.Answer)
+	answerWords := wordSet(answer)
+	tags := make(map[string]bool, len(fc.Edges.Tags))
+	for _, tag := range fc.Edges.Tags {
+		tags[tag.Name] = true
+	}
+
+	type candidate struct {
+		text  string
+		score float64
+	}
+
+	seen := map[string]bool{NormalizeAnswer(answer): true}
+	candidates := make([]candidate, 0, len(siblings))
+	for _, sibling := range siblings {
+		if sibling.ID == fc.ID || (sibling.Type != FlashcardTypeSimple && sibling.Type != FlashcardTypeMultipleChoice) {
+			continue
+		}
+
+		text := choiceText(sibling)
+		key := NormalizeAnswer(text)
+		if text == "" || seen[key] || GradeTypedAnswer(expected, text).Correct {
+			continue
+		}
+		seen[key] = true
+
+		sharesTag := false
+		for _, tag := range sibling.Edges.Tags {
+			if tags[tag.Name] {
+				sharesTag = true
+				break
+			}
+		}
+
+		score := distractorLengthWeight*lengthSimilarity(answer, text) +
+			distractorWordWeight*wordOverlap(answerWords, wordSet(text)) +
+			distractorJitter*rng.Float64()
+		if sharesTag {
+			score += distractorTagWeight
+		}
+		candidates = append(candidates, candidate{text: text, score: score})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	choices := []string{answer}
+	for _, c := range candidates {
+		if len(choices) >= count {
+			break
+		}
+		choices = append(choices, c.text)
+	}
+	if len(choices) < minChoiceCount {
+		return nil
+	}
+
+	rng.Shuffle(len(choices), func(i, j int) {
+		choices[i], choices[j] = choices[j], choices[i]
+	})
+	return choices
+}
+
+func lengthSimilarity(a, b string) float64 {
+	la, lb := float64(len([]rune(a))), float64(len([]rune(b)))
+	return 1 - math.Abs(la-lb)/math.Max(math.Max(la, lb), 1)
+}
+
+func wordSet(s string) map[string]bool {
+	words := make(map[string]bool)
+	for _, word := range strings.Fields(NormalizeAnswer(s)) {
+		words[word] = true
+	}
+	return words
+}
+
+// wordOverlap returns the Jaccard similarity of two sets of words
+func wordOverlap(a, b map[string]bool) float64 {
+	shared := 0
+	for word := range a {
+		if b[word] {
+			shared++
+		}
+	}
+	union := len(a) + len(b) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
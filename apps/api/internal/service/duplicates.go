@@ -0,0 +1,121 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/content"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// Duplicate detection thresholds. Similarity is the trigram similarity of the
+// normalized questions, 1 for exact duplicates.
+const (
+	duplicateSimilarity     = 0.6
+	maxDuplicateCandidates  = 5
+	maxDuplicatePairs       = 1000
+	questionKeyBackfillSize = 500
+)
+
+// DuplicateFlashcardsError is returned when a new flashcard resembles existing ones
+// and the caller has not confirmed that it should be created anyway
+type DuplicateFlashcardsError struct {
+	Candidates []repository.SimilarFlashcard
+}
+
+func (e *DuplicateFlashcardsError) Error() string {
+	return fmt.Sprintf("flashcard looks like a duplicate of %d existing flashcards", len(e.Candidates))
+}
+
+// DuplicateGroup is a set of flashcards with similar questions. KeepID is the
+// suggested flashcard to keep when merging: the one with the most review history.
+type DuplicateGroup struct {
+	KeepID     uuid.UUID            `json:"keep_id"`
+	Flashcards []DuplicateFlashcard `json:"flashcards"`
+	Exact      bool                 `json:"exact"`
+	Similarity float64              `json:"similarity"` // Lowest similarity between linked flashcards
+}
+
+// DuplicateFlashcard is a member of a duplicate group
+type DuplicateFlashcard struct {
+	Flashcard   *ent.Flashcard `json:"flashcard"`
+	ReviewCount int            `json:"review_count"`
+}
+
+// QuestionKey normalizes the question of a flashcard for duplicate detection
+func QuestionKey(format, question string) string {
+	return NormalizeAnswer(content.PlainText(format, question))
+}
+
+// groupDuplicates joins similar pairs into groups of transitively similar
+// flashcards
+func groupDuplicates(pairs []repository.SimilarPair) [][]uuid.UUID {
+	parent := make(map[uuid.UUID]uuid.UUID)
+
+	var find func(id uuid.UUID) uuid.UUID
+	find = func(id uuid.UUID) uuid.UUID {
+		p, ok := parent[id]
+		if !ok {
+			parent[id] = id
+			return id
+		}
+		if p == id {
+			return id
+		}
+		root := find(p)
+		parent[id] = root
+		return root
+	}
+
+	for _, pair := range pairs {
+		a, b := find(pair.FlashcardID), find(pair.DuplicateID)
+		if a != b {
+			parent[b] = a
+		}
+	}
+
+	members := make(map[uuid.UUID][]uuid.UUID)
+	for id := range parent {
+		root := find(id)
+		members[root] = append(members[root], id)
+	}
+
+	groups := make([][]uuid.UUID, 0, len(members))
+	for _, ids := range members {
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i].String() < ids[j].String()
+		})
+		groups = append(groups, ids)
+	}
+
+	return groups
+}
+
+// buildDuplicateGroup orders the flashcards of a group by review history and
+// suggests keeping the most reviewed one, or the oldest on a tie
+func buildDuplicateGroup(flashcards []*ent.Flashcard, reviewCounts map[uuid.UUID]int, similarity float64) DuplicateGroup {
+	members := make([]DuplicateFlashcard, len(flashcards))
+	exact := true
+	for i, fc := range flashcards {
+		members[i] = DuplicateFlashcard{Flashcard: fc, ReviewCount: reviewCounts[fc.ID]}
+		if fc.QuestionKey != flashcards[0].QuestionKey {
+			exact = false
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].ReviewCount != members[j].ReviewCount {
+			return members[i].ReviewCount > members[j].ReviewCount
+		}
+		return members[i].Flashcard.CreatedAt.Before(members[j].Flashcard.CreatedAt)
+	})
+
+	return DuplicateGroup{
+		KeepID:     members[0].Flashcard.ID,
+		Flashcards: members,
+		Exact:      exact,
+		Similarity: similarity,
+	}
+}
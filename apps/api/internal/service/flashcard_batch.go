@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// MaxBatchOperations is the maximum number of operations in a flashcard batch
+const MaxBatchOperations = 500
+
+// Statuses of batch operations
+const (
+	BatchStatusOK         = "ok"
+	BatchStatusFailed     = "failed"
+	BatchStatusRolledBack = "rolled_back" // Valid, but undone because another operation failed
+)
+
+// BatchItemResult is the outcome of one operation of a flashcard batch
+type BatchItemResult struct {
+	Index        int            `json:"index"`
+	Op           string         `json:"op"`
+	Status       string         `json:"status"`
+	Flashcard    *ent.Flashcard `json:"flashcard,omitempty"`
+	ErrorMessage string         `json:"errorMessage,omitempty"`
+}
+
+// BatchResult is the outcome of a flashcard batch. Committed is false when an
+// atomic batch was rolled back.
+type BatchResult struct {
+	Committed bool              `json:"committed"`
+	Succeeded int               `json:"succeeded"`
+	Failed    int               `json:"failed"`
+	Results   []BatchItemResult `json:"results"`
+}
+
+// BatchFlashcards runs mixed create, update and delete operations on the flashcards
+// of a collection in one transaction. Every operation is validated first. Atomic
+// batches apply nothing unless every operation succeeds; otherwise the valid
+// operations are applied and the failed ones reported. Batch creates skip the
+// duplicate check of CreateFlashcard.
+func (s *flashcardServiceImpl) BatchFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, ops []repository.BatchOperation, atomic bool) (*BatchResult, error) {
+	if len(ops) == 0 {
+		return nil, errors.New("operations are required")
+	}
+	if len(ops) > MaxBatchOperations {
+		return nil, fmt.Errorf("a batch can contain at most %d operations", MaxBatchOperations)
+	}
+
+	// Permissions are checked once for the whole batch
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return nil, err
+	}
+
+	current, err := s.batchFlashcards(ctx, collectionID, ops)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResult{Results: make([]BatchItemResult, len(ops))}
+	attachable := make(map[uuid.UUID]error)
+
+	valid := make([]repository.BatchOperation, 0, len(ops))
+	validIndex := make([]int, 0, len(ops))
+	for i, op := range ops {
+		result.Results[i] = BatchItemResult{Index: i, Op: op.Op}
+
+		if err := s.validateBatchOperation(ctx, collectionID, userID, &op, current, attachable); err != nil {
+			result.Results[i].Status = BatchStatusFailed
+			result.Results[i].ErrorMessage = err.Error()
+			result.Failed++
+			continue
+		}
+
+		valid = append(valid, op)
+		validIndex = append(validIndex, i)
+	}
+
+	if atomic && result.Failed > 0 {
+		result.markRolledBack()
+		return result, nil
+	}
+
+	outcomes, err := s.flashcardRepo.ApplyBatch(ctx, collectionID, userID, valid, atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	for j, outcome := range outcomes {
+		item := &result.Results[validIndex[j]]
+		if outcome.Err != nil {
+			item.Status = BatchStatusFailed
+			item.ErrorMessage = batchErrorMessage(outcome.Err)
+			result.Failed++
+			continue
+		}
+		item.Status = BatchStatusOK
+		item.Flashcard = outcome.Flashcard
+	}
+
+	if atomic && result.Failed > 0 {
+		result.markRolledBack()
+		return result, nil
+	}
+
+	result.Committed = true
+	result.Succeeded = len(ops) - result.Failed
+	return result, nil
+}
+
+// batchFlashcards loads the flashcards the batch updates or deletes
+func (s *flashcardServiceImpl) batchFlashcards(ctx context.Context, collectionID uuid.UUID, ops []repository.BatchOperation) (map[uuid.UUID]*ent.Flashcard, error) {
+	var ids []uuid.UUID
+	for _, op := range ops {
+		if op.Op == repository.BatchUpdate || op.Op == repository.BatchDelete {
+			ids = append(ids, op.FlashcardID)
+		}
+	}
+
+	current := make(map[uuid.UUID]*ent.Flashcard, len(ids))
+	if len(ids) == 0 {
+		return current, nil
+	}
+
+	flashcards, err := s.flashcardRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fc := range flashcards {
+		if fc.CollectionID == collectionID {
+			current[fc.ID] = fc
+		}
+	}
+
+	return current, nil
+}
+
+// validateBatchOperation validates an operation and prepares its content the same
+// way as the single flashcard endpoints
+func (s *flashcardServiceImpl) validateBatchOperation(ctx context.Context, collectionID uuid.UUID, userID string, op *repository.BatchOperation, current map[uuid.UUID]*ent.Flashcard, attachable map[uuid.UUID]error) error {
+	switch op.Op {
+	case repository.BatchCreate:
+		applyFlashcardDefaults(&op.Fields)
+		if !validFlashcardStatus(op.Fields.Status) {
+			return errors.New("invalid flashcard status")
+		}
+
+	case repository.BatchUpdate:
+		flashcard, ok := current[op.FlashcardID]
+		if !ok {
+			return errors.New("flashcard not found")
+		}
+		mergeFlashcardFields(flashcard, &op.Fields)
+		if err := checkOcclusionMaskIDs(flashcard, &op.Fields); err != nil {
+			return err
+		}
+
+	case repository.BatchDelete:
+		if _, ok := current[op.FlashcardID]; !ok {
+			return errors.New("flashcard not found")
+		}
+		return nil
+
+	default:
+		return errors.New("op must be create, update or delete")
+	}
+
+	if err := prepareFlashcardContent(&op.Fields); err != nil {
+		return err
+	}
+
+	for _, mediaID := range op.Fields.MediaIDs {
+		err, checked := attachable[mediaID]
+		if !checked {
+			err = s.mediaService.CanAttach(ctx, mediaID, collectionID, userID)
+			attachable[mediaID] = err
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markRolledBack reports every operation of a rolled back batch that did not fail
+// itself as rolled back
+func (r *BatchResult) markRolledBack() {
+	for i := range r.Results {
+		if r.Results[i].Status != BatchStatusFailed {
+			r.Results[i].Status = BatchStatusRolledBack
+			r.Results[i].Flashcard = nil
+		}
+	}
+}
+
+func batchErrorMessage(err error) string {
+	switch {
+	case ent.IsNotFound(err):
+		return "flashcard not found"
+	case ent.IsValidationError(err), ent.IsConstraintError(err):
+		return "invalid flashcard"
+	}
+	return err.Error()
+}
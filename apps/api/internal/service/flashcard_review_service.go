@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
@@ -19,15 +20,22 @@ const (
 	RatingEasy  ReviewRating = 3 // Perfect recall
 )
 
+// maxHintedRating caps the rating of answers given after revealing the hint:
+// assisted recall is scheduled like recall with serious difficulty
+const maxHintedRating = RatingHard
+
 // FlashcardReviewService defines the interface for flashcard review business logic
 type FlashcardReviewService interface {
 	StartLearningSession(ctx context.Context, collectionID uuid.UUID, userID string) error
-	GetDueCards(ctx context.Context, collectionID uuid.UUID, userID string, limit int) ([]*ent.FlashcardReview, error)
+	GetDueCards(ctx context.Context, collectionID uuid.UUID, userID string, limit int, filter repository.FlashcardFilter) ([]*ent.FlashcardReview, error)
 	GetCollectionStats(ctx context.Context, collectionID uuid.UUID, userID string) (*repository.CollectionStats, error)
-	SubmitReview(ctx context.Context, flashcardID uuid.UUID, userID string, rating ReviewRating) (*ent.FlashcardReview, error)
-	GetReviewByFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) (*ent.FlashcardReview, error)
+	SubmitReview(ctx context.Context, flashcardID uuid.UUID, userID string, item int, rating ReviewRating, hintUsed bool) (*ent.FlashcardReview, error)
+	GradeAnswer(ctx context.Context, flashcardID uuid.UUID, userID string, submission AnswerSubmission) (*GradeResult, error)
+	GetChoices(ctx context.Context, flashcardID uuid.UUID, userID string, count int, seed *int64) ([]string, int64, error)
+	GetReviewByFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string, item int) (*ent.FlashcardReview, error)
 	GetAllReviewsForCollection(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.FlashcardReview, error)
 	ClearProgress(ctx context.Context, collectionID uuid.UUID, userID string) (int, error)
+	ApplyQuizAnswers(ctx context.Context, attempt *ent.QuizAttempt, slowAnswer time.Duration) (int, int, error)
 }
 
 // ValidateRating checks if the rating is valid
@@ -41,11 +49,13 @@ func ValidateRating(rating int) (ReviewRating, error) {
 // NewFlashcardReviewService creates a new FlashcardReviewService instance
 func NewFlashcardReviewService(
 	reviewRepo repository.FlashcardReviewRepository,
+	quizUpdateRepo repository.QuizReviewUpdateRepository,
 	flashcardRepo repository.FlashcardRepository,
 	collectionService CollectionService,
 ) FlashcardReviewService {
 	return &flashcardReviewServiceImpl{
 		reviewRepo:        reviewRepo,
+		quizUpdateRepo:    quizUpdateRepo,
 		flashcardRepo:     flashcardRepo,
 		collectionService: collectionService,
 	}
@@ -2,7 +2,11 @@ package service
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"math"
+	"math/rand/v2"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +25,7 @@ const maxInterval = 365 * 1440     // Max interval: 1 year in minutes
 
 type flashcardReviewServiceImpl struct {
 	reviewRepo        repository.FlashcardReviewRepository
+	quizUpdateRepo    repository.QuizReviewUpdateRepository
 	flashcardRepo     repository.FlashcardRepository
 	collectionService CollectionService
 }
@@ -36,13 +41,23 @@ func (s *flashcardReviewServiceImpl) StartLearningSession(ctx context.Context, c
 }
 
 // GetDueCards returns cards that are due for review in a collection
-func (s *flashcardReviewServiceImpl) GetDueCards(ctx context.Context, collectionID uuid.UUID, userID string, limit int) ([]*ent.FlashcardReview, error) {
+func (s *flashcardReviewServiceImpl) GetDueCards(ctx context.Context, collectionID uuid.UUID, userID string, limit int, filter repository.FlashcardFilter) ([]*ent.FlashcardReview, error) {
 	_, _, err := s.collectionService.GetCollection(ctx, collectionID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.reviewRepo.ListDueByCollection(ctx, userID, collectionID, limit)
+	filter.Tags, err = NormalizeTags(filter.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Annotations, err = normalizeAnnotationFilter(filter.Annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.reviewRepo.ListDueByCollection(ctx, userID, collectionID, limit, filter)
 }
 
 // GetCollectionStats returns learning statistics for a collection
@@ -55,8 +70,9 @@ func (s *flashcardReviewServiceImpl) GetCollectionStats(ctx context.Context, col
 	return s.reviewRepo.GetCollectionStats(ctx, userID, collectionID)
 }
 
-// SubmitReview processes a review and updates the card's SRS data based on SM-2 algorithm
-func (s *flashcardReviewServiceImpl) SubmitReview(ctx context.Context, flashcardID uuid.UUID, userID string, rating ReviewRating) (*ent.FlashcardReview, error) {
+// SubmitReview processes a review and updates the card's SRS data based on SM-2 algorithm.
+// Answers given after revealing the hint are counted and rated at most Hard.
+func (s *flashcardReviewServiceImpl) SubmitReview(ctx context.Context, flashcardID uuid.UUID, userID string, item int, rating ReviewRating, hintUsed bool) (*ent.FlashcardReview, error) {
 	fc, err := s.flashcardRepo.GetByID(ctx, flashcardID)
 	if err != nil {
 		return nil, err
@@ -67,16 +83,101 @@ func (s *flashcardReviewServiceImpl) SubmitReview(ctx context.Context, flashcard
 		return nil, err
 	}
 
-	review, err := s.reviewRepo.GetOrCreate(ctx, userID, flashcardID)
+	if fc.Status.String() != FlashcardStatusPublished {
+		return nil, errors.New("flashcard is not published")
+	}
+
+	if item < 0 || item >= fc.Occlusion.ReviewItemCount() {
+		return nil, errors.New("invalid review item")
+	}
+
+	review, err := s.reviewRepo.GetOrCreate(ctx, userID, flashcardID, item)
 	if err != nil {
 		return nil, err
 	}
 
+	if hintUsed && rating > maxHintedRating {
+		rating = maxHintedRating
+	}
+
 	update := s.calculateNextReview(review, rating)
+	if hintUsed {
+		update.HintCount++
+	}
 
 	return s.reviewRepo.Update(ctx, review.ID, update)
 }
 
+// GradeAnswer grades an answer according to the flashcard type and suggests a rating
+func (s *flashcardReviewServiceImpl) GradeAnswer(ctx context.Context, flashcardID uuid.UUID, userID string, submission AnswerSubmission) (*GradeResult, error) {
+	fc, err := s.flashcardRepo.GetByID(ctx, flashcardID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, role, err := s.collectionService.GetCollection(ctx, fc.CollectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !visibleWithRole(fc, role) {
+		return nil, errors.New("flashcard not found")
+	}
+
+	return GradeSubmission(fc, submission), nil
+}
+
+// GetChoices presents a simple flashcard as multiple choice during review, with
+// distractors generated from the answers of the other published flashcards of its
+// collection. The same seed gives the same choices; a random seed is used when
+// none is given and returned with the choices. The chosen option is graded with
+// GradeAnswer like a typed answer.
+func (s *flashcardReviewServiceImpl) GetChoices(ctx context.Context, flashcardID uuid.UUID, userID string, count int, seed *int64) ([]string, int64, error) {
+	fc, err := s.flashcardRepo.GetByID(ctx, flashcardID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	_, role, err := s.collectionService.GetCollection(ctx, fc.CollectionID, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !visibleWithRole(fc, role) {
+		return nil, 0, errors.New("flashcard not found")
+	}
+
+	if fc.Type != FlashcardTypeSimple {
+		return nil, 0, errors.New("only simple flashcards can be presented as multiple choice")
+	}
+
+	if count == 0 {
+		count = DefaultGeneratedChoices
+	}
+	if !validChoiceCount(count) {
+		return nil, 0, fmt.Errorf("choice count must be between %d and %d", minChoiceCount, maxGeneratedChoices)
+	}
+
+	siblings, err := s.flashcardRepo.ListByCollection(ctx, fc.CollectionID, repository.FlashcardFilter{Status: FlashcardStatusPublished})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	chosenSeed := rand.Int64()
+	if seed != nil {
+		chosenSeed = *seed
+	}
+
+	// Each flashcard gets its own stream, so one seed can serve a whole session
+	rng := rand.New(rand.NewPCG(uint64(chosenSeed), binary.BigEndian.Uint64(fc.ID[8:])))
+	choices := GenerateChoices(fc, siblings, count, rng)
+	if choices == nil {
+		return nil, 0, errors.New("not enough other answers in the collection")
+	}
+
+	return choices, chosenSeed, nil
+}
+
 // calculateNextReview implements the SM-2 algorithm to determine the next review
 func (s *flashcardReviewServiceImpl) calculateNextReview(review *ent.FlashcardReview, rating ReviewRating) repository.FlashcardReviewUpdate {
 	now := time.Now()
@@ -88,6 +189,7 @@ func (s *flashcardReviewServiceImpl) calculateNextReview(review *ent.FlashcardRe
 		LearningStep:   review.LearningStep,
 		ReviewCount:    review.ReviewCount + 1,
 		LapseCount:     review.LapseCount,
+		HintCount:      review.HintCount,
 		LastReviewedAt: now,
 	}
 
@@ -190,18 +292,22 @@ func (s *flashcardReviewServiceImpl) processReview(update *repository.FlashcardR
 }
 
 // GetReviewByFlashcard returns the review for a specific flashcard for the current user
-func (s *flashcardReviewServiceImpl) GetReviewByFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) (*ent.FlashcardReview, error) {
+func (s *flashcardReviewServiceImpl) GetReviewByFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string, item int) (*ent.FlashcardReview, error) {
 	fc, err := s.flashcardRepo.GetByID(ctx, flashcardID)
 	if err != nil {
 		return nil, err
 	}
 
-	_, _, err = s.collectionService.GetCollection(ctx, fc.CollectionID, userID)
+	_, role, err := s.collectionService.GetCollection(ctx, fc.CollectionID, userID)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.reviewRepo.GetByUserAndFlashcard(ctx, userID, flashcardID)
+	if !visibleWithRole(fc, role) {
+		return nil, errors.New("flashcard not found")
+	}
+
+	return s.reviewRepo.GetByUserAndFlashcard(ctx, userID, flashcardID, item)
 }
 
 // GetAllReviewsForCollection returns all reviews for a user in a collection
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"reflect"
+
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// RevisionFieldChange is a field that differs between two revisions. Text fields
+// also carry a character diff.
+type RevisionFieldChange struct {
+	Field string        `json:"field"`
+	From  any           `json:"from"`
+	To    any           `json:"to"`
+	Diff  []DiffSegment `json:"diff,omitempty"`
+}
+
+// RevisionDiff is the difference between two revisions of a flashcard
+type RevisionDiff struct {
+	From    *ent.FlashcardRevision `json:"from"`
+	To      *ent.FlashcardRevision `json:"to"`
+	Changes []RevisionFieldChange  `json:"changes"`
+}
+
+// DiffRevisions compares the content of two revisions field by field
+func DiffRevisions(from, to *ent.FlashcardRevision) *RevisionDiff {
+	diff := &RevisionDiff{
+		From:    from,
+		To:      to,
+		Changes: []RevisionFieldChange{},
+	}
+
+	text := func(field, a, b string) {
+		if a != b {
+			diff.Changes = append(diff.Changes, RevisionFieldChange{
+				Field: field,
+				From:  a,
+				To:    b,
+				Diff:  diffRunes(a, b),
+			})
+		}
+	}
+	value := func(field string, a, b any) {
+		if !reflect.DeepEqual(a, b) {
+			diff.Changes = append(diff.Changes, RevisionFieldChange{
+				Field: field,
+				From:  a,
+				To:    b,
+			})
+		}
+	}
+
+	text(repository.RevisionFieldQuestion, from.Question, to.Question)
+	text(repository.RevisionFieldAnswer, from.Answer, to.Answer)
+	value(repository.RevisionFieldType, from.Type, to.Type)
+	value(repository.RevisionFieldContentFormat, from.ContentFormat, to.ContentFormat)
+	text(repository.RevisionFieldHint, from.Hint, to.Hint)
+	text(repository.RevisionFieldExplanation, from.Explanation, to.Explanation)
+	if len(from.Sources) > 0 || len(to.Sources) > 0 {
+		value(repository.RevisionFieldSources, from.Sources, to.Sources)
+	}
+	if len(from.Options) > 0 || len(to.Options) > 0 {
+		value(repository.RevisionFieldOptions, from.Options, to.Options)
+	}
+	if len(from.Pairs) > 0 || len(to.Pairs) > 0 {
+		value(repository.RevisionFieldPairs, from.Pairs, to.Pairs)
+	}
+	value(repository.RevisionFieldOcclusion, from.Occlusion, to.Occlusion)
+	if len(from.MediaIds) > 0 || len(to.MediaIds) > 0 {
+		value(repository.RevisionFieldMedia, from.MediaIds, to.MediaIds)
+	}
+
+	return diff
+}
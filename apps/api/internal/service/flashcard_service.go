@@ -8,19 +8,61 @@ import (
 	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
 )
 
+// FlashcardSearchResult is a flashcard matching a search. The snippets are safe HTML
+// with the matched terms wrapped in <mark>.
+type FlashcardSearchResult struct {
+	Flashcard       *ent.Flashcard `json:"flashcard"`
+	Rank            float64        `json:"rank"`
+	QuestionSnippet string         `json:"question_snippet"`
+	AnswerSnippet   string         `json:"answer_snippet"`
+}
+
 // FlashcardService defines the interface for flashcard business logic
 type FlashcardService interface {
-	GetCollectionFlashcards(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.Flashcard, string, error)
+	GetCollectionFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, filter repository.FlashcardFilter) ([]*ent.Flashcard, string, error)
 	GetFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) (*ent.Flashcard, string, error)
-	CreateFlashcard(ctx context.Context, collectionID uuid.UUID, userID, question, answer, typeStr string) (*ent.Flashcard, error)
-	UpdateFlashcard(ctx context.Context, flashcardID uuid.UUID, userID, question, answer, typeStr string) (*ent.Flashcard, error)
+	CreateFlashcard(ctx context.Context, collectionID uuid.UUID, userID string, fields repository.FlashcardFields, allowDuplicates bool) (*ent.Flashcard, error)
+	UpdateFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string, fields repository.FlashcardFields) (*ent.Flashcard, error)
 	DeleteFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) error
+	MoveFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, targetCollectionID uuid.UUID) ([]*ent.Flashcard, error)
+	CopyFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, targetCollectionID uuid.UUID, copyProgress bool) ([]*ent.Flashcard, error)
+	BatchFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, ops []repository.BatchOperation, atomic bool) (*BatchResult, error)
+	SetFlashcardStatus(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, all bool, status string) (int, error)
+	MoveFlashcard(ctx context.Context, collectionID, flashcardID uuid.UUID, userID string, targetCollectionID, afterID, beforeID *uuid.UUID) (*ent.Flashcard, error)
+	GetOcclusionItems(ctx context.Context, flashcardID uuid.UUID, userID string) ([]*OcclusionRender, error)
+	ListTags(ctx context.Context, collectionID uuid.UUID, userID string) ([]repository.TagCount, error)
+	AddTags(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, tags []string) (int, error)
+	RemoveTags(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, tags []string) (int, error)
+	RenameTag(ctx context.Context, collectionID uuid.UUID, userID string, from, to string) (int, error)
+	SearchFlashcards(ctx context.Context, search repository.FlashcardSearch) ([]FlashcardSearchResult, int, error)
+	ListRevisions(ctx context.Context, flashcardID uuid.UUID, userID string) ([]*ent.FlashcardRevision, error)
+	DiffRevisions(ctx context.Context, flashcardID uuid.UUID, userID string, from, to int) (*RevisionDiff, error)
+	RestoreRevision(ctx context.Context, flashcardID uuid.UUID, userID string, number int) (*ent.Flashcard, error)
+	FindDuplicates(ctx context.Context, collectionID uuid.UUID, userID string) ([]DuplicateGroup, error)
+	MergeDuplicates(ctx context.Context, collectionID uuid.UUID, userID string, keepID uuid.UUID, duplicateIDs []uuid.UUID) (*ent.Flashcard, error)
+	BackfillQuestionKeys(ctx context.Context)
+	BackfillSearchText(ctx context.Context)
+	GetAnnotation(ctx context.Context, flashcardID uuid.UUID, userID string) (*ent.FlashcardAnnotation, error)
+	AnnotateFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string, fields repository.AnnotationFields) (*ent.FlashcardAnnotation, error)
+	DeleteAnnotation(ctx context.Context, flashcardID uuid.UUID, userID string) error
+	ListAnnotations(ctx context.Context, userID string, filter repository.AnnotationFilter) ([]*ent.FlashcardAnnotation, error)
 }
 
 // NewFlashcardService creates a new FlashcardService instance
-func NewFlashcardService(flashcardRepo repository.FlashcardRepository, collectionService CollectionService) FlashcardService {
+func NewFlashcardService(
+	flashcardRepo repository.FlashcardRepository,
+	tagRepo repository.TagRepository,
+	revisionRepo repository.FlashcardRevisionRepository,
+	annotationRepo repository.FlashcardAnnotationRepository,
+	collectionService CollectionService,
+	mediaService MediaService,
+) FlashcardService {
 	return &flashcardServiceImpl{
 		flashcardRepo:     flashcardRepo,
+		tagRepo:           tagRepo,
+		revisionRepo:      revisionRepo,
+		annotationRepo:    annotationRepo,
 		collectionService: collectionService,
+		mediaService:      mediaService,
 	}
 }
@@ -3,24 +3,62 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+	"github.com/quanphung1120/advanced-quiz-be/internal/content"
 	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
 )
 
+// Search limits
+const (
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 100
+	maxSearchQueryLength = 200
+)
+
+// Limits on the teaching fields of a flashcard
+const (
+	maxHintLength        = 1000
+	maxExplanationLength = 20000
+	maxSources           = 20
+	maxCitationLength    = 500
+)
+
 type flashcardServiceImpl struct {
 	flashcardRepo     repository.FlashcardRepository
+	tagRepo           repository.TagRepository
+	revisionRepo      repository.FlashcardRevisionRepository
+	annotationRepo    repository.FlashcardAnnotationRepository
 	collectionService CollectionService
+	mediaService      MediaService
 }
 
-func (s *flashcardServiceImpl) GetCollectionFlashcards(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.Flashcard, string, error) {
+func (s *flashcardServiceImpl) GetCollectionFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, filter repository.FlashcardFilter) ([]*ent.Flashcard, string, error) {
 	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
 	if err != nil {
 		return nil, "", err
 	}
 
-	flashcards, err := s.flashcardRepo.ListByCollection(ctx, collectionID)
+	filter.Tags, err = NormalizeTags(filter.Tags)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if filter.Status != "" && !validFlashcardStatus(filter.Status) {
+		return nil, "", errors.New("invalid flashcard status")
+	}
+	if role == "viewer" {
+		filter.Status = FlashcardStatusPublished
+	}
+
+	flashcards, err := s.flashcardRepo.ListByCollection(ctx, collectionID, filter)
 	if err != nil {
 		return nil, "", err
 	}
@@ -39,10 +77,16 @@ func (s *flashcardServiceImpl) GetFlashcard(ctx context.Context, flashcardID uui
 		return nil, "", err
 	}
 
+	if !visibleWithRole(flashcard, role) {
+		return nil, "", errors.New("flashcard not found")
+	}
+
 	return flashcard, role, nil
 }
 
-func (s *flashcardServiceImpl) CreateFlashcard(ctx context.Context, collectionID uuid.UUID, userID, question, answer, typeStr string) (*ent.Flashcard, error) {
+// CreateFlashcard creates a flashcard. Unless allowDuplicates is set, it returns a
+// *DuplicateFlashcardsError when the collection already has similar questions.
+func (s *flashcardServiceImpl) CreateFlashcard(ctx context.Context, collectionID uuid.UUID, userID string, fields repository.FlashcardFields, allowDuplicates bool) (*ent.Flashcard, error) {
 	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
 	if err != nil {
 		return nil, err
@@ -52,14 +96,36 @@ func (s *flashcardServiceImpl) CreateFlashcard(ctx context.Context, collectionID
 		return nil, errors.New("permission denied")
 	}
 
-	if typeStr == "" {
-		typeStr = "simple"
+	applyFlashcardDefaults(&fields)
+
+	if !validFlashcardStatus(fields.Status) {
+		return nil, errors.New("invalid flashcard status")
+	}
+
+	if err := prepareFlashcardContent(&fields); err != nil {
+		return nil, err
+	}
+
+	for _, mediaID := range fields.MediaIDs {
+		if err := s.mediaService.CanAttach(ctx, mediaID, collectionID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	if !allowDuplicates {
+		candidates, err := s.flashcardRepo.FindSimilar(ctx, collectionID, fields.QuestionKey, duplicateSimilarity, maxDuplicateCandidates)
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) > 0 {
+			return nil, &DuplicateFlashcardsError{Candidates: candidates}
+		}
 	}
 
-	return s.flashcardRepo.Create(ctx, question, answer, typeStr, collectionID, userID)
+	return s.flashcardRepo.Create(ctx, fields, collectionID, userID)
 }
 
-func (s *flashcardServiceImpl) UpdateFlashcard(ctx context.Context, flashcardID uuid.UUID, userID, question, answer, typeStr string) (*ent.Flashcard, error) {
+func (s *flashcardServiceImpl) UpdateFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string, fields repository.FlashcardFields) (*ent.Flashcard, error) {
 	flashcard, role, err := s.GetFlashcard(ctx, flashcardID, userID)
 	if err != nil {
 		return nil, err
@@ -69,20 +135,159 @@ func (s *flashcardServiceImpl) UpdateFlashcard(ctx context.Context, flashcardID
 		return nil, errors.New("permission denied")
 	}
 
-	newQuestion := flashcard.Question
-	if question != "" {
-		newQuestion = question
+	mergeFlashcardFields(flashcard, &fields)
+
+	if err := checkOcclusionMaskIDs(flashcard, &fields); err != nil {
+		return nil, err
 	}
-	newAnswer := flashcard.Answer
-	if answer != "" {
-		newAnswer = answer
+
+	if err := prepareFlashcardContent(&fields); err != nil {
+		return nil, err
 	}
-	newType := flashcard.Type
-	if typeStr != "" {
-		newType = typeStr
+
+	for _, mediaID := range fields.MediaIDs {
+		if err := s.mediaService.CanAttach(ctx, mediaID, flashcard.CollectionID, userID); err != nil {
+			return nil, err
+		}
 	}
 
-	return s.flashcardRepo.Update(ctx, flashcardID, newQuestion, newAnswer, newType)
+	return s.flashcardRepo.Update(ctx, flashcardID, fields, userID)
+}
+
+func applyFlashcardDefaults(fields *repository.FlashcardFields) {
+	if fields.Type == "" {
+		fields.Type = FlashcardTypeSimple
+	}
+	if fields.ContentFormat == "" {
+		fields.ContentFormat = content.FormatPlain
+	}
+	if fields.Status == "" {
+		fields.Status = FlashcardStatusPublished
+	}
+}
+
+// mergeFlashcardFields keeps the current value of every field left empty in an update
+func mergeFlashcardFields(current *ent.Flashcard, fields *repository.FlashcardFields) {
+	if fields.Question == "" {
+		fields.Question = current.Question
+	}
+	if fields.Answer == "" {
+		fields.Answer = current.Answer
+	}
+	if fields.Type == "" {
+		fields.Type = current.Type
+	}
+	if fields.ContentFormat == "" {
+		fields.ContentFormat = current.ContentFormat
+	}
+	if fields.Options == nil {
+		fields.Options = current.Options
+	}
+	if fields.Pairs == nil {
+		fields.Pairs = current.Pairs
+	}
+	if fields.Occlusion == nil {
+		fields.Occlusion = current.Occlusion
+	}
+	if fields.Hint == nil {
+		fields.Hint = &current.Hint
+	}
+	if fields.Explanation == nil {
+		fields.Explanation = &current.Explanation
+	}
+	if fields.Sources == nil {
+		fields.Sources = current.Sources
+	}
+}
+
+// prepareFlashcardContent sanitizes and validates the content of a flashcard and
+// pre-renders its HTML
+func prepareFlashcardContent(fields *repository.FlashcardFields) error {
+	if !content.ValidFormat(fields.ContentFormat) {
+		return content.ErrInvalidFormat
+	}
+
+	var err error
+	if fields.Question, err = content.Sanitize(fields.ContentFormat, fields.Question); err != nil {
+		return err
+	}
+	if fields.Answer, err = content.Sanitize(fields.ContentFormat, fields.Answer); err != nil {
+		return err
+	}
+
+	if err := ValidateFlashcardContent(fields); err != nil {
+		return err
+	}
+
+	if fields.QuestionHTML, err = content.Render(fields.ContentFormat, fields.Question); err != nil {
+		return err
+	}
+	if fields.AnswerHTML, err = content.Render(fields.ContentFormat, fields.Answer); err != nil {
+		return err
+	}
+
+	fields.QuestionKey = QuestionKey(fields.ContentFormat, fields.Question)
+	questionText, answerText := searchText(fields.ContentFormat, fields.Question, fields.Answer)
+	fields.QuestionText, fields.AnswerText = &questionText, &answerText
+
+	if fields.HintHTML, err = prepareOptionalText(fields.ContentFormat, fields.Hint, maxHintLength, "hint"); err != nil {
+		return err
+	}
+	if fields.ExplanationHTML, err = prepareOptionalText(fields.ContentFormat, fields.Explanation, maxExplanationLength, "explanation"); err != nil {
+		return err
+	}
+
+	return validateSources(fields.Sources)
+}
+
+// prepareOptionalText sanitizes an optional text field such as the hint in place
+// and returns its HTML rendering, which is empty when the text is
+func prepareOptionalText(format string, text *string, maxLength int, name string) (string, error) {
+	if text == nil {
+		return "", nil
+	}
+
+	sanitized, err := content.Sanitize(format, strings.TrimSpace(*text))
+	if err != nil {
+		return "", err
+	}
+	if len(sanitized) > maxLength {
+		return "", fmt.Errorf("%s is too long", name)
+	}
+	*text = sanitized
+
+	if sanitized == "" {
+		return "", nil
+	}
+	return content.Render(format, sanitized)
+}
+
+func validateSources(sources []schema.SourceReference) error {
+	if len(sources) > maxSources {
+		return fmt.Errorf("a flashcard can cite at most %d sources", maxSources)
+	}
+
+	for i := range sources {
+		source := &sources[i]
+		source.Citation = strings.TrimSpace(source.Citation)
+		source.Page = strings.TrimSpace(source.Page)
+		source.URL = strings.TrimSpace(source.URL)
+
+		if source.Citation == "" {
+			return errors.New("every source needs a citation")
+		}
+		if len(source.Citation) > maxCitationLength || len(source.Page) > maxCitationLength {
+			return errors.New("source citation is too long")
+		}
+		if source.URL != "" {
+			u, err := url.Parse(source.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return errors.New("source url must be an http or https link")
+			}
+		}
+	}
+
+	return nil
 }
 
 func (s *flashcardServiceImpl) DeleteFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) error {
@@ -95,5 +300,508 @@ func (s *flashcardServiceImpl) DeleteFlashcard(ctx context.Context, flashcardID
 		return errors.New("permission denied")
 	}
 
-	return s.flashcardRepo.Delete(ctx, flashcardID)
+	// Moved to the trash; TrashService purges it after the retention window
+	return s.flashcardRepo.SoftDelete(ctx, flashcardID, userID)
+}
+
+// MoveFlashcard reorders a flashcard within its collection or moves it to another
+// collection, such as a parent or sub-collection. afterID and beforeID are its new
+// neighbors; either may be nil when moving to the start or end, and both may be nil
+// when moving to the end of another collection.
+func (s *flashcardServiceImpl) MoveFlashcard(ctx context.Context, collectionID, flashcardID uuid.UUID, userID string, targetCollectionID, afterID, beforeID *uuid.UUID) (*ent.Flashcard, error) {
+	flashcard, err := s.flashcardRepo.GetByID(ctx, flashcardID)
+	if err != nil || flashcard.CollectionID != collectionID {
+		return nil, errors.New("flashcard not found")
+	}
+
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return nil, err
+	}
+
+	target := collectionID
+	if targetCollectionID != nil && *targetCollectionID != collectionID {
+		target = *targetCollectionID
+		if err := s.checkCanEdit(ctx, target, userID); err != nil {
+			return nil, err
+		}
+	} else if afterID == nil && beforeID == nil {
+		return nil, errors.New("after_id or before_id is required")
+	}
+
+	moved, err := s.flashcardRepo.Move(ctx, target, flashcardID, afterID, beforeID)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("flashcard not found")
+	}
+
+	return moved, err
+}
+
+func (s *flashcardServiceImpl) GetOcclusionItems(ctx context.Context, flashcardID uuid.UUID, userID string) ([]*OcclusionRender, error) {
+	flashcard, _, err := s.GetFlashcard(ctx, flashcardID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if flashcard.Type != FlashcardTypeImageOcclusion {
+		return nil, errors.New("not an image occlusion card")
+	}
+
+	return RenderOcclusionItems(flashcard), nil
+}
+
+// ListTags returns the tags of a collection with their flashcard counts
+func (s *flashcardServiceImpl) ListTags(ctx context.Context, collectionID uuid.UUID, userID string) ([]repository.TagCount, error) {
+	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Viewers cannot see drafts, so they are not counted for them
+	return s.tagRepo.ListWithCounts(ctx, collectionID, role == "viewer")
+}
+
+// AddTags adds the tags to the flashcards of a collection
+func (s *flashcardServiceImpl) AddTags(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, tags []string) (int, error) {
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return 0, err
+	}
+
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.tagRepo.AddTags(ctx, collectionID, flashcardIDs, normalized)
+}
+
+// RemoveTags removes the tags from the flashcards of a collection
+func (s *flashcardServiceImpl) RemoveTags(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, tags []string) (int, error) {
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return 0, err
+	}
+
+	normalized, err := NormalizeTags(tags)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.tagRepo.RemoveTags(ctx, collectionID, flashcardIDs, normalized)
+}
+
+// RenameTag renames a tag and its descendants across a collection. Renaming onto an
+// existing tag merges the two.
+func (s *flashcardServiceImpl) RenameTag(ctx context.Context, collectionID uuid.UUID, userID string, from, to string) (int, error) {
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return 0, err
+	}
+
+	from, err := NormalizeTag(from)
+	if err != nil {
+		return 0, err
+	}
+	to, err = NormalizeTag(to)
+	if err != nil {
+		return 0, err
+	}
+
+	if from == to {
+		return 0, nil
+	}
+	if strings.HasPrefix(to, from+repository.TagSeparator) {
+		return 0, errors.New("cannot move a tag under itself")
+	}
+
+	return s.tagRepo.Rename(ctx, collectionID, from, to)
+}
+
+func (s *flashcardServiceImpl) checkCanEdit(ctx context.Context, collectionID uuid.UUID, userID string) error {
+	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role == "viewer" {
+		return errors.New("permission denied")
+	}
+
+	return nil
+}
+
+// SearchFlashcards runs a full-text search over every flashcard the user can access
+func (s *flashcardServiceImpl) SearchFlashcards(ctx context.Context, search repository.FlashcardSearch) ([]FlashcardSearchResult, int, error) {
+	search.Query = strings.TrimSpace(search.Query)
+	if search.Query == "" {
+		return nil, 0, errors.New("search query is required")
+	}
+	if len(search.Query) > maxSearchQueryLength {
+		return nil, 0, errors.New("search query is too long")
+	}
+
+	if search.Type != "" && !validFlashcardType(search.Type) {
+		return nil, 0, errors.New("invalid flashcard type")
+	}
+
+	var err error
+	search.Tags, err = NormalizeTags(search.Tags)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	search.Annotations, err = normalizeAnnotationFilter(search.Annotations)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchLimit
+	}
+	search.Limit = min(search.Limit, maxSearchLimit)
+	search.Offset = max(search.Offset, 0)
+
+	hits, total, err := s.flashcardRepo.Search(ctx, search)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	results := make([]FlashcardSearchResult, len(hits))
+	for i, hit := range hits {
+		results[i] = FlashcardSearchResult{
+			Flashcard:       hit.Flashcard,
+			Rank:            hit.Rank,
+			QuestionSnippet: content.Highlight(hit.QuestionSnippet, repository.SnippetMatchStart, repository.SnippetMatchEnd),
+			AnswerSnippet:   content.Highlight(hit.AnswerSnippet, repository.SnippetMatchStart, repository.SnippetMatchEnd),
+		}
+	}
+
+	return results, total, nil
+}
+
+// ListRevisions returns the edit history of a flashcard, newest first
+func (s *flashcardServiceImpl) ListRevisions(ctx context.Context, flashcardID uuid.UUID, userID string) ([]*ent.FlashcardRevision, error) {
+	if _, _, err := s.GetFlashcard(ctx, flashcardID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.revisionRepo.ListByFlashcard(ctx, flashcardID)
+}
+
+// DiffRevisions compares two revisions of a flashcard
+func (s *flashcardServiceImpl) DiffRevisions(ctx context.Context, flashcardID uuid.UUID, userID string, from, to int) (*RevisionDiff, error) {
+	if _, _, err := s.GetFlashcard(ctx, flashcardID, userID); err != nil {
+		return nil, err
+	}
+
+	fromRevision, err := s.revisionRepo.GetByNumber(ctx, flashcardID, from)
+	if err != nil {
+		return nil, errors.New("revision not found")
+	}
+	toRevision, err := s.revisionRepo.GetByNumber(ctx, flashcardID, to)
+	if err != nil {
+		return nil, errors.New("revision not found")
+	}
+
+	return DiffRevisions(fromRevision, toRevision), nil
+}
+
+// RestoreRevision brings back the content of an old revision as a new edit, so the
+// history is kept and the restore itself can be undone
+func (s *flashcardServiceImpl) RestoreRevision(ctx context.Context, flashcardID uuid.UUID, userID string, number int) (*ent.Flashcard, error) {
+	if _, _, err := s.GetFlashcard(ctx, flashcardID, userID); err != nil {
+		return nil, err
+	}
+
+	revision, err := s.revisionRepo.GetByNumber(ctx, flashcardID, number)
+	if err != nil {
+		return nil, errors.New("revision not found")
+	}
+
+	// Empty values are set explicitly so that UpdateFlashcard does not keep the
+	// current ones
+	fields := repository.FlashcardFields{
+		Question:      revision.Question,
+		Answer:        revision.Answer,
+		Type:          revision.Type,
+		ContentFormat: revision.ContentFormat,
+		Options:       revision.Options,
+		Pairs:         revision.Pairs,
+		Occlusion:     revision.Occlusion,
+		MediaIDs:      revision.MediaIds,
+		Hint:          &revision.Hint,
+		Explanation:   &revision.Explanation,
+		Sources:       revision.Sources,
+	}
+	if fields.Options == nil {
+		fields.Options = []string{}
+	}
+	if fields.Pairs == nil {
+		fields.Pairs = []schema.MatchPair{}
+	}
+	if fields.MediaIDs == nil {
+		fields.MediaIDs = []uuid.UUID{}
+	}
+	if fields.Sources == nil {
+		fields.Sources = []schema.SourceReference{}
+	}
+
+	return s.UpdateFlashcard(ctx, flashcardID, userID, fields)
+}
+
+// FindDuplicates scans a collection for groups of flashcards with identical or
+// near-identical questions
+func (s *flashcardServiceImpl) FindDuplicates(ctx context.Context, collectionID uuid.UUID, userID string) ([]DuplicateGroup, error) {
+	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Viewers cannot see drafts, so they are left out of the groups for them
+	pairs, err := s.flashcardRepo.ListSimilarPairs(ctx, collectionID, duplicateSimilarity, maxDuplicatePairs, role == "viewer")
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := groupDuplicates(pairs)
+
+	ids := make([]uuid.UUID, 0, len(pairs)*2)
+	groupOf := make(map[uuid.UUID]int)
+	for i, group := range groupIDs {
+		for _, id := range group {
+			groupOf[id] = i
+			ids = append(ids, id)
+		}
+	}
+
+	flashcards, err := s.flashcardRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewCounts, err := s.flashcardRepo.ReviewCounts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([][]*ent.Flashcard, len(groupIDs))
+	for _, fc := range flashcards {
+		i := groupOf[fc.ID]
+		members[i] = append(members[i], fc)
+	}
+
+	similarity := make([]float64, len(groupIDs))
+	for i := range similarity {
+		similarity[i] = 1
+	}
+	for _, pair := range pairs {
+		i := groupOf[pair.FlashcardID]
+		similarity[i] = min(similarity[i], pair.Similarity)
+	}
+
+	groups := make([]DuplicateGroup, 0, len(groupIDs))
+	for i, group := range members {
+		if len(group) < 2 {
+			continue
+		}
+		groups = append(groups, buildDuplicateGroup(group, reviewCounts, similarity[i]))
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Similarity != groups[j].Similarity {
+			return groups[i].Similarity > groups[j].Similarity
+		}
+		return groups[i].KeepID.String() < groups[j].KeepID.String()
+	})
+
+	return groups, nil
+}
+
+// MergeDuplicates keeps one flashcard of a duplicate group, carrying over the review
+// progress and tags of the others and moving them to the trash
+func (s *flashcardServiceImpl) MergeDuplicates(ctx context.Context, collectionID uuid.UUID, userID string, keepID uuid.UUID, duplicateIDs []uuid.UUID) (*ent.Flashcard, error) {
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return nil, err
+	}
+
+	seen := map[uuid.UUID]bool{keepID: true}
+	ids := make([]uuid.UUID, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, errors.New("no duplicates to merge")
+	}
+
+	flashcards, err := s.flashcardRepo.ListByIDs(ctx, append([]uuid.UUID{keepID}, ids...))
+	if err != nil {
+		return nil, err
+	}
+	if len(flashcards) != len(ids)+1 {
+		return nil, errors.New("flashcard not found")
+	}
+	for _, fc := range flashcards {
+		if fc.CollectionID != collectionID {
+			return nil, errors.New("flashcards must belong to the collection")
+		}
+	}
+
+	if err := s.flashcardRepo.MergeDuplicates(ctx, keepID, ids, userID); err != nil {
+		return nil, err
+	}
+
+	return s.flashcardRepo.GetByID(ctx, keepID)
+}
+
+// BackfillQuestionKeys computes the duplicate detection key of flashcards created
+// before it existed. Flashcards are paged by ID, since questions without any text,
+// such as image-only ones, keep an empty key and would be listed again.
+func (s *flashcardServiceImpl) BackfillQuestionKeys(ctx context.Context) {
+	after := uuid.Nil
+	for {
+		flashcards, err := s.flashcardRepo.ListMissingQuestionKey(ctx, after, questionKeyBackfillSize)
+		if err != nil {
+			log.Println("Question key backfill failed:", err)
+			return
+		}
+
+		for _, fc := range flashcards {
+			after = fc.ID
+			key := QuestionKey(fc.ContentFormat, fc.Question)
+			if key == "" {
+				continue
+			}
+			if err := s.flashcardRepo.SetQuestionKey(ctx, fc.ID, key); err != nil {
+				log.Println("Question key backfill failed:", err)
+				return
+			}
+		}
+
+		if len(flashcards) < questionKeyBackfillSize {
+			return
+		}
+	}
+}
+
+// searchText returns the plain text of the question and answer that full-text
+// search indexes, without markup
+func searchText(format, question, answer string) (string, string) {
+	return content.PlainText(format, question), content.PlainText(format, answer)
+}
+
+// Flashcards whose search text is computed per batch of the backfill
+const searchTextBackfillSize = 500
+
+// BackfillSearchText computes the plain text indexed for full-text search of
+// flashcards created before it was stored. Once every flashcard has it, this only
+// finds nothing to do.
+func (s *flashcardServiceImpl) BackfillSearchText(ctx context.Context) {
+	after := uuid.Nil
+	for {
+		flashcards, err := s.flashcardRepo.ListMissingSearchText(ctx, after, searchTextBackfillSize)
+		if err != nil {
+			log.Println("Search text backfill failed:", err)
+			return
+		}
+
+		for _, fc := range flashcards {
+			after = fc.ID
+			questionText, answerText := searchText(fc.ContentFormat, fc.Question, fc.Answer)
+			if err := s.flashcardRepo.SetSearchText(ctx, fc.ID, questionText, answerText); err != nil {
+				log.Println("Search text backfill failed:", err)
+				return
+			}
+		}
+
+		if len(flashcards) < searchTextBackfillSize {
+			return
+		}
+	}
+}
+
+// MoveFlashcards moves flashcards to another collection the user can edit. The
+// flashcards keep their IDs, so every learner's review history follows them.
+func (s *flashcardServiceImpl) MoveFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, targetCollectionID uuid.UUID) ([]*ent.Flashcard, error) {
+	if targetCollectionID == collectionID {
+		return nil, errors.New("flashcards are already in this collection")
+	}
+
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return nil, err
+	}
+	if err := s.checkCanEdit(ctx, targetCollectionID, userID); err != nil {
+		return nil, err
+	}
+
+	ids, err := s.selectedFlashcards(ctx, collectionID, flashcardIDs, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.flashcardRepo.MoveToCollection(ctx, ids, targetCollectionID)
+}
+
+// CopyFlashcards copies flashcards the user can see to a collection the user can
+// edit. With copyProgress, the user's own review progress is duplicated onto the
+// copies; other learners' progress is never copied.
+func (s *flashcardServiceImpl) CopyFlashcards(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, targetCollectionID uuid.UUID, copyProgress bool) ([]*ent.Flashcard, error) {
+	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkCanEdit(ctx, targetCollectionID, userID); err != nil {
+		return nil, err
+	}
+
+	// Viewers cannot see, and so cannot copy, drafts
+	ids, err := s.selectedFlashcards(ctx, collectionID, flashcardIDs, role == "viewer")
+	if err != nil {
+		return nil, err
+	}
+
+	progressOf := ""
+	if copyProgress {
+		progressOf = userID
+	}
+
+	return s.flashcardRepo.CopyToCollection(ctx, ids, targetCollectionID, userID, progressOf)
+}
+
+// selectedFlashcards dedupes the flashcards selected for a bulk action and checks
+// that they all belong to the collection and, with publishedOnly, are published
+func (s *flashcardServiceImpl) selectedFlashcards(ctx context.Context, collectionID uuid.UUID, flashcardIDs []uuid.UUID, publishedOnly bool) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]bool, len(flashcardIDs))
+	ids := make([]uuid.UUID, 0, len(flashcardIDs))
+	for _, id := range flashcardIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, errors.New("flashcard_ids are required")
+	}
+	if len(ids) > MaxBatchOperations {
+		return nil, fmt.Errorf("at most %d flashcards can be selected at once", MaxBatchOperations)
+	}
+
+	flashcards, err := s.flashcardRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(flashcards) != len(ids) {
+		return nil, errors.New("flashcard not found")
+	}
+	for _, fc := range flashcards {
+		if fc.CollectionID != collectionID {
+			return nil, errors.New("flashcard not found")
+		}
+		if publishedOnly && fc.Status.String() != FlashcardStatusPublished {
+			return nil, errors.New("flashcard not found")
+		}
+	}
+
+	return ids, nil
 }
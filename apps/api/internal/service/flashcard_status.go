@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// Flashcard statuses. Drafts are only visible to owners, admins and editors, and
+// learners never review them.
+const (
+	FlashcardStatusDraft     = "draft"
+	FlashcardStatusPublished = "published"
+)
+
+func validFlashcardStatus(status string) bool {
+	return status == FlashcardStatusDraft || status == FlashcardStatusPublished
+}
+
+// visibleWithRole reports whether a user with the role in the flashcard's
+// collection may see the flashcard
+func visibleWithRole(fc *ent.Flashcard, role string) bool {
+	return fc.Status.String() == FlashcardStatusPublished || role != "viewer"
+}
+
+// SetFlashcardStatus publishes or unpublishes flashcards of a collection, or all of
+// its flashcards, and returns the number of flashcards changed
+func (s *flashcardServiceImpl) SetFlashcardStatus(ctx context.Context, collectionID uuid.UUID, userID string, flashcardIDs []uuid.UUID, all bool, status string) (int, error) {
+	if !validFlashcardStatus(status) {
+		return 0, errors.New("invalid flashcard status")
+	}
+
+	if err := s.checkCanEdit(ctx, collectionID, userID); err != nil {
+		return 0, err
+	}
+
+	if all {
+		return s.flashcardRepo.SetStatus(ctx, collectionID, nil, status)
+	}
+
+	ids, err := s.selectedFlashcards(ctx, collectionID, flashcardIDs, false)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.flashcardRepo.SetStatus(ctx, collectionID, ids, status)
+}
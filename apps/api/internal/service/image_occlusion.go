@@ -0,0 +1,172 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// Image occlusion modes
+const (
+	OcclusionModeHideAllGuessOne = "hide_all_guess_one" // every mask is drawn, one is asked
+	OcclusionModeHideOneGuessOne = "hide_one_guess_one" // only the asked mask is drawn
+)
+
+// Mask shapes
+const (
+	MaskShapeRect    = "rect"
+	MaskShapePolygon = "polygon"
+)
+
+const maxOcclusionMasks = 100
+
+// OcclusionRender is everything a client needs to draw one review item of an
+// image occlusion card
+type OcclusionRender struct {
+	Item     int                    `json:"item"`
+	ImageURL string                 `json:"image_url"`
+	Width    int                    `json:"width,omitempty"`
+	Height   int                    `json:"height,omitempty"`
+	Mode     string                 `json:"mode"`
+	Target   schema.OcclusionMask   `json:"target"`
+	Hidden   []schema.OcclusionMask `json:"hidden"`
+	Answer   string                 `json:"answer"`
+}
+
+// checkOcclusionMaskIDs requires the masks of an image occlusion card being updated
+// to carry their IDs, which is what keeps the reviews of a mask with it. Masks
+// added in the update come with IDs of their own.
+func checkOcclusionMaskIDs(current *ent.Flashcard, fields *repository.FlashcardFields) error {
+	if current.Occlusion == nil || fields.Occlusion == nil {
+		return nil
+	}
+	for _, mask := range fields.Occlusion.Masks {
+		if mask.ID == "" {
+			return errors.New("masks of an existing card need their IDs")
+		}
+	}
+	return nil
+}
+
+func validateOcclusion(occlusion *schema.ImageOcclusion) error {
+	if occlusion == nil || strings.TrimSpace(occlusion.ImageURL) == "" {
+		return errors.New("image occlusion cards need an image")
+	}
+
+	switch occlusion.Mode {
+	case "":
+		occlusion.Mode = OcclusionModeHideAllGuessOne
+	case OcclusionModeHideAllGuessOne, OcclusionModeHideOneGuessOne:
+	default:
+		return errors.New("invalid occlusion mode")
+	}
+
+	if len(occlusion.Masks) == 0 || len(occlusion.Masks) > maxOcclusionMasks {
+		return errors.New("image occlusion cards need between 1 and 100 masks")
+	}
+
+	ids := make(map[string]bool, len(occlusion.Masks))
+	for i := range occlusion.Masks {
+		mask := &occlusion.Masks[i]
+
+		if mask.ID == "" {
+			mask.ID = uuid.NewString()
+		}
+		if ids[mask.ID] {
+			return errors.New("mask IDs must be distinct")
+		}
+		ids[mask.ID] = true
+
+		if strings.TrimSpace(mask.Label) == "" {
+			return errors.New("every mask needs a label")
+		}
+
+		switch mask.Shape {
+		case MaskShapeRect:
+			if mask.Width <= 0 || mask.Height <= 0 ||
+				!inUnitRange(mask.X) || !inUnitRange(mask.Y) ||
+				!inUnitRange(mask.X+mask.Width) || !inUnitRange(mask.Y+mask.Height) {
+				return errors.New("rectangle masks must lie within the image")
+			}
+		case MaskShapePolygon:
+			if len(mask.Points) < 3 {
+				return errors.New("polygon masks need at least 3 points")
+			}
+			for _, point := range mask.Points {
+				if !inUnitRange(point[0]) || !inUnitRange(point[1]) {
+					return errors.New("polygon masks must lie within the image")
+				}
+			}
+		default:
+			return errors.New("mask shape must be rect or polygon")
+		}
+	}
+
+	return nil
+}
+
+func inUnitRange(v float64) bool {
+	return v >= 0 && v <= 1
+}
+
+func occlusionAnswer(occlusion *schema.ImageOcclusion) string {
+	labels := make([]string, len(occlusion.Masks))
+	for i, mask := range occlusion.Masks {
+		labels[i] = mask.Label
+	}
+	return strings.Join(labels, "\n")
+}
+
+func occlusionMask(occlusion *schema.ImageOcclusion, item int) (schema.OcclusionMask, bool) {
+	if occlusion == nil || item < 0 || item >= len(occlusion.Masks) {
+		return schema.OcclusionMask{}, false
+	}
+	return occlusion.Masks[item], true
+}
+
+// RenderOcclusionItem builds the render data for one review item of an image
+// occlusion card
+func RenderOcclusionItem(fc *ent.Flashcard, item int) (*OcclusionRender, bool) {
+	if fc.Type != FlashcardTypeImageOcclusion {
+		return nil, false
+	}
+
+	target, ok := occlusionMask(fc.Occlusion, item)
+	if !ok {
+		return nil, false
+	}
+
+	render := &OcclusionRender{
+		Item:     item,
+		ImageURL: fc.Occlusion.ImageURL,
+		Width:    fc.Occlusion.Width,
+		Height:   fc.Occlusion.Height,
+		Mode:     fc.Occlusion.Mode,
+		Target:   target,
+		Answer:   target.Label,
+	}
+
+	if fc.Occlusion.Mode == OcclusionModeHideOneGuessOne {
+		render.Hidden = []schema.OcclusionMask{target}
+	} else {
+		render.Hidden = fc.Occlusion.Masks
+	}
+
+	return render, true
+}
+
+// RenderOcclusionItems builds the render data for every review item of an image
+// occlusion card
+func RenderOcclusionItems(fc *ent.Flashcard) []*OcclusionRender {
+	var renders []*OcclusionRender
+	for item := 0; item < fc.Occlusion.ReviewItemCount(); item++ {
+		if render, ok := RenderOcclusionItem(fc, item); ok {
+			renders = append(renders, render)
+		}
+	}
+	return renders
+}
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+)
+
+// Flags of questions that may be badly written
+const (
+	ItemFlagTooHard                = "too_hard"
+	ItemFlagTooEasy                = "too_easy"
+	ItemFlagPoorDiscrimination     = "poor_discrimination"
+	ItemFlagNegativeDiscrimination = "negative_discrimination" // Often a wrong answer key
+	ItemFlagUnusedDistractor       = "unused_distractor"
+)
+
+// Thresholds of the item flags. Questions are only flagged once enough attempts
+// have been submitted to judge them.
+const (
+	minFlaggedAttempts      = 5
+	tooHardDifficulty       = 0.2
+	tooEasyDifficulty       = 0.9
+	poorDiscriminationIndex = 0.2
+	discriminationMinSpread = 1e-9
+)
+
+// OptionFrequency is how often an option of a question was chosen
+type OptionFrequency struct {
+	Option    string  `json:"option"`
+	Count     int     `json:"count"`
+	Frequency float64 `json:"frequency"` // Share of the answered attempts
+	Correct   bool    `json:"correct"`
+}
+
+// ItemAnalysis holds the classical item statistics of a flashcard asked in a quiz,
+// or across all quizzes when QuizID is nil
+type ItemAnalysis struct {
+	FlashcardID   uuid.UUID  `json:"flashcard_id"`
+	QuizID        *uuid.UUID `json:"quiz_id,omitempty"`
+	Question      string     `json:"question"`
+	Type          string     `json:"type"`
+	AttemptCount  int        `json:"attempt_count"`
+	AnsweredCount int        `json:"answered_count"`
+
+	// Difficulty is the p-value: the mean score, from 0 when nobody got the
+	// question right to 1 when everybody did
+	Difficulty float64 `json:"difficulty"`
+
+	// Discrimination is the correlation between the score of the question and the
+	// score of the rest of the attempt, from -1 to 1. It is nil until the scores vary.
+	Discrimination *float64 `json:"discrimination"`
+
+	AverageTimeMs float64           `json:"average_time_ms"` // Of the answered attempts
+	Options       []OptionFrequency `json:"options,omitempty"`
+	Flags         []string          `json:"flags,omitempty"`
+}
+
+// analyzeItem derives the item statistics from the running sums of a flashcard
+func analyzeItem(stat *ent.ItemStatistic, fc *ent.Flashcard) ItemAnalysis {
+	analysis := ItemAnalysis{
+		FlashcardID:   stat.FlashcardID,
+		QuizID:        stat.QuizID,
+		Question:      fc.Question,
+		Type:          fc.Type,
+		AttemptCount:  stat.AttemptCount,
+		AnsweredCount: stat.AnsweredCount,
+	}
+	if stat.AttemptCount == 0 {
+		return analysis
+	}
+
+	n := float64(stat.AttemptCount)
+	analysis.Difficulty = stat.ScoreSum / n
+
+	// Point-biserial correlation of the question score with the rest score
+	scoreSpread := n*stat.ScoreSquares - stat.ScoreSum*stat.ScoreSum
+	restSpread := n*stat.RestSquares - stat.RestSum*stat.RestSum
+	if scoreSpread > discriminationMinSpread && restSpread > discriminationMinSpread {
+		r := (n*stat.ScoreRestProducts - stat.ScoreSum*stat.RestSum) / math.Sqrt(scoreSpread*restSpread)
+		analysis.Discrimination = &r
+	}
+
+	if stat.AnsweredCount > 0 {
+		analysis.AverageTimeMs = float64(stat.TimeSpentMs) / float64(stat.AnsweredCount)
+		analysis.Options = optionFrequencies(stat, fc)
+	}
+
+	if stat.AttemptCount >= minFlaggedAttempts {
+		analysis.Flags = itemFlags(analysis)
+	}
+
+	return analysis
+}
+
+// optionFrequencies lists the options chosen for a question, most chosen first. The
+// options of multiple choice cards are listed even if nobody chose them.
+func optionFrequencies(stat *ent.ItemStatistic, fc *ent.Flashcard) []OptionFrequency {
+	counts := make(map[string]int, len(stat.OptionCounts))
+	for option, count := range stat.OptionCounts {
+		counts[option] = count
+	}
+
+	correct := choiceText(fc)
+	if fc.Type == FlashcardTypeMultipleChoice {
+		correct = fc.Answer
+		for _, option := range fc.Options {
+			if _, ok := counts[option]; !ok {
+				counts[option] = 0
+			}
+		}
+	}
+
+	frequencies := make([]OptionFrequency, 0, len(counts))
+	for option, count := range counts {
+		frequencies = append(frequencies, OptionFrequency{
+			Option:    option,
+			Count:     count,
+			Frequency: float64(count) / float64(stat.AnsweredCount),
+			Correct:   option == correct,
+		})
+	}
+
+	sort.Slice(frequencies, func(i, j int) bool {
+		if frequencies[i].Count != frequencies[j].Count {
+			return frequencies[i].Count > frequencies[j].Count
+		}
+		return frequencies[i].Option < frequencies[j].Option
+	})
+
+	return frequencies
+}
+
+func itemFlags(analysis ItemAnalysis) []string {
+	var flags []string
+
+	switch {
+	case analysis.Difficulty < tooHardDifficulty:
+		flags = append(flags, ItemFlagTooHard)
+	case analysis.Difficulty > tooEasyDifficulty:
+		flags = append(flags, ItemFlagTooEasy)
+	}
+
+	if analysis.Discrimination != nil {
+		switch {
+		case *analysis.Discrimination < 0:
+			flags = append(flags, ItemFlagNegativeDiscrimination)
+		case *analysis.Discrimination < poorDiscriminationIndex:
+			flags = append(flags, ItemFlagPoorDiscrimination)
+		}
+	}
+
+	for _, option := range analysis.Options {
+		if option.Count == 0 && !option.Correct {
+			flags = append(flags, ItemFlagUnusedDistractor)
+			break
+		}
+	}
+
+	return flags
+}
+
+// checkCanAnalyze checks that the user can see the item statistics of a
+// collection: they include the answers of every learner, so only editors can
+func (s *quizServiceImpl) checkCanAnalyze(ctx context.Context, collectionID uuid.UUID, userID string) error {
+	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return err
+	}
+
+	if role == "viewer" {
+		return errors.New("permission denied")
+	}
+
+	return nil
+}
+
+// GetQuizItemAnalysis returns the item statistics of the questions of a quiz in
+// question order, for the questions asked in submitted attempts
+func (s *quizServiceImpl) GetQuizItemAnalysis(ctx context.Context, quizID uuid.UUID, userID string) ([]ItemAnalysis, error) {
+	q, err := s.quizRepo.GetByID(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCanAnalyze(ctx, q.CollectionID, userID); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statRepo.ListByQuiz(ctx, quizID)
+	if err != nil {
+		return nil, err
+	}
+
+	byFlashcard := make(map[uuid.UUID]*ent.ItemStatistic, len(stats))
+	for _, stat := range stats {
+		byFlashcard[stat.FlashcardID] = stat
+	}
+
+	analyses := make([]ItemAnalysis, 0, len(stats))
+	for _, id := range q.FlashcardIds {
+		if stat, ok := byFlashcard[id]; ok {
+			analyses = append(analyses, analyzeItem(stat, stat.Edges.Flashcard))
+		}
+	}
+
+	return analyses, nil
+}
+
+// GetFlashcardItemAnalysis returns the item statistics of a flashcard across all
+// quizzes, followed by those of each quiz that asked it
+func (s *quizServiceImpl) GetFlashcardItemAnalysis(ctx context.Context, flashcardID uuid.UUID, userID string) ([]ItemAnalysis, error) {
+	fc, err := s.flashcardRepo.GetByID(ctx, flashcardID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCanAnalyze(ctx, fc.CollectionID, userID); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statRepo.ListByFlashcard(ctx, flashcardID)
+	if err != nil {
+		return nil, err
+	}
+
+	analyses := make([]ItemAnalysis, len(stats))
+	for i, stat := range stats {
+		analyses[i] = analyzeItem(stat, fc)
+	}
+
+	return analyses, nil
+}
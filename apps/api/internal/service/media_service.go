@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+	"github.com/quanphung1120/advanced-quiz-be/internal/storage"
+)
+
+// MediaService defines the interface for media attachment business logic
+type MediaService interface {
+	Upload(ctx context.Context, userID string, r io.Reader) (*ent.Media, error)
+	GetDownloadURL(ctx context.Context, mediaID uuid.UUID, userID string) (string, time.Time, error)
+	OpenSigned(ctx context.Context, mediaID uuid.UUID, expires int64, signature string) (*ent.Media, io.ReadCloser, error)
+	CanAttach(ctx context.Context, mediaID uuid.UUID, collectionID uuid.UUID, userID string) error
+	CollectGarbage(ctx context.Context) (int, error)
+	RunGarbageCollector(ctx context.Context, interval time.Duration)
+}
+
+// NewMediaService creates a new MediaService instance
+func NewMediaService(
+	mediaRepo repository.MediaRepository,
+	blobStore storage.BlobStore,
+	collectionService CollectionService,
+	urlSecret []byte,
+) MediaService {
+	return &mediaServiceImpl{
+		mediaRepo:         mediaRepo,
+		blobStore:         blobStore,
+		collectionService: collectionService,
+		urlSecret:         urlSecret,
+	}
+}
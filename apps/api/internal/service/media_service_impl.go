@@ -0,0 +1,281 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+	"github.com/quanphung1120/advanced-quiz-be/internal/storage"
+)
+
+// Allowed media content types and their maximum size in bytes
+var allowedMediaTypes = map[string]int64{
+	"image/png":  10 << 20,
+	"image/jpeg": 10 << 20,
+	"image/gif":  10 << 20,
+	"image/webp": 10 << 20,
+	"audio/mpeg": 20 << 20,
+	"audio/ogg":  20 << 20,
+	"audio/wave": 20 << 20,
+	"audio/mp4":  20 << 20,
+}
+
+// MaxMediaSize is the largest media file accepted, whatever its type
+const MaxMediaSize = 20 << 20
+
+const downloadURLExpiry = 15 * time.Minute
+
+// Unreferenced media younger than this is kept so that a fresh upload can still be
+// attached to the card being edited
+const mediaGarbageGracePeriod = 24 * time.Hour
+
+const mediaGarbageBatchSize = 100
+
+type mediaServiceImpl struct {
+	mediaRepo         repository.MediaRepository
+	blobStore         storage.BlobStore
+	collectionService CollectionService
+	urlSecret         []byte
+}
+
+// Upload validates and stores a media file, returning the existing media when the
+// same content was uploaded before. Every upload is recorded for the user, which
+// lets them attach the media and keeps it from the garbage collector for a while.
+func (s *mediaServiceImpl) Upload(ctx context.Context, userID string, r io.Reader) (*ent.Media, error) {
+	content, err := io.ReadAll(io.LimitReader(r, MaxMediaSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := detectMediaType(content)
+	maxSize, ok := allowedMediaTypes[contentType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported media type %s", contentType)
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("file exceeds the %d MB limit for %s", maxSize>>20, contentType)
+	}
+	if len(content) == 0 {
+		return nil, errors.New("file is empty")
+	}
+
+	sum := sha256.Sum256(content)
+	hash := hex.EncodeToString(sum[:])
+
+	existing, err := s.mediaRepo.GetByHash(ctx, hash)
+	if err == nil {
+		err = s.mediaRepo.RecordUpload(ctx, existing.ID, userID)
+		if err == nil {
+			return existing, nil
+		}
+		// The media was collected in the meantime; store it again
+		if !ent.IsConstraintError(err) {
+			return nil, err
+		}
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// Every stored copy gets its own key, so that collecting an earlier copy of the
+	// content cannot delete this one
+	key := "media/" + hash[:2] + "/" + hash + "/" + uuid.NewString()
+	if err := s.blobStore.Put(ctx, key, bytes.NewReader(content), int64(len(content)), contentType); err != nil {
+		return nil, err
+	}
+
+	created, err := s.mediaRepo.Create(ctx, hash, contentType, int64(len(content)), key, userID)
+	if ent.IsConstraintError(err) {
+		// Another upload of the same content won the race
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			return nil, err
+		}
+		existing, err := s.mediaRepo.GetByHash(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		return existing, s.mediaRepo.RecordUpload(ctx, existing.ID, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return created, s.mediaRepo.RecordUpload(ctx, created.ID, userID)
+}
+
+// detectMediaType sniffs the content type, since client-declared types cannot be trusted
+func detectMediaType(content []byte) string {
+	contentType := http.DetectContentType(content)
+	switch contentType {
+	case "application/ogg":
+		return "audio/ogg"
+	case "video/mp4":
+		return "audio/mp4"
+	}
+	return contentType
+}
+
+// GetDownloadURL returns a short-lived signed URL for media the user can access
+func (s *mediaServiceImpl) GetDownloadURL(ctx context.Context, mediaID uuid.UUID, userID string) (string, time.Time, error) {
+	m, err := s.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := s.checkAccess(ctx, m, userID); err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(downloadURLExpiry)
+	expires := expiresAt.Unix()
+	url := fmt.Sprintf("/media/%s?expires=%d&signature=%s", m.ID, expires, s.sign(m.ID, expires))
+
+	return url, expiresAt, nil
+}
+
+// checkAccess allows the uploaders and anyone who can access a collection
+// containing a flashcard that references the media
+func (s *mediaServiceImpl) checkAccess(ctx context.Context, m *ent.Media, userID string) error {
+	uploader, err := s.mediaRepo.IsUploader(ctx, m, userID)
+	if err != nil {
+		return err
+	}
+	if uploader {
+		return nil
+	}
+
+	collectionIDs, err := s.mediaRepo.ListCollectionIDs(ctx, m.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, collectionID := range collectionIDs {
+		if _, _, err := s.collectionService.GetCollection(ctx, collectionID, userID); err == nil {
+			return nil
+		}
+	}
+
+	return errors.New("access denied")
+}
+
+// CanAttach checks that the user may attach the media to a flashcard in the collection
+func (s *mediaServiceImpl) CanAttach(ctx context.Context, mediaID uuid.UUID, collectionID uuid.UUID, userID string) error {
+	m, err := s.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return errors.New("media not found")
+	}
+
+	uploader, err := s.mediaRepo.IsUploader(ctx, m, userID)
+	if err != nil {
+		return err
+	}
+	if uploader {
+		return nil
+	}
+
+	collectionIDs, err := s.mediaRepo.ListCollectionIDs(ctx, m.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range collectionIDs {
+		if id == collectionID {
+			return nil
+		}
+	}
+
+	return errors.New("media not found")
+}
+
+// OpenSigned verifies a signed download URL and opens the media content
+func (s *mediaServiceImpl) OpenSigned(ctx context.Context, mediaID uuid.UUID, expires int64, signature string) (*ent.Media, io.ReadCloser, error) {
+	if time.Now().Unix() > expires {
+		return nil, nil, errors.New("download link expired")
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(s.sign(mediaID, expires))) {
+		return nil, nil, errors.New("invalid signature")
+	}
+
+	m, err := s.mediaRepo.GetByID(ctx, mediaID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobStore.Get(ctx, m.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return m, content, nil
+}
+
+func (s *mediaServiceImpl) sign(mediaID uuid.UUID, expires int64) string {
+	mac := hmac.New(sha256.New, s.urlSecret)
+	mac.Write([]byte(mediaID.String() + ":" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CollectGarbage deletes media that no flashcard references anymore and that was
+// not uploaded recently. The blob is only deleted once the media row is, so media
+// attached in the meantime keeps its content.
+func (s *mediaServiceImpl) CollectGarbage(ctx context.Context) (int, error) {
+	deleted := 0
+	cutoff := time.Now().Add(-mediaGarbageGracePeriod)
+
+	for {
+		orphans, err := s.mediaRepo.ListUnreferenced(ctx, cutoff, mediaGarbageBatchSize)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, m := range orphans {
+			removed, err := s.mediaRepo.DeleteUnreferenced(ctx, m.ID, cutoff)
+			if err != nil {
+				return deleted, err
+			}
+			if !removed {
+				continue
+			}
+			if err := s.blobStore.Delete(ctx, m.StorageKey); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		if len(orphans) < mediaGarbageBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+// RunGarbageCollector collects unreferenced media every interval until ctx is done
+func (s *mediaServiceImpl) RunGarbageCollector(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.CollectGarbage(ctx)
+			if err != nil {
+				log.Println("Media garbage collection failed:", err)
+			} else if deleted > 0 {
+				log.Printf("Media garbage collection removed %d files\n", deleted)
+			}
+		}
+	}
+}
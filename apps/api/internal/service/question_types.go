@@ -0,0 +1,295 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+	"github.com/quanphung1120/advanced-quiz-be/internal/content"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// Flashcard types
+const (
+	FlashcardTypeSimple         = "simple"
+	FlashcardTypeMultipleChoice = "multiple_choice"
+	FlashcardTypeTrueFalse      = "true_false"
+	FlashcardTypeOrdering       = "ordering"
+	FlashcardTypeMatching       = "matching"
+	FlashcardTypeImageOcclusion = "image_occlusion"
+)
+
+// Limits on the structured content of a flashcard
+const (
+	minChoiceCount = 2
+	maxChoiceCount = 50
+)
+
+// Scores at or above this threshold are rated "Hard" rather than "Again"
+const partialCreditThreshold = 0.5
+
+func validFlashcardType(flashcardType string) bool {
+	switch flashcardType {
+	case FlashcardTypeSimple, FlashcardTypeMultipleChoice, FlashcardTypeTrueFalse,
+		FlashcardTypeOrdering, FlashcardTypeMatching, FlashcardTypeImageOcclusion:
+		return true
+	}
+	return false
+}
+
+// AnswerSubmission is a learner's answer to a flashcard of any type
+type AnswerSubmission struct {
+	Answer  string             // simple, multiple_choice and true_false cards
+	Order   []string           // ordering cards: items in the submitted order
+	Matches []schema.MatchPair // matching cards: submitted left/right pairs
+	Item    int                // image_occlusion cards: the mask being answered
+}
+
+// ValidateFlashcardContent checks that the content of the flashcard is valid for its
+// type and fills in the answer for types where it is derived from the content
+func ValidateFlashcardContent(fields *repository.FlashcardFields) error {
+	answer, err := validateContent(fields)
+	if err != nil {
+		return err
+	}
+	fields.Answer = answer
+	return nil
+}
+
+func validateContent(fields *repository.FlashcardFields) (string, error) {
+	answer := strings.TrimSpace(fields.Answer)
+	options := fields.Options
+	pairs := fields.Pairs
+
+	// Only image occlusion cards carry occlusion data, which determines how many
+	// review items the card produces
+	if fields.Type != FlashcardTypeImageOcclusion {
+		fields.Occlusion = nil
+	}
+
+	switch fields.Type {
+	case FlashcardTypeSimple:
+		if answer == "" {
+			return "", errors.New("answer is required")
+		}
+
+	case FlashcardTypeMultipleChoice:
+		if err := validateChoices(options); err != nil {
+			return "", err
+		}
+		found := false
+		for _, option := range options {
+			if sameChoice(fields.ContentFormat, option, answer) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", errors.New("answer must be one of the options")
+		}
+
+	case FlashcardTypeTrueFalse:
+		value, ok := parseTrueFalse(answer)
+		if !ok {
+			return "", errors.New("answer must be true or false")
+		}
+		answer = formatTrueFalse(value)
+
+	case FlashcardTypeOrdering:
+		if err := validateChoices(options); err != nil {
+			return "", err
+		}
+		answer = strings.Join(options, " → ")
+
+	case FlashcardTypeMatching:
+		if len(pairs) < minChoiceCount || len(pairs) > maxChoiceCount {
+			return "", errors.New("matching cards need between 2 and 50 pairs")
+		}
+		lefts := make(map[string]bool, len(pairs))
+		lines := make([]string, len(pairs))
+		for i, pair := range pairs {
+			if strings.TrimSpace(pair.Left) == "" || strings.TrimSpace(pair.Right) == "" {
+				return "", errors.New("pairs must not be empty")
+			}
+			if lefts[pair.Left] {
+				return "", errors.New("pairs must have distinct left items")
+			}
+			lefts[pair.Left] = true
+			lines[i] = pair.Left + " → " + pair.Right
+		}
+		answer = strings.Join(lines, "\n")
+
+	case FlashcardTypeImageOcclusion:
+		if err := validateOcclusion(fields.Occlusion); err != nil {
+			return "", err
+		}
+		answer = occlusionAnswer(fields.Occlusion)
+
+	default:
+		return "", errors.New("invalid flashcard type")
+	}
+
+	return answer, nil
+}
+
+// sameChoice reports whether two choices are the same once rendered. Options are
+// stored as written while the answer is sanitized, so HTML cards can hold "&" in
+// an option and "&amp;" in the answer.
+func sameChoice(format, a, b string) bool {
+	return content.PlainText(format, strings.TrimSpace(a)) == content.PlainText(format, strings.TrimSpace(b))
+}
+
+func validateChoices(options []string) error {
+	if len(options) < minChoiceCount || len(options) > maxChoiceCount {
+		return errors.New("options must contain between 2 and 50 items")
+	}
+	seen := make(map[string]bool, len(options))
+	for _, option := range options {
+		if strings.TrimSpace(option) == "" {
+			return errors.New("options must not be empty")
+		}
+		if seen[option] {
+			return errors.New("options must be distinct")
+		}
+		seen[option] = true
+	}
+	return nil
+}
+
+// GradeSubmission grades an answer to a flashcard according to its type. Ordering
+// and matching cards earn partial credit.
+func GradeSubmission(fc *ent.Flashcard, submission AnswerSubmission) *GradeResult {
+	var result *GradeResult
+
+	switch fc.Type {
+	case FlashcardTypeMultipleChoice:
+		exact := sameChoice(fc.ContentFormat, submission.Answer, fc.Answer)
+		result = &GradeResult{Exact: exact, Score: boolScore(exact)}
+
+	case FlashcardTypeTrueFalse:
+		expected, _ := parseTrueFalse(fc.Answer)
+		given, ok := parseTrueFalse(submission.Answer)
+		exact := ok && given == expected
+		result = &GradeResult{Exact: exact, Score: boolScore(exact)}
+
+	case FlashcardTypeOrdering:
+		score := gradeOrdering(fc.Options, submission.Order)
+		result = &GradeResult{Exact: score == 1, Score: score}
+
+	case FlashcardTypeMatching:
+		score := gradeMatching(fc.Pairs, submission.Matches)
+		result = &GradeResult{Exact: score == 1, Score: score}
+
+	case FlashcardTypeImageOcclusion:
+		mask, ok := occlusionMask(fc.Occlusion, submission.Item)
+		if !ok {
+			return &GradeResult{SuggestedRating: RatingAgain}
+		}
+		return GradeTypedAnswer(mask.Label, submission.Answer)
+
+	default:
+		return GradeTypedAnswer(content.PlainText(fc.ContentFormat, fc.Answer), submission.Answer)
+	}
+
+	result.Correct = result.Exact
+	result.Similarity = result.Score
+	result.MatchedAnswer = fc.Answer
+	result.SuggestedRating = ratingForScore(result.Score)
+
+	return result
+}
+
+// gradeOrdering returns how much better than chance the learner ordered the items:
+// the fraction of item pairs in the correct relative order (a normalized Kendall
+// tau distance) rescaled so that a random order, which gets half of the pairs
+// right, scores 0 and a reversed one too
+func gradeOrdering(expected, given []string) float64 {
+	if len(expected) < 2 || len(given) != len(expected) {
+		return 0
+	}
+
+	position := make(map[string]int, len(expected))
+	for i, item := range expected {
+		position[item] = i
+	}
+
+	ranks := make([]int, len(given))
+	seen := make(map[string]bool, len(given))
+	for i, item := range given {
+		rank, ok := position[item]
+		if !ok || seen[item] {
+			return 0
+		}
+		seen[item] = true
+		ranks[i] = rank
+	}
+
+	correct, total := 0, 0
+	for i := 0; i < len(ranks); i++ {
+		for j := i + 1; j < len(ranks); j++ {
+			total++
+			if ranks[i] < ranks[j] {
+				correct++
+			}
+		}
+	}
+
+	return max(0, 2*float64(correct)/float64(total)-1)
+}
+
+// gradeMatching returns the fraction of pairs matched correctly
+func gradeMatching(expected, given []schema.MatchPair) float64 {
+	if len(expected) == 0 {
+		return 0
+	}
+
+	submitted := make(map[string]string, len(given))
+	for _, pair := range given {
+		submitted[pair.Left] = pair.Right
+	}
+
+	correct := 0
+	for _, pair := range expected {
+		if right, ok := submitted[pair.Left]; ok && right == pair.Right {
+			correct++
+		}
+	}
+
+	return float64(correct) / float64(len(expected))
+}
+
+func ratingForScore(score float64) ReviewRating {
+	switch {
+	case score >= 1:
+		return RatingGood
+	case score >= partialCreditThreshold:
+		return RatingHard
+	default:
+		return RatingAgain
+	}
+}
+
+func boolScore(ok bool) float64 {
+	if ok {
+		return 1
+	}
+	return 0
+}
+
+func parseTrueFalse(s string) (bool, bool) {
+	switch NormalizeAnswer(s) {
+	case "true", "t", "yes", "y", "1":
+		return true, true
+	case "false", "f", "no", "n", "0":
+		return false, true
+	}
+	return false, false
+}
+
+func formatTrueFalse(value bool) string {
+	if value {
+		return "true"
+	}
+	return "false"
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+)
+
+// DefaultSlowAnswerSeconds is how long a correct quiz answer may take before it
+// counts as Hard when the quiz feeds spaced repetition
+const DefaultSlowAnswerSeconds = 30
+
+// quizFeedbackRating returns the rating a graded quiz answer counts as in spaced
+// repetition: Again when wrong and Hard when correct but slow. Quick correct
+// answers leave the schedule alone, as a quiz is no reason to postpone reviews.
+func quizFeedbackRating(answer *ent.QuizAnswer, slowAnswer time.Duration) (ReviewRating, bool) {
+	switch {
+	case answer.AnsweredAt == nil:
+		return 0, false
+	case !answer.Correct:
+		return RatingAgain, true
+	case int64(answer.TimeSpentMs) > slowAnswer.Milliseconds():
+		return RatingHard, true
+	}
+	return 0, false
+}
+
+// ApplyQuizAnswers updates the reviews of the user of a submitted attempt from its
+// answers and records every update so that it can be reverted. Flashcards that
+// were trashed or unpublished since are left out. An answer that cannot be applied,
+// for instance because its review changed concurrently, is skipped without
+// stopping the others. It returns the number of reviews updated and skipped, and
+// the errors of the skipped ones.
+func (s *flashcardReviewServiceImpl) ApplyQuizAnswers(ctx context.Context, attempt *ent.QuizAttempt, slowAnswer time.Duration) (int, int, error) {
+	applied, skipped := 0, 0
+	var errs []error
+	for _, answer := range attempt.Edges.Answers {
+		rating, ok := quizFeedbackRating(answer, slowAnswer)
+		fc := answer.Edges.Flashcard
+		if !ok || fc == nil || fc.DeletedAt != nil || fc.Status.String() != FlashcardStatusPublished {
+			continue
+		}
+
+		if err := s.applyQuizAnswer(ctx, attempt, fc.ID, rating); err != nil {
+			skipped++
+			errs = append(errs, err)
+			continue
+		}
+		applied++
+	}
+
+	return applied, skipped, errors.Join(errs...)
+}
+
+func (s *flashcardReviewServiceImpl) applyQuizAnswer(ctx context.Context, attempt *ent.QuizAttempt, flashcardID uuid.UUID, rating ReviewRating) error {
+	review, err := s.reviewRepo.GetOrCreate(ctx, attempt.UserID, flashcardID, 0)
+	if err != nil {
+		return err
+	}
+
+	update := s.calculateNextReview(review, rating)
+	_, err = s.quizUpdateRepo.Apply(ctx, attempt.ID, review, int(rating), update)
+	return err
+}
+
+// submit finishes an attempt in progress and feeds its answers to spaced
+// repetition
+func (s *quizServiceImpl) submit(ctx context.Context, attemptID uuid.UUID, submittedAt time.Time, automatic bool) error {
+	submitted, err := s.attemptRepo.Submit(ctx, attemptID, submittedAt, automatic)
+	if err != nil {
+		return err
+	}
+
+	s.feedReviews(ctx, submitted)
+	return nil
+}
+
+// feedReviews updates the reviews of the user from the answers of a submitted
+// attempt when its quiz feeds spaced repetition. Failures are logged, as the
+// attempt is submitted either way.
+func (s *quizServiceImpl) feedReviews(ctx context.Context, submitted *ent.QuizAttempt) {
+	q, err := s.quizRepo.GetByID(ctx, submitted.QuizID)
+	if err != nil {
+		log.Println("Loading the quiz of a submitted attempt failed:", err)
+		return
+	}
+	if !q.ReviewFeedback {
+		return
+	}
+
+	attempt, err := s.attemptRepo.GetByID(ctx, submitted.ID)
+	if err != nil {
+		log.Println("Loading a submitted attempt for spaced repetition failed:", err)
+		return
+	}
+
+	_, skipped, err := s.reviewService.ApplyQuizAnswers(ctx, attempt, time.Duration(q.SlowAnswerSeconds)*time.Second)
+	if skipped > 0 {
+		log.Printf("Skipped %d quiz answers of attempt %s when updating spaced repetition: %v\n", skipped, submitted.ID, err)
+	}
+}
+
+// ListReviewUpdates returns the spaced repetition updates made from one of the
+// user's attempts
+func (s *quizServiceImpl) ListReviewUpdates(ctx context.Context, attemptID uuid.UUID, userID string) ([]*ent.QuizReviewUpdate, error) {
+	if _, _, err := s.attemptFor(ctx, attemptID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.quizUpdateRepo.ListByAttempt(ctx, attemptID)
+}
+
+// RevertReviewUpdates restores the spaced repetition schedule of the flashcards
+// updated from one of the user's attempts. Flashcards reviewed again since keep
+// their schedule. It returns how many updates were reverted and how many kept.
+func (s *quizServiceImpl) RevertReviewUpdates(ctx context.Context, attemptID uuid.UUID, userID string) (int, int, error) {
+	attempt, _, err := s.attemptFor(ctx, attemptID, userID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if attempt.Status != quizattempt.StatusSubmitted {
+		return 0, 0, errors.New("attempt is not submitted yet")
+	}
+
+	return s.quizUpdateRepo.RevertByAttempt(ctx, attemptID, time.Now())
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// Quiz selection rules
+const (
+	QuizSelectionRandom  = "random"  // Any flashcards
+	QuizSelectionWeakest = "weakest" // The creator's most lapsed and hardest flashcards first
+	QuizSelectionTagged  = "tagged"  // Flashcards carrying the quiz tags
+)
+
+// MaxQuizQuestions is the maximum number of questions of a quiz
+const MaxQuizQuestions = 200
+
+// QuizDeadlineGrace is how long after the deadline of a timed attempt answers are
+// still accepted, to allow for network latency. Expired attempts are submitted
+// automatically once the grace period is over.
+const QuizDeadlineGrace = 10 * time.Second
+
+// QuizSettings describes how the questions of a new quiz are selected
+type QuizSettings struct {
+	Title         string
+	Selection     string
+	QuestionCount int            // For adaptive quizzes, the most questions an attempt asks
+	TypeCounts    map[string]int // Questions of each flashcard type; the rest may be of any type
+	Tags          []string
+	ChoiceCount   int    // Ask simple cards as multiple choice with generated options; 0 to type the answer
+	Seed          *int64 // Random when nil
+	Shared        bool   // Let everyone who can see the collection take the quiz
+	Adaptive      bool   // Pick each question by the learner's ability and stop once it is known
+
+	// Let submitted answers update the takers' spaced repetition: wrong answers as
+	// Again, correct answers slower than SlowAnswerSeconds as Hard
+	ReviewFeedback    bool
+	SlowAnswerSeconds int // DefaultSlowAnswerSeconds when 0
+
+	// Exam settings; zero values leave the quiz unrestricted
+	TimeLimitSeconds int
+	OpensAt          *time.Time // Start window
+	ClosesAt         *time.Time
+	MaxAttempts      int
+}
+
+// QuizQuestion is a question of a quiz attempt. Choices are shuffled the same way
+// on every view of the attempt. The correct answer and explanation are only
+// included when reviewing a submitted attempt.
+type QuizQuestion struct {
+	Position      int                  `json:"position"`
+	FlashcardID   uuid.UUID            `json:"flashcard_id"`
+	Type          string               `json:"type"`
+	ContentFormat string               `json:"content_format"`
+	Question      string               `json:"question"`
+	QuestionHTML  string               `json:"question_html"`
+	Options       []string             `json:"options,omitempty"` // multiple_choice and ordering cards, and simple cards asked as multiple choice
+	Lefts         []string             `json:"lefts,omitempty"`   // matching cards
+	Rights        []string             `json:"rights,omitempty"`  // matching cards
+	Response      *schema.QuizResponse `json:"response,omitempty"`
+	Answered      bool                 `json:"answered"`
+	TimeSpentMs   int                  `json:"time_spent_ms"`
+
+	Correct         *bool                    `json:"correct,omitempty"`
+	Score           *float64                 `json:"score,omitempty"`
+	Answer          string                   `json:"answer,omitempty"`
+	AnswerHTML      string                   `json:"answer_html,omitempty"`
+	Explanation     string                   `json:"explanation,omitempty"`
+	ExplanationHTML string                   `json:"explanation_html,omitempty"`
+	Sources         []schema.SourceReference `json:"sources,omitempty"`
+}
+
+// QuizAttemptView is an attempt at a quiz with its questions. The score is only
+// included once the attempt is submitted.
+type QuizAttemptView struct {
+	ID               uuid.UUID      `json:"id"`
+	QuizID           uuid.UUID      `json:"quiz_id"`
+	Status           string         `json:"status"`
+	StartedAt        time.Time      `json:"started_at"`
+	DeadlineAt       *time.Time     `json:"deadline_at,omitempty"`
+	ServerTime       time.Time      `json:"server_time"` // For clients to correct their clock when showing the time left
+	SubmittedAt      *time.Time     `json:"submitted_at,omitempty"`
+	AutoSubmitted    bool           `json:"auto_submitted"`
+	Score            *float64       `json:"score,omitempty"`
+	MaxScore         float64        `json:"max_score"`
+	TimeSpentSeconds int            `json:"time_spent_seconds"`
+	AnsweredCount    int            `json:"answered_count"`
+	Ability          *float64       `json:"ability,omitempty"`       // Adaptive attempts only
+	AbilityError     *float64       `json:"ability_error,omitempty"` // Adaptive attempts only
+	Questions        []QuizQuestion `json:"questions"`
+}
+
+// QuizAnswerResult is an answered question. Adaptive attempts also return the next
+// question, or finish once the ability of the learner is known well enough.
+type QuizAnswerResult struct {
+	Question QuizQuestion
+	Next     *QuizQuestion
+	Finished bool
+}
+
+// QuizService defines the interface for quiz business logic
+type QuizService interface {
+	CreateQuiz(ctx context.Context, collectionID uuid.UUID, userID string, settings QuizSettings) (*ent.Quiz, error)
+	GetQuiz(ctx context.Context, quizID uuid.UUID, userID string) (*ent.Quiz, error)
+	ListQuizzes(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.Quiz, error)
+	DeleteQuiz(ctx context.Context, quizID uuid.UUID, userID string) error
+
+	StartAttempt(ctx context.Context, quizID uuid.UUID, userID string) (*QuizAttemptView, error)
+	ListAttempts(ctx context.Context, quizID uuid.UUID, userID string) ([]*ent.QuizAttempt, error)
+	GetAttempt(ctx context.Context, attemptID uuid.UUID, userID string) (*QuizAttemptView, error)
+	AnswerQuestion(ctx context.Context, attemptID uuid.UUID, userID string, position int, submission AnswerSubmission) (*QuizAnswerResult, error)
+	SubmitAttempt(ctx context.Context, attemptID uuid.UUID, userID string) (*QuizAttemptView, error)
+	ReviewAttempt(ctx context.Context, attemptID uuid.UUID, userID string) (*QuizAttemptView, error)
+	ListReviewUpdates(ctx context.Context, attemptID uuid.UUID, userID string) ([]*ent.QuizReviewUpdate, error)
+	RevertReviewUpdates(ctx context.Context, attemptID uuid.UUID, userID string) (int, int, error)
+
+	GetQuizItemAnalysis(ctx context.Context, quizID uuid.UUID, userID string) ([]ItemAnalysis, error)
+	GetFlashcardItemAnalysis(ctx context.Context, flashcardID uuid.UUID, userID string) ([]ItemAnalysis, error)
+
+	SubmitExpired(ctx context.Context) (int, error)
+	RunDeadlineSweeper(ctx context.Context, interval time.Duration)
+}
+
+// NewQuizService creates a new QuizService instance
+func NewQuizService(
+	quizRepo repository.QuizRepository,
+	attemptRepo repository.QuizAttemptRepository,
+	statRepo repository.ItemStatisticRepository,
+	abilityRepo repository.AbilityRepository,
+	quizUpdateRepo repository.QuizReviewUpdateRepository,
+	flashcardRepo repository.FlashcardRepository,
+	reviewService FlashcardReviewService,
+	collectionService CollectionService,
+) QuizService {
+	return &quizServiceImpl{
+		quizRepo:          quizRepo,
+		attemptRepo:       attemptRepo,
+		statRepo:          statRepo,
+		abilityRepo:       abilityRepo,
+		quizUpdateRepo:    quizUpdateRepo,
+		flashcardRepo:     flashcardRepo,
+		reviewService:     reviewService,
+		collectionService: collectionService,
+	}
+}
@@ -0,0 +1,835 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/ent/quizattempt"
+	"github.com/quanphung1120/advanced-quiz-be/ent/schema"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+const maxQuizTitleLength = 255
+
+// maxQuizTimeLimit is the longest time limit of a timed quiz
+const maxQuizTimeLimit = 24 * time.Hour
+
+// quizSweepBatchSize is how many expired attempts are submitted per query
+const quizSweepBatchSize = 100
+
+// defaultEaseFactor is the ease of flashcards that were never reviewed
+const defaultEaseFactor = 2.5
+
+type quizServiceImpl struct {
+	quizRepo          repository.QuizRepository
+	attemptRepo       repository.QuizAttemptRepository
+	statRepo          repository.ItemStatisticRepository
+	abilityRepo       repository.AbilityRepository
+	quizUpdateRepo    repository.QuizReviewUpdateRepository
+	flashcardRepo     repository.FlashcardRepository
+	reviewService     FlashcardReviewService
+	collectionService CollectionService
+}
+
+func validQuizSelection(selection string) bool {
+	switch selection {
+	case QuizSelectionRandom, QuizSelectionWeakest, QuizSelectionTagged:
+		return true
+	}
+	return false
+}
+
+// quizQuestionType reports whether flashcards of the type can be asked in a quiz.
+// Image occlusion cards are answered one mask at a time and are left out.
+func quizQuestionType(flashcardType string) bool {
+	return validFlashcardType(flashcardType) && flashcardType != FlashcardTypeImageOcclusion
+}
+
+// quizRand returns a random source derived from the seed of a quiz. Stream 0
+// selects the questions and each question shuffles its choices with its own stream,
+// so that every view of an attempt shows the same order.
+func quizRand(seed int64, stream uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(seed), stream))
+}
+
+// CreateQuiz selects the questions of a new quiz from a collection and its
+// sub-collections. Anyone who can see the collection can create a quiz for
+// themselves; only editors can share one. Adaptive quizzes keep the matching
+// flashcards as candidates and pick the questions while the quiz is taken.
+func (s *quizServiceImpl) CreateQuiz(ctx context.Context, collectionID uuid.UUID, userID string, settings QuizSettings) (*ent.Quiz, error) {
+	_, role, err := s.collectionService.GetCollection(ctx, collectionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.Shared && role == "viewer" {
+		return nil, errors.New("permission denied")
+	}
+
+	settings.Title = strings.TrimSpace(settings.Title)
+	if len(settings.Title) > maxQuizTitleLength {
+		return nil, errors.New("title is too long")
+	}
+
+	if settings.Selection == "" {
+		settings.Selection = QuizSelectionRandom
+	}
+	if !validQuizSelection(settings.Selection) {
+		return nil, errors.New("invalid selection")
+	}
+
+	if settings.QuestionCount < 1 || settings.QuestionCount > MaxQuizQuestions {
+		return nil, fmt.Errorf("question count must be between 1 and %d", MaxQuizQuestions)
+	}
+
+	settings.Tags, err = NormalizeTags(settings.Tags)
+	if err != nil {
+		return nil, err
+	}
+	if settings.Selection == QuizSelectionTagged && len(settings.Tags) == 0 {
+		return nil, errors.New("tagged quizzes need at least one tag")
+	}
+
+	typed := 0
+	for flashcardType, count := range settings.TypeCounts {
+		if !quizQuestionType(flashcardType) {
+			return nil, fmt.Errorf("invalid question type %q", flashcardType)
+		}
+		if count < 0 {
+			return nil, errors.New("question type counts must not be negative")
+		}
+		typed += count
+	}
+	if typed > settings.QuestionCount {
+		return nil, errors.New("question type counts exceed the question count")
+	}
+
+	if settings.Adaptive {
+		if settings.Selection == QuizSelectionWeakest {
+			return nil, errors.New("adaptive quizzes cannot use the weakest selection")
+		}
+		if typed > 0 {
+			return nil, errors.New("adaptive quizzes cannot set question type counts")
+		}
+	}
+
+	if settings.ChoiceCount != 0 && !validChoiceCount(settings.ChoiceCount) {
+		return nil, fmt.Errorf("choice count must be 0 or between %d and %d", minChoiceCount, maxGeneratedChoices)
+	}
+
+	if settings.TimeLimitSeconds < 0 || settings.TimeLimitSeconds > int(maxQuizTimeLimit/time.Second) {
+		return nil, errors.New("time limit must be at most 24 hours")
+	}
+	if settings.MaxAttempts < 0 {
+		return nil, errors.New("maximum attempts must not be negative")
+	}
+	if settings.SlowAnswerSeconds < 0 || settings.SlowAnswerSeconds > int(maxQuizTimeLimit/time.Second) {
+		return nil, errors.New("slow answer time must be at most 24 hours")
+	}
+	if settings.SlowAnswerSeconds == 0 {
+		settings.SlowAnswerSeconds = DefaultSlowAnswerSeconds
+	}
+	if settings.OpensAt != nil && settings.ClosesAt != nil && !settings.ClosesAt.After(*settings.OpensAt) {
+		return nil, errors.New("quiz must close after it opens")
+	}
+
+	seed := rand.Int64()
+	if settings.Seed != nil {
+		seed = *settings.Seed
+	}
+
+	candidates, err := s.quizRepo.ListQuestionCandidates(ctx, collectionID, userID, settings.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	questionCount := settings.QuestionCount
+	if settings.Adaptive {
+		settings.QuestionCount = maxAdaptiveCandidates
+	}
+
+	flashcardIDs, err := selectQuizQuestions(candidates, settings, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quizRepo.Create(ctx, collectionID, userID, repository.QuizFields{
+		Title:         settings.Title,
+		Selection:     settings.Selection,
+		QuestionCount: min(questionCount, len(flashcardIDs)),
+		TypeCounts:    settings.TypeCounts,
+		Tags:          settings.Tags,
+		ChoiceCount:   settings.ChoiceCount,
+		Seed:          seed,
+		FlashcardIDs:  flashcardIDs,
+		Shared:        settings.Shared,
+		Adaptive:      settings.Adaptive,
+
+		ReviewFeedback:    settings.ReviewFeedback,
+		SlowAnswerSeconds: settings.SlowAnswerSeconds,
+
+		TimeLimitSeconds: positiveOrNil(settings.TimeLimitSeconds),
+		OpensAt:          settings.OpensAt,
+		ClosesAt:         settings.ClosesAt,
+		MaxAttempts:      positiveOrNil(settings.MaxAttempts),
+	})
+}
+
+func positiveOrNil(n int) *int {
+	if n <= 0 {
+		return nil
+	}
+	return &n
+}
+
+// selectQuizQuestions picks the flashcards of a quiz in question order. The same
+// candidates, settings and seed always give the same questions. When fewer
+// flashcards match than requested, the quiz gets all of them.
+func selectQuizQuestions(candidates []*ent.Flashcard, settings QuizSettings, seed int64) ([]uuid.UUID, error) {
+	rng := quizRand(seed, 0)
+
+	pool := make([]*ent.Flashcard, 0, len(candidates))
+	for _, fc := range candidates {
+		if quizQuestionType(fc.Type) {
+			pool = append(pool, fc)
+		}
+	}
+	if len(pool) == 0 {
+		return nil, errors.New("no flashcards match the quiz settings")
+	}
+
+	rng.Shuffle(len(pool), func(i, j int) {
+		pool[i], pool[j] = pool[j], pool[i]
+	})
+	if settings.Selection == QuizSelectionWeakest {
+		// The shuffle breaks ties between equally weak flashcards
+		sort.SliceStable(pool, func(i, j int) bool {
+			return weakerThan(pool[i], pool[j])
+		})
+	}
+
+	types := make([]string, 0, len(settings.TypeCounts))
+	for flashcardType := range settings.TypeCounts {
+		types = append(types, flashcardType)
+	}
+	sort.Strings(types)
+
+	picked := make(map[uuid.UUID]bool, settings.QuestionCount)
+	selected := make([]uuid.UUID, 0, settings.QuestionCount)
+	for _, flashcardType := range types {
+		wanted := settings.TypeCounts[flashcardType]
+		for _, fc := range pool {
+			if wanted == 0 {
+				break
+			}
+			if fc.Type == flashcardType {
+				selected = append(selected, fc.ID)
+				picked[fc.ID] = true
+				wanted--
+			}
+		}
+		if wanted > 0 {
+			return nil, fmt.Errorf("not enough %s flashcards for the quiz", flashcardType)
+		}
+	}
+
+	for _, fc := range pool {
+		if len(selected) >= settings.QuestionCount {
+			break
+		}
+		if !picked[fc.ID] {
+			selected = append(selected, fc.ID)
+		}
+	}
+
+	// Mix the question types
+	rng.Shuffle(len(selected), func(i, j int) {
+		selected[i], selected[j] = selected[j], selected[i]
+	})
+
+	return selected, nil
+}
+
+// weakerThan orders flashcards for the weakest selection: the most lapses first,
+// then the lowest ease. Flashcards never reviewed count as having the default ease.
+func weakerThan(a, b *ent.Flashcard) bool {
+	lapsesA, easeA := reviewWeakness(a)
+	lapsesB, easeB := reviewWeakness(b)
+	if lapsesA != lapsesB {
+		return lapsesA > lapsesB
+	}
+	return easeA < easeB
+}
+
+func reviewWeakness(fc *ent.Flashcard) (int, float64) {
+	lapses, ease := 0, defaultEaseFactor
+	for _, review := range fc.Edges.Reviews {
+		lapses += review.LapseCount
+		ease = min(ease, review.EaseFactor)
+	}
+	return lapses, ease
+}
+
+// quizFor returns a quiz the user can take: one they created, or a shared quiz of
+// a collection they can see. It also returns the user's role on the collection.
+func (s *quizServiceImpl) quizFor(ctx context.Context, quizID uuid.UUID, userID string) (*ent.Quiz, string, error) {
+	q, err := s.quizRepo.GetByID(ctx, quizID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, role, err := s.collectionService.GetCollection(ctx, q.CollectionID, userID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if q.CreatedBy != userID && !q.Shared {
+		return nil, "", errors.New("quiz not found")
+	}
+
+	return q, role, nil
+}
+
+// GetQuiz returns a quiz the user can take
+func (s *quizServiceImpl) GetQuiz(ctx context.Context, quizID uuid.UUID, userID string) (*ent.Quiz, error) {
+	q, _, err := s.quizFor(ctx, quizID, userID)
+	return q, err
+}
+
+// ListQuizzes returns the user's own quizzes of a collection and the shared ones
+func (s *quizServiceImpl) ListQuizzes(ctx context.Context, collectionID uuid.UUID, userID string) ([]*ent.Quiz, error) {
+	if _, _, err := s.collectionService.GetCollection(ctx, collectionID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.quizRepo.ListByCollection(ctx, collectionID, userID)
+}
+
+// DeleteQuiz deletes a quiz with all its attempts. Shared quizzes can also be
+// deleted by editors of the collection.
+func (s *quizServiceImpl) DeleteQuiz(ctx context.Context, quizID uuid.UUID, userID string) error {
+	q, role, err := s.quizFor(ctx, quizID, userID)
+	if err != nil {
+		return err
+	}
+
+	if q.CreatedBy != userID && role == "viewer" {
+		return errors.New("permission denied")
+	}
+
+	return s.quizRepo.Delete(ctx, quizID)
+}
+
+// StartAttempt starts a new attempt at a quiz. Flashcards that were deleted or
+// unpublished since the quiz was created are left out. The options of multiple
+// choice questions are shuffled and kept with the attempt; when the quiz asks
+// simple cards as multiple choice, their options are generated from the answers of
+// the other published flashcards of their collection.
+// Exams can only be started within their start window and up to their maximum
+// number of attempts. Adaptive attempts start with the single most informative
+// question at the learner's current ability.
+func (s *quizServiceImpl) StartAttempt(ctx context.Context, quizID uuid.UUID, userID string) (*QuizAttemptView, error) {
+	q, _, err := s.quizFor(ctx, quizID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if q.OpensAt != nil && now.Before(*q.OpensAt) {
+		return nil, errors.New("quiz is not open yet")
+	}
+	if q.ClosesAt != nil && now.After(*q.ClosesAt) {
+		return nil, errors.New("quiz is closed")
+	}
+
+	limits := repository.AttemptLimits{ClosesAt: q.ClosesAt}
+	if q.TimeLimitSeconds != nil {
+		limits.TimeLimit = time.Duration(*q.TimeLimitSeconds) * time.Second
+	}
+	if q.MaxAttempts != nil {
+		limits.MaxAttempts = *q.MaxAttempts
+	}
+
+	flashcards, err := s.quizFlashcards(ctx, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.Adaptive && len(flashcards) > 0 {
+		learner, err := s.abilityRepo.GetAbility(ctx, userID, q.CollectionID)
+		if err != nil {
+			return nil, err
+		}
+		first, err := s.pickAdaptive(ctx, q, learner.Ability, flashcards, 0)
+		if err != nil {
+			return nil, err
+		}
+		flashcards = []*ent.Flashcard{first}
+	}
+
+	siblings := make(map[uuid.UUID][]*ent.Flashcard)
+	questions := make([]repository.AttemptQuestion, 0, len(flashcards))
+	for _, fc := range flashcards {
+		question, err := s.attemptQuestion(ctx, q, fc, len(questions), siblings)
+		if err != nil {
+			return nil, err
+		}
+		questions = append(questions, question)
+	}
+	if len(questions) == 0 {
+		return nil, errors.New("the quiz has no questions left")
+	}
+
+	attempt, err := s.attemptRepo.Start(ctx, q.ID, userID, questions, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetAttempt(ctx, attempt.ID, userID)
+}
+
+// quizFlashcards returns the flashcards of a quiz that are still published in the
+// quiz collection or its live sub-collections, in question order, leaving out
+// those already asked. Flashcards moved elsewhere or trashed since the quiz was
+// created are not asked.
+func (s *quizServiceImpl) quizFlashcards(ctx context.Context, q *ent.Quiz, asked map[uuid.UUID]bool) ([]*ent.Flashcard, error) {
+	flashcards, err := s.flashcardRepo.ListByIDsInTree(ctx, q.CollectionID, q.FlashcardIds)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[uuid.UUID]*ent.Flashcard, len(flashcards))
+	for _, fc := range flashcards {
+		if fc.Status.String() == FlashcardStatusPublished {
+			available[fc.ID] = fc
+		}
+	}
+
+	ordered := make([]*ent.Flashcard, 0, len(available))
+	for _, id := range q.FlashcardIds {
+		if fc, ok := available[id]; ok && !asked[id] {
+			ordered = append(ordered, fc)
+		}
+	}
+	return ordered, nil
+}
+
+// attemptQuestion prepares the question at a position of an attempt. The options
+// of multiple choice cards are shuffled and kept with the attempt so that the
+// chosen options can be counted; simple cards asked as multiple choice get options
+// generated from the other published flashcards of their collection, which are
+// cached in siblings.
+func (s *quizServiceImpl) attemptQuestion(ctx context.Context, q *ent.Quiz, fc *ent.Flashcard, position int, siblings map[uuid.UUID][]*ent.Flashcard) (repository.AttemptQuestion, error) {
+	question := repository.AttemptQuestion{FlashcardID: fc.ID}
+	rng := quizRand(q.Seed, uint64(position)+1)
+
+	switch {
+	case fc.Type == FlashcardTypeMultipleChoice:
+		question.Options = shuffledCopy(rng, fc.Options)
+	case fc.Type == FlashcardTypeSimple && q.ChoiceCount > 0:
+		cards, ok := siblings[fc.CollectionID]
+		if !ok {
+			var err error
+			cards, err = s.flashcardRepo.ListByCollection(ctx, fc.CollectionID, repository.FlashcardFilter{Status: FlashcardStatusPublished})
+			if err != nil {
+				return question, err
+			}
+			siblings[fc.CollectionID] = cards
+		}
+		question.Options = GenerateChoices(fc, cards, q.ChoiceCount, rng)
+	}
+
+	return question, nil
+}
+
+// pickAdaptive returns the candidate flashcard with the most information at the
+// user's ability
+func (s *quizServiceImpl) pickAdaptive(ctx context.Context, q *ent.Quiz, ability float64, candidates []*ent.Flashcard, position int) (*ent.Flashcard, error) {
+	ids := make([]uuid.UUID, len(candidates))
+	for i, fc := range candidates {
+		ids[i] = fc.ID
+	}
+	ratings, err := s.abilityRepo.ListDifficulties(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return mostInformative(candidates, ability, ratings, quizRand(q.Seed, adaptiveStream+uint64(position))), nil
+}
+
+// ListAttempts returns the user's attempts at a quiz
+func (s *quizServiceImpl) ListAttempts(ctx context.Context, quizID uuid.UUID, userID string) ([]*ent.QuizAttempt, error) {
+	if _, _, err := s.quizFor(ctx, quizID, userID); err != nil {
+		return nil, err
+	}
+
+	return s.attemptRepo.ListByQuizAndUser(ctx, quizID, userID)
+}
+
+// attemptFor returns one of the user's attempts with its answers and its quiz
+func (s *quizServiceImpl) attemptFor(ctx context.Context, attemptID uuid.UUID, userID string) (*ent.QuizAttempt, *ent.Quiz, error) {
+	attempt, err := s.attemptRepo.GetByID(ctx, attemptID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if attempt.UserID != userID {
+		return nil, nil, errors.New("attempt not found")
+	}
+
+	q, err := s.quizRepo.GetByID(ctx, attempt.QuizID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attempt, q, nil
+}
+
+// GetAttempt returns one of the user's attempts without the correct answers
+func (s *quizServiceImpl) GetAttempt(ctx context.Context, attemptID uuid.UUID, userID string) (*QuizAttemptView, error) {
+	attempt, q, err := s.attemptFor(ctx, attemptID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return attemptView(attempt, q.Seed, nil), nil
+}
+
+// AnswerQuestion grades and records the answer to a question of an attempt in
+// progress. The grade is kept until the attempt is submitted. Answers to timed
+// attempts are refused once the deadline and its grace period have passed.
+// Adaptive attempts are answered one question at a time; each answer updates the
+// ability and difficulty estimates and either adds the next question or submits
+// the attempt.
+func (s *quizServiceImpl) AnswerQuestion(ctx context.Context, attemptID uuid.UUID, userID string, position int, submission AnswerSubmission) (*QuizAnswerResult, error) {
+	attempt, q, err := s.attemptFor(ctx, attemptID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.Status != quizattempt.StatusInProgress {
+		return nil, errors.New("attempt is already submitted")
+	}
+
+	now := time.Now()
+	if attempt.DeadlineAt != nil && now.After(attempt.DeadlineAt.Add(QuizDeadlineGrace)) {
+		return nil, errors.New("time is up")
+	}
+
+	var fc *ent.Flashcard
+	for _, answer := range attempt.Edges.Answers {
+		if answer.Position == position {
+			fc = answer.Edges.Flashcard
+			break
+		}
+	}
+	if fc == nil {
+		return nil, errors.New("invalid question")
+	}
+
+	available, err := s.flashcardRepo.ListByIDsInTree(ctx, q.CollectionID, []uuid.UUID{fc.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(available) == 0 {
+		return nil, errors.New("question is no longer available")
+	}
+
+	if q.Adaptive {
+		current := attempt.Edges.Answers[len(attempt.Edges.Answers)-1]
+		if position != current.Position {
+			return nil, errors.New("only the current question can be answered")
+		}
+		if current.AnsweredAt != nil {
+			return nil, errors.New("question is already answered")
+		}
+	}
+
+	result := GradeSubmission(fc, AnswerSubmission{
+		Answer:  submission.Answer,
+		Order:   submission.Order,
+		Matches: submission.Matches,
+	})
+
+	grade := repository.QuizAnswerGrade{
+		Response: &schema.QuizResponse{
+			Answer:  submission.Answer,
+			Order:   submission.Order,
+			Matches: submission.Matches,
+		},
+		Correct: result.Correct,
+		Score:   result.Score,
+	}
+	if q.Adaptive {
+		return s.answerAdaptive(ctx, attempt, q, fc, position, grade, now)
+	}
+
+	saved, err := s.attemptRepo.SaveAnswer(ctx, attempt.ID, position, grade, now)
+	if err != nil {
+		return nil, err
+	}
+
+	saved.Edges.Flashcard = fc
+	return &QuizAnswerResult{Question: quizQuestion(saved, q.Seed, false)}, nil
+}
+
+// answerAdaptive records the answer to the current question of an adaptive attempt
+// with the updated ability and difficulty estimates, and adds the next question or
+// submits the attempt when it should stop: the ability estimate has converged, the
+// question budget is spent or no flashcards are left. Everything is saved in one
+// transaction, so the question stays unanswered when any of it fails.
+func (s *quizServiceImpl) answerAdaptive(ctx context.Context, attempt *ent.QuizAttempt, q *ent.Quiz, fc *ent.Flashcard, position int, grade repository.QuizAnswerGrade, now time.Time) (*QuizAnswerResult, error) {
+	asked := make(map[uuid.UUID]bool, len(attempt.Edges.Answers))
+	askedIDs := make([]uuid.UUID, 0, len(attempt.Edges.Answers))
+	for _, answer := range attempt.Edges.Answers {
+		asked[answer.FlashcardID] = true
+		askedIDs = append(askedIDs, answer.FlashcardID)
+	}
+
+	var next *ent.Flashcard
+	answered, err := s.attemptRepo.AnswerAdaptive(ctx, attempt.ID, q.CollectionID, position, grade, now, func(learner *ent.LearnerAbility, rating *ent.FlashcardRating) (*repository.AdaptiveStep, error) {
+		step := &repository.AdaptiveStep{}
+		step.Ability, step.Difficulty = updateElo(learner.Ability, learner.AnswerCount, rating.Difficulty, rating.AnswerCount, grade.Score)
+
+		ratings, err := s.abilityRepo.ListDifficulties(ctx, askedIDs)
+		if err != nil {
+			return nil, err
+		}
+		ratings[fc.ID] = &ent.FlashcardRating{FlashcardID: fc.ID, Difficulty: step.Difficulty}
+
+		// Every question of an adaptive attempt is answered before the next is added
+		difficulties := make([]float64, len(askedIDs))
+		for i, id := range askedIDs {
+			difficulties[i], _ = difficultyOf(ratings, id)
+		}
+		step.AbilityError = abilityError(step.Ability, difficulties)
+
+		count := len(askedIDs)
+		if count >= q.QuestionCount || (count >= adaptiveMinQuestions && step.AbilityError <= adaptiveTargetError) {
+			return step, nil
+		}
+
+		candidates, err := s.quizFlashcards(ctx, q, asked)
+		if err != nil || len(candidates) == 0 {
+			return step, err
+		}
+
+		next, err = s.pickAdaptive(ctx, q, step.Ability, candidates, count)
+		if err != nil {
+			return nil, err
+		}
+
+		question, err := s.attemptQuestion(ctx, q, next, count, make(map[uuid.UUID][]*ent.Flashcard))
+		if err != nil {
+			return nil, err
+		}
+		step.Next = &question
+		return step, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	answered.Answer.Edges.Flashcard = fc
+	result := &QuizAnswerResult{Question: quizQuestion(answered.Answer, q.Seed, false)}
+	if answered.Next == nil {
+		s.feedReviews(ctx, answered.Submitted)
+		result.Finished = true
+		return result, nil
+	}
+
+	answered.Next.Edges.Flashcard = next
+	view := quizQuestion(answered.Next, q.Seed, false)
+	result.Next = &view
+	return result, nil
+}
+
+// SubmitAttempt finishes an attempt in progress and returns it with its score
+func (s *quizServiceImpl) SubmitAttempt(ctx context.Context, attemptID uuid.UUID, userID string) (*QuizAttemptView, error) {
+	if _, _, err := s.attemptFor(ctx, attemptID, userID); err != nil {
+		return nil, err
+	}
+
+	if err := s.submit(ctx, attemptID, time.Now(), false); err != nil {
+		return nil, err
+	}
+
+	return s.GetAttempt(ctx, attemptID, userID)
+}
+
+// ReviewAttempt returns a submitted attempt with the grade, correct answer and
+// explanation of every question whose flashcard is still in the quiz collection
+func (s *quizServiceImpl) ReviewAttempt(ctx context.Context, attemptID uuid.UUID, userID string) (*QuizAttemptView, error) {
+	attempt, q, err := s.attemptFor(ctx, attemptID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if attempt.Status != quizattempt.StatusSubmitted {
+		return nil, errors.New("attempt is not submitted yet")
+	}
+
+	ids := make([]uuid.UUID, len(attempt.Edges.Answers))
+	for i, answer := range attempt.Edges.Answers {
+		ids[i] = answer.FlashcardID
+	}
+	available, err := s.flashcardRepo.ListByIDsInTree(ctx, q.CollectionID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	revealed := make(map[uuid.UUID]bool, len(available))
+	for _, fc := range available {
+		revealed[fc.ID] = true
+	}
+
+	return attemptView(attempt, q.Seed, revealed), nil
+}
+
+// SubmitExpired submits the timed attempts whose deadline and grace period have
+// passed, as of their deadline, and returns how many were submitted
+func (s *quizServiceImpl) SubmitExpired(ctx context.Context) (int, error) {
+	submitted := 0
+	cutoff := time.Now().Add(-QuizDeadlineGrace)
+
+	for {
+		attempts, err := s.attemptRepo.ListExpired(ctx, cutoff, quizSweepBatchSize)
+		if err != nil {
+			return submitted, err
+		}
+
+		for _, attempt := range attempts {
+			if err := s.submit(ctx, attempt.ID, *attempt.DeadlineAt, true); err != nil {
+				return submitted, err
+			}
+			submitted++
+		}
+
+		if len(attempts) < quizSweepBatchSize {
+			break
+		}
+	}
+
+	return submitted, nil
+}
+
+// RunDeadlineSweeper periodically submits expired timed attempts until the context
+// is canceled
+func (s *quizServiceImpl) RunDeadlineSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			submitted, err := s.SubmitExpired(ctx)
+			if err != nil {
+				log.Println("Submitting expired quiz attempts failed:", err)
+			} else if submitted > 0 {
+				log.Printf("Submitted %d expired quiz attempts\n", submitted)
+			}
+		}
+	}
+}
+
+// attemptView presents an attempt, revealing the answers of the flashcards in
+// revealed
+func attemptView(attempt *ent.QuizAttempt, seed int64, revealed map[uuid.UUID]bool) *QuizAttemptView {
+	view := &QuizAttemptView{
+		ID:               attempt.ID,
+		QuizID:           attempt.QuizID,
+		Status:           attempt.Status.String(),
+		StartedAt:        attempt.StartedAt,
+		DeadlineAt:       attempt.DeadlineAt,
+		ServerTime:       time.Now(),
+		SubmittedAt:      attempt.SubmittedAt,
+		AutoSubmitted:    attempt.AutoSubmitted,
+		MaxScore:         attempt.MaxScore,
+		TimeSpentSeconds: attempt.TimeSpentSeconds,
+		Ability:          attempt.Ability,
+		AbilityError:     attempt.AbilityError,
+		Questions:        make([]QuizQuestion, 0, len(attempt.Edges.Answers)),
+	}
+
+	if attempt.Status == quizattempt.StatusSubmitted {
+		score := attempt.Score
+		view.Score = &score
+	}
+
+	for _, answer := range attempt.Edges.Answers {
+		question := quizQuestion(answer, seed, revealed[answer.FlashcardID])
+		if question.Answered {
+			view.AnsweredCount++
+		}
+		view.Questions = append(view.Questions, question)
+	}
+
+	return view
+}
+
+// quizQuestion presents the question of an answer. Multiple choice questions show
+// the options chosen when the attempt started. Other choices are shuffled with the
+// question's own stream of the quiz seed; matching cards keep their left items in
+// order and shuffle the right ones.
+func quizQuestion(answer *ent.QuizAnswer, seed int64, reveal bool) QuizQuestion {
+	fc := answer.Edges.Flashcard
+	question := QuizQuestion{
+		Position:      answer.Position,
+		FlashcardID:   answer.FlashcardID,
+		Type:          fc.Type,
+		ContentFormat: fc.ContentFormat,
+		Question:      fc.Question,
+		QuestionHTML:  fc.QuestionHTML,
+		Response:      answer.Response,
+		Answered:      answer.AnsweredAt != nil,
+		TimeSpentMs:   answer.TimeSpentMs,
+	}
+
+	rng := quizRand(seed, uint64(answer.Position)+1)
+	switch fc.Type {
+	case FlashcardTypeSimple, FlashcardTypeMultipleChoice:
+		question.Options = answer.Options
+	case FlashcardTypeOrdering:
+		question.Options = shuffledCopy(rng, fc.Options)
+	case FlashcardTypeMatching:
+		rights := make([]string, len(fc.Pairs))
+		question.Lefts = make([]string, len(fc.Pairs))
+		for i, pair := range fc.Pairs {
+			question.Lefts[i] = pair.Left
+			rights[i] = pair.Right
+		}
+		question.Rights = shuffledCopy(rng, rights)
+	}
+
+	if reveal {
+		correct, score := answer.Correct, answer.Score
+		question.Correct = &correct
+		question.Score = &score
+		question.Answer = fc.Answer
+		question.AnswerHTML = fc.AnswerHTML
+		question.Explanation = fc.Explanation
+		question.ExplanationHTML = fc.ExplanationHTML
+		question.Sources = fc.Sources
+	}
+
+	return question
+}
+
+func shuffledCopy(rng *rand.Rand, items []string) []string {
+	shuffled := make([]string, len(items))
+	copy(shuffled, items)
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+const (
+	maxTagLength = 255
+	maxTagDepth  = 10
+	maxTagsPerOp = 50
+)
+
+// NormalizeTag canonicalizes a hierarchical tag such as "Bio :: Cell Biology" into
+// "bio::cell_biology" so that tags differing only in case or spacing are the same tag
+func NormalizeTag(tag string) (string, error) {
+	var segments []string
+	for _, segment := range strings.Split(tag, repository.TagSeparator) {
+		segment = strings.Join(strings.FieldsFunc(strings.ToLower(segment), unicode.IsSpace), "_")
+		if segment == "" {
+			continue
+		}
+		if strings.Contains(segment, ":") {
+			return "", errors.New("tag segments cannot contain ':'")
+		}
+		segments = append(segments, segment)
+	}
+
+	if len(segments) == 0 {
+		return "", errors.New("tag cannot be empty")
+	}
+	if len(segments) > maxTagDepth {
+		return "", errors.New("tag is nested too deeply")
+	}
+
+	normalized := strings.Join(segments, repository.TagSeparator)
+	if len(normalized) > maxTagLength {
+		return "", errors.New("tag is too long")
+	}
+
+	return normalized, nil
+}
+
+// NormalizeTags normalizes and deduplicates a list of tags
+func NormalizeTags(tags []string) ([]string, error) {
+	if len(tags) > maxTagsPerOp {
+		return nil, errors.New("too many tags")
+	}
+
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		n, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[n] {
+			seen[n] = true
+			normalized = append(normalized, n)
+		}
+	}
+
+	return normalized, nil
+}
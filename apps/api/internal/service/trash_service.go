@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+// TrashRetention is how long deleted collections and flashcards can be restored
+// before they are permanently removed
+const TrashRetention = 30 * 24 * time.Hour
+
+// TrashedCollection is a collection in the trash with the time it will be purged
+type TrashedCollection struct {
+	*ent.Collection
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// TrashedFlashcard is a flashcard in the trash with the time it will be purged
+type TrashedFlashcard struct {
+	*ent.Flashcard
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// Trash lists the deleted items a user can restore
+type Trash struct {
+	Collections []TrashedCollection `json:"collections"`
+	Flashcards  []TrashedFlashcard  `json:"flashcards"`
+}
+
+// TrashService defines the interface for the trash bin business logic
+type TrashService interface {
+	ListTrash(ctx context.Context, userID string) (*Trash, error)
+	RestoreCollection(ctx context.Context, collectionID uuid.UUID, userID string) error
+	RestoreFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) error
+	DeleteCollectionPermanently(ctx context.Context, collectionID uuid.UUID, userID string) (*ent.DeletionJob, error)
+	GetDeletionJob(ctx context.Context, jobID uuid.UUID, userID string) (*ent.DeletionJob, error)
+	Purge(ctx context.Context) (int, error)
+	RunPurger(ctx context.Context, interval time.Duration)
+}
+
+// NewTrashService creates a new TrashService instance
+func NewTrashService(
+	collectionRepo repository.CollectionRepository,
+	flashcardRepo repository.FlashcardRepository,
+	deletionJobRepo repository.DeletionJobRepository,
+	collectionService CollectionService,
+) TrashService {
+	return &trashServiceImpl{
+		collectionRepo:    collectionRepo,
+		flashcardRepo:     flashcardRepo,
+		deletionJobRepo:   deletionJobRepo,
+		collectionService: collectionService,
+	}
+}
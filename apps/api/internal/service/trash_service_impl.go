@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
+	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
+)
+
+const trashPurgeBatchSize = 100
+
+// Collections with more flashcards than this are deleted by a background job in
+// batches rather than in a single transaction
+const syncDeleteFlashcardLimit = 1000
+
+const deletionJobBatchSize = 500
+
+// Unfinished deletion jobs without progress for this long are resumed
+const deletionJobStaleAfter = 10 * time.Minute
+
+// Requester of deletion jobs started by the trash purge
+const purgeRequester = "system"
+
+type trashServiceImpl struct {
+	collectionRepo    repository.CollectionRepository
+	flashcardRepo     repository.FlashcardRepository
+	deletionJobRepo   repository.DeletionJobRepository
+	collectionService CollectionService
+}
+
+// ListTrash returns the deleted collections the user owns and the deleted flashcards
+// of collections the user can edit
+func (s *trashServiceImpl) ListTrash(ctx context.Context, userID string) (*Trash, error) {
+	collections, err := s.collectionRepo.ListTrashedByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	flashcards, err := s.flashcardRepo.ListTrashedEditableBy(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	trash := &Trash{
+		Collections: make([]TrashedCollection, len(collections)),
+		Flashcards:  make([]TrashedFlashcard, len(flashcards)),
+	}
+	for i, c := range collections {
+		trash.Collections[i] = TrashedCollection{Collection: c, PurgeAt: c.DeletedAt.Add(TrashRetention)}
+	}
+	for i, fc := range flashcards {
+		trash.Flashcards[i] = TrashedFlashcard{Flashcard: fc, PurgeAt: fc.DeletedAt.Add(TrashRetention)}
+	}
+
+	return trash, nil
+}
+
+// RestoreCollection takes a collection out of the trash. Only the owner can restore
+// it, since only the owner can delete it.
+func (s *trashServiceImpl) RestoreCollection(ctx context.Context, collectionID uuid.UUID, userID string) error {
+	collection, err := s.collectionRepo.GetTrashedByID(ctx, collectionID)
+	if err != nil {
+		return errors.New("collection not found in trash")
+	}
+
+	if collection.OwnerID != userID {
+		return errors.New("permission denied")
+	}
+
+	return s.collectionRepo.Restore(ctx, collectionID)
+}
+
+// RestoreFlashcard takes a flashcard out of the trash together with the review
+// progress every user had on it
+func (s *trashServiceImpl) RestoreFlashcard(ctx context.Context, flashcardID uuid.UUID, userID string) error {
+	flashcard, err := s.flashcardRepo.GetTrashedByID(ctx, flashcardID)
+	if err != nil {
+		return errors.New("flashcard not found in trash")
+	}
+
+	_, role, err := s.collectionService.GetCollection(ctx, flashcard.CollectionID, userID)
+	if err != nil {
+		return errors.New("collection not found; restore the collection first")
+	}
+
+	if role == "viewer" {
+		return errors.New("permission denied")
+	}
+
+	return s.flashcardRepo.Restore(ctx, flashcardID)
+}
+
+// Purge permanently deletes items that have been in the trash for longer than
+// the retention window
+func (s *trashServiceImpl) Purge(ctx context.Context) (int, error) {
+	purged := 0
+	cutoff := time.Now().Add(-TrashRetention)
+
+	for {
+		flashcards, err := s.flashcardRepo.ListTrashedBefore(ctx, cutoff, trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, fc := range flashcards {
+			if err := s.flashcardRepo.Delete(ctx, fc.ID); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		if len(flashcards) < trashPurgeBatchSize {
+			break
+		}
+	}
+
+	for {
+		collections, err := s.collectionRepo.ListTrashedBefore(ctx, cutoff, trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, c := range collections {
+			job, created, err := s.deleteCollection(ctx, c.ID, purgeRequester)
+			if err != nil {
+				return purged, err
+			}
+			if created {
+				// Already in the background, so large collections are deleted inline
+				if err := s.runDeletionJob(ctx, job); err != nil {
+					return purged, err
+				}
+			}
+			purged++
+		}
+
+		if len(collections) < trashPurgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// DeleteCollectionPermanently deletes a trashed collection right away instead of
+// waiting for the purge. Large collections are deleted by a background job, which
+// is returned so its progress can be followed; nil means the collection is gone.
+func (s *trashServiceImpl) DeleteCollectionPermanently(ctx context.Context, collectionID uuid.UUID, userID string) (*ent.DeletionJob, error) {
+	collection, err := s.collectionRepo.GetTrashedByID(ctx, collectionID)
+	if err != nil {
+		return nil, errors.New("collection not found in trash")
+	}
+
+	if collection.OwnerID != userID {
+		return nil, errors.New("permission denied")
+	}
+
+	job, created, err := s.deleteCollection(ctx, collectionID, userID)
+	if err != nil || !created {
+		// An active job is already running, or resumed by the purger
+		return job, err
+	}
+
+	go func() {
+		// Detached from the request, which ends before the job does
+		if err := s.runDeletionJob(context.WithoutCancel(ctx), job); err != nil {
+			log.Println("Collection deletion job failed:", err)
+		}
+	}()
+
+	return job, nil
+}
+
+// deleteCollection deletes a small collection in one transaction, or returns the
+// job that deletes a large one and whether the job was just created and still
+// needs a runner
+func (s *trashServiceImpl) deleteCollection(ctx context.Context, collectionID uuid.UUID, requestedBy string) (*ent.DeletionJob, bool, error) {
+	if job, err := s.deletionJobRepo.GetActiveByCollection(ctx, collectionID); err == nil {
+		return job, false, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	total, err := s.collectionRepo.CountFlashcards(ctx, collectionID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if total <= syncDeleteFlashcardLimit {
+		return nil, false, s.collectionRepo.Delete(ctx, collectionID)
+	}
+
+	job, err := s.deletionJobRepo.Create(ctx, collectionID, requestedBy, total)
+	if err != nil {
+		return nil, false, err
+	}
+	return job, true, nil
+}
+
+// runDeletionJob deletes the flashcards of the collection in batches, recording the
+// progress after each one, and then the collection itself. A job interrupted by a
+// restart is resumed by the purger. Jobs claimed by another runner are left alone.
+func (s *trashServiceImpl) runDeletionJob(ctx context.Context, job *ent.DeletionJob) error {
+	claimed, err := s.deletionJobRepo.Claim(ctx, job.ID, time.Now().Add(-deletionJobStaleAfter))
+	if err != nil || !claimed {
+		return err
+	}
+
+	fail := func(err error) error {
+		if failErr := s.deletionJobRepo.Fail(ctx, job.ID, err.Error()); failErr != nil {
+			log.Println("Failed to record deletion job failure:", failErr)
+		}
+		return err
+	}
+
+	for {
+		n, err := s.collectionRepo.DeleteFlashcardBatch(ctx, job.CollectionID, deletionJobBatchSize)
+		if err != nil {
+			return fail(err)
+		}
+
+		// Added rather than set, so that a runner still finishing a batch after its
+		// job was resumed does not overwrite the progress
+		if err := s.deletionJobRepo.AddProgress(ctx, job.ID, n); err != nil {
+			return fail(err)
+		}
+
+		if n < deletionJobBatchSize {
+			break
+		}
+	}
+
+	if err := s.collectionRepo.Delete(ctx, job.CollectionID); err != nil && !ent.IsNotFound(err) {
+		return fail(err)
+	}
+
+	return s.deletionJobRepo.Complete(ctx, job.ID)
+}
+
+// GetDeletionJob returns a deletion job requested by the user
+func (s *trashServiceImpl) GetDeletionJob(ctx context.Context, jobID uuid.UUID, userID string) (*ent.DeletionJob, error) {
+	job, err := s.deletionJobRepo.GetByID(ctx, jobID)
+	if err != nil || job.RequestedBy != userID {
+		return nil, errors.New("deletion job not found")
+	}
+
+	return job, nil
+}
+
+// resumeDeletionJobs restarts jobs that stopped making progress
+func (s *trashServiceImpl) resumeDeletionJobs(ctx context.Context) error {
+	jobs, err := s.deletionJobRepo.ListStale(ctx, time.Now().Add(-deletionJobStaleAfter))
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if err := s.runDeletionJob(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RunPurger purges the trash every interval until ctx is done
+func (s *trashServiceImpl) RunPurger(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.resumeDeletionJobs(ctx); err != nil {
+				log.Println("Resuming deletion jobs failed:", err)
+			}
+
+			purged, err := s.Purge(ctx)
+			if err != nil {
+				log.Println("Trash purge failed:", err)
+			} else if purged > 0 {
+				log.Printf("Trash purge removed %d items\n", purged)
+			}
+		}
+	}
+}
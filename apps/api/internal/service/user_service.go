@@ -1,17 +1,43 @@
 package service
 
 import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quanphung1120/advanced-quiz-be/ent"
 	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
 )
 
+// PersonalDataExport is the data stored about a user: learning progress and
+// private annotations, with the tags of the flashcards they belong to so that
+// they can be grouped by topic. Tags are only included for flashcards the user
+// can still see.
+type PersonalDataExport struct {
+	UserID        string                     `json:"user_id"`
+	ExportedAt    time.Time                  `json:"exported_at"`
+	Reviews       []*ent.FlashcardReview     `json:"reviews"`
+	Annotations   []*ent.FlashcardAnnotation `json:"annotations"`
+	FlashcardTags map[uuid.UUID][]string     `json:"flashcard_tags"`
+}
+
 // UserService defines the interface for user business logic
 type UserService interface {
 	SearchUsers(query string) ([]repository.UserSearchResult, error)
+	ExportPersonalData(ctx context.Context, userID string) (*PersonalDataExport, error)
 }
 
 // NewUserService creates a new UserService instance
-func NewUserService(userRepo repository.UserRepository) UserService {
+func NewUserService(
+	userRepo repository.UserRepository,
+	reviewRepo repository.FlashcardReviewRepository,
+	annotationRepo repository.FlashcardAnnotationRepository,
+	tagRepo repository.TagRepository,
+) UserService {
 	return &userServiceImpl{
-		userRepo: userRepo,
+		userRepo:       userRepo,
+		reviewRepo:     reviewRepo,
+		annotationRepo: annotationRepo,
+		tagRepo:        tagRepo,
 	}
 }
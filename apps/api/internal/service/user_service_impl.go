@@ -1,13 +1,63 @@
 package service
 
 import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/quanphung1120/advanced-quiz-be/internal/repository"
 )
 
 type userServiceImpl struct {
-	userRepo repository.UserRepository
+	userRepo       repository.UserRepository
+	reviewRepo     repository.FlashcardReviewRepository
+	annotationRepo repository.FlashcardAnnotationRepository
+	tagRepo        repository.TagRepository
 }
 
 func (s *userServiceImpl) SearchUsers(query string) ([]repository.UserSearchResult, error) {
 	return s.userRepo.SearchUsers(query)
 }
+
+// ExportPersonalData returns everything stored about the user's own activity,
+// including progress and annotations on flashcards that were since deleted or
+// unshared
+func (s *userServiceImpl) ExportPersonalData(ctx context.Context, userID string) (*PersonalDataExport, error) {
+	reviews, err := s.reviewRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := s.annotationRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool, len(reviews)+len(annotations))
+	ids := make([]uuid.UUID, 0, len(reviews)+len(annotations))
+	for _, review := range reviews {
+		if !seen[review.FlashcardID] {
+			seen[review.FlashcardID] = true
+			ids = append(ids, review.FlashcardID)
+		}
+	}
+	for _, annotation := range annotations {
+		if !seen[annotation.FlashcardID] {
+			seen[annotation.FlashcardID] = true
+			ids = append(ids, annotation.FlashcardID)
+		}
+	}
+
+	tags, err := s.tagRepo.ListByFlashcards(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PersonalDataExport{
+		UserID:        userID,
+		ExportedAt:    time.Now(),
+		Reviews:       reviews,
+		Annotations:   annotations,
+		FlashcardTags: tags,
+	}, nil
+}
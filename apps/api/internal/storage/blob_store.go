@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when a blob does not exist in the store
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore defines the interface for storing binary objects such as media attachments
+type BlobStore interface {
+	// Put stores the content of r under key, replacing any existing blob
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+
+	// Get opens the blob stored under key; the caller must close the reader
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Exists reports whether a blob is stored under key
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// Delete removes the blob stored under key; deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
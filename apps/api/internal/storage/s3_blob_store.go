@@ -0,0 +1,219 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Requests are signed without hashing the body, which S3 and MinIO both accept
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// S3Config configures an S3-compatible blob store such as AWS S3 or MinIO
+type S3Config struct {
+	Endpoint  string // e.g. "https://s3.eu-west-1.amazonaws.com" or "http://localhost:9000"
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+}
+
+// S3BlobStore implements BlobStore against an S3-compatible API using path-style
+// addressing and AWS Signature Version 4
+type S3BlobStore struct {
+	config     S3Config
+	endpoint   *url.URL
+	httpClient *http.Client
+}
+
+func NewS3BlobStore(config S3Config) (BlobStore, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(config.Endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", config.Endpoint)
+	}
+	if config.Bucket == "" {
+		return nil, fmt.Errorf("S3 bucket is required")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+
+	return &S3BlobStore{
+		config:     config,
+		endpoint:   endpoint,
+		httpClient: &http.Client{Timeout: 5 * time.Minute},
+	}, nil
+}
+
+func (s *S3BlobStore) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	u.Path = "/" + s.config.Bucket + "/" + strings.TrimPrefix(key, "/")
+	return &u
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), r)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return checkResponse(resp)
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkResponse(resp); err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+func (s *S3BlobStore) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key).String(), nil)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	err = checkResponse(resp)
+	if err == ErrBlobNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = checkResponse(resp)
+	if err == ErrBlobNotFound {
+		return nil
+	}
+	return err
+}
+
+func (s *S3BlobStore) do(req *http.Request) (*http.Response, error) {
+	s.sign(req, time.Now().UTC())
+	return s.httpClient.Do(req)
+}
+
+func checkResponse(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrBlobNotFound
+	case resp.StatusCode >= 300:
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to the request
+func (s *S3BlobStore) sign(req *http.Request, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	// Canonical headers: lowercase names, sorted, trimmed values
+	names := make([]string, 0, len(req.Header))
+	headers := make(map[string]string, len(req.Header))
+	for name, values := range req.Header {
+		lower := strings.ToLower(name)
+		names = append(names, lower)
+		headers[lower] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		unsignedPayload,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+s.config.AccessKey+"/"+scope+
+		", SignedHeaders="+signedHeaders+", Signature="+signature)
+
+	// Host is sent from req.Host, not the header map
+	req.Header.Del("Host")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}